@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.8.4
+// - protoc             v5.29.0--rc1
+// source: admin/v1/invitation.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationInvitationServiceAcceptInvitation = "/admin.v1.InvitationService/AcceptInvitation"
+const OperationInvitationServiceCreateInvitation = "/admin.v1.InvitationService/CreateInvitation"
+const OperationInvitationServiceListInvitations = "/admin.v1.InvitationService/ListInvitations"
+const OperationInvitationServicePreviewInvitation = "/admin.v1.InvitationService/PreviewInvitation"
+const OperationInvitationServiceResendInvitation = "/admin.v1.InvitationService/ResendInvitation"
+const OperationInvitationServiceRevokeInvitation = "/admin.v1.InvitationService/RevokeInvitation"
+
+type InvitationServiceHTTPServer interface {
+	// AcceptInvitation 凭令牌接受邀请，无需登录；没有账号时同时注册
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	// CreateInvitation 邀请用户加入租户，按邮箱或手机号发送带令牌的邀请链接
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	// ListInvitations 获取租户的邀请列表
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	// PreviewInvitation 凭令牌查看邀请内容，无需登录
+	PreviewInvitation(context.Context, *PreviewInvitationRequest) (*PreviewInvitationResponse, error)
+	// ResendInvitation 重新发送邀请，旧链接失效，有效期重新计算
+	ResendInvitation(context.Context, *ResendInvitationRequest) (*ResendInvitationResponse, error)
+	// RevokeInvitation 撤销待接受的邀请
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+}
+
+func RegisterInvitationServiceHTTPServer(s *http.Server, srv InvitationServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/tenants/{tenant_id}/invitations", _InvitationService_CreateInvitation0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/invitations", _InvitationService_ListInvitations0_HTTP_Handler(srv))
+	r.POST("/v1/invitations/{id}/revoke", _InvitationService_RevokeInvitation0_HTTP_Handler(srv))
+	r.POST("/v1/invitations/{id}/resend", _InvitationService_ResendInvitation0_HTTP_Handler(srv))
+	r.GET("/v1/invitations/preview", _InvitationService_PreviewInvitation0_HTTP_Handler(srv))
+	r.POST("/v1/invitations/accept", _InvitationService_AcceptInvitation0_HTTP_Handler(srv))
+}
+
+func _InvitationService_CreateInvitation0_HTTP_Handler(srv InvitationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInvitationServiceCreateInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateInvitation(ctx, req.(*CreateInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateInvitationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InvitationService_ListInvitations0_HTTP_Handler(srv InvitationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListInvitationsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInvitationServiceListInvitations)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListInvitations(ctx, req.(*ListInvitationsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInvitationsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InvitationService_RevokeInvitation0_HTTP_Handler(srv InvitationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInvitationServiceRevokeInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeInvitationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InvitationService_ResendInvitation0_HTTP_Handler(srv InvitationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResendInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInvitationServiceResendInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendInvitation(ctx, req.(*ResendInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResendInvitationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InvitationService_PreviewInvitation0_HTTP_Handler(srv InvitationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PreviewInvitationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInvitationServicePreviewInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PreviewInvitation(ctx, req.(*PreviewInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreviewInvitationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InvitationService_AcceptInvitation0_HTTP_Handler(srv InvitationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInvitationServiceAcceptInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcceptInvitationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type InvitationServiceHTTPClient interface {
+	// AcceptInvitation 凭令牌接受邀请，无需登录；没有账号时同时注册
+	AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest, opts ...http.CallOption) (rsp *AcceptInvitationResponse, err error)
+	// CreateInvitation 邀请用户加入租户，按邮箱或手机号发送带令牌的邀请链接
+	CreateInvitation(ctx context.Context, req *CreateInvitationRequest, opts ...http.CallOption) (rsp *CreateInvitationResponse, err error)
+	// ListInvitations 获取租户的邀请列表
+	ListInvitations(ctx context.Context, req *ListInvitationsRequest, opts ...http.CallOption) (rsp *ListInvitationsResponse, err error)
+	// PreviewInvitation 凭令牌查看邀请内容，无需登录
+	PreviewInvitation(ctx context.Context, req *PreviewInvitationRequest, opts ...http.CallOption) (rsp *PreviewInvitationResponse, err error)
+	// ResendInvitation 重新发送邀请，旧链接失效，有效期重新计算
+	ResendInvitation(ctx context.Context, req *ResendInvitationRequest, opts ...http.CallOption) (rsp *ResendInvitationResponse, err error)
+	// RevokeInvitation 撤销待接受的邀请
+	RevokeInvitation(ctx context.Context, req *RevokeInvitationRequest, opts ...http.CallOption) (rsp *RevokeInvitationResponse, err error)
+}
+
+type InvitationServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewInvitationServiceHTTPClient(client *http.Client) InvitationServiceHTTPClient {
+	return &InvitationServiceHTTPClientImpl{client}
+}
+
+// AcceptInvitation 凭令牌接受邀请，无需登录；没有账号时同时注册
+func (c *InvitationServiceHTTPClientImpl) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...http.CallOption) (*AcceptInvitationResponse, error) {
+	var out AcceptInvitationResponse
+	pattern := "/v1/invitations/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInvitationServiceAcceptInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateInvitation 邀请用户加入租户，按邮箱或手机号发送带令牌的邀请链接
+func (c *InvitationServiceHTTPClientImpl) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...http.CallOption) (*CreateInvitationResponse, error) {
+	var out CreateInvitationResponse
+	pattern := "/v1/tenants/{tenant_id}/invitations"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInvitationServiceCreateInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListInvitations 获取租户的邀请列表
+func (c *InvitationServiceHTTPClientImpl) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...http.CallOption) (*ListInvitationsResponse, error) {
+	var out ListInvitationsResponse
+	pattern := "/v1/tenants/{tenant_id}/invitations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInvitationServiceListInvitations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PreviewInvitation 凭令牌查看邀请内容，无需登录
+func (c *InvitationServiceHTTPClientImpl) PreviewInvitation(ctx context.Context, in *PreviewInvitationRequest, opts ...http.CallOption) (*PreviewInvitationResponse, error) {
+	var out PreviewInvitationResponse
+	pattern := "/v1/invitations/preview"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInvitationServicePreviewInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResendInvitation 重新发送邀请，旧链接失效，有效期重新计算
+func (c *InvitationServiceHTTPClientImpl) ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...http.CallOption) (*ResendInvitationResponse, error) {
+	var out ResendInvitationResponse
+	pattern := "/v1/invitations/{id}/resend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInvitationServiceResendInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeInvitation 撤销待接受的邀请
+func (c *InvitationServiceHTTPClientImpl) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...http.CallOption) (*RevokeInvitationResponse, error) {
+	var out RevokeInvitationResponse
+	pattern := "/v1/invitations/{id}/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInvitationServiceRevokeInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
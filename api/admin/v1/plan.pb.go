@@ -0,0 +1,1276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.0--rc1
+// source: admin/v1/plan.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 套餐，各上限未设置表示不限制
+type Plan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	MaxUsers       *int32                 `protobuf:"varint,5,opt,name=max_users,json=maxUsers,proto3,oneof" json:"max_users,omitempty"`
+	MaxDepartments *int32                 `protobuf:"varint,6,opt,name=max_departments,json=maxDepartments,proto3,oneof" json:"max_departments,omitempty"`
+	MaxSubTenants  *int32                 `protobuf:"varint,7,opt,name=max_sub_tenants,json=maxSubTenants,proto3,oneof" json:"max_sub_tenants,omitempty"`
+	MaxRoles       *int32                 `protobuf:"varint,8,opt,name=max_roles,json=maxRoles,proto3,oneof" json:"max_roles,omitempty"`
+	Features       map[string]bool        `protobuf:"bytes,9,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 功能开关，未列出的功能视为关闭
+	IsDefault      bool                   `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`                                                       // 新建租户未指定套餐时使用
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_admin_v1_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Plan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Plan) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Plan) GetMaxUsers() int32 {
+	if x != nil && x.MaxUsers != nil {
+		return *x.MaxUsers
+	}
+	return 0
+}
+
+func (x *Plan) GetMaxDepartments() int32 {
+	if x != nil && x.MaxDepartments != nil {
+		return *x.MaxDepartments
+	}
+	return 0
+}
+
+func (x *Plan) GetMaxSubTenants() int32 {
+	if x != nil && x.MaxSubTenants != nil {
+		return *x.MaxSubTenants
+	}
+	return 0
+}
+
+func (x *Plan) GetMaxRoles() int32 {
+	if x != nil && x.MaxRoles != nil {
+		return *x.MaxRoles
+	}
+	return 0
+}
+
+func (x *Plan) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *Plan) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Plan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Plan) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 创建套餐请求
+type CreatePlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxUsers       *int32                 `protobuf:"varint,4,opt,name=max_users,json=maxUsers,proto3,oneof" json:"max_users,omitempty"`
+	MaxDepartments *int32                 `protobuf:"varint,5,opt,name=max_departments,json=maxDepartments,proto3,oneof" json:"max_departments,omitempty"`
+	MaxSubTenants  *int32                 `protobuf:"varint,6,opt,name=max_sub_tenants,json=maxSubTenants,proto3,oneof" json:"max_sub_tenants,omitempty"`
+	MaxRoles       *int32                 `protobuf:"varint,7,opt,name=max_roles,json=maxRoles,proto3,oneof" json:"max_roles,omitempty"`
+	Features       map[string]bool        `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	IsDefault      bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePlanRequest) Reset() {
+	*x = CreatePlanRequest{}
+	mi := &file_admin_v1_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanRequest) ProtoMessage() {}
+
+func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePlanRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePlanRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePlanRequest) GetMaxUsers() int32 {
+	if x != nil && x.MaxUsers != nil {
+		return *x.MaxUsers
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetMaxDepartments() int32 {
+	if x != nil && x.MaxDepartments != nil {
+		return *x.MaxDepartments
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetMaxSubTenants() int32 {
+	if x != nil && x.MaxSubTenants != nil {
+		return *x.MaxSubTenants
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetMaxRoles() int32 {
+	if x != nil && x.MaxRoles != nil {
+		return *x.MaxRoles
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *CreatePlanRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// 创建套餐响应
+type CreatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Plan          *Plan                  `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlanResponse) Reset() {
+	*x = CreatePlanResponse{}
+	mi := &file_admin_v1_plan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanResponse) ProtoMessage() {}
+
+func (x *CreatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePlanResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *CreatePlanResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreatePlanResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreatePlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// 修改套餐请求，编码不可修改
+type UpdatePlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxUsers       *int32                 `protobuf:"varint,4,opt,name=max_users,json=maxUsers,proto3,oneof" json:"max_users,omitempty"`
+	MaxDepartments *int32                 `protobuf:"varint,5,opt,name=max_departments,json=maxDepartments,proto3,oneof" json:"max_departments,omitempty"`
+	MaxSubTenants  *int32                 `protobuf:"varint,6,opt,name=max_sub_tenants,json=maxSubTenants,proto3,oneof" json:"max_sub_tenants,omitempty"`
+	MaxRoles       *int32                 `protobuf:"varint,7,opt,name=max_roles,json=maxRoles,proto3,oneof" json:"max_roles,omitempty"`
+	Features       map[string]bool        `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	IsDefault      bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePlanRequest) Reset() {
+	*x = UpdatePlanRequest{}
+	mi := &file_admin_v1_plan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanRequest) ProtoMessage() {}
+
+func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetMaxUsers() int32 {
+	if x != nil && x.MaxUsers != nil {
+		return *x.MaxUsers
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMaxDepartments() int32 {
+	if x != nil && x.MaxDepartments != nil {
+		return *x.MaxDepartments
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMaxSubTenants() int32 {
+	if x != nil && x.MaxSubTenants != nil {
+		return *x.MaxSubTenants
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetMaxRoles() int32 {
+	if x != nil && x.MaxRoles != nil {
+		return *x.MaxRoles
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *UpdatePlanRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// 修改套餐响应
+type UpdatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Plan          *Plan                  `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlanResponse) Reset() {
+	*x = UpdatePlanResponse{}
+	mi := &file_admin_v1_plan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanResponse) ProtoMessage() {}
+
+func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdatePlanResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *UpdatePlanResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdatePlanResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UpdatePlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// 删除套餐请求
+type DeletePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanRequest) Reset() {
+	*x = DeletePlanRequest{}
+	mi := &file_admin_v1_plan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanRequest) ProtoMessage() {}
+
+func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 删除套餐响应
+type DeletePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanResponse) Reset() {
+	*x = DeletePlanResponse{}
+	mi := &file_admin_v1_plan_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanResponse) ProtoMessage() {}
+
+func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePlanResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *DeletePlanResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeletePlanResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 获取套餐列表请求
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_admin_v1_plan_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{7}
+}
+
+// 获取套餐列表响应
+type ListPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Plans         []*Plan                `protobuf:"bytes,4,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_admin_v1_plan_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPlansResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListPlansResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPlansResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListPlansResponse) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// 分配套餐请求
+type SetTenantPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTenantPlanRequest) Reset() {
+	*x = SetTenantPlanRequest{}
+	mi := &file_admin_v1_plan_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTenantPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantPlanRequest) ProtoMessage() {}
+
+func (x *SetTenantPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantPlanRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetTenantPlanRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SetTenantPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// 分配套餐响应，降级后已超出上限的资源在usages中标记exceeded
+type SetTenantPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Usages        []*QuotaUsage          `protobuf:"bytes,4,rep,name=usages,proto3" json:"usages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTenantPlanResponse) Reset() {
+	*x = SetTenantPlanResponse{}
+	mi := &file_admin_v1_plan_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTenantPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantPlanResponse) ProtoMessage() {}
+
+func (x *SetTenantPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantPlanResponse.ProtoReflect.Descriptor instead.
+func (*SetTenantPlanResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetTenantPlanResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *SetTenantPlanResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetTenantPlanResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SetTenantPlanResponse) GetUsages() []*QuotaUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+// 资源用量
+type QuotaUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"` // users、departments、sub_tenants、roles
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Used          int32                  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // 未设置表示不限制
+	Exceeded      bool                   `protobuf:"varint,5,opt,name=exceeded,proto3" json:"exceeded,omitempty"` // 用量已超出上限（套餐降级后可能出现），此时不能再新增
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_admin_v1_plan_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuotaUsage) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+// 获取租户用量请求
+type GetTenantUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageRequest) Reset() {
+	*x = GetTenantUsageRequest{}
+	mi := &file_admin_v1_plan_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageRequest) ProtoMessage() {}
+
+func (x *GetTenantUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTenantUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// 获取租户用量响应
+type GetTenantUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Plan          *Plan                  `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"` // 未分配套餐时为空
+	Usages        []*QuotaUsage          `protobuf:"bytes,5,rep,name=usages,proto3" json:"usages,omitempty"`
+	Features      map[string]bool        `protobuf:"bytes,6,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 套餐开启的功能，未分配套餐时为空，表示所有功能可用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageResponse) Reset() {
+	*x = GetTenantUsageResponse{}
+	mi := &file_admin_v1_plan_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageResponse) ProtoMessage() {}
+
+func (x *GetTenantUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_plan_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_plan_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTenantUsageResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *GetTenantUsageResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetTenantUsageResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetTenantUsageResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *GetTenantUsageResponse) GetUsages() []*QuotaUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+func (x *GetTenantUsageResponse) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+var File_admin_v1_plan_proto protoreflect.FileDescriptor
+
+const file_admin_v1_plan_proto_rawDesc = "" +
+	"\n" +
+	"\x13admin/v1/plan.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\"\x97\x04\n" +
+	"\x04Plan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\tmax_users\x18\x05 \x01(\x05H\x00R\bmaxUsers\x88\x01\x01\x12,\n" +
+	"\x0fmax_departments\x18\x06 \x01(\x05H\x01R\x0emaxDepartments\x88\x01\x01\x12+\n" +
+	"\x0fmax_sub_tenants\x18\a \x01(\x05H\x02R\rmaxSubTenants\x88\x01\x01\x12 \n" +
+	"\tmax_roles\x18\b \x01(\x05H\x03R\bmaxRoles\x88\x01\x01\x128\n" +
+	"\bfeatures\x18\t \x03(\v2\x1c.admin.v1.Plan.FeaturesEntryR\bfeatures\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\n" +
+	" \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_max_usersB\x12\n" +
+	"\x10_max_departmentsB\x12\n" +
+	"\x10_max_sub_tenantsB\f\n" +
+	"\n" +
+	"_max_roles\"\xe3\x03\n" +
+	"\x11CreatePlanRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\tmax_users\x18\x04 \x01(\x05H\x00R\bmaxUsers\x88\x01\x01\x12,\n" +
+	"\x0fmax_departments\x18\x05 \x01(\x05H\x01R\x0emaxDepartments\x88\x01\x01\x12+\n" +
+	"\x0fmax_sub_tenants\x18\x06 \x01(\x05H\x02R\rmaxSubTenants\x88\x01\x01\x12 \n" +
+	"\tmax_roles\x18\a \x01(\x05H\x03R\bmaxRoles\x88\x01\x01\x12E\n" +
+	"\bfeatures\x18\b \x03(\v2).admin.v1.CreatePlanRequest.FeaturesEntryR\bfeatures\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_max_usersB\x12\n" +
+	"\x10_max_departmentsB\x12\n" +
+	"\x10_max_sub_tenantsB\f\n" +
+	"\n" +
+	"_max_roles\"v\n" +
+	"\x12CreatePlanResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\"\n" +
+	"\x04plan\x18\x04 \x01(\v2\x0e.admin.v1.PlanR\x04plan\"\xdf\x03\n" +
+	"\x11UpdatePlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\tmax_users\x18\x04 \x01(\x05H\x00R\bmaxUsers\x88\x01\x01\x12,\n" +
+	"\x0fmax_departments\x18\x05 \x01(\x05H\x01R\x0emaxDepartments\x88\x01\x01\x12+\n" +
+	"\x0fmax_sub_tenants\x18\x06 \x01(\x05H\x02R\rmaxSubTenants\x88\x01\x01\x12 \n" +
+	"\tmax_roles\x18\a \x01(\x05H\x03R\bmaxRoles\x88\x01\x01\x12E\n" +
+	"\bfeatures\x18\b \x03(\v2).admin.v1.UpdatePlanRequest.FeaturesEntryR\bfeatures\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_max_usersB\x12\n" +
+	"\x10_max_departmentsB\x12\n" +
+	"\x10_max_sub_tenantsB\f\n" +
+	"\n" +
+	"_max_roles\"v\n" +
+	"\x12UpdatePlanResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\"\n" +
+	"\x04plan\x18\x04 \x01(\v2\x0e.admin.v1.PlanR\x04plan\"#\n" +
+	"\x11DeletePlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x12DeletePlanResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"\x12\n" +
+	"\x10ListPlansRequest\"w\n" +
+	"\x11ListPlansResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12$\n" +
+	"\x05plans\x18\x04 \x03(\v2\x0e.admin.v1.PlanR\x05plans\"L\n" +
+	"\x14SetTenantPlanRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\tR\x06planId\"\x83\x01\n" +
+	"\x15SetTenantPlanResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12,\n" +
+	"\x06usages\x18\x04 \x03(\v2\x14.admin.v1.QuotaUsageR\x06usages\"\x93\x01\n" +
+	"\n" +
+	"QuotaUsage\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04used\x18\x03 \x01(\x05R\x04used\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1a\n" +
+	"\bexceeded\x18\x05 \x01(\bR\bexceededB\b\n" +
+	"\x06_limit\"4\n" +
+	"\x15GetTenantUsageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\xb1\x02\n" +
+	"\x16GetTenantUsageResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\"\n" +
+	"\x04plan\x18\x04 \x01(\v2\x0e.admin.v1.PlanR\x04plan\x12,\n" +
+	"\x06usages\x18\x05 \x03(\v2\x14.admin.v1.QuotaUsageR\x06usages\x12J\n" +
+	"\bfeatures\x18\x06 \x03(\v2..admin.v1.GetTenantUsageResponse.FeaturesEntryR\bfeatures\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x012\x81\x05\n" +
+	"\vPlanService\x12]\n" +
+	"\n" +
+	"CreatePlan\x12\x1b.admin.v1.CreatePlanRequest\x1a\x1c.admin.v1.CreatePlanResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/plans\x12b\n" +
+	"\n" +
+	"UpdatePlan\x12\x1b.admin.v1.UpdatePlanRequest\x1a\x1c.admin.v1.UpdatePlanResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/plans/{id}\x12_\n" +
+	"\n" +
+	"DeletePlan\x12\x1b.admin.v1.DeletePlanRequest\x1a\x1c.admin.v1.DeletePlanResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/plans/{id}\x12W\n" +
+	"\tListPlans\x12\x1a.admin.v1.ListPlansRequest\x1a\x1b.admin.v1.ListPlansResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/plans\x12y\n" +
+	"\rSetTenantPlan\x12\x1e.admin.v1.SetTenantPlanRequest\x1a\x1f.admin.v1.SetTenantPlanResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/tenants/{tenant_id}/plan\x12z\n" +
+	"\x0eGetTenantUsage\x12\x1f.admin.v1.GetTenantUsageRequest\x1a .admin.v1.GetTenantUsageResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/tenants/{tenant_id}/usageB+Z)github.com/yc-alpha/admin/api/admin/v1;v1b\x06proto3"
+
+var (
+	file_admin_v1_plan_proto_rawDescOnce sync.Once
+	file_admin_v1_plan_proto_rawDescData []byte
+)
+
+func file_admin_v1_plan_proto_rawDescGZIP() []byte {
+	file_admin_v1_plan_proto_rawDescOnce.Do(func() {
+		file_admin_v1_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_plan_proto_rawDesc), len(file_admin_v1_plan_proto_rawDesc)))
+	})
+	return file_admin_v1_plan_proto_rawDescData
+}
+
+var file_admin_v1_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_admin_v1_plan_proto_goTypes = []any{
+	(*Plan)(nil),                   // 0: admin.v1.Plan
+	(*CreatePlanRequest)(nil),      // 1: admin.v1.CreatePlanRequest
+	(*CreatePlanResponse)(nil),     // 2: admin.v1.CreatePlanResponse
+	(*UpdatePlanRequest)(nil),      // 3: admin.v1.UpdatePlanRequest
+	(*UpdatePlanResponse)(nil),     // 4: admin.v1.UpdatePlanResponse
+	(*DeletePlanRequest)(nil),      // 5: admin.v1.DeletePlanRequest
+	(*DeletePlanResponse)(nil),     // 6: admin.v1.DeletePlanResponse
+	(*ListPlansRequest)(nil),       // 7: admin.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),      // 8: admin.v1.ListPlansResponse
+	(*SetTenantPlanRequest)(nil),   // 9: admin.v1.SetTenantPlanRequest
+	(*SetTenantPlanResponse)(nil),  // 10: admin.v1.SetTenantPlanResponse
+	(*QuotaUsage)(nil),             // 11: admin.v1.QuotaUsage
+	(*GetTenantUsageRequest)(nil),  // 12: admin.v1.GetTenantUsageRequest
+	(*GetTenantUsageResponse)(nil), // 13: admin.v1.GetTenantUsageResponse
+	nil,                            // 14: admin.v1.Plan.FeaturesEntry
+	nil,                            // 15: admin.v1.CreatePlanRequest.FeaturesEntry
+	nil,                            // 16: admin.v1.UpdatePlanRequest.FeaturesEntry
+	nil,                            // 17: admin.v1.GetTenantUsageResponse.FeaturesEntry
+}
+var file_admin_v1_plan_proto_depIdxs = []int32{
+	14, // 0: admin.v1.Plan.features:type_name -> admin.v1.Plan.FeaturesEntry
+	15, // 1: admin.v1.CreatePlanRequest.features:type_name -> admin.v1.CreatePlanRequest.FeaturesEntry
+	0,  // 2: admin.v1.CreatePlanResponse.plan:type_name -> admin.v1.Plan
+	16, // 3: admin.v1.UpdatePlanRequest.features:type_name -> admin.v1.UpdatePlanRequest.FeaturesEntry
+	0,  // 4: admin.v1.UpdatePlanResponse.plan:type_name -> admin.v1.Plan
+	0,  // 5: admin.v1.ListPlansResponse.plans:type_name -> admin.v1.Plan
+	11, // 6: admin.v1.SetTenantPlanResponse.usages:type_name -> admin.v1.QuotaUsage
+	0,  // 7: admin.v1.GetTenantUsageResponse.plan:type_name -> admin.v1.Plan
+	11, // 8: admin.v1.GetTenantUsageResponse.usages:type_name -> admin.v1.QuotaUsage
+	17, // 9: admin.v1.GetTenantUsageResponse.features:type_name -> admin.v1.GetTenantUsageResponse.FeaturesEntry
+	1,  // 10: admin.v1.PlanService.CreatePlan:input_type -> admin.v1.CreatePlanRequest
+	3,  // 11: admin.v1.PlanService.UpdatePlan:input_type -> admin.v1.UpdatePlanRequest
+	5,  // 12: admin.v1.PlanService.DeletePlan:input_type -> admin.v1.DeletePlanRequest
+	7,  // 13: admin.v1.PlanService.ListPlans:input_type -> admin.v1.ListPlansRequest
+	9,  // 14: admin.v1.PlanService.SetTenantPlan:input_type -> admin.v1.SetTenantPlanRequest
+	12, // 15: admin.v1.PlanService.GetTenantUsage:input_type -> admin.v1.GetTenantUsageRequest
+	2,  // 16: admin.v1.PlanService.CreatePlan:output_type -> admin.v1.CreatePlanResponse
+	4,  // 17: admin.v1.PlanService.UpdatePlan:output_type -> admin.v1.UpdatePlanResponse
+	6,  // 18: admin.v1.PlanService.DeletePlan:output_type -> admin.v1.DeletePlanResponse
+	8,  // 19: admin.v1.PlanService.ListPlans:output_type -> admin.v1.ListPlansResponse
+	10, // 20: admin.v1.PlanService.SetTenantPlan:output_type -> admin.v1.SetTenantPlanResponse
+	13, // 21: admin.v1.PlanService.GetTenantUsage:output_type -> admin.v1.GetTenantUsageResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_plan_proto_init() }
+func file_admin_v1_plan_proto_init() {
+	if File_admin_v1_plan_proto != nil {
+		return
+	}
+	file_admin_v1_plan_proto_msgTypes[0].OneofWrappers = []any{}
+	file_admin_v1_plan_proto_msgTypes[1].OneofWrappers = []any{}
+	file_admin_v1_plan_proto_msgTypes[3].OneofWrappers = []any{}
+	file_admin_v1_plan_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_plan_proto_rawDesc), len(file_admin_v1_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_plan_proto_goTypes,
+		DependencyIndexes: file_admin_v1_plan_proto_depIdxs,
+		MessageInfos:      file_admin_v1_plan_proto_msgTypes,
+	}.Build()
+	File_admin_v1_plan_proto = out.File
+	file_admin_v1_plan_proto_goTypes = nil
+	file_admin_v1_plan_proto_depIdxs = nil
+}
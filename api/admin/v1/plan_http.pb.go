@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.8.4
+// - protoc             v5.29.0--rc1
+// source: admin/v1/plan.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationPlanServiceCreatePlan = "/admin.v1.PlanService/CreatePlan"
+const OperationPlanServiceDeletePlan = "/admin.v1.PlanService/DeletePlan"
+const OperationPlanServiceGetTenantUsage = "/admin.v1.PlanService/GetTenantUsage"
+const OperationPlanServiceListPlans = "/admin.v1.PlanService/ListPlans"
+const OperationPlanServiceSetTenantPlan = "/admin.v1.PlanService/SetTenantPlan"
+const OperationPlanServiceUpdatePlan = "/admin.v1.PlanService/UpdatePlan"
+
+type PlanServiceHTTPServer interface {
+	// CreatePlan 创建套餐
+	CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanResponse, error)
+	// DeletePlan 删除套餐，仍有租户使用时不能删除
+	DeletePlan(context.Context, *DeletePlanRequest) (*DeletePlanResponse, error)
+	// GetTenantUsage 获取租户的资源用量和套餐上限
+	GetTenantUsage(context.Context, *GetTenantUsageRequest) (*GetTenantUsageResponse, error)
+	// ListPlans 获取套餐列表
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
+	// SetTenantPlan 为租户分配套餐，plan_id为空时取消套餐（不限制）
+	SetTenantPlan(context.Context, *SetTenantPlanRequest) (*SetTenantPlanResponse, error)
+	// UpdatePlan 修改套餐，未填写的上限表示不限制
+	UpdatePlan(context.Context, *UpdatePlanRequest) (*UpdatePlanResponse, error)
+}
+
+func RegisterPlanServiceHTTPServer(s *http.Server, srv PlanServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/plans", _PlanService_CreatePlan0_HTTP_Handler(srv))
+	r.PUT("/v1/plans/{id}", _PlanService_UpdatePlan0_HTTP_Handler(srv))
+	r.DELETE("/v1/plans/{id}", _PlanService_DeletePlan0_HTTP_Handler(srv))
+	r.GET("/v1/plans", _PlanService_ListPlans0_HTTP_Handler(srv))
+	r.PUT("/v1/tenants/{tenant_id}/plan", _PlanService_SetTenantPlan0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/usage", _PlanService_GetTenantUsage0_HTTP_Handler(srv))
+}
+
+func _PlanService_CreatePlan0_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreatePlanRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlanServiceCreatePlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePlan(ctx, req.(*CreatePlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreatePlanResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlanService_UpdatePlan0_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePlanRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlanServiceUpdatePlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePlan(ctx, req.(*UpdatePlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePlanResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlanService_DeletePlan0_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePlanRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlanServiceDeletePlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePlan(ctx, req.(*DeletePlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeletePlanResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlanService_ListPlans0_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPlansRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlanServiceListPlans)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPlans(ctx, req.(*ListPlansRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPlansResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlanService_SetTenantPlan0_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetTenantPlanRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlanServiceSetTenantPlan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetTenantPlan(ctx, req.(*SetTenantPlanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetTenantPlanResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PlanService_GetTenantUsage0_HTTP_Handler(srv PlanServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPlanServiceGetTenantUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantUsage(ctx, req.(*GetTenantUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTenantUsageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PlanServiceHTTPClient interface {
+	// CreatePlan 创建套餐
+	CreatePlan(ctx context.Context, req *CreatePlanRequest, opts ...http.CallOption) (rsp *CreatePlanResponse, err error)
+	// DeletePlan 删除套餐，仍有租户使用时不能删除
+	DeletePlan(ctx context.Context, req *DeletePlanRequest, opts ...http.CallOption) (rsp *DeletePlanResponse, err error)
+	// GetTenantUsage 获取租户的资源用量和套餐上限
+	GetTenantUsage(ctx context.Context, req *GetTenantUsageRequest, opts ...http.CallOption) (rsp *GetTenantUsageResponse, err error)
+	// ListPlans 获取套餐列表
+	ListPlans(ctx context.Context, req *ListPlansRequest, opts ...http.CallOption) (rsp *ListPlansResponse, err error)
+	// SetTenantPlan 为租户分配套餐，plan_id为空时取消套餐（不限制）
+	SetTenantPlan(ctx context.Context, req *SetTenantPlanRequest, opts ...http.CallOption) (rsp *SetTenantPlanResponse, err error)
+	// UpdatePlan 修改套餐，未填写的上限表示不限制
+	UpdatePlan(ctx context.Context, req *UpdatePlanRequest, opts ...http.CallOption) (rsp *UpdatePlanResponse, err error)
+}
+
+type PlanServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewPlanServiceHTTPClient(client *http.Client) PlanServiceHTTPClient {
+	return &PlanServiceHTTPClientImpl{client}
+}
+
+// CreatePlan 创建套餐
+func (c *PlanServiceHTTPClientImpl) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...http.CallOption) (*CreatePlanResponse, error) {
+	var out CreatePlanResponse
+	pattern := "/v1/plans"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlanServiceCreatePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePlan 删除套餐，仍有租户使用时不能删除
+func (c *PlanServiceHTTPClientImpl) DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...http.CallOption) (*DeletePlanResponse, error) {
+	var out DeletePlanResponse
+	pattern := "/v1/plans/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlanServiceDeletePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTenantUsage 获取租户的资源用量和套餐上限
+func (c *PlanServiceHTTPClientImpl) GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest, opts ...http.CallOption) (*GetTenantUsageResponse, error) {
+	var out GetTenantUsageResponse
+	pattern := "/v1/tenants/{tenant_id}/usage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlanServiceGetTenantUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPlans 获取套餐列表
+func (c *PlanServiceHTTPClientImpl) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...http.CallOption) (*ListPlansResponse, error) {
+	var out ListPlansResponse
+	pattern := "/v1/plans"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPlanServiceListPlans))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetTenantPlan 为租户分配套餐，plan_id为空时取消套餐（不限制）
+func (c *PlanServiceHTTPClientImpl) SetTenantPlan(ctx context.Context, in *SetTenantPlanRequest, opts ...http.CallOption) (*SetTenantPlanResponse, error) {
+	var out SetTenantPlanResponse
+	pattern := "/v1/tenants/{tenant_id}/plan"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlanServiceSetTenantPlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdatePlan 修改套餐，未填写的上限表示不限制
+func (c *PlanServiceHTTPClientImpl) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...http.CallOption) (*UpdatePlanResponse, error) {
+	var out UpdatePlanResponse
+	pattern := "/v1/plans/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPlanServiceUpdatePlan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,2106 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.0--rc1
+// source: admin/v1/role.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 角色信息
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId      string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`  // 为空表示平台级角色
+	IsSystem      bool                   `protobuf:"varint,5,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"` // 系统预置角色不可删除，不可修改code
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DataScope     string                 `protobuf:"bytes,10,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`     // 数据范围：ALL、TENANT_SUBTREE、TENANT、DEPT_SUBTREE、SELF
+	IsTemplate    bool                   `protobuf:"varint,11,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"` // 角色模板，租户角色只能继承平台级角色模板
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_admin_v1_role_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Role) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Role) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Role) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Role) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *Role) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+// 用户角色授予信息
+type UserRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 为空表示平台级授予，在所有租户生效
+	GrantedAt     string                 `protobuf:"bytes,4,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 为空表示永久有效
+	Role          *Role                  `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	GrantedBy     string                 `protobuf:"bytes,7,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"` // 授予人ID
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`                        // 授予原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRole) Reset() {
+	*x = UserRole{}
+	mi := &file_admin_v1_role_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRole) ProtoMessage() {}
+
+func (x *UserRole) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRole.ProtoReflect.Descriptor instead.
+func (*UserRole) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserRole) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserRole) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRole) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UserRole) GetGrantedAt() string {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return ""
+}
+
+func (x *UserRole) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *UserRole) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *UserRole) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
+func (x *UserRole) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 创建角色请求
+type CreateRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId       string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 为空创建平台级角色
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	InheritRoleIds []string               `protobuf:"bytes,5,rep,name=inherit_role_ids,json=inheritRoleIds,proto3" json:"inherit_role_ids,omitempty"` // 继承的角色ID，租户可据此从平台级角色模板派生自定义角色
+	DataScope      string                 `protobuf:"bytes,6,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`                  // 数据范围，为空默认TENANT；租户角色不能使用ALL
+	IsTemplate     bool                   `protobuf:"varint,7,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`              // 是否角色模板，仅平台级角色可设置，且不能使用ALL
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRoleRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetInheritRoleIds() []string {
+	if x != nil {
+		return x.InheritRoleIds
+	}
+	return nil
+}
+
+func (x *CreateRoleRequest) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+// 创建角色响应
+type CreateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Role          *Role                  `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponse) ProtoMessage() {}
+
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *CreateRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// 获取角色请求
+type GetRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleRequest) Reset() {
+	*x = GetRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleRequest) ProtoMessage() {}
+
+func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 获取角色响应
+type GetRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Role          *Role                  `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleResponse) Reset() {
+	*x = GetRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleResponse) ProtoMessage() {}
+
+func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *GetRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// 获取角色列表请求
+type ListRolesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Page            int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword         string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	TenantId        string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                       // 为空时使用当前租户
+	IncludePlatform bool                   `protobuf:"varint,5,opt,name=include_platform,json=includePlatform,proto3" json:"include_platform,omitempty"` // 是否同时返回平台级角色
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRolesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRolesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRolesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetIncludePlatform() bool {
+	if x != nil {
+		return x.IncludePlatform
+	}
+	return false
+}
+
+// 获取角色列表响应
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Roles         []*Role                `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRolesResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListRolesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListRolesResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListRolesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 更新角色请求
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // 系统预置角色不可修改
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`       // 不传保持不变
+	DataScope     string                 `protobuf:"bytes,6,opt,name=data_scope,json=dataScope,proto3" json:"data_scope,omitempty"`           // 数据范围，为空保持不变；租户角色不能使用ALL
+	IsTemplate    *bool                  `protobuf:"varint,7,opt,name=is_template,json=isTemplate,proto3,oneof" json:"is_template,omitempty"` // 不传保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateRoleRequest) GetDataScope() string {
+	if x != nil {
+		return x.DataScope
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetIsTemplate() bool {
+	if x != nil && x.IsTemplate != nil {
+		return *x.IsTemplate
+	}
+	return false
+}
+
+// 更新角色响应
+type UpdateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Role          *Role                  `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *UpdateRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UpdateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// 删除角色请求
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 删除角色响应
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *DeleteRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 添加角色继承请求
+type AddRoleInheritanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InheritRoleId string                 `protobuf:"bytes,2,opt,name=inherit_role_id,json=inheritRoleId,proto3" json:"inherit_role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleInheritanceRequest) Reset() {
+	*x = AddRoleInheritanceRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleInheritanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleInheritanceRequest) ProtoMessage() {}
+
+func (x *AddRoleInheritanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleInheritanceRequest.ProtoReflect.Descriptor instead.
+func (*AddRoleInheritanceRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddRoleInheritanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddRoleInheritanceRequest) GetInheritRoleId() string {
+	if x != nil {
+		return x.InheritRoleId
+	}
+	return ""
+}
+
+// 添加角色继承响应
+type AddRoleInheritanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleInheritanceResponse) Reset() {
+	*x = AddRoleInheritanceResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleInheritanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleInheritanceResponse) ProtoMessage() {}
+
+func (x *AddRoleInheritanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleInheritanceResponse.ProtoReflect.Descriptor instead.
+func (*AddRoleInheritanceResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddRoleInheritanceResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *AddRoleInheritanceResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AddRoleInheritanceResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 移除角色继承请求
+type RemoveRoleInheritanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InheritRoleId string                 `protobuf:"bytes,2,opt,name=inherit_role_id,json=inheritRoleId,proto3" json:"inherit_role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleInheritanceRequest) Reset() {
+	*x = RemoveRoleInheritanceRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleInheritanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleInheritanceRequest) ProtoMessage() {}
+
+func (x *RemoveRoleInheritanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleInheritanceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoleInheritanceRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveRoleInheritanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveRoleInheritanceRequest) GetInheritRoleId() string {
+	if x != nil {
+		return x.InheritRoleId
+	}
+	return ""
+}
+
+// 移除角色继承响应
+type RemoveRoleInheritanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRoleInheritanceResponse) Reset() {
+	*x = RemoveRoleInheritanceResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleInheritanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleInheritanceResponse) ProtoMessage() {}
+
+func (x *RemoveRoleInheritanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRoleInheritanceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRoleInheritanceResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveRoleInheritanceResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RemoveRoleInheritanceResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RemoveRoleInheritanceResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 获取角色继承请求
+type ListRoleInheritanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleInheritanceRequest) Reset() {
+	*x = ListRoleInheritanceRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleInheritanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleInheritanceRequest) ProtoMessage() {}
+
+func (x *ListRoleInheritanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleInheritanceRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleInheritanceRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRoleInheritanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 获取角色继承响应
+type ListRoleInheritanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Inherits      []*Role                `protobuf:"bytes,4,rep,name=inherits,proto3" json:"inherits,omitempty"`   // 直接继承的角色
+	Effective     []*Role                `protobuf:"bytes,5,rep,name=effective,proto3" json:"effective,omitempty"` // 沿继承链展开后的所有角色（不含自身）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleInheritanceResponse) Reset() {
+	*x = ListRoleInheritanceResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleInheritanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleInheritanceResponse) ProtoMessage() {}
+
+func (x *ListRoleInheritanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleInheritanceResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleInheritanceResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListRoleInheritanceResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListRoleInheritanceResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListRoleInheritanceResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListRoleInheritanceResponse) GetInherits() []*Role {
+	if x != nil {
+		return x.Inherits
+	}
+	return nil
+}
+
+func (x *ListRoleInheritanceResponse) GetEffective() []*Role {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+// 授予角色请求
+type AssignRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`    // 为空表示平台级授予，仅平台级角色可用
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 格式 2006-01-02 15:04:05，为空表示永久有效
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AssignRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// 授予角色响应
+type AssignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	UserRole      *UserRole              `protobuf:"bytes,4,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AssignRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *AssignRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AssignRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AssignRoleResponse) GetUserRole() *UserRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return nil
+}
+
+// 临时提权请求
+type GrantTemporaryRoleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId          string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	TenantId        string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                       // 为空表示平台级授予，仅平台级角色可用
+	DurationSeconds int64                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 有效时长（秒），不能超过auth.elevation.max_duration
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                           // 提权原因，必填
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GrantTemporaryRoleRequest) Reset() {
+	*x = GrantTemporaryRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantTemporaryRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantTemporaryRoleRequest) ProtoMessage() {}
+
+func (x *GrantTemporaryRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantTemporaryRoleRequest.ProtoReflect.Descriptor instead.
+func (*GrantTemporaryRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GrantTemporaryRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GrantTemporaryRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *GrantTemporaryRoleRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GrantTemporaryRoleRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *GrantTemporaryRoleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 临时提权响应
+type GrantTemporaryRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	UserRole      *UserRole              `protobuf:"bytes,4,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantTemporaryRoleResponse) Reset() {
+	*x = GrantTemporaryRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantTemporaryRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantTemporaryRoleResponse) ProtoMessage() {}
+
+func (x *GrantTemporaryRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantTemporaryRoleResponse.ProtoReflect.Descriptor instead.
+func (*GrantTemporaryRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GrantTemporaryRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *GrantTemporaryRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GrantTemporaryRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GrantTemporaryRoleResponse) GetUserRole() *UserRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return nil
+}
+
+// 撤销角色请求
+type RevokeRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// 撤销角色响应
+type RevokeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleResponse) Reset() {
+	*x = RevokeRoleResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleResponse) ProtoMessage() {}
+
+func (x *RevokeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeRoleResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RevokeRoleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RevokeRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 获取用户角色请求
+type ListUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 为空时使用当前租户，仍为空则只返回平台级角色
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRolesRequest) Reset() {
+	*x = ListUserRolesRequest{}
+	mi := &file_admin_v1_role_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRolesRequest) ProtoMessage() {}
+
+func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListUserRolesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserRolesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// 获取用户角色响应
+type ListUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	UserRoles     []*UserRole            `protobuf:"bytes,4,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRolesResponse) Reset() {
+	*x = ListUserRolesResponse{}
+	mi := &file_admin_v1_role_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRolesResponse) ProtoMessage() {}
+
+func (x *ListUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_role_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_role_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserRolesResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListUserRolesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListUserRolesResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUserRolesResponse) GetUserRoles() []*UserRole {
+	if x != nil {
+		return x.UserRoles
+	}
+	return nil
+}
+
+var File_admin_v1_role_proto protoreflect.FileDescriptor
+
+const file_admin_v1_role_proto_rawDesc = "" +
+	"\n" +
+	"\x13admin/v1/role.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\"\xb5\x02\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tis_system\x18\x05 \x01(\bR\bisSystem\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\n" +
+	" \x01(\tR\tdataScope\x12\x1f\n" +
+	"\vis_template\x18\v \x01(\bR\n" +
+	"isTemplate\"\xe9\x01\n" +
+	"\bUserRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"granted_at\x18\x04 \x01(\tR\tgrantedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12\"\n" +
+	"\x04role\x18\x06 \x01(\v2\x0e.admin.v1.RoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\a \x01(\tR\tgrantedBy\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\"\xe4\x01\n" +
+	"\x11CreateRoleRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12(\n" +
+	"\x10inherit_role_ids\x18\x05 \x03(\tR\x0einheritRoleIds\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\x06 \x01(\tR\tdataScope\x12\x1f\n" +
+	"\vis_template\x18\a \x01(\bR\n" +
+	"isTemplate\"v\n" +
+	"\x12CreateRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\"\n" +
+	"\x04role\x18\x04 \x01(\v2\x0e.admin.v1.RoleR\x04role\" \n" +
+	"\x0eGetRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x0fGetRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\"\n" +
+	"\x04role\x18\x04 \x01(\v2\x0e.admin.v1.RoleR\x04role\"\xa5\x01\n" +
+	"\x10ListRolesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\x12)\n" +
+	"\x10include_platform\x18\x05 \x01(\bR\x0fincludePlatform\"\x8d\x01\n" +
+	"\x11ListRolesResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12$\n" +
+	"\x05roles\x18\x04 \x03(\v2\x0e.admin.v1.RoleR\x05roles\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"\xf2\x01\n" +
+	"\x11UpdateRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"data_scope\x18\x06 \x01(\tR\tdataScope\x12$\n" +
+	"\vis_template\x18\a \x01(\bH\x01R\n" +
+	"isTemplate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_activeB\x0e\n" +
+	"\f_is_template\"v\n" +
+	"\x12UpdateRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\"\n" +
+	"\x04role\x18\x04 \x01(\v2\x0e.admin.v1.RoleR\x04role\"#\n" +
+	"\x11DeleteRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x12DeleteRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"S\n" +
+	"\x19AddRoleInheritanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0finherit_role_id\x18\x02 \x01(\tR\rinheritRoleId\"Z\n" +
+	"\x1aAddRoleInheritanceResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"V\n" +
+	"\x1cRemoveRoleInheritanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0finherit_role_id\x18\x02 \x01(\tR\rinheritRoleId\"]\n" +
+	"\x1dRemoveRoleInheritanceResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\",\n" +
+	"\x1aListRoleInheritanceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb5\x01\n" +
+	"\x1bListRoleInheritanceResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12*\n" +
+	"\binherits\x18\x04 \x03(\v2\x0e.admin.v1.RoleR\binherits\x12,\n" +
+	"\teffective\x18\x05 \x03(\v2\x0e.admin.v1.RoleR\teffective\"\x81\x01\n" +
+	"\x11AssignRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"\x83\x01\n" +
+	"\x12AssignRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12/\n" +
+	"\tuser_role\x18\x04 \x01(\v2\x12.admin.v1.UserRoleR\buserRole\"\xad\x01\n" +
+	"\x19GrantTemporaryRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x8b\x01\n" +
+	"\x1aGrantTemporaryRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12/\n" +
+	"\tuser_role\x18\x04 \x01(\v2\x12.admin.v1.UserRoleR\buserRole\"b\n" +
+	"\x11RevokeRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\"R\n" +
+	"\x12RevokeRoleResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"L\n" +
+	"\x14ListUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"\x88\x01\n" +
+	"\x15ListUserRolesResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x121\n" +
+	"\n" +
+	"user_roles\x18\x04 \x03(\v2\x12.admin.v1.UserRoleR\tuserRoles2\xf8\n" +
+	"\n" +
+	"\vRoleService\x12]\n" +
+	"\n" +
+	"CreateRole\x12\x1b.admin.v1.CreateRoleRequest\x1a\x1c.admin.v1.CreateRoleResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/roles\x12V\n" +
+	"\aGetRole\x12\x18.admin.v1.GetRoleRequest\x1a\x19.admin.v1.GetRoleResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/roles/{id}\x12W\n" +
+	"\tListRoles\x12\x1a.admin.v1.ListRolesRequest\x1a\x1b.admin.v1.ListRolesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/roles\x12b\n" +
+	"\n" +
+	"UpdateRole\x12\x1b.admin.v1.UpdateRoleRequest\x1a\x1c.admin.v1.UpdateRoleResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/roles/{id}\x12_\n" +
+	"\n" +
+	"DeleteRole\x12\x1b.admin.v1.DeleteRoleRequest\x1a\x1c.admin.v1.DeleteRoleResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/roles/{id}\x12\x83\x01\n" +
+	"\x12AddRoleInheritance\x12#.admin.v1.AddRoleInheritanceRequest\x1a$.admin.v1.AddRoleInheritanceResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/roles/{id}/inherits\x12\x9b\x01\n" +
+	"\x15RemoveRoleInheritance\x12&.admin.v1.RemoveRoleInheritanceRequest\x1a'.admin.v1.RemoveRoleInheritanceResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/roles/{id}/inherits/{inherit_role_id}\x12\x83\x01\n" +
+	"\x13ListRoleInheritance\x12$.admin.v1.ListRoleInheritanceRequest\x1a%.admin.v1.ListRoleInheritanceResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/roles/{id}/inherits\x12m\n" +
+	"\n" +
+	"AssignRole\x12\x1b.admin.v1.AssignRoleRequest\x1a\x1c.admin.v1.AssignRoleResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/users/{user_id}/roles\x12\x8f\x01\n" +
+	"\x12GrantTemporaryRole\x12#.admin.v1.GrantTemporaryRoleRequest\x1a$.admin.v1.GrantTemporaryRoleResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/users/{user_id}/roles/temporary\x12t\n" +
+	"\n" +
+	"RevokeRole\x12\x1b.admin.v1.RevokeRoleRequest\x1a\x1c.admin.v1.RevokeRoleResponse\"+\x82\xd3\xe4\x93\x02%*#/v1/users/{user_id}/roles/{role_id}\x12s\n" +
+	"\rListUserRoles\x12\x1e.admin.v1.ListUserRolesRequest\x1a\x1f.admin.v1.ListUserRolesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/users/{user_id}/rolesB+Z)github.com/yc-alpha/admin/api/admin/v1;v1b\x06proto3"
+
+var (
+	file_admin_v1_role_proto_rawDescOnce sync.Once
+	file_admin_v1_role_proto_rawDescData []byte
+)
+
+func file_admin_v1_role_proto_rawDescGZIP() []byte {
+	file_admin_v1_role_proto_rawDescOnce.Do(func() {
+		file_admin_v1_role_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_role_proto_rawDesc), len(file_admin_v1_role_proto_rawDesc)))
+	})
+	return file_admin_v1_role_proto_rawDescData
+}
+
+var file_admin_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_admin_v1_role_proto_goTypes = []any{
+	(*Role)(nil),                          // 0: admin.v1.Role
+	(*UserRole)(nil),                      // 1: admin.v1.UserRole
+	(*CreateRoleRequest)(nil),             // 2: admin.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),            // 3: admin.v1.CreateRoleResponse
+	(*GetRoleRequest)(nil),                // 4: admin.v1.GetRoleRequest
+	(*GetRoleResponse)(nil),               // 5: admin.v1.GetRoleResponse
+	(*ListRolesRequest)(nil),              // 6: admin.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),             // 7: admin.v1.ListRolesResponse
+	(*UpdateRoleRequest)(nil),             // 8: admin.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),            // 9: admin.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),             // 10: admin.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),            // 11: admin.v1.DeleteRoleResponse
+	(*AddRoleInheritanceRequest)(nil),     // 12: admin.v1.AddRoleInheritanceRequest
+	(*AddRoleInheritanceResponse)(nil),    // 13: admin.v1.AddRoleInheritanceResponse
+	(*RemoveRoleInheritanceRequest)(nil),  // 14: admin.v1.RemoveRoleInheritanceRequest
+	(*RemoveRoleInheritanceResponse)(nil), // 15: admin.v1.RemoveRoleInheritanceResponse
+	(*ListRoleInheritanceRequest)(nil),    // 16: admin.v1.ListRoleInheritanceRequest
+	(*ListRoleInheritanceResponse)(nil),   // 17: admin.v1.ListRoleInheritanceResponse
+	(*AssignRoleRequest)(nil),             // 18: admin.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),            // 19: admin.v1.AssignRoleResponse
+	(*GrantTemporaryRoleRequest)(nil),     // 20: admin.v1.GrantTemporaryRoleRequest
+	(*GrantTemporaryRoleResponse)(nil),    // 21: admin.v1.GrantTemporaryRoleResponse
+	(*RevokeRoleRequest)(nil),             // 22: admin.v1.RevokeRoleRequest
+	(*RevokeRoleResponse)(nil),            // 23: admin.v1.RevokeRoleResponse
+	(*ListUserRolesRequest)(nil),          // 24: admin.v1.ListUserRolesRequest
+	(*ListUserRolesResponse)(nil),         // 25: admin.v1.ListUserRolesResponse
+}
+var file_admin_v1_role_proto_depIdxs = []int32{
+	0,  // 0: admin.v1.UserRole.role:type_name -> admin.v1.Role
+	0,  // 1: admin.v1.CreateRoleResponse.role:type_name -> admin.v1.Role
+	0,  // 2: admin.v1.GetRoleResponse.role:type_name -> admin.v1.Role
+	0,  // 3: admin.v1.ListRolesResponse.roles:type_name -> admin.v1.Role
+	0,  // 4: admin.v1.UpdateRoleResponse.role:type_name -> admin.v1.Role
+	0,  // 5: admin.v1.ListRoleInheritanceResponse.inherits:type_name -> admin.v1.Role
+	0,  // 6: admin.v1.ListRoleInheritanceResponse.effective:type_name -> admin.v1.Role
+	1,  // 7: admin.v1.AssignRoleResponse.user_role:type_name -> admin.v1.UserRole
+	1,  // 8: admin.v1.GrantTemporaryRoleResponse.user_role:type_name -> admin.v1.UserRole
+	1,  // 9: admin.v1.ListUserRolesResponse.user_roles:type_name -> admin.v1.UserRole
+	2,  // 10: admin.v1.RoleService.CreateRole:input_type -> admin.v1.CreateRoleRequest
+	4,  // 11: admin.v1.RoleService.GetRole:input_type -> admin.v1.GetRoleRequest
+	6,  // 12: admin.v1.RoleService.ListRoles:input_type -> admin.v1.ListRolesRequest
+	8,  // 13: admin.v1.RoleService.UpdateRole:input_type -> admin.v1.UpdateRoleRequest
+	10, // 14: admin.v1.RoleService.DeleteRole:input_type -> admin.v1.DeleteRoleRequest
+	12, // 15: admin.v1.RoleService.AddRoleInheritance:input_type -> admin.v1.AddRoleInheritanceRequest
+	14, // 16: admin.v1.RoleService.RemoveRoleInheritance:input_type -> admin.v1.RemoveRoleInheritanceRequest
+	16, // 17: admin.v1.RoleService.ListRoleInheritance:input_type -> admin.v1.ListRoleInheritanceRequest
+	18, // 18: admin.v1.RoleService.AssignRole:input_type -> admin.v1.AssignRoleRequest
+	20, // 19: admin.v1.RoleService.GrantTemporaryRole:input_type -> admin.v1.GrantTemporaryRoleRequest
+	22, // 20: admin.v1.RoleService.RevokeRole:input_type -> admin.v1.RevokeRoleRequest
+	24, // 21: admin.v1.RoleService.ListUserRoles:input_type -> admin.v1.ListUserRolesRequest
+	3,  // 22: admin.v1.RoleService.CreateRole:output_type -> admin.v1.CreateRoleResponse
+	5,  // 23: admin.v1.RoleService.GetRole:output_type -> admin.v1.GetRoleResponse
+	7,  // 24: admin.v1.RoleService.ListRoles:output_type -> admin.v1.ListRolesResponse
+	9,  // 25: admin.v1.RoleService.UpdateRole:output_type -> admin.v1.UpdateRoleResponse
+	11, // 26: admin.v1.RoleService.DeleteRole:output_type -> admin.v1.DeleteRoleResponse
+	13, // 27: admin.v1.RoleService.AddRoleInheritance:output_type -> admin.v1.AddRoleInheritanceResponse
+	15, // 28: admin.v1.RoleService.RemoveRoleInheritance:output_type -> admin.v1.RemoveRoleInheritanceResponse
+	17, // 29: admin.v1.RoleService.ListRoleInheritance:output_type -> admin.v1.ListRoleInheritanceResponse
+	19, // 30: admin.v1.RoleService.AssignRole:output_type -> admin.v1.AssignRoleResponse
+	21, // 31: admin.v1.RoleService.GrantTemporaryRole:output_type -> admin.v1.GrantTemporaryRoleResponse
+	23, // 32: admin.v1.RoleService.RevokeRole:output_type -> admin.v1.RevokeRoleResponse
+	25, // 33: admin.v1.RoleService.ListUserRoles:output_type -> admin.v1.ListUserRolesResponse
+	22, // [22:34] is the sub-list for method output_type
+	10, // [10:22] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_role_proto_init() }
+func file_admin_v1_role_proto_init() {
+	if File_admin_v1_role_proto != nil {
+		return
+	}
+	file_admin_v1_role_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_role_proto_rawDesc), len(file_admin_v1_role_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_role_proto_goTypes,
+		DependencyIndexes: file_admin_v1_role_proto_depIdxs,
+		MessageInfos:      file_admin_v1_role_proto_msgTypes,
+	}.Build()
+	File_admin_v1_role_proto = out.File
+	file_admin_v1_role_proto_goTypes = nil
+	file_admin_v1_role_proto_depIdxs = nil
+}
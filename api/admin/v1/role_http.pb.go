@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.8.4
+// - protoc             v5.29.0--rc1
+// source: admin/v1/role.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationRoleServiceAddRoleInheritance = "/admin.v1.RoleService/AddRoleInheritance"
+const OperationRoleServiceAssignRole = "/admin.v1.RoleService/AssignRole"
+const OperationRoleServiceCreateRole = "/admin.v1.RoleService/CreateRole"
+const OperationRoleServiceDeleteRole = "/admin.v1.RoleService/DeleteRole"
+const OperationRoleServiceGetRole = "/admin.v1.RoleService/GetRole"
+const OperationRoleServiceGrantTemporaryRole = "/admin.v1.RoleService/GrantTemporaryRole"
+const OperationRoleServiceListRoleInheritance = "/admin.v1.RoleService/ListRoleInheritance"
+const OperationRoleServiceListRoles = "/admin.v1.RoleService/ListRoles"
+const OperationRoleServiceListUserRoles = "/admin.v1.RoleService/ListUserRoles"
+const OperationRoleServiceRemoveRoleInheritance = "/admin.v1.RoleService/RemoveRoleInheritance"
+const OperationRoleServiceRevokeRole = "/admin.v1.RoleService/RevokeRole"
+const OperationRoleServiceUpdateRole = "/admin.v1.RoleService/UpdateRole"
+
+type RoleServiceHTTPServer interface {
+	// AddRoleInheritance 添加角色继承：角色{id}包含角色{inherit_role_id}的全部权限
+	AddRoleInheritance(context.Context, *AddRoleInheritanceRequest) (*AddRoleInheritanceResponse, error)
+	// AssignRole 为用户授予角色
+	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
+	// CreateRole 创建角色
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	// DeleteRole 删除角色
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	// GetRole 获取角色详情
+	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
+	// GrantTemporaryRole 临时提权：为用户授予限时角色，到期自动失效
+	GrantTemporaryRole(context.Context, *GrantTemporaryRoleRequest) (*GrantTemporaryRoleResponse, error)
+	// ListRoleInheritance 获取角色直接继承和展开后的所有角色
+	ListRoleInheritance(context.Context, *ListRoleInheritanceRequest) (*ListRoleInheritanceResponse, error)
+	// ListRoles 获取角色列表
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// ListUserRoles 获取用户在租户下的有效角色（平台级角色 + 该租户下的角色）
+	ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error)
+	// RemoveRoleInheritance 移除角色继承
+	RemoveRoleInheritance(context.Context, *RemoveRoleInheritanceRequest) (*RemoveRoleInheritanceResponse, error)
+	// RevokeRole 撤销用户的角色
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error)
+	// UpdateRole 更新角色
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+}
+
+func RegisterRoleServiceHTTPServer(s *http.Server, srv RoleServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/roles", _RoleService_CreateRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles/{id}", _RoleService_GetRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles", _RoleService_ListRoles0_HTTP_Handler(srv))
+	r.PUT("/v1/roles/{id}", _RoleService_UpdateRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/roles/{id}", _RoleService_DeleteRole0_HTTP_Handler(srv))
+	r.POST("/v1/roles/{id}/inherits", _RoleService_AddRoleInheritance0_HTTP_Handler(srv))
+	r.DELETE("/v1/roles/{id}/inherits/{inherit_role_id}", _RoleService_RemoveRoleInheritance0_HTTP_Handler(srv))
+	r.GET("/v1/roles/{id}/inherits", _RoleService_ListRoleInheritance0_HTTP_Handler(srv))
+	r.POST("/v1/users/{user_id}/roles", _RoleService_AssignRole0_HTTP_Handler(srv))
+	r.POST("/v1/users/{user_id}/roles/temporary", _RoleService_GrantTemporaryRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{user_id}/roles/{role_id}", _RoleService_RevokeRole0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/roles", _RoleService_ListUserRoles0_HTTP_Handler(srv))
+}
+
+func _RoleService_CreateRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceCreateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRole(ctx, req.(*CreateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_GetRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceGetRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRole(ctx, req.(*GetRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_ListRoles0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceListRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoles(ctx, req.(*ListRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRolesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_UpdateRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRole(ctx, req.(*UpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_DeleteRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceDeleteRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRole(ctx, req.(*DeleteRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_AddRoleInheritance0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddRoleInheritanceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceAddRoleInheritance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddRoleInheritance(ctx, req.(*AddRoleInheritanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddRoleInheritanceResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_RemoveRoleInheritance0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveRoleInheritanceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceRemoveRoleInheritance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveRoleInheritance(ctx, req.(*RemoveRoleInheritanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveRoleInheritanceResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_ListRoleInheritance0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleInheritanceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceListRoleInheritance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoleInheritance(ctx, req.(*ListRoleInheritanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleInheritanceResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_AssignRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceAssignRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignRole(ctx, req.(*AssignRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AssignRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_GrantTemporaryRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GrantTemporaryRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceGrantTemporaryRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GrantTemporaryRole(ctx, req.(*GrantTemporaryRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GrantTemporaryRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_RevokeRole0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceRevokeRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeRole(ctx, req.(*RevokeRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RoleService_ListUserRoles0_HTTP_Handler(srv RoleServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRoleServiceListUserRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserRoles(ctx, req.(*ListUserRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserRolesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RoleServiceHTTPClient interface {
+	// AddRoleInheritance 添加角色继承：角色{id}包含角色{inherit_role_id}的全部权限
+	AddRoleInheritance(ctx context.Context, req *AddRoleInheritanceRequest, opts ...http.CallOption) (rsp *AddRoleInheritanceResponse, err error)
+	// AssignRole 为用户授予角色
+	AssignRole(ctx context.Context, req *AssignRoleRequest, opts ...http.CallOption) (rsp *AssignRoleResponse, err error)
+	// CreateRole 创建角色
+	CreateRole(ctx context.Context, req *CreateRoleRequest, opts ...http.CallOption) (rsp *CreateRoleResponse, err error)
+	// DeleteRole 删除角色
+	DeleteRole(ctx context.Context, req *DeleteRoleRequest, opts ...http.CallOption) (rsp *DeleteRoleResponse, err error)
+	// GetRole 获取角色详情
+	GetRole(ctx context.Context, req *GetRoleRequest, opts ...http.CallOption) (rsp *GetRoleResponse, err error)
+	// GrantTemporaryRole 临时提权：为用户授予限时角色，到期自动失效
+	GrantTemporaryRole(ctx context.Context, req *GrantTemporaryRoleRequest, opts ...http.CallOption) (rsp *GrantTemporaryRoleResponse, err error)
+	// ListRoleInheritance 获取角色直接继承和展开后的所有角色
+	ListRoleInheritance(ctx context.Context, req *ListRoleInheritanceRequest, opts ...http.CallOption) (rsp *ListRoleInheritanceResponse, err error)
+	// ListRoles 获取角色列表
+	ListRoles(ctx context.Context, req *ListRolesRequest, opts ...http.CallOption) (rsp *ListRolesResponse, err error)
+	// ListUserRoles 获取用户在租户下的有效角色（平台级角色 + 该租户下的角色）
+	ListUserRoles(ctx context.Context, req *ListUserRolesRequest, opts ...http.CallOption) (rsp *ListUserRolesResponse, err error)
+	// RemoveRoleInheritance 移除角色继承
+	RemoveRoleInheritance(ctx context.Context, req *RemoveRoleInheritanceRequest, opts ...http.CallOption) (rsp *RemoveRoleInheritanceResponse, err error)
+	// RevokeRole 撤销用户的角色
+	RevokeRole(ctx context.Context, req *RevokeRoleRequest, opts ...http.CallOption) (rsp *RevokeRoleResponse, err error)
+	// UpdateRole 更新角色
+	UpdateRole(ctx context.Context, req *UpdateRoleRequest, opts ...http.CallOption) (rsp *UpdateRoleResponse, err error)
+}
+
+type RoleServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRoleServiceHTTPClient(client *http.Client) RoleServiceHTTPClient {
+	return &RoleServiceHTTPClientImpl{client}
+}
+
+// AddRoleInheritance 添加角色继承：角色{id}包含角色{inherit_role_id}的全部权限
+func (c *RoleServiceHTTPClientImpl) AddRoleInheritance(ctx context.Context, in *AddRoleInheritanceRequest, opts ...http.CallOption) (*AddRoleInheritanceResponse, error) {
+	var out AddRoleInheritanceResponse
+	pattern := "/v1/roles/{id}/inherits"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleServiceAddRoleInheritance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AssignRole 为用户授予角色
+func (c *RoleServiceHTTPClientImpl) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...http.CallOption) (*AssignRoleResponse, error) {
+	var out AssignRoleResponse
+	pattern := "/v1/users/{user_id}/roles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleServiceAssignRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRole 创建角色
+func (c *RoleServiceHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...http.CallOption) (*CreateRoleResponse, error) {
+	var out CreateRoleResponse
+	pattern := "/v1/roles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleServiceCreateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRole 删除角色
+func (c *RoleServiceHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...http.CallOption) (*DeleteRoleResponse, error) {
+	var out DeleteRoleResponse
+	pattern := "/v1/roles/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceDeleteRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRole 获取角色详情
+func (c *RoleServiceHTTPClientImpl) GetRole(ctx context.Context, in *GetRoleRequest, opts ...http.CallOption) (*GetRoleResponse, error) {
+	var out GetRoleResponse
+	pattern := "/v1/roles/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceGetRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GrantTemporaryRole 临时提权：为用户授予限时角色，到期自动失效
+func (c *RoleServiceHTTPClientImpl) GrantTemporaryRole(ctx context.Context, in *GrantTemporaryRoleRequest, opts ...http.CallOption) (*GrantTemporaryRoleResponse, error) {
+	var out GrantTemporaryRoleResponse
+	pattern := "/v1/users/{user_id}/roles/temporary"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleServiceGrantTemporaryRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRoleInheritance 获取角色直接继承和展开后的所有角色
+func (c *RoleServiceHTTPClientImpl) ListRoleInheritance(ctx context.Context, in *ListRoleInheritanceRequest, opts ...http.CallOption) (*ListRoleInheritanceResponse, error) {
+	var out ListRoleInheritanceResponse
+	pattern := "/v1/roles/{id}/inherits"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceListRoleInheritance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRoles 获取角色列表
+func (c *RoleServiceHTTPClientImpl) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...http.CallOption) (*ListRolesResponse, error) {
+	var out ListRolesResponse
+	pattern := "/v1/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceListRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUserRoles 获取用户在租户下的有效角色（平台级角色 + 该租户下的角色）
+func (c *RoleServiceHTTPClientImpl) ListUserRoles(ctx context.Context, in *ListUserRolesRequest, opts ...http.CallOption) (*ListUserRolesResponse, error) {
+	var out ListUserRolesResponse
+	pattern := "/v1/users/{user_id}/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceListUserRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveRoleInheritance 移除角色继承
+func (c *RoleServiceHTTPClientImpl) RemoveRoleInheritance(ctx context.Context, in *RemoveRoleInheritanceRequest, opts ...http.CallOption) (*RemoveRoleInheritanceResponse, error) {
+	var out RemoveRoleInheritanceResponse
+	pattern := "/v1/roles/{id}/inherits/{inherit_role_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceRemoveRoleInheritance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeRole 撤销用户的角色
+func (c *RoleServiceHTTPClientImpl) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...http.CallOption) (*RevokeRoleResponse, error) {
+	var out RevokeRoleResponse
+	pattern := "/v1/users/{user_id}/roles/{role_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRoleServiceRevokeRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateRole 更新角色
+func (c *RoleServiceHTTPClientImpl) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...http.CallOption) (*UpdateRoleResponse, error) {
+	var out UpdateRoleResponse
+	pattern := "/v1/roles/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRoleServiceUpdateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
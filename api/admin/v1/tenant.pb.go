@@ -146,6 +146,9 @@ type Tenant struct {
 	// 关联信息
 	Children      []*Tenant `protobuf:"bytes,16,rep,name=children,proto3" json:"children,omitempty"`
 	Parent        *Tenant   `protobuf:"bytes,17,opt,name=parent,proto3" json:"parent,omitempty"`
+	DeletedAt     string    `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt       string    `protobuf:"bytes,19,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // 已删除租户将被彻底清理的时间，未配置保留期时为空
+	PlanId        string    `protobuf:"bytes,20,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`    // 套餐ID，为空不限制用量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -299,6 +302,27 @@ func (x *Tenant) GetParent() *Tenant {
 	return nil
 }
 
+func (x *Tenant) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Tenant) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+func (x *Tenant) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
 // 创建租户请求
 type CreateTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -310,6 +334,7 @@ type CreateTenantRequest struct {
 	ExpiredAt     string                 `protobuf:"bytes,6,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
 	Attributes    map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	PlanId        string                 `protobuf:"bytes,9,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // 为空时使用默认套餐
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -400,6 +425,13 @@ func (x *CreateTenantRequest) GetCreatedBy() string {
 	return ""
 }
 
+func (x *CreateTenantRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
 // 创建租户响应
 type CreateTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1122,6 +1154,11 @@ func (x *ListGroupTenantsResponse) GetTotal() int32 {
 // 获取租户统计请求
 type GetTenantStatisticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Granularity   string                 `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"` // 创建趋势的粒度：DAY（默认）、MONTH
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`             // 创建趋势的起点（RFC3339），默认DAY为最近30天，MONTH为最近12个月
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`                 // 创建趋势的终点（RFC3339），默认当前时间
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`              // 租户明细分页
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1156,13 +1193,53 @@ func (*GetTenantStatisticsRequest) Descriptor() ([]byte, []int) {
 	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{13}
 }
 
+func (x *GetTenantStatisticsRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetTenantStatisticsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetTenantStatisticsRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GetTenantStatisticsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTenantStatisticsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 // 获取租户统计响应
 type GetTenantStatisticsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	Statistics    map[string]int32       `protobuf:"bytes,4,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Result        bool                     `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                    `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                   `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Statistics    map[string]int32         `protobuf:"bytes,4,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 按类型和状态的汇总：total、normal、group、sub以及小写的状态名
+	ByTypeStatus  []*TenantTypeStatusCount `protobuf:"bytes,5,rep,name=by_type_status,json=byTypeStatus,proto3" json:"by_type_status,omitempty"`
+	Groups        []*TenantGroupStatistics `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`   // 集团型租户的整棵子树统计
+	Tenants       []*TenantCountStatistics `protobuf:"bytes,7,rep,name=tenants,proto3" json:"tenants,omitempty"` // 各租户的成员和部门数，按成员数倒序分页
+	TenantTotal   int32                    `protobuf:"varint,8,opt,name=tenant_total,json=tenantTotal,proto3" json:"tenant_total,omitempty"`
+	Growth        []*TenantGrowthPoint     `protobuf:"bytes,9,rep,name=growth,proto3" json:"growth,omitempty"` // 租户创建趋势
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1225,34 +1302,65 @@ func (x *GetTenantStatisticsResponse) GetStatistics() map[string]int32 {
 	return nil
 }
 
-// 更新租户请求
-type UpdateTenantRequest struct {
+func (x *GetTenantStatisticsResponse) GetByTypeStatus() []*TenantTypeStatusCount {
+	if x != nil {
+		return x.ByTypeStatus
+	}
+	return nil
+}
+
+func (x *GetTenantStatisticsResponse) GetGroups() []*TenantGroupStatistics {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetTenantStatisticsResponse) GetTenants() []*TenantCountStatistics {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *GetTenantStatisticsResponse) GetTenantTotal() int32 {
+	if x != nil {
+		return x.TenantTotal
+	}
+	return 0
+}
+
+func (x *GetTenantStatisticsResponse) GetGrowth() []*TenantGrowthPoint {
+	if x != nil {
+		return x.Growth
+	}
+	return nil
+}
+
+// 按类型和状态分组的租户数
+type TenantTypeStatusCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Status        TenantStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=admin.v1.TenantStatus" json:"status,omitempty"`
-	ExpiredAt     string                 `protobuf:"bytes,5,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
-	Attributes    map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Type          TenantType             `protobuf:"varint,1,opt,name=type,proto3,enum=admin.v1.TenantType" json:"type,omitempty"`
+	Status        TenantStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=admin.v1.TenantStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTenantRequest) Reset() {
-	*x = UpdateTenantRequest{}
+func (x *TenantTypeStatusCount) Reset() {
+	*x = TenantTypeStatusCount{}
 	mi := &file_admin_v1_tenant_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTenantRequest) String() string {
+func (x *TenantTypeStatusCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTenantRequest) ProtoMessage() {}
+func (*TenantTypeStatusCount) ProtoMessage() {}
 
-func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+func (x *TenantTypeStatusCount) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_tenant_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1264,85 +1372,58 @@ func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TenantTypeStatusCount.ProtoReflect.Descriptor instead.
+func (*TenantTypeStatusCount) Descriptor() ([]byte, []int) {
 	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateTenantRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateTenantRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateTenantRequest) GetOwnerId() string {
+func (x *TenantTypeStatusCount) GetType() TenantType {
 	if x != nil {
-		return x.OwnerId
+		return x.Type
 	}
-	return ""
+	return TenantType_NORMAL
 }
 
-func (x *UpdateTenantRequest) GetStatus() TenantStatus {
+func (x *TenantTypeStatusCount) GetStatus() TenantStatus {
 	if x != nil {
 		return x.Status
 	}
 	return TenantStatus_TENANT_PENDING
 }
 
-func (x *UpdateTenantRequest) GetExpiredAt() string {
-	if x != nil {
-		return x.ExpiredAt
-	}
-	return ""
-}
-
-func (x *UpdateTenantRequest) GetAttributes() map[string]string {
+func (x *TenantTypeStatusCount) GetCount() int32 {
 	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-func (x *UpdateTenantRequest) GetUpdatedBy() string {
-	if x != nil {
-		return x.UpdatedBy
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-// 更新租户响应
-type UpdateTenantResponse struct {
+// 集团型租户子树统计，包含集团本身
+type TenantGroupStatistics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SubTenants    int32                  `protobuf:"varint,3,opt,name=sub_tenants,json=subTenants,proto3" json:"sub_tenants,omitempty"` // 下级租户数，不含集团本身
+	Members       int32                  `protobuf:"varint,4,opt,name=members,proto3" json:"members,omitempty"`                         // 子树内的成员数，同一用户加入多个租户只计一次
+	Departments   int32                  `protobuf:"varint,5,opt,name=departments,proto3" json:"departments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTenantResponse) Reset() {
-	*x = UpdateTenantResponse{}
+func (x *TenantGroupStatistics) Reset() {
+	*x = TenantGroupStatistics{}
 	mi := &file_admin_v1_tenant_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTenantResponse) String() string {
+func (x *TenantGroupStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTenantResponse) ProtoMessage() {}
+func (*TenantGroupStatistics) ProtoMessage() {}
 
-func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+func (x *TenantGroupStatistics) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_tenant_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1354,61 +1435,73 @@ func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TenantGroupStatistics.ProtoReflect.Descriptor instead.
+func (*TenantGroupStatistics) Descriptor() ([]byte, []int) {
 	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateTenantResponse) GetResult() bool {
+func (x *TenantGroupStatistics) GetTenantId() string {
 	if x != nil {
-		return x.Result
+		return x.TenantId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateTenantResponse) GetCode() int32 {
+func (x *TenantGroupStatistics) GetName() string {
 	if x != nil {
-		return x.Code
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantGroupStatistics) GetSubTenants() int32 {
+	if x != nil {
+		return x.SubTenants
 	}
 	return 0
 }
 
-func (x *UpdateTenantResponse) GetMsg() string {
+func (x *TenantGroupStatistics) GetMembers() int32 {
 	if x != nil {
-		return x.Msg
+		return x.Members
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTenantResponse) GetTenant() *Tenant {
+func (x *TenantGroupStatistics) GetDepartments() int32 {
 	if x != nil {
-		return x.Tenant
+		return x.Departments
 	}
-	return nil
+	return 0
 }
 
-// 删除租户请求
-type DeleteTenantRequest struct {
+// 单个租户的成员和部门数
+type TenantCountStatistics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          TenantType             `protobuf:"varint,3,opt,name=type,proto3,enum=admin.v1.TenantType" json:"type,omitempty"`
+	Status        TenantStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=admin.v1.TenantStatus" json:"status,omitempty"`
+	Members       int32                  `protobuf:"varint,5,opt,name=members,proto3" json:"members,omitempty"`
+	Departments   int32                  `protobuf:"varint,6,opt,name=departments,proto3" json:"departments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTenantRequest) Reset() {
-	*x = DeleteTenantRequest{}
+func (x *TenantCountStatistics) Reset() {
+	*x = TenantCountStatistics{}
 	mi := &file_admin_v1_tenant_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTenantRequest) String() string {
+func (x *TenantCountStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantRequest) ProtoMessage() {}
+func (*TenantCountStatistics) ProtoMessage() {}
 
-func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+func (x *TenantCountStatistics) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_tenant_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1420,42 +1513,77 @@ func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TenantCountStatistics.ProtoReflect.Descriptor instead.
+func (*TenantCountStatistics) Descriptor() ([]byte, []int) {
 	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteTenantRequest) GetId() string {
+func (x *TenantCountStatistics) GetTenantId() string {
 	if x != nil {
-		return x.Id
+		return x.TenantId
 	}
 	return ""
 }
 
-// 删除租户响应
-type DeleteTenantResponse struct {
+func (x *TenantCountStatistics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantCountStatistics) GetType() TenantType {
+	if x != nil {
+		return x.Type
+	}
+	return TenantType_NORMAL
+}
+
+func (x *TenantCountStatistics) GetStatus() TenantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TenantStatus_TENANT_PENDING
+}
+
+func (x *TenantCountStatistics) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *TenantCountStatistics) GetDepartments() int32 {
+	if x != nil {
+		return x.Departments
+	}
+	return 0
+}
+
+// 租户创建趋势中的一个时间段
+type TenantGrowthPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`    // 时间段起点（RFC3339）
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // 该时间段内创建的租户数
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`     // 截至该时间段末创建的租户总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTenantResponse) Reset() {
-	*x = DeleteTenantResponse{}
+func (x *TenantGrowthPoint) Reset() {
+	*x = TenantGrowthPoint{}
 	mi := &file_admin_v1_tenant_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTenantResponse) String() string {
+func (x *TenantGrowthPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantResponse) ProtoMessage() {}
+func (*TenantGrowthPoint) ProtoMessage() {}
 
-func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+func (x *TenantGrowthPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_tenant_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1467,49 +1595,1965 @@ func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TenantGrowthPoint.ProtoReflect.Descriptor instead.
+func (*TenantGrowthPoint) Descriptor() ([]byte, []int) {
 	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteTenantResponse) GetResult() bool {
+func (x *TenantGrowthPoint) GetPeriod() string {
 	if x != nil {
-		return x.Result
+		return x.Period
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteTenantResponse) GetCode() int32 {
+func (x *TenantGrowthPoint) GetCreated() int32 {
 	if x != nil {
-		return x.Code
+		return x.Created
 	}
 	return 0
 }
 
-func (x *DeleteTenantResponse) GetMsg() string {
+func (x *TenantGrowthPoint) GetTotal() int32 {
 	if x != nil {
-		return x.Msg
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-var File_admin_v1_tenant_proto protoreflect.FileDescriptor
+// 更新租户请求
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Status        TenantStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=admin.v1.TenantStatus" json:"status,omitempty"` // 不支持，请使用ActivateTenant/DisableTenant/ExpireTenant
+	ExpiredAt     string                 `protobuf:"bytes,5,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`      // 不支持，请使用RenewTenant
+	Attributes    map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_admin_v1_tenant_proto_rawDesc = "" +
-	"\n" +
-	"\x15admin/v1/tenant.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\"\xf6\x04\n" +
-	"\x06Tenant\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12(\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x14.admin.v1.TenantTypeR\x04type\x12\x1b\n" +
-	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12\x12\n" +
-	"\x04path\x18\x06 \x01(\tR\x04path\x12\x14\n" +
-	"\x05level\x18\a \x01(\x05R\x05level\x12.\n" +
-	"\x06status\x18\b \x01(\x0e2\x16.admin.v1.TenantStatusR\x06status\x12\x1d\n" +
-	"\n" +
-	"expired_at\x18\t \x01(\tR\texpiredAt\x12@\n" +
-	"\n" +
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetStatus() TenantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TenantStatus_TENANT_PENDING
+}
+
+func (x *UpdateTenantRequest) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateTenantRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+// 更新租户响应
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *UpdateTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UpdateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// 删除租户请求
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 删除租户响应
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *DeleteTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 激活租户请求
+type ActivateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateTenantRequest) Reset() {
+	*x = ActivateTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateTenantRequest) ProtoMessage() {}
+
+func (x *ActivateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateTenantRequest.ProtoReflect.Descriptor instead.
+func (*ActivateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ActivateTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActivateTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 激活租户响应
+type ActivateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateTenantResponse) Reset() {
+	*x = ActivateTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateTenantResponse) ProtoMessage() {}
+
+func (x *ActivateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateTenantResponse.ProtoReflect.Descriptor instead.
+func (*ActivateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ActivateTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ActivateTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ActivateTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ActivateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// 停用租户请求
+type DisableTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTenantRequest) Reset() {
+	*x = DisableTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTenantRequest) ProtoMessage() {}
+
+func (x *DisableTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTenantRequest.ProtoReflect.Descriptor instead.
+func (*DisableTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DisableTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DisableTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 停用租户响应
+type DisableTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTenantResponse) Reset() {
+	*x = DisableTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTenantResponse) ProtoMessage() {}
+
+func (x *DisableTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTenantResponse.ProtoReflect.Descriptor instead.
+func (*DisableTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DisableTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *DisableTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DisableTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DisableTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// 续期租户请求
+type RenewTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiredAt     string                 `protobuf:"bytes,2,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"` // 新的到期时间，RFC3339格式，必须晚于当前时间
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewTenantRequest) Reset() {
+	*x = RenewTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewTenantRequest) ProtoMessage() {}
+
+func (x *RenewTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewTenantRequest.ProtoReflect.Descriptor instead.
+func (*RenewTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RenewTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenewTenantRequest) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *RenewTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 续期租户响应
+type RenewTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewTenantResponse) Reset() {
+	*x = RenewTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewTenantResponse) ProtoMessage() {}
+
+func (x *RenewTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewTenantResponse.ProtoReflect.Descriptor instead.
+func (*RenewTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RenewTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RenewTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RenewTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RenewTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// 使租户过期请求
+type ExpireTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireTenantRequest) Reset() {
+	*x = ExpireTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireTenantRequest) ProtoMessage() {}
+
+func (x *ExpireTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireTenantRequest.ProtoReflect.Descriptor instead.
+func (*ExpireTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExpireTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpireTenantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 使租户过期响应
+type ExpireTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireTenantResponse) Reset() {
+	*x = ExpireTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireTenantResponse) ProtoMessage() {}
+
+func (x *ExpireTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireTenantResponse.ProtoReflect.Descriptor instead.
+func (*ExpireTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExpireTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ExpireTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ExpireTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ExpireTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// 租户状态变更记录
+type TenantStatusLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // ACTIVATE, DISABLE, RENEW, EXPIRE
+	FromStatus    TenantStatus           `protobuf:"varint,4,opt,name=from_status,json=fromStatus,proto3,enum=admin.v1.TenantStatus" json:"from_status,omitempty"`
+	ToStatus      TenantStatus           `protobuf:"varint,5,opt,name=to_status,json=toStatus,proto3,enum=admin.v1.TenantStatus" json:"to_status,omitempty"`
+	ExpiredAt     string                 `protobuf:"bytes,6,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,8,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 为空表示系统自动变更
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantStatusLog) Reset() {
+	*x = TenantStatusLog{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantStatusLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantStatusLog) ProtoMessage() {}
+
+func (x *TenantStatusLog) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantStatusLog.ProtoReflect.Descriptor instead.
+func (*TenantStatusLog) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TenantStatusLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantStatusLog) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantStatusLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TenantStatusLog) GetFromStatus() TenantStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return TenantStatus_TENANT_PENDING
+}
+
+func (x *TenantStatusLog) GetToStatus() TenantStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return TenantStatus_TENANT_PENDING
+}
+
+func (x *TenantStatusLog) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *TenantStatusLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TenantStatusLog) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *TenantStatusLog) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取租户状态变更记录请求
+type ListTenantStatusLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantStatusLogsRequest) Reset() {
+	*x = ListTenantStatusLogsRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantStatusLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantStatusLogsRequest) ProtoMessage() {}
+
+func (x *ListTenantStatusLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantStatusLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantStatusLogsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTenantStatusLogsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListTenantStatusLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTenantStatusLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取租户状态变更记录响应
+type ListTenantStatusLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Logs          []*TenantStatusLog     `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantStatusLogsResponse) Reset() {
+	*x = ListTenantStatusLogsResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantStatusLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantStatusLogsResponse) ProtoMessage() {}
+
+func (x *ListTenantStatusLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantStatusLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantStatusLogsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListTenantStatusLogsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListTenantStatusLogsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListTenantStatusLogsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListTenantStatusLogsResponse) GetLogs() []*TenantStatusLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListTenantStatusLogsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 移动租户请求
+type MoveTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 新的父租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTenantRequest) Reset() {
+	*x = MoveTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTenantRequest) ProtoMessage() {}
+
+func (x *MoveTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTenantRequest.ProtoReflect.Descriptor instead.
+func (*MoveTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MoveTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveTenantRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// 移动租户响应
+type MoveTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Affected      int32                  `protobuf:"varint,5,opt,name=affected,proto3" json:"affected,omitempty"` // 路径被改写的租户数量（含自身）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTenantResponse) Reset() {
+	*x = MoveTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTenantResponse) ProtoMessage() {}
+
+func (x *MoveTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTenantResponse.ProtoReflect.Descriptor instead.
+func (*MoveTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MoveTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *MoveTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MoveTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *MoveTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *MoveTenantResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 转换租户类型请求
+type ConvertTenantTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          TenantType             `protobuf:"varint,2,opt,name=type,proto3,enum=admin.v1.TenantType" json:"type,omitempty"` // 目标类型
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`   // 转换为SUB时必填，为GROUP/NORMAL时忽略（父租户固定为系统租户）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertTenantTypeRequest) Reset() {
+	*x = ConvertTenantTypeRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertTenantTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertTenantTypeRequest) ProtoMessage() {}
+
+func (x *ConvertTenantTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertTenantTypeRequest.ProtoReflect.Descriptor instead.
+func (*ConvertTenantTypeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConvertTenantTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConvertTenantTypeRequest) GetType() TenantType {
+	if x != nil {
+		return x.Type
+	}
+	return TenantType_NORMAL
+}
+
+func (x *ConvertTenantTypeRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// 转换租户类型响应
+type ConvertTenantTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Affected      int32                  `protobuf:"varint,5,opt,name=affected,proto3" json:"affected,omitempty"` // 路径被改写的租户数量（含自身）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertTenantTypeResponse) Reset() {
+	*x = ConvertTenantTypeResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertTenantTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertTenantTypeResponse) ProtoMessage() {}
+
+func (x *ConvertTenantTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertTenantTypeResponse.ProtoReflect.Descriptor instead.
+func (*ConvertTenantTypeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConvertTenantTypeResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ConvertTenantTypeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ConvertTenantTypeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ConvertTenantTypeResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *ConvertTenantTypeResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 恢复租户请求
+type RestoreTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTenantRequest) Reset() {
+	*x = RestoreTenantRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantRequest) ProtoMessage() {}
+
+func (x *RestoreTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 恢复租户响应
+type RestoreTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTenantResponse) Reset() {
+	*x = RestoreTenantResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantResponse) ProtoMessage() {}
+
+func (x *RestoreTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreTenantResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RestoreTenantResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RestoreTenantResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RestoreTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// 获取已删除租户列表请求
+type ListDeletedTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTenantsRequest) Reset() {
+	*x = ListDeletedTenantsRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTenantsRequest) ProtoMessage() {}
+
+func (x *ListDeletedTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListDeletedTenantsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedTenantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedTenantsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 获取已删除租户列表响应
+type ListDeletedTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenants       []*Tenant              `protobuf:"bytes,4,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTenantsResponse) Reset() {
+	*x = ListDeletedTenantsResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTenantsResponse) ProtoMessage() {}
+
+func (x *ListDeletedTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDeletedTenantsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListDeletedTenantsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListDeletedTenantsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListDeletedTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *ListDeletedTenantsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 清理已删除租户请求
+type PurgeDeletedTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只生成报告，不删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedTenantsRequest) Reset() {
+	*x = PurgeDeletedTenantsRequest{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedTenantsRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedTenantsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PurgeDeletedTenantsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 清理已删除租户响应
+type PurgeDeletedTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Report        *TenantPurgeReport     `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedTenantsResponse) Reset() {
+	*x = PurgeDeletedTenantsResponse{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedTenantsResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedTenantsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PurgeDeletedTenantsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *PurgeDeletedTenantsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PurgeDeletedTenantsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *PurgeDeletedTenantsResponse) GetReport() *TenantPurgeReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// 租户清理报告
+type TenantPurgeReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt     string                 `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Cutoff        string                 `protobuf:"bytes,3,opt,name=cutoff,proto3" json:"cutoff,omitempty"` // 删除时间早于该时间的租户会被清理
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Purged        []*PurgedTenant        `protobuf:"bytes,5,rep,name=purged,proto3" json:"purged,omitempty"`
+	Skipped       []*SkippedTenant       `protobuf:"bytes,6,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPurgeReport) Reset() {
+	*x = TenantPurgeReport{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPurgeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPurgeReport) ProtoMessage() {}
+
+func (x *TenantPurgeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPurgeReport.ProtoReflect.Descriptor instead.
+func (*TenantPurgeReport) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TenantPurgeReport) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *TenantPurgeReport) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *TenantPurgeReport) GetCutoff() string {
+	if x != nil {
+		return x.Cutoff
+	}
+	return ""
+}
+
+func (x *TenantPurgeReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *TenantPurgeReport) GetPurged() []*PurgedTenant {
+	if x != nil {
+		return x.Purged
+	}
+	return nil
+}
+
+func (x *TenantPurgeReport) GetSkipped() []*SkippedTenant {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// 被清理的租户及随之删除的数据量
+type PurgedTenant struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path            string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	DeletedAt       string                 `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Departments     int32                  `protobuf:"varint,5,opt,name=departments,proto3" json:"departments,omitempty"`
+	Roles           int32                  `protobuf:"varint,6,opt,name=roles,proto3" json:"roles,omitempty"`
+	Members         int32                  `protobuf:"varint,7,opt,name=members,proto3" json:"members,omitempty"` // user_tenants
+	UserRoles       int32                  `protobuf:"varint,8,opt,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	UserDepartments int32                  `protobuf:"varint,9,opt,name=user_departments,json=userDepartments,proto3" json:"user_departments,omitempty"`
+	CasbinRules     int32                  `protobuf:"varint,10,opt,name=casbin_rules,json=casbinRules,proto3" json:"casbin_rules,omitempty"`
+	StatusLogs      int32                  `protobuf:"varint,11,opt,name=status_logs,json=statusLogs,proto3" json:"status_logs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurgedTenant) Reset() {
+	*x = PurgedTenant{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgedTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgedTenant) ProtoMessage() {}
+
+func (x *PurgedTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgedTenant.ProtoReflect.Descriptor instead.
+func (*PurgedTenant) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PurgedTenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgedTenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PurgedTenant) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PurgedTenant) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *PurgedTenant) GetDepartments() int32 {
+	if x != nil {
+		return x.Departments
+	}
+	return 0
+}
+
+func (x *PurgedTenant) GetRoles() int32 {
+	if x != nil {
+		return x.Roles
+	}
+	return 0
+}
+
+func (x *PurgedTenant) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *PurgedTenant) GetUserRoles() int32 {
+	if x != nil {
+		return x.UserRoles
+	}
+	return 0
+}
+
+func (x *PurgedTenant) GetUserDepartments() int32 {
+	if x != nil {
+		return x.UserDepartments
+	}
+	return 0
+}
+
+func (x *PurgedTenant) GetCasbinRules() int32 {
+	if x != nil {
+		return x.CasbinRules
+	}
+	return 0
+}
+
+func (x *PurgedTenant) GetStatusLogs() int32 {
+	if x != nil {
+		return x.StatusLogs
+	}
+	return 0
+}
+
+// 未被清理的租户
+type SkippedTenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedTenant) Reset() {
+	*x = SkippedTenant{}
+	mi := &file_admin_v1_tenant_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedTenant) ProtoMessage() {}
+
+func (x *SkippedTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedTenant.ProtoReflect.Descriptor instead.
+func (*SkippedTenant) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SkippedTenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SkippedTenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkippedTenant) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_admin_v1_tenant_proto protoreflect.FileDescriptor
+
+const file_admin_v1_tenant_proto_rawDesc = "" +
+	"\n" +
+	"\x15admin/v1/tenant.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\"\xc9\x05\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12(\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x14.admin.v1.TenantTypeR\x04type\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04path\x18\x06 \x01(\tR\x04path\x12\x14\n" +
+	"\x05level\x18\a \x01(\x05R\x05level\x12.\n" +
+	"\x06status\x18\b \x01(\x0e2\x16.admin.v1.TenantStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"expired_at\x18\t \x01(\tR\texpiredAt\x12@\n" +
+	"\n" +
 	"attributes\x18\n" +
 	" \x03(\v2 .admin.v1.Tenant.AttributesEntryR\n" +
 	"attributes\x12\x1d\n" +
@@ -1523,10 +3567,14 @@ const file_admin_v1_tenant_proto_rawDesc = "" +
 	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12\x18\n" +
 	"\adeleted\x18\x0f \x01(\bR\adeleted\x12,\n" +
 	"\bchildren\x18\x10 \x03(\v2\x10.admin.v1.TenantR\bchildren\x12(\n" +
-	"\x06parent\x18\x11 \x01(\v2\x10.admin.v1.TenantR\x06parent\x1a=\n" +
+	"\x06parent\x18\x11 \x01(\v2\x10.admin.v1.TenantR\x06parent\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\x13 \x01(\tR\apurgeAt\x12\x17\n" +
+	"\aplan_id\x18\x14 \x01(\tR\x06planId\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa0\x03\n" +
 	"\x13CreateTenantRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12(\n" +
@@ -1539,7 +3587,8 @@ const file_admin_v1_tenant_proto_rawDesc = "" +
 	"attributes\x18\a \x03(\v2-.admin.v1.CreateTenantRequest.AttributesEntryR\n" +
 	"attributes\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\b \x01(\tR\tcreatedBy\x1a=\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x17\n" +
+	"\aplan_id\x18\t \x01(\tR\x06planId\x1a=\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"~\n" +
@@ -1592,18 +3641,50 @@ const file_admin_v1_tenant_proto_rawDesc = "" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x03 \x01(\tR\x03msg\x12*\n" +
 	"\atenants\x18\x04 \x03(\v2\x10.admin.v1.TenantR\atenants\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x05R\x05total\"\x1c\n" +
-	"\x1aGetTenantStatisticsRequest\"\xf1\x01\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"\x97\x01\n" +
+	"\x1aGetTenantStatisticsRequest\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x84\x04\n" +
 	"\x1bGetTenantStatisticsResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x03 \x01(\tR\x03msg\x12U\n" +
 	"\n" +
 	"statistics\x18\x04 \x03(\v25.admin.v1.GetTenantStatisticsResponse.StatisticsEntryR\n" +
-	"statistics\x1a=\n" +
+	"statistics\x12E\n" +
+	"\x0eby_type_status\x18\x05 \x03(\v2\x1f.admin.v1.TenantTypeStatusCountR\fbyTypeStatus\x127\n" +
+	"\x06groups\x18\x06 \x03(\v2\x1f.admin.v1.TenantGroupStatisticsR\x06groups\x129\n" +
+	"\atenants\x18\a \x03(\v2\x1f.admin.v1.TenantCountStatisticsR\atenants\x12!\n" +
+	"\ftenant_total\x18\b \x01(\x05R\vtenantTotal\x123\n" +
+	"\x06growth\x18\t \x03(\v2\x1b.admin.v1.TenantGrowthPointR\x06growth\x1a=\n" +
 	"\x0fStatisticsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xd0\x02\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x87\x01\n" +
+	"\x15TenantTypeStatusCount\x12(\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x14.admin.v1.TenantTypeR\x04type\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.admin.v1.TenantStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xa5\x01\n" +
+	"\x15TenantGroupStatistics\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vsub_tenants\x18\x03 \x01(\x05R\n" +
+	"subTenants\x12\x18\n" +
+	"\amembers\x18\x04 \x01(\x05R\amembers\x12 \n" +
+	"\vdepartments\x18\x05 \x01(\x05R\vdepartments\"\xde\x01\n" +
+	"\x15TenantCountStatistics\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x14.admin.v1.TenantTypeR\x04type\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.admin.v1.TenantStatusR\x06status\x12\x18\n" +
+	"\amembers\x18\x05 \x01(\x05R\amembers\x12 \n" +
+	"\vdepartments\x18\x06 \x01(\x05R\vdepartments\"[\n" +
+	"\x11TenantGrowthPoint\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"\xd0\x02\n" +
 	"\x13UpdateTenantRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
@@ -1629,7 +3710,137 @@ const file_admin_v1_tenant_proto_rawDesc = "" +
 	"\x14DeleteTenantResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x03 \x01(\tR\x03msg*,\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"?\n" +
+	"\x15ActivateTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x80\x01\n" +
+	"\x16ActivateTenantResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\">\n" +
+	"\x14DisableTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x7f\n" +
+	"\x15DisableTenantResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\"[\n" +
+	"\x12RenewTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"expired_at\x18\x02 \x01(\tR\texpiredAt\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"}\n" +
+	"\x13RenewTenantResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\"=\n" +
+	"\x13ExpireTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"~\n" +
+	"\x14ExpireTenantResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\"\xbb\x02\n" +
+	"\x0fTenantStatusLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x127\n" +
+	"\vfrom_status\x18\x04 \x01(\x0e2\x16.admin.v1.TenantStatusR\n" +
+	"fromStatus\x123\n" +
+	"\tto_status\x18\x05 \x01(\x0e2\x16.admin.v1.TenantStatusR\btoStatus\x12\x1d\n" +
+	"\n" +
+	"expired_at\x18\x06 \x01(\tR\texpiredAt\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\b \x01(\tR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"^\n" +
+	"\x1bListTenantStatusLogsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa1\x01\n" +
+	"\x1cListTenantStatusLogsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12-\n" +
+	"\x04logs\x18\x04 \x03(\v2\x19.admin.v1.TenantStatusLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"@\n" +
+	"\x11MoveTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"\x98\x01\n" +
+	"\x12MoveTenantResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\x12\x1a\n" +
+	"\baffected\x18\x05 \x01(\x05R\baffected\"q\n" +
+	"\x18ConvertTenantTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.admin.v1.TenantTypeR\x04type\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\"\x9f\x01\n" +
+	"\x19ConvertTenantTypeResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\x12\x1a\n" +
+	"\baffected\x18\x05 \x01(\x05R\baffected\"&\n" +
+	"\x14RestoreTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x7f\n" +
+	"\x15RestoreTenantResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12(\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x10.admin.v1.TenantR\x06tenant\"f\n" +
+	"\x19ListDeletedTenantsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\"\x9c\x01\n" +
+	"\x1aListDeletedTenantsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12*\n" +
+	"\atenants\x18\x04 \x03(\v2\x10.admin.v1.TenantR\atenants\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"5\n" +
+	"\x1aPurgeDeletedTenantsRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\x90\x01\n" +
+	"\x1bPurgeDeletedTenantsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x123\n" +
+	"\x06report\x18\x04 \x01(\v2\x1b.admin.v1.TenantPurgeReportR\x06report\"\xe7\x01\n" +
+	"\x11TenantPurgeReport\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x02 \x01(\tR\n" +
+	"finishedAt\x12\x16\n" +
+	"\x06cutoff\x18\x03 \x01(\tR\x06cutoff\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12.\n" +
+	"\x06purged\x18\x05 \x03(\v2\x16.admin.v1.PurgedTenantR\x06purged\x121\n" +
+	"\askipped\x18\x06 \x03(\v2\x17.admin.v1.SkippedTenantR\askipped\"\xc5\x02\n" +
+	"\fPurgedTenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\tR\tdeletedAt\x12 \n" +
+	"\vdepartments\x18\x05 \x01(\x05R\vdepartments\x12\x14\n" +
+	"\x05roles\x18\x06 \x01(\x05R\x05roles\x12\x18\n" +
+	"\amembers\x18\a \x01(\x05R\amembers\x12\x1d\n" +
+	"\n" +
+	"user_roles\x18\b \x01(\x05R\tuserRoles\x12)\n" +
+	"\x10user_departments\x18\t \x01(\x05R\x0fuserDepartments\x12!\n" +
+	"\fcasbin_rules\x18\n" +
+	" \x01(\x05R\vcasbinRules\x12\x1f\n" +
+	"\vstatus_logs\x18\v \x01(\x05R\n" +
+	"statusLogs\"K\n" +
+	"\rSkippedTenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason*,\n" +
 	"\n" +
 	"TenantType\x12\n" +
 	"\n" +
@@ -1640,17 +3851,28 @@ const file_admin_v1_tenant_proto_rawDesc = "" +
 	"\x0eTENANT_PENDING\x10\x00\x12\x11\n" +
 	"\rTENANT_ACTIVE\x10\x01\x12\x13\n" +
 	"\x0fTENANT_DISABLED\x10\x02\x12\x12\n" +
-	"\x0eTENANT_EXPIRED\x10\x032\x9e\b\n" +
+	"\x0eTENANT_EXPIRED\x10\x032\xe7\x11\n" +
 	"\rTenantService\x12e\n" +
-	"\fCreateTenant\x12\x1d.admin.v1.CreateTenantRequest\x1a\x1e.admin.v1.CreateTenantResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tenants\x12^\n" +
+	"\fCreateTenant\x12\x1d.admin.v1.CreateTenantRequest\x1a\x1e.admin.v1.CreateTenantResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tenants\x12p\n" +
+	"\x0fListRootTenants\x12 .admin.v1.ListRootTenantsRequest\x1a!.admin.v1.ListRootTenantsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tenants/root\x12u\n" +
+	"\x10ListGroupTenants\x12!.admin.v1.ListGroupTenantsRequest\x1a\".admin.v1.ListGroupTenantsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/tenants/groups\x12|\n" +
+	"\x12ListDeletedTenants\x12#.admin.v1.ListDeletedTenantsRequest\x1a$.admin.v1.ListDeletedTenantsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/tenants/deleted\x12\x80\x01\n" +
+	"\x13PurgeDeletedTenants\x12$.admin.v1.PurgeDeletedTenantsRequest\x1a%.admin.v1.PurgeDeletedTenantsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/tenants/purge\x12\x82\x01\n" +
+	"\x13GetTenantStatistics\x12$.admin.v1.GetTenantStatisticsRequest\x1a%.admin.v1.GetTenantStatisticsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/tenants/statistics\x12^\n" +
 	"\tGetTenant\x12\x1a.admin.v1.GetTenantRequest\x1a\x1b.admin.v1.GetTenantResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tenants/{id}\x12\x83\x01\n" +
-	"\x12GetTenantHierarchy\x12#.admin.v1.GetTenantHierarchyRequest\x1a$.admin.v1.GetTenantHierarchyResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/tenants/{id}/hierarchy\x12p\n" +
-	"\x0fListRootTenants\x12 .admin.v1.ListRootTenantsRequest\x1a!.admin.v1.ListRootTenantsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tenants/root\x12}\n" +
-	"\x0eListSubTenants\x12\x1f.admin.v1.ListSubTenantsRequest\x1a .admin.v1.ListSubTenantsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/tenants/{parent_id}/children\x12u\n" +
-	"\x10ListGroupTenants\x12!.admin.v1.ListGroupTenantsRequest\x1a\".admin.v1.ListGroupTenantsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/tenants/groups\x12\x82\x01\n" +
-	"\x13GetTenantStatistics\x12$.admin.v1.GetTenantStatisticsRequest\x1a%.admin.v1.GetTenantStatisticsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/tenants/statistics\x12j\n" +
+	"\x12GetTenantHierarchy\x12#.admin.v1.GetTenantHierarchyRequest\x1a$.admin.v1.GetTenantHierarchyResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/tenants/{id}/hierarchy\x12}\n" +
+	"\x0eListSubTenants\x12\x1f.admin.v1.ListSubTenantsRequest\x1a .admin.v1.ListSubTenantsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/tenants/{parent_id}/children\x12j\n" +
 	"\fUpdateTenant\x12\x1d.admin.v1.UpdateTenantRequest\x1a\x1e.admin.v1.UpdateTenantResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/tenants/{id}\x12g\n" +
-	"\fDeleteTenant\x12\x1d.admin.v1.DeleteTenantRequest\x1a\x1e.admin.v1.DeleteTenantResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/tenants/{id}B+Z)github.com/yc-alpha/admin/api/admin/v1;v1b\x06proto3"
+	"\fDeleteTenant\x12\x1d.admin.v1.DeleteTenantRequest\x1a\x1e.admin.v1.DeleteTenantResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/tenants/{id}\x12u\n" +
+	"\rRestoreTenant\x12\x1e.admin.v1.RestoreTenantRequest\x1a\x1f.admin.v1.RestoreTenantResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tenants/{id}/restore\x12y\n" +
+	"\x0eActivateTenant\x12\x1f.admin.v1.ActivateTenantRequest\x1a .admin.v1.ActivateTenantResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/tenants/{id}/activate\x12u\n" +
+	"\rDisableTenant\x12\x1e.admin.v1.DisableTenantRequest\x1a\x1f.admin.v1.DisableTenantResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tenants/{id}/disable\x12m\n" +
+	"\vRenewTenant\x12\x1c.admin.v1.RenewTenantRequest\x1a\x1d.admin.v1.RenewTenantResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/tenants/{id}/renew\x12q\n" +
+	"\fExpireTenant\x12\x1d.admin.v1.ExpireTenantRequest\x1a\x1e.admin.v1.ExpireTenantResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tenants/{id}/expire\x12i\n" +
+	"\n" +
+	"MoveTenant\x12\x1b.admin.v1.MoveTenantRequest\x1a\x1c.admin.v1.MoveTenantResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tenants/{id}/move\x12\x81\x01\n" +
+	"\x11ConvertTenantType\x12\".admin.v1.ConvertTenantTypeRequest\x1a#.admin.v1.ConvertTenantTypeResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tenants/{id}/convert\x12\x8b\x01\n" +
+	"\x14ListTenantStatusLogs\x12%.admin.v1.ListTenantStatusLogsRequest\x1a&.admin.v1.ListTenantStatusLogsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/tenants/{id}/status-logsB+Z)github.com/yc-alpha/admin/api/admin/v1;v1b\x06proto3"
 
 var (
 	file_admin_v1_tenant_proto_rawDescOnce sync.Once
@@ -1665,76 +3887,147 @@ func file_admin_v1_tenant_proto_rawDescGZIP() []byte {
 }
 
 var file_admin_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_admin_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_admin_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_admin_v1_tenant_proto_goTypes = []any{
-	(TenantType)(0),                     // 0: admin.v1.TenantType
-	(TenantStatus)(0),                   // 1: admin.v1.TenantStatus
-	(*Tenant)(nil),                      // 2: admin.v1.Tenant
-	(*CreateTenantRequest)(nil),         // 3: admin.v1.CreateTenantRequest
-	(*CreateTenantResponse)(nil),        // 4: admin.v1.CreateTenantResponse
-	(*GetTenantRequest)(nil),            // 5: admin.v1.GetTenantRequest
-	(*GetTenantResponse)(nil),           // 6: admin.v1.GetTenantResponse
-	(*GetTenantHierarchyRequest)(nil),   // 7: admin.v1.GetTenantHierarchyRequest
-	(*GetTenantHierarchyResponse)(nil),  // 8: admin.v1.GetTenantHierarchyResponse
-	(*ListRootTenantsRequest)(nil),      // 9: admin.v1.ListRootTenantsRequest
-	(*ListRootTenantsResponse)(nil),     // 10: admin.v1.ListRootTenantsResponse
-	(*ListSubTenantsRequest)(nil),       // 11: admin.v1.ListSubTenantsRequest
-	(*ListSubTenantsResponse)(nil),      // 12: admin.v1.ListSubTenantsResponse
-	(*ListGroupTenantsRequest)(nil),     // 13: admin.v1.ListGroupTenantsRequest
-	(*ListGroupTenantsResponse)(nil),    // 14: admin.v1.ListGroupTenantsResponse
-	(*GetTenantStatisticsRequest)(nil),  // 15: admin.v1.GetTenantStatisticsRequest
-	(*GetTenantStatisticsResponse)(nil), // 16: admin.v1.GetTenantStatisticsResponse
-	(*UpdateTenantRequest)(nil),         // 17: admin.v1.UpdateTenantRequest
-	(*UpdateTenantResponse)(nil),        // 18: admin.v1.UpdateTenantResponse
-	(*DeleteTenantRequest)(nil),         // 19: admin.v1.DeleteTenantRequest
-	(*DeleteTenantResponse)(nil),        // 20: admin.v1.DeleteTenantResponse
-	nil,                                 // 21: admin.v1.Tenant.AttributesEntry
-	nil,                                 // 22: admin.v1.CreateTenantRequest.AttributesEntry
-	nil,                                 // 23: admin.v1.GetTenantStatisticsResponse.StatisticsEntry
-	nil,                                 // 24: admin.v1.UpdateTenantRequest.AttributesEntry
+	(TenantType)(0),                      // 0: admin.v1.TenantType
+	(TenantStatus)(0),                    // 1: admin.v1.TenantStatus
+	(*Tenant)(nil),                       // 2: admin.v1.Tenant
+	(*CreateTenantRequest)(nil),          // 3: admin.v1.CreateTenantRequest
+	(*CreateTenantResponse)(nil),         // 4: admin.v1.CreateTenantResponse
+	(*GetTenantRequest)(nil),             // 5: admin.v1.GetTenantRequest
+	(*GetTenantResponse)(nil),            // 6: admin.v1.GetTenantResponse
+	(*GetTenantHierarchyRequest)(nil),    // 7: admin.v1.GetTenantHierarchyRequest
+	(*GetTenantHierarchyResponse)(nil),   // 8: admin.v1.GetTenantHierarchyResponse
+	(*ListRootTenantsRequest)(nil),       // 9: admin.v1.ListRootTenantsRequest
+	(*ListRootTenantsResponse)(nil),      // 10: admin.v1.ListRootTenantsResponse
+	(*ListSubTenantsRequest)(nil),        // 11: admin.v1.ListSubTenantsRequest
+	(*ListSubTenantsResponse)(nil),       // 12: admin.v1.ListSubTenantsResponse
+	(*ListGroupTenantsRequest)(nil),      // 13: admin.v1.ListGroupTenantsRequest
+	(*ListGroupTenantsResponse)(nil),     // 14: admin.v1.ListGroupTenantsResponse
+	(*GetTenantStatisticsRequest)(nil),   // 15: admin.v1.GetTenantStatisticsRequest
+	(*GetTenantStatisticsResponse)(nil),  // 16: admin.v1.GetTenantStatisticsResponse
+	(*TenantTypeStatusCount)(nil),        // 17: admin.v1.TenantTypeStatusCount
+	(*TenantGroupStatistics)(nil),        // 18: admin.v1.TenantGroupStatistics
+	(*TenantCountStatistics)(nil),        // 19: admin.v1.TenantCountStatistics
+	(*TenantGrowthPoint)(nil),            // 20: admin.v1.TenantGrowthPoint
+	(*UpdateTenantRequest)(nil),          // 21: admin.v1.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),         // 22: admin.v1.UpdateTenantResponse
+	(*DeleteTenantRequest)(nil),          // 23: admin.v1.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),         // 24: admin.v1.DeleteTenantResponse
+	(*ActivateTenantRequest)(nil),        // 25: admin.v1.ActivateTenantRequest
+	(*ActivateTenantResponse)(nil),       // 26: admin.v1.ActivateTenantResponse
+	(*DisableTenantRequest)(nil),         // 27: admin.v1.DisableTenantRequest
+	(*DisableTenantResponse)(nil),        // 28: admin.v1.DisableTenantResponse
+	(*RenewTenantRequest)(nil),           // 29: admin.v1.RenewTenantRequest
+	(*RenewTenantResponse)(nil),          // 30: admin.v1.RenewTenantResponse
+	(*ExpireTenantRequest)(nil),          // 31: admin.v1.ExpireTenantRequest
+	(*ExpireTenantResponse)(nil),         // 32: admin.v1.ExpireTenantResponse
+	(*TenantStatusLog)(nil),              // 33: admin.v1.TenantStatusLog
+	(*ListTenantStatusLogsRequest)(nil),  // 34: admin.v1.ListTenantStatusLogsRequest
+	(*ListTenantStatusLogsResponse)(nil), // 35: admin.v1.ListTenantStatusLogsResponse
+	(*MoveTenantRequest)(nil),            // 36: admin.v1.MoveTenantRequest
+	(*MoveTenantResponse)(nil),           // 37: admin.v1.MoveTenantResponse
+	(*ConvertTenantTypeRequest)(nil),     // 38: admin.v1.ConvertTenantTypeRequest
+	(*ConvertTenantTypeResponse)(nil),    // 39: admin.v1.ConvertTenantTypeResponse
+	(*RestoreTenantRequest)(nil),         // 40: admin.v1.RestoreTenantRequest
+	(*RestoreTenantResponse)(nil),        // 41: admin.v1.RestoreTenantResponse
+	(*ListDeletedTenantsRequest)(nil),    // 42: admin.v1.ListDeletedTenantsRequest
+	(*ListDeletedTenantsResponse)(nil),   // 43: admin.v1.ListDeletedTenantsResponse
+	(*PurgeDeletedTenantsRequest)(nil),   // 44: admin.v1.PurgeDeletedTenantsRequest
+	(*PurgeDeletedTenantsResponse)(nil),  // 45: admin.v1.PurgeDeletedTenantsResponse
+	(*TenantPurgeReport)(nil),            // 46: admin.v1.TenantPurgeReport
+	(*PurgedTenant)(nil),                 // 47: admin.v1.PurgedTenant
+	(*SkippedTenant)(nil),                // 48: admin.v1.SkippedTenant
+	nil,                                  // 49: admin.v1.Tenant.AttributesEntry
+	nil,                                  // 50: admin.v1.CreateTenantRequest.AttributesEntry
+	nil,                                  // 51: admin.v1.GetTenantStatisticsResponse.StatisticsEntry
+	nil,                                  // 52: admin.v1.UpdateTenantRequest.AttributesEntry
 }
 var file_admin_v1_tenant_proto_depIdxs = []int32{
 	0,  // 0: admin.v1.Tenant.type:type_name -> admin.v1.TenantType
 	1,  // 1: admin.v1.Tenant.status:type_name -> admin.v1.TenantStatus
-	21, // 2: admin.v1.Tenant.attributes:type_name -> admin.v1.Tenant.AttributesEntry
+	49, // 2: admin.v1.Tenant.attributes:type_name -> admin.v1.Tenant.AttributesEntry
 	2,  // 3: admin.v1.Tenant.children:type_name -> admin.v1.Tenant
 	2,  // 4: admin.v1.Tenant.parent:type_name -> admin.v1.Tenant
 	0,  // 5: admin.v1.CreateTenantRequest.type:type_name -> admin.v1.TenantType
 	1,  // 6: admin.v1.CreateTenantRequest.status:type_name -> admin.v1.TenantStatus
-	22, // 7: admin.v1.CreateTenantRequest.attributes:type_name -> admin.v1.CreateTenantRequest.AttributesEntry
+	50, // 7: admin.v1.CreateTenantRequest.attributes:type_name -> admin.v1.CreateTenantRequest.AttributesEntry
 	2,  // 8: admin.v1.CreateTenantResponse.tenant:type_name -> admin.v1.Tenant
 	2,  // 9: admin.v1.GetTenantResponse.tenant:type_name -> admin.v1.Tenant
 	2,  // 10: admin.v1.GetTenantHierarchyResponse.tenant:type_name -> admin.v1.Tenant
 	2,  // 11: admin.v1.ListRootTenantsResponse.tenants:type_name -> admin.v1.Tenant
 	2,  // 12: admin.v1.ListSubTenantsResponse.tenants:type_name -> admin.v1.Tenant
 	2,  // 13: admin.v1.ListGroupTenantsResponse.tenants:type_name -> admin.v1.Tenant
-	23, // 14: admin.v1.GetTenantStatisticsResponse.statistics:type_name -> admin.v1.GetTenantStatisticsResponse.StatisticsEntry
-	1,  // 15: admin.v1.UpdateTenantRequest.status:type_name -> admin.v1.TenantStatus
-	24, // 16: admin.v1.UpdateTenantRequest.attributes:type_name -> admin.v1.UpdateTenantRequest.AttributesEntry
-	2,  // 17: admin.v1.UpdateTenantResponse.tenant:type_name -> admin.v1.Tenant
-	3,  // 18: admin.v1.TenantService.CreateTenant:input_type -> admin.v1.CreateTenantRequest
-	5,  // 19: admin.v1.TenantService.GetTenant:input_type -> admin.v1.GetTenantRequest
-	7,  // 20: admin.v1.TenantService.GetTenantHierarchy:input_type -> admin.v1.GetTenantHierarchyRequest
-	9,  // 21: admin.v1.TenantService.ListRootTenants:input_type -> admin.v1.ListRootTenantsRequest
-	11, // 22: admin.v1.TenantService.ListSubTenants:input_type -> admin.v1.ListSubTenantsRequest
-	13, // 23: admin.v1.TenantService.ListGroupTenants:input_type -> admin.v1.ListGroupTenantsRequest
-	15, // 24: admin.v1.TenantService.GetTenantStatistics:input_type -> admin.v1.GetTenantStatisticsRequest
-	17, // 25: admin.v1.TenantService.UpdateTenant:input_type -> admin.v1.UpdateTenantRequest
-	19, // 26: admin.v1.TenantService.DeleteTenant:input_type -> admin.v1.DeleteTenantRequest
-	4,  // 27: admin.v1.TenantService.CreateTenant:output_type -> admin.v1.CreateTenantResponse
-	6,  // 28: admin.v1.TenantService.GetTenant:output_type -> admin.v1.GetTenantResponse
-	8,  // 29: admin.v1.TenantService.GetTenantHierarchy:output_type -> admin.v1.GetTenantHierarchyResponse
-	10, // 30: admin.v1.TenantService.ListRootTenants:output_type -> admin.v1.ListRootTenantsResponse
-	12, // 31: admin.v1.TenantService.ListSubTenants:output_type -> admin.v1.ListSubTenantsResponse
-	14, // 32: admin.v1.TenantService.ListGroupTenants:output_type -> admin.v1.ListGroupTenantsResponse
-	16, // 33: admin.v1.TenantService.GetTenantStatistics:output_type -> admin.v1.GetTenantStatisticsResponse
-	18, // 34: admin.v1.TenantService.UpdateTenant:output_type -> admin.v1.UpdateTenantResponse
-	20, // 35: admin.v1.TenantService.DeleteTenant:output_type -> admin.v1.DeleteTenantResponse
-	27, // [27:36] is the sub-list for method output_type
-	18, // [18:27] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	51, // 14: admin.v1.GetTenantStatisticsResponse.statistics:type_name -> admin.v1.GetTenantStatisticsResponse.StatisticsEntry
+	17, // 15: admin.v1.GetTenantStatisticsResponse.by_type_status:type_name -> admin.v1.TenantTypeStatusCount
+	18, // 16: admin.v1.GetTenantStatisticsResponse.groups:type_name -> admin.v1.TenantGroupStatistics
+	19, // 17: admin.v1.GetTenantStatisticsResponse.tenants:type_name -> admin.v1.TenantCountStatistics
+	20, // 18: admin.v1.GetTenantStatisticsResponse.growth:type_name -> admin.v1.TenantGrowthPoint
+	0,  // 19: admin.v1.TenantTypeStatusCount.type:type_name -> admin.v1.TenantType
+	1,  // 20: admin.v1.TenantTypeStatusCount.status:type_name -> admin.v1.TenantStatus
+	0,  // 21: admin.v1.TenantCountStatistics.type:type_name -> admin.v1.TenantType
+	1,  // 22: admin.v1.TenantCountStatistics.status:type_name -> admin.v1.TenantStatus
+	1,  // 23: admin.v1.UpdateTenantRequest.status:type_name -> admin.v1.TenantStatus
+	52, // 24: admin.v1.UpdateTenantRequest.attributes:type_name -> admin.v1.UpdateTenantRequest.AttributesEntry
+	2,  // 25: admin.v1.UpdateTenantResponse.tenant:type_name -> admin.v1.Tenant
+	2,  // 26: admin.v1.ActivateTenantResponse.tenant:type_name -> admin.v1.Tenant
+	2,  // 27: admin.v1.DisableTenantResponse.tenant:type_name -> admin.v1.Tenant
+	2,  // 28: admin.v1.RenewTenantResponse.tenant:type_name -> admin.v1.Tenant
+	2,  // 29: admin.v1.ExpireTenantResponse.tenant:type_name -> admin.v1.Tenant
+	1,  // 30: admin.v1.TenantStatusLog.from_status:type_name -> admin.v1.TenantStatus
+	1,  // 31: admin.v1.TenantStatusLog.to_status:type_name -> admin.v1.TenantStatus
+	33, // 32: admin.v1.ListTenantStatusLogsResponse.logs:type_name -> admin.v1.TenantStatusLog
+	2,  // 33: admin.v1.MoveTenantResponse.tenant:type_name -> admin.v1.Tenant
+	0,  // 34: admin.v1.ConvertTenantTypeRequest.type:type_name -> admin.v1.TenantType
+	2,  // 35: admin.v1.ConvertTenantTypeResponse.tenant:type_name -> admin.v1.Tenant
+	2,  // 36: admin.v1.RestoreTenantResponse.tenant:type_name -> admin.v1.Tenant
+	2,  // 37: admin.v1.ListDeletedTenantsResponse.tenants:type_name -> admin.v1.Tenant
+	46, // 38: admin.v1.PurgeDeletedTenantsResponse.report:type_name -> admin.v1.TenantPurgeReport
+	47, // 39: admin.v1.TenantPurgeReport.purged:type_name -> admin.v1.PurgedTenant
+	48, // 40: admin.v1.TenantPurgeReport.skipped:type_name -> admin.v1.SkippedTenant
+	3,  // 41: admin.v1.TenantService.CreateTenant:input_type -> admin.v1.CreateTenantRequest
+	9,  // 42: admin.v1.TenantService.ListRootTenants:input_type -> admin.v1.ListRootTenantsRequest
+	13, // 43: admin.v1.TenantService.ListGroupTenants:input_type -> admin.v1.ListGroupTenantsRequest
+	42, // 44: admin.v1.TenantService.ListDeletedTenants:input_type -> admin.v1.ListDeletedTenantsRequest
+	44, // 45: admin.v1.TenantService.PurgeDeletedTenants:input_type -> admin.v1.PurgeDeletedTenantsRequest
+	15, // 46: admin.v1.TenantService.GetTenantStatistics:input_type -> admin.v1.GetTenantStatisticsRequest
+	5,  // 47: admin.v1.TenantService.GetTenant:input_type -> admin.v1.GetTenantRequest
+	7,  // 48: admin.v1.TenantService.GetTenantHierarchy:input_type -> admin.v1.GetTenantHierarchyRequest
+	11, // 49: admin.v1.TenantService.ListSubTenants:input_type -> admin.v1.ListSubTenantsRequest
+	21, // 50: admin.v1.TenantService.UpdateTenant:input_type -> admin.v1.UpdateTenantRequest
+	23, // 51: admin.v1.TenantService.DeleteTenant:input_type -> admin.v1.DeleteTenantRequest
+	40, // 52: admin.v1.TenantService.RestoreTenant:input_type -> admin.v1.RestoreTenantRequest
+	25, // 53: admin.v1.TenantService.ActivateTenant:input_type -> admin.v1.ActivateTenantRequest
+	27, // 54: admin.v1.TenantService.DisableTenant:input_type -> admin.v1.DisableTenantRequest
+	29, // 55: admin.v1.TenantService.RenewTenant:input_type -> admin.v1.RenewTenantRequest
+	31, // 56: admin.v1.TenantService.ExpireTenant:input_type -> admin.v1.ExpireTenantRequest
+	36, // 57: admin.v1.TenantService.MoveTenant:input_type -> admin.v1.MoveTenantRequest
+	38, // 58: admin.v1.TenantService.ConvertTenantType:input_type -> admin.v1.ConvertTenantTypeRequest
+	34, // 59: admin.v1.TenantService.ListTenantStatusLogs:input_type -> admin.v1.ListTenantStatusLogsRequest
+	4,  // 60: admin.v1.TenantService.CreateTenant:output_type -> admin.v1.CreateTenantResponse
+	10, // 61: admin.v1.TenantService.ListRootTenants:output_type -> admin.v1.ListRootTenantsResponse
+	14, // 62: admin.v1.TenantService.ListGroupTenants:output_type -> admin.v1.ListGroupTenantsResponse
+	43, // 63: admin.v1.TenantService.ListDeletedTenants:output_type -> admin.v1.ListDeletedTenantsResponse
+	45, // 64: admin.v1.TenantService.PurgeDeletedTenants:output_type -> admin.v1.PurgeDeletedTenantsResponse
+	16, // 65: admin.v1.TenantService.GetTenantStatistics:output_type -> admin.v1.GetTenantStatisticsResponse
+	6,  // 66: admin.v1.TenantService.GetTenant:output_type -> admin.v1.GetTenantResponse
+	8,  // 67: admin.v1.TenantService.GetTenantHierarchy:output_type -> admin.v1.GetTenantHierarchyResponse
+	12, // 68: admin.v1.TenantService.ListSubTenants:output_type -> admin.v1.ListSubTenantsResponse
+	22, // 69: admin.v1.TenantService.UpdateTenant:output_type -> admin.v1.UpdateTenantResponse
+	24, // 70: admin.v1.TenantService.DeleteTenant:output_type -> admin.v1.DeleteTenantResponse
+	41, // 71: admin.v1.TenantService.RestoreTenant:output_type -> admin.v1.RestoreTenantResponse
+	26, // 72: admin.v1.TenantService.ActivateTenant:output_type -> admin.v1.ActivateTenantResponse
+	28, // 73: admin.v1.TenantService.DisableTenant:output_type -> admin.v1.DisableTenantResponse
+	30, // 74: admin.v1.TenantService.RenewTenant:output_type -> admin.v1.RenewTenantResponse
+	32, // 75: admin.v1.TenantService.ExpireTenant:output_type -> admin.v1.ExpireTenantResponse
+	37, // 76: admin.v1.TenantService.MoveTenant:output_type -> admin.v1.MoveTenantResponse
+	39, // 77: admin.v1.TenantService.ConvertTenantType:output_type -> admin.v1.ConvertTenantTypeResponse
+	35, // 78: admin.v1.TenantService.ListTenantStatusLogs:output_type -> admin.v1.ListTenantStatusLogsResponse
+	60, // [60:79] is the sub-list for method output_type
+	41, // [41:60] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_tenant_proto_init() }
@@ -1748,7 +4041,7 @@ func file_admin_v1_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_proto_rawDesc), len(file_admin_v1_tenant_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   23,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
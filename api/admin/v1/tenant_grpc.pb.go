@@ -19,15 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TenantService_CreateTenant_FullMethodName        = "/admin.v1.TenantService/CreateTenant"
-	TenantService_GetTenant_FullMethodName           = "/admin.v1.TenantService/GetTenant"
-	TenantService_GetTenantHierarchy_FullMethodName  = "/admin.v1.TenantService/GetTenantHierarchy"
-	TenantService_ListRootTenants_FullMethodName     = "/admin.v1.TenantService/ListRootTenants"
-	TenantService_ListSubTenants_FullMethodName      = "/admin.v1.TenantService/ListSubTenants"
-	TenantService_ListGroupTenants_FullMethodName    = "/admin.v1.TenantService/ListGroupTenants"
-	TenantService_GetTenantStatistics_FullMethodName = "/admin.v1.TenantService/GetTenantStatistics"
-	TenantService_UpdateTenant_FullMethodName        = "/admin.v1.TenantService/UpdateTenant"
-	TenantService_DeleteTenant_FullMethodName        = "/admin.v1.TenantService/DeleteTenant"
+	TenantService_CreateTenant_FullMethodName         = "/admin.v1.TenantService/CreateTenant"
+	TenantService_ListRootTenants_FullMethodName      = "/admin.v1.TenantService/ListRootTenants"
+	TenantService_ListGroupTenants_FullMethodName     = "/admin.v1.TenantService/ListGroupTenants"
+	TenantService_ListDeletedTenants_FullMethodName   = "/admin.v1.TenantService/ListDeletedTenants"
+	TenantService_PurgeDeletedTenants_FullMethodName  = "/admin.v1.TenantService/PurgeDeletedTenants"
+	TenantService_GetTenantStatistics_FullMethodName  = "/admin.v1.TenantService/GetTenantStatistics"
+	TenantService_GetTenant_FullMethodName            = "/admin.v1.TenantService/GetTenant"
+	TenantService_GetTenantHierarchy_FullMethodName   = "/admin.v1.TenantService/GetTenantHierarchy"
+	TenantService_ListSubTenants_FullMethodName       = "/admin.v1.TenantService/ListSubTenants"
+	TenantService_UpdateTenant_FullMethodName         = "/admin.v1.TenantService/UpdateTenant"
+	TenantService_DeleteTenant_FullMethodName         = "/admin.v1.TenantService/DeleteTenant"
+	TenantService_RestoreTenant_FullMethodName        = "/admin.v1.TenantService/RestoreTenant"
+	TenantService_ActivateTenant_FullMethodName       = "/admin.v1.TenantService/ActivateTenant"
+	TenantService_DisableTenant_FullMethodName        = "/admin.v1.TenantService/DisableTenant"
+	TenantService_RenewTenant_FullMethodName          = "/admin.v1.TenantService/RenewTenant"
+	TenantService_ExpireTenant_FullMethodName         = "/admin.v1.TenantService/ExpireTenant"
+	TenantService_MoveTenant_FullMethodName           = "/admin.v1.TenantService/MoveTenant"
+	TenantService_ConvertTenantType_FullMethodName    = "/admin.v1.TenantService/ConvertTenantType"
+	TenantService_ListTenantStatusLogs_FullMethodName = "/admin.v1.TenantService/ListTenantStatusLogs"
 )
 
 // TenantServiceClient is the client API for TenantService service.
@@ -38,22 +48,43 @@ const (
 type TenantServiceClient interface {
 	// 创建租户
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
-	// 获取租户详情
-	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
-	// 获取租户层级结构
-	GetTenantHierarchy(ctx context.Context, in *GetTenantHierarchyRequest, opts ...grpc.CallOption) (*GetTenantHierarchyResponse, error)
 	// 获取根租户列表
+	// 静态路由需声明在/v1/tenants/{id}之前，否则HTTP路由会先匹配到GetTenant
 	ListRootTenants(ctx context.Context, in *ListRootTenantsRequest, opts ...grpc.CallOption) (*ListRootTenantsResponse, error)
-	// 获取子租户列表
-	ListSubTenants(ctx context.Context, in *ListSubTenantsRequest, opts ...grpc.CallOption) (*ListSubTenantsResponse, error)
 	// 获取集团型租户列表
 	ListGroupTenants(ctx context.Context, in *ListGroupTenantsRequest, opts ...grpc.CallOption) (*ListGroupTenantsResponse, error)
+	// 获取已删除（回收站中）的租户列表
+	ListDeletedTenants(ctx context.Context, in *ListDeletedTenantsRequest, opts ...grpc.CallOption) (*ListDeletedTenantsResponse, error)
+	// 立即清理超过保留期的已删除租户，dry_run时只生成报告
+	PurgeDeletedTenants(ctx context.Context, in *PurgeDeletedTenantsRequest, opts ...grpc.CallOption) (*PurgeDeletedTenantsResponse, error)
 	// 获取租户统计信息
 	GetTenantStatistics(ctx context.Context, in *GetTenantStatisticsRequest, opts ...grpc.CallOption) (*GetTenantStatisticsResponse, error)
+	// 获取租户详情
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	// 获取租户层级结构
+	GetTenantHierarchy(ctx context.Context, in *GetTenantHierarchyRequest, opts ...grpc.CallOption) (*GetTenantHierarchyResponse, error)
+	// 获取子租户列表
+	ListSubTenants(ctx context.Context, in *ListSubTenantsRequest, opts ...grpc.CallOption) (*ListSubTenantsResponse, error)
 	// 更新租户
 	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error)
 	// 删除租户
 	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	// 恢复已删除的租户，需在保留期内且上级租户未被删除
+	RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error)
+	// 激活租户：PENDING/DISABLED -> ACTIVE，已到期的租户需先续期
+	ActivateTenant(ctx context.Context, in *ActivateTenantRequest, opts ...grpc.CallOption) (*ActivateTenantResponse, error)
+	// 停用租户：PENDING/ACTIVE/EXPIRED -> DISABLED，其下子租户同时不可用
+	DisableTenant(ctx context.Context, in *DisableTenantRequest, opts ...grpc.CallOption) (*DisableTenantResponse, error)
+	// 续期租户：更新到期时间，EXPIRED -> ACTIVE
+	RenewTenant(ctx context.Context, in *RenewTenantRequest, opts ...grpc.CallOption) (*RenewTenantResponse, error)
+	// 使租户立即过期：PENDING/ACTIVE -> EXPIRED
+	ExpireTenant(ctx context.Context, in *ExpireTenantRequest, opts ...grpc.CallOption) (*ExpireTenantResponse, error)
+	// 将租户（连同其子租户）移动到新的父租户下，类型不变
+	MoveTenant(ctx context.Context, in *MoveTenantRequest, opts ...grpc.CallOption) (*MoveTenantResponse, error)
+	// 转换租户类型，如NORMAL升级为GROUP、SUB提升为独立租户，必要时同时变更父租户
+	ConvertTenantType(ctx context.Context, in *ConvertTenantTypeRequest, opts ...grpc.CallOption) (*ConvertTenantTypeResponse, error)
+	// 获取租户状态变更记录
+	ListTenantStatusLogs(ctx context.Context, in *ListTenantStatusLogsRequest, opts ...grpc.CallOption) (*ListTenantStatusLogsResponse, error)
 }
 
 type tenantServiceClient struct {
@@ -74,60 +105,80 @@ func (c *tenantServiceClient) CreateTenant(ctx context.Context, in *CreateTenant
 	return out, nil
 }
 
-func (c *tenantServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error) {
+func (c *tenantServiceClient) ListRootTenants(ctx context.Context, in *ListRootTenantsRequest, opts ...grpc.CallOption) (*ListRootTenantsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTenantResponse)
-	err := c.cc.Invoke(ctx, TenantService_GetTenant_FullMethodName, in, out, cOpts...)
+	out := new(ListRootTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListRootTenants_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tenantServiceClient) GetTenantHierarchy(ctx context.Context, in *GetTenantHierarchyRequest, opts ...grpc.CallOption) (*GetTenantHierarchyResponse, error) {
+func (c *tenantServiceClient) ListGroupTenants(ctx context.Context, in *ListGroupTenantsRequest, opts ...grpc.CallOption) (*ListGroupTenantsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTenantHierarchyResponse)
-	err := c.cc.Invoke(ctx, TenantService_GetTenantHierarchy_FullMethodName, in, out, cOpts...)
+	out := new(ListGroupTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListGroupTenants_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tenantServiceClient) ListRootTenants(ctx context.Context, in *ListRootTenantsRequest, opts ...grpc.CallOption) (*ListRootTenantsResponse, error) {
+func (c *tenantServiceClient) ListDeletedTenants(ctx context.Context, in *ListDeletedTenantsRequest, opts ...grpc.CallOption) (*ListDeletedTenantsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListRootTenantsResponse)
-	err := c.cc.Invoke(ctx, TenantService_ListRootTenants_FullMethodName, in, out, cOpts...)
+	out := new(ListDeletedTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListDeletedTenants_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tenantServiceClient) ListSubTenants(ctx context.Context, in *ListSubTenantsRequest, opts ...grpc.CallOption) (*ListSubTenantsResponse, error) {
+func (c *tenantServiceClient) PurgeDeletedTenants(ctx context.Context, in *PurgeDeletedTenantsRequest, opts ...grpc.CallOption) (*PurgeDeletedTenantsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListSubTenantsResponse)
-	err := c.cc.Invoke(ctx, TenantService_ListSubTenants_FullMethodName, in, out, cOpts...)
+	out := new(PurgeDeletedTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantService_PurgeDeletedTenants_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tenantServiceClient) ListGroupTenants(ctx context.Context, in *ListGroupTenantsRequest, opts ...grpc.CallOption) (*ListGroupTenantsResponse, error) {
+func (c *tenantServiceClient) GetTenantStatistics(ctx context.Context, in *GetTenantStatisticsRequest, opts ...grpc.CallOption) (*GetTenantStatisticsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListGroupTenantsResponse)
-	err := c.cc.Invoke(ctx, TenantService_ListGroupTenants_FullMethodName, in, out, cOpts...)
+	out := new(GetTenantStatisticsResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantStatistics_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tenantServiceClient) GetTenantStatistics(ctx context.Context, in *GetTenantStatisticsRequest, opts ...grpc.CallOption) (*GetTenantStatisticsResponse, error) {
+func (c *tenantServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTenantStatisticsResponse)
-	err := c.cc.Invoke(ctx, TenantService_GetTenantStatistics_FullMethodName, in, out, cOpts...)
+	out := new(GetTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenantHierarchy(ctx context.Context, in *GetTenantHierarchyRequest, opts ...grpc.CallOption) (*GetTenantHierarchyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantHierarchyResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantHierarchy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ListSubTenants(ctx context.Context, in *ListSubTenantsRequest, opts ...grpc.CallOption) (*ListSubTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListSubTenants_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +205,86 @@ func (c *tenantServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenant
 	return out, nil
 }
 
+func (c *tenantServiceClient) RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_RestoreTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ActivateTenant(ctx context.Context, in *ActivateTenantRequest, opts ...grpc.CallOption) (*ActivateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ActivateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) DisableTenant(ctx context.Context, in *DisableTenantRequest, opts ...grpc.CallOption) (*DisableTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_DisableTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) RenewTenant(ctx context.Context, in *RenewTenantRequest, opts ...grpc.CallOption) (*RenewTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_RenewTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ExpireTenant(ctx context.Context, in *ExpireTenantRequest, opts ...grpc.CallOption) (*ExpireTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ExpireTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) MoveTenant(ctx context.Context, in *MoveTenantRequest, opts ...grpc.CallOption) (*MoveTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_MoveTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ConvertTenantType(ctx context.Context, in *ConvertTenantTypeRequest, opts ...grpc.CallOption) (*ConvertTenantTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertTenantTypeResponse)
+	err := c.cc.Invoke(ctx, TenantService_ConvertTenantType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ListTenantStatusLogs(ctx context.Context, in *ListTenantStatusLogsRequest, opts ...grpc.CallOption) (*ListTenantStatusLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantStatusLogsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListTenantStatusLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TenantServiceServer is the server API for TenantService service.
 // All implementations must embed UnimplementedTenantServiceServer
 // for forward compatibility.
@@ -162,22 +293,43 @@ func (c *tenantServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenant
 type TenantServiceServer interface {
 	// 创建租户
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
-	// 获取租户详情
-	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
-	// 获取租户层级结构
-	GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*GetTenantHierarchyResponse, error)
 	// 获取根租户列表
+	// 静态路由需声明在/v1/tenants/{id}之前，否则HTTP路由会先匹配到GetTenant
 	ListRootTenants(context.Context, *ListRootTenantsRequest) (*ListRootTenantsResponse, error)
-	// 获取子租户列表
-	ListSubTenants(context.Context, *ListSubTenantsRequest) (*ListSubTenantsResponse, error)
 	// 获取集团型租户列表
 	ListGroupTenants(context.Context, *ListGroupTenantsRequest) (*ListGroupTenantsResponse, error)
+	// 获取已删除（回收站中）的租户列表
+	ListDeletedTenants(context.Context, *ListDeletedTenantsRequest) (*ListDeletedTenantsResponse, error)
+	// 立即清理超过保留期的已删除租户，dry_run时只生成报告
+	PurgeDeletedTenants(context.Context, *PurgeDeletedTenantsRequest) (*PurgeDeletedTenantsResponse, error)
 	// 获取租户统计信息
 	GetTenantStatistics(context.Context, *GetTenantStatisticsRequest) (*GetTenantStatisticsResponse, error)
+	// 获取租户详情
+	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	// 获取租户层级结构
+	GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*GetTenantHierarchyResponse, error)
+	// 获取子租户列表
+	ListSubTenants(context.Context, *ListSubTenantsRequest) (*ListSubTenantsResponse, error)
 	// 更新租户
 	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
 	// 删除租户
 	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	// 恢复已删除的租户，需在保留期内且上级租户未被删除
+	RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error)
+	// 激活租户：PENDING/DISABLED -> ACTIVE，已到期的租户需先续期
+	ActivateTenant(context.Context, *ActivateTenantRequest) (*ActivateTenantResponse, error)
+	// 停用租户：PENDING/ACTIVE/EXPIRED -> DISABLED，其下子租户同时不可用
+	DisableTenant(context.Context, *DisableTenantRequest) (*DisableTenantResponse, error)
+	// 续期租户：更新到期时间，EXPIRED -> ACTIVE
+	RenewTenant(context.Context, *RenewTenantRequest) (*RenewTenantResponse, error)
+	// 使租户立即过期：PENDING/ACTIVE -> EXPIRED
+	ExpireTenant(context.Context, *ExpireTenantRequest) (*ExpireTenantResponse, error)
+	// 将租户（连同其子租户）移动到新的父租户下，类型不变
+	MoveTenant(context.Context, *MoveTenantRequest) (*MoveTenantResponse, error)
+	// 转换租户类型，如NORMAL升级为GROUP、SUB提升为独立租户，必要时同时变更父租户
+	ConvertTenantType(context.Context, *ConvertTenantTypeRequest) (*ConvertTenantTypeResponse, error)
+	// 获取租户状态变更记录
+	ListTenantStatusLogs(context.Context, *ListTenantStatusLogsRequest) (*ListTenantStatusLogsResponse, error)
 	mustEmbedUnimplementedTenantServiceServer()
 }
 
@@ -191,30 +343,60 @@ type UnimplementedTenantServiceServer struct{}
 func (UnimplementedTenantServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
 }
-func (UnimplementedTenantServiceServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
-}
-func (UnimplementedTenantServiceServer) GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*GetTenantHierarchyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenantHierarchy not implemented")
-}
 func (UnimplementedTenantServiceServer) ListRootTenants(context.Context, *ListRootTenantsRequest) (*ListRootTenantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRootTenants not implemented")
 }
-func (UnimplementedTenantServiceServer) ListSubTenants(context.Context, *ListSubTenantsRequest) (*ListSubTenantsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSubTenants not implemented")
-}
 func (UnimplementedTenantServiceServer) ListGroupTenants(context.Context, *ListGroupTenantsRequest) (*ListGroupTenantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGroupTenants not implemented")
 }
+func (UnimplementedTenantServiceServer) ListDeletedTenants(context.Context, *ListDeletedTenantsRequest) (*ListDeletedTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedTenants not implemented")
+}
+func (UnimplementedTenantServiceServer) PurgeDeletedTenants(context.Context, *PurgeDeletedTenantsRequest) (*PurgeDeletedTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletedTenants not implemented")
+}
 func (UnimplementedTenantServiceServer) GetTenantStatistics(context.Context, *GetTenantStatisticsRequest) (*GetTenantStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTenantStatistics not implemented")
 }
+func (UnimplementedTenantServiceServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*GetTenantHierarchyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantHierarchy not implemented")
+}
+func (UnimplementedTenantServiceServer) ListSubTenants(context.Context, *ListSubTenantsRequest) (*ListSubTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubTenants not implemented")
+}
 func (UnimplementedTenantServiceServer) UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
 }
 func (UnimplementedTenantServiceServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
 }
+func (UnimplementedTenantServiceServer) RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) ActivateTenant(context.Context, *ActivateTenantRequest) (*ActivateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) DisableTenant(context.Context, *DisableTenantRequest) (*DisableTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) RenewTenant(context.Context, *RenewTenantRequest) (*RenewTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) ExpireTenant(context.Context, *ExpireTenantRequest) (*ExpireTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) MoveTenant(context.Context, *MoveTenantRequest) (*MoveTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) ConvertTenantType(context.Context, *ConvertTenantTypeRequest) (*ConvertTenantTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertTenantType not implemented")
+}
+func (UnimplementedTenantServiceServer) ListTenantStatusLogs(context.Context, *ListTenantStatusLogsRequest) (*ListTenantStatusLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenantStatusLogs not implemented")
+}
 func (UnimplementedTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {}
 func (UnimplementedTenantServiceServer) testEmbeddedByValue()                       {}
 
@@ -254,110 +436,146 @@ func _TenantService_CreateTenant_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TenantService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantRequest)
+func _TenantService_ListRootTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRootTenantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServiceServer).GetTenant(ctx, in)
+		return srv.(TenantServiceServer).ListRootTenants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TenantService_GetTenant_FullMethodName,
+		FullMethod: TenantService_ListRootTenants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
+		return srv.(TenantServiceServer).ListRootTenants(ctx, req.(*ListRootTenantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TenantService_GetTenantHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantHierarchyRequest)
+func _TenantService_ListGroupTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupTenantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServiceServer).GetTenantHierarchy(ctx, in)
+		return srv.(TenantServiceServer).ListGroupTenants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TenantService_GetTenantHierarchy_FullMethodName,
+		FullMethod: TenantService_ListGroupTenants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServiceServer).GetTenantHierarchy(ctx, req.(*GetTenantHierarchyRequest))
+		return srv.(TenantServiceServer).ListGroupTenants(ctx, req.(*ListGroupTenantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TenantService_ListRootTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRootTenantsRequest)
+func _TenantService_ListDeletedTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedTenantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServiceServer).ListRootTenants(ctx, in)
+		return srv.(TenantServiceServer).ListDeletedTenants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TenantService_ListRootTenants_FullMethodName,
+		FullMethod: TenantService_ListDeletedTenants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServiceServer).ListRootTenants(ctx, req.(*ListRootTenantsRequest))
+		return srv.(TenantServiceServer).ListDeletedTenants(ctx, req.(*ListDeletedTenantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TenantService_ListSubTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSubTenantsRequest)
+func _TenantService_PurgeDeletedTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedTenantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServiceServer).ListSubTenants(ctx, in)
+		return srv.(TenantServiceServer).PurgeDeletedTenants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TenantService_ListSubTenants_FullMethodName,
+		FullMethod: TenantService_PurgeDeletedTenants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServiceServer).ListSubTenants(ctx, req.(*ListSubTenantsRequest))
+		return srv.(TenantServiceServer).PurgeDeletedTenants(ctx, req.(*PurgeDeletedTenantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TenantService_ListGroupTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupTenantsRequest)
+func _TenantService_GetTenantStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServiceServer).ListGroupTenants(ctx, in)
+		return srv.(TenantServiceServer).GetTenantStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TenantService_ListGroupTenants_FullMethodName,
+		FullMethod: TenantService_GetTenantStatistics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServiceServer).ListGroupTenants(ctx, req.(*ListGroupTenantsRequest))
+		return srv.(TenantServiceServer).GetTenantStatistics(ctx, req.(*GetTenantStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TenantService_GetTenantStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantStatisticsRequest)
+func _TenantService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TenantServiceServer).GetTenantStatistics(ctx, in)
+		return srv.(TenantServiceServer).GetTenant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TenantService_GetTenantStatistics_FullMethodName,
+		FullMethod: TenantService_GetTenant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TenantServiceServer).GetTenantStatistics(ctx, req.(*GetTenantStatisticsRequest))
+		return srv.(TenantServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenantHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantHierarchy(ctx, req.(*GetTenantHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ListSubTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ListSubTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ListSubTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ListSubTenants(ctx, req.(*ListSubTenantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -398,6 +616,150 @@ func _TenantService_DeleteTenant_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_RestoreTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).RestoreTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_RestoreTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).RestoreTenant(ctx, req.(*RestoreTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ActivateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ActivateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ActivateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ActivateTenant(ctx, req.(*ActivateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_DisableTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).DisableTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_DisableTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).DisableTenant(ctx, req.(*DisableTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_RenewTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).RenewTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_RenewTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).RenewTenant(ctx, req.(*RenewTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ExpireTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ExpireTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ExpireTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ExpireTenant(ctx, req.(*ExpireTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_MoveTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).MoveTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_MoveTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).MoveTenant(ctx, req.(*MoveTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ConvertTenantType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertTenantTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ConvertTenantType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ConvertTenantType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ConvertTenantType(ctx, req.(*ConvertTenantTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ListTenantStatusLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantStatusLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ListTenantStatusLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ListTenantStatusLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ListTenantStatusLogs(ctx, req.(*ListTenantStatusLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TenantService_ServiceDesc is the grpc.ServiceDesc for TenantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -409,30 +771,38 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTenant",
 			Handler:    _TenantService_CreateTenant_Handler,
 		},
-		{
-			MethodName: "GetTenant",
-			Handler:    _TenantService_GetTenant_Handler,
-		},
-		{
-			MethodName: "GetTenantHierarchy",
-			Handler:    _TenantService_GetTenantHierarchy_Handler,
-		},
 		{
 			MethodName: "ListRootTenants",
 			Handler:    _TenantService_ListRootTenants_Handler,
 		},
-		{
-			MethodName: "ListSubTenants",
-			Handler:    _TenantService_ListSubTenants_Handler,
-		},
 		{
 			MethodName: "ListGroupTenants",
 			Handler:    _TenantService_ListGroupTenants_Handler,
 		},
+		{
+			MethodName: "ListDeletedTenants",
+			Handler:    _TenantService_ListDeletedTenants_Handler,
+		},
+		{
+			MethodName: "PurgeDeletedTenants",
+			Handler:    _TenantService_PurgeDeletedTenants_Handler,
+		},
 		{
 			MethodName: "GetTenantStatistics",
 			Handler:    _TenantService_GetTenantStatistics_Handler,
 		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _TenantService_GetTenant_Handler,
+		},
+		{
+			MethodName: "GetTenantHierarchy",
+			Handler:    _TenantService_GetTenantHierarchy_Handler,
+		},
+		{
+			MethodName: "ListSubTenants",
+			Handler:    _TenantService_ListSubTenants_Handler,
+		},
 		{
 			MethodName: "UpdateTenant",
 			Handler:    _TenantService_UpdateTenant_Handler,
@@ -441,6 +811,38 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTenant",
 			Handler:    _TenantService_DeleteTenant_Handler,
 		},
+		{
+			MethodName: "RestoreTenant",
+			Handler:    _TenantService_RestoreTenant_Handler,
+		},
+		{
+			MethodName: "ActivateTenant",
+			Handler:    _TenantService_ActivateTenant_Handler,
+		},
+		{
+			MethodName: "DisableTenant",
+			Handler:    _TenantService_DisableTenant_Handler,
+		},
+		{
+			MethodName: "RenewTenant",
+			Handler:    _TenantService_RenewTenant_Handler,
+		},
+		{
+			MethodName: "ExpireTenant",
+			Handler:    _TenantService_ExpireTenant_Handler,
+		},
+		{
+			MethodName: "MoveTenant",
+			Handler:    _TenantService_MoveTenant_Handler,
+		},
+		{
+			MethodName: "ConvertTenantType",
+			Handler:    _TenantService_ConvertTenantType_Handler,
+		},
+		{
+			MethodName: "ListTenantStatusLogs",
+			Handler:    _TenantService_ListTenantStatusLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/tenant.proto",
@@ -19,33 +19,64 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationTenantServiceActivateTenant = "/admin.v1.TenantService/ActivateTenant"
+const OperationTenantServiceConvertTenantType = "/admin.v1.TenantService/ConvertTenantType"
 const OperationTenantServiceCreateTenant = "/admin.v1.TenantService/CreateTenant"
 const OperationTenantServiceDeleteTenant = "/admin.v1.TenantService/DeleteTenant"
+const OperationTenantServiceDisableTenant = "/admin.v1.TenantService/DisableTenant"
+const OperationTenantServiceExpireTenant = "/admin.v1.TenantService/ExpireTenant"
 const OperationTenantServiceGetTenant = "/admin.v1.TenantService/GetTenant"
 const OperationTenantServiceGetTenantHierarchy = "/admin.v1.TenantService/GetTenantHierarchy"
 const OperationTenantServiceGetTenantStatistics = "/admin.v1.TenantService/GetTenantStatistics"
+const OperationTenantServiceListDeletedTenants = "/admin.v1.TenantService/ListDeletedTenants"
 const OperationTenantServiceListGroupTenants = "/admin.v1.TenantService/ListGroupTenants"
 const OperationTenantServiceListRootTenants = "/admin.v1.TenantService/ListRootTenants"
 const OperationTenantServiceListSubTenants = "/admin.v1.TenantService/ListSubTenants"
+const OperationTenantServiceListTenantStatusLogs = "/admin.v1.TenantService/ListTenantStatusLogs"
+const OperationTenantServiceMoveTenant = "/admin.v1.TenantService/MoveTenant"
+const OperationTenantServicePurgeDeletedTenants = "/admin.v1.TenantService/PurgeDeletedTenants"
+const OperationTenantServiceRenewTenant = "/admin.v1.TenantService/RenewTenant"
+const OperationTenantServiceRestoreTenant = "/admin.v1.TenantService/RestoreTenant"
 const OperationTenantServiceUpdateTenant = "/admin.v1.TenantService/UpdateTenant"
 
 type TenantServiceHTTPServer interface {
+	// ActivateTenant 激活租户：PENDING/DISABLED -> ACTIVE，已到期的租户需先续期
+	ActivateTenant(context.Context, *ActivateTenantRequest) (*ActivateTenantResponse, error)
+	// ConvertTenantType 转换租户类型，如NORMAL升级为GROUP、SUB提升为独立租户，必要时同时变更父租户
+	ConvertTenantType(context.Context, *ConvertTenantTypeRequest) (*ConvertTenantTypeResponse, error)
 	// CreateTenant 创建租户
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
 	// DeleteTenant 删除租户
 	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	// DisableTenant 停用租户：PENDING/ACTIVE/EXPIRED -> DISABLED，其下子租户同时不可用
+	DisableTenant(context.Context, *DisableTenantRequest) (*DisableTenantResponse, error)
+	// ExpireTenant 使租户立即过期：PENDING/ACTIVE -> EXPIRED
+	ExpireTenant(context.Context, *ExpireTenantRequest) (*ExpireTenantResponse, error)
 	// GetTenant 获取租户详情
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
 	// GetTenantHierarchy 获取租户层级结构
 	GetTenantHierarchy(context.Context, *GetTenantHierarchyRequest) (*GetTenantHierarchyResponse, error)
 	// GetTenantStatistics 获取租户统计信息
 	GetTenantStatistics(context.Context, *GetTenantStatisticsRequest) (*GetTenantStatisticsResponse, error)
+	// ListDeletedTenants 获取已删除（回收站中）的租户列表
+	ListDeletedTenants(context.Context, *ListDeletedTenantsRequest) (*ListDeletedTenantsResponse, error)
 	// ListGroupTenants 获取集团型租户列表
 	ListGroupTenants(context.Context, *ListGroupTenantsRequest) (*ListGroupTenantsResponse, error)
 	// ListRootTenants 获取根租户列表
+	// 静态路由需声明在/v1/tenants/{id}之前，否则HTTP路由会先匹配到GetTenant
 	ListRootTenants(context.Context, *ListRootTenantsRequest) (*ListRootTenantsResponse, error)
 	// ListSubTenants 获取子租户列表
 	ListSubTenants(context.Context, *ListSubTenantsRequest) (*ListSubTenantsResponse, error)
+	// ListTenantStatusLogs 获取租户状态变更记录
+	ListTenantStatusLogs(context.Context, *ListTenantStatusLogsRequest) (*ListTenantStatusLogsResponse, error)
+	// MoveTenant 将租户（连同其子租户）移动到新的父租户下，类型不变
+	MoveTenant(context.Context, *MoveTenantRequest) (*MoveTenantResponse, error)
+	// PurgeDeletedTenants 立即清理超过保留期的已删除租户，dry_run时只生成报告
+	PurgeDeletedTenants(context.Context, *PurgeDeletedTenantsRequest) (*PurgeDeletedTenantsResponse, error)
+	// RenewTenant 续期租户：更新到期时间，EXPIRED -> ACTIVE
+	RenewTenant(context.Context, *RenewTenantRequest) (*RenewTenantResponse, error)
+	// RestoreTenant 恢复已删除的租户，需在保留期内且上级租户未被删除
+	RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error)
 	// UpdateTenant 更新租户
 	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
 }
@@ -53,14 +84,24 @@ type TenantServiceHTTPServer interface {
 func RegisterTenantServiceHTTPServer(s *http.Server, srv TenantServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/tenants", _TenantService_CreateTenant0_HTTP_Handler(srv))
-	r.GET("/v1/tenants/{id}", _TenantService_GetTenant0_HTTP_Handler(srv))
-	r.GET("/v1/tenants/{id}/hierarchy", _TenantService_GetTenantHierarchy0_HTTP_Handler(srv))
 	r.GET("/v1/tenants/root", _TenantService_ListRootTenants0_HTTP_Handler(srv))
-	r.GET("/v1/tenants/{parent_id}/children", _TenantService_ListSubTenants0_HTTP_Handler(srv))
 	r.GET("/v1/tenants/groups", _TenantService_ListGroupTenants0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/deleted", _TenantService_ListDeletedTenants0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/purge", _TenantService_PurgeDeletedTenants0_HTTP_Handler(srv))
 	r.GET("/v1/tenants/statistics", _TenantService_GetTenantStatistics0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{id}", _TenantService_GetTenant0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{id}/hierarchy", _TenantService_GetTenantHierarchy0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{parent_id}/children", _TenantService_ListSubTenants0_HTTP_Handler(srv))
 	r.PUT("/v1/tenants/{id}", _TenantService_UpdateTenant0_HTTP_Handler(srv))
 	r.DELETE("/v1/tenants/{id}", _TenantService_DeleteTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/restore", _TenantService_RestoreTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/activate", _TenantService_ActivateTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/disable", _TenantService_DisableTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/renew", _TenantService_RenewTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/expire", _TenantService_ExpireTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/move", _TenantService_MoveTenant0_HTTP_Handler(srv))
+	r.POST("/v1/tenants/{id}/convert", _TenantService_ConvertTenantType0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{id}/status-logs", _TenantService_ListTenantStatusLogs0_HTTP_Handler(srv))
 }
 
 func _TenantService_CreateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
@@ -85,6 +126,104 @@ func _TenantService_CreateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func
 	}
 }
 
+func _TenantService_ListRootTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRootTenantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceListRootTenants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRootTenants(ctx, req.(*ListRootTenantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRootTenantsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_ListGroupTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListGroupTenantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceListGroupTenants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGroupTenants(ctx, req.(*ListGroupTenantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGroupTenantsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_ListDeletedTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedTenantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceListDeletedTenants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedTenants(ctx, req.(*ListDeletedTenantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedTenantsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_PurgeDeletedTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeDeletedTenantsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServicePurgeDeletedTenants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeDeletedTenants(ctx, req.(*PurgeDeletedTenantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeDeletedTenantsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_GetTenantStatistics0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantStatisticsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceGetTenantStatistics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantStatistics(ctx, req.(*GetTenantStatisticsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTenantStatisticsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _TenantService_GetTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetTenantRequest
@@ -129,88 +268,103 @@ func _TenantService_GetTenantHierarchy0_HTTP_Handler(srv TenantServiceHTTPServer
 	}
 }
 
-func _TenantService_ListRootTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_ListSubTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListRootTenantsRequest
+		var in ListSubTenantsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantServiceListRootTenants)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceListSubTenants)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListRootTenants(ctx, req.(*ListRootTenantsRequest))
+			return srv.ListSubTenants(ctx, req.(*ListSubTenantsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListRootTenantsResponse)
+		reply := out.(*ListSubTenantsResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _TenantService_ListSubTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_UpdateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListSubTenantsRequest
+		var in UpdateTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantServiceListSubTenants)
+		http.SetOperation(ctx, OperationTenantServiceUpdateTenant)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListSubTenants(ctx, req.(*ListSubTenantsRequest))
+			return srv.UpdateTenant(ctx, req.(*UpdateTenantRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListSubTenantsResponse)
+		reply := out.(*UpdateTenantResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _TenantService_ListGroupTenants0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_DeleteTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListGroupTenantsRequest
+		var in DeleteTenantRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantServiceListGroupTenants)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceDeleteTenant)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListGroupTenants(ctx, req.(*ListGroupTenantsRequest))
+			return srv.DeleteTenant(ctx, req.(*DeleteTenantRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListGroupTenantsResponse)
+		reply := out.(*DeleteTenantResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _TenantService_GetTenantStatistics0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_RestoreTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetTenantStatisticsRequest
+		var in RestoreTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantServiceGetTenantStatistics)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceRestoreTenant)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetTenantStatistics(ctx, req.(*GetTenantStatisticsRequest))
+			return srv.RestoreTenant(ctx, req.(*RestoreTenantRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetTenantStatisticsResponse)
+		reply := out.(*RestoreTenantResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _TenantService_UpdateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_ActivateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateTenantRequest
+		var in ActivateTenantRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -220,58 +374,204 @@ func _TenantService_UpdateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantServiceUpdateTenant)
+		http.SetOperation(ctx, OperationTenantServiceActivateTenant)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateTenant(ctx, req.(*UpdateTenantRequest))
+			return srv.ActivateTenant(ctx, req.(*ActivateTenantRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*UpdateTenantResponse)
+		reply := out.(*ActivateTenantResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _TenantService_DeleteTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_DisableTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteTenantRequest
+		var in DisableTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationTenantServiceDeleteTenant)
+		http.SetOperation(ctx, OperationTenantServiceDisableTenant)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteTenant(ctx, req.(*DeleteTenantRequest))
+			return srv.DisableTenant(ctx, req.(*DisableTenantRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteTenantResponse)
+		reply := out.(*DisableTenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_RenewTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenewTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceRenewTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenewTenant(ctx, req.(*RenewTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenewTenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_ExpireTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExpireTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceExpireTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExpireTenant(ctx, req.(*ExpireTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExpireTenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_MoveTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MoveTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceMoveTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveTenant(ctx, req.(*MoveTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MoveTenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_ConvertTenantType0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConvertTenantTypeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceConvertTenantType)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConvertTenantType(ctx, req.(*ConvertTenantTypeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConvertTenantTypeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_ListTenantStatusLogs0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantStatusLogsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceListTenantStatusLogs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantStatusLogs(ctx, req.(*ListTenantStatusLogsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantStatusLogsResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
 type TenantServiceHTTPClient interface {
+	// ActivateTenant 激活租户：PENDING/DISABLED -> ACTIVE，已到期的租户需先续期
+	ActivateTenant(ctx context.Context, req *ActivateTenantRequest, opts ...http.CallOption) (rsp *ActivateTenantResponse, err error)
+	// ConvertTenantType 转换租户类型，如NORMAL升级为GROUP、SUB提升为独立租户，必要时同时变更父租户
+	ConvertTenantType(ctx context.Context, req *ConvertTenantTypeRequest, opts ...http.CallOption) (rsp *ConvertTenantTypeResponse, err error)
 	// CreateTenant 创建租户
 	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *CreateTenantResponse, err error)
 	// DeleteTenant 删除租户
 	DeleteTenant(ctx context.Context, req *DeleteTenantRequest, opts ...http.CallOption) (rsp *DeleteTenantResponse, err error)
+	// DisableTenant 停用租户：PENDING/ACTIVE/EXPIRED -> DISABLED，其下子租户同时不可用
+	DisableTenant(ctx context.Context, req *DisableTenantRequest, opts ...http.CallOption) (rsp *DisableTenantResponse, err error)
+	// ExpireTenant 使租户立即过期：PENDING/ACTIVE -> EXPIRED
+	ExpireTenant(ctx context.Context, req *ExpireTenantRequest, opts ...http.CallOption) (rsp *ExpireTenantResponse, err error)
 	// GetTenant 获取租户详情
 	GetTenant(ctx context.Context, req *GetTenantRequest, opts ...http.CallOption) (rsp *GetTenantResponse, err error)
 	// GetTenantHierarchy 获取租户层级结构
 	GetTenantHierarchy(ctx context.Context, req *GetTenantHierarchyRequest, opts ...http.CallOption) (rsp *GetTenantHierarchyResponse, err error)
 	// GetTenantStatistics 获取租户统计信息
 	GetTenantStatistics(ctx context.Context, req *GetTenantStatisticsRequest, opts ...http.CallOption) (rsp *GetTenantStatisticsResponse, err error)
+	// ListDeletedTenants 获取已删除（回收站中）的租户列表
+	ListDeletedTenants(ctx context.Context, req *ListDeletedTenantsRequest, opts ...http.CallOption) (rsp *ListDeletedTenantsResponse, err error)
 	// ListGroupTenants 获取集团型租户列表
 	ListGroupTenants(ctx context.Context, req *ListGroupTenantsRequest, opts ...http.CallOption) (rsp *ListGroupTenantsResponse, err error)
 	// ListRootTenants 获取根租户列表
+	// 静态路由需声明在/v1/tenants/{id}之前，否则HTTP路由会先匹配到GetTenant
 	ListRootTenants(ctx context.Context, req *ListRootTenantsRequest, opts ...http.CallOption) (rsp *ListRootTenantsResponse, err error)
 	// ListSubTenants 获取子租户列表
 	ListSubTenants(ctx context.Context, req *ListSubTenantsRequest, opts ...http.CallOption) (rsp *ListSubTenantsResponse, err error)
+	// ListTenantStatusLogs 获取租户状态变更记录
+	ListTenantStatusLogs(ctx context.Context, req *ListTenantStatusLogsRequest, opts ...http.CallOption) (rsp *ListTenantStatusLogsResponse, err error)
+	// MoveTenant 将租户（连同其子租户）移动到新的父租户下，类型不变
+	MoveTenant(ctx context.Context, req *MoveTenantRequest, opts ...http.CallOption) (rsp *MoveTenantResponse, err error)
+	// PurgeDeletedTenants 立即清理超过保留期的已删除租户，dry_run时只生成报告
+	PurgeDeletedTenants(ctx context.Context, req *PurgeDeletedTenantsRequest, opts ...http.CallOption) (rsp *PurgeDeletedTenantsResponse, err error)
+	// RenewTenant 续期租户：更新到期时间，EXPIRED -> ACTIVE
+	RenewTenant(ctx context.Context, req *RenewTenantRequest, opts ...http.CallOption) (rsp *RenewTenantResponse, err error)
+	// RestoreTenant 恢复已删除的租户，需在保留期内且上级租户未被删除
+	RestoreTenant(ctx context.Context, req *RestoreTenantRequest, opts ...http.CallOption) (rsp *RestoreTenantResponse, err error)
 	// UpdateTenant 更新租户
 	UpdateTenant(ctx context.Context, req *UpdateTenantRequest, opts ...http.CallOption) (rsp *UpdateTenantResponse, err error)
 }
@@ -284,6 +584,34 @@ func NewTenantServiceHTTPClient(client *http.Client) TenantServiceHTTPClient {
 	return &TenantServiceHTTPClientImpl{client}
 }
 
+// ActivateTenant 激活租户：PENDING/DISABLED -> ACTIVE，已到期的租户需先续期
+func (c *TenantServiceHTTPClientImpl) ActivateTenant(ctx context.Context, in *ActivateTenantRequest, opts ...http.CallOption) (*ActivateTenantResponse, error) {
+	var out ActivateTenantResponse
+	pattern := "/v1/tenants/{id}/activate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceActivateTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConvertTenantType 转换租户类型，如NORMAL升级为GROUP、SUB提升为独立租户，必要时同时变更父租户
+func (c *TenantServiceHTTPClientImpl) ConvertTenantType(ctx context.Context, in *ConvertTenantTypeRequest, opts ...http.CallOption) (*ConvertTenantTypeResponse, error) {
+	var out ConvertTenantTypeResponse
+	pattern := "/v1/tenants/{id}/convert"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceConvertTenantType))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateTenant 创建租户
 func (c *TenantServiceHTTPClientImpl) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...http.CallOption) (*CreateTenantResponse, error) {
 	var out CreateTenantResponse
@@ -312,6 +640,34 @@ func (c *TenantServiceHTTPClientImpl) DeleteTenant(ctx context.Context, in *Dele
 	return &out, nil
 }
 
+// DisableTenant 停用租户：PENDING/ACTIVE/EXPIRED -> DISABLED，其下子租户同时不可用
+func (c *TenantServiceHTTPClientImpl) DisableTenant(ctx context.Context, in *DisableTenantRequest, opts ...http.CallOption) (*DisableTenantResponse, error) {
+	var out DisableTenantResponse
+	pattern := "/v1/tenants/{id}/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceDisableTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExpireTenant 使租户立即过期：PENDING/ACTIVE -> EXPIRED
+func (c *TenantServiceHTTPClientImpl) ExpireTenant(ctx context.Context, in *ExpireTenantRequest, opts ...http.CallOption) (*ExpireTenantResponse, error) {
+	var out ExpireTenantResponse
+	pattern := "/v1/tenants/{id}/expire"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceExpireTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetTenant 获取租户详情
 func (c *TenantServiceHTTPClientImpl) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...http.CallOption) (*GetTenantResponse, error) {
 	var out GetTenantResponse
@@ -354,6 +710,20 @@ func (c *TenantServiceHTTPClientImpl) GetTenantStatistics(ctx context.Context, i
 	return &out, nil
 }
 
+// ListDeletedTenants 获取已删除（回收站中）的租户列表
+func (c *TenantServiceHTTPClientImpl) ListDeletedTenants(ctx context.Context, in *ListDeletedTenantsRequest, opts ...http.CallOption) (*ListDeletedTenantsResponse, error) {
+	var out ListDeletedTenantsResponse
+	pattern := "/v1/tenants/deleted"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantServiceListDeletedTenants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListGroupTenants 获取集团型租户列表
 func (c *TenantServiceHTTPClientImpl) ListGroupTenants(ctx context.Context, in *ListGroupTenantsRequest, opts ...http.CallOption) (*ListGroupTenantsResponse, error) {
 	var out ListGroupTenantsResponse
@@ -369,6 +739,7 @@ func (c *TenantServiceHTTPClientImpl) ListGroupTenants(ctx context.Context, in *
 }
 
 // ListRootTenants 获取根租户列表
+// 静态路由需声明在/v1/tenants/{id}之前，否则HTTP路由会先匹配到GetTenant
 func (c *TenantServiceHTTPClientImpl) ListRootTenants(ctx context.Context, in *ListRootTenantsRequest, opts ...http.CallOption) (*ListRootTenantsResponse, error) {
 	var out ListRootTenantsResponse
 	pattern := "/v1/tenants/root"
@@ -396,6 +767,76 @@ func (c *TenantServiceHTTPClientImpl) ListSubTenants(ctx context.Context, in *Li
 	return &out, nil
 }
 
+// ListTenantStatusLogs 获取租户状态变更记录
+func (c *TenantServiceHTTPClientImpl) ListTenantStatusLogs(ctx context.Context, in *ListTenantStatusLogsRequest, opts ...http.CallOption) (*ListTenantStatusLogsResponse, error) {
+	var out ListTenantStatusLogsResponse
+	pattern := "/v1/tenants/{id}/status-logs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantServiceListTenantStatusLogs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MoveTenant 将租户（连同其子租户）移动到新的父租户下，类型不变
+func (c *TenantServiceHTTPClientImpl) MoveTenant(ctx context.Context, in *MoveTenantRequest, opts ...http.CallOption) (*MoveTenantResponse, error) {
+	var out MoveTenantResponse
+	pattern := "/v1/tenants/{id}/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceMoveTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurgeDeletedTenants 立即清理超过保留期的已删除租户，dry_run时只生成报告
+func (c *TenantServiceHTTPClientImpl) PurgeDeletedTenants(ctx context.Context, in *PurgeDeletedTenantsRequest, opts ...http.CallOption) (*PurgeDeletedTenantsResponse, error) {
+	var out PurgeDeletedTenantsResponse
+	pattern := "/v1/tenants/purge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServicePurgeDeletedTenants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RenewTenant 续期租户：更新到期时间，EXPIRED -> ACTIVE
+func (c *TenantServiceHTTPClientImpl) RenewTenant(ctx context.Context, in *RenewTenantRequest, opts ...http.CallOption) (*RenewTenantResponse, error) {
+	var out RenewTenantResponse
+	pattern := "/v1/tenants/{id}/renew"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceRenewTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreTenant 恢复已删除的租户，需在保留期内且上级租户未被删除
+func (c *TenantServiceHTTPClientImpl) RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...http.CallOption) (*RestoreTenantResponse, error) {
+	var out RestoreTenantResponse
+	pattern := "/v1/tenants/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceRestoreTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateTenant 更新租户
 func (c *TenantServiceHTTPClientImpl) UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...http.CallOption) (*UpdateTenantResponse, error) {
 	var out UpdateTenantResponse
@@ -0,0 +1,1088 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.0--rc1
+// source: admin/v1/tenant_member.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 租户成员
+type TenantMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Avatar        string                 `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Fullname      string                 `protobuf:"bytes,7,opt,name=fullname,proto3" json:"fullname,omitempty"`
+	UserStatus    string                 `protobuf:"bytes,8,opt,name=user_status,json=userStatus,proto3" json:"user_status,omitempty"`
+	RoleLabels    []string               `protobuf:"bytes,9,rep,name=role_labels,json=roleLabels,proto3" json:"role_labels,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 加入时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantMember) Reset() {
+	*x = TenantMember{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantMember) ProtoMessage() {}
+
+func (x *TenantMember) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantMember.ProtoReflect.Descriptor instead.
+func (*TenantMember) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TenantMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TenantMember) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TenantMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantMember) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *TenantMember) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *TenantMember) GetFullname() string {
+	if x != nil {
+		return x.Fullname
+	}
+	return ""
+}
+
+func (x *TenantMember) GetUserStatus() string {
+	if x != nil {
+		return x.UserStatus
+	}
+	return ""
+}
+
+func (x *TenantMember) GetRoleLabels() []string {
+	if x != nil {
+		return x.RoleLabels
+	}
+	return nil
+}
+
+func (x *TenantMember) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TenantMember) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 用户所属的租户
+type UserTenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	RoleLabels    []string               `protobuf:"bytes,6,rep,name=role_labels,json=roleLabels,proto3" json:"role_labels,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,7,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	Usable        bool                   `protobuf:"varint,8,opt,name=usable,proto3" json:"usable,omitempty"` // 租户及其上级租户均可用，不可用的租户无法切换进入
+	IsOwner       bool                   `protobuf:"varint,9,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTenant) Reset() {
+	*x = UserTenant{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTenant) ProtoMessage() {}
+
+func (x *UserTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTenant.ProtoReflect.Descriptor instead.
+func (*UserTenant) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserTenant) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UserTenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserTenant) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserTenant) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserTenant) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UserTenant) GetRoleLabels() []string {
+	if x != nil {
+		return x.RoleLabels
+	}
+	return nil
+}
+
+func (x *UserTenant) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *UserTenant) GetUsable() bool {
+	if x != nil {
+		return x.Usable
+	}
+	return false
+}
+
+func (x *UserTenant) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+// 添加租户成员请求
+type AddTenantMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	RoleLabels    []string               `protobuf:"bytes,3,rep,name=role_labels,json=roleLabels,proto3" json:"role_labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTenantMembersRequest) Reset() {
+	*x = AddTenantMembersRequest{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTenantMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantMembersRequest) ProtoMessage() {}
+
+func (x *AddTenantMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddTenantMembersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddTenantMembersRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AddTenantMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *AddTenantMembersRequest) GetRoleLabels() []string {
+	if x != nil {
+		return x.RoleLabels
+	}
+	return nil
+}
+
+// 添加租户成员响应
+type AddTenantMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Members       []*TenantMember        `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`  // 本次新加入的成员
+	Skipped       int32                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"` // 已是成员而被忽略的数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTenantMembersResponse) Reset() {
+	*x = AddTenantMembersResponse{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTenantMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTenantMembersResponse) ProtoMessage() {}
+
+func (x *AddTenantMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTenantMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddTenantMembersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTenantMembersResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *AddTenantMembersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AddTenantMembersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AddTenantMembersResponse) GetMembers() []*TenantMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AddTenantMembersResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// 修改租户成员请求
+type UpdateTenantMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleLabels    []string               `protobuf:"bytes,3,rep,name=role_labels,json=roleLabels,proto3" json:"role_labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantMemberRequest) Reset() {
+	*x = UpdateTenantMemberRequest{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantMemberRequest) ProtoMessage() {}
+
+func (x *UpdateTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTenantMemberRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTenantMemberRequest) GetRoleLabels() []string {
+	if x != nil {
+		return x.RoleLabels
+	}
+	return nil
+}
+
+// 修改租户成员响应
+type UpdateTenantMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Member        *TenantMember          `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantMemberResponse) Reset() {
+	*x = UpdateTenantMemberResponse{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantMemberResponse) ProtoMessage() {}
+
+func (x *UpdateTenantMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantMemberResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTenantMemberResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *UpdateTenantMemberResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateTenantMemberResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UpdateTenantMemberResponse) GetMember() *TenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// 移除租户成员请求
+type RemoveTenantMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTenantMemberRequest) Reset() {
+	*x = RemoveTenantMemberRequest{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTenantMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTenantMemberRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveTenantMemberRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RemoveTenantMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 移除租户成员响应
+type RemoveTenantMemberResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Result          bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code            int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg             string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	UserRoles       int32                  `protobuf:"varint,4,opt,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`                   // 随之撤销的角色授予数量
+	UserDepartments int32                  `protobuf:"varint,5,opt,name=user_departments,json=userDepartments,proto3" json:"user_departments,omitempty"` // 随之删除的部门关系数量
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemoveTenantMemberResponse) Reset() {
+	*x = RemoveTenantMemberResponse{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTenantMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTenantMemberResponse) ProtoMessage() {}
+
+func (x *RemoveTenantMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTenantMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTenantMemberResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveTenantMemberResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RemoveTenantMemberResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RemoveTenantMemberResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RemoveTenantMemberResponse) GetUserRoles() int32 {
+	if x != nil {
+		return x.UserRoles
+	}
+	return 0
+}
+
+func (x *RemoveTenantMemberResponse) GetUserDepartments() int32 {
+	if x != nil {
+		return x.UserDepartments
+	}
+	return 0
+}
+
+// 获取租户成员列表请求
+type ListTenantMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword       string                 `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword,omitempty"` // 匹配用户名、姓名、邮箱、手机号
+	RoleLabel     string                 `protobuf:"bytes,5,opt,name=role_label,json=roleLabel,proto3" json:"role_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersRequest) Reset() {
+	*x = ListTenantMembersRequest{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersRequest) ProtoMessage() {}
+
+func (x *ListTenantMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTenantMembersRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListTenantMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTenantMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTenantMembersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListTenantMembersRequest) GetRoleLabel() string {
+	if x != nil {
+		return x.RoleLabel
+	}
+	return ""
+}
+
+// 获取租户成员列表响应
+type ListTenantMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Members       []*TenantMember        `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersResponse) Reset() {
+	*x = ListTenantMembersResponse{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersResponse) ProtoMessage() {}
+
+func (x *ListTenantMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTenantMembersResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListTenantMembersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListTenantMembersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListTenantMembersResponse) GetMembers() []*TenantMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListTenantMembersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取用户所属租户请求
+type ListUserTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTenantsRequest) Reset() {
+	*x = ListUserTenantsRequest{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTenantsRequest) ProtoMessage() {}
+
+func (x *ListUserTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListUserTenantsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 获取用户所属租户响应
+type ListUserTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Tenants       []*UserTenant          `protobuf:"bytes,4,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTenantsResponse) Reset() {
+	*x = ListUserTenantsResponse{}
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTenantsResponse) ProtoMessage() {}
+
+func (x *ListUserTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_member_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_member_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUserTenantsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListUserTenantsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListUserTenantsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUserTenantsResponse) GetTenants() []*UserTenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+var File_admin_v1_tenant_member_proto protoreflect.FileDescriptor
+
+const file_admin_v1_tenant_member_proto_rawDesc = "" +
+	"\n" +
+	"\x1cadmin/v1/tenant_member.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\"\xc0\x02\n" +
+	"\fTenantMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x16\n" +
+	"\x06avatar\x18\x06 \x01(\tR\x06avatar\x12\x1a\n" +
+	"\bfullname\x18\a \x01(\tR\bfullname\x12\x1f\n" +
+	"\vuser_status\x18\b \x01(\tR\n" +
+	"userStatus\x12\x1f\n" +
+	"\vrole_labels\x18\t \x03(\tR\n" +
+	"roleLabels\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\xee\x01\n" +
+	"\n" +
+	"UserTenant\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x1f\n" +
+	"\vrole_labels\x18\x06 \x03(\tR\n" +
+	"roleLabels\x12\x1b\n" +
+	"\tjoined_at\x18\a \x01(\tR\bjoinedAt\x12\x16\n" +
+	"\x06usable\x18\b \x01(\bR\x06usable\x12\x19\n" +
+	"\bis_owner\x18\t \x01(\bR\aisOwner\"r\n" +
+	"\x17AddTenantMembersRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x1f\n" +
+	"\vrole_labels\x18\x03 \x03(\tR\n" +
+	"roleLabels\"\xa4\x01\n" +
+	"\x18AddTenantMembersResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x120\n" +
+	"\amembers\x18\x04 \x03(\v2\x16.admin.v1.TenantMemberR\amembers\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x05R\askipped\"r\n" +
+	"\x19UpdateTenantMemberRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vrole_labels\x18\x03 \x03(\tR\n" +
+	"roleLabels\"\x8a\x01\n" +
+	"\x1aUpdateTenantMemberResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12.\n" +
+	"\x06member\x18\x04 \x01(\v2\x16.admin.v1.TenantMemberR\x06member\"Q\n" +
+	"\x19RemoveTenantMemberRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xa4\x01\n" +
+	"\x1aRemoveTenantMemberResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\x1d\n" +
+	"\n" +
+	"user_roles\x18\x04 \x01(\x05R\tuserRoles\x12)\n" +
+	"\x10user_departments\x18\x05 \x01(\x05R\x0fuserDepartments\"\xa1\x01\n" +
+	"\x18ListTenantMembersRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x04 \x01(\tR\akeyword\x12\x1d\n" +
+	"\n" +
+	"role_label\x18\x05 \x01(\tR\troleLabel\"\xa1\x01\n" +
+	"\x19ListTenantMembersResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x120\n" +
+	"\amembers\x18\x04 \x03(\v2\x16.admin.v1.TenantMemberR\amembers\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"1\n" +
+	"\x16ListUserTenantsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x87\x01\n" +
+	"\x17ListUserTenantsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12.\n" +
+	"\atenants\x18\x04 \x03(\v2\x14.admin.v1.UserTenantR\atenants2\xcf\x05\n" +
+	"\x13TenantMemberService\x12\x85\x01\n" +
+	"\x10AddTenantMembers\x12!.admin.v1.AddTenantMembersRequest\x1a\".admin.v1.AddTenantMembersResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tenants/{tenant_id}/members\x12\x95\x01\n" +
+	"\x12UpdateTenantMember\x12#.admin.v1.UpdateTenantMemberRequest\x1a$.admin.v1.UpdateTenantMemberResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/tenants/{tenant_id}/members/{user_id}\x12\x92\x01\n" +
+	"\x12RemoveTenantMember\x12#.admin.v1.RemoveTenantMemberRequest\x1a$.admin.v1.RemoveTenantMemberResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/tenants/{tenant_id}/members/{user_id}\x12\x85\x01\n" +
+	"\x11ListTenantMembers\x12\".admin.v1.ListTenantMembersRequest\x1a#.admin.v1.ListTenantMembersResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/tenants/{tenant_id}/members\x12{\n" +
+	"\x0fListUserTenants\x12 .admin.v1.ListUserTenantsRequest\x1a!.admin.v1.ListUserTenantsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{user_id}/tenantsB+Z)github.com/yc-alpha/admin/api/admin/v1;v1b\x06proto3"
+
+var (
+	file_admin_v1_tenant_member_proto_rawDescOnce sync.Once
+	file_admin_v1_tenant_member_proto_rawDescData []byte
+)
+
+func file_admin_v1_tenant_member_proto_rawDescGZIP() []byte {
+	file_admin_v1_tenant_member_proto_rawDescOnce.Do(func() {
+		file_admin_v1_tenant_member_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_member_proto_rawDesc), len(file_admin_v1_tenant_member_proto_rawDesc)))
+	})
+	return file_admin_v1_tenant_member_proto_rawDescData
+}
+
+var file_admin_v1_tenant_member_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_admin_v1_tenant_member_proto_goTypes = []any{
+	(*TenantMember)(nil),               // 0: admin.v1.TenantMember
+	(*UserTenant)(nil),                 // 1: admin.v1.UserTenant
+	(*AddTenantMembersRequest)(nil),    // 2: admin.v1.AddTenantMembersRequest
+	(*AddTenantMembersResponse)(nil),   // 3: admin.v1.AddTenantMembersResponse
+	(*UpdateTenantMemberRequest)(nil),  // 4: admin.v1.UpdateTenantMemberRequest
+	(*UpdateTenantMemberResponse)(nil), // 5: admin.v1.UpdateTenantMemberResponse
+	(*RemoveTenantMemberRequest)(nil),  // 6: admin.v1.RemoveTenantMemberRequest
+	(*RemoveTenantMemberResponse)(nil), // 7: admin.v1.RemoveTenantMemberResponse
+	(*ListTenantMembersRequest)(nil),   // 8: admin.v1.ListTenantMembersRequest
+	(*ListTenantMembersResponse)(nil),  // 9: admin.v1.ListTenantMembersResponse
+	(*ListUserTenantsRequest)(nil),     // 10: admin.v1.ListUserTenantsRequest
+	(*ListUserTenantsResponse)(nil),    // 11: admin.v1.ListUserTenantsResponse
+}
+var file_admin_v1_tenant_member_proto_depIdxs = []int32{
+	0,  // 0: admin.v1.AddTenantMembersResponse.members:type_name -> admin.v1.TenantMember
+	0,  // 1: admin.v1.UpdateTenantMemberResponse.member:type_name -> admin.v1.TenantMember
+	0,  // 2: admin.v1.ListTenantMembersResponse.members:type_name -> admin.v1.TenantMember
+	1,  // 3: admin.v1.ListUserTenantsResponse.tenants:type_name -> admin.v1.UserTenant
+	2,  // 4: admin.v1.TenantMemberService.AddTenantMembers:input_type -> admin.v1.AddTenantMembersRequest
+	4,  // 5: admin.v1.TenantMemberService.UpdateTenantMember:input_type -> admin.v1.UpdateTenantMemberRequest
+	6,  // 6: admin.v1.TenantMemberService.RemoveTenantMember:input_type -> admin.v1.RemoveTenantMemberRequest
+	8,  // 7: admin.v1.TenantMemberService.ListTenantMembers:input_type -> admin.v1.ListTenantMembersRequest
+	10, // 8: admin.v1.TenantMemberService.ListUserTenants:input_type -> admin.v1.ListUserTenantsRequest
+	3,  // 9: admin.v1.TenantMemberService.AddTenantMembers:output_type -> admin.v1.AddTenantMembersResponse
+	5,  // 10: admin.v1.TenantMemberService.UpdateTenantMember:output_type -> admin.v1.UpdateTenantMemberResponse
+	7,  // 11: admin.v1.TenantMemberService.RemoveTenantMember:output_type -> admin.v1.RemoveTenantMemberResponse
+	9,  // 12: admin.v1.TenantMemberService.ListTenantMembers:output_type -> admin.v1.ListTenantMembersResponse
+	11, // 13: admin.v1.TenantMemberService.ListUserTenants:output_type -> admin.v1.ListUserTenantsResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_tenant_member_proto_init() }
+func file_admin_v1_tenant_member_proto_init() {
+	if File_admin_v1_tenant_member_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_member_proto_rawDesc), len(file_admin_v1_tenant_member_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_tenant_member_proto_goTypes,
+		DependencyIndexes: file_admin_v1_tenant_member_proto_depIdxs,
+		MessageInfos:      file_admin_v1_tenant_member_proto_msgTypes,
+	}.Build()
+	File_admin_v1_tenant_member_proto = out.File
+	file_admin_v1_tenant_member_proto_goTypes = nil
+	file_admin_v1_tenant_member_proto_depIdxs = nil
+}
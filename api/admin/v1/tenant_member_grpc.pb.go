@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0--rc1
+// source: admin/v1/tenant_member.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TenantMemberService_AddTenantMembers_FullMethodName   = "/admin.v1.TenantMemberService/AddTenantMembers"
+	TenantMemberService_UpdateTenantMember_FullMethodName = "/admin.v1.TenantMemberService/UpdateTenantMember"
+	TenantMemberService_RemoveTenantMember_FullMethodName = "/admin.v1.TenantMemberService/RemoveTenantMember"
+	TenantMemberService_ListTenantMembers_FullMethodName  = "/admin.v1.TenantMemberService/ListTenantMembers"
+	TenantMemberService_ListUserTenants_FullMethodName    = "/admin.v1.TenantMemberService/ListUserTenants"
+)
+
+// TenantMemberServiceClient is the client API for TenantMemberService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 租户成员管理服务
+type TenantMemberServiceClient interface {
+	// 将用户加入租户，已是成员的用户忽略
+	AddTenantMembers(ctx context.Context, in *AddTenantMembersRequest, opts ...grpc.CallOption) (*AddTenantMembersResponse, error)
+	// 修改成员的身份标签
+	UpdateTenantMember(ctx context.Context, in *UpdateTenantMemberRequest, opts ...grpc.CallOption) (*UpdateTenantMemberResponse, error)
+	// 将用户移出租户，同时撤销其在该租户下的角色授予和部门关系
+	RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberResponse, error)
+	// 获取租户的成员列表
+	ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...grpc.CallOption) (*ListTenantMembersResponse, error)
+	// 获取用户所属的租户列表，user_id为me时表示当前用户，用于租户切换
+	ListUserTenants(ctx context.Context, in *ListUserTenantsRequest, opts ...grpc.CallOption) (*ListUserTenantsResponse, error)
+}
+
+type tenantMemberServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantMemberServiceClient(cc grpc.ClientConnInterface) TenantMemberServiceClient {
+	return &tenantMemberServiceClient{cc}
+}
+
+func (c *tenantMemberServiceClient) AddTenantMembers(ctx context.Context, in *AddTenantMembersRequest, opts ...grpc.CallOption) (*AddTenantMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTenantMembersResponse)
+	err := c.cc.Invoke(ctx, TenantMemberService_AddTenantMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantMemberServiceClient) UpdateTenantMember(ctx context.Context, in *UpdateTenantMemberRequest, opts ...grpc.CallOption) (*UpdateTenantMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantMemberResponse)
+	err := c.cc.Invoke(ctx, TenantMemberService_UpdateTenantMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantMemberServiceClient) RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...grpc.CallOption) (*RemoveTenantMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTenantMemberResponse)
+	err := c.cc.Invoke(ctx, TenantMemberService_RemoveTenantMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantMemberServiceClient) ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...grpc.CallOption) (*ListTenantMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantMembersResponse)
+	err := c.cc.Invoke(ctx, TenantMemberService_ListTenantMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantMemberServiceClient) ListUserTenants(ctx context.Context, in *ListUserTenantsRequest, opts ...grpc.CallOption) (*ListUserTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserTenantsResponse)
+	err := c.cc.Invoke(ctx, TenantMemberService_ListUserTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantMemberServiceServer is the server API for TenantMemberService service.
+// All implementations must embed UnimplementedTenantMemberServiceServer
+// for forward compatibility.
+//
+// 租户成员管理服务
+type TenantMemberServiceServer interface {
+	// 将用户加入租户，已是成员的用户忽略
+	AddTenantMembers(context.Context, *AddTenantMembersRequest) (*AddTenantMembersResponse, error)
+	// 修改成员的身份标签
+	UpdateTenantMember(context.Context, *UpdateTenantMemberRequest) (*UpdateTenantMemberResponse, error)
+	// 将用户移出租户，同时撤销其在该租户下的角色授予和部门关系
+	RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error)
+	// 获取租户的成员列表
+	ListTenantMembers(context.Context, *ListTenantMembersRequest) (*ListTenantMembersResponse, error)
+	// 获取用户所属的租户列表，user_id为me时表示当前用户，用于租户切换
+	ListUserTenants(context.Context, *ListUserTenantsRequest) (*ListUserTenantsResponse, error)
+	mustEmbedUnimplementedTenantMemberServiceServer()
+}
+
+// UnimplementedTenantMemberServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantMemberServiceServer struct{}
+
+func (UnimplementedTenantMemberServiceServer) AddTenantMembers(context.Context, *AddTenantMembersRequest) (*AddTenantMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTenantMembers not implemented")
+}
+func (UnimplementedTenantMemberServiceServer) UpdateTenantMember(context.Context, *UpdateTenantMemberRequest) (*UpdateTenantMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantMember not implemented")
+}
+func (UnimplementedTenantMemberServiceServer) RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTenantMember not implemented")
+}
+func (UnimplementedTenantMemberServiceServer) ListTenantMembers(context.Context, *ListTenantMembersRequest) (*ListTenantMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenantMembers not implemented")
+}
+func (UnimplementedTenantMemberServiceServer) ListUserTenants(context.Context, *ListUserTenantsRequest) (*ListUserTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTenants not implemented")
+}
+func (UnimplementedTenantMemberServiceServer) mustEmbedUnimplementedTenantMemberServiceServer() {}
+func (UnimplementedTenantMemberServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeTenantMemberServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantMemberServiceServer will
+// result in compilation errors.
+type UnsafeTenantMemberServiceServer interface {
+	mustEmbedUnimplementedTenantMemberServiceServer()
+}
+
+func RegisterTenantMemberServiceServer(s grpc.ServiceRegistrar, srv TenantMemberServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenantMemberServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenantMemberService_ServiceDesc, srv)
+}
+
+func _TenantMemberService_AddTenantMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTenantMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantMemberServiceServer).AddTenantMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantMemberService_AddTenantMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantMemberServiceServer).AddTenantMembers(ctx, req.(*AddTenantMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantMemberService_UpdateTenantMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantMemberServiceServer).UpdateTenantMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantMemberService_UpdateTenantMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantMemberServiceServer).UpdateTenantMember(ctx, req.(*UpdateTenantMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantMemberService_RemoveTenantMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTenantMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantMemberServiceServer).RemoveTenantMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantMemberService_RemoveTenantMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantMemberServiceServer).RemoveTenantMember(ctx, req.(*RemoveTenantMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantMemberService_ListTenantMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantMemberServiceServer).ListTenantMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantMemberService_ListTenantMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantMemberServiceServer).ListTenantMembers(ctx, req.(*ListTenantMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantMemberService_ListUserTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantMemberServiceServer).ListUserTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantMemberService_ListUserTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantMemberServiceServer).ListUserTenants(ctx, req.(*ListUserTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantMemberService_ServiceDesc is the grpc.ServiceDesc for TenantMemberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantMemberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.v1.TenantMemberService",
+	HandlerType: (*TenantMemberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTenantMembers",
+			Handler:    _TenantMemberService_AddTenantMembers_Handler,
+		},
+		{
+			MethodName: "UpdateTenantMember",
+			Handler:    _TenantMemberService_UpdateTenantMember_Handler,
+		},
+		{
+			MethodName: "RemoveTenantMember",
+			Handler:    _TenantMemberService_RemoveTenantMember_Handler,
+		},
+		{
+			MethodName: "ListTenantMembers",
+			Handler:    _TenantMemberService_ListTenantMembers_Handler,
+		},
+		{
+			MethodName: "ListUserTenants",
+			Handler:    _TenantMemberService_ListUserTenants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1/tenant_member.proto",
+}
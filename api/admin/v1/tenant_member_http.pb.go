@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.8.4
+// - protoc             v5.29.0--rc1
+// source: admin/v1/tenant_member.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationTenantMemberServiceAddTenantMembers = "/admin.v1.TenantMemberService/AddTenantMembers"
+const OperationTenantMemberServiceListTenantMembers = "/admin.v1.TenantMemberService/ListTenantMembers"
+const OperationTenantMemberServiceListUserTenants = "/admin.v1.TenantMemberService/ListUserTenants"
+const OperationTenantMemberServiceRemoveTenantMember = "/admin.v1.TenantMemberService/RemoveTenantMember"
+const OperationTenantMemberServiceUpdateTenantMember = "/admin.v1.TenantMemberService/UpdateTenantMember"
+
+type TenantMemberServiceHTTPServer interface {
+	// AddTenantMembers 将用户加入租户，已是成员的用户忽略
+	AddTenantMembers(context.Context, *AddTenantMembersRequest) (*AddTenantMembersResponse, error)
+	// ListTenantMembers 获取租户的成员列表
+	ListTenantMembers(context.Context, *ListTenantMembersRequest) (*ListTenantMembersResponse, error)
+	// ListUserTenants 获取用户所属的租户列表，user_id为me时表示当前用户，用于租户切换
+	ListUserTenants(context.Context, *ListUserTenantsRequest) (*ListUserTenantsResponse, error)
+	// RemoveTenantMember 将用户移出租户，同时撤销其在该租户下的角色授予和部门关系
+	RemoveTenantMember(context.Context, *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error)
+	// UpdateTenantMember 修改成员的身份标签
+	UpdateTenantMember(context.Context, *UpdateTenantMemberRequest) (*UpdateTenantMemberResponse, error)
+}
+
+func RegisterTenantMemberServiceHTTPServer(s *http.Server, srv TenantMemberServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/tenants/{tenant_id}/members", _TenantMemberService_AddTenantMembers0_HTTP_Handler(srv))
+	r.PUT("/v1/tenants/{tenant_id}/members/{user_id}", _TenantMemberService_UpdateTenantMember0_HTTP_Handler(srv))
+	r.DELETE("/v1/tenants/{tenant_id}/members/{user_id}", _TenantMemberService_RemoveTenantMember0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/members", _TenantMemberService_ListTenantMembers0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/tenants", _TenantMemberService_ListUserTenants0_HTTP_Handler(srv))
+}
+
+func _TenantMemberService_AddTenantMembers0_HTTP_Handler(srv TenantMemberServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddTenantMembersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantMemberServiceAddTenantMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddTenantMembers(ctx, req.(*AddTenantMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddTenantMembersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantMemberService_UpdateTenantMember0_HTTP_Handler(srv TenantMemberServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTenantMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantMemberServiceUpdateTenantMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTenantMember(ctx, req.(*UpdateTenantMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTenantMemberResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantMemberService_RemoveTenantMember0_HTTP_Handler(srv TenantMemberServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveTenantMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantMemberServiceRemoveTenantMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveTenantMember(ctx, req.(*RemoveTenantMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveTenantMemberResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantMemberService_ListTenantMembers0_HTTP_Handler(srv TenantMemberServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantMembersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantMemberServiceListTenantMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantMembers(ctx, req.(*ListTenantMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantMembersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantMemberService_ListUserTenants0_HTTP_Handler(srv TenantMemberServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserTenantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantMemberServiceListUserTenants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserTenants(ctx, req.(*ListUserTenantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserTenantsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type TenantMemberServiceHTTPClient interface {
+	// AddTenantMembers 将用户加入租户，已是成员的用户忽略
+	AddTenantMembers(ctx context.Context, req *AddTenantMembersRequest, opts ...http.CallOption) (rsp *AddTenantMembersResponse, err error)
+	// ListTenantMembers 获取租户的成员列表
+	ListTenantMembers(ctx context.Context, req *ListTenantMembersRequest, opts ...http.CallOption) (rsp *ListTenantMembersResponse, err error)
+	// ListUserTenants 获取用户所属的租户列表，user_id为me时表示当前用户，用于租户切换
+	ListUserTenants(ctx context.Context, req *ListUserTenantsRequest, opts ...http.CallOption) (rsp *ListUserTenantsResponse, err error)
+	// RemoveTenantMember 将用户移出租户，同时撤销其在该租户下的角色授予和部门关系
+	RemoveTenantMember(ctx context.Context, req *RemoveTenantMemberRequest, opts ...http.CallOption) (rsp *RemoveTenantMemberResponse, err error)
+	// UpdateTenantMember 修改成员的身份标签
+	UpdateTenantMember(ctx context.Context, req *UpdateTenantMemberRequest, opts ...http.CallOption) (rsp *UpdateTenantMemberResponse, err error)
+}
+
+type TenantMemberServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewTenantMemberServiceHTTPClient(client *http.Client) TenantMemberServiceHTTPClient {
+	return &TenantMemberServiceHTTPClientImpl{client}
+}
+
+// AddTenantMembers 将用户加入租户，已是成员的用户忽略
+func (c *TenantMemberServiceHTTPClientImpl) AddTenantMembers(ctx context.Context, in *AddTenantMembersRequest, opts ...http.CallOption) (*AddTenantMembersResponse, error) {
+	var out AddTenantMembersResponse
+	pattern := "/v1/tenants/{tenant_id}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantMemberServiceAddTenantMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTenantMembers 获取租户的成员列表
+func (c *TenantMemberServiceHTTPClientImpl) ListTenantMembers(ctx context.Context, in *ListTenantMembersRequest, opts ...http.CallOption) (*ListTenantMembersResponse, error) {
+	var out ListTenantMembersResponse
+	pattern := "/v1/tenants/{tenant_id}/members"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantMemberServiceListTenantMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUserTenants 获取用户所属的租户列表，user_id为me时表示当前用户，用于租户切换
+func (c *TenantMemberServiceHTTPClientImpl) ListUserTenants(ctx context.Context, in *ListUserTenantsRequest, opts ...http.CallOption) (*ListUserTenantsResponse, error) {
+	var out ListUserTenantsResponse
+	pattern := "/v1/users/{user_id}/tenants"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantMemberServiceListUserTenants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveTenantMember 将用户移出租户，同时撤销其在该租户下的角色授予和部门关系
+func (c *TenantMemberServiceHTTPClientImpl) RemoveTenantMember(ctx context.Context, in *RemoveTenantMemberRequest, opts ...http.CallOption) (*RemoveTenantMemberResponse, error) {
+	var out RemoveTenantMemberResponse
+	pattern := "/v1/tenants/{tenant_id}/members/{user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantMemberServiceRemoveTenantMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTenantMember 修改成员的身份标签
+func (c *TenantMemberServiceHTTPClientImpl) UpdateTenantMember(ctx context.Context, in *UpdateTenantMemberRequest, opts ...http.CallOption) (*UpdateTenantMemberResponse, error) {
+	var out UpdateTenantMemberResponse
+	pattern := "/v1/tenants/{tenant_id}/members/{user_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantMemberServiceUpdateTenantMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,1054 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.0--rc1
+// source: admin/v1/tenant_setting.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 设置项定义
+type SettingDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"` // password、session、locale、login、branding
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                                     // bool、int、string、string_list
+	DefaultValue  string                 `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"` // JSON编码的默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettingDefinition) Reset() {
+	*x = SettingDefinition{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingDefinition) ProtoMessage() {}
+
+func (x *SettingDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingDefinition.ProtoReflect.Descriptor instead.
+func (*SettingDefinition) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SettingDefinition) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SettingDefinition) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SettingDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SettingDefinition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SettingDefinition) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+// 获取设置项定义请求
+type ListSettingDefinitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingDefinitionsRequest) Reset() {
+	*x = ListSettingDefinitionsRequest{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingDefinitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingDefinitionsRequest) ProtoMessage() {}
+
+func (x *ListSettingDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSettingDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{1}
+}
+
+// 获取设置项定义响应
+type ListSettingDefinitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Definitions   []*SettingDefinition   `protobuf:"bytes,4,rep,name=definitions,proto3" json:"definitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingDefinitionsResponse) Reset() {
+	*x = ListSettingDefinitionsResponse{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingDefinitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingDefinitionsResponse) ProtoMessage() {}
+
+func (x *ListSettingDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSettingDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSettingDefinitionsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListSettingDefinitionsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListSettingDefinitionsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListSettingDefinitionsResponse) GetDefinitions() []*SettingDefinition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
+// 设置项的生效值
+type TenantSettingValue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value          string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                                           // JSON编码的生效值
+	SourceTenantId string                 `protobuf:"bytes,3,opt,name=source_tenant_id,json=sourceTenantId,proto3" json:"source_tenant_id,omitempty"` // 值来源的租户ID，为空表示使用默认值
+	Overridden     bool                   `protobuf:"varint,4,opt,name=overridden,proto3" json:"overridden,omitempty"`                                // 是否由租户自身设置
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TenantSettingValue) Reset() {
+	*x = TenantSettingValue{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSettingValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSettingValue) ProtoMessage() {}
+
+func (x *TenantSettingValue) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSettingValue.ProtoReflect.Descriptor instead.
+func (*TenantSettingValue) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TenantSettingValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TenantSettingValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TenantSettingValue) GetSourceTenantId() string {
+	if x != nil {
+		return x.SourceTenantId
+	}
+	return ""
+}
+
+func (x *TenantSettingValue) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+// 获取租户设置请求
+type GetTenantSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantSettingsRequest) Reset() {
+	*x = GetTenantSettingsRequest{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantSettingsRequest) ProtoMessage() {}
+
+func (x *GetTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTenantSettingsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// 获取租户设置响应
+type GetTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Settings      []*TenantSettingValue  `protobuf:"bytes,4,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantSettingsResponse) Reset() {
+	*x = GetTenantSettingsResponse{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantSettingsResponse) ProtoMessage() {}
+
+func (x *GetTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTenantSettingsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *GetTenantSettingsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetTenantSettingsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetTenantSettingsResponse) GetSettings() []*TenantSettingValue {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// 修改租户设置请求
+type UpdateTenantSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key到JSON编码的值
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantSettingsRequest) Reset() {
+	*x = UpdateTenantSettingsRequest{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTenantSettingsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantSettingsRequest) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *UpdateTenantSettingsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 修改租户设置响应，返回修改后所有设置项的生效值
+type UpdateTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Settings      []*TenantSettingValue  `protobuf:"bytes,4,rep,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantSettingsResponse) Reset() {
+	*x = UpdateTenantSettingsResponse{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTenantSettingsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *UpdateTenantSettingsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateTenantSettingsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UpdateTenantSettingsResponse) GetSettings() []*TenantSettingValue {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// 恢复继承请求
+type ResetTenantSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetTenantSettingRequest) Reset() {
+	*x = ResetTenantSettingRequest{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetTenantSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetTenantSettingRequest) ProtoMessage() {}
+
+func (x *ResetTenantSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetTenantSettingRequest.ProtoReflect.Descriptor instead.
+func (*ResetTenantSettingRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResetTenantSettingRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ResetTenantSettingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ResetTenantSettingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 恢复继承响应，返回该设置项恢复后的生效值
+type ResetTenantSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Setting       *TenantSettingValue    `protobuf:"bytes,4,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetTenantSettingResponse) Reset() {
+	*x = ResetTenantSettingResponse{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetTenantSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetTenantSettingResponse) ProtoMessage() {}
+
+func (x *ResetTenantSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetTenantSettingResponse.ProtoReflect.Descriptor instead.
+func (*ResetTenantSettingResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResetTenantSettingResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ResetTenantSettingResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ResetTenantSettingResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ResetTenantSettingResponse) GetSetting() *TenantSettingValue {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+// 设置变更记录
+type TenantSettingLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                     // SET、RESET
+	OldValue      string                 `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // 变更前租户自身的值，为空表示变更前为继承值
+	NewValue      string                 `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // 变更后的值，RESET时为空
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,7,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantSettingLog) Reset() {
+	*x = TenantSettingLog{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSettingLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSettingLog) ProtoMessage() {}
+
+func (x *TenantSettingLog) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSettingLog.ProtoReflect.Descriptor instead.
+func (*TenantSettingLog) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TenantSettingLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *TenantSettingLog) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 获取变更记录请求
+type ListTenantSettingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // 为空时返回所有设置项的记录
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantSettingHistoryRequest) Reset() {
+	*x = ListTenantSettingHistoryRequest{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantSettingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantSettingHistoryRequest) ProtoMessage() {}
+
+func (x *ListTenantSettingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantSettingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantSettingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTenantSettingHistoryRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListTenantSettingHistoryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListTenantSettingHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTenantSettingHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取变更记录响应
+type ListTenantSettingHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Logs          []*TenantSettingLog    `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantSettingHistoryResponse) Reset() {
+	*x = ListTenantSettingHistoryResponse{}
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantSettingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantSettingHistoryResponse) ProtoMessage() {}
+
+func (x *ListTenantSettingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_tenant_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantSettingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantSettingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_tenant_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTenantSettingHistoryResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListTenantSettingHistoryResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListTenantSettingHistoryResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListTenantSettingHistoryResponse) GetLogs() []*TenantSettingLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListTenantSettingHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_admin_v1_tenant_setting_proto protoreflect.FileDescriptor
+
+const file_admin_v1_tenant_setting_proto_rawDesc = "" +
+	"\n" +
+	"\x1dadmin/v1/tenant_setting.proto\x12\badmin.v1\x1a\x1cgoogle/api/annotations.proto\"\x96\x01\n" +
+	"\x11SettingDefinition\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\tR\fdefaultValue\"\x1f\n" +
+	"\x1dListSettingDefinitionsRequest\"\x9d\x01\n" +
+	"\x1eListSettingDefinitionsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12=\n" +
+	"\vdefinitions\x18\x04 \x03(\v2\x1b.admin.v1.SettingDefinitionR\vdefinitions\"\x86\x01\n" +
+	"\x12TenantSettingValue\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12(\n" +
+	"\x10source_tenant_id\x18\x03 \x01(\tR\x0esourceTenantId\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x04 \x01(\bR\n" +
+	"overridden\"7\n" +
+	"\x18GetTenantSettingsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x93\x01\n" +
+	"\x19GetTenantSettingsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x128\n" +
+	"\bsettings\x18\x04 \x03(\v2\x1c.admin.v1.TenantSettingValueR\bsettings\"\xd8\x01\n" +
+	"\x1bUpdateTenantSettingsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12I\n" +
+	"\x06values\x18\x02 \x03(\v21.admin.v1.UpdateTenantSettingsRequest.ValuesEntryR\x06values\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x01\n" +
+	"\x1cUpdateTenantSettingsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x128\n" +
+	"\bsettings\x18\x04 \x03(\v2\x1c.admin.v1.TenantSettingValueR\bsettings\"b\n" +
+	"\x19ResetTenantSettingRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x92\x01\n" +
+	"\x1aResetTenantSettingResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x126\n" +
+	"\asetting\x18\x04 \x01(\v2\x1c.admin.v1.TenantSettingValueR\asetting\"\xde\x01\n" +
+	"\x10TenantSettingLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1b\n" +
+	"\told_value\x18\x04 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x05 \x01(\tR\bnewValue\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\a \x01(\tR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x81\x01\n" +
+	"\x1fListTenantSettingHistoryRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xa6\x01\n" +
+	" ListTenantSettingHistoryResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12.\n" +
+	"\x04logs\x18\x04 \x03(\v2\x1a.admin.v1.TenantSettingLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total2\xf6\x05\n" +
+	"\x0eSettingService\x12\x8d\x01\n" +
+	"\x16ListSettingDefinitions\x12'.admin.v1.ListSettingDefinitionsRequest\x1a(.admin.v1.ListSettingDefinitionsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/settings/definitions\x12\x86\x01\n" +
+	"\x11GetTenantSettings\x12\".admin.v1.GetTenantSettingsRequest\x1a#.admin.v1.GetTenantSettingsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/tenants/{tenant_id}/settings\x12\x92\x01\n" +
+	"\x14UpdateTenantSettings\x12%.admin.v1.UpdateTenantSettingsRequest\x1a&.admin.v1.UpdateTenantSettingsResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v1/tenants/{tenant_id}/settings\x12\x8f\x01\n" +
+	"\x12ResetTenantSetting\x12#.admin.v1.ResetTenantSettingRequest\x1a$.admin.v1.ResetTenantSettingResponse\".\x82\xd3\xe4\x93\x02(*&/v1/tenants/{tenant_id}/settings/{key}\x12\xa3\x01\n" +
+	"\x18ListTenantSettingHistory\x12).admin.v1.ListTenantSettingHistoryRequest\x1a*.admin.v1.ListTenantSettingHistoryResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/tenants/{tenant_id}/settings/historyB+Z)github.com/yc-alpha/admin/api/admin/v1;v1b\x06proto3"
+
+var (
+	file_admin_v1_tenant_setting_proto_rawDescOnce sync.Once
+	file_admin_v1_tenant_setting_proto_rawDescData []byte
+)
+
+func file_admin_v1_tenant_setting_proto_rawDescGZIP() []byte {
+	file_admin_v1_tenant_setting_proto_rawDescOnce.Do(func() {
+		file_admin_v1_tenant_setting_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_setting_proto_rawDesc), len(file_admin_v1_tenant_setting_proto_rawDesc)))
+	})
+	return file_admin_v1_tenant_setting_proto_rawDescData
+}
+
+var file_admin_v1_tenant_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_admin_v1_tenant_setting_proto_goTypes = []any{
+	(*SettingDefinition)(nil),                // 0: admin.v1.SettingDefinition
+	(*ListSettingDefinitionsRequest)(nil),    // 1: admin.v1.ListSettingDefinitionsRequest
+	(*ListSettingDefinitionsResponse)(nil),   // 2: admin.v1.ListSettingDefinitionsResponse
+	(*TenantSettingValue)(nil),               // 3: admin.v1.TenantSettingValue
+	(*GetTenantSettingsRequest)(nil),         // 4: admin.v1.GetTenantSettingsRequest
+	(*GetTenantSettingsResponse)(nil),        // 5: admin.v1.GetTenantSettingsResponse
+	(*UpdateTenantSettingsRequest)(nil),      // 6: admin.v1.UpdateTenantSettingsRequest
+	(*UpdateTenantSettingsResponse)(nil),     // 7: admin.v1.UpdateTenantSettingsResponse
+	(*ResetTenantSettingRequest)(nil),        // 8: admin.v1.ResetTenantSettingRequest
+	(*ResetTenantSettingResponse)(nil),       // 9: admin.v1.ResetTenantSettingResponse
+	(*TenantSettingLog)(nil),                 // 10: admin.v1.TenantSettingLog
+	(*ListTenantSettingHistoryRequest)(nil),  // 11: admin.v1.ListTenantSettingHistoryRequest
+	(*ListTenantSettingHistoryResponse)(nil), // 12: admin.v1.ListTenantSettingHistoryResponse
+	nil,                                      // 13: admin.v1.UpdateTenantSettingsRequest.ValuesEntry
+}
+var file_admin_v1_tenant_setting_proto_depIdxs = []int32{
+	0,  // 0: admin.v1.ListSettingDefinitionsResponse.definitions:type_name -> admin.v1.SettingDefinition
+	3,  // 1: admin.v1.GetTenantSettingsResponse.settings:type_name -> admin.v1.TenantSettingValue
+	13, // 2: admin.v1.UpdateTenantSettingsRequest.values:type_name -> admin.v1.UpdateTenantSettingsRequest.ValuesEntry
+	3,  // 3: admin.v1.UpdateTenantSettingsResponse.settings:type_name -> admin.v1.TenantSettingValue
+	3,  // 4: admin.v1.ResetTenantSettingResponse.setting:type_name -> admin.v1.TenantSettingValue
+	10, // 5: admin.v1.ListTenantSettingHistoryResponse.logs:type_name -> admin.v1.TenantSettingLog
+	1,  // 6: admin.v1.SettingService.ListSettingDefinitions:input_type -> admin.v1.ListSettingDefinitionsRequest
+	4,  // 7: admin.v1.SettingService.GetTenantSettings:input_type -> admin.v1.GetTenantSettingsRequest
+	6,  // 8: admin.v1.SettingService.UpdateTenantSettings:input_type -> admin.v1.UpdateTenantSettingsRequest
+	8,  // 9: admin.v1.SettingService.ResetTenantSetting:input_type -> admin.v1.ResetTenantSettingRequest
+	11, // 10: admin.v1.SettingService.ListTenantSettingHistory:input_type -> admin.v1.ListTenantSettingHistoryRequest
+	2,  // 11: admin.v1.SettingService.ListSettingDefinitions:output_type -> admin.v1.ListSettingDefinitionsResponse
+	5,  // 12: admin.v1.SettingService.GetTenantSettings:output_type -> admin.v1.GetTenantSettingsResponse
+	7,  // 13: admin.v1.SettingService.UpdateTenantSettings:output_type -> admin.v1.UpdateTenantSettingsResponse
+	9,  // 14: admin.v1.SettingService.ResetTenantSetting:output_type -> admin.v1.ResetTenantSettingResponse
+	12, // 15: admin.v1.SettingService.ListTenantSettingHistory:output_type -> admin.v1.ListTenantSettingHistoryResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_tenant_setting_proto_init() }
+func file_admin_v1_tenant_setting_proto_init() {
+	if File_admin_v1_tenant_setting_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_tenant_setting_proto_rawDesc), len(file_admin_v1_tenant_setting_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_tenant_setting_proto_goTypes,
+		DependencyIndexes: file_admin_v1_tenant_setting_proto_depIdxs,
+		MessageInfos:      file_admin_v1_tenant_setting_proto_msgTypes,
+	}.Build()
+	File_admin_v1_tenant_setting_proto = out.File
+	file_admin_v1_tenant_setting_proto_goTypes = nil
+	file_admin_v1_tenant_setting_proto_depIdxs = nil
+}
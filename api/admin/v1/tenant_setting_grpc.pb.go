@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.0--rc1
+// source: admin/v1/tenant_setting.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SettingService_ListSettingDefinitions_FullMethodName   = "/admin.v1.SettingService/ListSettingDefinitions"
+	SettingService_GetTenantSettings_FullMethodName        = "/admin.v1.SettingService/GetTenantSettings"
+	SettingService_UpdateTenantSettings_FullMethodName     = "/admin.v1.SettingService/UpdateTenantSettings"
+	SettingService_ResetTenantSetting_FullMethodName       = "/admin.v1.SettingService/ResetTenantSetting"
+	SettingService_ListTenantSettingHistory_FullMethodName = "/admin.v1.SettingService/ListTenantSettingHistory"
+)
+
+// SettingServiceClient is the client API for SettingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 租户设置服务，设置值均为JSON编码的字符串
+type SettingServiceClient interface {
+	// 获取所有设置项的定义和默认值
+	ListSettingDefinitions(ctx context.Context, in *ListSettingDefinitionsRequest, opts ...grpc.CallOption) (*ListSettingDefinitionsResponse, error)
+	// 获取租户所有设置项的生效值，未设置的项继承上级租户或使用默认值
+	GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*GetTenantSettingsResponse, error)
+	// 修改租户设置，所有值校验通过后一起保存
+	UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...grpc.CallOption) (*UpdateTenantSettingsResponse, error)
+	// 删除租户自身的设置值，恢复继承
+	ResetTenantSetting(ctx context.Context, in *ResetTenantSettingRequest, opts ...grpc.CallOption) (*ResetTenantSettingResponse, error)
+	// 获取租户设置的变更记录
+	ListTenantSettingHistory(ctx context.Context, in *ListTenantSettingHistoryRequest, opts ...grpc.CallOption) (*ListTenantSettingHistoryResponse, error)
+}
+
+type settingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettingServiceClient(cc grpc.ClientConnInterface) SettingServiceClient {
+	return &settingServiceClient{cc}
+}
+
+func (c *settingServiceClient) ListSettingDefinitions(ctx context.Context, in *ListSettingDefinitionsRequest, opts ...grpc.CallOption) (*ListSettingDefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettingDefinitionsResponse)
+	err := c.cc.Invoke(ctx, SettingService_ListSettingDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingServiceClient) GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*GetTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, SettingService_GetTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingServiceClient) UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...grpc.CallOption) (*UpdateTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, SettingService_UpdateTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingServiceClient) ResetTenantSetting(ctx context.Context, in *ResetTenantSettingRequest, opts ...grpc.CallOption) (*ResetTenantSettingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetTenantSettingResponse)
+	err := c.cc.Invoke(ctx, SettingService_ResetTenantSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingServiceClient) ListTenantSettingHistory(ctx context.Context, in *ListTenantSettingHistoryRequest, opts ...grpc.CallOption) (*ListTenantSettingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantSettingHistoryResponse)
+	err := c.cc.Invoke(ctx, SettingService_ListTenantSettingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettingServiceServer is the server API for SettingService service.
+// All implementations must embed UnimplementedSettingServiceServer
+// for forward compatibility.
+//
+// 租户设置服务，设置值均为JSON编码的字符串
+type SettingServiceServer interface {
+	// 获取所有设置项的定义和默认值
+	ListSettingDefinitions(context.Context, *ListSettingDefinitionsRequest) (*ListSettingDefinitionsResponse, error)
+	// 获取租户所有设置项的生效值，未设置的项继承上级租户或使用默认值
+	GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error)
+	// 修改租户设置，所有值校验通过后一起保存
+	UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*UpdateTenantSettingsResponse, error)
+	// 删除租户自身的设置值，恢复继承
+	ResetTenantSetting(context.Context, *ResetTenantSettingRequest) (*ResetTenantSettingResponse, error)
+	// 获取租户设置的变更记录
+	ListTenantSettingHistory(context.Context, *ListTenantSettingHistoryRequest) (*ListTenantSettingHistoryResponse, error)
+	mustEmbedUnimplementedSettingServiceServer()
+}
+
+// UnimplementedSettingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSettingServiceServer struct{}
+
+func (UnimplementedSettingServiceServer) ListSettingDefinitions(context.Context, *ListSettingDefinitionsRequest) (*ListSettingDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettingDefinitions not implemented")
+}
+func (UnimplementedSettingServiceServer) GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantSettings not implemented")
+}
+func (UnimplementedSettingServiceServer) UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*UpdateTenantSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantSettings not implemented")
+}
+func (UnimplementedSettingServiceServer) ResetTenantSetting(context.Context, *ResetTenantSettingRequest) (*ResetTenantSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTenantSetting not implemented")
+}
+func (UnimplementedSettingServiceServer) ListTenantSettingHistory(context.Context, *ListTenantSettingHistoryRequest) (*ListTenantSettingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenantSettingHistory not implemented")
+}
+func (UnimplementedSettingServiceServer) mustEmbedUnimplementedSettingServiceServer() {}
+func (UnimplementedSettingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSettingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettingServiceServer will
+// result in compilation errors.
+type UnsafeSettingServiceServer interface {
+	mustEmbedUnimplementedSettingServiceServer()
+}
+
+func RegisterSettingServiceServer(s grpc.ServiceRegistrar, srv SettingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSettingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SettingService_ServiceDesc, srv)
+}
+
+func _SettingService_ListSettingDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettingDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).ListSettingDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_ListSettingDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).ListSettingDefinitions(ctx, req.(*ListSettingDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingService_GetTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).GetTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_GetTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).GetTenantSettings(ctx, req.(*GetTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingService_UpdateTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).UpdateTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_UpdateTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).UpdateTenantSettings(ctx, req.(*UpdateTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingService_ResetTenantSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTenantSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).ResetTenantSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_ResetTenantSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).ResetTenantSetting(ctx, req.(*ResetTenantSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingService_ListTenantSettingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantSettingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).ListTenantSettingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_ListTenantSettingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).ListTenantSettingHistory(ctx, req.(*ListTenantSettingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettingService_ServiceDesc is the grpc.ServiceDesc for SettingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.v1.SettingService",
+	HandlerType: (*SettingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSettingDefinitions",
+			Handler:    _SettingService_ListSettingDefinitions_Handler,
+		},
+		{
+			MethodName: "GetTenantSettings",
+			Handler:    _SettingService_GetTenantSettings_Handler,
+		},
+		{
+			MethodName: "UpdateTenantSettings",
+			Handler:    _SettingService_UpdateTenantSettings_Handler,
+		},
+		{
+			MethodName: "ResetTenantSetting",
+			Handler:    _SettingService_ResetTenantSetting_Handler,
+		},
+		{
+			MethodName: "ListTenantSettingHistory",
+			Handler:    _SettingService_ListTenantSettingHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1/tenant_setting.proto",
+}
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.8.4
+// - protoc             v5.29.0--rc1
+// source: admin/v1/tenant_setting.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationSettingServiceGetTenantSettings = "/admin.v1.SettingService/GetTenantSettings"
+const OperationSettingServiceListSettingDefinitions = "/admin.v1.SettingService/ListSettingDefinitions"
+const OperationSettingServiceListTenantSettingHistory = "/admin.v1.SettingService/ListTenantSettingHistory"
+const OperationSettingServiceResetTenantSetting = "/admin.v1.SettingService/ResetTenantSetting"
+const OperationSettingServiceUpdateTenantSettings = "/admin.v1.SettingService/UpdateTenantSettings"
+
+type SettingServiceHTTPServer interface {
+	// GetTenantSettings 获取租户所有设置项的生效值，未设置的项继承上级租户或使用默认值
+	GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error)
+	// ListSettingDefinitions 获取所有设置项的定义和默认值
+	ListSettingDefinitions(context.Context, *ListSettingDefinitionsRequest) (*ListSettingDefinitionsResponse, error)
+	// ListTenantSettingHistory 获取租户设置的变更记录
+	ListTenantSettingHistory(context.Context, *ListTenantSettingHistoryRequest) (*ListTenantSettingHistoryResponse, error)
+	// ResetTenantSetting 删除租户自身的设置值，恢复继承
+	ResetTenantSetting(context.Context, *ResetTenantSettingRequest) (*ResetTenantSettingResponse, error)
+	// UpdateTenantSettings 修改租户设置，所有值校验通过后一起保存
+	UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*UpdateTenantSettingsResponse, error)
+}
+
+func RegisterSettingServiceHTTPServer(s *http.Server, srv SettingServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/settings/definitions", _SettingService_ListSettingDefinitions0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/settings", _SettingService_GetTenantSettings0_HTTP_Handler(srv))
+	r.PUT("/v1/tenants/{tenant_id}/settings", _SettingService_UpdateTenantSettings0_HTTP_Handler(srv))
+	r.DELETE("/v1/tenants/{tenant_id}/settings/{key}", _SettingService_ResetTenantSetting0_HTTP_Handler(srv))
+	r.GET("/v1/tenants/{tenant_id}/settings/history", _SettingService_ListTenantSettingHistory0_HTTP_Handler(srv))
+}
+
+func _SettingService_ListSettingDefinitions0_HTTP_Handler(srv SettingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSettingDefinitionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSettingServiceListSettingDefinitions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSettingDefinitions(ctx, req.(*ListSettingDefinitionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSettingDefinitionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SettingService_GetTenantSettings0_HTTP_Handler(srv SettingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantSettingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSettingServiceGetTenantSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantSettings(ctx, req.(*GetTenantSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTenantSettingsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SettingService_UpdateTenantSettings0_HTTP_Handler(srv SettingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTenantSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSettingServiceUpdateTenantSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTenantSettings(ctx, req.(*UpdateTenantSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTenantSettingsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SettingService_ResetTenantSetting0_HTTP_Handler(srv SettingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetTenantSettingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSettingServiceResetTenantSetting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetTenantSetting(ctx, req.(*ResetTenantSettingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetTenantSettingResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SettingService_ListTenantSettingHistory0_HTTP_Handler(srv SettingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTenantSettingHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSettingServiceListTenantSettingHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantSettingHistory(ctx, req.(*ListTenantSettingHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantSettingHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SettingServiceHTTPClient interface {
+	// GetTenantSettings 获取租户所有设置项的生效值，未设置的项继承上级租户或使用默认值
+	GetTenantSettings(ctx context.Context, req *GetTenantSettingsRequest, opts ...http.CallOption) (rsp *GetTenantSettingsResponse, err error)
+	// ListSettingDefinitions 获取所有设置项的定义和默认值
+	ListSettingDefinitions(ctx context.Context, req *ListSettingDefinitionsRequest, opts ...http.CallOption) (rsp *ListSettingDefinitionsResponse, err error)
+	// ListTenantSettingHistory 获取租户设置的变更记录
+	ListTenantSettingHistory(ctx context.Context, req *ListTenantSettingHistoryRequest, opts ...http.CallOption) (rsp *ListTenantSettingHistoryResponse, err error)
+	// ResetTenantSetting 删除租户自身的设置值，恢复继承
+	ResetTenantSetting(ctx context.Context, req *ResetTenantSettingRequest, opts ...http.CallOption) (rsp *ResetTenantSettingResponse, err error)
+	// UpdateTenantSettings 修改租户设置，所有值校验通过后一起保存
+	UpdateTenantSettings(ctx context.Context, req *UpdateTenantSettingsRequest, opts ...http.CallOption) (rsp *UpdateTenantSettingsResponse, err error)
+}
+
+type SettingServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSettingServiceHTTPClient(client *http.Client) SettingServiceHTTPClient {
+	return &SettingServiceHTTPClientImpl{client}
+}
+
+// GetTenantSettings 获取租户所有设置项的生效值，未设置的项继承上级租户或使用默认值
+func (c *SettingServiceHTTPClientImpl) GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...http.CallOption) (*GetTenantSettingsResponse, error) {
+	var out GetTenantSettingsResponse
+	pattern := "/v1/tenants/{tenant_id}/settings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSettingServiceGetTenantSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSettingDefinitions 获取所有设置项的定义和默认值
+func (c *SettingServiceHTTPClientImpl) ListSettingDefinitions(ctx context.Context, in *ListSettingDefinitionsRequest, opts ...http.CallOption) (*ListSettingDefinitionsResponse, error) {
+	var out ListSettingDefinitionsResponse
+	pattern := "/v1/settings/definitions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSettingServiceListSettingDefinitions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTenantSettingHistory 获取租户设置的变更记录
+func (c *SettingServiceHTTPClientImpl) ListTenantSettingHistory(ctx context.Context, in *ListTenantSettingHistoryRequest, opts ...http.CallOption) (*ListTenantSettingHistoryResponse, error) {
+	var out ListTenantSettingHistoryResponse
+	pattern := "/v1/tenants/{tenant_id}/settings/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSettingServiceListTenantSettingHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetTenantSetting 删除租户自身的设置值，恢复继承
+func (c *SettingServiceHTTPClientImpl) ResetTenantSetting(ctx context.Context, in *ResetTenantSettingRequest, opts ...http.CallOption) (*ResetTenantSettingResponse, error) {
+	var out ResetTenantSettingResponse
+	pattern := "/v1/tenants/{tenant_id}/settings/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSettingServiceResetTenantSetting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTenantSettings 修改租户设置，所有值校验通过后一起保存
+func (c *SettingServiceHTTPClientImpl) UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...http.CallOption) (*UpdateTenantSettingsResponse, error) {
+	var out UpdateTenantSettingsResponse
+	pattern := "/v1/tenants/{tenant_id}/settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSettingServiceUpdateTenantSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
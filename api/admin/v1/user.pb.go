@@ -541,6 +541,7 @@ type CreateUserRequest struct {
 	LastLoginIp   string                 `protobuf:"bytes,12,opt,name=last_login_ip,json=lastLoginIp,proto3" json:"last_login_ip,omitempty"`
 	LastLoginAt   string                 `protobuf:"bytes,13,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
 	UserAccounts  []*UserAccount         `protobuf:"bytes,14,rep,name=user_accounts,json=userAccounts,proto3" json:"user_accounts,omitempty"`
+	TenantId      string                 `protobuf:"bytes,15,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 创建后加入的租户，计入该租户的成员配额
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -673,6 +674,13 @@ func (x *CreateUserRequest) GetUserAccounts() []*UserAccount {
 	return nil
 }
 
+func (x *CreateUserRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -1852,7 +1860,7 @@ const file_admin_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xd7\x03\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xf4\x03\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x14\n" +
@@ -1868,7 +1876,8 @@ const file_admin_v1_user_proto_rawDesc = "" +
 	"\blanguage\x18\v \x01(\tR\blanguage\x12\"\n" +
 	"\rlast_login_ip\x18\f \x01(\tR\vlastLoginIp\x12\"\n" +
 	"\rlast_login_at\x18\r \x01(\tR\vlastLoginAt\x12:\n" +
-	"\ruser_accounts\x18\x0e \x03(\v2\x15.admin.v1.UserAccountR\fuserAccounts\"v\n" +
+	"\ruser_accounts\x18\x0e \x03(\v2\x15.admin.v1.UserAccountR\fuserAccounts\x12\x1b\n" +
+	"\ttenant_id\x18\x0f \x01(\tR\btenantId\"v\n" +
 	"\x12CreateUserResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\"\n" +
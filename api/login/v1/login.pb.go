@@ -97,6 +97,10 @@ type LoginResponse struct {
 	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
 	Msg           string                 `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`                                   // 访问令牌(JWT)
+	RefreshToken  string                 `protobuf:"bytes,6,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 刷新令牌
+	ExpiresIn     int64                  `protobuf:"varint,7,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`         // 访问令牌有效期(秒)
+	UserInfo      *v1.SimpleUser         `protobuf:"bytes,8,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`             // 用户信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,6 +156,79 @@ func (x *LoginResponse) GetMsg() string {
 	return ""
 }
 
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginResponse) GetUserInfo() *v1.SimpleUser {
+	if x != nil {
+		return x.UserInfo
+	}
+	return nil
+}
+
+// 刷新令牌请求
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_login_v1_login_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_login_v1_login_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_login_v1_login_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -160,7 +237,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[2]
+	mi := &file_login_v1_login_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +249,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[2]
+	mi := &file_login_v1_login_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +262,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{2}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{3}
 }
 
 type LogoutResponse struct {
@@ -199,7 +276,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_login_v1_login_proto_msgTypes[3]
+	mi := &file_login_v1_login_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -211,7 +288,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[3]
+	mi := &file_login_v1_login_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +301,7 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{3}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LogoutResponse) GetResult() bool {
@@ -258,7 +335,7 @@ type GetCaptchaRequest struct {
 
 func (x *GetCaptchaRequest) Reset() {
 	*x = GetCaptchaRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[4]
+	mi := &file_login_v1_login_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +347,7 @@ func (x *GetCaptchaRequest) String() string {
 func (*GetCaptchaRequest) ProtoMessage() {}
 
 func (x *GetCaptchaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[4]
+	mi := &file_login_v1_login_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +360,7 @@ func (x *GetCaptchaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCaptchaRequest.ProtoReflect.Descriptor instead.
 func (*GetCaptchaRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{4}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetCaptchaRequest) GetCaptchaType() string {
@@ -305,7 +382,7 @@ type GetCaptchaResponse struct {
 
 func (x *GetCaptchaResponse) Reset() {
 	*x = GetCaptchaResponse{}
-	mi := &file_login_v1_login_proto_msgTypes[5]
+	mi := &file_login_v1_login_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +394,7 @@ func (x *GetCaptchaResponse) String() string {
 func (*GetCaptchaResponse) ProtoMessage() {}
 
 func (x *GetCaptchaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[5]
+	mi := &file_login_v1_login_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +407,7 @@ func (x *GetCaptchaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCaptchaResponse.ProtoReflect.Descriptor instead.
 func (*GetCaptchaResponse) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{5}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetCaptchaResponse) GetCaptchaId() string {
@@ -367,7 +444,7 @@ type VerifyCaptchaRequest struct {
 
 func (x *VerifyCaptchaRequest) Reset() {
 	*x = VerifyCaptchaRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[6]
+	mi := &file_login_v1_login_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -379,7 +456,7 @@ func (x *VerifyCaptchaRequest) String() string {
 func (*VerifyCaptchaRequest) ProtoMessage() {}
 
 func (x *VerifyCaptchaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[6]
+	mi := &file_login_v1_login_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +469,7 @@ func (x *VerifyCaptchaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCaptchaRequest.ProtoReflect.Descriptor instead.
 func (*VerifyCaptchaRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{6}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *VerifyCaptchaRequest) GetCaptchaId() string {
@@ -434,7 +511,7 @@ type VerifyCaptchaResponse struct {
 
 func (x *VerifyCaptchaResponse) Reset() {
 	*x = VerifyCaptchaResponse{}
-	mi := &file_login_v1_login_proto_msgTypes[7]
+	mi := &file_login_v1_login_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +523,7 @@ func (x *VerifyCaptchaResponse) String() string {
 func (*VerifyCaptchaResponse) ProtoMessage() {}
 
 func (x *VerifyCaptchaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[7]
+	mi := &file_login_v1_login_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +536,7 @@ func (x *VerifyCaptchaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCaptchaResponse.ProtoReflect.Descriptor instead.
 func (*VerifyCaptchaResponse) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{7}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VerifyCaptchaResponse) GetSuccess() bool {
@@ -487,7 +564,7 @@ type SendSmsCodeRequest struct {
 
 func (x *SendSmsCodeRequest) Reset() {
 	*x = SendSmsCodeRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[8]
+	mi := &file_login_v1_login_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +576,7 @@ func (x *SendSmsCodeRequest) String() string {
 func (*SendSmsCodeRequest) ProtoMessage() {}
 
 func (x *SendSmsCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[8]
+	mi := &file_login_v1_login_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +589,7 @@ func (x *SendSmsCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSmsCodeRequest.ProtoReflect.Descriptor instead.
 func (*SendSmsCodeRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{8}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SendSmsCodeRequest) GetPhone() string {
@@ -540,7 +617,7 @@ type SendSmsCodeResponse struct {
 
 func (x *SendSmsCodeResponse) Reset() {
 	*x = SendSmsCodeResponse{}
-	mi := &file_login_v1_login_proto_msgTypes[9]
+	mi := &file_login_v1_login_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +629,7 @@ func (x *SendSmsCodeResponse) String() string {
 func (*SendSmsCodeResponse) ProtoMessage() {}
 
 func (x *SendSmsCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[9]
+	mi := &file_login_v1_login_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +642,7 @@ func (x *SendSmsCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSmsCodeResponse.ProtoReflect.Descriptor instead.
 func (*SendSmsCodeResponse) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{9}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SendSmsCodeResponse) GetSuccess() bool {
@@ -593,7 +670,7 @@ type LoginBySmsRequest struct {
 
 func (x *LoginBySmsRequest) Reset() {
 	*x = LoginBySmsRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[10]
+	mi := &file_login_v1_login_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +682,7 @@ func (x *LoginBySmsRequest) String() string {
 func (*LoginBySmsRequest) ProtoMessage() {}
 
 func (x *LoginBySmsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[10]
+	mi := &file_login_v1_login_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +695,7 @@ func (x *LoginBySmsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginBySmsRequest.ProtoReflect.Descriptor instead.
 func (*LoginBySmsRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{10}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LoginBySmsRequest) GetPhone() string {
@@ -647,7 +724,7 @@ type OAuthLoginRequest struct {
 
 func (x *OAuthLoginRequest) Reset() {
 	*x = OAuthLoginRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[11]
+	mi := &file_login_v1_login_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +736,7 @@ func (x *OAuthLoginRequest) String() string {
 func (*OAuthLoginRequest) ProtoMessage() {}
 
 func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[11]
+	mi := &file_login_v1_login_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +749,7 @@ func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthLoginRequest.ProtoReflect.Descriptor instead.
 func (*OAuthLoginRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{11}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OAuthLoginRequest) GetProvider() string {
@@ -706,7 +783,7 @@ type OAuthLoginResponse struct {
 
 func (x *OAuthLoginResponse) Reset() {
 	*x = OAuthLoginResponse{}
-	mi := &file_login_v1_login_proto_msgTypes[12]
+	mi := &file_login_v1_login_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +795,7 @@ func (x *OAuthLoginResponse) String() string {
 func (*OAuthLoginResponse) ProtoMessage() {}
 
 func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[12]
+	mi := &file_login_v1_login_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +808,7 @@ func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthLoginResponse.ProtoReflect.Descriptor instead.
 func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{12}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *OAuthLoginResponse) GetAuthUrl() string {
@@ -753,7 +830,7 @@ type OAuthCallbackRequest struct {
 
 func (x *OAuthCallbackRequest) Reset() {
 	*x = OAuthCallbackRequest{}
-	mi := &file_login_v1_login_proto_msgTypes[13]
+	mi := &file_login_v1_login_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +842,7 @@ func (x *OAuthCallbackRequest) String() string {
 func (*OAuthCallbackRequest) ProtoMessage() {}
 
 func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[13]
+	mi := &file_login_v1_login_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +855,7 @@ func (x *OAuthCallbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthCallbackRequest.ProtoReflect.Descriptor instead.
 func (*OAuthCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{13}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *OAuthCallbackRequest) GetProvider() string {
@@ -815,7 +892,7 @@ type OAuthCallbackResponse struct {
 
 func (x *OAuthCallbackResponse) Reset() {
 	*x = OAuthCallbackResponse{}
-	mi := &file_login_v1_login_proto_msgTypes[14]
+	mi := &file_login_v1_login_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +904,7 @@ func (x *OAuthCallbackResponse) String() string {
 func (*OAuthCallbackResponse) ProtoMessage() {}
 
 func (x *OAuthCallbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_login_v1_login_proto_msgTypes[14]
+	mi := &file_login_v1_login_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +917,7 @@ func (x *OAuthCallbackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthCallbackResponse.ProtoReflect.Descriptor instead.
 func (*OAuthCallbackResponse) Descriptor() ([]byte, []int) {
-	return file_login_v1_login_proto_rawDescGZIP(), []int{14}
+	return file_login_v1_login_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OAuthCallbackResponse) GetSuccess() bool {
@@ -880,11 +957,18 @@ const file_login_v1_login_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
 	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\"M\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\"\xe4\x01\n" +
 	"\rLoginResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x04 \x01(\tR\x03msg\"\x0f\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x06 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\a \x01(\x03R\texpiresIn\x12;\n" +
+	"\tuser_info\x18\b \x01(\v2\x1e.user_management.v1.SimpleUserR\buserInfo\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x0f\n" +
 	"\rLogoutRequest\"N\n" +
 	"\x0eLogoutResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
@@ -930,11 +1014,12 @@ const file_login_v1_login_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
 	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12;\n" +
-	"\tuser_info\x18\x04 \x01(\v2\x1e.user_management.v1.SimpleUserR\buserInfo2\x9f\x06\n" +
+	"\tuser_info\x18\x04 \x01(\v2\x1e.user_management.v1.SimpleUserR\buserInfo2\x85\a\n" +
 	"\fLoginService\x12N\n" +
 	"\x05Login\x12\x16.login.v1.LoginRequest\x1a\x17.login.v1.LoginResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/login\x12O\n" +
 	"\x06Logout\x12\x17.login.v1.LogoutRequest\x1a\x18.login.v1.LogoutResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/v1/logout\x12\\\n" +
+	"/v1/logout\x12d\n" +
+	"\fRefreshToken\x12\x1d.login.v1.RefreshTokenRequest\x1a\x17.login.v1.LoginResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/token/refresh\x12\\\n" +
 	"\n" +
 	"GetCaptcha\x12\x1b.login.v1.GetCaptchaRequest\x1a\x1c.login.v1.GetCaptchaResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/captcha\x12o\n" +
 	"\rVerifyCaptcha\x12\x1e.login.v1.VerifyCaptchaRequest\x1a\x1f.login.v1.VerifyCaptchaResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/captcha/verify\x12c\n" +
@@ -957,48 +1042,52 @@ func file_login_v1_login_proto_rawDescGZIP() []byte {
 	return file_login_v1_login_proto_rawDescData
 }
 
-var file_login_v1_login_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_login_v1_login_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_login_v1_login_proto_goTypes = []any{
 	(*LoginRequest)(nil),          // 0: login.v1.LoginRequest
 	(*LoginResponse)(nil),         // 1: login.v1.LoginResponse
-	(*LogoutRequest)(nil),         // 2: login.v1.LogoutRequest
-	(*LogoutResponse)(nil),        // 3: login.v1.LogoutResponse
-	(*GetCaptchaRequest)(nil),     // 4: login.v1.GetCaptchaRequest
-	(*GetCaptchaResponse)(nil),    // 5: login.v1.GetCaptchaResponse
-	(*VerifyCaptchaRequest)(nil),  // 6: login.v1.VerifyCaptchaRequest
-	(*VerifyCaptchaResponse)(nil), // 7: login.v1.VerifyCaptchaResponse
-	(*SendSmsCodeRequest)(nil),    // 8: login.v1.SendSmsCodeRequest
-	(*SendSmsCodeResponse)(nil),   // 9: login.v1.SendSmsCodeResponse
-	(*LoginBySmsRequest)(nil),     // 10: login.v1.LoginBySmsRequest
-	(*OAuthLoginRequest)(nil),     // 11: login.v1.OAuthLoginRequest
-	(*OAuthLoginResponse)(nil),    // 12: login.v1.OAuthLoginResponse
-	(*OAuthCallbackRequest)(nil),  // 13: login.v1.OAuthCallbackRequest
-	(*OAuthCallbackResponse)(nil), // 14: login.v1.OAuthCallbackResponse
-	(*v1.SimpleUser)(nil),         // 15: user_management.v1.SimpleUser
+	(*RefreshTokenRequest)(nil),   // 2: login.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),         // 3: login.v1.LogoutRequest
+	(*LogoutResponse)(nil),        // 4: login.v1.LogoutResponse
+	(*GetCaptchaRequest)(nil),     // 5: login.v1.GetCaptchaRequest
+	(*GetCaptchaResponse)(nil),    // 6: login.v1.GetCaptchaResponse
+	(*VerifyCaptchaRequest)(nil),  // 7: login.v1.VerifyCaptchaRequest
+	(*VerifyCaptchaResponse)(nil), // 8: login.v1.VerifyCaptchaResponse
+	(*SendSmsCodeRequest)(nil),    // 9: login.v1.SendSmsCodeRequest
+	(*SendSmsCodeResponse)(nil),   // 10: login.v1.SendSmsCodeResponse
+	(*LoginBySmsRequest)(nil),     // 11: login.v1.LoginBySmsRequest
+	(*OAuthLoginRequest)(nil),     // 12: login.v1.OAuthLoginRequest
+	(*OAuthLoginResponse)(nil),    // 13: login.v1.OAuthLoginResponse
+	(*OAuthCallbackRequest)(nil),  // 14: login.v1.OAuthCallbackRequest
+	(*OAuthCallbackResponse)(nil), // 15: login.v1.OAuthCallbackResponse
+	(*v1.SimpleUser)(nil),         // 16: user_management.v1.SimpleUser
 }
 var file_login_v1_login_proto_depIdxs = []int32{
-	15, // 0: login.v1.OAuthCallbackResponse.user_info:type_name -> user_management.v1.SimpleUser
-	0,  // 1: login.v1.LoginService.Login:input_type -> login.v1.LoginRequest
-	2,  // 2: login.v1.LoginService.Logout:input_type -> login.v1.LogoutRequest
-	4,  // 3: login.v1.LoginService.GetCaptcha:input_type -> login.v1.GetCaptchaRequest
-	6,  // 4: login.v1.LoginService.VerifyCaptcha:input_type -> login.v1.VerifyCaptchaRequest
-	8,  // 5: login.v1.LoginService.SendSmsCode:input_type -> login.v1.SendSmsCodeRequest
-	10, // 6: login.v1.LoginService.LoginBySms:input_type -> login.v1.LoginBySmsRequest
-	11, // 7: login.v1.LoginService.OAuthLogin:input_type -> login.v1.OAuthLoginRequest
-	13, // 8: login.v1.LoginService.OAuthCallback:input_type -> login.v1.OAuthCallbackRequest
-	1,  // 9: login.v1.LoginService.Login:output_type -> login.v1.LoginResponse
-	3,  // 10: login.v1.LoginService.Logout:output_type -> login.v1.LogoutResponse
-	5,  // 11: login.v1.LoginService.GetCaptcha:output_type -> login.v1.GetCaptchaResponse
-	7,  // 12: login.v1.LoginService.VerifyCaptcha:output_type -> login.v1.VerifyCaptchaResponse
-	9,  // 13: login.v1.LoginService.SendSmsCode:output_type -> login.v1.SendSmsCodeResponse
-	1,  // 14: login.v1.LoginService.LoginBySms:output_type -> login.v1.LoginResponse
-	12, // 15: login.v1.LoginService.OAuthLogin:output_type -> login.v1.OAuthLoginResponse
-	14, // 16: login.v1.LoginService.OAuthCallback:output_type -> login.v1.OAuthCallbackResponse
-	9,  // [9:17] is the sub-list for method output_type
-	1,  // [1:9] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	16, // 0: login.v1.LoginResponse.user_info:type_name -> user_management.v1.SimpleUser
+	16, // 1: login.v1.OAuthCallbackResponse.user_info:type_name -> user_management.v1.SimpleUser
+	0,  // 2: login.v1.LoginService.Login:input_type -> login.v1.LoginRequest
+	3,  // 3: login.v1.LoginService.Logout:input_type -> login.v1.LogoutRequest
+	2,  // 4: login.v1.LoginService.RefreshToken:input_type -> login.v1.RefreshTokenRequest
+	5,  // 5: login.v1.LoginService.GetCaptcha:input_type -> login.v1.GetCaptchaRequest
+	7,  // 6: login.v1.LoginService.VerifyCaptcha:input_type -> login.v1.VerifyCaptchaRequest
+	9,  // 7: login.v1.LoginService.SendSmsCode:input_type -> login.v1.SendSmsCodeRequest
+	11, // 8: login.v1.LoginService.LoginBySms:input_type -> login.v1.LoginBySmsRequest
+	12, // 9: login.v1.LoginService.OAuthLogin:input_type -> login.v1.OAuthLoginRequest
+	14, // 10: login.v1.LoginService.OAuthCallback:input_type -> login.v1.OAuthCallbackRequest
+	1,  // 11: login.v1.LoginService.Login:output_type -> login.v1.LoginResponse
+	4,  // 12: login.v1.LoginService.Logout:output_type -> login.v1.LogoutResponse
+	1,  // 13: login.v1.LoginService.RefreshToken:output_type -> login.v1.LoginResponse
+	6,  // 14: login.v1.LoginService.GetCaptcha:output_type -> login.v1.GetCaptchaResponse
+	8,  // 15: login.v1.LoginService.VerifyCaptcha:output_type -> login.v1.VerifyCaptchaResponse
+	10, // 16: login.v1.LoginService.SendSmsCode:output_type -> login.v1.SendSmsCodeResponse
+	1,  // 17: login.v1.LoginService.LoginBySms:output_type -> login.v1.LoginResponse
+	13, // 18: login.v1.LoginService.OAuthLogin:output_type -> login.v1.OAuthLoginResponse
+	15, // 19: login.v1.LoginService.OAuthCallback:output_type -> login.v1.OAuthCallbackResponse
+	11, // [11:20] is the sub-list for method output_type
+	2,  // [2:11] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_login_v1_login_proto_init() }
@@ -1012,7 +1101,7 @@ func file_login_v1_login_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_login_v1_login_proto_rawDesc), len(file_login_v1_login_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	LoginService_Login_FullMethodName         = "/login.v1.LoginService/Login"
 	LoginService_Logout_FullMethodName        = "/login.v1.LoginService/Logout"
+	LoginService_RefreshToken_FullMethodName  = "/login.v1.LoginService/RefreshToken"
 	LoginService_GetCaptcha_FullMethodName    = "/login.v1.LoginService/GetCaptcha"
 	LoginService_VerifyCaptcha_FullMethodName = "/login.v1.LoginService/VerifyCaptcha"
 	LoginService_SendSmsCode_FullMethodName   = "/login.v1.LoginService/SendSmsCode"
@@ -37,6 +38,8 @@ type LoginServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// 登出
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// 刷新访问令牌
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// 获取图片验证码
 	GetCaptcha(ctx context.Context, in *GetCaptchaRequest, opts ...grpc.CallOption) (*GetCaptchaResponse, error)
 	// 验证图片验证码
@@ -79,6 +82,16 @@ func (c *loginServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts
 	return out, nil
 }
 
+func (c *loginServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, LoginService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loginServiceClient) GetCaptcha(ctx context.Context, in *GetCaptchaRequest, opts ...grpc.CallOption) (*GetCaptchaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCaptchaResponse)
@@ -147,6 +160,8 @@ type LoginServiceServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	// 登出
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// 刷新访问令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error)
 	// 获取图片验证码
 	GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaResponse, error)
 	// 验证图片验证码
@@ -175,6 +190,9 @@ func (UnimplementedLoginServiceServer) Login(context.Context, *LoginRequest) (*L
 func (UnimplementedLoginServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedLoginServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
 func (UnimplementedLoginServiceServer) GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCaptcha not implemented")
 }
@@ -250,6 +268,24 @@ func _LoginService_Logout_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoginService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoginService_GetCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCaptchaRequest)
 	if err := dec(in); err != nil {
@@ -373,6 +409,10 @@ var LoginService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _LoginService_Logout_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _LoginService_RefreshToken_Handler,
+		},
 		{
 			MethodName: "GetCaptcha",
 			Handler:    _LoginService_GetCaptcha_Handler,
@@ -25,6 +25,7 @@ const OperationLoginServiceLoginBySms = "/login.v1.LoginService/LoginBySms"
 const OperationLoginServiceLogout = "/login.v1.LoginService/Logout"
 const OperationLoginServiceOAuthCallback = "/login.v1.LoginService/OAuthCallback"
 const OperationLoginServiceOAuthLogin = "/login.v1.LoginService/OAuthLogin"
+const OperationLoginServiceRefreshToken = "/login.v1.LoginService/RefreshToken"
 const OperationLoginServiceSendSmsCode = "/login.v1.LoginService/SendSmsCode"
 const OperationLoginServiceVerifyCaptcha = "/login.v1.LoginService/VerifyCaptcha"
 
@@ -41,6 +42,8 @@ type LoginServiceHTTPServer interface {
 	OAuthCallback(context.Context, *OAuthCallbackRequest) (*OAuthCallbackResponse, error)
 	// OAuthLogin OAuth2.0第三方登录
 	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
+	// RefreshToken 刷新访问令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error)
 	// SendSmsCode 发送手机验证码
 	SendSmsCode(context.Context, *SendSmsCodeRequest) (*SendSmsCodeResponse, error)
 	// VerifyCaptcha 验证图片验证码
@@ -51,6 +54,7 @@ func RegisterLoginServiceHTTPServer(s *http.Server, srv LoginServiceHTTPServer)
 	r := s.Route("/")
 	r.POST("/v1/login", _LoginService_Login0_HTTP_Handler(srv))
 	r.GET("/v1/logout", _LoginService_Logout0_HTTP_Handler(srv))
+	r.POST("/v1/token/refresh", _LoginService_RefreshToken0_HTTP_Handler(srv))
 	r.GET("/v1/captcha", _LoginService_GetCaptcha0_HTTP_Handler(srv))
 	r.POST("/v1/captcha/verify", _LoginService_VerifyCaptcha0_HTTP_Handler(srv))
 	r.POST("/v1/sms/code", _LoginService_SendSmsCode0_HTTP_Handler(srv))
@@ -100,6 +104,28 @@ func _LoginService_Logout0_HTTP_Handler(srv LoginServiceHTTPServer) func(ctx htt
 	}
 }
 
+func _LoginService_RefreshToken0_HTTP_Handler(srv LoginServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLoginServiceRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _LoginService_GetCaptcha0_HTTP_Handler(srv LoginServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCaptchaRequest
@@ -242,6 +268,8 @@ type LoginServiceHTTPClient interface {
 	OAuthCallback(ctx context.Context, req *OAuthCallbackRequest, opts ...http.CallOption) (rsp *OAuthCallbackResponse, err error)
 	// OAuthLogin OAuth2.0第三方登录
 	OAuthLogin(ctx context.Context, req *OAuthLoginRequest, opts ...http.CallOption) (rsp *OAuthLoginResponse, err error)
+	// RefreshToken 刷新访问令牌
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	// SendSmsCode 发送手机验证码
 	SendSmsCode(ctx context.Context, req *SendSmsCodeRequest, opts ...http.CallOption) (rsp *SendSmsCodeResponse, err error)
 	// VerifyCaptcha 验证图片验证码
@@ -340,6 +368,20 @@ func (c *LoginServiceHTTPClientImpl) OAuthLogin(ctx context.Context, in *OAuthLo
 	return &out, nil
 }
 
+// RefreshToken 刷新访问令牌
+func (c *LoginServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/v1/token/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLoginServiceRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SendSmsCode 发送手机验证码
 func (c *LoginServiceHTTPClientImpl) SendSmsCode(ctx context.Context, in *SendSmsCodeRequest, opts ...http.CallOption) (*SendSmsCodeResponse, error) {
 	var out SendSmsCodeResponse
@@ -167,6 +167,7 @@ type Policy struct {
 	IsEnabled     bool                   `protobuf:"varint,10,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`   // 是否启用
 	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // 创建时间
 	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // 更新时间
+	TenantId      string                 `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`       // 所属租户ID，为空表示平台级策略
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +286,13 @@ func (x *Policy) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Policy) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
 // 属性定义
 type Attribute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -866,10 +874,9 @@ func (x *ListPoliciesResponse) GetTotal() int32 {
 	return 0
 }
 
-// 检查权限请求
+// 检查权限请求，主体属性由服务端根据当前登录用户构建，见authz.Subject.Attributes
 type CheckPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subject       map[string]string      `protobuf:"bytes,1,rep,name=subject,proto3" json:"subject,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`   // 主体属性
 	Resource      map[string]string      `protobuf:"bytes,2,rep,name=resource,proto3" json:"resource,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 资源属性
 	Action        map[string]string      `protobuf:"bytes,3,rep,name=action,proto3" json:"action,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`     // 操作属性
 	Context       map[string]string      `protobuf:"bytes,4,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`   // 环境属性
@@ -907,13 +914,6 @@ func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
 	return file_permission_v1_permission_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CheckPermissionRequest) GetSubject() map[string]string {
-	if x != nil {
-		return x.Subject
-	}
-	return nil
-}
-
 func (x *CheckPermissionRequest) GetResource() map[string]string {
 	if x != nil {
 		return x.Resource
@@ -1090,7 +1090,7 @@ var File_permission_v1_permission_proto protoreflect.FileDescriptor
 
 const file_permission_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x1epermission/v1/permission.proto\x12\rpermission.v1\x1a\x1cgoogle/api/annotations.proto\"\xce\x03\n" +
+	"\x1epermission/v1/permission.proto\x12\rpermission.v1\x1a\x1cgoogle/api/annotations.proto\"\xeb\x03\n" +
 	"\x06Policy\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1107,7 +1107,8 @@ const file_permission_v1_permission_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\"j\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\ttenant_id\x18\r \x01(\tR\btenantId\"j\n" +
 	"\tAttribute\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
 	"\boperator\x18\x02 \x01(\x0e2\x17.permission.v1.OperatorR\boperator\x12\x16\n" +
@@ -1150,15 +1151,11 @@ const file_permission_v1_permission_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"Y\n" +
 	"\x14ListPoliciesResponse\x12+\n" +
 	"\x05items\x18\x01 \x03(\v2\x15.permission.v1.PolicyR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xc0\x04\n" +
-	"\x16CheckPermissionRequest\x12L\n" +
-	"\asubject\x18\x01 \x03(\v22.permission.v1.CheckPermissionRequest.SubjectEntryR\asubject\x12O\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xc5\x03\n" +
+	"\x16CheckPermissionRequest\x12O\n" +
 	"\bresource\x18\x02 \x03(\v23.permission.v1.CheckPermissionRequest.ResourceEntryR\bresource\x12I\n" +
 	"\x06action\x18\x03 \x03(\v21.permission.v1.CheckPermissionRequest.ActionEntryR\x06action\x12L\n" +
-	"\acontext\x18\x04 \x03(\v22.permission.v1.CheckPermissionRequest.ContextEntryR\acontext\x1a:\n" +
-	"\fSubjectEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\acontext\x18\x04 \x03(\v22.permission.v1.CheckPermissionRequest.ContextEntryR\acontext\x1a;\n" +
 	"\rResourceEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
@@ -1167,7 +1164,7 @@ const file_permission_v1_permission_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
 	"\fContextEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x01\x10\x02R\asubject\"h\n" +
 	"\x17CheckPermissionResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x1b\n" +
 	"\tpolicy_id\x18\x02 \x01(\tR\bpolicyId\x12\x16\n" +
@@ -1217,7 +1214,7 @@ func file_permission_v1_permission_proto_rawDescGZIP() []byte {
 }
 
 var file_permission_v1_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_permission_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_permission_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_permission_v1_permission_proto_goTypes = []any{
 	(Effect)(0),                          // 0: permission.v1.Effect
 	(Operator)(0),                        // 1: permission.v1.Operator
@@ -1235,10 +1232,9 @@ var file_permission_v1_permission_proto_goTypes = []any{
 	(*CheckPermissionResponse)(nil),      // 13: permission.v1.CheckPermissionResponse
 	(*BatchCheckPermissionRequest)(nil),  // 14: permission.v1.BatchCheckPermissionRequest
 	(*BatchCheckPermissionResponse)(nil), // 15: permission.v1.BatchCheckPermissionResponse
-	nil,                                  // 16: permission.v1.CheckPermissionRequest.SubjectEntry
-	nil,                                  // 17: permission.v1.CheckPermissionRequest.ResourceEntry
-	nil,                                  // 18: permission.v1.CheckPermissionRequest.ActionEntry
-	nil,                                  // 19: permission.v1.CheckPermissionRequest.ContextEntry
+	nil,                                  // 16: permission.v1.CheckPermissionRequest.ResourceEntry
+	nil,                                  // 17: permission.v1.CheckPermissionRequest.ActionEntry
+	nil,                                  // 18: permission.v1.CheckPermissionRequest.ContextEntry
 }
 var file_permission_v1_permission_proto_depIdxs = []int32{
 	0,  // 0: permission.v1.Policy.effect:type_name -> permission.v1.Effect
@@ -1258,29 +1254,28 @@ var file_permission_v1_permission_proto_depIdxs = []int32{
 	3,  // 14: permission.v1.UpdatePolicyRequest.actions:type_name -> permission.v1.Attribute
 	3,  // 15: permission.v1.UpdatePolicyRequest.contexts:type_name -> permission.v1.Attribute
 	2,  // 16: permission.v1.ListPoliciesResponse.items:type_name -> permission.v1.Policy
-	16, // 17: permission.v1.CheckPermissionRequest.subject:type_name -> permission.v1.CheckPermissionRequest.SubjectEntry
-	17, // 18: permission.v1.CheckPermissionRequest.resource:type_name -> permission.v1.CheckPermissionRequest.ResourceEntry
-	18, // 19: permission.v1.CheckPermissionRequest.action:type_name -> permission.v1.CheckPermissionRequest.ActionEntry
-	19, // 20: permission.v1.CheckPermissionRequest.context:type_name -> permission.v1.CheckPermissionRequest.ContextEntry
-	12, // 21: permission.v1.BatchCheckPermissionRequest.requests:type_name -> permission.v1.CheckPermissionRequest
-	13, // 22: permission.v1.BatchCheckPermissionResponse.results:type_name -> permission.v1.CheckPermissionResponse
-	4,  // 23: permission.v1.PermissionService.CreatePolicy:input_type -> permission.v1.CreatePolicyRequest
-	6,  // 24: permission.v1.PermissionService.UpdatePolicy:input_type -> permission.v1.UpdatePolicyRequest
-	8,  // 25: permission.v1.PermissionService.DeletePolicy:input_type -> permission.v1.DeletePolicyRequest
-	10, // 26: permission.v1.PermissionService.ListPolicies:input_type -> permission.v1.ListPoliciesRequest
-	12, // 27: permission.v1.PermissionService.CheckPermission:input_type -> permission.v1.CheckPermissionRequest
-	14, // 28: permission.v1.PermissionService.BatchCheckPermission:input_type -> permission.v1.BatchCheckPermissionRequest
-	5,  // 29: permission.v1.PermissionService.CreatePolicy:output_type -> permission.v1.CreatePolicyResponse
-	7,  // 30: permission.v1.PermissionService.UpdatePolicy:output_type -> permission.v1.UpdatePolicyResponse
-	9,  // 31: permission.v1.PermissionService.DeletePolicy:output_type -> permission.v1.DeletePolicyResponse
-	11, // 32: permission.v1.PermissionService.ListPolicies:output_type -> permission.v1.ListPoliciesResponse
-	13, // 33: permission.v1.PermissionService.CheckPermission:output_type -> permission.v1.CheckPermissionResponse
-	15, // 34: permission.v1.PermissionService.BatchCheckPermission:output_type -> permission.v1.BatchCheckPermissionResponse
-	29, // [29:35] is the sub-list for method output_type
-	23, // [23:29] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	16, // 17: permission.v1.CheckPermissionRequest.resource:type_name -> permission.v1.CheckPermissionRequest.ResourceEntry
+	17, // 18: permission.v1.CheckPermissionRequest.action:type_name -> permission.v1.CheckPermissionRequest.ActionEntry
+	18, // 19: permission.v1.CheckPermissionRequest.context:type_name -> permission.v1.CheckPermissionRequest.ContextEntry
+	12, // 20: permission.v1.BatchCheckPermissionRequest.requests:type_name -> permission.v1.CheckPermissionRequest
+	13, // 21: permission.v1.BatchCheckPermissionResponse.results:type_name -> permission.v1.CheckPermissionResponse
+	4,  // 22: permission.v1.PermissionService.CreatePolicy:input_type -> permission.v1.CreatePolicyRequest
+	6,  // 23: permission.v1.PermissionService.UpdatePolicy:input_type -> permission.v1.UpdatePolicyRequest
+	8,  // 24: permission.v1.PermissionService.DeletePolicy:input_type -> permission.v1.DeletePolicyRequest
+	10, // 25: permission.v1.PermissionService.ListPolicies:input_type -> permission.v1.ListPoliciesRequest
+	12, // 26: permission.v1.PermissionService.CheckPermission:input_type -> permission.v1.CheckPermissionRequest
+	14, // 27: permission.v1.PermissionService.BatchCheckPermission:input_type -> permission.v1.BatchCheckPermissionRequest
+	5,  // 28: permission.v1.PermissionService.CreatePolicy:output_type -> permission.v1.CreatePolicyResponse
+	7,  // 29: permission.v1.PermissionService.UpdatePolicy:output_type -> permission.v1.UpdatePolicyResponse
+	9,  // 30: permission.v1.PermissionService.DeletePolicy:output_type -> permission.v1.DeletePolicyResponse
+	11, // 31: permission.v1.PermissionService.ListPolicies:output_type -> permission.v1.ListPoliciesResponse
+	13, // 32: permission.v1.PermissionService.CheckPermission:output_type -> permission.v1.CheckPermissionResponse
+	15, // 33: permission.v1.PermissionService.BatchCheckPermission:output_type -> permission.v1.BatchCheckPermissionResponse
+	28, // [28:34] is the sub-list for method output_type
+	22, // [22:28] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_permission_proto_init() }
@@ -1294,7 +1289,7 @@ func file_permission_v1_permission_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_permission_proto_rawDesc), len(file_permission_v1_permission_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   18,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
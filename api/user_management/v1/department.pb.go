@@ -33,6 +33,12 @@ type Department struct {
 	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TenantId      string                 `protobuf:"bytes,10,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Path          string                 `protobuf:"bytes,11,opt,name=path,proto3" json:"path,omitempty"`                               // ltree路径，由根部门到本部门的ID以.连接
+	SortOrder     int32                  `protobuf:"varint,12,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`   // 同级部门中的排序，从小到大
+	HeadId        string                 `protobuf:"bytes,13,opt,name=head_id,json=headId,proto3" json:"head_id,omitempty"`             // 部门负责人用户ID，为空表示空缺
+	DeputyIds     []string               `protobuf:"bytes,14,rep,name=deputy_ids,json=deputyIds,proto3" json:"deputy_ids,omitempty"`    // 部门副职用户ID
+	ExternalId    string                 `protobuf:"bytes,15,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"` // 外部系统中的部门ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -130,12 +136,55 @@ func (x *Department) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Department) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Department) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Department) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Department) GetHeadId() string {
+	if x != nil {
+		return x.HeadId
+	}
+	return ""
+}
+
+func (x *Department) GetDeputyIds() []string {
+	if x != nil {
+		return x.DeputyIds
+	}
+	return nil
+}
+
+func (x *Department) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 type CreateDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // 租户内唯一，可为空
 	Pid           string                 `protobuf:"bytes,3,opt,name=pid,proto3" json:"pid,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"` // 租户内唯一，可为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -198,6 +247,13 @@ func (x *CreateDepartmentRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateDepartmentRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
 type CreateDepartmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -269,6 +325,7 @@ func (x *CreateDepartmentResponse) GetMsg() string {
 type DeleteDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cascade       bool                   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"` // 同时删除所有下级部门并移除成员
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -310,13 +367,22 @@ func (x *DeleteDepartmentRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteDepartmentRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
 type DeleteDepartmentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Result             bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code               int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg                string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	DeletedDepartments int32                  `protobuf:"varint,4,opt,name=deleted_departments,json=deletedDepartments,proto3" json:"deleted_departments,omitempty"`
+	RemovedMembers     int32                  `protobuf:"varint,5,opt,name=removed_members,json=removedMembers,proto3" json:"removed_members,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *DeleteDepartmentResponse) Reset() {
@@ -370,6 +436,20 @@ func (x *DeleteDepartmentResponse) GetMsg() string {
 	return ""
 }
 
+func (x *DeleteDepartmentResponse) GetDeletedDepartments() int32 {
+	if x != nil {
+		return x.DeletedDepartments
+	}
+	return 0
+}
+
+func (x *DeleteDepartmentResponse) GetRemovedMembers() int32 {
+	if x != nil {
+		return x.RemovedMembers
+	}
+	return 0
+}
+
 type UpdateDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -377,6 +457,7 @@ type UpdateDepartmentRequest struct {
 	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
 	Pid           string                 `protobuf:"bytes,4,opt,name=pid,proto3" json:"pid,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ExternalId    *string                `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"` // 不传表示不修改，为空时清除
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -446,6 +527,13 @@ func (x *UpdateDepartmentRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateDepartmentRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
 type UpdateDepartmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -514,27 +602,29 @@ func (x *UpdateDepartmentResponse) GetMsg() string {
 	return ""
 }
 
-type GetDepartmentRequest struct {
+type MoveDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pid           string                 `protobuf:"bytes,2,opt,name=pid,proto3" json:"pid,omitempty"`                                     // 新的上级部门ID，为空时移动为根部门
+	SortOrder     *int32                 `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"` // 在新的同级部门中的排序，未指定时排在最后
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDepartmentRequest) Reset() {
-	*x = GetDepartmentRequest{}
+func (x *MoveDepartmentRequest) Reset() {
+	*x = MoveDepartmentRequest{}
 	mi := &file_user_management_v1_department_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDepartmentRequest) String() string {
+func (x *MoveDepartmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDepartmentRequest) ProtoMessage() {}
+func (*MoveDepartmentRequest) ProtoMessage() {}
 
-func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveDepartmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_v1_department_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,45 +636,57 @@ func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*MoveDepartmentRequest) Descriptor() ([]byte, []int) {
 	return file_user_management_v1_department_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetDepartmentRequest) GetId() string {
+func (x *MoveDepartmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListDepartmentsRequest struct {
+func (x *MoveDepartmentRequest) GetPid() string {
+	if x != nil {
+		return x.Pid
+	}
+	return ""
+}
+
+func (x *MoveDepartmentRequest) GetSortOrder() int32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
+type MoveDepartmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Order         string                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
-	IsDesc        bool                   `protobuf:"varint,4,opt,name=is_desc,json=isDesc,proto3" json:"is_desc,omitempty"`
-	Pid           string                 `protobuf:"bytes,5,opt,name=pid,proto3" json:"pid,omitempty"` // 筛选特定父部门下的子部门
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Code          string                 `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Department    *Department            `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	Affected      int32                  `protobuf:"varint,5,opt,name=affected,proto3" json:"affected,omitempty"` // 改写路径的部门数，包含自身
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDepartmentsRequest) Reset() {
-	*x = ListDepartmentsRequest{}
+func (x *MoveDepartmentResponse) Reset() {
+	*x = MoveDepartmentResponse{}
 	mi := &file_user_management_v1_department_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDepartmentsRequest) String() string {
+func (x *MoveDepartmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepartmentsRequest) ProtoMessage() {}
+func (*MoveDepartmentResponse) ProtoMessage() {}
 
-func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveDepartmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_v1_department_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,84 +698,68 @@ func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*MoveDepartmentResponse) Descriptor() ([]byte, []int) {
 	return file_user_management_v1_department_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListDepartmentsRequest) GetPage() int32 {
+func (x *MoveDepartmentResponse) GetResult() bool {
 	if x != nil {
-		return x.Page
+		return x.Result
 	}
-	return 0
+	return false
 }
 
-func (x *ListDepartmentsRequest) GetPageSize() int32 {
+func (x *MoveDepartmentResponse) GetCode() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Code
 	}
 	return 0
 }
 
-func (x *ListDepartmentsRequest) GetOrder() string {
-	if x != nil {
-		return x.Order
-	}
-	return ""
-}
-
-func (x *ListDepartmentsRequest) GetIsDesc() bool {
-	if x != nil {
-		return x.IsDesc
-	}
-	return false
-}
-
-func (x *ListDepartmentsRequest) GetPid() string {
+func (x *MoveDepartmentResponse) GetMsg() string {
 	if x != nil {
-		return x.Pid
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListDepartmentsRequest) GetName() string {
+func (x *MoveDepartmentResponse) GetDepartment() *Department {
 	if x != nil {
-		return x.Name
+		return x.Department
 	}
-	return ""
+	return nil
 }
 
-func (x *ListDepartmentsRequest) GetCode() string {
+func (x *MoveDepartmentResponse) GetAffected() int32 {
 	if x != nil {
-		return x.Code
+		return x.Affected
 	}
-	return ""
+	return 0
 }
 
-type ListDepartmentsResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Result        bool                                `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Code          int32                               `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Data          *ListDepartmentsResponse_PageResult `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Msg           string                              `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+type ReorderDepartmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pid           string                 `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"` // 上级部门ID，为空时调整根部门的顺序
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"` // 该上级部门下的全部部门ID，按新的顺序排列
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDepartmentsResponse) Reset() {
-	*x = ListDepartmentsResponse{}
+func (x *ReorderDepartmentsRequest) Reset() {
+	*x = ReorderDepartmentsRequest{}
 	mi := &file_user_management_v1_department_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDepartmentsResponse) String() string {
+func (x *ReorderDepartmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepartmentsResponse) ProtoMessage() {}
+func (*ReorderDepartmentsRequest) ProtoMessage() {}
 
-func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReorderDepartmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_v1_department_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,61 +771,49 @@ func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderDepartmentsRequest) Descriptor() ([]byte, []int) {
 	return file_user_management_v1_department_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListDepartmentsResponse) GetResult() bool {
-	if x != nil {
-		return x.Result
-	}
-	return false
-}
-
-func (x *ListDepartmentsResponse) GetCode() int32 {
+func (x *ReorderDepartmentsRequest) GetPid() string {
 	if x != nil {
-		return x.Code
+		return x.Pid
 	}
-	return 0
+	return ""
 }
 
-func (x *ListDepartmentsResponse) GetData() *ListDepartmentsResponse_PageResult {
+func (x *ReorderDepartmentsRequest) GetIds() []string {
 	if x != nil {
-		return x.Data
+		return x.Ids
 	}
 	return nil
 }
 
-func (x *ListDepartmentsResponse) GetMsg() string {
-	if x != nil {
-		return x.Msg
-	}
-	return ""
-}
-
-type AddUsersToDepartmentRequest struct {
+type ReorderDepartmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DepartmentId  string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Departments   []*Department          `protobuf:"bytes,4,rep,name=departments,proto3" json:"departments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddUsersToDepartmentRequest) Reset() {
-	*x = AddUsersToDepartmentRequest{}
+func (x *ReorderDepartmentsResponse) Reset() {
+	*x = ReorderDepartmentsResponse{}
 	mi := &file_user_management_v1_department_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddUsersToDepartmentRequest) String() string {
+func (x *ReorderDepartmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUsersToDepartmentRequest) ProtoMessage() {}
+func (*ReorderDepartmentsResponse) ProtoMessage() {}
 
-func (x *AddUsersToDepartmentRequest) ProtoReflect() protoreflect.Message {
+func (x *ReorderDepartmentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_v1_department_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,47 +825,60 @@ func (x *AddUsersToDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUsersToDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*AddUsersToDepartmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderDepartmentsResponse) Descriptor() ([]byte, []int) {
 	return file_user_management_v1_department_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AddUsersToDepartmentRequest) GetDepartmentId() string {
+func (x *ReorderDepartmentsResponse) GetResult() bool {
 	if x != nil {
-		return x.DepartmentId
+		return x.Result
+	}
+	return false
+}
+
+func (x *ReorderDepartmentsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReorderDepartmentsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *AddUsersToDepartmentRequest) GetUserIds() []string {
+func (x *ReorderDepartmentsResponse) GetDepartments() []*Department {
 	if x != nil {
-		return x.UserIds
+		return x.Departments
 	}
 	return nil
 }
 
-type RemoveUsersFromDepartmentRequest struct {
+type GetDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DepartmentId  string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveUsersFromDepartmentRequest) Reset() {
-	*x = RemoveUsersFromDepartmentRequest{}
+func (x *GetDepartmentRequest) Reset() {
+	*x = GetDepartmentRequest{}
 	mi := &file_user_management_v1_department_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUsersFromDepartmentRequest) String() string {
+func (x *GetDepartmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUsersFromDepartmentRequest) ProtoMessage() {}
+func (*GetDepartmentRequest) ProtoMessage() {}
 
-func (x *RemoveUsersFromDepartmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_v1_department_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,48 +890,45 @@ func (x *RemoveUsersFromDepartmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUsersFromDepartmentRequest.ProtoReflect.Descriptor instead.
-func (*RemoveUsersFromDepartmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
 	return file_user_management_v1_department_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveUsersFromDepartmentRequest) GetDepartmentId() string {
+func (x *GetDepartmentRequest) GetId() string {
 	if x != nil {
-		return x.DepartmentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RemoveUsersFromDepartmentRequest) GetUserIds() []string {
-	if x != nil {
-		return x.UserIds
-	}
-	return nil
-}
-
-type ListDepartmentUsersRequest struct {
+type ListDepartmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DepartmentId  string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Order         string                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	IsDesc        bool                   `protobuf:"varint,4,opt,name=is_desc,json=isDesc,proto3" json:"is_desc,omitempty"`
+	Pid           string                 `protobuf:"bytes,5,opt,name=pid,proto3" json:"pid,omitempty"` // 筛选特定父部门下的子部门
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,7,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDepartmentUsersRequest) Reset() {
-	*x = ListDepartmentUsersRequest{}
+func (x *ListDepartmentsRequest) Reset() {
+	*x = ListDepartmentsRequest{}
 	mi := &file_user_management_v1_department_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDepartmentUsersRequest) String() string {
+func (x *ListDepartmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepartmentUsersRequest) ProtoMessage() {}
+func (*ListDepartmentsRequest) ProtoMessage() {}
 
-func (x *ListDepartmentUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_v1_department_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,32 +940,1702 @@ func (x *ListDepartmentUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepartmentUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListDepartmentUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
 	return file_user_management_v1_department_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListDepartmentUsersRequest) GetDepartmentId() string {
+func (x *ListDepartmentsRequest) GetPage() int32 {
 	if x != nil {
-		return x.DepartmentId
+		return x.Page
 	}
-	return ""
+	return 0
+}
+
+func (x *ListDepartmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDepartmentsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListDepartmentsRequest) GetIsDesc() bool {
+	if x != nil {
+		return x.IsDesc
+	}
+	return false
+}
+
+func (x *ListDepartmentsRequest) GetPid() string {
+	if x != nil {
+		return x.Pid
+	}
+	return ""
+}
+
+func (x *ListDepartmentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListDepartmentsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListDepartmentsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Result        bool                                `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                               `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *ListDepartmentsResponse_PageResult `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Msg           string                              `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsResponse) Reset() {
+	*x = ListDepartmentsResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDepartmentsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListDepartmentsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListDepartmentsResponse) GetData() *ListDepartmentsResponse_PageResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListDepartmentsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type DepartmentNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	Children      []*DepartmentNode      `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentNode) Reset() {
+	*x = DepartmentNode{}
+	mi := &file_user_management_v1_department_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentNode) ProtoMessage() {}
+
+func (x *DepartmentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentNode.ProtoReflect.Descriptor instead.
+func (*DepartmentNode) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DepartmentNode) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+func (x *DepartmentNode) GetChildren() []*DepartmentNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetDepartmentTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 为空时返回租户的所有部门
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentTreeRequest) Reset() {
+	*x = GetDepartmentTreeRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentTreeRequest) ProtoMessage() {}
+
+func (x *GetDepartmentTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentTreeRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDepartmentTreeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDepartmentTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Nodes         []*DepartmentNode      `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentTreeResponse) Reset() {
+	*x = GetDepartmentTreeResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentTreeResponse) ProtoMessage() {}
+
+func (x *GetDepartmentTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetDepartmentTreeResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDepartmentTreeResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *GetDepartmentTreeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDepartmentTreeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetDepartmentTreeResponse) GetNodes() []*DepartmentNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type ListDepartmentAncestorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentAncestorsRequest) Reset() {
+	*x = ListDepartmentAncestorsRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentAncestorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentAncestorsRequest) ProtoMessage() {}
+
+func (x *ListDepartmentAncestorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentAncestorsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentAncestorsRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDepartmentAncestorsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDepartmentAncestorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Departments   []*Department          `protobuf:"bytes,4,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentAncestorsResponse) Reset() {
+	*x = ListDepartmentAncestorsResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentAncestorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentAncestorsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentAncestorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentAncestorsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentAncestorsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDepartmentAncestorsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListDepartmentAncestorsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListDepartmentAncestorsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListDepartmentAncestorsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+type ListDepartmentDescendantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentDescendantsRequest) Reset() {
+	*x = ListDepartmentDescendantsRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentDescendantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentDescendantsRequest) ProtoMessage() {}
+
+func (x *ListDepartmentDescendantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentDescendantsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentDescendantsRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDepartmentDescendantsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDepartmentDescendantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Departments   []*Department          `protobuf:"bytes,4,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentDescendantsResponse) Reset() {
+	*x = ListDepartmentDescendantsResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentDescendantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentDescendantsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentDescendantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentDescendantsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentDescendantsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDepartmentDescendantsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListDepartmentDescendantsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListDepartmentDescendantsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListDepartmentDescendantsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+type AddUsersToDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId  string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Primary       bool                   `protobuf:"varint,3,opt,name=primary,proto3" json:"primary,omitempty"` // 同时设为这些用户的主部门；用户还没有主部门时总是设为主部门
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUsersToDepartmentRequest) Reset() {
+	*x = AddUsersToDepartmentRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUsersToDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUsersToDepartmentRequest) ProtoMessage() {}
+
+func (x *AddUsersToDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUsersToDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*AddUsersToDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddUsersToDepartmentRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *AddUsersToDepartmentRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *AddUsersToDepartmentRequest) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+type AddUsersToDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Added         int32                  `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"` // 新加入的用户数，已在部门中的用户不计入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUsersToDepartmentResponse) Reset() {
+	*x = AddUsersToDepartmentResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUsersToDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUsersToDepartmentResponse) ProtoMessage() {}
+
+func (x *AddUsersToDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUsersToDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*AddUsersToDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddUsersToDepartmentResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *AddUsersToDepartmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AddUsersToDepartmentResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AddUsersToDepartmentResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type RemoveUsersFromDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId  string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUsersFromDepartmentRequest) Reset() {
+	*x = RemoveUsersFromDepartmentRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUsersFromDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUsersFromDepartmentRequest) ProtoMessage() {}
+
+func (x *RemoveUsersFromDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUsersFromDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUsersFromDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveUsersFromDepartmentRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *RemoveUsersFromDepartmentRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type RemoveUsersFromDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Removed       int32                  `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUsersFromDepartmentResponse) Reset() {
+	*x = RemoveUsersFromDepartmentResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUsersFromDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUsersFromDepartmentResponse) ProtoMessage() {}
+
+func (x *RemoveUsersFromDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUsersFromDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUsersFromDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveUsersFromDepartmentResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RemoveUsersFromDepartmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RemoveUsersFromDepartmentResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RemoveUsersFromDepartmentResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type ListDepartmentUsersRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DepartmentId       string                 `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Page               int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize           int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,4,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"` // 包含所有下级部门的成员
+	Keyword            string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                                                  // 按用户名、姓名、邮箱、手机号筛选
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListDepartmentUsersRequest) Reset() {
+	*x = ListDepartmentUsersRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentUsersRequest) ProtoMessage() {}
+
+func (x *ListDepartmentUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDepartmentUsersRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ListDepartmentUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDepartmentUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDepartmentUsersRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListDepartmentUsersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 部门成员，同一用户在多个部门时每个部门各一条
+type DepartmentUser struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *SimpleUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	DepartmentId   string                 `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	DepartmentName string                 `protobuf:"bytes,3,opt,name=department_name,json=departmentName,proto3" json:"department_name,omitempty"`
+	IsPrimary      bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DepartmentUser) Reset() {
+	*x = DepartmentUser{}
+	mi := &file_user_management_v1_department_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentUser) ProtoMessage() {}
+
+func (x *DepartmentUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentUser.ProtoReflect.Descriptor instead.
+func (*DepartmentUser) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DepartmentUser) GetUser() *SimpleUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DepartmentUser) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *DepartmentUser) GetDepartmentName() string {
+	if x != nil {
+		return x.DepartmentName
+	}
+	return ""
+}
+
+func (x *DepartmentUser) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type ListDepartmentUsersResponse struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	Result        bool                                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                                   `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *ListDepartmentUsersResponse_PageResult `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Msg           string                                  `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentUsersResponse) Reset() {
+	*x = ListDepartmentUsersResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentUsersResponse) ProtoMessage() {}
+
+func (x *ListDepartmentUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDepartmentUsersResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListDepartmentUsersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListDepartmentUsersResponse) GetData() *ListDepartmentUsersResponse_PageResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListDepartmentUsersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type SetPrimaryDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryDepartmentRequest) Reset() {
+	*x = SetPrimaryDepartmentRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryDepartmentRequest) ProtoMessage() {}
+
+func (x *SetPrimaryDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetPrimaryDepartmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetPrimaryDepartmentRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+type SetPrimaryDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrimaryDepartmentResponse) Reset() {
+	*x = SetPrimaryDepartmentResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrimaryDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryDepartmentResponse) ProtoMessage() {}
+
+func (x *SetPrimaryDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetPrimaryDepartmentResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *SetPrimaryDepartmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetPrimaryDepartmentResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type SetDepartmentLeadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HeadId        string                 `protobuf:"bytes,2,opt,name=head_id,json=headId,proto3" json:"head_id,omitempty"` // 为空表示负责人空缺
+	DeputyIds     []string               `protobuf:"bytes,3,rep,name=deputy_ids,json=deputyIds,proto3" json:"deputy_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepartmentLeadersRequest) Reset() {
+	*x = SetDepartmentLeadersRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepartmentLeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepartmentLeadersRequest) ProtoMessage() {}
+
+func (x *SetDepartmentLeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepartmentLeadersRequest.ProtoReflect.Descriptor instead.
+func (*SetDepartmentLeadersRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetDepartmentLeadersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetDepartmentLeadersRequest) GetHeadId() string {
+	if x != nil {
+		return x.HeadId
+	}
+	return ""
+}
+
+func (x *SetDepartmentLeadersRequest) GetDeputyIds() []string {
+	if x != nil {
+		return x.DeputyIds
+	}
+	return nil
+}
+
+type SetDepartmentLeadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Department    *Department            `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepartmentLeadersResponse) Reset() {
+	*x = SetDepartmentLeadersResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepartmentLeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepartmentLeadersResponse) ProtoMessage() {}
+
+func (x *SetDepartmentLeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepartmentLeadersResponse.ProtoReflect.Descriptor instead.
+func (*SetDepartmentLeadersResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetDepartmentLeadersResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *SetDepartmentLeadersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetDepartmentLeadersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SetDepartmentLeadersResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type GetReportingLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"` // 按用户所在的该部门计算，为空时使用用户的主部门
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportingLineRequest) Reset() {
+	*x = GetReportingLineRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportingLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportingLineRequest) ProtoMessage() {}
+
+func (x *GetReportingLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportingLineRequest.ProtoReflect.Descriptor instead.
+func (*GetReportingLineRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetReportingLineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReportingLineRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+// 汇报线上的一级主管
+type ReportingManager struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *SimpleUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	DepartmentId   string                 `protobuf:"bytes,2,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"` // 主管负责的部门
+	DepartmentName string                 `protobuf:"bytes,3,opt,name=department_name,json=departmentName,proto3" json:"department_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportingManager) Reset() {
+	*x = ReportingManager{}
+	mi := &file_user_management_v1_department_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportingManager) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportingManager) ProtoMessage() {}
+
+func (x *ReportingManager) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportingManager.ProtoReflect.Descriptor instead.
+func (*ReportingManager) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReportingManager) GetUser() *SimpleUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ReportingManager) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ReportingManager) GetDepartmentName() string {
+	if x != nil {
+		return x.DepartmentName
+	}
+	return ""
+}
+
+type GetReportingLineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"` // 计算汇报线使用的部门
+	Managers      []*ReportingManager    `protobuf:"bytes,5,rep,name=managers,proto3" json:"managers,omitempty"`                             // 从直接主管开始向上
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportingLineResponse) Reset() {
+	*x = GetReportingLineResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportingLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportingLineResponse) ProtoMessage() {}
+
+func (x *GetReportingLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportingLineResponse.ProtoReflect.Descriptor instead.
+func (*GetReportingLineResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetReportingLineResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *GetReportingLineResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetReportingLineResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetReportingLineResponse) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *GetReportingLineResponse) GetManagers() []*ReportingManager {
+	if x != nil {
+		return x.Managers
+	}
+	return nil
+}
+
+type ListReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"` // 包含间接下属，即用户负责的部门及其下级部门中的全部成员
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListReportsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ListReportsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Result        bool                            `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                           `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Data          *ListReportsResponse_PageResult `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Msg           string                          `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListReportsResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *ListReportsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListReportsResponse) GetData() *ListReportsResponse_PageResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
 }
 
-func (x *ListDepartmentUsersRequest) GetPage() int32 {
+type SyncDepartmentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId       string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code             string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ParentExternalId string                 `protobuf:"bytes,5,opt,name=parent_external_id,json=parentExternalId,proto3" json:"parent_external_id,omitempty"` // 上级部门的外部ID，优先于pid
+	Pid              string                 `protobuf:"bytes,6,opt,name=pid,proto3" json:"pid,omitempty"`                                                     // 上级部门ID，与parent_external_id都为空时为根部门
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncDepartmentRequest) Reset() {
+	*x = SyncDepartmentRequest{}
+	mi := &file_user_management_v1_department_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncDepartmentRequest) ProtoMessage() {}
+
+func (x *SyncDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[37]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListDepartmentUsersRequest) GetPageSize() int32 {
+// Deprecated: Use SyncDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*SyncDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SyncDepartmentRequest) GetExternalId() string {
 	if x != nil {
-		return x.PageSize
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *SyncDepartmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SyncDepartmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SyncDepartmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SyncDepartmentRequest) GetParentExternalId() string {
+	if x != nil {
+		return x.ParentExternalId
+	}
+	return ""
+}
+
+func (x *SyncDepartmentRequest) GetPid() string {
+	if x != nil {
+		return x.Pid
+	}
+	return ""
+}
+
+type SyncDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Department    *Department            `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	Created       bool                   `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"` // true表示新建，false表示已存在并按请求更新
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncDepartmentResponse) Reset() {
+	*x = SyncDepartmentResponse{}
+	mi := &file_user_management_v1_department_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncDepartmentResponse) ProtoMessage() {}
+
+func (x *SyncDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*SyncDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SyncDepartmentResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *SyncDepartmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
 	}
 	return 0
 }
 
+func (x *SyncDepartmentResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SyncDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+func (x *SyncDepartmentResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
 type ListDepartmentsResponse_PageResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -894,7 +2648,7 @@ type ListDepartmentsResponse_PageResult struct {
 
 func (x *ListDepartmentsResponse_PageResult) Reset() {
 	*x = ListDepartmentsResponse_PageResult{}
-	mi := &file_user_management_v1_department_proto_msgTypes[13]
+	mi := &file_user_management_v1_department_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +2660,7 @@ func (x *ListDepartmentsResponse_PageResult) String() string {
 func (*ListDepartmentsResponse_PageResult) ProtoMessage() {}
 
 func (x *ListDepartmentsResponse_PageResult) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_v1_department_proto_msgTypes[13]
+	mi := &file_user_management_v1_department_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +2673,7 @@ func (x *ListDepartmentsResponse_PageResult) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListDepartmentsResponse_PageResult.ProtoReflect.Descriptor instead.
 func (*ListDepartmentsResponse_PageResult) Descriptor() ([]byte, []int) {
-	return file_user_management_v1_department_proto_rawDescGZIP(), []int{9, 0}
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *ListDepartmentsResponse_PageResult) GetTotal() int32 {
@@ -950,11 +2704,147 @@ func (x *ListDepartmentsResponse_PageResult) GetPageSize() int32 {
 	return 0
 }
 
+type ListDepartmentUsersResponse_PageResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Users         []*DepartmentUser      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentUsersResponse_PageResult) Reset() {
+	*x = ListDepartmentUsersResponse_PageResult{}
+	mi := &file_user_management_v1_department_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentUsersResponse_PageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentUsersResponse_PageResult) ProtoMessage() {}
+
+func (x *ListDepartmentUsersResponse_PageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentUsersResponse_PageResult.ProtoReflect.Descriptor instead.
+func (*ListDepartmentUsersResponse_PageResult) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *ListDepartmentUsersResponse_PageResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDepartmentUsersResponse_PageResult) GetUsers() []*DepartmentUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListDepartmentUsersResponse_PageResult) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDepartmentUsersResponse_PageResult) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReportsResponse_PageResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Users         []*DepartmentUser      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"` // 下属及其主部门
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse_PageResult) Reset() {
+	*x = ListReportsResponse_PageResult{}
+	mi := &file_user_management_v1_department_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse_PageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse_PageResult) ProtoMessage() {}
+
+func (x *ListReportsResponse_PageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_v1_department_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse_PageResult.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse_PageResult) Descriptor() ([]byte, []int) {
+	return file_user_management_v1_department_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *ListReportsResponse_PageResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReportsResponse_PageResult) GetUsers() []*DepartmentUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListReportsResponse_PageResult) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReportsResponse_PageResult) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 var File_user_management_v1_department_proto protoreflect.FileDescriptor
 
 const file_user_management_v1_department_proto_rawDesc = "" +
 	"\n" +
-	"#user_management/v1/department.proto\x12\x12user_management.v1\x1a\x1cgoogle/api/annotations.proto\"\xf4\x01\n" +
+	"#user_management/v1/department.proto\x12\x12user_management.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1duser_management/v1/user.proto\"\x9d\x03\n" +
 	"\n" +
 	"Department\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -969,38 +2859,78 @@ const file_user_management_v1_department_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt\"u\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\ttenant_id\x18\n" +
+	" \x01(\tR\btenantId\x12\x12\n" +
+	"\x04path\x18\v \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\f \x01(\x05R\tsortOrder\x12\x17\n" +
+	"\ahead_id\x18\r \x01(\tR\x06headId\x12\x1d\n" +
+	"\n" +
+	"deputy_ids\x18\x0e \x03(\tR\tdeputyIds\x12\x1f\n" +
+	"\vexternal_id\x18\x0f \x01(\tR\n" +
+	"externalId\"\x96\x01\n" +
 	"\x17CreateDepartmentRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x10\n" +
 	"\x03pid\x18\x03 \x01(\tR\x03pid\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"\x98\x01\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vexternal_id\x18\x05 \x01(\tR\n" +
+	"externalId\"\x98\x01\n" +
 	"\x18CreateDepartmentResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12>\n" +
 	"\n" +
 	"department\x18\x03 \x01(\v2\x1e.user_management.v1.DepartmentR\n" +
 	"department\x12\x10\n" +
-	"\x03msg\x18\x04 \x01(\tR\x03msg\")\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\"C\n" +
 	"\x17DeleteDepartmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"X\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"\xb2\x01\n" +
 	"\x18DeleteDepartmentResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
-	"\x03msg\x18\x03 \x01(\tR\x03msg\"\x85\x01\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12/\n" +
+	"\x13deleted_departments\x18\x04 \x01(\x05R\x12deletedDepartments\x12'\n" +
+	"\x0fremoved_members\x18\x05 \x01(\x05R\x0eremovedMembers\"\xbb\x01\n" +
 	"\x17UpdateDepartmentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04code\x18\x03 \x01(\tR\x04code\x12\x10\n" +
 	"\x03pid\x18\x04 \x01(\tR\x03pid\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x98\x01\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12$\n" +
+	"\vexternal_id\x18\x06 \x01(\tH\x00R\n" +
+	"externalId\x88\x01\x01B\x0e\n" +
+	"\f_external_id\"\x98\x01\n" +
 	"\x18UpdateDepartmentResponse\x12\x16\n" +
 	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12>\n" +
 	"\n" +
 	"department\x18\x03 \x01(\v2\x1e.user_management.v1.DepartmentR\n" +
 	"department\x12\x10\n" +
-	"\x03msg\x18\x04 \x01(\tR\x03msg\"&\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\"l\n" +
+	"\x15MoveDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03pid\x18\x02 \x01(\tR\x03pid\x12\"\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05H\x00R\tsortOrder\x88\x01\x01B\r\n" +
+	"\v_sort_order\"\xb2\x01\n" +
+	"\x16MoveDepartmentResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12>\n" +
+	"\n" +
+	"department\x18\x04 \x01(\v2\x1e.user_management.v1.DepartmentR\n" +
+	"department\x12\x1a\n" +
+	"\baffected\x18\x05 \x01(\x05R\baffected\"?\n" +
+	"\x19ReorderDepartmentsRequest\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\tR\x03pid\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\"\x9c\x01\n" +
+	"\x1aReorderDepartmentsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12@\n" +
+	"\vdepartments\x18\x04 \x03(\v2\x1e.user_management.v1.DepartmentR\vdepartments\"&\n" +
 	"\x14GetDepartmentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\xb2\x01\n" +
 	"\x16ListDepartmentsRequest\x12\x12\n" +
@@ -1021,22 +2951,155 @@ const file_user_management_v1_department_proto_rawDesc = "" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12@\n" +
 	"\vdepartments\x18\x02 \x03(\v2\x1e.user_management.v1.DepartmentR\vdepartments\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"]\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x90\x01\n" +
+	"\x0eDepartmentNode\x12>\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x1e.user_management.v1.DepartmentR\n" +
+	"department\x12>\n" +
+	"\bchildren\x18\x02 \x03(\v2\".user_management.v1.DepartmentNodeR\bchildren\"*\n" +
+	"\x18GetDepartmentTreeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x01\n" +
+	"\x19GetDepartmentTreeResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x128\n" +
+	"\x05nodes\x18\x04 \x03(\v2\".user_management.v1.DepartmentNodeR\x05nodes\"0\n" +
+	"\x1eListDepartmentAncestorsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa1\x01\n" +
+	"\x1fListDepartmentAncestorsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12@\n" +
+	"\vdepartments\x18\x04 \x03(\v2\x1e.user_management.v1.DepartmentR\vdepartments\"2\n" +
+	" ListDepartmentDescendantsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa3\x01\n" +
+	"!ListDepartmentDescendantsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12@\n" +
+	"\vdepartments\x18\x04 \x03(\v2\x1e.user_management.v1.DepartmentR\vdepartments\"w\n" +
 	"\x1bAddUsersToDepartmentRequest\x12#\n" +
 	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\x12\x19\n" +
-	"\buser_ids\x18\x02 \x03(\tR\auserIds\"b\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x18\n" +
+	"\aprimary\x18\x03 \x01(\bR\aprimary\"r\n" +
+	"\x1cAddUsersToDepartmentResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\x14\n" +
+	"\x05added\x18\x04 \x01(\x05R\x05added\"b\n" +
 	" RemoveUsersFromDepartmentRequest\x12#\n" +
 	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\x12\x19\n" +
-	"\buser_ids\x18\x02 \x03(\tR\auserIds\"r\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"{\n" +
+	"!RemoveUsersFromDepartmentResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12\x18\n" +
+	"\aremoved\x18\x04 \x01(\x05R\aremoved\"\xbd\x01\n" +
 	"\x1aListDepartmentUsersRequest\x12#\n" +
 	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize2\xc4\x04\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12/\n" +
+	"\x13include_descendants\x18\x04 \x01(\bR\x12includeDescendants\x12\x18\n" +
+	"\akeyword\x18\x05 \x01(\tR\akeyword\"\xb1\x01\n" +
+	"\x0eDepartmentUser\x122\n" +
+	"\x04user\x18\x01 \x01(\v2\x1e.user_management.v1.SimpleUserR\x04user\x12#\n" +
+	"\rdepartment_id\x18\x02 \x01(\tR\fdepartmentId\x12'\n" +
+	"\x0fdepartment_name\x18\x03 \x01(\tR\x0edepartmentName\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x04 \x01(\bR\tisPrimary\"\xbb\x02\n" +
+	"\x1bListDepartmentUsersResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12N\n" +
+	"\x04data\x18\x03 \x01(\v2:.user_management.v1.ListDepartmentUsersResponse.PageResultR\x04data\x12\x10\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\x1a\x8d\x01\n" +
+	"\n" +
+	"PageResult\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x128\n" +
+	"\x05users\x18\x02 \x03(\v2\".user_management.v1.DepartmentUserR\x05users\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"[\n" +
+	"\x1bSetPrimaryDepartmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rdepartment_id\x18\x02 \x01(\tR\fdepartmentId\"\\\n" +
+	"\x1cSetPrimaryDepartmentResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\"e\n" +
+	"\x1bSetDepartmentLeadersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\ahead_id\x18\x02 \x01(\tR\x06headId\x12\x1d\n" +
+	"\n" +
+	"deputy_ids\x18\x03 \x03(\tR\tdeputyIds\"\x9c\x01\n" +
+	"\x1cSetDepartmentLeadersResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12>\n" +
+	"\n" +
+	"department\x18\x04 \x01(\v2\x1e.user_management.v1.DepartmentR\n" +
+	"department\"W\n" +
+	"\x17GetReportingLineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rdepartment_id\x18\x02 \x01(\tR\fdepartmentId\"\x94\x01\n" +
+	"\x10ReportingManager\x122\n" +
+	"\x04user\x18\x01 \x01(\v2\x1e.user_management.v1.SimpleUserR\x04user\x12#\n" +
+	"\rdepartment_id\x18\x02 \x01(\tR\fdepartmentId\x12'\n" +
+	"\x0fdepartment_name\x18\x03 \x01(\tR\x0edepartmentName\"\xbf\x01\n" +
+	"\x18GetReportingLineResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12#\n" +
+	"\rdepartment_id\x18\x04 \x01(\tR\fdepartmentId\x12@\n" +
+	"\bmanagers\x18\x05 \x03(\v2$.user_management.v1.ReportingManagerR\bmanagers\"p\n" +
+	"\x12ListReportsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xab\x02\n" +
+	"\x13ListReportsResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12F\n" +
+	"\x04data\x18\x03 \x01(\v22.user_management.v1.ListReportsResponse.PageResultR\x04data\x12\x10\n" +
+	"\x03msg\x18\x04 \x01(\tR\x03msg\x1a\x8d\x01\n" +
+	"\n" +
+	"PageResult\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x128\n" +
+	"\x05users\x18\x02 \x03(\v2\".user_management.v1.DepartmentUserR\x05users\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc2\x01\n" +
+	"\x15SyncDepartmentRequest\x12\x1f\n" +
+	"\vexternal_id\x18\x01 \x01(\tR\n" +
+	"externalId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12,\n" +
+	"\x12parent_external_id\x18\x05 \x01(\tR\x10parentExternalId\x12\x10\n" +
+	"\x03pid\x18\x06 \x01(\tR\x03pid\"\xb0\x01\n" +
+	"\x16SyncDepartmentResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x03 \x01(\tR\x03msg\x12>\n" +
+	"\n" +
+	"department\x18\x04 \x01(\v2\x1e.user_management.v1.DepartmentR\n" +
+	"department\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\bR\acreated2\x8e\x15\n" +
 	"\x11DepartmentService\x12\x89\x01\n" +
 	"\x10CreateDepartment\x12+.user_management.v1.CreateDepartmentRequest\x1a,.user_management.v1.CreateDepartmentResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/departments\x12\x8b\x01\n" +
 	"\x10DeleteDepartment\x12+.user_management.v1.DeleteDepartmentRequest\x1a,.user_management.v1.DeleteDepartmentResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/departments/{id}\x12\x8e\x01\n" +
-	"\x10UpdateDepartment\x12+.user_management.v1.UpdateDepartmentRequest\x1a,.user_management.v1.UpdateDepartmentResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/departments/{id}\x12\x83\x01\n" +
-	"\x0fListDepartments\x12*.user_management.v1.ListDepartmentsRequest\x1a+.user_management.v1.ListDepartmentsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/departmentsB5Z3github.com/yc-alpha/admin/api/user_management/v1;v1b\x06proto3"
+	"\x10UpdateDepartment\x12+.user_management.v1.UpdateDepartmentRequest\x1a,.user_management.v1.UpdateDepartmentResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/departments/{id}\x12\x8d\x01\n" +
+	"\x0eMoveDepartment\x12).user_management.v1.MoveDepartmentRequest\x1a*.user_management.v1.MoveDepartmentResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/departments/{id}/move\x12\x97\x01\n" +
+	"\x12ReorderDepartments\x12-.user_management.v1.ReorderDepartmentsRequest\x1a..user_management.v1.ReorderDepartmentsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/departments/reorder\x12\x83\x01\n" +
+	"\x0fListDepartments\x12*.user_management.v1.ListDepartmentsRequest\x1a+.user_management.v1.ListDepartmentsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/departments\x12\x8e\x01\n" +
+	"\x11GetDepartmentTree\x12,.user_management.v1.GetDepartmentTreeRequest\x1a-.user_management.v1.GetDepartmentTreeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/departments/tree\x12\xaa\x01\n" +
+	"\x17ListDepartmentAncestors\x122.user_management.v1.ListDepartmentAncestorsRequest\x1a3.user_management.v1.ListDepartmentAncestorsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/departments/{id}/ancestors\x12\xb2\x01\n" +
+	"\x19ListDepartmentDescendants\x124.user_management.v1.ListDepartmentDescendantsRequest\x1a5.user_management.v1.ListDepartmentDescendantsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/departments/{id}/descendants\x12\xab\x01\n" +
+	"\x14AddUsersToDepartment\x12/.user_management.v1.AddUsersToDepartmentRequest\x1a0.user_management.v1.AddUsersToDepartmentResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/departments/{department_id}/users\x12\xc1\x01\n" +
+	"\x19RemoveUsersFromDepartment\x124.user_management.v1.RemoveUsersFromDepartmentRequest\x1a5.user_management.v1.RemoveUsersFromDepartmentResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/departments/{department_id}/users/remove\x12\xa5\x01\n" +
+	"\x13ListDepartmentUsers\x12..user_management.v1.ListDepartmentUsersRequest\x1a/.user_management.v1.ListDepartmentUsersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/departments/{department_id}/users\x12\xac\x01\n" +
+	"\x14SetPrimaryDepartment\x12/.user_management.v1.SetPrimaryDepartmentRequest\x1a0.user_management.v1.SetPrimaryDepartmentResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/users/{user_id}/primary-department\x12\x9a\x01\n" +
+	"\x0eSyncDepartment\x12).user_management.v1.SyncDepartmentRequest\x1a*.user_management.v1.SyncDepartmentResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v1/departments/external/{external_id}\x12\xa2\x01\n" +
+	"\x14SetDepartmentLeaders\x12/.user_management.v1.SetDepartmentLeadersRequest\x1a0.user_management.v1.SetDepartmentLeadersResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/v1/departments/{id}/leaders\x12\x99\x01\n" +
+	"\x10GetReportingLine\x12+.user_management.v1.GetReportingLineRequest\x1a,.user_management.v1.GetReportingLineResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/users/{user_id}/reporting-line\x12\x83\x01\n" +
+	"\vListReports\x12&.user_management.v1.ListReportsRequest\x1a'.user_management.v1.ListReportsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{user_id}/reportsB5Z3github.com/yc-alpha/admin/api/user_management/v1;v1b\x06proto3"
 
 var (
 	file_user_management_v1_department_proto_rawDescOnce sync.Once
@@ -1050,41 +3113,112 @@ func file_user_management_v1_department_proto_rawDescGZIP() []byte {
 	return file_user_management_v1_department_proto_rawDescData
 }
 
-var file_user_management_v1_department_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_user_management_v1_department_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_user_management_v1_department_proto_goTypes = []any{
-	(*Department)(nil),                         // 0: user_management.v1.Department
-	(*CreateDepartmentRequest)(nil),            // 1: user_management.v1.CreateDepartmentRequest
-	(*CreateDepartmentResponse)(nil),           // 2: user_management.v1.CreateDepartmentResponse
-	(*DeleteDepartmentRequest)(nil),            // 3: user_management.v1.DeleteDepartmentRequest
-	(*DeleteDepartmentResponse)(nil),           // 4: user_management.v1.DeleteDepartmentResponse
-	(*UpdateDepartmentRequest)(nil),            // 5: user_management.v1.UpdateDepartmentRequest
-	(*UpdateDepartmentResponse)(nil),           // 6: user_management.v1.UpdateDepartmentResponse
-	(*GetDepartmentRequest)(nil),               // 7: user_management.v1.GetDepartmentRequest
-	(*ListDepartmentsRequest)(nil),             // 8: user_management.v1.ListDepartmentsRequest
-	(*ListDepartmentsResponse)(nil),            // 9: user_management.v1.ListDepartmentsResponse
-	(*AddUsersToDepartmentRequest)(nil),        // 10: user_management.v1.AddUsersToDepartmentRequest
-	(*RemoveUsersFromDepartmentRequest)(nil),   // 11: user_management.v1.RemoveUsersFromDepartmentRequest
-	(*ListDepartmentUsersRequest)(nil),         // 12: user_management.v1.ListDepartmentUsersRequest
-	(*ListDepartmentsResponse_PageResult)(nil), // 13: user_management.v1.ListDepartmentsResponse.PageResult
+	(*Department)(nil),                             // 0: user_management.v1.Department
+	(*CreateDepartmentRequest)(nil),                // 1: user_management.v1.CreateDepartmentRequest
+	(*CreateDepartmentResponse)(nil),               // 2: user_management.v1.CreateDepartmentResponse
+	(*DeleteDepartmentRequest)(nil),                // 3: user_management.v1.DeleteDepartmentRequest
+	(*DeleteDepartmentResponse)(nil),               // 4: user_management.v1.DeleteDepartmentResponse
+	(*UpdateDepartmentRequest)(nil),                // 5: user_management.v1.UpdateDepartmentRequest
+	(*UpdateDepartmentResponse)(nil),               // 6: user_management.v1.UpdateDepartmentResponse
+	(*MoveDepartmentRequest)(nil),                  // 7: user_management.v1.MoveDepartmentRequest
+	(*MoveDepartmentResponse)(nil),                 // 8: user_management.v1.MoveDepartmentResponse
+	(*ReorderDepartmentsRequest)(nil),              // 9: user_management.v1.ReorderDepartmentsRequest
+	(*ReorderDepartmentsResponse)(nil),             // 10: user_management.v1.ReorderDepartmentsResponse
+	(*GetDepartmentRequest)(nil),                   // 11: user_management.v1.GetDepartmentRequest
+	(*ListDepartmentsRequest)(nil),                 // 12: user_management.v1.ListDepartmentsRequest
+	(*ListDepartmentsResponse)(nil),                // 13: user_management.v1.ListDepartmentsResponse
+	(*DepartmentNode)(nil),                         // 14: user_management.v1.DepartmentNode
+	(*GetDepartmentTreeRequest)(nil),               // 15: user_management.v1.GetDepartmentTreeRequest
+	(*GetDepartmentTreeResponse)(nil),              // 16: user_management.v1.GetDepartmentTreeResponse
+	(*ListDepartmentAncestorsRequest)(nil),         // 17: user_management.v1.ListDepartmentAncestorsRequest
+	(*ListDepartmentAncestorsResponse)(nil),        // 18: user_management.v1.ListDepartmentAncestorsResponse
+	(*ListDepartmentDescendantsRequest)(nil),       // 19: user_management.v1.ListDepartmentDescendantsRequest
+	(*ListDepartmentDescendantsResponse)(nil),      // 20: user_management.v1.ListDepartmentDescendantsResponse
+	(*AddUsersToDepartmentRequest)(nil),            // 21: user_management.v1.AddUsersToDepartmentRequest
+	(*AddUsersToDepartmentResponse)(nil),           // 22: user_management.v1.AddUsersToDepartmentResponse
+	(*RemoveUsersFromDepartmentRequest)(nil),       // 23: user_management.v1.RemoveUsersFromDepartmentRequest
+	(*RemoveUsersFromDepartmentResponse)(nil),      // 24: user_management.v1.RemoveUsersFromDepartmentResponse
+	(*ListDepartmentUsersRequest)(nil),             // 25: user_management.v1.ListDepartmentUsersRequest
+	(*DepartmentUser)(nil),                         // 26: user_management.v1.DepartmentUser
+	(*ListDepartmentUsersResponse)(nil),            // 27: user_management.v1.ListDepartmentUsersResponse
+	(*SetPrimaryDepartmentRequest)(nil),            // 28: user_management.v1.SetPrimaryDepartmentRequest
+	(*SetPrimaryDepartmentResponse)(nil),           // 29: user_management.v1.SetPrimaryDepartmentResponse
+	(*SetDepartmentLeadersRequest)(nil),            // 30: user_management.v1.SetDepartmentLeadersRequest
+	(*SetDepartmentLeadersResponse)(nil),           // 31: user_management.v1.SetDepartmentLeadersResponse
+	(*GetReportingLineRequest)(nil),                // 32: user_management.v1.GetReportingLineRequest
+	(*ReportingManager)(nil),                       // 33: user_management.v1.ReportingManager
+	(*GetReportingLineResponse)(nil),               // 34: user_management.v1.GetReportingLineResponse
+	(*ListReportsRequest)(nil),                     // 35: user_management.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),                    // 36: user_management.v1.ListReportsResponse
+	(*SyncDepartmentRequest)(nil),                  // 37: user_management.v1.SyncDepartmentRequest
+	(*SyncDepartmentResponse)(nil),                 // 38: user_management.v1.SyncDepartmentResponse
+	(*ListDepartmentsResponse_PageResult)(nil),     // 39: user_management.v1.ListDepartmentsResponse.PageResult
+	(*ListDepartmentUsersResponse_PageResult)(nil), // 40: user_management.v1.ListDepartmentUsersResponse.PageResult
+	(*ListReportsResponse_PageResult)(nil),         // 41: user_management.v1.ListReportsResponse.PageResult
+	(*SimpleUser)(nil),                             // 42: user_management.v1.SimpleUser
 }
 var file_user_management_v1_department_proto_depIdxs = []int32{
 	0,  // 0: user_management.v1.CreateDepartmentResponse.department:type_name -> user_management.v1.Department
 	0,  // 1: user_management.v1.UpdateDepartmentResponse.department:type_name -> user_management.v1.Department
-	13, // 2: user_management.v1.ListDepartmentsResponse.data:type_name -> user_management.v1.ListDepartmentsResponse.PageResult
-	0,  // 3: user_management.v1.ListDepartmentsResponse.PageResult.departments:type_name -> user_management.v1.Department
-	1,  // 4: user_management.v1.DepartmentService.CreateDepartment:input_type -> user_management.v1.CreateDepartmentRequest
-	3,  // 5: user_management.v1.DepartmentService.DeleteDepartment:input_type -> user_management.v1.DeleteDepartmentRequest
-	5,  // 6: user_management.v1.DepartmentService.UpdateDepartment:input_type -> user_management.v1.UpdateDepartmentRequest
-	8,  // 7: user_management.v1.DepartmentService.ListDepartments:input_type -> user_management.v1.ListDepartmentsRequest
-	2,  // 8: user_management.v1.DepartmentService.CreateDepartment:output_type -> user_management.v1.CreateDepartmentResponse
-	4,  // 9: user_management.v1.DepartmentService.DeleteDepartment:output_type -> user_management.v1.DeleteDepartmentResponse
-	6,  // 10: user_management.v1.DepartmentService.UpdateDepartment:output_type -> user_management.v1.UpdateDepartmentResponse
-	9,  // 11: user_management.v1.DepartmentService.ListDepartments:output_type -> user_management.v1.ListDepartmentsResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 2: user_management.v1.MoveDepartmentResponse.department:type_name -> user_management.v1.Department
+	0,  // 3: user_management.v1.ReorderDepartmentsResponse.departments:type_name -> user_management.v1.Department
+	39, // 4: user_management.v1.ListDepartmentsResponse.data:type_name -> user_management.v1.ListDepartmentsResponse.PageResult
+	0,  // 5: user_management.v1.DepartmentNode.department:type_name -> user_management.v1.Department
+	14, // 6: user_management.v1.DepartmentNode.children:type_name -> user_management.v1.DepartmentNode
+	14, // 7: user_management.v1.GetDepartmentTreeResponse.nodes:type_name -> user_management.v1.DepartmentNode
+	0,  // 8: user_management.v1.ListDepartmentAncestorsResponse.departments:type_name -> user_management.v1.Department
+	0,  // 9: user_management.v1.ListDepartmentDescendantsResponse.departments:type_name -> user_management.v1.Department
+	42, // 10: user_management.v1.DepartmentUser.user:type_name -> user_management.v1.SimpleUser
+	40, // 11: user_management.v1.ListDepartmentUsersResponse.data:type_name -> user_management.v1.ListDepartmentUsersResponse.PageResult
+	0,  // 12: user_management.v1.SetDepartmentLeadersResponse.department:type_name -> user_management.v1.Department
+	42, // 13: user_management.v1.ReportingManager.user:type_name -> user_management.v1.SimpleUser
+	33, // 14: user_management.v1.GetReportingLineResponse.managers:type_name -> user_management.v1.ReportingManager
+	41, // 15: user_management.v1.ListReportsResponse.data:type_name -> user_management.v1.ListReportsResponse.PageResult
+	0,  // 16: user_management.v1.SyncDepartmentResponse.department:type_name -> user_management.v1.Department
+	0,  // 17: user_management.v1.ListDepartmentsResponse.PageResult.departments:type_name -> user_management.v1.Department
+	26, // 18: user_management.v1.ListDepartmentUsersResponse.PageResult.users:type_name -> user_management.v1.DepartmentUser
+	26, // 19: user_management.v1.ListReportsResponse.PageResult.users:type_name -> user_management.v1.DepartmentUser
+	1,  // 20: user_management.v1.DepartmentService.CreateDepartment:input_type -> user_management.v1.CreateDepartmentRequest
+	3,  // 21: user_management.v1.DepartmentService.DeleteDepartment:input_type -> user_management.v1.DeleteDepartmentRequest
+	5,  // 22: user_management.v1.DepartmentService.UpdateDepartment:input_type -> user_management.v1.UpdateDepartmentRequest
+	7,  // 23: user_management.v1.DepartmentService.MoveDepartment:input_type -> user_management.v1.MoveDepartmentRequest
+	9,  // 24: user_management.v1.DepartmentService.ReorderDepartments:input_type -> user_management.v1.ReorderDepartmentsRequest
+	12, // 25: user_management.v1.DepartmentService.ListDepartments:input_type -> user_management.v1.ListDepartmentsRequest
+	15, // 26: user_management.v1.DepartmentService.GetDepartmentTree:input_type -> user_management.v1.GetDepartmentTreeRequest
+	17, // 27: user_management.v1.DepartmentService.ListDepartmentAncestors:input_type -> user_management.v1.ListDepartmentAncestorsRequest
+	19, // 28: user_management.v1.DepartmentService.ListDepartmentDescendants:input_type -> user_management.v1.ListDepartmentDescendantsRequest
+	21, // 29: user_management.v1.DepartmentService.AddUsersToDepartment:input_type -> user_management.v1.AddUsersToDepartmentRequest
+	23, // 30: user_management.v1.DepartmentService.RemoveUsersFromDepartment:input_type -> user_management.v1.RemoveUsersFromDepartmentRequest
+	25, // 31: user_management.v1.DepartmentService.ListDepartmentUsers:input_type -> user_management.v1.ListDepartmentUsersRequest
+	28, // 32: user_management.v1.DepartmentService.SetPrimaryDepartment:input_type -> user_management.v1.SetPrimaryDepartmentRequest
+	37, // 33: user_management.v1.DepartmentService.SyncDepartment:input_type -> user_management.v1.SyncDepartmentRequest
+	30, // 34: user_management.v1.DepartmentService.SetDepartmentLeaders:input_type -> user_management.v1.SetDepartmentLeadersRequest
+	32, // 35: user_management.v1.DepartmentService.GetReportingLine:input_type -> user_management.v1.GetReportingLineRequest
+	35, // 36: user_management.v1.DepartmentService.ListReports:input_type -> user_management.v1.ListReportsRequest
+	2,  // 37: user_management.v1.DepartmentService.CreateDepartment:output_type -> user_management.v1.CreateDepartmentResponse
+	4,  // 38: user_management.v1.DepartmentService.DeleteDepartment:output_type -> user_management.v1.DeleteDepartmentResponse
+	6,  // 39: user_management.v1.DepartmentService.UpdateDepartment:output_type -> user_management.v1.UpdateDepartmentResponse
+	8,  // 40: user_management.v1.DepartmentService.MoveDepartment:output_type -> user_management.v1.MoveDepartmentResponse
+	10, // 41: user_management.v1.DepartmentService.ReorderDepartments:output_type -> user_management.v1.ReorderDepartmentsResponse
+	13, // 42: user_management.v1.DepartmentService.ListDepartments:output_type -> user_management.v1.ListDepartmentsResponse
+	16, // 43: user_management.v1.DepartmentService.GetDepartmentTree:output_type -> user_management.v1.GetDepartmentTreeResponse
+	18, // 44: user_management.v1.DepartmentService.ListDepartmentAncestors:output_type -> user_management.v1.ListDepartmentAncestorsResponse
+	20, // 45: user_management.v1.DepartmentService.ListDepartmentDescendants:output_type -> user_management.v1.ListDepartmentDescendantsResponse
+	22, // 46: user_management.v1.DepartmentService.AddUsersToDepartment:output_type -> user_management.v1.AddUsersToDepartmentResponse
+	24, // 47: user_management.v1.DepartmentService.RemoveUsersFromDepartment:output_type -> user_management.v1.RemoveUsersFromDepartmentResponse
+	27, // 48: user_management.v1.DepartmentService.ListDepartmentUsers:output_type -> user_management.v1.ListDepartmentUsersResponse
+	29, // 49: user_management.v1.DepartmentService.SetPrimaryDepartment:output_type -> user_management.v1.SetPrimaryDepartmentResponse
+	38, // 50: user_management.v1.DepartmentService.SyncDepartment:output_type -> user_management.v1.SyncDepartmentResponse
+	31, // 51: user_management.v1.DepartmentService.SetDepartmentLeaders:output_type -> user_management.v1.SetDepartmentLeadersResponse
+	34, // 52: user_management.v1.DepartmentService.GetReportingLine:output_type -> user_management.v1.GetReportingLineResponse
+	36, // 53: user_management.v1.DepartmentService.ListReports:output_type -> user_management.v1.ListReportsResponse
+	37, // [37:54] is the sub-list for method output_type
+	20, // [20:37] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_user_management_v1_department_proto_init() }
@@ -1092,13 +3226,16 @@ func file_user_management_v1_department_proto_init() {
 	if File_user_management_v1_department_proto != nil {
 		return
 	}
+	file_user_management_v1_user_proto_init()
+	file_user_management_v1_department_proto_msgTypes[5].OneofWrappers = []any{}
+	file_user_management_v1_department_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_management_v1_department_proto_rawDesc), len(file_user_management_v1_department_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
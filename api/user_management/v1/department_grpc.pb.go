@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DepartmentService_CreateDepartment_FullMethodName = "/user_management.v1.DepartmentService/CreateDepartment"
-	DepartmentService_DeleteDepartment_FullMethodName = "/user_management.v1.DepartmentService/DeleteDepartment"
-	DepartmentService_UpdateDepartment_FullMethodName = "/user_management.v1.DepartmentService/UpdateDepartment"
-	DepartmentService_ListDepartments_FullMethodName  = "/user_management.v1.DepartmentService/ListDepartments"
+	DepartmentService_CreateDepartment_FullMethodName          = "/user_management.v1.DepartmentService/CreateDepartment"
+	DepartmentService_DeleteDepartment_FullMethodName          = "/user_management.v1.DepartmentService/DeleteDepartment"
+	DepartmentService_UpdateDepartment_FullMethodName          = "/user_management.v1.DepartmentService/UpdateDepartment"
+	DepartmentService_MoveDepartment_FullMethodName            = "/user_management.v1.DepartmentService/MoveDepartment"
+	DepartmentService_ReorderDepartments_FullMethodName        = "/user_management.v1.DepartmentService/ReorderDepartments"
+	DepartmentService_ListDepartments_FullMethodName           = "/user_management.v1.DepartmentService/ListDepartments"
+	DepartmentService_GetDepartmentTree_FullMethodName         = "/user_management.v1.DepartmentService/GetDepartmentTree"
+	DepartmentService_ListDepartmentAncestors_FullMethodName   = "/user_management.v1.DepartmentService/ListDepartmentAncestors"
+	DepartmentService_ListDepartmentDescendants_FullMethodName = "/user_management.v1.DepartmentService/ListDepartmentDescendants"
+	DepartmentService_AddUsersToDepartment_FullMethodName      = "/user_management.v1.DepartmentService/AddUsersToDepartment"
+	DepartmentService_RemoveUsersFromDepartment_FullMethodName = "/user_management.v1.DepartmentService/RemoveUsersFromDepartment"
+	DepartmentService_ListDepartmentUsers_FullMethodName       = "/user_management.v1.DepartmentService/ListDepartmentUsers"
+	DepartmentService_SetPrimaryDepartment_FullMethodName      = "/user_management.v1.DepartmentService/SetPrimaryDepartment"
+	DepartmentService_SyncDepartment_FullMethodName            = "/user_management.v1.DepartmentService/SyncDepartment"
+	DepartmentService_SetDepartmentLeaders_FullMethodName      = "/user_management.v1.DepartmentService/SetDepartmentLeaders"
+	DepartmentService_GetReportingLine_FullMethodName          = "/user_management.v1.DepartmentService/GetReportingLine"
+	DepartmentService_ListReports_FullMethodName               = "/user_management.v1.DepartmentService/ListReports"
 )
 
 // DepartmentServiceClient is the client API for DepartmentService service.
@@ -31,12 +44,40 @@ const (
 type DepartmentServiceClient interface {
 	// 创建部门
 	CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*CreateDepartmentResponse, error)
-	// 删除部门
+	// 删除部门，有下级部门或成员时需指定cascade
 	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error)
 	// 更新部门
 	UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*UpdateDepartmentResponse, error)
+	// 移动部门，连同下级部门一起挂到新的上级部门下
+	MoveDepartment(ctx context.Context, in *MoveDepartmentRequest, opts ...grpc.CallOption) (*MoveDepartmentResponse, error)
+	// 调整同级部门的顺序
+	ReorderDepartments(ctx context.Context, in *ReorderDepartmentsRequest, opts ...grpc.CallOption) (*ReorderDepartmentsResponse, error)
 	// 获取部门列表
 	ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error)
+	// 获取部门树，指定id时只返回该部门及其下级部门
+	GetDepartmentTree(ctx context.Context, in *GetDepartmentTreeRequest, opts ...grpc.CallOption) (*GetDepartmentTreeResponse, error)
+	// 获取部门的所有上级部门，从根部门开始
+	ListDepartmentAncestors(ctx context.Context, in *ListDepartmentAncestorsRequest, opts ...grpc.CallOption) (*ListDepartmentAncestorsResponse, error)
+	// 获取部门的所有下级部门，按路径排序
+	ListDepartmentDescendants(ctx context.Context, in *ListDepartmentDescendantsRequest, opts ...grpc.CallOption) (*ListDepartmentDescendantsResponse, error)
+	//    rpc GetDepartment(GetDepartmentRequest) returns (DepartmentResponse) {}
+	// 用户-部门关联操作
+	// 添加部门成员，用户需已是当前租户的成员
+	AddUsersToDepartment(ctx context.Context, in *AddUsersToDepartmentRequest, opts ...grpc.CallOption) (*AddUsersToDepartmentResponse, error)
+	// 移除部门成员，移除的是主部门时由其余部门中最早加入的一个接替
+	RemoveUsersFromDepartment(ctx context.Context, in *RemoveUsersFromDepartmentRequest, opts ...grpc.CallOption) (*RemoveUsersFromDepartmentResponse, error)
+	// 获取部门成员，可包含所有下级部门的成员
+	ListDepartmentUsers(ctx context.Context, in *ListDepartmentUsersRequest, opts ...grpc.CallOption) (*ListDepartmentUsersResponse, error)
+	// 设置用户在当前租户下的主部门，用户需已在该部门中
+	SetPrimaryDepartment(ctx context.Context, in *SetPrimaryDepartmentRequest, opts ...grpc.CallOption) (*SetPrimaryDepartmentResponse, error)
+	// 按外部系统的部门ID创建或更新部门，供HR系统同步，重复调用结果不变
+	SyncDepartment(ctx context.Context, in *SyncDepartmentRequest, opts ...grpc.CallOption) (*SyncDepartmentResponse, error)
+	// 设置部门负责人和副职，负责人为空表示空缺
+	SetDepartmentLeaders(ctx context.Context, in *SetDepartmentLeadersRequest, opts ...grpc.CallOption) (*SetDepartmentLeadersResponse, error)
+	// 获取用户的汇报线，从直接主管开始沿部门路径向上，跳过空缺的负责人
+	GetReportingLine(ctx context.Context, in *GetReportingLineRequest, opts ...grpc.CallOption) (*GetReportingLineResponse, error)
+	// 获取向用户汇报的成员，默认只返回直接下属
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
 }
 
 type departmentServiceClient struct {
@@ -77,6 +118,26 @@ func (c *departmentServiceClient) UpdateDepartment(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *departmentServiceClient) MoveDepartment(ctx context.Context, in *MoveDepartmentRequest, opts ...grpc.CallOption) (*MoveDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_MoveDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ReorderDepartments(ctx context.Context, in *ReorderDepartmentsRequest, opts ...grpc.CallOption) (*ReorderDepartmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderDepartmentsResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ReorderDepartments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *departmentServiceClient) ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListDepartmentsResponse)
@@ -87,18 +148,156 @@ func (c *departmentServiceClient) ListDepartments(ctx context.Context, in *ListD
 	return out, nil
 }
 
+func (c *departmentServiceClient) GetDepartmentTree(ctx context.Context, in *GetDepartmentTreeRequest, opts ...grpc.CallOption) (*GetDepartmentTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDepartmentTreeResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_GetDepartmentTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ListDepartmentAncestors(ctx context.Context, in *ListDepartmentAncestorsRequest, opts ...grpc.CallOption) (*ListDepartmentAncestorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentAncestorsResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ListDepartmentAncestors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ListDepartmentDescendants(ctx context.Context, in *ListDepartmentDescendantsRequest, opts ...grpc.CallOption) (*ListDepartmentDescendantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentDescendantsResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ListDepartmentDescendants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) AddUsersToDepartment(ctx context.Context, in *AddUsersToDepartmentRequest, opts ...grpc.CallOption) (*AddUsersToDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddUsersToDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_AddUsersToDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) RemoveUsersFromDepartment(ctx context.Context, in *RemoveUsersFromDepartmentRequest, opts ...grpc.CallOption) (*RemoveUsersFromDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveUsersFromDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_RemoveUsersFromDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ListDepartmentUsers(ctx context.Context, in *ListDepartmentUsersRequest, opts ...grpc.CallOption) (*ListDepartmentUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentUsersResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ListDepartmentUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) SetPrimaryDepartment(ctx context.Context, in *SetPrimaryDepartmentRequest, opts ...grpc.CallOption) (*SetPrimaryDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_SetPrimaryDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) SyncDepartment(ctx context.Context, in *SyncDepartmentRequest, opts ...grpc.CallOption) (*SyncDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_SyncDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) SetDepartmentLeaders(ctx context.Context, in *SetDepartmentLeadersRequest, opts ...grpc.CallOption) (*SetDepartmentLeadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDepartmentLeadersResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_SetDepartmentLeaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) GetReportingLine(ctx context.Context, in *GetReportingLineRequest, opts ...grpc.CallOption) (*GetReportingLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportingLineResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_GetReportingLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DepartmentServiceServer is the server API for DepartmentService service.
 // All implementations must embed UnimplementedDepartmentServiceServer
 // for forward compatibility.
 type DepartmentServiceServer interface {
 	// 创建部门
 	CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error)
-	// 删除部门
+	// 删除部门，有下级部门或成员时需指定cascade
 	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
 	// 更新部门
 	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error)
+	// 移动部门，连同下级部门一起挂到新的上级部门下
+	MoveDepartment(context.Context, *MoveDepartmentRequest) (*MoveDepartmentResponse, error)
+	// 调整同级部门的顺序
+	ReorderDepartments(context.Context, *ReorderDepartmentsRequest) (*ReorderDepartmentsResponse, error)
 	// 获取部门列表
 	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	// 获取部门树，指定id时只返回该部门及其下级部门
+	GetDepartmentTree(context.Context, *GetDepartmentTreeRequest) (*GetDepartmentTreeResponse, error)
+	// 获取部门的所有上级部门，从根部门开始
+	ListDepartmentAncestors(context.Context, *ListDepartmentAncestorsRequest) (*ListDepartmentAncestorsResponse, error)
+	// 获取部门的所有下级部门，按路径排序
+	ListDepartmentDescendants(context.Context, *ListDepartmentDescendantsRequest) (*ListDepartmentDescendantsResponse, error)
+	//    rpc GetDepartment(GetDepartmentRequest) returns (DepartmentResponse) {}
+	// 用户-部门关联操作
+	// 添加部门成员，用户需已是当前租户的成员
+	AddUsersToDepartment(context.Context, *AddUsersToDepartmentRequest) (*AddUsersToDepartmentResponse, error)
+	// 移除部门成员，移除的是主部门时由其余部门中最早加入的一个接替
+	RemoveUsersFromDepartment(context.Context, *RemoveUsersFromDepartmentRequest) (*RemoveUsersFromDepartmentResponse, error)
+	// 获取部门成员，可包含所有下级部门的成员
+	ListDepartmentUsers(context.Context, *ListDepartmentUsersRequest) (*ListDepartmentUsersResponse, error)
+	// 设置用户在当前租户下的主部门，用户需已在该部门中
+	SetPrimaryDepartment(context.Context, *SetPrimaryDepartmentRequest) (*SetPrimaryDepartmentResponse, error)
+	// 按外部系统的部门ID创建或更新部门，供HR系统同步，重复调用结果不变
+	SyncDepartment(context.Context, *SyncDepartmentRequest) (*SyncDepartmentResponse, error)
+	// 设置部门负责人和副职，负责人为空表示空缺
+	SetDepartmentLeaders(context.Context, *SetDepartmentLeadersRequest) (*SetDepartmentLeadersResponse, error)
+	// 获取用户的汇报线，从直接主管开始沿部门路径向上，跳过空缺的负责人
+	GetReportingLine(context.Context, *GetReportingLineRequest) (*GetReportingLineResponse, error)
+	// 获取向用户汇报的成员，默认只返回直接下属
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
 	mustEmbedUnimplementedDepartmentServiceServer()
 }
 
@@ -118,9 +317,48 @@ func (UnimplementedDepartmentServiceServer) DeleteDepartment(context.Context, *D
 func (UnimplementedDepartmentServiceServer) UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDepartment not implemented")
 }
+func (UnimplementedDepartmentServiceServer) MoveDepartment(context.Context, *MoveDepartmentRequest) (*MoveDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ReorderDepartments(context.Context, *ReorderDepartmentsRequest) (*ReorderDepartmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderDepartments not implemented")
+}
 func (UnimplementedDepartmentServiceServer) ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDepartments not implemented")
 }
+func (UnimplementedDepartmentServiceServer) GetDepartmentTree(context.Context, *GetDepartmentTreeRequest) (*GetDepartmentTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentTree not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ListDepartmentAncestors(context.Context, *ListDepartmentAncestorsRequest) (*ListDepartmentAncestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartmentAncestors not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ListDepartmentDescendants(context.Context, *ListDepartmentDescendantsRequest) (*ListDepartmentDescendantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartmentDescendants not implemented")
+}
+func (UnimplementedDepartmentServiceServer) AddUsersToDepartment(context.Context, *AddUsersToDepartmentRequest) (*AddUsersToDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUsersToDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) RemoveUsersFromDepartment(context.Context, *RemoveUsersFromDepartmentRequest) (*RemoveUsersFromDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUsersFromDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ListDepartmentUsers(context.Context, *ListDepartmentUsersRequest) (*ListDepartmentUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartmentUsers not implemented")
+}
+func (UnimplementedDepartmentServiceServer) SetPrimaryDepartment(context.Context, *SetPrimaryDepartmentRequest) (*SetPrimaryDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) SyncDepartment(context.Context, *SyncDepartmentRequest) (*SyncDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) SetDepartmentLeaders(context.Context, *SetDepartmentLeadersRequest) (*SetDepartmentLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDepartmentLeaders not implemented")
+}
+func (UnimplementedDepartmentServiceServer) GetReportingLine(context.Context, *GetReportingLineRequest) (*GetReportingLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportingLine not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
 func (UnimplementedDepartmentServiceServer) mustEmbedUnimplementedDepartmentServiceServer() {}
 func (UnimplementedDepartmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -196,6 +434,42 @@ func _DepartmentService_UpdateDepartment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepartmentService_MoveDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).MoveDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_MoveDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).MoveDepartment(ctx, req.(*MoveDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ReorderDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderDepartmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ReorderDepartments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ReorderDepartments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ReorderDepartments(ctx, req.(*ReorderDepartmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DepartmentService_ListDepartments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDepartmentsRequest)
 	if err := dec(in); err != nil {
@@ -214,6 +488,204 @@ func _DepartmentService_ListDepartments_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepartmentService_GetDepartmentTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).GetDepartmentTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_GetDepartmentTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).GetDepartmentTree(ctx, req.(*GetDepartmentTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ListDepartmentAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentAncestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ListDepartmentAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ListDepartmentAncestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ListDepartmentAncestors(ctx, req.(*ListDepartmentAncestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ListDepartmentDescendants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentDescendantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ListDepartmentDescendants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ListDepartmentDescendants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ListDepartmentDescendants(ctx, req.(*ListDepartmentDescendantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_AddUsersToDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUsersToDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).AddUsersToDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_AddUsersToDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).AddUsersToDepartment(ctx, req.(*AddUsersToDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_RemoveUsersFromDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUsersFromDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).RemoveUsersFromDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_RemoveUsersFromDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).RemoveUsersFromDepartment(ctx, req.(*RemoveUsersFromDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ListDepartmentUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ListDepartmentUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ListDepartmentUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ListDepartmentUsers(ctx, req.(*ListDepartmentUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_SetPrimaryDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).SetPrimaryDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_SetPrimaryDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).SetPrimaryDepartment(ctx, req.(*SetPrimaryDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_SyncDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).SyncDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_SyncDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).SyncDepartment(ctx, req.(*SyncDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_SetDepartmentLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDepartmentLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).SetDepartmentLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_SetDepartmentLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).SetDepartmentLeaders(ctx, req.(*SetDepartmentLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_GetReportingLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportingLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).GetReportingLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_GetReportingLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).GetReportingLine(ctx, req.(*GetReportingLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DepartmentService_ServiceDesc is the grpc.ServiceDesc for DepartmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,10 +705,62 @@ var DepartmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDepartment",
 			Handler:    _DepartmentService_UpdateDepartment_Handler,
 		},
+		{
+			MethodName: "MoveDepartment",
+			Handler:    _DepartmentService_MoveDepartment_Handler,
+		},
+		{
+			MethodName: "ReorderDepartments",
+			Handler:    _DepartmentService_ReorderDepartments_Handler,
+		},
 		{
 			MethodName: "ListDepartments",
 			Handler:    _DepartmentService_ListDepartments_Handler,
 		},
+		{
+			MethodName: "GetDepartmentTree",
+			Handler:    _DepartmentService_GetDepartmentTree_Handler,
+		},
+		{
+			MethodName: "ListDepartmentAncestors",
+			Handler:    _DepartmentService_ListDepartmentAncestors_Handler,
+		},
+		{
+			MethodName: "ListDepartmentDescendants",
+			Handler:    _DepartmentService_ListDepartmentDescendants_Handler,
+		},
+		{
+			MethodName: "AddUsersToDepartment",
+			Handler:    _DepartmentService_AddUsersToDepartment_Handler,
+		},
+		{
+			MethodName: "RemoveUsersFromDepartment",
+			Handler:    _DepartmentService_RemoveUsersFromDepartment_Handler,
+		},
+		{
+			MethodName: "ListDepartmentUsers",
+			Handler:    _DepartmentService_ListDepartmentUsers_Handler,
+		},
+		{
+			MethodName: "SetPrimaryDepartment",
+			Handler:    _DepartmentService_SetPrimaryDepartment_Handler,
+		},
+		{
+			MethodName: "SyncDepartment",
+			Handler:    _DepartmentService_SyncDepartment_Handler,
+		},
+		{
+			MethodName: "SetDepartmentLeaders",
+			Handler:    _DepartmentService_SetDepartmentLeaders_Handler,
+		},
+		{
+			MethodName: "GetReportingLine",
+			Handler:    _DepartmentService_GetReportingLine_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _DepartmentService_ListReports_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_management/v1/department.proto",
@@ -19,18 +19,59 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationDepartmentServiceAddUsersToDepartment = "/user_management.v1.DepartmentService/AddUsersToDepartment"
 const OperationDepartmentServiceCreateDepartment = "/user_management.v1.DepartmentService/CreateDepartment"
 const OperationDepartmentServiceDeleteDepartment = "/user_management.v1.DepartmentService/DeleteDepartment"
+const OperationDepartmentServiceGetDepartmentTree = "/user_management.v1.DepartmentService/GetDepartmentTree"
+const OperationDepartmentServiceGetReportingLine = "/user_management.v1.DepartmentService/GetReportingLine"
+const OperationDepartmentServiceListDepartmentAncestors = "/user_management.v1.DepartmentService/ListDepartmentAncestors"
+const OperationDepartmentServiceListDepartmentDescendants = "/user_management.v1.DepartmentService/ListDepartmentDescendants"
+const OperationDepartmentServiceListDepartmentUsers = "/user_management.v1.DepartmentService/ListDepartmentUsers"
 const OperationDepartmentServiceListDepartments = "/user_management.v1.DepartmentService/ListDepartments"
+const OperationDepartmentServiceListReports = "/user_management.v1.DepartmentService/ListReports"
+const OperationDepartmentServiceMoveDepartment = "/user_management.v1.DepartmentService/MoveDepartment"
+const OperationDepartmentServiceRemoveUsersFromDepartment = "/user_management.v1.DepartmentService/RemoveUsersFromDepartment"
+const OperationDepartmentServiceReorderDepartments = "/user_management.v1.DepartmentService/ReorderDepartments"
+const OperationDepartmentServiceSetDepartmentLeaders = "/user_management.v1.DepartmentService/SetDepartmentLeaders"
+const OperationDepartmentServiceSetPrimaryDepartment = "/user_management.v1.DepartmentService/SetPrimaryDepartment"
+const OperationDepartmentServiceSyncDepartment = "/user_management.v1.DepartmentService/SyncDepartment"
 const OperationDepartmentServiceUpdateDepartment = "/user_management.v1.DepartmentService/UpdateDepartment"
 
 type DepartmentServiceHTTPServer interface {
+	// AddUsersToDepartment    rpc GetDepartment(GetDepartmentRequest) returns (DepartmentResponse) {}
+	// 用户-部门关联操作
+	// 添加部门成员，用户需已是当前租户的成员
+	AddUsersToDepartment(context.Context, *AddUsersToDepartmentRequest) (*AddUsersToDepartmentResponse, error)
 	// CreateDepartment 创建部门
 	CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error)
-	// DeleteDepartment 删除部门
+	// DeleteDepartment 删除部门，有下级部门或成员时需指定cascade
 	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
+	// GetDepartmentTree 获取部门树，指定id时只返回该部门及其下级部门
+	GetDepartmentTree(context.Context, *GetDepartmentTreeRequest) (*GetDepartmentTreeResponse, error)
+	// GetReportingLine 获取用户的汇报线，从直接主管开始沿部门路径向上，跳过空缺的负责人
+	GetReportingLine(context.Context, *GetReportingLineRequest) (*GetReportingLineResponse, error)
+	// ListDepartmentAncestors 获取部门的所有上级部门，从根部门开始
+	ListDepartmentAncestors(context.Context, *ListDepartmentAncestorsRequest) (*ListDepartmentAncestorsResponse, error)
+	// ListDepartmentDescendants 获取部门的所有下级部门，按路径排序
+	ListDepartmentDescendants(context.Context, *ListDepartmentDescendantsRequest) (*ListDepartmentDescendantsResponse, error)
+	// ListDepartmentUsers 获取部门成员，可包含所有下级部门的成员
+	ListDepartmentUsers(context.Context, *ListDepartmentUsersRequest) (*ListDepartmentUsersResponse, error)
 	// ListDepartments 获取部门列表
 	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	// ListReports 获取向用户汇报的成员，默认只返回直接下属
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	// MoveDepartment 移动部门，连同下级部门一起挂到新的上级部门下
+	MoveDepartment(context.Context, *MoveDepartmentRequest) (*MoveDepartmentResponse, error)
+	// RemoveUsersFromDepartment 移除部门成员，移除的是主部门时由其余部门中最早加入的一个接替
+	RemoveUsersFromDepartment(context.Context, *RemoveUsersFromDepartmentRequest) (*RemoveUsersFromDepartmentResponse, error)
+	// ReorderDepartments 调整同级部门的顺序
+	ReorderDepartments(context.Context, *ReorderDepartmentsRequest) (*ReorderDepartmentsResponse, error)
+	// SetDepartmentLeaders 设置部门负责人和副职，负责人为空表示空缺
+	SetDepartmentLeaders(context.Context, *SetDepartmentLeadersRequest) (*SetDepartmentLeadersResponse, error)
+	// SetPrimaryDepartment 设置用户在当前租户下的主部门，用户需已在该部门中
+	SetPrimaryDepartment(context.Context, *SetPrimaryDepartmentRequest) (*SetPrimaryDepartmentResponse, error)
+	// SyncDepartment 按外部系统的部门ID创建或更新部门，供HR系统同步，重复调用结果不变
+	SyncDepartment(context.Context, *SyncDepartmentRequest) (*SyncDepartmentResponse, error)
 	// UpdateDepartment 更新部门
 	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error)
 }
@@ -40,7 +81,20 @@ func RegisterDepartmentServiceHTTPServer(s *http.Server, srv DepartmentServiceHT
 	r.POST("/v1/departments", _DepartmentService_CreateDepartment0_HTTP_Handler(srv))
 	r.DELETE("/v1/departments/{id}", _DepartmentService_DeleteDepartment0_HTTP_Handler(srv))
 	r.PUT("/v1/departments/{id}", _DepartmentService_UpdateDepartment0_HTTP_Handler(srv))
+	r.POST("/v1/departments/{id}/move", _DepartmentService_MoveDepartment0_HTTP_Handler(srv))
+	r.POST("/v1/departments/reorder", _DepartmentService_ReorderDepartments0_HTTP_Handler(srv))
 	r.GET("/v1/departments", _DepartmentService_ListDepartments0_HTTP_Handler(srv))
+	r.GET("/v1/departments/tree", _DepartmentService_GetDepartmentTree0_HTTP_Handler(srv))
+	r.GET("/v1/departments/{id}/ancestors", _DepartmentService_ListDepartmentAncestors0_HTTP_Handler(srv))
+	r.GET("/v1/departments/{id}/descendants", _DepartmentService_ListDepartmentDescendants0_HTTP_Handler(srv))
+	r.POST("/v1/departments/{department_id}/users", _DepartmentService_AddUsersToDepartment0_HTTP_Handler(srv))
+	r.POST("/v1/departments/{department_id}/users/remove", _DepartmentService_RemoveUsersFromDepartment0_HTTP_Handler(srv))
+	r.GET("/v1/departments/{department_id}/users", _DepartmentService_ListDepartmentUsers0_HTTP_Handler(srv))
+	r.PUT("/v1/users/{user_id}/primary-department", _DepartmentService_SetPrimaryDepartment0_HTTP_Handler(srv))
+	r.PUT("/v1/departments/external/{external_id}", _DepartmentService_SyncDepartment0_HTTP_Handler(srv))
+	r.PUT("/v1/departments/{id}/leaders", _DepartmentService_SetDepartmentLeaders0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/reporting-line", _DepartmentService_GetReportingLine0_HTTP_Handler(srv))
+	r.GET("/v1/users/{user_id}/reports", _DepartmentService_ListReports0_HTTP_Handler(srv))
 }
 
 func _DepartmentService_CreateDepartment0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
@@ -112,6 +166,53 @@ func _DepartmentService_UpdateDepartment0_HTTP_Handler(srv DepartmentServiceHTTP
 	}
 }
 
+func _DepartmentService_MoveDepartment0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MoveDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceMoveDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveDepartment(ctx, req.(*MoveDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MoveDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_ReorderDepartments0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderDepartmentsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceReorderDepartments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderDepartments(ctx, req.(*ReorderDepartmentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReorderDepartmentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _DepartmentService_ListDepartments0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListDepartmentsRequest
@@ -131,13 +232,295 @@ func _DepartmentService_ListDepartments0_HTTP_Handler(srv DepartmentServiceHTTPS
 	}
 }
 
+func _DepartmentService_GetDepartmentTree0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDepartmentTreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceGetDepartmentTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDepartmentTree(ctx, req.(*GetDepartmentTreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDepartmentTreeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_ListDepartmentAncestors0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDepartmentAncestorsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceListDepartmentAncestors)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDepartmentAncestors(ctx, req.(*ListDepartmentAncestorsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDepartmentAncestorsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_ListDepartmentDescendants0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDepartmentDescendantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceListDepartmentDescendants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDepartmentDescendants(ctx, req.(*ListDepartmentDescendantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDepartmentDescendantsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_AddUsersToDepartment0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddUsersToDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceAddUsersToDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddUsersToDepartment(ctx, req.(*AddUsersToDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddUsersToDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_RemoveUsersFromDepartment0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveUsersFromDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceRemoveUsersFromDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveUsersFromDepartment(ctx, req.(*RemoveUsersFromDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveUsersFromDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_ListDepartmentUsers0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDepartmentUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceListDepartmentUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDepartmentUsers(ctx, req.(*ListDepartmentUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDepartmentUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_SetPrimaryDepartment0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetPrimaryDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceSetPrimaryDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetPrimaryDepartment(ctx, req.(*SetPrimaryDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetPrimaryDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_SyncDepartment0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SyncDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceSyncDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SyncDepartment(ctx, req.(*SyncDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SyncDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_SetDepartmentLeaders0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetDepartmentLeadersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceSetDepartmentLeaders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetDepartmentLeaders(ctx, req.(*SetDepartmentLeadersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetDepartmentLeadersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_GetReportingLine0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReportingLineRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceGetReportingLine)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReportingLine(ctx, req.(*GetReportingLineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReportingLineResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DepartmentService_ListReports0_HTTP_Handler(srv DepartmentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDepartmentServiceListReports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReports(ctx, req.(*ListReportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReportsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DepartmentServiceHTTPClient interface {
+	// AddUsersToDepartment    rpc GetDepartment(GetDepartmentRequest) returns (DepartmentResponse) {}
+	// 用户-部门关联操作
+	// 添加部门成员，用户需已是当前租户的成员
+	AddUsersToDepartment(ctx context.Context, req *AddUsersToDepartmentRequest, opts ...http.CallOption) (rsp *AddUsersToDepartmentResponse, err error)
 	// CreateDepartment 创建部门
 	CreateDepartment(ctx context.Context, req *CreateDepartmentRequest, opts ...http.CallOption) (rsp *CreateDepartmentResponse, err error)
-	// DeleteDepartment 删除部门
+	// DeleteDepartment 删除部门，有下级部门或成员时需指定cascade
 	DeleteDepartment(ctx context.Context, req *DeleteDepartmentRequest, opts ...http.CallOption) (rsp *DeleteDepartmentResponse, err error)
+	// GetDepartmentTree 获取部门树，指定id时只返回该部门及其下级部门
+	GetDepartmentTree(ctx context.Context, req *GetDepartmentTreeRequest, opts ...http.CallOption) (rsp *GetDepartmentTreeResponse, err error)
+	// GetReportingLine 获取用户的汇报线，从直接主管开始沿部门路径向上，跳过空缺的负责人
+	GetReportingLine(ctx context.Context, req *GetReportingLineRequest, opts ...http.CallOption) (rsp *GetReportingLineResponse, err error)
+	// ListDepartmentAncestors 获取部门的所有上级部门，从根部门开始
+	ListDepartmentAncestors(ctx context.Context, req *ListDepartmentAncestorsRequest, opts ...http.CallOption) (rsp *ListDepartmentAncestorsResponse, err error)
+	// ListDepartmentDescendants 获取部门的所有下级部门，按路径排序
+	ListDepartmentDescendants(ctx context.Context, req *ListDepartmentDescendantsRequest, opts ...http.CallOption) (rsp *ListDepartmentDescendantsResponse, err error)
+	// ListDepartmentUsers 获取部门成员，可包含所有下级部门的成员
+	ListDepartmentUsers(ctx context.Context, req *ListDepartmentUsersRequest, opts ...http.CallOption) (rsp *ListDepartmentUsersResponse, err error)
 	// ListDepartments 获取部门列表
 	ListDepartments(ctx context.Context, req *ListDepartmentsRequest, opts ...http.CallOption) (rsp *ListDepartmentsResponse, err error)
+	// ListReports 获取向用户汇报的成员，默认只返回直接下属
+	ListReports(ctx context.Context, req *ListReportsRequest, opts ...http.CallOption) (rsp *ListReportsResponse, err error)
+	// MoveDepartment 移动部门，连同下级部门一起挂到新的上级部门下
+	MoveDepartment(ctx context.Context, req *MoveDepartmentRequest, opts ...http.CallOption) (rsp *MoveDepartmentResponse, err error)
+	// RemoveUsersFromDepartment 移除部门成员，移除的是主部门时由其余部门中最早加入的一个接替
+	RemoveUsersFromDepartment(ctx context.Context, req *RemoveUsersFromDepartmentRequest, opts ...http.CallOption) (rsp *RemoveUsersFromDepartmentResponse, err error)
+	// ReorderDepartments 调整同级部门的顺序
+	ReorderDepartments(ctx context.Context, req *ReorderDepartmentsRequest, opts ...http.CallOption) (rsp *ReorderDepartmentsResponse, err error)
+	// SetDepartmentLeaders 设置部门负责人和副职，负责人为空表示空缺
+	SetDepartmentLeaders(ctx context.Context, req *SetDepartmentLeadersRequest, opts ...http.CallOption) (rsp *SetDepartmentLeadersResponse, err error)
+	// SetPrimaryDepartment 设置用户在当前租户下的主部门，用户需已在该部门中
+	SetPrimaryDepartment(ctx context.Context, req *SetPrimaryDepartmentRequest, opts ...http.CallOption) (rsp *SetPrimaryDepartmentResponse, err error)
+	// SyncDepartment 按外部系统的部门ID创建或更新部门，供HR系统同步，重复调用结果不变
+	SyncDepartment(ctx context.Context, req *SyncDepartmentRequest, opts ...http.CallOption) (rsp *SyncDepartmentResponse, err error)
 	// UpdateDepartment 更新部门
 	UpdateDepartment(ctx context.Context, req *UpdateDepartmentRequest, opts ...http.CallOption) (rsp *UpdateDepartmentResponse, err error)
 }
@@ -150,6 +533,22 @@ func NewDepartmentServiceHTTPClient(client *http.Client) DepartmentServiceHTTPCl
 	return &DepartmentServiceHTTPClientImpl{client}
 }
 
+// AddUsersToDepartment    rpc GetDepartment(GetDepartmentRequest) returns (DepartmentResponse) {}
+// 用户-部门关联操作
+// 添加部门成员，用户需已是当前租户的成员
+func (c *DepartmentServiceHTTPClientImpl) AddUsersToDepartment(ctx context.Context, in *AddUsersToDepartmentRequest, opts ...http.CallOption) (*AddUsersToDepartmentResponse, error) {
+	var out AddUsersToDepartmentResponse
+	pattern := "/v1/departments/{department_id}/users"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceAddUsersToDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateDepartment 创建部门
 func (c *DepartmentServiceHTTPClientImpl) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...http.CallOption) (*CreateDepartmentResponse, error) {
 	var out CreateDepartmentResponse
@@ -164,7 +563,7 @@ func (c *DepartmentServiceHTTPClientImpl) CreateDepartment(ctx context.Context,
 	return &out, nil
 }
 
-// DeleteDepartment 删除部门
+// DeleteDepartment 删除部门，有下级部门或成员时需指定cascade
 func (c *DepartmentServiceHTTPClientImpl) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...http.CallOption) (*DeleteDepartmentResponse, error) {
 	var out DeleteDepartmentResponse
 	pattern := "/v1/departments/{id}"
@@ -178,6 +577,76 @@ func (c *DepartmentServiceHTTPClientImpl) DeleteDepartment(ctx context.Context,
 	return &out, nil
 }
 
+// GetDepartmentTree 获取部门树，指定id时只返回该部门及其下级部门
+func (c *DepartmentServiceHTTPClientImpl) GetDepartmentTree(ctx context.Context, in *GetDepartmentTreeRequest, opts ...http.CallOption) (*GetDepartmentTreeResponse, error) {
+	var out GetDepartmentTreeResponse
+	pattern := "/v1/departments/tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDepartmentServiceGetDepartmentTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetReportingLine 获取用户的汇报线，从直接主管开始沿部门路径向上，跳过空缺的负责人
+func (c *DepartmentServiceHTTPClientImpl) GetReportingLine(ctx context.Context, in *GetReportingLineRequest, opts ...http.CallOption) (*GetReportingLineResponse, error) {
+	var out GetReportingLineResponse
+	pattern := "/v1/users/{user_id}/reporting-line"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDepartmentServiceGetReportingLine))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDepartmentAncestors 获取部门的所有上级部门，从根部门开始
+func (c *DepartmentServiceHTTPClientImpl) ListDepartmentAncestors(ctx context.Context, in *ListDepartmentAncestorsRequest, opts ...http.CallOption) (*ListDepartmentAncestorsResponse, error) {
+	var out ListDepartmentAncestorsResponse
+	pattern := "/v1/departments/{id}/ancestors"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDepartmentServiceListDepartmentAncestors))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDepartmentDescendants 获取部门的所有下级部门，按路径排序
+func (c *DepartmentServiceHTTPClientImpl) ListDepartmentDescendants(ctx context.Context, in *ListDepartmentDescendantsRequest, opts ...http.CallOption) (*ListDepartmentDescendantsResponse, error) {
+	var out ListDepartmentDescendantsResponse
+	pattern := "/v1/departments/{id}/descendants"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDepartmentServiceListDepartmentDescendants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDepartmentUsers 获取部门成员，可包含所有下级部门的成员
+func (c *DepartmentServiceHTTPClientImpl) ListDepartmentUsers(ctx context.Context, in *ListDepartmentUsersRequest, opts ...http.CallOption) (*ListDepartmentUsersResponse, error) {
+	var out ListDepartmentUsersResponse
+	pattern := "/v1/departments/{department_id}/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDepartmentServiceListDepartmentUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListDepartments 获取部门列表
 func (c *DepartmentServiceHTTPClientImpl) ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...http.CallOption) (*ListDepartmentsResponse, error) {
 	var out ListDepartmentsResponse
@@ -192,6 +661,104 @@ func (c *DepartmentServiceHTTPClientImpl) ListDepartments(ctx context.Context, i
 	return &out, nil
 }
 
+// ListReports 获取向用户汇报的成员，默认只返回直接下属
+func (c *DepartmentServiceHTTPClientImpl) ListReports(ctx context.Context, in *ListReportsRequest, opts ...http.CallOption) (*ListReportsResponse, error) {
+	var out ListReportsResponse
+	pattern := "/v1/users/{user_id}/reports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDepartmentServiceListReports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MoveDepartment 移动部门，连同下级部门一起挂到新的上级部门下
+func (c *DepartmentServiceHTTPClientImpl) MoveDepartment(ctx context.Context, in *MoveDepartmentRequest, opts ...http.CallOption) (*MoveDepartmentResponse, error) {
+	var out MoveDepartmentResponse
+	pattern := "/v1/departments/{id}/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceMoveDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveUsersFromDepartment 移除部门成员，移除的是主部门时由其余部门中最早加入的一个接替
+func (c *DepartmentServiceHTTPClientImpl) RemoveUsersFromDepartment(ctx context.Context, in *RemoveUsersFromDepartmentRequest, opts ...http.CallOption) (*RemoveUsersFromDepartmentResponse, error) {
+	var out RemoveUsersFromDepartmentResponse
+	pattern := "/v1/departments/{department_id}/users/remove"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceRemoveUsersFromDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReorderDepartments 调整同级部门的顺序
+func (c *DepartmentServiceHTTPClientImpl) ReorderDepartments(ctx context.Context, in *ReorderDepartmentsRequest, opts ...http.CallOption) (*ReorderDepartmentsResponse, error) {
+	var out ReorderDepartmentsResponse
+	pattern := "/v1/departments/reorder"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceReorderDepartments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetDepartmentLeaders 设置部门负责人和副职，负责人为空表示空缺
+func (c *DepartmentServiceHTTPClientImpl) SetDepartmentLeaders(ctx context.Context, in *SetDepartmentLeadersRequest, opts ...http.CallOption) (*SetDepartmentLeadersResponse, error) {
+	var out SetDepartmentLeadersResponse
+	pattern := "/v1/departments/{id}/leaders"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceSetDepartmentLeaders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetPrimaryDepartment 设置用户在当前租户下的主部门，用户需已在该部门中
+func (c *DepartmentServiceHTTPClientImpl) SetPrimaryDepartment(ctx context.Context, in *SetPrimaryDepartmentRequest, opts ...http.CallOption) (*SetPrimaryDepartmentResponse, error) {
+	var out SetPrimaryDepartmentResponse
+	pattern := "/v1/users/{user_id}/primary-department"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceSetPrimaryDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SyncDepartment 按外部系统的部门ID创建或更新部门，供HR系统同步，重复调用结果不变
+func (c *DepartmentServiceHTTPClientImpl) SyncDepartment(ctx context.Context, in *SyncDepartmentRequest, opts ...http.CallOption) (*SyncDepartmentResponse, error) {
+	var out SyncDepartmentResponse
+	pattern := "/v1/departments/external/{external_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDepartmentServiceSyncDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateDepartment 更新部门
 func (c *DepartmentServiceHTTPClientImpl) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...http.CallOption) (*UpdateDepartmentResponse, error) {
 	var out UpdateDepartmentResponse
@@ -3,13 +3,26 @@ package usermanagement
 import (
 	"context"
 
+	"github.com/casbin/casbin/v2"
+	kmiddleware "github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	loginv1 "github.com/yc-alpha/admin/api/login/v1"
+	permissionv1 "github.com/yc-alpha/admin/api/permission/v1"
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
 	"github.com/yc-alpha/admin/app/admin/internal/config"
 	"github.com/yc-alpha/admin/app/admin/internal/data"
 	"github.com/yc-alpha/admin/app/admin/internal/service"
+	"github.com/yc-alpha/admin/common/authn"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/datascope"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/invite"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/notify"
+	"github.com/yc-alpha/admin/common/rls"
 	"github.com/yc-alpha/logger"
 )
 
@@ -19,24 +32,154 @@ func RegisteApplication(http *http.Server, grpc *grpc.Server) {
 	// 初始化系统数据
 	initService := service.NewInitService(basicData.Client)
 	initConfig := config.LoadInitConfig()
-	if err := initService.InitializeSystemWithConfig(context.Background(), initConfig); err != nil {
+	if err := rls.WithBypass(context.Background(), basicData.Client, func(ctx context.Context) error {
+		return initService.InitializeSystemWithConfig(ctx, initConfig)
+	}); err != nil {
 		logger.Fatalf("系统初始化失败: %v", err)
 	}
 
-	userService := service.NewUserService(basicData.Client)
-	tenantHandler := service.NewTenantHTTPHandler(basicData.Client)
+	authConfig := config.LoadAuthConfig()
+	if err := authConfig.ValidateSecret(); err != nil {
+		logger.Fatal(err.Error())
+	}
+	tokenManager := authn.NewTokenManager(authConfig.Secret, authConfig.Issuer, authConfig.AccessTokenTTL, authConfig.RefreshTokenTTL)
+	tokenManager.SetVersionSource(middleware.TokenVersions(basicData.Client))
+
+	// 未启用Casbin时enforcer为nil
+	enforcer := newEnforcer(basicData, authConfig)
+
+	userService := service.NewUserService(basicData.Client, authConfig.DataScope)
+	loginService := service.NewLoginService(basicData.Client, tokenManager)
+	permissionService := service.NewPermissionService(basicData.Client)
+	// 进程内事件总线，目前只记录日志
+	bus := event.NewBus()
+	bus.Subscribe("*", event.LogHandler)
+
+	roleService := service.NewRoleService(basicData.Client, enforcer, bus, authConfig.MaxElevationDuration)
+	tenantConfig := config.LoadTenantConfig()
+	tenantPurger := service.NewTenantPurger(basicData.Client, enforcer, bus, tenantConfig.RetentionPeriod, tenantConfig.PurgeInterval)
+	tenantService := service.NewTenantServiceImpl(basicData.Client, bus, tenantPurger)
+	tenantMemberService := service.NewTenantMemberService(basicData.Client, enforcer, bus)
+	invitationConfig := config.LoadInvitationConfig()
+	invitationService := service.NewInvitationService(basicData.Client, enforcer, bus,
+		invite.NewSigner(authConfig.Secret, authConfig.Issuer), newNotifier(invitationConfig), invitationConfig)
+	planService := service.NewPlanService(basicData.Client)
+	settingService := service.NewSettingService(basicData.Client, bus)
+	departmentService := service.NewDepartmentService(basicData.Client)
 
 	// Register HTTP services
 	v1.RegisterUserServiceHTTPServer(http, userService)
-	http.HandleFunc("/v1/users/export", userService.ExportUser)
-
-	// Register tenant HTTP handlers
-	http.HandleFunc("/v1/tenants", tenantHandler.CreateTenant)
-	http.HandleFunc("/v1/tenants/root", tenantHandler.ListRootTenants)
-	http.HandleFunc("/v1/tenants/children", tenantHandler.ListSubTenants)
-	http.HandleFunc("/v1/tenants/statistics", tenantHandler.GetTenantStatistics)
-	http.HandleFunc("/v1/tenants/detail", tenantHandler.GetTenantByID)
+	// 导出接口直接写出文件，经Kratos路由注册以执行下方的认证等中间件
+	http.Route("/").POST("/v1/users/export", userService.ExportUser)
+	loginv1.RegisterLoginServiceHTTPServer(http, loginService)
+	permissionv1.RegisterPermissionServiceHTTPServer(http, permissionService)
+	v1.RegisterRoleServiceHTTPServer(http, roleService)
+	v1.RegisterTenantServiceHTTPServer(http, tenantService)
+	v1.RegisterTenantMemberServiceHTTPServer(http, tenantMemberService)
+	v1.RegisterInvitationServiceHTTPServer(http, invitationService)
+	v1.RegisterPlanServiceHTTPServer(http, planService)
+	v1.RegisterSettingServiceHTTPServer(http, settingService)
+	umv1.RegisterDepartmentServiceHTTPServer(http, departmentService)
 
 	// Register gRPC services
 	v1.RegisterUserServiceServer(grpc, userService)
+	loginv1.RegisterLoginServiceServer(grpc, loginService)
+	permissionv1.RegisterPermissionServiceServer(grpc, permissionService)
+	v1.RegisterRoleServiceServer(grpc, roleService)
+	v1.RegisterTenantServiceServer(grpc, tenantService)
+	v1.RegisterTenantMemberServiceServer(grpc, tenantMemberService)
+	v1.RegisterInvitationServiceServer(grpc, invitationService)
+	v1.RegisterPlanServiceServer(grpc, planService)
+	v1.RegisterSettingServiceServer(grpc, settingService)
+	umv1.RegisterDepartmentServiceServer(grpc, departmentService)
+
+	// 认证、授权中间件：白名单之外的operation都需要携带有效的访问令牌
+	authMiddlewares := []kmiddleware.Middleware{
+		middleware.AuthnMiddleware(tokenManager, basicData.Client),
+	}
+	subjectBuilder := authz.NewSubjectBuilder(basicData.Client)
+	if enforcer != nil {
+		authMiddlewares = append(authMiddlewares, middleware.AuthzMiddleware(enforcer, subjectBuilder))
+	}
+	if authConfig.DataScope {
+		authMiddlewares = append(authMiddlewares,
+			middleware.DataScopeMiddleware(subjectBuilder, datascope.NewResolver(basicData.Client)),
+		)
+	}
+	if basicData.RLS {
+		// 行级安全必须最后收窄，之前中间件在SystemRLSMiddleware开启的请求事务中跳过行级安全查询
+		authMiddlewares = append(authMiddlewares, middleware.RLSMiddleware(basicData.Client))
+	}
+	authMiddleware := middleware.Authenticated(authConfig.Whitelist, authMiddlewares...)
+	if basicData.RLS {
+		systemMiddleware := middleware.SystemRLSMiddleware(basicData.Client)
+		http.Use("/*", systemMiddleware, authMiddleware)
+		grpc.Use("/*", systemMiddleware, authMiddleware)
+	} else {
+		http.Use("/*", authMiddleware)
+		grpc.Use("/*", authMiddleware)
+	}
+
+	// 后台清理过期的限时角色授予
+	service.NewRoleExpirySweeper(basicData.Client, enforcer, bus, authConfig.RoleExpirySweepInterval).
+		Start(context.Background())
+	// 后台处理租户到期和到期提醒
+	service.NewTenantExpiryScheduler(basicData.Client, bus, tenantConfig.ExpirySweepInterval, tenantConfig.ExpiryNoticeBefore).
+		Start(context.Background())
+	// 后台清理超过保留期的已删除租户
+	tenantPurger.Start(context.Background())
+}
+
+// newEnforcer 根据配置创建Casbin enforcer并挂载策略同步Watcher，未启用时返回nil
+// casbin_rules不启用行级安全，策略加载和Watcher触发的重新加载无需跳过
+func newEnforcer(basicData *data.Data, authConfig *config.AuthConfig) *casbin.SyncedEnforcer {
+	if !authConfig.CasbinEnabled {
+		return nil
+	}
+	enforcer, err := authz.NewEnforcer(basicData.Client, authConfig.CasbinModel)
+	if err != nil {
+		logger.Fatalf("初始化Casbin失败: %v", err)
+	}
+	if watcher := newPolicyWatcher(basicData, authConfig); watcher != nil {
+		if err := enforcer.SetWatcher(watcher); err != nil {
+			logger.Fatalf("设置Casbin Watcher失败: %v", err)
+		}
+		_ = watcher.SetUpdateCallback(authz.SyncCallback(enforcer))
+	}
+	return enforcer
+}
+
+// newPolicyWatcher 根据配置创建多实例策略同步Watcher，未配置时返回nil
+func newPolicyWatcher(basicData *data.Data, authConfig *config.AuthConfig) *authz.Watcher {
+	var (
+		watcher *authz.Watcher
+		err     error
+	)
+	switch authConfig.CasbinWatcher {
+	case "":
+		return nil
+	case "postgres":
+		watcher, err = authz.NewPostgresWatcher(basicData.DB, basicData.DSN, authConfig.CasbinChannel)
+	case "etcd":
+		watcher, err = authz.NewEtcdWatcher(data.NewEtcdClient(), authConfig.CasbinChannel)
+	default:
+		logger.Fatalf("不支持的Casbin Watcher: %s", authConfig.CasbinWatcher)
+	}
+	if err != nil {
+		logger.Fatalf("初始化Casbin Watcher失败: %v", err)
+	}
+	return watcher
+}
+
+// newNotifier 根据配置创建邀请的投递方式
+func newNotifier(invitationConfig *config.InvitationConfig) notify.Notifier {
+	switch invitationConfig.Notifier {
+	case "log":
+		return notify.NewLogNotifier()
+	case "file":
+		return notify.NewFileNotifier(invitationConfig.NotifierFile)
+	default:
+		logger.Fatalf("不支持的邀请投递方式: %s", invitationConfig.Notifier)
+	}
+	return nil
 }
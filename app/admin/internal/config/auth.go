@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/yc-alpha/config"
+)
+
+// DefaultAuthWhitelist 未配置白名单时免认证的operation
+var DefaultAuthWhitelist = []string{
+	"/login.v1.LoginService/Login",
+	"/login.v1.LoginService/RefreshToken",
+	"/login.v1.LoginService/GetCaptcha",
+	"/login.v1.LoginService/VerifyCaptcha",
+	"/login.v1.LoginService/SendSmsCode",
+	"/login.v1.LoginService/LoginBySms",
+	"/login.v1.LoginService/OAuthLogin",
+	"/login.v1.LoginService/OAuthCallback",
+	"/admin.v1.InvitationService/PreviewInvitation",
+	"/admin.v1.InvitationService/AcceptInvitation",
+}
+
+// placeholderSecrets 示例配置中出现过的占位密钥，不能用于签名
+var placeholderSecrets = []string{"change-me-in-production", "change-me", "secret"}
+
+// AuthConfig 认证配置
+type AuthConfig struct {
+	Secret          string        // JWT签名密钥
+	Issuer          string        // JWT签发者
+	AccessTokenTTL  time.Duration // 访问令牌有效期
+	RefreshTokenTTL time.Duration // 刷新令牌有效期
+	Whitelist       []string      // 免认证的operation，支持以*结尾的前缀
+	CasbinEnabled   bool          // 是否启用Casbin授权
+	CasbinModel     string        // Casbin模型文件路径，为空时使用内置模型
+	CasbinWatcher   string        // 多实例策略同步方式：postgres、etcd，为空不同步
+	CasbinChannel   string        // 策略变更通知通道（postgres）或key（etcd），为空使用默认值
+	DataScope       bool          // 是否按角色的数据范围过滤列表查询
+	// 限时授予
+	RoleExpirySweepInterval time.Duration // 过期角色授予的清理间隔，<=0时不启动清理任务
+	MaxElevationDuration    time.Duration // 临时提权的最长有效期
+}
+
+// LoadAuthConfig 从配置文件加载认证配置
+func LoadAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		Secret:          config.GetString("auth.jwt.secret", ""),
+		Issuer:          config.GetString("auth.jwt.issuer", "paas.admin"),
+		AccessTokenTTL:  time.Duration(config.GetInt64("auth.jwt.access_token_ttl", 7200)) * time.Second,
+		RefreshTokenTTL: time.Duration(config.GetInt64("auth.jwt.refresh_token_ttl", 604800)) * time.Second,
+		Whitelist:       loadStrings("auth.whitelist", DefaultAuthWhitelist),
+		CasbinEnabled:   config.GetBool("auth.casbin.enabled", false),
+		CasbinModel:     config.GetString("auth.casbin.model", ""),
+		CasbinWatcher:   config.GetString("auth.casbin.watcher", ""),
+		CasbinChannel:   config.GetString("auth.casbin.channel", ""),
+		DataScope:       config.GetBool("auth.data_scope.enabled", false),
+
+		RoleExpirySweepInterval: time.Duration(config.GetInt64("auth.elevation.sweep_interval", 60)) * time.Second,
+		MaxElevationDuration:    time.Duration(config.GetInt64("auth.elevation.max_duration", 86400)) * time.Second,
+	}
+}
+
+// ValidateSecret 校验JWT签名密钥已配置且不是示例占位值
+func (c *AuthConfig) ValidateSecret() error {
+	if c.Secret == "" {
+		return fmt.Errorf("未配置JWT签名密钥: auth.jwt.secret")
+	}
+	if slices.Contains(placeholderSecrets, c.Secret) {
+		return fmt.Errorf("JWT签名密钥auth.jwt.secret为示例占位值，请更换为随机密钥")
+	}
+	return nil
+}
+
+// loadStrings 读取字符串列表配置，未配置时返回默认值
+func loadStrings(path string, _default []string) []string {
+	var values []string
+	for i := 0; ; i++ {
+		v := config.GetString(fmt.Sprintf("%s[%d]", path, i), "")
+		if v == "" {
+			break
+		}
+		values = append(values, v)
+	}
+	if len(values) == 0 {
+		return _default
+	}
+	return values
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"time"
+
+	"github.com/yc-alpha/config"
+)
+
+// InvitationConfig 租户邀请配置
+type InvitationConfig struct {
+	TTL          time.Duration // 邀请有效期，重新发送时重新计算
+	AcceptURL    string        // 接受邀请的前端页面地址，令牌以token参数附加在后面
+	Notifier     string        // 邀请的投递方式：log（只记录日志）、file（写入文件，用于测试和联调）
+	NotifierFile string        // Notifier为file时写入的文件
+}
+
+// LoadInvitationConfig 从配置文件加载租户邀请配置
+func LoadInvitationConfig() *InvitationConfig {
+	return &InvitationConfig{
+		TTL:          time.Duration(config.GetInt64("invitation.ttl", 604800)) * time.Second,
+		AcceptURL:    config.GetString("invitation.accept_url", "http://localhost:3000/invitation/accept"),
+		Notifier:     config.GetString("invitation.notifier.type", "log"),
+		NotifierFile: config.GetString("invitation.notifier.file", "./invitations.jsonl"),
+	}
+}
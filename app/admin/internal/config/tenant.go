@@ -0,0 +1,28 @@
+package config
+
+import (
+	"time"
+
+	"github.com/yc-alpha/config"
+)
+
+// TenantConfig 租户管理配置
+type TenantConfig struct {
+	// 生命周期
+	ExpirySweepInterval time.Duration // 租户到期检查间隔，<=0时不启动（到期租户在鉴权时仍会被拒绝）
+	ExpiryNoticeBefore  time.Duration // 到期前多久发送提醒，<=0时不提醒
+
+	// 删除保留
+	RetentionPeriod time.Duration // 已删除租户的保留期，期内可恢复，到期后彻底清理；<=0时不清理
+	PurgeInterval   time.Duration // 清理任务执行间隔，<=0时不启动（仍可通过接口手动清理）
+}
+
+// LoadTenantConfig 从配置文件加载租户管理配置
+func LoadTenantConfig() *TenantConfig {
+	return &TenantConfig{
+		ExpirySweepInterval: time.Duration(config.GetInt64("tenant.lifecycle.sweep_interval", 60)) * time.Second,
+		ExpiryNoticeBefore:  time.Duration(config.GetInt64("tenant.lifecycle.notice_before", 604800)) * time.Second,
+		RetentionPeriod:     time.Duration(config.GetInt64("tenant.retention.period", 2592000)) * time.Second,
+		PurgeInterval:       time.Duration(config.GetInt64("tenant.retention.purge_interval", 3600)) * time.Second,
+	}
+}
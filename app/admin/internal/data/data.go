@@ -9,6 +9,7 @@ import (
 	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
 	"github.com/go-kratos/kratos/v2/registry"
 	_ "github.com/lib/pq"
+	"github.com/yc-alpha/admin/common/rls"
 	"github.com/yc-alpha/admin/ent"
 	_ "github.com/yc-alpha/admin/ent/runtime"
 	"github.com/yc-alpha/config"
@@ -19,6 +20,8 @@ import (
 type Data struct {
 	Client *ent.Client
 	DB     *sql.DB
+	DSN    string // 数据库连接串，供需要独占连接的组件（如LISTEN）使用
+	RLS    bool   // 是否按请求开启事务并启用行级安全，见middleware.RLSMiddleware
 }
 
 func NewData() *Data {
@@ -26,17 +29,29 @@ func NewData() *Data {
 	return &Data{
 		Client: client,
 		DB:     db,
+		DSN:    DataSourceName(),
+		RLS:    config.GetBool("data.database.rls", false),
 	}
 }
 
-func NewDBClient() (*ent.Client, *sql.DB) {
+// DataSourceName 根据配置生成PostgreSQL连接串
+// 启用行级安全时连接不跳过行级安全，系统查询（初始化、后台任务等）需经rls.WithBypass或rls.BypassTx显式跳过；
+// 未启用时应用不设置租户上下文，连接默认跳过行级安全
+func DataSourceName() string {
 	host := config.GetString("data.database.host", "")
 	port := config.GetInt("data.database.port", 5432)
 	username := config.GetString("data.database.username", "")
 	password := config.GetString("data.database.password", "")
 	dbName := config.GetString("data.database.db", "")
 	dsn := fmt.Sprintf("host=%s port=%d user=%s dbname=%s password=%s sslmode=disable", host, port, username, dbName, password)
-	db, err := sql.Open("postgres", dsn)
+	if !config.GetBool("data.database.rls", false) {
+		dsn += " options='-c app.bypass_rls=on'"
+	}
+	return dsn
+}
+
+func NewDBClient() (*ent.Client, *sql.DB) {
+	db, err := sql.Open("postgres", DataSourceName())
 	if err != nil {
 		logger.Fatalf("failed opening connection to postgres: %v", err)
 	}
@@ -47,13 +62,19 @@ func NewDBClient() (*ent.Client, *sql.DB) {
 	}
 	// 用 ent 的 SQL driver 封装
 	drv := entsql.OpenDB("postgres", db)
-	// 创建 ent client
-	client := ent.NewClient(ent.Driver(drv))
+	// 创建 ent client，请求内的查询经rls.Driver路由到请求事务
+	client := ent.NewClient(ent.Driver(rls.NewDriver(drv)))
 	// defer client.Close()
 	return client, db
 }
 
 func NewRegistrar() registry.Registrar {
+	r := etcd.New(NewEtcdClient())
+	return r
+}
+
+// NewEtcdClient 根据registry.etcd配置创建etcd客户端
+func NewEtcdClient() *clientv3.Client {
 	host := config.GetString("registry.etcd.host", "")
 	port := config.GetString("registry.etcd.port", "")
 	cli, err := clientv3.New(clientv3.Config{
@@ -63,6 +84,5 @@ func NewRegistrar() registry.Registrar {
 	if err != nil {
 		logger.Fatal(err)
 	}
-	r := etcd.New(cli)
-	return r
+	return cli
 }
@@ -0,0 +1,549 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
+	"github.com/yc-alpha/admin/common/datascope"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/quota"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/logger"
+	"github.com/yc-alpha/variant"
+)
+
+// DepartmentService 当前租户下的部门管理，部门路径由Department的创建钩子维护
+type DepartmentService struct {
+	umv1.UnimplementedDepartmentServiceServer
+	client *ent.Client
+}
+
+func NewDepartmentService(client *ent.Client) *DepartmentService {
+	return &DepartmentService{client: client}
+}
+
+// CreateDepartment 在当前租户下创建部门，未指定上级部门时创建根部门
+func (s *DepartmentService) CreateDepartment(ctx context.Context, req *umv1.CreateDepartmentRequest) (*umv1.CreateDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.CreateDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	in := newDepartmentInput(req.GetName(), req.GetCode(), req.GetDescription())
+	if in.name == "" {
+		return &umv1.CreateDepartmentResponse{Result: false, Code: 400, Msg: "部门名称不能为空"}, nil
+	}
+	if v := strings.TrimSpace(req.GetExternalId()); v != "" {
+		in.externalID = &v
+	}
+	parentID, err := parseOptionalID(req.GetPid())
+	if err != nil {
+		return &umv1.CreateDepartmentResponse{Result: false, Code: 400, Msg: "无效的上级部门ID"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.CreateDepartmentResponse{Result: false, Code: 500, Msg: "创建部门失败"}, nil
+	}
+	defer tx.Rollback()
+	d, code, msg := createDepartment(ctx, tx, tenantID, parentID, in)
+	if code != 0 {
+		return &umv1.CreateDepartmentResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.CreateDepartmentResponse{Result: false, Code: 500, Msg: "创建部门失败"}, nil
+	}
+	return &umv1.CreateDepartmentResponse{Result: true, Code: 200, Msg: "创建成功", Department: convertDepartmentToProto(d)}, nil
+}
+
+// UpdateDepartment 修改部门名称、编码和描述；pid与当前上级部门不同时同时移动部门，与MoveDepartment一致
+func (s *DepartmentService) UpdateDepartment(ctx context.Context, req *umv1.UpdateDepartmentRequest) (*umv1.UpdateDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+	in := newDepartmentInput(req.GetName(), req.GetCode(), req.GetDescription())
+	if in.name == "" {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 400, Msg: "部门名称不能为空"}, nil
+	}
+	parentID, err := parseOptionalID(req.GetPid())
+	if err != nil {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 400, Msg: "无效的上级部门ID"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 500, Msg: "修改部门失败"}, nil
+	}
+	defer tx.Rollback()
+	d, err := departmentQuery(tx.Client(), tenantID).Where(department.ID(id)).ForUpdate().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &umv1.UpdateDepartmentResponse{Result: false, Code: 404, Msg: "部门不存在"}, nil
+		}
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	// pid为空表示不修改上级部门，为0时移动为根部门
+	if req.GetPid() != "" && parentID != d.ParentID {
+		if _, code, msg := relocateDepartment(ctx, tx, d, parentID, nil); code != 0 {
+			return &umv1.UpdateDepartmentResponse{Result: false, Code: code, Msg: msg}, nil
+		}
+		d.ParentID = parentID
+	}
+	if req.ExternalId != nil {
+		in.externalID = nil
+		if v := strings.TrimSpace(req.GetExternalId()); v != "" {
+			in.externalID = &v
+		}
+	} else {
+		in.externalID = d.ExternalID
+	}
+
+	d, code, msg := updateDepartment(ctx, tx, d, in)
+	if code != 0 {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.UpdateDepartmentResponse{Result: false, Code: 500, Msg: "修改部门失败"}, nil
+	}
+	return &umv1.UpdateDepartmentResponse{Result: true, Code: 200, Msg: "修改成功", Department: convertDepartmentToProto(d)}, nil
+}
+
+// DeleteDepartment 软删除部门；有下级部门或成员时需指定cascade，同时删除整棵子树并移除子树中的成员关系
+func (s *DepartmentService) DeleteDepartment(ctx context.Context, req *umv1.DeleteDepartmentRequest) (*umv1.DeleteDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "删除部门失败"}, nil
+	}
+	defer tx.Rollback()
+	d, err := departmentQuery(tx.Client(), tenantID).Where(department.ID(id)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &umv1.DeleteDepartmentResponse{Result: false, Code: 404, Msg: "部门不存在"}, nil
+		}
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	// 锁定整棵子树，与在子树下创建部门的操作互斥
+	ids, err := departmentQuery(tx.Client(), tenantID).
+		Where(predicate.Department(datascope.DescendantOf(department.FieldPath, d.Path))).
+		ForUpdate().
+		IDs(ctx)
+	if err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "查询下级部门失败"}, nil
+	}
+	members, err := tx.UserDepartment.Query().Where(userdepartment.DeptIDIn(ids...)).Count(ctx)
+	if err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+	if !req.GetCascade() && (len(ids) > 1 || members > 0) {
+		return &umv1.DeleteDepartmentResponse{
+			Result: false,
+			Code:   400,
+			Msg:    "部门下还有" + strconv.Itoa(len(ids)-1) + "个下级部门和" + strconv.Itoa(members) + "个成员，请先移除或指定级联删除",
+		}, nil
+	}
+
+	var userIDs []int64
+	if err := tx.UserDepartment.Query().
+		Where(userdepartment.DeptIDIn(ids...)).
+		Unique(true).
+		Select(userdepartment.FieldUserID).
+		Scan(ctx, &userIDs); err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+	removed, err := tx.UserDepartment.Delete().Where(userdepartment.DeptIDIn(ids...)).Exec(ctx)
+	if err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "移除部门成员失败"}, nil
+	}
+	deleted, err := tx.Department.Update().
+		Where(department.IDIn(ids...)).
+		SetDeletedAt(time.Now()).
+		SetNillableUpdatedBy(operatorFromContext(ctx)).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("删除部门失败: %v", err)
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "删除部门失败"}, nil
+	}
+	// 主部门被删除的成员改用其余部门中最早加入的一个
+	if len(userIDs) > 0 {
+		if err := ensurePrimaryDepartments(ctx, tx, tenantID, userIDs); err != nil {
+			logger.Errorf("设置主部门失败: %v", err)
+			return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "删除部门失败"}, nil
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.DeleteDepartmentResponse{Result: false, Code: 500, Msg: "删除部门失败"}, nil
+	}
+	return &umv1.DeleteDepartmentResponse{
+		Result:             true,
+		Code:               200,
+		Msg:                "删除成功",
+		DeletedDepartments: int32(deleted),
+		RemovedMembers:     int32(removed),
+	}, nil
+}
+
+// ListDepartments 分页获取当前租户的部门列表；默认按路径排序，同一上级部门的部门相邻，按上级部门筛选时按同级排序
+func (s *DepartmentService) ListDepartments(ctx context.Context, req *umv1.ListDepartmentsRequest) (*umv1.ListDepartmentsResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.ListDepartmentsResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	q := departmentQuery(s.client, tenantID).Where(datascope.FromContext(ctx).Departments()...)
+	if req.GetPid() != "" {
+		parentID, err := strconv.ParseInt(req.GetPid(), 10, 64)
+		if err != nil {
+			return &umv1.ListDepartmentsResponse{Result: false, Code: 400, Msg: "无效的上级部门ID"}, nil
+		}
+		q.Where(department.ParentID(parentID))
+	}
+	if name := strings.TrimSpace(req.GetName()); name != "" {
+		q.Where(department.NameContainsFold(name))
+	}
+	if code := strings.TrimSpace(req.GetCode()); code != "" {
+		q.Where(department.Code(code))
+	}
+	// 排序参数
+	allowedOrderFields := []string{
+		department.FieldName,
+		department.FieldSortOrder,
+		department.FieldCreatedAt,
+	}
+	switch {
+	case !slices.Contains(allowedOrderFields, req.GetOrder()) && req.GetPid() != "":
+		q.Order(ent.Asc(department.FieldSortOrder), ent.Asc(department.FieldID))
+	case !slices.Contains(allowedOrderFields, req.GetOrder()):
+		q.Order(ent.Asc(department.FieldPath))
+	case req.GetIsDesc():
+		q.Order(ent.Desc(req.GetOrder()))
+	default:
+		q.Order(ent.Asc(req.GetOrder()))
+	}
+
+	// 分页参数
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return &umv1.ListDepartmentsResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	depts, err := q.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).All(ctx)
+	if err != nil {
+		return &umv1.ListDepartmentsResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	return &umv1.ListDepartmentsResponse{
+		Result: true,
+		Code:   200,
+		Data: &umv1.ListDepartmentsResponse_PageResult{
+			Total:       int32(total),
+			Departments: convertDepartmentsToProto(depts),
+			Page:        page,
+			PageSize:    pageSize,
+		},
+		Msg: "查询成功",
+	}, nil
+}
+
+// GetDepartmentTree 获取部门树，同级部门按排序返回；数据范围只包含部分部门时，上级部门不可见的部门作为根节点返回
+func (s *DepartmentService) GetDepartmentTree(ctx context.Context, req *umv1.GetDepartmentTreeRequest) (*umv1.GetDepartmentTreeResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.GetDepartmentTreeResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	q := departmentQuery(s.client, tenantID).Where(datascope.FromContext(ctx).Departments()...)
+	if req.GetId() != "" {
+		root, code, msg := s.loadDepartment(ctx, tenantID, req.GetId())
+		if root == nil {
+			return &umv1.GetDepartmentTreeResponse{Result: false, Code: code, Msg: msg}, nil
+		}
+		q.Where(predicate.Department(datascope.DescendantOf(department.FieldPath, root.Path)))
+	}
+	depts, err := q.Order(ent.Asc(department.FieldSortOrder), ent.Asc(department.FieldID)).All(ctx)
+	if err != nil {
+		return &umv1.GetDepartmentTreeResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	return &umv1.GetDepartmentTreeResponse{Result: true, Code: 200, Msg: "查询成功", Nodes: buildDepartmentTree(depts)}, nil
+}
+
+// ListDepartmentAncestors 获取部门的所有上级部门，从根部门到直接上级
+// 上级部门用于展示部门所在位置，不受数据范围限制
+func (s *DepartmentService) ListDepartmentAncestors(ctx context.Context, req *umv1.ListDepartmentAncestorsRequest) (*umv1.ListDepartmentAncestorsResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.ListDepartmentAncestorsResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	d, code, msg := s.loadDepartment(ctx, tenantID, req.GetId())
+	if d == nil {
+		return &umv1.ListDepartmentAncestorsResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	depts, err := departmentQuery(s.client, tenantID).
+		Where(
+			predicate.Department(tenancy.AncestorOf(department.FieldPath, d.Path)),
+			department.IDNEQ(d.ID),
+		).
+		All(ctx)
+	if err != nil {
+		return &umv1.ListDepartmentAncestorsResponse{Result: false, Code: 500, Msg: "查询上级部门失败"}, nil
+	}
+	slices.SortFunc(depts, func(a, b *ent.Department) int {
+		return departmentDepth(a.Path) - departmentDepth(b.Path)
+	})
+	return &umv1.ListDepartmentAncestorsResponse{Result: true, Code: 200, Msg: "查询成功", Departments: convertDepartmentsToProto(depts)}, nil
+}
+
+// ListDepartmentDescendants 获取部门的所有下级部门（不含自身），按路径排序
+func (s *DepartmentService) ListDepartmentDescendants(ctx context.Context, req *umv1.ListDepartmentDescendantsRequest) (*umv1.ListDepartmentDescendantsResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.ListDepartmentDescendantsResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	d, code, msg := s.loadDepartment(ctx, tenantID, req.GetId())
+	if d == nil {
+		return &umv1.ListDepartmentDescendantsResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	depts, err := departmentQuery(s.client, tenantID).
+		Where(datascope.FromContext(ctx).Departments()...).
+		Where(
+			predicate.Department(datascope.DescendantOf(department.FieldPath, d.Path)),
+			department.IDNEQ(d.ID),
+		).
+		Order(ent.Asc(department.FieldPath)).
+		All(ctx)
+	if err != nil {
+		return &umv1.ListDepartmentDescendantsResponse{Result: false, Code: 500, Msg: "查询下级部门失败"}, nil
+	}
+	return &umv1.ListDepartmentDescendantsResponse{Result: true, Code: 200, Msg: "查询成功", Departments: convertDepartmentsToProto(depts)}, nil
+}
+
+// loadDepartment 查询当前租户下未删除的部门，失败时返回错误码和提示
+func (s *DepartmentService) loadDepartment(ctx context.Context, tenantID int64, idStr string) (*ent.Department, int32, string) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return nil, 400, "无效的部门ID"
+	}
+	d, err := departmentQuery(s.client, tenantID).Where(department.ID(id)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 404, "部门不存在"
+		}
+		return nil, 500, "查询部门失败"
+	}
+	return d, 0, ""
+}
+
+// departmentQuery 租户下未删除的部门
+func departmentQuery(client *ent.Client, tenantID int64) *ent.DepartmentQuery {
+	return client.Department.Query().Where(department.TenantID(tenantID), department.DeletedAtIsNil())
+}
+
+// operatorFromContext 当前操作人ID，未登录时为nil
+func operatorFromContext(ctx context.Context) *int64 {
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		return &uid
+	}
+	return nil
+}
+
+// departmentInput 创建、修改部门时写入的字段，编码为空表示不设置
+type departmentInput struct {
+	name        string
+	code        string
+	description string
+	externalID  *string
+}
+
+func newDepartmentInput(name, code, description string) departmentInput {
+	return departmentInput{
+		name:        strings.TrimSpace(name),
+		code:        strings.TrimSpace(code),
+		description: strings.TrimSpace(description),
+	}
+}
+
+func (in departmentInput) codeOrNil() *string {
+	if in.code == "" {
+		return nil
+	}
+	return &in.code
+}
+
+// createDepartment 在tx中创建部门：锁定上级部门，检查部门数上限和唯一性，新部门排在同级部门最后
+func createDepartment(ctx context.Context, tx *ent.Tx, tenantID, parentID int64, in departmentInput) (*ent.Department, int32, string) {
+	if parentID > 0 {
+		// 锁定上级部门，避免与删除上级部门并发时挂到已删除的部门下
+		if _, err := departmentQuery(tx.Client(), tenantID).Where(department.ID(parentID)).ForUpdate().Only(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return nil, 404, "上级部门不存在"
+			}
+			return nil, 500, "查询上级部门失败"
+		}
+	}
+	var exceeded *quota.ExceededError
+	if err := quota.Check(ctx, tx.Client(), tenantID, quota.ResourceDepartments, 1); errors.As(err, &exceeded) {
+		return nil, 403, quotaExceededMsg(exceeded)
+	} else if err != nil {
+		return nil, 500, "创建部门失败"
+	}
+	if code, msg := checkDepartmentUnique(ctx, tx.Client(), tenantID, 0, parentID, in); code != 0 {
+		return nil, code, msg
+	}
+	sortOrder, err := nextSortOrder(ctx, tx.Client(), tenantID, parentID)
+	if err != nil {
+		return nil, 500, "创建部门失败"
+	}
+
+	operator := operatorFromContext(ctx)
+	d, err := tx.Department.Create().
+		SetTenantID(tenantID).
+		SetParentID(parentID).
+		SetName(in.name).
+		SetNillableCode(in.codeOrNil()).
+		SetDescription(in.description).
+		SetNillableExternalID(in.externalID).
+		SetSortOrder(sortOrder).
+		SetNillableCreatedBy(operator).
+		SetNillableUpdatedBy(operator).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, 409, "部门名称、编码或外部ID已存在"
+	}
+	if err != nil {
+		logger.Errorf("创建部门失败: %v", err)
+		return nil, 500, "创建部门失败"
+	}
+	return d, 0, ""
+}
+
+// updateDepartment 在tx中修改已锁定部门d的名称、编码、描述和外部ID，d.ParentID为修改后的上级部门
+func updateDepartment(ctx context.Context, tx *ent.Tx, d *ent.Department, in departmentInput) (*ent.Department, int32, string) {
+	if code, msg := checkDepartmentUnique(ctx, tx.Client(), d.TenantID, d.ID, d.ParentID, in); code != 0 {
+		return nil, code, msg
+	}
+	upd := tx.Department.UpdateOneID(d.ID).
+		SetName(in.name).
+		SetDescription(in.description).
+		SetNillableUpdatedBy(operatorFromContext(ctx))
+	if in.code != "" {
+		upd.SetCode(in.code)
+	} else {
+		upd.ClearCode()
+	}
+	if in.externalID != nil {
+		upd.SetExternalID(*in.externalID)
+	} else {
+		upd.ClearExternalID()
+	}
+	d, err := upd.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, 409, "部门名称、编码或外部ID已存在"
+	}
+	if err != nil {
+		logger.Errorf("修改部门失败: %v", err)
+		return nil, 500, "修改部门失败"
+	}
+	return d, 0, ""
+}
+
+// checkDepartmentUnique 检查同级部门名称、租户内编码和外部ID是否与其他未删除的部门重复，selfID为修改中的部门
+func checkDepartmentUnique(ctx context.Context, client *ent.Client, tenantID, selfID, parentID int64, in departmentInput) (int32, string) {
+	conds := []predicate.Department{department.And(department.ParentID(parentID), department.Name(in.name))}
+	if in.code != "" {
+		conds = append(conds, department.Code(in.code))
+	}
+	if in.externalID != nil {
+		conds = append(conds, department.ExternalID(*in.externalID))
+	}
+	others, err := departmentQuery(client, tenantID).
+		Where(department.IDNEQ(selfID), department.Or(conds...)).
+		All(ctx)
+	if err != nil {
+		return 500, "查询部门失败"
+	}
+	for _, o := range others {
+		switch {
+		case o.ParentID == parentID && o.Name == in.name:
+			return 409, "同一上级部门下已有同名部门: " + in.name
+		case in.code != "" && o.Code != nil && *o.Code == in.code:
+			return 409, "部门编码已存在: " + in.code
+		case in.externalID != nil && o.ExternalID != nil && *o.ExternalID == *in.externalID:
+			return 409, "外部ID已被其他部门使用: " + *in.externalID
+		}
+	}
+	return 0, ""
+}
+
+// departmentDepth 部门在树中的深度，根部门为1
+func departmentDepth(path string) int {
+	return strings.Count(path, ".") + 1
+}
+
+// buildDepartmentTree 把部门列表组装为树，上级部门不在列表中的部门作为根节点，子节点保持列表中的顺序
+func buildDepartmentTree(depts []*ent.Department) []*umv1.DepartmentNode {
+	nodes := make(map[int64]*umv1.DepartmentNode, len(depts))
+	for _, d := range depts {
+		nodes[d.ID] = &umv1.DepartmentNode{Department: convertDepartmentToProto(d)}
+	}
+	roots := make([]*umv1.DepartmentNode, 0)
+	for _, d := range depts {
+		if parent, ok := nodes[d.ParentID]; ok && d.ParentID != d.ID {
+			parent.Children = append(parent.Children, nodes[d.ID])
+		} else {
+			roots = append(roots, nodes[d.ID])
+		}
+	}
+	return roots
+}
+
+func convertDepartmentToProto(d *ent.Department) *umv1.Department {
+	item := &umv1.Department{
+		Id:          strconv.FormatInt(d.ID, 10),
+		Name:        d.Name,
+		Code:        variant.New(d.Code).ToString(),
+		Description: d.Description,
+		CreatedBy:   variant.New(d.CreatedBy).ToString(),
+		UpdatedBy:   variant.New(d.UpdatedBy).ToString(),
+		CreatedAt:   d.CreatedAt.Format(time.DateTime),
+		UpdatedAt:   d.UpdatedAt.Format(time.DateTime),
+		TenantId:    strconv.FormatInt(d.TenantID, 10),
+		Path:        d.Path,
+		SortOrder:   int32(d.SortOrder),
+		HeadId:      variant.New(d.HeadID).ToString(),
+		ExternalId:  variant.New(d.ExternalID).ToString(),
+	}
+	if d.ParentID != 0 {
+		item.Pid = strconv.FormatInt(d.ParentID, 10)
+	}
+	for _, id := range d.DeputyIds {
+		item.DeputyIds = append(item.DeputyIds, strconv.FormatInt(id, 10))
+	}
+	return item
+}
+
+func convertDepartmentsToProto(depts []*ent.Department) []*umv1.Department {
+	items := make([]*umv1.Department, 0, len(depts))
+	for _, d := range depts {
+		items = append(items, convertDepartmentToProto(d))
+	}
+	return items
+}
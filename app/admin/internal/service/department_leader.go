@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
+	"github.com/yc-alpha/admin/common/datascope"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/orgchart"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/admin/ent/usertenant"
+	"github.com/yc-alpha/logger"
+)
+
+// SetDepartmentLeaders 设置部门负责人和副职，均需为当前租户的成员，但不要求在该部门中
+func (s *DepartmentService) SetDepartmentLeaders(ctx context.Context, req *umv1.SetDepartmentLeadersRequest) (*umv1.SetDepartmentLeadersResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+	headID, err := parseOptionalID(req.GetHeadId())
+	if err != nil {
+		return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 400, Msg: "无效的负责人ID"}, nil
+	}
+	deputyIDs := make([]int64, 0, len(req.GetDeputyIds()))
+	for _, v := range req.GetDeputyIds() {
+		uid, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 400, Msg: "无效的副职ID: " + v}, nil
+		}
+		if uid == headID {
+			return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 400, Msg: "负责人不能同时为副职"}, nil
+		}
+		if !slices.Contains(deputyIDs, uid) {
+			deputyIDs = append(deputyIDs, uid)
+		}
+	}
+	leaderIDs := deputyIDs
+	if headID != 0 {
+		leaderIDs = append([]int64{headID}, deputyIDs...)
+	}
+	if len(leaderIDs) > 0 {
+		members, err := s.client.UserTenant.Query().
+			Where(
+				usertenant.TenantID(tenantID),
+				usertenant.UserIDIn(leaderIDs...),
+				usertenant.HasUserWith(user.DeletedAtIsNil()),
+			).
+			Count(ctx)
+		if err != nil {
+			return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+		}
+		if members != len(leaderIDs) {
+			return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 400, Msg: "负责人和副职需为当前租户的成员"}, nil
+		}
+	}
+
+	d, err := departmentQuery(s.client, tenantID).Where(department.ID(id)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 404, Msg: "部门不存在"}, nil
+		}
+		return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	upd := s.client.Department.UpdateOne(d).
+		SetDeputyIds(deputyIDs).
+		SetNillableUpdatedBy(operatorFromContext(ctx))
+	if headID != 0 {
+		upd.SetHeadID(headID)
+	} else {
+		upd.ClearHeadID()
+	}
+	if d, err = upd.Save(ctx); err != nil {
+		logger.Errorf("设置部门负责人失败: %v", err)
+		return &umv1.SetDepartmentLeadersResponse{Result: false, Code: 500, Msg: "设置部门负责人失败"}, nil
+	}
+	return &umv1.SetDepartmentLeadersResponse{Result: true, Code: 200, Msg: "设置成功", Department: convertDepartmentToProto(d)}, nil
+}
+
+// GetReportingLine 获取用户的汇报线：从用户所在部门沿路径向上，依次取各级部门的负责人
+// 负责人空缺或已不是租户成员的部门被跳过，用户自己负责的部门也跳过
+func (s *DepartmentService) GetReportingLine(ctx context.Context, req *umv1.GetReportingLineRequest) (*umv1.GetReportingLineResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.GetReportingLineResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &umv1.GetReportingLineResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	deptID, err := parseOptionalID(req.GetDepartmentId())
+	if err != nil {
+		return &umv1.GetReportingLineResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+
+	q := s.client.UserDepartment.Query().
+		Where(
+			userdepartment.UserID(userID),
+			userdepartment.TenantID(tenantID),
+			userdepartment.HasDepartmentWith(department.DeletedAtIsNil()),
+		)
+	if deptID != 0 {
+		q.Where(userdepartment.DeptID(deptID))
+	} else {
+		q.Where(userdepartment.IsPrimary(true))
+	}
+	membership, err := q.WithDepartment().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) && deptID != 0 {
+			return &umv1.GetReportingLineResponse{Result: false, Code: 404, Msg: "用户不在该部门中"}, nil
+		}
+		if ent.IsNotFound(err) {
+			return &umv1.GetReportingLineResponse{Result: true, Code: 200, Msg: "用户在当前租户下没有部门"}, nil
+		}
+		return &umv1.GetReportingLineResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+	d := membership.Edges.Department
+
+	ancestors, err := departmentQuery(s.client, tenantID).
+		Where(predicate.Department(tenancy.AncestorOf(department.FieldPath, d.Path))).
+		All(ctx)
+	if err != nil {
+		return &umv1.GetReportingLineResponse{Result: false, Code: 500, Msg: "查询上级部门失败"}, nil
+	}
+	heads, err := departmentHeads(ctx, s.client, tenantID, ancestors)
+	if err != nil {
+		return &umv1.GetReportingLineResponse{Result: false, Code: 500, Msg: "查询部门负责人失败"}, nil
+	}
+	chain := orgchart.Managers(d.Path, heads, userID)
+
+	managerIDs := make([]int64, 0, len(chain))
+	for _, m := range chain {
+		managerIDs = append(managerIDs, m.UserID)
+	}
+	users, err := s.client.User.Query().Where(user.IDIn(managerIDs...)).All(ctx)
+	if err != nil {
+		return &umv1.GetReportingLineResponse{Result: false, Code: 500, Msg: "查询用户失败"}, nil
+	}
+	userByID := make(map[int64]*ent.User, len(users))
+	for _, u := range users {
+		userByID[u.ID] = u
+	}
+	deptByID := make(map[int64]*ent.Department, len(ancestors))
+	for _, a := range ancestors {
+		deptByID[a.ID] = a
+	}
+	managers := make([]*umv1.ReportingManager, 0, len(chain))
+	for _, m := range chain {
+		managers = append(managers, &umv1.ReportingManager{
+			User:           convertLoginUserToProto(userByID[m.UserID]),
+			DepartmentId:   strconv.FormatInt(m.DepartmentID, 10),
+			DepartmentName: deptByID[m.DepartmentID].Name,
+		})
+	}
+	return &umv1.GetReportingLineResponse{
+		Result:       true,
+		Code:         200,
+		Msg:          "查询成功",
+		DepartmentId: strconv.FormatInt(d.ID, 10),
+		Managers:     managers,
+	}, nil
+}
+
+// ListReports 获取向用户汇报的成员，按成员的主部门计算
+// 直接下属为汇报线上第一位主管是该用户的成员；all为true时返回用户负责的部门及其下级部门中的全部成员
+func (s *DepartmentService) ListReports(ctx context.Context, req *umv1.ListReportsRequest) (*umv1.ListReportsResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.ListReportsResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &umv1.ListReportsResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+	empty := &umv1.ListReportsResponse{
+		Result: true,
+		Code:   200,
+		Data:   &umv1.ListReportsResponse_PageResult{Users: []*umv1.DepartmentUser{}, Page: page, PageSize: pageSize},
+		Msg:    "查询成功",
+	}
+
+	member, err := s.client.UserTenant.Query().
+		Where(
+			usertenant.TenantID(tenantID),
+			usertenant.UserID(userID),
+			usertenant.HasUserWith(user.DeletedAtIsNil()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+	if !member {
+		return &umv1.ListReportsResponse{Result: false, Code: 404, Msg: "用户不是当前租户的成员"}, nil
+	}
+	led, err := departmentQuery(s.client, tenantID).Where(department.HeadID(userID)).All(ctx)
+	if err != nil {
+		return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询负责的部门失败"}, nil
+	}
+	if len(led) == 0 {
+		return empty, nil
+	}
+	subtree := make([]predicate.Department, 0, len(led))
+	for _, d := range led {
+		subtree = append(subtree, predicate.Department(datascope.DescendantOf(department.FieldPath, d.Path)))
+	}
+
+	userFilter := []predicate.User{user.DeletedAtIsNil()}
+	userFilter = append(userFilter, datascope.FromContext(ctx).Users()...)
+	q := s.client.UserDepartment.Query().
+		Where(
+			userdepartment.TenantID(tenantID),
+			userdepartment.IsPrimary(true),
+			userdepartment.UserIDNEQ(userID),
+			userdepartment.HasDepartmentWith(department.DeletedAtIsNil(), department.Or(subtree...)),
+			userdepartment.HasUserWith(userFilter...),
+		).
+		WithUser().
+		WithDepartment().
+		Order(
+			userdepartment.ByDepartmentField(department.FieldPath),
+			ent.Asc(userdepartment.FieldUserID),
+		)
+
+	var (
+		rows  []*ent.UserDepartment
+		total int
+	)
+	if req.GetAll() {
+		if total, err = q.Clone().Count(ctx); err != nil {
+			return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询下属失败"}, nil
+		}
+		if rows, err = q.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).All(ctx); err != nil {
+			return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询下属失败"}, nil
+		}
+	} else {
+		// 直接下属需要逐个计算汇报线，子树中下级部门的负责人会截断向上的汇报
+		depts, err := departmentQuery(s.client, tenantID).Where(department.Or(subtree...)).All(ctx)
+		if err != nil {
+			return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询下级部门失败"}, nil
+		}
+		heads, err := departmentHeads(ctx, s.client, tenantID, depts)
+		if err != nil {
+			return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询部门负责人失败"}, nil
+		}
+		all, err := q.All(ctx)
+		if err != nil {
+			return &umv1.ListReportsResponse{Result: false, Code: 500, Msg: "查询下属失败"}, nil
+		}
+		direct := make([]*ent.UserDepartment, 0, len(all))
+		for _, r := range all {
+			if m, ok := orgchart.DirectManager(r.Edges.Department.Path, heads, r.UserID); ok && m.UserID == userID {
+				direct = append(direct, r)
+			}
+		}
+		total = len(direct)
+		start := min(int((page-1)*pageSize), total)
+		rows = direct[start:min(start+int(pageSize), total)]
+	}
+
+	users := make([]*umv1.DepartmentUser, 0, len(rows))
+	for _, r := range rows {
+		users = append(users, convertDepartmentUserToProto(r))
+	}
+	return &umv1.ListReportsResponse{
+		Result: true,
+		Code:   200,
+		Data: &umv1.ListReportsResponse_PageResult{
+			Total:    int32(total),
+			Users:    users,
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Msg: "查询成功",
+	}, nil
+}
+
+// departmentHeads 部门ID到负责人的映射，负责人已不是租户成员或已删除的部门视为空缺
+func departmentHeads(ctx context.Context, client *ent.Client, tenantID int64, depts []*ent.Department) (map[int64]int64, error) {
+	headIDs := make([]int64, 0, len(depts))
+	for _, d := range depts {
+		if d.HeadID != nil {
+			headIDs = append(headIDs, *d.HeadID)
+		}
+	}
+	heads := make(map[int64]int64, len(headIDs))
+	if len(headIDs) == 0 {
+		return heads, nil
+	}
+	var active []int64
+	if err := client.UserTenant.Query().
+		Where(
+			usertenant.TenantID(tenantID),
+			usertenant.UserIDIn(headIDs...),
+			usertenant.HasUserWith(user.DeletedAtIsNil()),
+		).
+		Select(usertenant.FieldUserID).
+		Scan(ctx, &active); err != nil {
+		return nil, err
+	}
+	for _, d := range depts {
+		if d.HeadID != nil && slices.Contains(active, *d.HeadID) {
+			heads[d.ID] = *d.HeadID
+		}
+	}
+	return heads, nil
+}
+
+// clearDepartmentLeader 用户离开租户时撤销其在该租户下的部门负责人和副职
+func clearDepartmentLeader(ctx context.Context, tx *ent.Tx, tenantID, userID int64) error {
+	if err := tx.Department.Update().
+		Where(department.TenantID(tenantID), department.HeadID(userID)).
+		ClearHeadID().
+		Exec(ctx); err != nil {
+		return err
+	}
+	depts, err := tx.Department.Query().
+		Where(department.TenantID(tenantID), func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(department.FieldDeputyIds, userID))
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range depts {
+		deputies := slices.DeleteFunc(slices.Clone(d.DeputyIds), func(id int64) bool { return id == userID })
+		if err := tx.Department.UpdateOne(d).SetDeputyIds(deputies).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
+	"github.com/yc-alpha/admin/common/datascope"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/admin/ent/usertenant"
+	"github.com/yc-alpha/logger"
+)
+
+// AddUsersToDepartment 添加部门成员；primary为true或用户在当前租户下还没有主部门时，该部门成为用户的主部门
+func (s *DepartmentService) AddUsersToDepartment(ctx context.Context, req *umv1.AddUsersToDepartmentRequest) (*umv1.AddUsersToDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	deptID, err := strconv.ParseInt(req.GetDepartmentId(), 10, 64)
+	if err != nil {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+	userIDs, msg := parseUserIDs(req.GetUserIds())
+	if msg != "" {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "添加部门成员失败"}, nil
+	}
+	defer tx.Rollback()
+	// 锁定部门，与删除部门互斥
+	if _, err := departmentQuery(tx.Client(), tenantID).Where(department.ID(deptID)).ForUpdate().Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 404, Msg: "部门不存在"}, nil
+		}
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	members, err := tx.UserTenant.Query().
+		Where(usertenant.TenantID(tenantID), usertenant.UserIDIn(userIDs...)).
+		Count(ctx)
+	if err != nil {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+	if members != len(userIDs) {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 400, Msg: "部分用户不是当前租户的成员，请先添加为租户成员"}, nil
+	}
+
+	existing, err := tx.UserDepartment.Query().
+		Where(userdepartment.DeptID(deptID), userdepartment.UserIDIn(userIDs...)).
+		Count(ctx)
+	if err != nil {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+	builders := make([]*ent.UserDepartmentCreate, 0, len(userIDs))
+	for _, uid := range userIDs {
+		builders = append(builders, tx.UserDepartment.Create().SetUserID(uid).SetDeptID(deptID).SetTenantID(tenantID))
+	}
+	if err := tx.UserDepartment.CreateBulk(builders...).
+		OnConflictColumns(userdepartment.FieldUserID, userdepartment.FieldDeptID).
+		DoNothing().
+		Exec(ctx); err != nil {
+		logger.Errorf("添加部门成员失败: %v", err)
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "添加部门成员失败"}, nil
+	}
+	if req.GetPrimary() {
+		err = setPrimaryDepartment(ctx, tx, tenantID, deptID, userIDs...)
+	} else {
+		err = ensurePrimaryDepartments(ctx, tx, tenantID, userIDs)
+	}
+	if err != nil {
+		logger.Errorf("设置主部门失败: %v", err)
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "添加部门成员失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.AddUsersToDepartmentResponse{Result: false, Code: 500, Msg: "添加部门成员失败"}, nil
+	}
+	return &umv1.AddUsersToDepartmentResponse{Result: true, Code: 200, Msg: "添加成功", Added: int32(len(userIDs) - existing)}, nil
+}
+
+// RemoveUsersFromDepartment 移除部门成员，用户仍是租户成员；移除的是主部门时由其余部门中最早加入的一个接替
+func (s *DepartmentService) RemoveUsersFromDepartment(ctx context.Context, req *umv1.RemoveUsersFromDepartmentRequest) (*umv1.RemoveUsersFromDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	deptID, err := strconv.ParseInt(req.GetDepartmentId(), 10, 64)
+	if err != nil {
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+	userIDs, msg := parseUserIDs(req.GetUserIds())
+	if msg != "" {
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 500, Msg: "移除部门成员失败"}, nil
+	}
+	defer tx.Rollback()
+	if _, err := departmentQuery(tx.Client(), tenantID).Where(department.ID(deptID)).Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 404, Msg: "部门不存在"}, nil
+		}
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	removed, err := tx.UserDepartment.Delete().
+		Where(userdepartment.DeptID(deptID), userdepartment.TenantID(tenantID), userdepartment.UserIDIn(userIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 500, Msg: "移除部门成员失败"}, nil
+	}
+	if err := ensurePrimaryDepartments(ctx, tx, tenantID, userIDs); err != nil {
+		logger.Errorf("设置主部门失败: %v", err)
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 500, Msg: "移除部门成员失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.RemoveUsersFromDepartmentResponse{Result: false, Code: 500, Msg: "移除部门成员失败"}, nil
+	}
+	return &umv1.RemoveUsersFromDepartmentResponse{Result: true, Code: 200, Msg: "移除成功", Removed: int32(removed)}, nil
+}
+
+// ListDepartmentUsers 分页获取部门成员，include_descendants为true时沿部门路径包含所有下级部门的成员
+// 同一用户在多个部门时每个部门各返回一条，按部门路径和用户排序
+func (s *DepartmentService) ListDepartmentUsers(ctx context.Context, req *umv1.ListDepartmentUsersRequest) (*umv1.ListDepartmentUsersResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.ListDepartmentUsersResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	d, code, msg := s.loadDepartment(ctx, tenantID, req.GetDepartmentId())
+	if d == nil {
+		return &umv1.ListDepartmentUsersResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	q := s.client.UserDepartment.Query().Where(userdepartment.TenantID(tenantID))
+	if req.GetIncludeDescendants() {
+		q.Where(userdepartment.HasDepartmentWith(
+			department.DeletedAtIsNil(),
+			predicate.Department(datascope.DescendantOf(department.FieldPath, d.Path)),
+		))
+	} else {
+		q.Where(userdepartment.DeptID(d.ID))
+	}
+	userFilter := []predicate.User{user.DeletedAtIsNil()}
+	userFilter = append(userFilter, datascope.FromContext(ctx).Users()...)
+	if kw := strings.TrimSpace(req.GetKeyword()); kw != "" {
+		userFilter = append(userFilter, user.Or(
+			user.UsernameContains(kw),
+			user.FullNameContains(kw),
+			user.EmailContains(kw),
+			user.PhoneContains(kw),
+		))
+	}
+	q.Where(userdepartment.HasUserWith(userFilter...))
+
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return &umv1.ListDepartmentUsersResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+	rows, err := q.
+		WithUser().
+		WithDepartment().
+		Order(
+			userdepartment.ByDepartmentField(department.FieldPath),
+			ent.Asc(userdepartment.FieldUserID),
+		).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &umv1.ListDepartmentUsersResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+
+	users := make([]*umv1.DepartmentUser, 0, len(rows))
+	for _, r := range rows {
+		users = append(users, convertDepartmentUserToProto(r))
+	}
+	return &umv1.ListDepartmentUsersResponse{
+		Result: true,
+		Code:   200,
+		Data: &umv1.ListDepartmentUsersResponse_PageResult{
+			Total:    int32(total),
+			Users:    users,
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Msg: "查询成功",
+	}, nil
+}
+
+// SetPrimaryDepartment 设置用户在当前租户下的主部门
+func (s *DepartmentService) SetPrimaryDepartment(ctx context.Context, req *umv1.SetPrimaryDepartmentRequest) (*umv1.SetPrimaryDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	deptID, err := strconv.ParseInt(req.GetDepartmentId(), 10, 64)
+	if err != nil {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 500, Msg: "设置主部门失败"}, nil
+	}
+	defer tx.Rollback()
+	exist, err := tx.UserDepartment.Query().
+		Where(
+			userdepartment.UserID(userID),
+			userdepartment.DeptID(deptID),
+			userdepartment.TenantID(tenantID),
+			userdepartment.HasDepartmentWith(department.DeletedAtIsNil()),
+		).
+		Exist(ctx)
+	if err != nil {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 500, Msg: "查询部门成员失败"}, nil
+	}
+	if !exist {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 404, Msg: "用户不在该部门中"}, nil
+	}
+	if err := setPrimaryDepartment(ctx, tx, tenantID, deptID, userID); err != nil {
+		logger.Errorf("设置主部门失败: %v", err)
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 500, Msg: "设置主部门失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.SetPrimaryDepartmentResponse{Result: false, Code: 500, Msg: "设置主部门失败"}, nil
+	}
+	return &umv1.SetPrimaryDepartmentResponse{Result: true, Code: 200, Msg: "设置成功"}, nil
+}
+
+// setPrimaryDepartment 把deptID设为用户在租户下的主部门，先取消原主部门以满足唯一索引
+func setPrimaryDepartment(ctx context.Context, tx *ent.Tx, tenantID, deptID int64, userIDs ...int64) error {
+	if err := tx.UserDepartment.Update().
+		Where(
+			userdepartment.TenantID(tenantID),
+			userdepartment.UserIDIn(userIDs...),
+			userdepartment.IsPrimary(true),
+			userdepartment.DeptIDNEQ(deptID),
+		).
+		SetIsPrimary(false).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return tx.UserDepartment.Update().
+		Where(
+			userdepartment.TenantID(tenantID),
+			userdepartment.UserIDIn(userIDs...),
+			userdepartment.DeptID(deptID),
+		).
+		SetIsPrimary(true).
+		Exec(ctx)
+}
+
+// ensurePrimaryDepartments 为在租户下没有主部门的用户指定主部门：取未删除部门中最早加入的一个，没有部门的用户保持不变
+func ensurePrimaryDepartments(ctx context.Context, tx *ent.Tx, tenantID int64, userIDs []int64) error {
+	rows, err := tx.UserDepartment.Query().
+		Where(
+			userdepartment.TenantID(tenantID),
+			userdepartment.UserIDIn(userIDs...),
+			userdepartment.HasDepartmentWith(department.DeletedAtIsNil()),
+		).
+		Order(ent.Asc(userdepartment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	candidates := make(map[int64]*ent.UserDepartment, len(userIDs))
+	hasPrimary := make(map[int64]bool, len(userIDs))
+	for _, r := range rows {
+		if r.IsPrimary {
+			hasPrimary[r.UserID] = true
+		}
+		if _, ok := candidates[r.UserID]; !ok {
+			candidates[r.UserID] = r
+		}
+	}
+	for uid, c := range candidates {
+		if hasPrimary[uid] {
+			continue
+		}
+		if err := tx.UserDepartment.UpdateOne(c).SetIsPrimary(true).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertDepartmentUserToProto 转换部门关系，需预加载用户和部门
+func convertDepartmentUserToProto(r *ent.UserDepartment) *umv1.DepartmentUser {
+	return &umv1.DepartmentUser{
+		User:           convertLoginUserToProto(r.Edges.User),
+		DepartmentId:   strconv.FormatInt(r.DeptID, 10),
+		DepartmentName: r.Edges.Department.Name,
+		IsPrimary:      r.IsPrimary,
+	}
+}
+
+// parseUserIDs 解析并去重用户ID
+func parseUserIDs(ids []string) ([]int64, string) {
+	if len(ids) == 0 {
+		return nil, "用户ID不能为空"
+	}
+	seen := make(map[int64]bool, len(ids))
+	userIDs := make([]int64, 0, len(ids))
+	for _, v := range ids {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, "无效的用户ID: " + v
+		}
+		if !seen[id] {
+			seen[id] = true
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs, ""
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/logger"
+)
+
+// 部门的层级字段受ent更新钩子保护，移动时用一条原生SQL改写整棵子树
+// 子树中每个节点的路径把旧前缀替换为新前缀，被移动的部门同时更新上级部门和排序；已删除的下级部门一并改写，保持路径一致
+const moveDepartmentSQL = `UPDATE departments SET
+	parent_id = CASE WHEN id = $3 THEN $4 ELSE parent_id END,
+	sort_order = CASE WHEN id = $3 THEN $5 ELSE sort_order END,
+	updated_by = CASE WHEN id = $3 THEN $6 ELSE updated_by END,
+	path = CASE WHEN path = $1::ltree THEN $2::ltree ELSE $2::ltree || subpath(path, nlevel($1::ltree)) END,
+	updated_at = now()
+WHERE tenant_id = $7 AND path <@ $1::ltree`
+
+// MoveDepartment 将部门连同其下级部门移动到新的上级部门下
+func (s *DepartmentService) MoveDepartment(ctx context.Context, req *umv1.MoveDepartmentRequest) (*umv1.MoveDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+	parentID, err := parseOptionalID(req.GetPid())
+	if err != nil {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 400, Msg: "无效的上级部门ID"}, nil
+	}
+	if req.SortOrder != nil && req.GetSortOrder() < 0 {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 400, Msg: "排序不能为负数"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 500, Msg: "移动部门失败"}, nil
+	}
+	defer tx.Rollback()
+	d, err := departmentQuery(tx.Client(), tenantID).Where(department.ID(id)).ForUpdate().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &umv1.MoveDepartmentResponse{Result: false, Code: 404, Msg: "部门不存在"}, nil
+		}
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	var sortOrder *int
+	if req.SortOrder != nil {
+		v := int(req.GetSortOrder())
+		sortOrder = &v
+	}
+	affected, code, msg := relocateDepartment(ctx, tx, d, parentID, sortOrder)
+	if code != 0 {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if d, err = tx.Department.Get(ctx, id); err != nil {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.MoveDepartmentResponse{Result: false, Code: 500, Msg: "移动部门失败"}, nil
+	}
+	return &umv1.MoveDepartmentResponse{
+		Result:     true,
+		Code:       200,
+		Msg:        "移动成功",
+		Department: convertDepartmentToProto(d),
+		Affected:   int32(affected),
+	}, nil
+}
+
+// ReorderDepartments 按给定顺序重排同一上级部门下的全部部门，排序依次为0、1、2……
+func (s *DepartmentService) ReorderDepartments(ctx context.Context, req *umv1.ReorderDepartmentsRequest) (*umv1.ReorderDepartmentsResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.ReorderDepartmentsResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	parentID, err := parseOptionalID(req.GetPid())
+	if err != nil {
+		return &umv1.ReorderDepartmentsResponse{Result: false, Code: 400, Msg: "无效的上级部门ID"}, nil
+	}
+	ids := make([]int64, 0, len(req.GetIds()))
+	for _, v := range req.GetIds() {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return &umv1.ReorderDepartmentsResponse{Result: false, Code: 400, Msg: "无效的部门ID: " + v}, nil
+		}
+		ids = append(ids, id)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.ReorderDepartmentsResponse{Result: false, Code: 500, Msg: "调整部门顺序失败"}, nil
+	}
+	defer tx.Rollback()
+	siblings, err := departmentQuery(tx.Client(), tenantID).
+		Where(department.ParentID(parentID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return &umv1.ReorderDepartmentsResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	byID := make(map[int64]*ent.Department, len(siblings))
+	for _, d := range siblings {
+		byID[d.ID] = d
+	}
+	// 只传部分部门时其余部门的位置无法确定，要求完整列出
+	sorted := slices.Sorted(slices.Values(ids))
+	if len(ids) != len(siblings) || len(slices.Compact(sorted)) != len(ids) {
+		return &umv1.ReorderDepartmentsResponse{Result: false, Code: 400, Msg: "需要按新顺序列出该上级部门下的全部" + strconv.Itoa(len(siblings)) + "个部门，且不能重复"}, nil
+	}
+
+	operator := operatorFromContext(ctx)
+	ordered := make([]*ent.Department, 0, len(ids))
+	for i, id := range ids {
+		d, ok := byID[id]
+		if !ok {
+			return &umv1.ReorderDepartmentsResponse{Result: false, Code: 400, Msg: "部门不属于该上级部门: " + strconv.FormatInt(id, 10)}, nil
+		}
+		if d.SortOrder != i {
+			if d, err = tx.Department.UpdateOne(d).SetSortOrder(i).SetNillableUpdatedBy(operator).Save(ctx); err != nil {
+				logger.Errorf("调整部门顺序失败: %v", err)
+				return &umv1.ReorderDepartmentsResponse{Result: false, Code: 500, Msg: "调整部门顺序失败"}, nil
+			}
+		}
+		ordered = append(ordered, d)
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.ReorderDepartmentsResponse{Result: false, Code: 500, Msg: "调整部门顺序失败"}, nil
+	}
+	return &umv1.ReorderDepartmentsResponse{Result: true, Code: 200, Msg: "调整成功", Departments: convertDepartmentsToProto(ordered)}, nil
+}
+
+// relocateDepartment 把已在tx中锁定的部门d移动到parentID下（0表示移动为根部门），改写整棵子树的路径
+// sortOrder为nil时排在新的同级部门最后，否则插入到该位置，原位置及之后的部门依次后移
+func relocateDepartment(ctx context.Context, tx *ent.Tx, d *ent.Department, parentID int64, sortOrder *int) (int64, int32, string) {
+	if parentID == d.ParentID {
+		return 0, 400, "部门已在该上级部门下，调整顺序请使用部门排序"
+	}
+	newPath := strconv.FormatInt(d.ID, 10)
+	if parentID != 0 {
+		parent, err := departmentQuery(tx.Client(), d.TenantID).Where(department.ID(parentID)).ForUpdate().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return 0, 404, "上级部门不存在"
+			}
+			return 0, 500, "查询上级部门失败"
+		}
+		if tenancy.IsDescendant(parent.Path, d.Path) {
+			return 0, 400, "不能移动到自身或其下级部门下"
+		}
+		newPath = tenancy.ChildPath(parent.Path, d.ID)
+	}
+	exist, err := departmentQuery(tx.Client(), d.TenantID).
+		Where(department.ParentID(parentID), department.Name(d.Name)).
+		Exist(ctx)
+	if err != nil {
+		return 0, 500, "查询部门失败"
+	}
+	if exist {
+		return 0, 409, "目标上级部门下已有同名部门: " + d.Name
+	}
+
+	var order int
+	if sortOrder != nil {
+		order = *sortOrder
+		err := tx.Department.Update().
+			Where(
+				department.TenantID(d.TenantID),
+				department.ParentID(parentID),
+				department.DeletedAtIsNil(),
+				department.SortOrderGTE(order),
+			).
+			AddSortOrder(1).
+			Exec(ctx)
+		if err != nil {
+			return 0, 500, "移动部门失败"
+		}
+	} else {
+		next, err := nextSortOrder(ctx, tx.Client(), d.TenantID, parentID)
+		if err != nil {
+			return 0, 500, "移动部门失败"
+		}
+		order = next
+	}
+
+	res, err := tx.ExecContext(ctx, moveDepartmentSQL, d.Path, newPath, d.ID, parentID, order, operatorFromContext(ctx), d.TenantID)
+	if err != nil {
+		logger.Errorf("改写部门子树路径失败: %v", err)
+		return 0, 500, "移动部门失败"
+	}
+	affected, _ := res.RowsAffected()
+	return affected, 0, ""
+}
+
+// nextSortOrder 同级部门中最大的排序加1，没有同级部门时为0
+func nextSortOrder(ctx context.Context, client *ent.Client, tenantID, parentID int64) (int, error) {
+	last, err := departmentQuery(client, tenantID).
+		Where(department.ParentID(parentID)).
+		Order(ent.Desc(department.FieldSortOrder)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return last.SortOrder + 1, nil
+}
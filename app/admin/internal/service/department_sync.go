@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/quota"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/variant"
+)
+
+// SyncDepartment 按外部ID创建或更新部门：不存在时创建，存在时更新名称、编码、描述，上级部门不同时移动部门
+// 请求与部门当前状态一致时不做修改，HR系统可以重复推送同一条数据
+func (s *DepartmentService) SyncDepartment(ctx context.Context, req *umv1.SyncDepartmentRequest) (*umv1.SyncDepartmentResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 400, Msg: "缺少租户信息"}, nil
+	}
+	externalID := strings.TrimSpace(req.GetExternalId())
+	if externalID == "" {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 400, Msg: "外部ID不能为空"}, nil
+	}
+	in := newDepartmentInput(req.GetName(), req.GetCode(), req.GetDescription())
+	if in.name == "" {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 400, Msg: "部门名称不能为空"}, nil
+	}
+	in.externalID = &externalID
+	parentID, err := parseOptionalID(req.GetPid())
+	if err != nil {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 400, Msg: "无效的上级部门ID"}, nil
+	}
+	var disabled *quota.FeatureDisabledError
+	if err := quota.CheckFeature(ctx, s.client, tenantID, quota.FeatureDepartmentSync); errors.As(err, &disabled) {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 403, Msg: featureDisabledMsg(disabled)}, nil
+	} else if err != nil {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 500, Msg: "查询租户套餐失败"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 500, Msg: "同步部门失败"}, nil
+	}
+	defer tx.Rollback()
+	if pe := strings.TrimSpace(req.GetParentExternalId()); pe != "" {
+		parent, err := departmentQuery(tx.Client(), tenantID).Where(department.ExternalID(pe)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return &umv1.SyncDepartmentResponse{Result: false, Code: 404, Msg: "上级部门不存在，请先同步上级部门: " + pe}, nil
+			}
+			return &umv1.SyncDepartmentResponse{Result: false, Code: 500, Msg: "查询上级部门失败"}, nil
+		}
+		parentID = parent.ID
+	}
+
+	d, err := departmentQuery(tx.Client(), tenantID).Where(department.ExternalID(externalID)).ForUpdate().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+	}
+	created := d == nil
+	var (
+		code int32
+		msg  string
+	)
+	switch {
+	case created:
+		d, code, msg = createDepartment(ctx, tx, tenantID, parentID, in)
+	case d.ParentID == parentID && d.Name == in.name && variant.New(d.Code).ToString() == in.code && d.Description == in.description:
+		// 没有变化
+	default:
+		if parentID != d.ParentID {
+			if _, code, msg = relocateDepartment(ctx, tx, d, parentID, nil); code != 0 {
+				break
+			}
+			d.ParentID = parentID
+		}
+		d, code, msg = updateDepartment(ctx, tx, d, in)
+	}
+	if code != 0 {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &umv1.SyncDepartmentResponse{Result: false, Code: 500, Msg: "同步部门失败"}, nil
+	}
+	return &umv1.SyncDepartmentResponse{
+		Result:     true,
+		Code:       200,
+		Msg:        "同步成功",
+		Department: convertDepartmentToProto(d),
+		Created:    created,
+	}, nil
+}
@@ -136,15 +136,14 @@ func (s *InitService) ensureRootDepartmentWithConfig(ctx context.Context, tenant
 	}
 
 	// 创建总公司部门
-	// 对于根部门，parent_id 设为 0，path 设为部门ID
+	// 对于根部门，parent_id 设为 0，path 由创建钩子设为部门ID
 	rootDept, err := s.client.Department.Create().
 		SetTenantID(tenantID).
 		SetParentID(0). // 根部门的父级ID为0
 		SetName(config.RootDeptName).
-		SetPath("0"). // 初始path设为"0"，创建后会更新为实际ID
+		SetDescription("系统默认根部门").
 		SetAttributes(map[string]any{
-			"description": "系统默认根部门",
-			"level":       0,
+			"level": 0,
 		}).
 		Save(ctx)
 
@@ -152,15 +151,6 @@ func (s *InitService) ensureRootDepartmentWithConfig(ctx context.Context, tenant
 		return nil, fmt.Errorf("创建总公司部门失败: %w", err)
 	}
 
-	// 更新部门的path为自身的ID（ltree格式）
-	_, err = s.client.Department.UpdateOneID(rootDept.ID).
-		SetPath(fmt.Sprintf("%d", rootDept.ID)).
-		Save(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("更新部门路径失败: %w", err)
-	}
-
 	logger.Info("已创建总公司部门")
 	return rootDept, nil
 }
@@ -212,6 +202,7 @@ func (s *InitService) ensureRootUserWithConfig(ctx context.Context, tenantID, de
 		SetUserID(user.ID).
 		SetTenantID(tenantID).
 		SetDepartmentID(deptID).
+		SetIsPrimary(true).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("创建ROOT用户部门关联失败: %w", err)
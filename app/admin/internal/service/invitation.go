@@ -0,0 +1,781 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/app/admin/internal/config"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/invite"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/notify"
+	"github.com/yc-alpha/admin/common/quota"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/role"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/admin/ent/usertenant"
+	"github.com/yc-alpha/logger"
+)
+
+// 租户邀请事件主题
+const (
+	TopicInvitationCreated  = "tenant.invitation_created"
+	TopicInvitationAccepted = "tenant.invitation_accepted"
+)
+
+// invitationStatusExpired 已过期的待接受邀请，只用于展示和筛选，不落库
+const invitationStatusExpired = "EXPIRED"
+
+// minInvitePasswordLen 接受邀请时设置密码的最小长度
+const minInvitePasswordLen = 8
+
+// InvitationEvent 租户邀请事件
+type InvitationEvent struct {
+	InvitationID int64  `json:"invitation_id"`
+	TenantID     int64  `json:"tenant_id"`
+	Contact      string `json:"contact"`
+	UserID       int64  `json:"user_id,omitempty"` // 接受邀请的用户
+	Created      bool   `json:"created,omitempty"` // 接受时是否新注册了账号
+	OperatorID   int64  `json:"operator_id,omitempty"`
+}
+
+// InvitationService 租户邀请
+// 邀请令牌由signer签名，内容包括目标租户、部门和初始角色；令牌是否已使用、撤销或被重新发送替换以数据库记录为准
+type InvitationService struct {
+	v1.UnimplementedInvitationServiceServer
+	client    *ent.Client
+	enforcer  *casbin.SyncedEnforcer
+	publisher event.Publisher
+	signer    *invite.Signer
+	notifier  notify.Notifier
+	ttl       time.Duration
+	acceptURL string
+}
+
+// NewInvitationService 创建租户邀请服务，未启用Casbin时enforcer传nil
+func NewInvitationService(client *ent.Client, enforcer *casbin.SyncedEnforcer, publisher event.Publisher, signer *invite.Signer, notifier notify.Notifier, cfg *config.InvitationConfig) *InvitationService {
+	return &InvitationService{
+		client:    client,
+		enforcer:  enforcer,
+		publisher: publisher,
+		signer:    signer,
+		notifier:  notifier,
+		ttl:       cfg.TTL,
+		acceptURL: cfg.AcceptURL,
+	}
+}
+
+// CreateInvitation 邀请用户加入租户
+func (s *InvitationService) CreateInvitation(ctx context.Context, req *v1.CreateInvitationRequest) (*v1.CreateInvitationResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.CreateInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	email, phone := strings.TrimSpace(req.GetEmail()), strings.TrimSpace(req.GetPhone())
+	if email == "" && phone == "" {
+		return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "请填写被邀请人的邮箱或手机号"}, nil
+	}
+	departmentID, err := parseOptionalID(req.GetDepartmentId())
+	if err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "无效的部门ID"}, nil
+	}
+	roleIDs := make([]int64, 0, len(req.GetRoleIds()))
+	for _, id := range req.GetRoleIds() {
+		rid, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "无效的角色ID: " + id}, nil
+		}
+		roleIDs = append(roleIDs, rid)
+	}
+	labels, msg := normalizeRoleLabels(req.GetRoleLabels())
+	if msg != "" {
+		return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	t, err := s.client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.CreateInvitationResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+	var unavailable *tenancy.UnavailableError
+	if err := tenancy.Check(ctx, s.client, tenantID, time.Now()); errors.As(err, &unavailable) {
+		return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "租户不可用: " + unavailable.Error()}, nil
+	} else if err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+	var disabled *quota.FeatureDisabledError
+	if err := quota.CheckFeature(ctx, s.client, tenantID, quota.FeatureInvitations); errors.As(err, &disabled) {
+		return &v1.CreateInvitationResponse{Result: false, Code: 403, Msg: featureDisabledMsg(disabled)}, nil
+	} else if err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询租户套餐失败"}, nil
+	}
+	// 成员已满时不再发出邀请，接受时仍会再次检查
+	var exceeded *quota.ExceededError
+	if err := quota.Check(ctx, s.client, tenantID, quota.ResourceUsers, 1); errors.As(err, &exceeded) {
+		return &v1.CreateInvitationResponse{Result: false, Code: 403, Msg: quotaExceededMsg(exceeded)}, nil
+	} else if err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询租户配额失败"}, nil
+	}
+	if departmentID > 0 {
+		exist, err := s.client.Department.Query().
+			Where(department.ID(departmentID), department.TenantID(tenantID), department.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+		}
+		if !exist {
+			return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "部门不存在或不属于该租户"}, nil
+		}
+	}
+	if len(roleIDs) > 0 {
+		n, err := s.client.Role.Query().
+			Where(role.IDIn(roleIDs...), role.TenantIDEQ(tenantID), role.IsActive(true)).
+			Count(ctx)
+		if err != nil {
+			return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询角色失败"}, nil
+		}
+		if n != len(roleIDs) {
+			return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "角色不存在、已停用或不属于该租户"}, nil
+		}
+	}
+	if u, err := s.findInvitee(ctx, s.client, email, phone); err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询用户失败"}, nil
+	} else if u != nil {
+		member, err := s.client.UserTenant.Query().
+			Where(usertenant.UserID(u.ID), usertenant.TenantID(tenantID)).
+			Exist(ctx)
+		if err != nil {
+			return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+		}
+		if member {
+			return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "该用户已是租户成员"}, nil
+		}
+	}
+
+	nonce, err := invite.NewNonce()
+	if err != nil {
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "创建邀请失败"}, nil
+	}
+	operatorID := middleware.GetUserIDFromContext(ctx)
+	creator := s.client.TenantInvitation.Create().
+		SetTenantID(tenantID).
+		SetRoleIds(roleIDs).
+		SetRoleLabels(labels).
+		SetNonce(nonce).
+		SetExpiresAt(time.Now().Add(s.ttl)).
+		SetSendCount(1)
+	if email != "" {
+		creator.SetEmail(email)
+	}
+	if phone != "" {
+		creator.SetPhone(phone)
+	}
+	if departmentID > 0 {
+		creator.SetDepartmentID(departmentID)
+	}
+	if operatorID > 0 {
+		creator.SetInvitedBy(operatorID)
+	}
+	inv, err := creator.Save(ctx)
+	if err != nil {
+		switch {
+		case ent.IsValidationError(err):
+			return &v1.CreateInvitationResponse{Result: false, Code: 400, Msg: "邮箱或手机号格式不正确"}, nil
+		case ent.IsConstraintError(err):
+			return &v1.CreateInvitationResponse{Result: false, Code: 409, Msg: "已有待接受的邀请，请重新发送"}, nil
+		}
+		logger.Errorf("创建邀请失败: %v", err)
+		return &v1.CreateInvitationResponse{Result: false, Code: 500, Msg: "创建邀请失败"}, nil
+	}
+
+	s.publish(ctx, TopicInvitationCreated, &InvitationEvent{
+		InvitationID: inv.ID,
+		TenantID:     tenantID,
+		Contact:      invitationContact(inv),
+		OperatorID:   operatorID,
+	})
+	if err := s.deliver(ctx, inv, t.Name); err != nil {
+		logger.Errorf("发送邀请%d失败: %v", inv.ID, err)
+		return &v1.CreateInvitationResponse{Result: false, Code: 502, Msg: "邀请已创建，但发送失败，请稍后重新发送", Invitation: convertInvitationToProto(inv, t.Name)}, nil
+	}
+	return &v1.CreateInvitationResponse{Result: true, Code: 200, Msg: "邀请已发送", Invitation: convertInvitationToProto(inv, t.Name)}, nil
+}
+
+// ListInvitations 获取租户的邀请列表，按创建时间倒序
+func (s *InvitationService) ListInvitations(ctx context.Context, req *v1.ListInvitationsRequest) (*v1.ListInvitationsResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.ListInvitationsResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.ListInvitationsResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+
+	now := time.Now()
+	query := s.client.TenantInvitation.Query().
+		Where(tenantinvitation.TenantID(tenantID))
+	switch status := req.GetStatus(); status {
+	case "":
+	case invitationStatusExpired:
+		query.Where(tenantinvitation.StatusEQ(tenantinvitation.StatusPENDING), tenantinvitation.ExpiresAtLTE(now))
+	case tenantinvitation.StatusPENDING.String():
+		query.Where(tenantinvitation.StatusEQ(tenantinvitation.StatusPENDING), tenantinvitation.ExpiresAtGT(now))
+	default:
+		st := tenantinvitation.Status(status)
+		if tenantinvitation.StatusValidator(st) != nil {
+			return &v1.ListInvitationsResponse{Result: false, Code: 400, Msg: "无效的邀请状态"}, nil
+		}
+		query.Where(tenantinvitation.StatusEQ(st))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return &v1.ListInvitationsResponse{Result: false, Code: 500, Msg: "查询邀请失败"}, nil
+	}
+	invitations, err := query.
+		WithTenant().
+		Order(ent.Desc(tenantinvitation.FieldCreatedAt)).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListInvitationsResponse{Result: false, Code: 500, Msg: "查询邀请失败"}, nil
+	}
+
+	items := make([]*v1.Invitation, 0, len(invitations))
+	for _, inv := range invitations {
+		var tenantName string
+		if inv.Edges.Tenant != nil {
+			tenantName = inv.Edges.Tenant.Name
+		}
+		items = append(items, convertInvitationToProto(inv, tenantName))
+	}
+	return &v1.ListInvitationsResponse{Result: true, Code: 200, Msg: "查询成功", Invitations: items, Total: int32(total)}, nil
+}
+
+// RevokeInvitation 撤销待接受的邀请，已发出的链接随即失效
+func (s *InvitationService) RevokeInvitation(ctx context.Context, req *v1.RevokeInvitationRequest) (*v1.RevokeInvitationResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.RevokeInvitationResponse{Result: false, Code: 400, Msg: "无效的邀请ID"}, nil
+	}
+	inv, err := s.client.TenantInvitation.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.RevokeInvitationResponse{Result: false, Code: 404, Msg: "邀请不存在"}, nil
+		}
+		return &v1.RevokeInvitationResponse{Result: false, Code: 500, Msg: "查询邀请失败"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, inv.TenantID); code != 0 {
+		return &v1.RevokeInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	n, err := s.client.TenantInvitation.Update().
+		Where(tenantinvitation.ID(id), tenantinvitation.StatusEQ(tenantinvitation.StatusPENDING)).
+		SetStatus(tenantinvitation.StatusREVOKED).
+		Save(ctx)
+	if err != nil {
+		return &v1.RevokeInvitationResponse{Result: false, Code: 500, Msg: "撤销邀请失败"}, nil
+	}
+	if n == 0 {
+		return &v1.RevokeInvitationResponse{Result: false, Code: 400, Msg: "只能撤销待接受的邀请"}, nil
+	}
+	return &v1.RevokeInvitationResponse{Result: true, Code: 200, Msg: "撤销成功"}, nil
+}
+
+// ResendInvitation 重新发送邀请，更换令牌版本并重新计算有效期，已过期的待接受邀请也可以重新发送
+func (s *InvitationService) ResendInvitation(ctx context.Context, req *v1.ResendInvitationRequest) (*v1.ResendInvitationResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.ResendInvitationResponse{Result: false, Code: 400, Msg: "无效的邀请ID"}, nil
+	}
+	nonce, err := invite.NewNonce()
+	if err != nil {
+		return &v1.ResendInvitationResponse{Result: false, Code: 500, Msg: "重新发送邀请失败"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.ResendInvitationResponse{Result: false, Code: 500, Msg: "重新发送邀请失败"}, nil
+	}
+	defer tx.Rollback()
+	inv, err := tx.TenantInvitation.Query().
+		Where(tenantinvitation.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.ResendInvitationResponse{Result: false, Code: 404, Msg: "邀请不存在"}, nil
+		}
+		return &v1.ResendInvitationResponse{Result: false, Code: 500, Msg: "查询邀请失败"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, inv.TenantID); code != 0 {
+		return &v1.ResendInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if inv.Status != tenantinvitation.StatusPENDING {
+		return &v1.ResendInvitationResponse{Result: false, Code: 400, Msg: "只能重新发送待接受的邀请"}, nil
+	}
+	t, err := tx.Tenant.Get(ctx, inv.TenantID)
+	if err != nil {
+		return &v1.ResendInvitationResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+	}
+	inv, err = tx.TenantInvitation.UpdateOne(inv).
+		SetNonce(nonce).
+		SetExpiresAt(time.Now().Add(s.ttl)).
+		AddSendCount(1).
+		Save(ctx)
+	if err != nil {
+		return &v1.ResendInvitationResponse{Result: false, Code: 500, Msg: "重新发送邀请失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.ResendInvitationResponse{Result: false, Code: 500, Msg: "重新发送邀请失败"}, nil
+	}
+
+	if err := s.deliver(ctx, inv, t.Name); err != nil {
+		logger.Errorf("发送邀请%d失败: %v", inv.ID, err)
+		return &v1.ResendInvitationResponse{Result: false, Code: 502, Msg: "发送邀请失败，请稍后重试", Invitation: convertInvitationToProto(inv, t.Name)}, nil
+	}
+	return &v1.ResendInvitationResponse{Result: true, Code: 200, Msg: "邀请已发送", Invitation: convertInvitationToProto(inv, t.Name)}, nil
+}
+
+// PreviewInvitation 凭令牌查看邀请内容
+func (s *InvitationService) PreviewInvitation(ctx context.Context, req *v1.PreviewInvitationRequest) (*v1.PreviewInvitationResponse, error) {
+	claims, code, msg := s.parseToken(req.GetToken())
+	if code != 0 {
+		return &v1.PreviewInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	inv, code, msg := s.loadInvitation(ctx, s.client, claims, false)
+	if code != 0 {
+		return &v1.PreviewInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	t, err := s.client.Tenant.Get(ctx, inv.TenantID)
+	if err != nil {
+		return &v1.PreviewInvitationResponse{Result: false, Code: 410, Msg: "邀请的租户已不存在"}, nil
+	}
+
+	resp := &v1.PreviewInvitationResponse{
+		Result:     true,
+		Code:       200,
+		Msg:        "查询成功",
+		TenantName: t.Name,
+		Contact:    invitationContact(inv),
+		ExpiresAt:  inv.ExpiresAt.Format(time.RFC3339),
+	}
+	if inv.DepartmentID != nil {
+		if d, err := s.client.Department.Get(ctx, *inv.DepartmentID); err == nil {
+			resp.DepartmentName = d.Name
+		}
+	}
+	if len(inv.RoleIds) > 0 {
+		names, err := s.client.Role.Query().
+			Where(role.IDIn(inv.RoleIds...)).
+			Select(role.FieldName).
+			Strings(ctx)
+		if err == nil {
+			resp.RoleNames = names
+		}
+	}
+	u, err := s.findInvitee(ctx, s.client, stringOrEmpty(inv.Email), stringOrEmpty(inv.Phone))
+	if err != nil {
+		return &v1.PreviewInvitationResponse{Result: false, Code: 500, Msg: "查询用户失败"}, nil
+	}
+	resp.AccountExists = u != nil
+	return resp, nil
+}
+
+// AcceptInvitation 凭令牌接受邀请
+// 按邀请的邮箱或手机号查找账号，没有时用填写的用户名和密码注册；新注册或待激活的账号在接受后变为ACTIVE
+// 同一事务中写入租户成员、部门关系和角色授予，已删除的部门和已停用的角色跳过
+func (s *InvitationService) AcceptInvitation(ctx context.Context, req *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error) {
+	claims, code, msg := s.parseToken(req.GetToken())
+	if code != 0 {
+		return &v1.AcceptInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+	}
+	defer tx.Rollback()
+	inv, code, msg := s.loadInvitation(ctx, tx.Client(), claims, true)
+	if code != 0 {
+		return &v1.AcceptInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	var unavailable *tenancy.UnavailableError
+	if err := tenancy.Check(ctx, tx.Client(), inv.TenantID, time.Now()); errors.As(err, &unavailable) {
+		return &v1.AcceptInvitationResponse{Result: false, Code: 403, Msg: "租户不可用，无法加入"}, nil
+	} else if err != nil {
+		return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+
+	u, created, code, msg := s.prepareInvitee(ctx, tx, inv, req)
+	if code != 0 {
+		return &v1.AcceptInvitationResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	isMember, err := tx.UserTenant.Query().
+		Where(usertenant.UserID(u.ID), usertenant.TenantID(inv.TenantID)).
+		Exist(ctx)
+	if err != nil {
+		return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+	if !isMember {
+		var exceeded *quota.ExceededError
+		if err := quota.Check(ctx, tx.Client(), inv.TenantID, quota.ResourceUsers, 1); errors.As(err, &exceeded) {
+			return &v1.AcceptInvitationResponse{Result: false, Code: 403, Msg: quotaExceededMsg(exceeded)}, nil
+		} else if err != nil {
+			return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "查询租户配额失败"}, nil
+		}
+	}
+	member := tx.UserTenant.Create().
+		SetUserID(u.ID).
+		SetTenantID(inv.TenantID).
+		SetRoleLabels(inv.RoleLabels).
+		SetNillableCreatedBy(inv.InvitedBy)
+	if err := member.OnConflictColumns(usertenant.FieldUserID, usertenant.FieldTenantID).DoNothing().Exec(ctx); err != nil {
+		logger.Errorf("接受邀请写入租户成员失败: %v", err)
+		return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+	}
+	if inv.DepartmentID != nil {
+		exist, err := tx.Department.Query().
+			Where(department.ID(*inv.DepartmentID), department.TenantID(inv.TenantID), department.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "查询部门失败"}, nil
+		}
+		if exist {
+			if err := tx.UserDepartment.Create().
+				SetUserID(u.ID).
+				SetDeptID(*inv.DepartmentID).
+				SetTenantID(inv.TenantID).
+				OnConflictColumns(userdepartment.FieldUserID, userdepartment.FieldDeptID).
+				DoNothing().
+				Exec(ctx); err != nil {
+				logger.Errorf("接受邀请写入部门关系失败: %v", err)
+				return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+			}
+			if err := ensurePrimaryDepartments(ctx, tx, inv.TenantID, []int64{u.ID}); err != nil {
+				logger.Errorf("接受邀请设置主部门失败: %v", err)
+				return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+			}
+		}
+	}
+	var roles []*ent.Role
+	if len(inv.RoleIds) > 0 {
+		roles, err = tx.Role.Query().
+			Where(role.IDIn(inv.RoleIds...), role.TenantIDEQ(inv.TenantID), role.IsActive(true)).
+			All(ctx)
+		if err != nil {
+			return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "查询角色失败"}, nil
+		}
+	}
+	for _, r := range roles {
+		if err := tx.UserRole.Create().
+			SetUserID(u.ID).
+			SetRoleID(r.ID).
+			SetTenantID(inv.TenantID).
+			SetNillableGrantedBy(inv.InvitedBy).
+			SetReason("接受邀请").
+			OnConflictColumns(userrole.FieldUserID, userrole.FieldRoleID, userrole.FieldTenantID).
+			DoNothing().
+			Exec(ctx); err != nil {
+			logger.Errorf("接受邀请写入角色授予失败: %v", err)
+			return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+		}
+	}
+	if err := tx.TenantInvitation.UpdateOne(inv).
+		SetStatus(tenantinvitation.StatusACCEPTED).
+		SetAcceptedBy(u.ID).
+		SetAcceptedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.AcceptInvitationResponse{Result: false, Code: 500, Msg: "接受邀请失败"}, nil
+	}
+
+	s.addGrantRules(u.ID, inv.TenantID, roles)
+	s.publish(ctx, TopicInvitationAccepted, &InvitationEvent{
+		InvitationID: inv.ID,
+		TenantID:     inv.TenantID,
+		Contact:      invitationContact(inv),
+		UserID:       u.ID,
+		Created:      created,
+	})
+	s.publish(ctx, TopicTenantMemberAdded, &TenantMemberEvent{
+		TenantID:   inv.TenantID,
+		UserID:     u.ID,
+		RoleLabels: inv.RoleLabels,
+	})
+	return &v1.AcceptInvitationResponse{
+		Result:   true,
+		Code:     200,
+		Msg:      "已加入租户",
+		UserId:   strconv.FormatInt(u.ID, 10),
+		TenantId: strconv.FormatInt(inv.TenantID, 10),
+		Created:  created,
+	}, nil
+}
+
+// prepareInvitee 查找或注册被邀请人，并把待激活的账号激活
+func (s *InvitationService) prepareInvitee(ctx context.Context, tx *ent.Tx, inv *ent.TenantInvitation, req *v1.AcceptInvitationRequest) (*ent.User, bool, int32, string) {
+	password := req.GetPassword()
+	if password != "" && len(password) < minInvitePasswordLen {
+		return nil, false, 400, "密码不能少于" + strconv.Itoa(minInvitePasswordLen) + "位"
+	}
+	u, err := s.findInvitee(ctx, tx.Client(), stringOrEmpty(inv.Email), stringOrEmpty(inv.Phone))
+	if err != nil {
+		return nil, false, 500, "查询用户失败"
+	}
+
+	created := false
+	if u == nil {
+		username := strings.TrimSpace(req.GetUsername())
+		if username == "" || password == "" {
+			return nil, false, 400, "请填写用户名和密码完成注册"
+		}
+		exist, err := tx.User.Query().Where(user.Username(username)).Exist(ctx)
+		if err != nil {
+			return nil, false, 500, "查询用户失败"
+		}
+		if exist {
+			return nil, false, 409, "用户名已被使用"
+		}
+		// 新账号按默认状态PENDING创建，与已有的待激活账号一起在下面激活
+		creator := tx.User.Create().
+			SetUsername(username).
+			SetPassword(password).
+			SetNillableEmail(inv.Email).
+			SetNillablePhone(inv.Phone).
+			SetNillableCreatedBy(inv.InvitedBy)
+		if fullname := strings.TrimSpace(req.GetFullname()); fullname != "" {
+			creator.SetFullName(fullname)
+		}
+		u, err = creator.Save(ctx)
+		if err != nil {
+			if ent.IsValidationError(err) || ent.IsConstraintError(err) {
+				return nil, false, 400, "注册账号失败: " + err.Error()
+			}
+			logger.Errorf("接受邀请注册账号失败: %v", err)
+			return nil, false, 500, "注册账号失败"
+		}
+		created = true
+	}
+
+	switch u.Status {
+	case user.StatusDISABLED:
+		return nil, false, 403, "账号已被停用"
+	case user.StatusPENDING:
+		updater := tx.User.UpdateOne(u).SetStatus(user.StatusACTIVE)
+		if !created && u.Password == nil {
+			if password == "" {
+				return nil, false, 400, "请设置登录密码"
+			}
+			updater.SetPassword(password)
+		}
+		if u, err = updater.Save(ctx); err != nil {
+			logger.Errorf("接受邀请激活账号失败: %v", err)
+			return nil, false, 500, "激活账号失败"
+		}
+	}
+	return u, created, 0, ""
+}
+
+// findInvitee 按邮箱或手机号查找未删除的账号，优先匹配邮箱
+func (s *InvitationService) findInvitee(ctx context.Context, client *ent.Client, email, phone string) (*ent.User, error) {
+	for _, p := range []struct {
+		value string
+		where func(string) predicate.User
+	}{
+		{email, user.Email},
+		{phone, user.Phone},
+	} {
+		if p.value == "" {
+			continue
+		}
+		u, err := client.User.Query().Where(p.where(p.value), user.DeletedAtIsNil()).Only(ctx)
+		if err == nil {
+			return u, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// parseToken 校验令牌签名和有效期，失败时返回错误码和提示
+func (s *InvitationService) parseToken(token string) (*invite.Claims, int32, string) {
+	if token == "" {
+		return nil, 400, "缺少邀请令牌"
+	}
+	claims, err := s.signer.Parse(token)
+	if err != nil {
+		if errors.Is(err, invite.ErrTokenExpired) {
+			return nil, 410, "邀请已过期，请联系邀请人重新发送"
+		}
+		return nil, 400, "无效的邀请链接"
+	}
+	return claims, 0, ""
+}
+
+// loadInvitation 读取令牌对应的邀请并确认仍可接受，forUpdate时在事务中锁定
+func (s *InvitationService) loadInvitation(ctx context.Context, client *ent.Client, claims *invite.Claims, forUpdate bool) (*ent.TenantInvitation, int32, string) {
+	query := client.TenantInvitation.Query().Where(tenantinvitation.ID(claims.InvitationID()))
+	if forUpdate {
+		query.ForUpdate()
+	}
+	inv, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 404, "邀请不存在"
+		}
+		return nil, 500, "查询邀请失败"
+	}
+	// 令牌版本不一致说明邀请已被重新发送，旧链接作废
+	if inv.Nonce != claims.Nonce || inv.TenantID != claims.TenantID {
+		return nil, 400, "邀请链接已失效，请使用最新的邀请"
+	}
+	switch inv.Status {
+	case tenantinvitation.StatusACCEPTED:
+		return nil, 409, "邀请已被接受"
+	case tenantinvitation.StatusREVOKED:
+		return nil, 410, "邀请已被撤销"
+	}
+	if !time.Now().Before(inv.ExpiresAt) {
+		return nil, 410, "邀请已过期，请联系邀请人重新发送"
+	}
+	return inv, 0, ""
+}
+
+// deliver 签发令牌并通过notifier发送邀请链接，有邮箱时发送邮件，否则发送短信
+func (s *InvitationService) deliver(ctx context.Context, inv *ent.TenantInvitation, tenantName string) error {
+	claims := invite.Claims{
+		TenantID: inv.TenantID,
+		RoleIDs:  inv.RoleIds,
+		Nonce:    inv.Nonce,
+	}
+	if inv.DepartmentID != nil {
+		claims.DepartmentID = *inv.DepartmentID
+	}
+	token, err := s.signer.Sign(inv.ID, invitationContact(inv), claims, time.Now(), inv.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	link := acceptLink(s.acceptURL, token)
+	expires := inv.ExpiresAt.Format("2006-01-02 15:04")
+
+	msg := &notify.Message{
+		Channel: notify.ChannelSMS,
+		To:      stringOrEmpty(inv.Phone),
+		Body:    "您被邀请加入" + tenantName + "，请在" + expires + "前打开链接接受邀请：" + link,
+		Data: map[string]string{
+			"tenant_name": tenantName,
+			"link":        link,
+			"expires_at":  inv.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+	if inv.Email != nil {
+		msg.Channel = notify.ChannelEmail
+		msg.To = *inv.Email
+		msg.Subject = "邀请您加入" + tenantName
+	}
+	return s.notifier.Notify(ctx, msg)
+}
+
+// addGrantRules 为接受邀请获得的永久角色授予写入g规则
+func (s *InvitationService) addGrantRules(userID, tenantID int64, roles []*ent.Role) {
+	if s.enforcer == nil || len(roles) == 0 {
+		return
+	}
+	rules := make([][]string, 0, len(roles))
+	for _, r := range roles {
+		rules = append(rules, authz.GroupingRule(userID, r.Code, tenantID))
+	}
+	if _, err := s.enforcer.AddGroupingPoliciesEx(rules); err != nil {
+		logger.Errorf("同步邀请角色授予规则失败: %v", err)
+	}
+}
+
+func (s *InvitationService) publish(ctx context.Context, topic string, payload any) {
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, event.New(topic, payload))
+	}
+}
+
+// acceptLink 把令牌以token参数附加到接受邀请的页面地址
+func acceptLink(base, token string) string {
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + "token=" + url.QueryEscape(token)
+}
+
+func invitationContact(inv *ent.TenantInvitation) string {
+	if inv.Email != nil {
+		return *inv.Email
+	}
+	return stringOrEmpty(inv.Phone)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func convertInvitationToProto(inv *ent.TenantInvitation, tenantName string) *v1.Invitation {
+	item := &v1.Invitation{
+		Id:         strconv.FormatInt(inv.ID, 10),
+		TenantId:   strconv.FormatInt(inv.TenantID, 10),
+		TenantName: tenantName,
+		Email:      stringOrEmpty(inv.Email),
+		Phone:      stringOrEmpty(inv.Phone),
+		RoleLabels: inv.RoleLabels,
+		Status:     inv.Status.String(),
+		ExpiresAt:  inv.ExpiresAt.Format(time.RFC3339),
+		SendCount:  int32(inv.SendCount),
+		CreatedAt:  inv.CreatedAt.Format(time.RFC3339),
+	}
+	if inv.Status == tenantinvitation.StatusPENDING && !time.Now().Before(inv.ExpiresAt) {
+		item.Status = invitationStatusExpired
+	}
+	if inv.DepartmentID != nil {
+		item.DepartmentId = strconv.FormatInt(*inv.DepartmentID, 10)
+	}
+	for _, id := range inv.RoleIds {
+		item.RoleIds = append(item.RoleIds, strconv.FormatInt(id, 10))
+	}
+	if inv.InvitedBy != nil {
+		item.InvitedBy = strconv.FormatInt(*inv.InvitedBy, 10)
+	}
+	if inv.AcceptedBy != nil {
+		item.AcceptedBy = strconv.FormatInt(*inv.AcceptedBy, 10)
+	}
+	if inv.AcceptedAt != nil {
+		item.AcceptedAt = inv.AcceptedAt.Format(time.RFC3339)
+	}
+	return item
+}
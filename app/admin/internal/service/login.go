@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	v1 "github.com/yc-alpha/admin/api/login/v1"
+	umv1 "github.com/yc-alpha/admin/api/user_management/v1"
+	"github.com/yc-alpha/admin/common/authn"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/rls"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/logger"
+	"github.com/yc-alpha/variant"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyPasswordHash 用户不存在或未设置密码时用于比较的哈希，与用户密码使用相同的cost，
+// 使这些情况的响应时间与密码错误一致，避免通过耗时判断账号是否存在
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+// LoginService 登录认证服务
+type LoginService struct {
+	v1.UnimplementedLoginServiceServer
+	client *ent.Client
+	tokens *authn.TokenManager
+}
+
+// NewLoginService 创建登录认证服务
+func NewLoginService(client *ent.Client, tokens *authn.TokenManager) *LoginService {
+	return &LoginService{
+		client: client,
+		tokens: tokens,
+	}
+}
+
+func convertLoginUserToProto(user *ent.User) *umv1.SimpleUser {
+	return &umv1.SimpleUser{
+		Id:        strconv.FormatInt(user.ID, 10),
+		Username:  user.Username,
+		Email:     variant.New(user.Email).ToString(),
+		Phone:     variant.New(user.Phone).ToString(),
+		Fullname:  variant.New(user.FullName).ToString(),
+		Avatar:    variant.New(user.Avatar).ToString(),
+		Status:    umv1.UserStatus(umv1.UserStatus_value[user.Status.String()]),
+		Gender:    umv1.Gender(umv1.Gender_value[user.Gender.String()]),
+		Timezone:  user.Timezone,
+		Language:  user.Language,
+		CreatedBy: variant.New(user.CreatedBy).ToString(),
+		UpdatedBy: variant.New(user.UpdatedBy).ToString(),
+		CreatedAt: user.CreatedAt.Format(time.DateTime),
+		UpdatedAt: user.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+// Login 使用用户名/邮箱/手机号加密码登录，签发访问令牌和刷新令牌
+func (s *LoginService) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResponse, error) {
+	if req.GetUsername() == "" && req.GetEmail() == "" && req.GetPhone() == "" {
+		return &v1.LoginResponse{Result: false, Code: 400, Msg: "username, email, or phone is required"}, nil
+	}
+	if req.GetPassword() == "" {
+		return &v1.LoginResponse{Result: false, Code: 400, Msg: "password is required"}, nil
+	}
+
+	var identity []predicate.User
+	if req.GetUsername() != "" {
+		identity = append(identity, user.Username(req.GetUsername()))
+	}
+	if req.GetEmail() != "" {
+		identity = append(identity, user.Email(req.GetEmail()))
+	}
+	if req.GetPhone() != "" {
+		identity = append(identity, user.Phone(req.GetPhone()))
+	}
+	u, err := s.client.User.Query().
+		Where(user.Or(identity...), user.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) || ent.IsNotSingular(err) {
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.GetPassword()))
+			return &v1.LoginResponse{Result: false, Code: 401, Msg: "invalid username or password"}, nil
+		}
+		return nil, err
+	}
+
+	if u.Password == nil || *u.Password == "" {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.GetPassword()))
+		return &v1.LoginResponse{Result: false, Code: 401, Msg: "invalid username or password"}, nil
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(*u.Password), []byte(req.GetPassword())); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return &v1.LoginResponse{Result: false, Code: 401, Msg: "invalid username or password"}, nil
+		}
+		return nil, err
+	}
+
+	if u.Status != user.StatusACTIVE {
+		return &v1.LoginResponse{Result: false, Code: 403, Msg: "user is " + u.Status.String() + ", login is not allowed"}, nil
+	}
+
+	return s.issue(u)
+}
+
+// RefreshToken 使用刷新令牌换取新的令牌对
+func (s *LoginService) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.LoginResponse, error) {
+	// 登出或修改密码后令牌版本递增，之前签发的刷新令牌在这里被拒绝
+	claims, err := s.tokens.ParseRefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		if authn.IsTokenError(err) {
+			return &v1.LoginResponse{Result: false, Code: 401, Msg: err.Error()}, nil
+		}
+		return nil, err
+	}
+
+	// 重新校验用户状态，防止禁用后仍能续期
+	u, err := s.client.User.Query().
+		Where(user.ID(claims.UserID()), user.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.LoginResponse{Result: false, Code: 401, Msg: "user not found"}, nil
+		}
+		return nil, err
+	}
+	if u.Status != user.StatusACTIVE {
+		return &v1.LoginResponse{Result: false, Code: 403, Msg: "user is " + u.Status.String() + ", login is not allowed"}, nil
+	}
+
+	return s.issue(u)
+}
+
+// Logout 登出，递增用户的令牌版本，使该用户已签发的访问令牌和刷新令牌全部失效
+// 令牌版本由系统维护，在跳过行级安全的事务中更新，不受当前租户上下文限制
+func (s *LoginService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutResponse, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return &v1.LogoutResponse{Result: false, Code: 401, Msg: "not logged in"}, nil
+	}
+	err := rls.WithBypass(ctx, s.client, func(ctx context.Context) error {
+		return s.client.User.UpdateOneID(userID).AddTokenVersion(1).Exec(ctx)
+	})
+	if err != nil {
+		logger.Errorf("吊销用户[%d]令牌失败: %v", userID, err)
+		return &v1.LogoutResponse{Result: false, Code: 500, Msg: "failed to revoke tokens"}, nil
+	}
+	return &v1.LogoutResponse{Result: true, Code: 200, Msg: "success"}, nil
+}
+
+func (s *LoginService) issue(u *ent.User) (*v1.LoginResponse, error) {
+	pair, err := s.tokens.Issue(u.ID, u.Username, u.TokenVersion)
+	if err != nil {
+		logger.Errorf("签发令牌失败: %v", err)
+		return &v1.LoginResponse{Result: false, Code: 500, Msg: "failed to issue token"}, nil
+	}
+	return &v1.LoginResponse{
+		Result:       true,
+		Code:         200,
+		Msg:          "success",
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    pair.ExpiresIn,
+		UserInfo:     convertLoginUserToProto(u),
+	}, nil
+}
@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	v1 "github.com/yc-alpha/admin/api/permission/v1"
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
+	"github.com/yc-alpha/admin/ent/predicate"
+)
+
+const (
+	// maxBatchCheckSize 单次批量鉴权的最大请求数
+	maxBatchCheckSize = 200
+	// policyEngineTTL 求值引擎缓存的有效期，本实例修改策略时立即失效，其他实例的修改最迟在有效期后生效
+	policyEngineTTL = time.Minute
+)
+
+// PermissionService ABAC策略管理与鉴权服务
+// 策略按租户隔离：租户只能管理自己的策略，平台级策略（tenant_id为空）对所有租户生效
+type PermissionService struct {
+	v1.UnimplementedPermissionServiceServer
+	client   *ent.Client
+	subjects *authz.SubjectBuilder
+	engines  engineCache
+}
+
+// NewPermissionService 创建权限服务
+func NewPermissionService(client *ent.Client) *PermissionService {
+	return &PermissionService{
+		client:   client,
+		subjects: authz.NewSubjectBuilder(client),
+		engines:  engineCache{items: make(map[int64]cachedEngine)},
+	}
+}
+
+// CreatePolicy 创建策略
+func (s *PermissionService) CreatePolicy(ctx context.Context, req *v1.CreatePolicyRequest) (*v1.CreatePolicyResponse, error) {
+	if err := s.checkPolicyScope(ctx); err != nil {
+		return nil, err
+	}
+	if err := validatePolicy(req.GetName(), req.GetEffect(), req.GetSubjects(), req.GetResources(), req.GetActions(), req.GetContexts()); err != nil {
+		return nil, err
+	}
+
+	creator := s.client.AccessPolicy.Create().
+		SetName(req.GetName()).
+		SetDescription(req.GetDescription()).
+		SetEffect(accesspolicy.Effect(req.GetEffect().String())).
+		SetSubjects(convertAttributesFromProto(req.GetSubjects())).
+		SetResources(convertAttributesFromProto(req.GetResources())).
+		SetActions(convertAttributesFromProto(req.GetActions())).
+		SetContexts(convertAttributesFromProto(req.GetContexts())).
+		SetPriority(req.GetPriority()).
+		SetIsEnabled(req.GetIsEnabled())
+	if tenantID := middleware.GetTenantIDFromContext(ctx); tenantID > 0 {
+		creator.SetTenantID(tenantID)
+	}
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		creator.SetCreatedBy(uid).SetUpdatedBy(uid)
+	}
+	p, err := creator.Save(ctx)
+	if err != nil {
+		return nil, errors.InternalServer("POLICY_CREATE_FAILED", err.Error())
+	}
+	s.invalidateEngines(ctx)
+	return &v1.CreatePolicyResponse{Id: strconv.FormatInt(p.ID, 10)}, nil
+}
+
+// UpdatePolicy 更新策略（全量覆盖）
+func (s *PermissionService) UpdatePolicy(ctx context.Context, req *v1.UpdatePolicyRequest) (*v1.UpdatePolicyResponse, error) {
+	if err := s.checkPolicyScope(ctx); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_POLICY_ID", "invalid policy id")
+	}
+	if err := validatePolicy(req.GetName(), req.GetEffect(), req.GetSubjects(), req.GetResources(), req.GetActions(), req.GetContexts()); err != nil {
+		return nil, err
+	}
+
+	updater := s.client.AccessPolicy.UpdateOneID(id).
+		Where(policyOfTenant(middleware.GetTenantIDFromContext(ctx))).
+		SetName(req.GetName()).
+		SetDescription(req.GetDescription()).
+		SetEffect(accesspolicy.Effect(req.GetEffect().String())).
+		SetSubjects(convertAttributesFromProto(req.GetSubjects())).
+		SetResources(convertAttributesFromProto(req.GetResources())).
+		SetActions(convertAttributesFromProto(req.GetActions())).
+		SetContexts(convertAttributesFromProto(req.GetContexts())).
+		SetPriority(req.GetPriority()).
+		SetIsEnabled(req.GetIsEnabled())
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		updater.SetUpdatedBy(uid)
+	}
+	if _, err := updater.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NotFound("POLICY_NOT_FOUND", "policy not found")
+		}
+		return nil, errors.InternalServer("POLICY_UPDATE_FAILED", err.Error())
+	}
+	s.invalidateEngines(ctx)
+	return &v1.UpdatePolicyResponse{Success: true}, nil
+}
+
+// DeletePolicy 删除策略
+func (s *PermissionService) DeletePolicy(ctx context.Context, req *v1.DeletePolicyRequest) (*v1.DeletePolicyResponse, error) {
+	if err := s.checkPolicyScope(ctx); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return nil, errors.BadRequest("INVALID_POLICY_ID", "invalid policy id")
+	}
+	if err := s.client.AccessPolicy.DeleteOneID(id).
+		Where(policyOfTenant(middleware.GetTenantIDFromContext(ctx))).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.NotFound("POLICY_NOT_FOUND", "policy not found")
+		}
+		return nil, errors.InternalServer("POLICY_DELETE_FAILED", err.Error())
+	}
+	s.invalidateEngines(ctx)
+	return &v1.DeletePolicyResponse{Success: true}, nil
+}
+
+// ListPolicies 分页查询当前租户的策略（未指定租户时为平台级策略），按优先级从高到低排序
+func (s *PermissionService) ListPolicies(ctx context.Context, req *v1.ListPoliciesRequest) (*v1.ListPoliciesResponse, error) {
+	if err := s.checkPolicyScope(ctx); err != nil {
+		return nil, err
+	}
+	q := s.client.AccessPolicy.Query().
+		Where(policyOfTenant(middleware.GetTenantIDFromContext(ctx)))
+	if kw := req.GetKeyword(); kw != "" {
+		q.Where(accesspolicy.Or(
+			accesspolicy.NameContainsFold(kw),
+			accesspolicy.DescriptionContainsFold(kw),
+		))
+	}
+
+	// 分页参数
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100) // 限定最大页大小
+	offset := (page - 1) * pageSize
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.InternalServer("POLICY_QUERY_FAILED", err.Error())
+	}
+	policies, err := q.
+		Order(ent.Desc(accesspolicy.FieldPriority), ent.Asc(accesspolicy.FieldID)).
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.InternalServer("POLICY_QUERY_FAILED", err.Error())
+	}
+
+	items := make([]*v1.Policy, 0, len(policies))
+	for _, p := range policies {
+		items = append(items, convertPolicyToProto(p))
+	}
+	return &v1.ListPoliciesResponse{Items: items, Total: int32(total)}, nil
+}
+
+// CheckPermission 以当前登录用户为主体，按策略优先级求值单个鉴权请求
+func (s *PermissionService) CheckPermission(ctx context.Context, req *v1.CheckPermissionRequest) (*v1.CheckPermissionResponse, error) {
+	subject, err := s.subjectAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	engine, err := s.loadEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertDecisionToProto(engine.Evaluate(convertCheckRequest(subject, req))), nil
+}
+
+// BatchCheckPermission 批量鉴权，所有请求共用同一主体和求值引擎
+func (s *PermissionService) BatchCheckPermission(ctx context.Context, req *v1.BatchCheckPermissionRequest) (*v1.BatchCheckPermissionResponse, error) {
+	if len(req.GetRequests()) > maxBatchCheckSize {
+		return nil, errors.BadRequest("BATCH_TOO_LARGE", "too many requests in one batch, max "+strconv.Itoa(maxBatchCheckSize))
+	}
+	if len(req.GetRequests()) == 0 {
+		return &v1.BatchCheckPermissionResponse{}, nil
+	}
+
+	subject, err := s.subjectAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	engine, err := s.loadEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqs := make([]*abac.Request, 0, len(req.GetRequests()))
+	for _, r := range req.GetRequests() {
+		reqs = append(reqs, convertCheckRequest(subject, r))
+	}
+	decisions := engine.EvaluateBatch(reqs)
+
+	results := make([]*v1.CheckPermissionResponse, 0, len(decisions))
+	for _, d := range decisions {
+		results = append(results, convertDecisionToProto(d))
+	}
+	return &v1.BatchCheckPermissionResponse{Results: results}, nil
+}
+
+// subjectAttributes 当前登录用户的主体属性，AuthzMiddleware已构建Subject时直接复用
+func (s *PermissionService) subjectAttributes(ctx context.Context) (map[string]string, error) {
+	subject := middleware.GetSubject(ctx)
+	if subject == nil {
+		userID := middleware.GetUserIDFromContext(ctx)
+		if userID == 0 {
+			return nil, errors.Unauthorized("UNAUTHORIZED", "missing user authentication")
+		}
+		var err error
+		if subject, err = s.subjects.BuildSubject(ctx, userID, middleware.GetTenantIDFromContext(ctx)); err != nil {
+			return nil, errors.InternalServer("SUBJECT_BUILD_FAILED", err.Error())
+		}
+	}
+	return subject.Attributes(), nil
+}
+
+// loadEngine 获取当前租户的求值引擎，包含平台级策略和租户自己的策略，未命中缓存时从数据库加载
+func (s *PermissionService) loadEngine(ctx context.Context) (*abac.Engine, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	engine, version := s.engines.get(tenantID, time.Now())
+	if engine != nil {
+		return engine, nil
+	}
+
+	scope := accesspolicy.TenantIDIsNil()
+	if tenantID > 0 {
+		scope = accesspolicy.Or(accesspolicy.TenantIDIsNil(), accesspolicy.TenantID(tenantID))
+	}
+	policies, err := s.client.AccessPolicy.Query().
+		Where(accesspolicy.IsEnabled(true), scope).
+		All(ctx)
+	if err != nil {
+		return nil, errors.InternalServer("POLICY_QUERY_FAILED", err.Error())
+	}
+	items := make([]abac.Policy, 0, len(policies))
+	for _, p := range policies {
+		items = append(items, abac.Policy{
+			ID:        strconv.FormatInt(p.ID, 10),
+			Name:      p.Name,
+			Effect:    abac.Effect(p.Effect.String()),
+			Priority:  p.Priority,
+			Subjects:  p.Subjects,
+			Resources: p.Resources,
+			Actions:   p.Actions,
+			Contexts:  p.Contexts,
+		})
+	}
+	engine = abac.NewEngine(items)
+	s.engines.put(tenantID, version, engine, time.Now())
+	return engine, nil
+}
+
+// invalidateEngines 失效求值引擎缓存
+// 在请求事务中修改时提交后再失效一次，避免提交前被其他请求重新加载的旧策略留在缓存中
+func (s *PermissionService) invalidateEngines(ctx context.Context) {
+	s.engines.invalidate()
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		tx.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				err := next.Commit(ctx, tx)
+				s.engines.invalidate()
+				return err
+			})
+		})
+	}
+}
+
+// engineCache 按租户缓存求值引擎，平台级策略变更会影响所有租户，因此任何修改都失效全部缓存
+type engineCache struct {
+	mu      sync.RWMutex
+	version uint64 // 每次失效加一，加载期间发生失效时丢弃加载结果
+	items   map[int64]cachedEngine
+}
+
+type cachedEngine struct {
+	engine   *abac.Engine
+	loadedAt time.Time
+}
+
+// get 返回未过期的缓存引擎，以及加载新引擎时应使用的版本
+func (c *engineCache) get(tenantID int64, now time.Time) (*abac.Engine, uint64) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if item, ok := c.items[tenantID]; ok && now.Sub(item.loadedAt) < policyEngineTTL {
+		return item.engine, c.version
+	}
+	return nil, c.version
+}
+
+func (c *engineCache) put(tenantID int64, version uint64, engine *abac.Engine, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if version == c.version {
+		c.items[tenantID] = cachedEngine{engine: engine, loadedAt: now}
+	}
+}
+
+func (c *engineCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.version++
+	clear(c.items)
+}
+
+// checkPolicyScope 未指定租户时管理的是对所有租户生效的平台级策略，需要平台级角色
+func (s *PermissionService) checkPolicyScope(ctx context.Context) error {
+	code, msg := checkTenantScope(ctx, s.client, middleware.GetTenantIDFromContext(ctx))
+	switch code {
+	case 0:
+		return nil
+	case 401:
+		return errors.Unauthorized("UNAUTHORIZED", msg)
+	case 403:
+		return errors.Forbidden("PERMISSION_DENIED", msg)
+	default:
+		return errors.InternalServer("POLICY_SCOPE_FAILED", msg)
+	}
+}
+
+// policyOfTenant 当前租户可管理的策略，未指定租户时为平台级策略
+func policyOfTenant(tenantID int64) predicate.AccessPolicy {
+	if tenantID > 0 {
+		return accesspolicy.TenantID(tenantID)
+	}
+	return accesspolicy.TenantIDIsNil()
+}
+
+func validatePolicy(name string, effect v1.Effect, groups ...[]*v1.Attribute) error {
+	if name == "" {
+		return errors.BadRequest("INVALID_POLICY", "policy name is required")
+	}
+	if effect != v1.Effect_ALLOW && effect != v1.Effect_DENY {
+		return errors.BadRequest("INVALID_POLICY", "policy effect must be ALLOW or DENY")
+	}
+	for _, attrs := range groups {
+		for _, attr := range attrs {
+			if attr.GetKey() == "" {
+				return errors.BadRequest("INVALID_POLICY", "attribute key is required")
+			}
+			if attr.GetOperator() == v1.Operator_OPERATOR_UNSPECIFIED {
+				return errors.BadRequest("INVALID_POLICY", "operator is required for attribute "+attr.GetKey())
+			}
+			if len(attr.GetValues()) == 0 {
+				return errors.BadRequest("INVALID_POLICY", "values are required for attribute "+attr.GetKey())
+			}
+		}
+	}
+	return nil
+}
+
+func convertAttributesFromProto(attrs []*v1.Attribute) []abac.Attribute {
+	items := make([]abac.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		items = append(items, abac.Attribute{
+			Key:      attr.GetKey(),
+			Operator: abac.Operator(attr.GetOperator().String()),
+			Values:   attr.GetValues(),
+		})
+	}
+	return items
+}
+
+func convertAttributesToProto(attrs []abac.Attribute) []*v1.Attribute {
+	items := make([]*v1.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		items = append(items, &v1.Attribute{
+			Key:      attr.Key,
+			Operator: v1.Operator(v1.Operator_value[string(attr.Operator)]),
+			Values:   attr.Values,
+		})
+	}
+	return items
+}
+
+func convertPolicyToProto(p *ent.AccessPolicy) *v1.Policy {
+	item := &v1.Policy{
+		Id:          strconv.FormatInt(p.ID, 10),
+		Name:        p.Name,
+		Description: p.Description,
+		Effect:      v1.Effect(v1.Effect_value[p.Effect.String()]),
+		Subjects:    convertAttributesToProto(p.Subjects),
+		Resources:   convertAttributesToProto(p.Resources),
+		Actions:     convertAttributesToProto(p.Actions),
+		Contexts:    convertAttributesToProto(p.Contexts),
+		Priority:    p.Priority,
+		IsEnabled:   p.IsEnabled,
+		CreatedAt:   p.CreatedAt.Format(time.DateTime),
+		UpdatedAt:   p.UpdatedAt.Format(time.DateTime),
+	}
+	if p.TenantID != nil {
+		item.TenantId = strconv.FormatInt(*p.TenantID, 10)
+	}
+	return item
+}
+
+func convertCheckRequest(subject map[string]string, req *v1.CheckPermissionRequest) *abac.Request {
+	return &abac.Request{
+		Subject:  subject,
+		Resource: req.GetResource(),
+		Action:   req.GetAction(),
+		Context:  req.GetContext(),
+	}
+}
+
+func convertDecisionToProto(d abac.Decision) *v1.CheckPermissionResponse {
+	return &v1.CheckPermissionResponse{
+		Allowed:  d.Allowed,
+		PolicyId: d.PolicyID,
+		Reason:   d.Reason,
+	}
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/quota"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/plan"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/logger"
+)
+
+// PlanService 租户套餐和资源配额
+type PlanService struct {
+	v1.UnimplementedPlanServiceServer
+	client *ent.Client
+}
+
+func NewPlanService(client *ent.Client) *PlanService {
+	return &PlanService{client: client}
+}
+
+// CreatePlan 创建套餐
+func (s *PlanService) CreatePlan(ctx context.Context, req *v1.CreatePlanRequest) (*v1.CreatePlanResponse, error) {
+	code, name := strings.TrimSpace(req.GetCode()), strings.TrimSpace(req.GetName())
+	if code == "" || name == "" {
+		return &v1.CreatePlanResponse{Result: false, Code: 400, Msg: "套餐编码和名称不能为空"}, nil
+	}
+	limits := planLimits{req.MaxUsers, req.MaxDepartments, req.MaxSubTenants, req.MaxRoles}
+	if msg := limits.validate(); msg != "" {
+		return &v1.CreatePlanResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.CreatePlanResponse{Result: false, Code: 500, Msg: "创建套餐失败"}, nil
+	}
+	defer tx.Rollback()
+	if req.GetIsDefault() {
+		if err := clearDefaultPlan(ctx, tx, 0); err != nil {
+			return &v1.CreatePlanResponse{Result: false, Code: 500, Msg: "创建套餐失败"}, nil
+		}
+	}
+	p, err := tx.Plan.Create().
+		SetCode(code).
+		SetName(name).
+		SetDescription(req.GetDescription()).
+		SetNillableMaxUsers(limits.users()).
+		SetNillableMaxDepartments(limits.departments()).
+		SetNillableMaxSubTenants(limits.subTenants()).
+		SetNillableMaxRoles(limits.roles()).
+		SetFeatures(planFeatures(req.GetFeatures())).
+		SetIsDefault(req.GetIsDefault()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return &v1.CreatePlanResponse{Result: false, Code: 400, Msg: "套餐编码已存在"}, nil
+		}
+		if ent.IsValidationError(err) {
+			return &v1.CreatePlanResponse{Result: false, Code: 400, Msg: err.Error()}, nil
+		}
+		logger.Errorf("创建套餐失败: %v", err)
+		return &v1.CreatePlanResponse{Result: false, Code: 500, Msg: "创建套餐失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.CreatePlanResponse{Result: false, Code: 500, Msg: "创建套餐失败"}, nil
+	}
+	return &v1.CreatePlanResponse{Result: true, Code: 200, Msg: "创建成功", Plan: convertPlanToProto(p.Unwrap())}, nil
+}
+
+// UpdatePlan 修改套餐，降低上限不影响已有资源，只限制之后的新增
+func (s *PlanService) UpdatePlan(ctx context.Context, req *v1.UpdatePlanRequest) (*v1.UpdatePlanResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.UpdatePlanResponse{Result: false, Code: 400, Msg: "无效的套餐ID"}, nil
+	}
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return &v1.UpdatePlanResponse{Result: false, Code: 400, Msg: "套餐名称不能为空"}, nil
+	}
+	limits := planLimits{req.MaxUsers, req.MaxDepartments, req.MaxSubTenants, req.MaxRoles}
+	if msg := limits.validate(); msg != "" {
+		return &v1.UpdatePlanResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.UpdatePlanResponse{Result: false, Code: 500, Msg: "修改套餐失败"}, nil
+	}
+	defer tx.Rollback()
+	if req.GetIsDefault() {
+		if err := clearDefaultPlan(ctx, tx, id); err != nil {
+			return &v1.UpdatePlanResponse{Result: false, Code: 500, Msg: "修改套餐失败"}, nil
+		}
+	}
+	updater := tx.Plan.UpdateOneID(id).
+		SetName(name).
+		SetDescription(req.GetDescription()).
+		SetFeatures(planFeatures(req.GetFeatures())).
+		SetIsDefault(req.GetIsDefault())
+	setOrClear(limits.users(), updater.SetMaxUsers, updater.ClearMaxUsers)
+	setOrClear(limits.departments(), updater.SetMaxDepartments, updater.ClearMaxDepartments)
+	setOrClear(limits.subTenants(), updater.SetMaxSubTenants, updater.ClearMaxSubTenants)
+	setOrClear(limits.roles(), updater.SetMaxRoles, updater.ClearMaxRoles)
+	p, err := updater.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.UpdatePlanResponse{Result: false, Code: 404, Msg: "套餐不存在"}, nil
+		}
+		logger.Errorf("修改套餐失败: %v", err)
+		return &v1.UpdatePlanResponse{Result: false, Code: 500, Msg: "修改套餐失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.UpdatePlanResponse{Result: false, Code: 500, Msg: "修改套餐失败"}, nil
+	}
+	return &v1.UpdatePlanResponse{Result: true, Code: 200, Msg: "修改成功", Plan: convertPlanToProto(p.Unwrap())}, nil
+}
+
+// DeletePlan 删除套餐
+func (s *PlanService) DeletePlan(ctx context.Context, req *v1.DeletePlanRequest) (*v1.DeletePlanResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.DeletePlanResponse{Result: false, Code: 400, Msg: "无效的套餐ID"}, nil
+	}
+	n, err := s.client.Tenant.Query().Where(tenant.PlanID(id)).Count(ctx)
+	if err != nil {
+		return &v1.DeletePlanResponse{Result: false, Code: 500, Msg: "查询套餐使用情况失败"}, nil
+	}
+	if n > 0 {
+		return &v1.DeletePlanResponse{Result: false, Code: 400, Msg: fmt.Sprintf("仍有%d个租户使用该套餐，不能删除", n)}, nil
+	}
+	if err := s.client.Plan.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.DeletePlanResponse{Result: false, Code: 404, Msg: "套餐不存在"}, nil
+		}
+		return &v1.DeletePlanResponse{Result: false, Code: 500, Msg: "删除套餐失败"}, nil
+	}
+	return &v1.DeletePlanResponse{Result: true, Code: 200, Msg: "删除成功"}, nil
+}
+
+// ListPlans 获取套餐列表
+func (s *PlanService) ListPlans(ctx context.Context, _ *v1.ListPlansRequest) (*v1.ListPlansResponse, error) {
+	plans, err := s.client.Plan.Query().
+		Order(ent.Asc(plan.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListPlansResponse{Result: false, Code: 500, Msg: "查询套餐失败"}, nil
+	}
+	items := make([]*v1.Plan, 0, len(plans))
+	for _, p := range plans {
+		items = append(items, convertPlanToProto(p))
+	}
+	return &v1.ListPlansResponse{Result: true, Code: 200, Msg: "查询成功", Plans: items}, nil
+}
+
+// SetTenantPlan 为租户分配套餐，允许降级到低于当前用量的套餐，超出部分保留但不能再新增
+func (s *PlanService) SetTenantPlan(ctx context.Context, req *v1.SetTenantPlanRequest) (*v1.SetTenantPlanResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.SetTenantPlanResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	planID, err := parseOptionalID(req.GetPlanId())
+	if err != nil {
+		return &v1.SetTenantPlanResponse{Result: false, Code: 400, Msg: "无效的套餐ID"}, nil
+	}
+	if planID > 0 {
+		if exist, err := s.client.Plan.Query().Where(plan.ID(planID)).Exist(ctx); err != nil {
+			return &v1.SetTenantPlanResponse{Result: false, Code: 500, Msg: "查询套餐失败"}, nil
+		} else if !exist {
+			return &v1.SetTenantPlanResponse{Result: false, Code: 404, Msg: "套餐不存在"}, nil
+		}
+	}
+
+	updater := s.client.Tenant.UpdateOneID(tenantID)
+	if planID > 0 {
+		updater.SetPlanID(planID)
+	} else {
+		updater.ClearPlanID()
+	}
+	if err := updater.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.SetTenantPlanResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		logger.Errorf("分配套餐失败: %v", err)
+		return &v1.SetTenantPlanResponse{Result: false, Code: 500, Msg: "分配套餐失败: " + err.Error()}, nil
+	}
+
+	_, usages, err := quota.Report(ctx, s.client, tenantID)
+	if err != nil {
+		return &v1.SetTenantPlanResponse{Result: false, Code: 500, Msg: "统计租户用量失败"}, nil
+	}
+	return &v1.SetTenantPlanResponse{Result: true, Code: 200, Msg: "分配成功", Usages: convertUsagesToProto(usages)}, nil
+}
+
+// GetTenantUsage 获取租户的资源用量和套餐上限
+func (s *PlanService) GetTenantUsage(ctx context.Context, req *v1.GetTenantUsageRequest) (*v1.GetTenantUsageResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.GetTenantUsageResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	p, usages, err := quota.Report(ctx, s.client, tenantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.GetTenantUsageResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		return &v1.GetTenantUsageResponse{Result: false, Code: 500, Msg: "统计租户用量失败"}, nil
+	}
+	resp := &v1.GetTenantUsageResponse{Result: true, Code: 200, Msg: "查询成功", Usages: convertUsagesToProto(usages)}
+	// 返回套餐中的全部开关，以及接入检查的功能的实际状态（未分配套餐时全部开启）
+	resp.Features = make(map[string]bool, len(quota.Features))
+	if p != nil {
+		resp.Plan = convertPlanToProto(p)
+		maps.Copy(resp.Features, p.Features)
+	}
+	for _, f := range quota.Features {
+		resp.Features[f] = quota.FeatureEnabled(p, f)
+	}
+	return resp, nil
+}
+
+// planLimits 请求中的各项上限，nil表示不限制
+type planLimits struct {
+	maxUsers, maxDepartments, maxSubTenants, maxRoles *int32
+}
+
+func (l planLimits) validate() string {
+	for _, v := range []*int32{l.maxUsers, l.maxDepartments, l.maxSubTenants, l.maxRoles} {
+		if v != nil && *v < 0 {
+			return "套餐上限不能为负数"
+		}
+	}
+	return ""
+}
+
+func (l planLimits) users() *int       { return int32PtrToInt(l.maxUsers) }
+func (l planLimits) departments() *int { return int32PtrToInt(l.maxDepartments) }
+func (l planLimits) subTenants() *int  { return int32PtrToInt(l.maxSubTenants) }
+func (l planLimits) roles() *int       { return int32PtrToInt(l.maxRoles) }
+
+func int32PtrToInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func setOrClear[T any](v *int, set func(int) T, clear func() T) {
+	if v != nil {
+		set(*v)
+	} else {
+		clear()
+	}
+}
+
+func planFeatures(features map[string]bool) map[string]bool {
+	if features == nil {
+		return map[string]bool{}
+	}
+	return features
+}
+
+// clearDefaultPlan 取消其他套餐的默认标记，最多一个默认套餐
+func clearDefaultPlan(ctx context.Context, tx *ent.Tx, exceptID int64) error {
+	return tx.Plan.Update().
+		Where(plan.IsDefault(true), plan.IDNEQ(exceptID)).
+		SetIsDefault(false).
+		Exec(ctx)
+}
+
+// defaultPlanID 新建租户使用的默认套餐，未配置时返回nil
+func defaultPlanID(ctx context.Context, client *ent.Client) (*int64, error) {
+	p, err := client.Plan.Query().Where(plan.IsDefault(true)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p.ID, nil
+}
+
+// quotaExceededMsg 配额不足的提示
+func quotaExceededMsg(e *quota.ExceededError) string {
+	return fmt.Sprintf("租户%s数量已达套餐上限（上限%d，已有%d）", e.Resource.Label(), e.Limit, e.Used)
+}
+
+// featureDisabledMsg 套餐未开启功能的提示
+func featureDisabledMsg(e *quota.FeatureDisabledError) string {
+	return fmt.Sprintf("当前套餐未开启%s功能", quota.FeatureLabel(e.Feature))
+}
+
+func convertPlanToProto(p *ent.Plan) *v1.Plan {
+	return &v1.Plan{
+		Id:             strconv.FormatInt(p.ID, 10),
+		Code:           p.Code,
+		Name:           p.Name,
+		Description:    p.Description,
+		MaxUsers:       intPtrToInt32(p.MaxUsers),
+		MaxDepartments: intPtrToInt32(p.MaxDepartments),
+		MaxSubTenants:  intPtrToInt32(p.MaxSubTenants),
+		MaxRoles:       intPtrToInt32(p.MaxRoles),
+		Features:       p.Features,
+		IsDefault:      p.IsDefault,
+		CreatedAt:      p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      p.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func convertUsagesToProto(usages []quota.Usage) []*v1.QuotaUsage {
+	items := make([]*v1.QuotaUsage, 0, len(usages))
+	for _, u := range usages {
+		items = append(items, &v1.QuotaUsage{
+			Resource: string(u.Resource),
+			Label:    u.Resource.Label(),
+			Used:     int32(u.Used),
+			Limit:    intPtrToInt32(u.Limit),
+			Exceeded: u.Exceeded(),
+		})
+	}
+	return items
+}
+
+func intPtrToInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
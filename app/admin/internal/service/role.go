@@ -1 +1,951 @@
 package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/quota"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/role"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/admin/ent/usertenant"
+	"github.com/yc-alpha/logger"
+	"github.com/yc-alpha/variant"
+)
+
+// 角色授予相关的事件主题
+const (
+	TopicUserRoleElevated = "user_role.elevated" // 临时提权
+	TopicUserRoleExpired  = "user_role.expired"  // 限时授予到期被清理
+)
+
+// UserRoleEvent 角色授予事件的内容
+type UserRoleEvent struct {
+	UserID    int64      `json:"user_id"`
+	RoleID    int64      `json:"role_id"`
+	RoleCode  string     `json:"role_code"`
+	TenantID  int64      `json:"tenant_id,omitempty"`
+	GrantedBy int64      `json:"granted_by,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// newUserRoleEvent 根据授予记录构造事件内容，ur.Edges.Role需要已加载
+func newUserRoleEvent(ur *ent.UserRole) UserRoleEvent {
+	e := UserRoleEvent{
+		UserID:    ur.UserID,
+		RoleID:    ur.RoleID,
+		TenantID:  tenantIDOrZero(ur.TenantID),
+		Reason:    ur.Reason,
+		ExpiresAt: ur.ExpiresAt,
+	}
+	if ur.GrantedBy != nil {
+		e.GrantedBy = *ur.GrantedBy
+	}
+	if ur.Edges.Role != nil {
+		e.RoleCode = ur.Edges.Role.Code
+	}
+	return e
+}
+
+// RoleService 角色管理服务
+// enforcer 不为nil时，角色的授予与撤销会同步维护Casbin的g规则
+type RoleService struct {
+	v1.UnimplementedRoleServiceServer
+	client       *ent.Client
+	enforcer     *casbin.SyncedEnforcer
+	publisher    event.Publisher
+	maxElevation time.Duration
+}
+
+// NewRoleService 创建角色管理服务，未启用Casbin时enforcer传nil
+// maxElevation 为临时提权的最长有效期
+func NewRoleService(client *ent.Client, enforcer *casbin.SyncedEnforcer, publisher event.Publisher, maxElevation time.Duration) *RoleService {
+	return &RoleService{
+		client:       client,
+		enforcer:     enforcer,
+		publisher:    publisher,
+		maxElevation: maxElevation,
+	}
+}
+
+func convertRoleToProto(r *ent.Role) *v1.Role {
+	return &v1.Role{
+		Id:          strconv.FormatInt(r.ID, 10),
+		Code:        r.Code,
+		Name:        r.Name,
+		TenantId:    variant.New(r.TenantID).ToString(),
+		IsSystem:    r.IsSystem,
+		Description: r.Description,
+		IsActive:    r.IsActive,
+		DataScope:   r.DataScope.String(),
+		IsTemplate:  r.IsTemplate,
+		CreatedAt:   r.CreatedAt.Format(time.DateTime),
+		UpdatedAt:   r.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+func convertUserRoleToProto(ur *ent.UserRole) *v1.UserRole {
+	pb := &v1.UserRole{
+		Id:        strconv.FormatInt(ur.ID, 10),
+		UserId:    strconv.FormatInt(ur.UserID, 10),
+		TenantId:  variant.New(ur.TenantID).ToString(),
+		GrantedAt: ur.GrantedAt.Format(time.DateTime),
+		GrantedBy: variant.New(ur.GrantedBy).ToString(),
+		Reason:    ur.Reason,
+	}
+	if ur.ExpiresAt != nil {
+		pb.ExpiresAt = ur.ExpiresAt.Format(time.DateTime)
+	}
+	if ur.Edges.Role != nil {
+		pb.Role = convertRoleToProto(ur.Edges.Role)
+	}
+	return pb
+}
+
+// parseOptionalID 解析可选的ID参数，为空时返回0
+func parseOptionalID(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// tenantIDOrZero 返回可空租户ID的值，nil表示平台级
+func tenantIDOrZero(tenantID *int64) int64 {
+	if tenantID == nil {
+		return 0
+	}
+	return *tenantID
+}
+
+// tenantIDEQ 按租户过滤，tenantID为0时匹配平台级（tenant_id IS NULL）
+func tenantIDEQ(tenantID int64) predicate.UserRole {
+	if tenantID == 0 {
+		return userrole.TenantIDIsNil()
+	}
+	return userrole.TenantIDEQ(tenantID)
+}
+
+// roleInTenant 角色是否属于调用者当前的租户上下文：租户下只能管理本租户的角色，未指定租户时只能管理平台级角色
+func roleInTenant(ctx context.Context, r *ent.Role) bool {
+	return tenantIDOrZero(r.TenantID) == middleware.GetTenantIDFromContext(ctx)
+}
+
+// checkRoleScope 校验调用者能否管理该角色，code为0表示通过
+// 其他租户的角色视为不存在；平台级角色只能由平台级用户在未指定租户时管理
+func checkRoleScope(ctx context.Context, client *ent.Client, r *ent.Role) (int32, string) {
+	if !roleInTenant(ctx, r) {
+		return 404, "角色不存在"
+	}
+	return checkTenantScope(ctx, client, tenantIDOrZero(r.TenantID))
+}
+
+// CreateRole 创建角色
+func (s *RoleService) CreateRole(ctx context.Context, req *v1.CreateRoleRequest) (*v1.CreateRoleResponse, error) {
+	if req.GetCode() == "" || req.GetName() == "" {
+		return &v1.CreateRoleResponse{Result: false, Code: 400, Msg: "角色编码和名称不能为空"}, nil
+	}
+	tenantID, code, msg := requestTenant(ctx, s.client, req.GetTenantId())
+	if code != 0 {
+		return &v1.CreateRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	if tenantID > 0 {
+		exists, err := s.client.Tenant.Query().Where(tenant.ID(tenantID)).Exist(ctx)
+		if err != nil {
+			return &v1.CreateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+		}
+		if !exists {
+			return &v1.CreateRoleResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+	}
+	if msg, err := s.checkCodeConflict(ctx, req.GetCode(), tenantID, 0); err != nil {
+		return &v1.CreateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	} else if msg != "" {
+		return &v1.CreateRoleResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	dataScope := req.GetDataScope()
+	if dataScope == "" {
+		dataScope = role.DefaultDataScope.String()
+	}
+	if msg := checkDataScope(dataScope, tenantID); msg != "" {
+		return &v1.CreateRoleResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	if msg := checkTemplate(req.GetIsTemplate(), dataScope, tenantID); msg != "" {
+		return &v1.CreateRoleResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	inheritIDs := make([]int64, 0, len(req.GetInheritRoleIds()))
+	for _, v := range req.GetInheritRoleIds() {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return &v1.CreateRoleResponse{Result: false, Code: 400, Msg: "无效的继承角色ID"}, nil
+		}
+		inheritIDs = append(inheritIDs, id)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.CreateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	defer tx.Rollback()
+	if tenantID > 0 {
+		var exceeded *quota.ExceededError
+		if err := quota.Check(ctx, tx.Client(), tenantID, quota.ResourceRoles, 1); errors.As(err, &exceeded) {
+			return &v1.CreateRoleResponse{Result: false, Code: 403, Msg: quotaExceededMsg(exceeded)}, nil
+		} else if err != nil {
+			return &v1.CreateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+		}
+	}
+	creator := tx.Role.Create().
+		SetCode(req.GetCode()).
+		SetName(req.GetName()).
+		SetDescription(req.GetDescription()).
+		SetDataScope(role.DataScope(dataScope)).
+		SetIsTemplate(req.GetIsTemplate())
+	if tenantID > 0 {
+		creator.SetTenantID(tenantID)
+	}
+	r, err := creator.Save(ctx)
+	if err != nil {
+		logger.Errorf("创建角色失败: %v", err)
+		return &v1.CreateRoleResponse{Result: false, Code: 500, Msg: "创建角色失败"}, nil
+	}
+	// 从已有角色（如平台级角色模板）派生，无需复制权限
+	children, code, msg := inheritRoles(ctx, tx, r, inheritIDs)
+	if code != 0 {
+		return &v1.CreateRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.CreateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	r = r.Unwrap()
+
+	s.addInheritanceRules(r, children)
+	return &v1.CreateRoleResponse{Result: true, Code: 200, Msg: "success", Role: convertRoleToProto(r)}, nil
+}
+
+// checkDataScope 校验角色的数据范围，返回非空字符串表示不合法的原因
+// 租户角色只能看到本租户范围内的数据，不能使用ALL
+func checkDataScope(scope string, tenantID int64) string {
+	if err := role.DataScopeValidator(role.DataScope(scope)); err != nil {
+		return "无效的数据范围: " + scope
+	}
+	if tenantID > 0 && role.DataScope(scope) == role.DataScopeALL {
+		return "租户角色不能使用全部数据范围"
+	}
+	return ""
+}
+
+// checkTemplate 校验角色模板标记，返回非空字符串表示不合法的原因
+// 模板供租户角色继承或在租户内授予，只有平台级角色可以作为模板，且不能使用ALL
+func checkTemplate(isTemplate bool, scope string, tenantID int64) string {
+	if !isTemplate {
+		return ""
+	}
+	if tenantID > 0 {
+		return "只有平台级角色可以作为角色模板"
+	}
+	if role.DataScope(scope) == role.DataScopeALL {
+		return "角色模板不能使用全部数据范围"
+	}
+	return ""
+}
+
+// checkCodeConflict 校验角色编码是否冲突，返回非空字符串表示冲突原因
+// 平台级角色与租户角色共享Casbin中的角色命名空间，因此租户角色不能与平台级角色同名
+func (s *RoleService) checkCodeConflict(ctx context.Context, code string, tenantID int64, excludeID int64) (string, error) {
+	q := s.client.Role.Query().Where(role.Code(code), role.IDNEQ(excludeID))
+	if tenantID > 0 {
+		// 同租户下重名，或与平台级角色重名
+		q.Where(role.Or(role.TenantIDEQ(tenantID), role.TenantIDIsNil()))
+	} // 平台级角色编码需要全局唯一，不限制租户
+	exists, err := q.Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "角色编码已存在: " + code, nil
+	}
+	return "", nil
+}
+
+// GetRole 获取角色详情
+func (s *RoleService) GetRole(ctx context.Context, req *v1.GetRoleRequest) (*v1.GetRoleResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.GetRoleResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	r, err := s.client.Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.GetRoleResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.GetRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	// 租户下可以查看本租户的角色和平台级角色（作为模板），其他租户的角色视为不存在
+	if r.TenantID != nil && !roleInTenant(ctx, r) {
+		return &v1.GetRoleResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+	}
+	return &v1.GetRoleResponse{Result: true, Code: 200, Msg: "success", Role: convertRoleToProto(r)}, nil
+}
+
+// ListRoles 获取当前租户下的角色列表，可同时返回平台级角色；未指定租户时列出平台级角色，需要平台级角色
+func (s *RoleService) ListRoles(ctx context.Context, req *v1.ListRolesRequest) (*v1.ListRolesResponse, error) {
+	tenantID, code, msg := requestTenant(ctx, s.client, req.GetTenantId())
+	if code != 0 {
+		return &v1.ListRolesResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	q := s.client.Role.Query()
+	switch {
+	case tenantID == 0:
+		q.Where(role.TenantIDIsNil())
+	case req.GetIncludePlatform():
+		q.Where(role.Or(role.TenantIDEQ(tenantID), role.TenantIDIsNil()))
+	default:
+		q.Where(role.TenantIDEQ(tenantID))
+	}
+	if kw := req.GetKeyword(); kw != "" {
+		q.Where(role.Or(role.CodeContainsFold(kw), role.NameContainsFold(kw)))
+	}
+
+	// 分页参数
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100) // 限定最大页大小
+	offset := (page - 1) * pageSize
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return &v1.ListRolesResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	roles, err := q.Order(ent.Asc(role.FieldCreatedAt), ent.Asc(role.FieldID)).
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListRolesResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	items := make([]*v1.Role, 0, len(roles))
+	for _, r := range roles {
+		items = append(items, convertRoleToProto(r))
+	}
+	return &v1.ListRolesResponse{Result: true, Code: 200, Msg: "success", Roles: items, Total: int32(total)}, nil
+}
+
+// UpdateRole 更新角色，系统预置角色不可修改code，也不可停用
+func (s *RoleService) UpdateRole(ctx context.Context, req *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	if req.GetName() == "" {
+		return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: "角色名称不能为空"}, nil
+	}
+	old, err := s.client.Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.UpdateRoleResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.UpdateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if code, msg := checkRoleScope(ctx, s.client, old); code != 0 {
+		return &v1.UpdateRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	code := req.GetCode()
+	if code == "" {
+		code = old.Code
+	}
+	if old.IsSystem {
+		if code != old.Code {
+			return &v1.UpdateRoleResponse{Result: false, Code: 403, Msg: "系统预置角色不可修改编码"}, nil
+		}
+		if req.IsActive != nil && !req.GetIsActive() {
+			return &v1.UpdateRoleResponse{Result: false, Code: 403, Msg: "系统预置角色不可停用"}, nil
+		}
+	}
+	dataScope := req.GetDataScope()
+	if dataScope == "" {
+		dataScope = old.DataScope.String()
+	}
+	if msg := checkDataScope(dataScope, tenantIDOrZero(old.TenantID)); msg != "" {
+		return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	isTemplate := old.IsTemplate
+	if req.IsTemplate != nil {
+		isTemplate = req.GetIsTemplate()
+	}
+	if msg := checkTemplate(isTemplate, dataScope, tenantIDOrZero(old.TenantID)); msg != "" {
+		return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	if !old.IsTemplate && isTemplate {
+		// 模板会被租户角色继承，不能借此间接继承非模板的平台级角色
+		inherits, err := old.QueryInherits().Where(role.IsTemplate(false)).Exist(ctx)
+		if err != nil {
+			return &v1.UpdateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+		}
+		if inherits {
+			return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: "角色模板只能继承角色模板"}, nil
+		}
+	}
+	if old.IsTemplate && !isTemplate {
+		// 已被租户角色继承的模板不能取消模板标记，否则租户角色的继承会失效
+		inherited, err := old.QueryInheritedBy().Where(role.TenantIDNotNil()).Exist(ctx)
+		if err != nil {
+			return &v1.UpdateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+		}
+		if inherited {
+			return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: "角色模板已被租户角色继承，不能取消模板"}, nil
+		}
+	}
+	if code != old.Code {
+		if msg, err := s.checkCodeConflict(ctx, code, tenantIDOrZero(old.TenantID), old.ID); err != nil {
+			return &v1.UpdateRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+		} else if msg != "" {
+			return &v1.UpdateRoleResponse{Result: false, Code: 400, Msg: msg}, nil
+		}
+	}
+
+	r, err := s.client.Role.UpdateOneID(id).
+		SetCode(code).
+		SetName(req.GetName()).
+		SetDescription(req.GetDescription()).
+		SetNillableIsActive(req.IsActive).
+		SetDataScope(role.DataScope(dataScope)).
+		SetIsTemplate(isTemplate).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("更新角色失败: %v", err)
+		return &v1.UpdateRoleResponse{Result: false, Code: 500, Msg: "更新角色失败"}, nil
+	}
+
+	if r.Code != old.Code || r.IsActive != old.IsActive {
+		if err := s.resyncRole(ctx, old, r); err != nil {
+			logger.Errorf("同步角色[%d]授权规则失败: %v", r.ID, err)
+		}
+	}
+	return &v1.UpdateRoleResponse{Result: true, Code: 200, Msg: "success", Role: convertRoleToProto(r)}, nil
+}
+
+// DeleteRole 删除角色及其所有授予记录，系统预置角色不可删除
+func (s *RoleService) DeleteRole(ctx context.Context, req *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	r, err := s.client.Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.DeleteRoleResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if code, msg := checkRoleScope(ctx, s.client, r); code != 0 {
+		return &v1.DeleteRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if r.IsSystem {
+		return &v1.DeleteRoleResponse{Result: false, Code: 403, Msg: "系统预置角色不可删除"}, nil
+	}
+
+	grants, err := s.client.UserRole.Query().Where(userrole.RoleID(id)).All(ctx)
+	if err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	// 继承关系随角色级联删除，需要在删除前记录对应的g规则
+	inherits, err := inheritanceRules(ctx, r, r.Code, false)
+	if err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	defer tx.Rollback()
+	if _, err := tx.UserRole.Delete().Where(userrole.RoleID(id)).Exec(ctx); err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if err := tx.Role.DeleteOneID(id).Exec(ctx); err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.DeleteRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	if err := s.removeRoleRules(r, grants, inherits); err != nil {
+		logger.Errorf("删除角色[%d]授权规则失败: %v", r.ID, err)
+	}
+	return &v1.DeleteRoleResponse{Result: true, Code: 200, Msg: "success"}, nil
+}
+
+// AssignRole 为用户授予角色，重复授予时更新过期时间
+func (s *RoleService) AssignRole(ctx context.Context, req *v1.AssignRoleRequest) (*v1.AssignRoleResponse, error) {
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &v1.AssignRoleResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	roleID, err := strconv.ParseInt(req.GetRoleId(), 10, 64)
+	if err != nil {
+		return &v1.AssignRoleResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	tenantID, code, msg := requestTenant(ctx, s.client, req.GetTenantId())
+	if code != 0 {
+		return &v1.AssignRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != "" {
+		t, err := time.ParseInLocation(time.DateTime, req.GetExpiresAt(), time.Local)
+		if err != nil {
+			return &v1.AssignRoleResponse{Result: false, Code: 400, Msg: "无效的过期时间"}, nil
+		}
+		if !t.After(time.Now()) {
+			return &v1.AssignRoleResponse{Result: false, Code: 400, Msg: "过期时间必须晚于当前时间"}, nil
+		}
+		expiresAt = &t
+	}
+
+	r, code, msg := s.prepareGrant(ctx, userID, roleID, tenantID)
+	if code != 0 {
+		return &v1.AssignRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.AssignRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	defer tx.Rollback()
+	// tenant_id为NULL时唯一索引不生效，需要先查询已有授予
+	ur, err := tx.UserRole.Query().
+		Where(userrole.UserID(userID), userrole.RoleID(roleID), tenantIDEQ(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	switch {
+	case err == nil:
+		updater := ur.Update()
+		if expiresAt != nil {
+			updater.SetExpiresAt(*expiresAt)
+		} else {
+			updater.ClearExpiresAt()
+		}
+		ur, err = updater.Save(ctx)
+	case ent.IsNotFound(err):
+		creator := tx.UserRole.Create().
+			SetUserID(userID).
+			SetRoleID(roleID).
+			SetNillableExpiresAt(expiresAt)
+		if tenantID > 0 {
+			creator.SetTenantID(tenantID)
+		}
+		if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+			creator.SetGrantedBy(uid)
+		}
+		ur, err = creator.Save(ctx)
+	}
+	if err != nil {
+		logger.Errorf("授予角色失败: %v", err)
+		return &v1.AssignRoleResponse{Result: false, Code: 500, Msg: "授予角色失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.AssignRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	ur = ur.Unwrap()
+	ur.Edges.Role = r
+
+	if err := afterCommit(ctx, func() error { return s.syncGrantRule(ur, r.Code) }); err != nil {
+		return &v1.AssignRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	return &v1.AssignRoleResponse{Result: true, Code: 200, Msg: "success", UserRole: convertUserRoleToProto(ur)}, nil
+}
+
+// GrantTemporaryRole 临时提权，为用户授予限时角色
+// 已有限时授予时延长到较晚的过期时间；已有永久授予时无需提权
+func (s *RoleService) GrantTemporaryRole(ctx context.Context, req *v1.GrantTemporaryRoleRequest) (*v1.GrantTemporaryRoleResponse, error) {
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	roleID, err := strconv.ParseInt(req.GetRoleId(), 10, 64)
+	if err != nil {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	tenantID, code, msg := requestTenant(ctx, s.client, req.GetTenantId())
+	if code != 0 {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if req.GetReason() == "" {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 400, Msg: "提权原因不能为空"}, nil
+	}
+	duration := time.Duration(req.GetDurationSeconds()) * time.Second
+	if duration <= 0 {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 400, Msg: "有效时长必须大于0"}, nil
+	}
+	if duration > s.maxElevation {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 400, Msg: "有效时长不能超过" + s.maxElevation.String()}, nil
+	}
+
+	r, code, msg := s.prepareGrant(ctx, userID, roleID, tenantID)
+	if code != 0 {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	defer tx.Rollback()
+	expiresAt := time.Now().Add(duration)
+	ur, err := tx.UserRole.Query().
+		Where(userrole.UserID(userID), userrole.RoleID(roleID), tenantIDEQ(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	switch {
+	case err == nil:
+		if ur.ExpiresAt == nil {
+			return &v1.GrantTemporaryRoleResponse{Result: false, Code: 400, Msg: "用户已永久拥有该角色"}, nil
+		}
+		if ur.ExpiresAt.After(expiresAt) {
+			expiresAt = *ur.ExpiresAt
+		}
+		updater := ur.Update().
+			SetExpiresAt(expiresAt).
+			SetReason(req.GetReason())
+		if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+			updater.SetGrantedBy(uid)
+		}
+		ur, err = updater.Save(ctx)
+	case ent.IsNotFound(err):
+		creator := tx.UserRole.Create().
+			SetUserID(userID).
+			SetRoleID(roleID).
+			SetExpiresAt(expiresAt).
+			SetReason(req.GetReason())
+		if tenantID > 0 {
+			creator.SetTenantID(tenantID)
+		}
+		if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+			creator.SetGrantedBy(uid)
+		}
+		ur, err = creator.Save(ctx)
+	}
+	if err != nil {
+		logger.Errorf("临时提权失败: %v", err)
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 500, Msg: "临时提权失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.GrantTemporaryRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	ur = ur.Unwrap()
+	ur.Edges.Role = r
+
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, event.New(TopicUserRoleElevated, newUserRoleEvent(ur)))
+	}
+	return &v1.GrantTemporaryRoleResponse{Result: true, Code: 200, Msg: "success", UserRole: convertUserRoleToProto(ur)}, nil
+}
+
+// prepareGrant 校验在tenantID（已通过requestTenant校验的当前租户）下授予角色的前置条件
+// code不为0时表示校验失败，msg为失败原因
+// 租户角色只能在所属租户内授予；平台级角色可以平台级授予，在租户内只能授予角色模板
+func (s *RoleService) prepareGrant(ctx context.Context, userID, roleID, tenantID int64) (*ent.Role, int32, string) {
+	r, err := s.client.Role.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 404, "角色不存在"
+		}
+		return nil, 500, err.Error()
+	}
+	if !r.IsActive {
+		return nil, 400, "角色已停用"
+	}
+	if r.TenantID != nil && *r.TenantID != tenantID {
+		return nil, 400, "租户角色只能在所属租户内授予"
+	}
+	if r.TenantID == nil && tenantID > 0 && !r.IsTemplate {
+		return nil, 400, "租户内只能授予平台级角色模板"
+	}
+
+	exists, err := s.client.User.Query().Where(user.ID(userID), user.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	if !exists {
+		return nil, 404, "用户不存在"
+	}
+	if tenantID > 0 {
+		member, err := s.client.UserTenant.Query().
+			Where(usertenant.UserID(userID), usertenant.TenantID(tenantID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, 500, err.Error()
+		}
+		if !member {
+			return nil, 400, "用户不属于该租户"
+		}
+	}
+	return r, 0, ""
+}
+
+// RevokeRole 撤销用户的角色
+func (s *RoleService) RevokeRole(ctx context.Context, req *v1.RevokeRoleRequest) (*v1.RevokeRoleResponse, error) {
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &v1.RevokeRoleResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	roleID, err := strconv.ParseInt(req.GetRoleId(), 10, 64)
+	if err != nil {
+		return &v1.RevokeRoleResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	tenantID, code, msg := requestTenant(ctx, s.client, req.GetTenantId())
+	if code != 0 {
+		return &v1.RevokeRoleResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	r, err := s.client.Role.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.RevokeRoleResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.RevokeRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if r.TenantID != nil && *r.TenantID != tenantID {
+		return &v1.RevokeRoleResponse{Result: false, Code: 400, Msg: "租户角色只能在所属租户内撤销"}, nil
+	}
+
+	rule := authz.GroupingRule(userID, r.Code, tenantID)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.RevokeRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	defer tx.Rollback()
+	n, err := tx.UserRole.Delete().
+		Where(userrole.UserID(userID), userrole.RoleID(roleID), tenantIDEQ(tenantID)).
+		Exec(ctx)
+	if err != nil {
+		return &v1.RevokeRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if n == 0 {
+		// 之前撤销时规则同步失败的，重试撤销可以清理残留的g规则
+		if err := s.removeGrantRule(rule); err != nil {
+			return &v1.RevokeRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+		}
+		return &v1.RevokeRoleResponse{Result: false, Code: 404, Msg: "用户未被授予该角色"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.RevokeRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	if err := afterCommit(ctx, func() error { return s.removeGrantRule(rule) }); err != nil {
+		return &v1.RevokeRoleResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	return &v1.RevokeRoleResponse{Result: true, Code: 200, Msg: "success"}, nil
+}
+
+// ListUserRoles 获取用户在当前租户下的有效角色：平台级授予 + 该租户下的授予，排除已停用角色和已过期授予
+// 未指定租户时只有平台级用户可以查询他人的角色
+func (s *RoleService) ListUserRoles(ctx context.Context, req *v1.ListUserRolesRequest) (*v1.ListUserRolesResponse, error) {
+	userID, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+	if err != nil {
+		return &v1.ListUserRolesResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+	}
+	tenantID, err := parseOptionalID(req.GetTenantId())
+	if err != nil {
+		return &v1.ListUserRolesResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	current := middleware.GetTenantIDFromContext(ctx)
+	if req.GetTenantId() != "" && tenantID != current {
+		return &v1.ListUserRolesResponse{Result: false, Code: 403, Msg: "租户ID与当前租户不一致"}, nil
+	}
+	tenantID = current
+	if userID != middleware.GetUserIDFromContext(ctx) {
+		if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+			return &v1.ListUserRolesResponse{Result: false, Code: code, Msg: msg}, nil
+		}
+	}
+
+	scope := userrole.TenantIDIsNil()
+	if tenantID > 0 {
+		scope = userrole.Or(userrole.TenantIDIsNil(), userrole.TenantIDEQ(tenantID))
+	}
+	grants, err := s.client.UserRole.Query().
+		Where(
+			userrole.UserID(userID),
+			scope,
+			authz.NotExpired(time.Now()),
+			userrole.HasRoleWith(role.IsActive(true)),
+		).
+		WithRole().
+		Order(ent.Asc(userrole.FieldGrantedAt)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListUserRolesResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	items := make([]*v1.UserRole, 0, len(grants))
+	for _, ur := range grants {
+		items = append(items, convertUserRoleToProto(ur))
+	}
+	return &v1.ListUserRolesResponse{Result: true, Code: 200, Msg: "success", UserRoles: items}, nil
+}
+
+// syncGrantRule 按授予记录维护Casbin的g规则，需在授予记录提交后调用（见afterCommit）
+// 只有永久授予才写入g规则；限时授予通过Subject.RoleCodes参与鉴权（BuildSubject已排除过期授予），
+// 这样授予到期后无需等待清理任务即可立即失效
+// 同步是幂等的，失败时返回错误，调用方重试授予即可补齐规则
+func (s *RoleService) syncGrantRule(ur *ent.UserRole, roleCode string) error {
+	if s.enforcer == nil {
+		return nil
+	}
+	rule := authz.GroupingRule(ur.UserID, roleCode, tenantIDOrZero(ur.TenantID))
+	var err error
+	if ur.ExpiresAt == nil {
+		_, err = s.enforcer.AddGroupingPolicy(rule)
+	} else {
+		// 永久授予改为限时授予时，移除原有的g规则
+		_, err = s.enforcer.RemoveGroupingPolicy(rule)
+	}
+	if err != nil {
+		logger.Errorf("同步角色授予规则失败: %v", err)
+		return fmt.Errorf("同步角色授予规则失败，请重试: %w", err)
+	}
+	return nil
+}
+
+// removeGrantRule 撤销授予后移除g规则，规则不存在时不报错
+func (s *RoleService) removeGrantRule(rule []string) error {
+	if s.enforcer == nil {
+		return nil
+	}
+	if _, err := s.enforcer.RemoveGroupingPolicy(rule); err != nil {
+		logger.Errorf("同步角色撤销规则失败: %v", err)
+		return fmt.Errorf("同步角色撤销规则失败，请重试: %w", err)
+	}
+	return nil
+}
+
+// afterCommit 在授予记录真正提交后执行fn
+// Casbin适配器在独立的事务中写入规则，授予记录回滚后规则不能生效，因此context中有请求事务（见SystemRLSMiddleware）时
+// fn在请求事务提交后执行，其错误作为提交错误返回给调用方；没有请求事务时handler的事务已提交，立即执行
+func afterCommit(ctx context.Context, fn func() error) error {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		return fn()
+	}
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			return fn()
+		})
+	})
+	return nil
+}
+
+// resyncRole 角色编码或启用状态变化后，重建该角色相关的Casbin规则
+func (s *RoleService) resyncRole(ctx context.Context, old, updated *ent.Role) error {
+	if s.enforcer == nil {
+		return nil
+	}
+	// 限时授予不写入g规则（见syncGrantRule），这里只需处理永久授予
+	grants, err := s.client.UserRole.Query().
+		Where(
+			userrole.RoleID(updated.ID),
+			userrole.ExpiresAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var oldRules, newRules [][]string
+	for _, ur := range grants {
+		oldRules = append(oldRules, authz.GroupingRule(ur.UserID, old.Code, tenantIDOrZero(ur.TenantID)))
+		if updated.IsActive {
+			newRules = append(newRules, authz.GroupingRule(ur.UserID, updated.Code, tenantIDOrZero(ur.TenantID)))
+		}
+	}
+	// 继承关系的g规则同样需要改名或随启用状态增删
+	rules, err := inheritanceRules(ctx, old, old.Code, false)
+	if err != nil {
+		return err
+	}
+	oldRules = append(oldRules, rules...)
+	if updated.IsActive {
+		rules, err := inheritanceRules(ctx, updated, updated.Code, true)
+		if err != nil {
+			return err
+		}
+		newRules = append(newRules, rules...)
+	}
+	if len(oldRules) > 0 {
+		if _, err := s.enforcer.RemoveGroupingPolicies(oldRules); err != nil {
+			return err
+		}
+	}
+	if len(newRules) > 0 {
+		if _, err := s.enforcer.AddGroupingPoliciesEx(newRules); err != nil {
+			return err
+		}
+	}
+
+	// 角色编码变化时，p规则的主体随之改名
+	if updated.Code != old.Code {
+		fieldValues := []string{old.Code}
+		if old.TenantID != nil {
+			fieldValues = append(fieldValues, authz.DomainOf(*old.TenantID))
+		}
+		policies, err := s.enforcer.GetFilteredPolicy(0, fieldValues...)
+		if err != nil {
+			return err
+		}
+		if len(policies) > 0 {
+			renamed := make([][]string, 0, len(policies))
+			for _, p := range policies {
+				rule := append([]string{updated.Code}, p[1:]...)
+				renamed = append(renamed, rule)
+			}
+			if _, err := s.enforcer.UpdatePolicies(policies, renamed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeRoleRules 删除角色后清理其g规则（授予和继承）和p规则
+func (s *RoleService) removeRoleRules(r *ent.Role, grants []*ent.UserRole, inherits [][]string) error {
+	if s.enforcer == nil {
+		return nil
+	}
+	rules := inherits
+	for _, ur := range grants {
+		rules = append(rules, authz.GroupingRule(ur.UserID, r.Code, tenantIDOrZero(ur.TenantID)))
+	}
+	if len(rules) > 0 {
+		if _, err := s.enforcer.RemoveGroupingPolicies(rules); err != nil {
+			return err
+		}
+	}
+
+	fieldValues := []string{r.Code}
+	if r.TenantID != nil {
+		fieldValues = append(fieldValues, authz.DomainOf(*r.TenantID))
+	}
+	_, err := s.enforcer.RemoveFilteredPolicy(0, fieldValues...)
+	return err
+}
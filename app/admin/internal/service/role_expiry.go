@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/casbin/casbin/v2"
+
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/rls"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/logger"
+)
+
+// roleExpiryBatchSize 每批清理的过期授予数量
+const roleExpiryBatchSize = 500
+
+// RoleExpirySweeper 定期删除已过期的限时角色授予，并发布user_role.expired事件
+// 过期授予在鉴权时已被忽略，清理任务只负责回收记录、同步Casbin规则和通知
+// 多实例同时运行时通过FOR UPDATE SKIP LOCKED避免重复处理
+type RoleExpirySweeper struct {
+	client    *ent.Client
+	enforcer  *casbin.SyncedEnforcer
+	publisher event.Publisher
+	interval  time.Duration
+}
+
+// NewRoleExpirySweeper 创建过期授予清理任务，未启用Casbin时enforcer传nil
+func NewRoleExpirySweeper(client *ent.Client, enforcer *casbin.SyncedEnforcer, publisher event.Publisher, interval time.Duration) *RoleExpirySweeper {
+	return &RoleExpirySweeper{
+		client:    client,
+		enforcer:  enforcer,
+		publisher: publisher,
+		interval:  interval,
+	}
+}
+
+// Start 在后台按固定间隔执行清理，ctx取消后退出
+func (s *RoleExpirySweeper) Start(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if n, err := s.Sweep(ctx); err != nil {
+					logger.Errorf("清理过期角色授予失败: %v", err)
+				} else if n > 0 {
+					logger.Infof("已清理%d条过期角色授予", n)
+				}
+			}
+		}
+	}()
+}
+
+// Sweep 清理截至当前时间已过期的授予，返回清理数量
+func (s *RoleExpirySweeper) Sweep(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		expired, err := s.sweepBatch(ctx, time.Now())
+		if err != nil {
+			return total, err
+		}
+		total += len(expired)
+		s.afterExpire(ctx, expired)
+		if len(expired) < roleExpiryBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// sweepBatch 在跳过行级安全的事务中锁定并删除一批过期授予，清理跨所有租户进行
+func (s *RoleExpirySweeper) sweepBatch(ctx context.Context, now time.Time) ([]*ent.UserRole, error) {
+	tx, err := rls.BypassTx(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	expired, err := tx.UserRole.Query().
+		Where(userrole.ExpiresAtLTE(now)).
+		Order(ent.Asc(userrole.FieldExpiresAt)).
+		Limit(roleExpiryBatchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		WithRole().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(expired))
+	for _, ur := range expired {
+		ids = append(ids, ur.ID)
+	}
+	if _, err := tx.UserRole.Delete().Where(userrole.IDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+// afterExpire 清理残留的g规则并发布事件
+// 限时授予本身不写入g规则，这里处理的是由永久授予改为限时授予前遗留的规则
+func (s *RoleExpirySweeper) afterExpire(ctx context.Context, expired []*ent.UserRole) {
+	if s.enforcer != nil {
+		var rules [][]string
+		for _, ur := range expired {
+			if ur.Edges.Role == nil {
+				continue
+			}
+			rule := authz.GroupingRule(ur.UserID, ur.Edges.Role.Code, tenantIDOrZero(ur.TenantID))
+			if ok, _ := s.enforcer.HasGroupingPolicy(rule); ok {
+				rules = append(rules, rule)
+			}
+		}
+		if len(rules) > 0 {
+			if _, err := s.enforcer.RemoveGroupingPolicies(rules); err != nil {
+				logger.Errorf("删除过期角色授予规则失败: %v", err)
+			}
+		}
+	}
+
+	if s.publisher == nil {
+		return
+	}
+	for _, ur := range expired {
+		s.publisher.Publish(ctx, event.New(TopicUserRoleExpired, newUserRoleEvent(ur)))
+	}
+}
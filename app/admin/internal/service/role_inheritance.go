@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/role"
+	"github.com/yc-alpha/logger"
+)
+
+// AddRoleInheritance 添加角色继承，角色{id}包含角色{inherit_role_id}的全部权限
+func (s *RoleService) AddRoleInheritance(ctx context.Context, req *v1.AddRoleInheritanceRequest) (*v1.AddRoleInheritanceResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	inheritID, err := strconv.ParseInt(req.GetInheritRoleId(), 10, 64)
+	if err != nil {
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: 400, Msg: "无效的继承角色ID"}, nil
+	}
+	parent, err := s.client.Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.AddRoleInheritanceResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if code, msg := checkRoleScope(ctx, s.client, parent); code != 0 {
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	defer tx.Rollback()
+	children, code, msg := inheritRoles(ctx, tx, parent, []int64{inheritID})
+	if code != 0 {
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.AddRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	s.addInheritanceRules(parent, children)
+	return &v1.AddRoleInheritanceResponse{Result: true, Code: 200, Msg: "success"}, nil
+}
+
+// RemoveRoleInheritance 移除角色继承
+func (s *RoleService) RemoveRoleInheritance(ctx context.Context, req *v1.RemoveRoleInheritanceRequest) (*v1.RemoveRoleInheritanceResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	inheritID, err := strconv.ParseInt(req.GetInheritRoleId(), 10, 64)
+	if err != nil {
+		return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 400, Msg: "无效的继承角色ID"}, nil
+	}
+	parent, err := s.client.Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	if code, msg := checkRoleScope(ctx, s.client, parent); code != 0 {
+		return &v1.RemoveRoleInheritanceResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	child, err := parent.QueryInherits().Where(role.ID(inheritID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 404, Msg: "角色未继承该角色"}, nil
+		}
+		return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	if err := s.client.Role.UpdateOneID(id).RemoveInheritIDs(inheritID).Exec(ctx); err != nil {
+		logger.Errorf("移除角色继承失败: %v", err)
+		return &v1.RemoveRoleInheritanceResponse{Result: false, Code: 500, Msg: "移除角色继承失败"}, nil
+	}
+
+	if s.enforcer != nil {
+		rule := authz.InheritanceRule(parent.Code, child.Code, tenantIDOrZero(parent.TenantID))
+		if _, err := s.enforcer.RemoveGroupingPolicy(rule); err != nil {
+			logger.Errorf("同步角色继承规则失败: %v", err)
+		}
+	}
+	return &v1.RemoveRoleInheritanceResponse{Result: true, Code: 200, Msg: "success"}, nil
+}
+
+// ListRoleInheritance 获取角色直接继承的角色，以及沿继承链展开后的所有角色
+func (s *RoleService) ListRoleInheritance(ctx context.Context, req *v1.ListRoleInheritanceRequest) (*v1.ListRoleInheritanceResponse, error) {
+	id, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.ListRoleInheritanceResponse{Result: false, Code: 400, Msg: "无效的角色ID"}, nil
+	}
+	r, err := s.client.Role.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.ListRoleInheritanceResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+		}
+		return &v1.ListRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	// 与GetRole一致：租户下可以查看本租户角色和平台级角色
+	if r.TenantID != nil && !roleInTenant(ctx, r) {
+		return &v1.ListRoleInheritanceResponse{Result: false, Code: 404, Msg: "角色不存在"}, nil
+	}
+
+	direct, err := r.QueryInherits().Order(ent.Asc(role.FieldID)).All(ctx)
+	if err != nil {
+		return &v1.ListRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	graph, err := loadRoleGraph(ctx, s.client.Role.Query().Where(role.HasInherits()))
+	if err != nil {
+		return &v1.ListRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+	ids := graph.Expand(r.ID)[1:]
+	effective, err := s.client.Role.Query().
+		Where(role.IDIn(ids...)).
+		Order(ent.Asc(role.FieldID)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListRoleInheritanceResponse{Result: false, Code: 500, Msg: err.Error()}, nil
+	}
+
+	resp := &v1.ListRoleInheritanceResponse{
+		Result:    true,
+		Code:      200,
+		Msg:       "success",
+		Inherits:  make([]*v1.Role, 0, len(direct)),
+		Effective: make([]*v1.Role, 0, len(effective)),
+	}
+	for _, item := range direct {
+		resp.Inherits = append(resp.Inherits, convertRoleToProto(item))
+	}
+	for _, item := range effective {
+		resp.Effective = append(resp.Effective, convertRoleToProto(item))
+	}
+	return resp, nil
+}
+
+// inheritRoles 在事务中为parent添加继承的角色，已继承的角色会被跳过
+// 校验作用域（平台级角色只能继承平台级角色，租户角色只能继承本租户角色或非ALL范围的平台级角色模板）、环和继承深度
+// code不为0时表示校验失败，msg为失败原因
+func inheritRoles(ctx context.Context, tx *ent.Tx, parent *ent.Role, childIDs []int64) ([]*ent.Role, int32, string) {
+	if len(childIDs) == 0 {
+		return nil, 0, ""
+	}
+	children, err := tx.Role.Query().Where(role.IDIn(childIDs...)).All(ctx)
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	if len(children) != len(uniqueIDs(childIDs)) {
+		return nil, 404, "继承的角色不存在"
+	}
+	for _, child := range children {
+		if child.TenantID == nil {
+			if parent.TenantID != nil && (!child.IsTemplate || child.DataScope == role.DataScopeALL) {
+				return nil, 400, "租户角色只能继承平台级角色模板: " + child.Code
+			}
+			if parent.IsTemplate && !child.IsTemplate {
+				return nil, 400, "角色模板只能继承角色模板: " + child.Code
+			}
+			continue
+		}
+		if parent.TenantID == nil {
+			return nil, 400, "平台级角色只能继承平台级角色: " + child.Code
+		}
+		if *child.TenantID != *parent.TenantID {
+			return nil, 400, "租户角色只能继承本租户角色或平台级角色模板: " + child.Code
+		}
+	}
+
+	// 锁定所有参与继承的角色后再加载继承关系，避免并发添加继承时形成环
+	locked := append([]int64{parent.ID}, childIDs...)
+	graph, err := loadRoleGraph(ctx, tx.Role.Query().
+		Where(role.Or(role.HasInherits(), role.IDIn(locked...))).
+		ForUpdate())
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	existing := make(map[int64]struct{}, len(graph[parent.ID]))
+	for _, id := range graph[parent.ID] {
+		existing[id] = struct{}{}
+	}
+
+	added := make([]*ent.Role, 0, len(children))
+	ids := make([]int64, 0, len(children))
+	for _, child := range children {
+		if _, ok := existing[child.ID]; ok {
+			continue
+		}
+		if err := graph.CheckEdge(parent.ID, child.ID); err != nil {
+			if errors.Is(err, authz.ErrInheritanceCycle) {
+				return nil, 400, "角色继承不能成环: " + child.Code
+			}
+			return nil, 400, "角色继承层级不能超过" + strconv.Itoa(authz.MaxInheritanceDepth) + "层"
+		}
+		graph[parent.ID] = append(graph[parent.ID], child.ID)
+		added = append(added, child)
+		ids = append(ids, child.ID)
+	}
+	if len(ids) > 0 {
+		if err := tx.Role.UpdateOneID(parent.ID).AddInheritIDs(ids...).Exec(ctx); err != nil {
+			return nil, 500, err.Error()
+		}
+	}
+	return added, 0, ""
+}
+
+// loadRoleGraph 加载q范围内角色的继承关系
+func loadRoleGraph(ctx context.Context, q *ent.RoleQuery) (authz.RoleGraph, error) {
+	roles, err := q.
+		WithInherits(func(q *ent.RoleQuery) { q.Select(role.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graph := make(authz.RoleGraph, len(roles))
+	for _, r := range roles {
+		for _, child := range r.Edges.Inherits {
+			graph[r.ID] = append(graph[r.ID], child.ID)
+		}
+	}
+	return graph, nil
+}
+
+// addInheritanceRules 为启用的角色写入继承的g规则，规则的域为parent所属租户
+func (s *RoleService) addInheritanceRules(parent *ent.Role, children []*ent.Role) {
+	if s.enforcer == nil || !parent.IsActive {
+		return
+	}
+	var rules [][]string
+	for _, child := range children {
+		if child.IsActive {
+			rules = append(rules, authz.InheritanceRule(parent.Code, child.Code, tenantIDOrZero(parent.TenantID)))
+		}
+	}
+	if len(rules) == 0 {
+		return
+	}
+	if _, err := s.enforcer.AddGroupingPoliciesEx(rules); err != nil {
+		logger.Errorf("同步角色继承规则失败: %v", err)
+	}
+}
+
+// inheritanceRules 返回角色r参与的所有继承g规则，r的编码使用code
+// activeOnly为true时跳过另一端已停用的角色
+func inheritanceRules(ctx context.Context, r *ent.Role, code string, activeOnly bool) ([][]string, error) {
+	children, err := r.QueryInherits().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parents, err := r.QueryInheritedBy().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rules [][]string
+	for _, child := range children {
+		if activeOnly && !child.IsActive {
+			continue
+		}
+		rules = append(rules, authz.InheritanceRule(code, child.Code, tenantIDOrZero(r.TenantID)))
+	}
+	for _, parent := range parents {
+		if activeOnly && !parent.IsActive {
+			continue
+		}
+		rules = append(rules, authz.InheritanceRule(parent.Code, code, tenantIDOrZero(parent.TenantID)))
+	}
+	return rules, nil
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/yc-alpha/admin/common/datascope"
+	"github.com/yc-alpha/admin/common/quota"
 	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/tenant"
 	"github.com/yc-alpha/logger"
 )
@@ -37,14 +43,16 @@ type CreateTenantRequest struct {
 	Type       TenantType     `json:"type"`
 	ParentID   *int64         `json:"parent_id,omitempty"`
 	Status     string         `json:"status,omitempty"`
-	ExpiredAt  *string        `json:"expired_at,omitempty"`
+	ExpiredAt  *time.Time     `json:"expired_at,omitempty"` // 为空表示不过期
 	Attributes map[string]any `json:"attributes,omitempty"`
 	CreatedBy  *int64         `json:"created_by,omitempty"`
+	PlanID     *int64         `json:"plan_id,omitempty"` // 为空时使用默认套餐
 }
 
 // CreateTenantResponse 创建租户响应
 type CreateTenantResponse struct {
 	Success bool        `json:"success"`
+	Code    int32       `json:"code,omitempty"` // 失败的错误码，为空表示参数错误
 	Message string      `json:"message"`
 	Tenant  *ent.Tenant `json:"tenant,omitempty"`
 }
@@ -76,11 +84,56 @@ func (s *TenantService) CreateTenant(ctx context.Context, req *CreateTenantReque
 		}
 	}
 
+	planID := req.PlanID
+	if planID != nil {
+		exist, err := s.client.Plan.Query().Where(plan.ID(*planID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return &CreateTenantResponse{
+				Success: false,
+				Message: "套餐不存在",
+			}, nil
+		}
+	} else {
+		var err error
+		if planID, err = defaultPlanID(ctx, s.client); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// 新租户计入父租户的子租户配额
+	if req.ParentID != nil {
+		var exceeded *quota.ExceededError
+		if err := quota.Check(ctx, tx.Client(), *req.ParentID, quota.ResourceSubTenants, 1); errors.As(err, &exceeded) {
+			return &CreateTenantResponse{
+				Success: false,
+				Code:    403,
+				Message: quotaExceededMsg(exceeded),
+			}, nil
+		} else if ent.IsNotFound(err) {
+			return &CreateTenantResponse{
+				Success: false,
+				Message: "父租户不存在",
+			}, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
 	// 创建租户
-	tenantBuilder := s.client.Tenant.Create().
+	tenantBuilder := tx.Tenant.Create().
 		SetName(req.Name).
 		SetOwnerID(req.OwnerID).
-		SetType(tenant.Type(req.Type))
+		SetType(tenant.Type(req.Type)).
+		SetNillablePlanID(planID)
 
 	if req.ParentID != nil {
 		tenantBuilder.SetParentID(*req.ParentID)
@@ -98,6 +151,8 @@ func (s *TenantService) CreateTenant(ctx context.Context, req *CreateTenantReque
 		tenantBuilder.SetCreatedBy(*req.CreatedBy)
 	}
 
+	tenantBuilder.SetNillableExpiredAt(req.ExpiredAt)
+
 	createdTenant, err := tenantBuilder.Save(ctx)
 	if err != nil {
 		logger.Errorf("创建租户失败: %v", err)
@@ -106,6 +161,10 @@ func (s *TenantService) CreateTenant(ctx context.Context, req *CreateTenantReque
 			Message: "创建租户失败",
 		}, nil
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	createdTenant = createdTenant.Unwrap()
 
 	logger.Infof("成功创建租户: %s (ID: %d)", createdTenant.Name, createdTenant.ID)
 	return &CreateTenantResponse{
@@ -127,10 +186,8 @@ func (s *TenantService) GetTenantHierarchy(ctx context.Context, tenantID int64)
 // GetRootTenants 获取根租户列表
 func (s *TenantService) GetRootTenants(ctx context.Context) ([]*ent.Tenant, error) {
 	return s.client.Tenant.Query().
-		Where(
-			tenant.ParentIDIsNil(),
-			tenant.DeletedAtIsNil(),
-		).
+		Where(tenant.ParentIDIsNil()).
+		Where(datascope.FromContext(ctx).Tenants()...).
 		WithChildren().
 		Order(ent.Asc(tenant.FieldCreatedAt)).
 		All(ctx)
@@ -139,10 +196,8 @@ func (s *TenantService) GetRootTenants(ctx context.Context) ([]*ent.Tenant, erro
 // GetSubTenants 获取子租户列表
 func (s *TenantService) GetSubTenants(ctx context.Context, parentID int64) ([]*ent.Tenant, error) {
 	return s.client.Tenant.Query().
-		Where(
-			tenant.ParentID(parentID),
-			tenant.DeletedAtIsNil(),
-		).
+		Where(tenant.ParentID(parentID)).
+		Where(datascope.FromContext(ctx).Tenants()...).
 		WithParent().
 		Order(ent.Asc(tenant.FieldCreatedAt)).
 		All(ctx)
@@ -151,10 +206,8 @@ func (s *TenantService) GetSubTenants(ctx context.Context, parentID int64) ([]*e
 // GetGroupTenants 获取集团型租户列表
 func (s *TenantService) GetGroupTenants(ctx context.Context) ([]*ent.Tenant, error) {
 	return s.client.Tenant.Query().
-		Where(
-			tenant.TypeEQ(tenant.TypeGROUP),
-			tenant.DeletedAtIsNil(),
-		).
+		Where(tenant.TypeEQ(tenant.TypeGROUP)).
+		Where(datascope.FromContext(ctx).Tenants()...).
 		WithChildren().
 		Order(ent.Asc(tenant.FieldCreatedAt)).
 		All(ctx)
@@ -216,43 +269,43 @@ func (s *TenantService) CanCreateSubTenant(ctx context.Context, tenantID int64)
 	return t.Type == tenant.TypeGROUP, nil
 }
 
-// GetTenantStatistics 获取租户统计信息
-func (s *TenantService) GetTenantStatistics(ctx context.Context) (map[string]int, error) {
-	stats := make(map[string]int)
-
-	// 统计各类型租户数量
-	normalCount, err := s.client.Tenant.Query().
-		Where(tenant.TypeEQ(tenant.TypeNORMAL), tenant.DeletedAtIsNil()).
-		Count(ctx)
-	if err != nil {
-		return nil, err
-	}
-	stats["normal"] = normalCount
+// TenantTypeStatusCount 按类型和状态分组的租户数
+type TenantTypeStatusCount struct {
+	Type   tenant.Type   `json:"type"`
+	Status tenant.Status `json:"status"`
+	Count  int           `json:"count"`
+}
 
-	groupCount, err := s.client.Tenant.Query().
-		Where(tenant.TypeEQ(tenant.TypeGROUP), tenant.DeletedAtIsNil()).
-		Count(ctx)
-	if err != nil {
+// CountByTypeStatus 按类型和状态分组统计未删除的租户，一次GROUP BY查询
+func (s *TenantService) CountByTypeStatus(ctx context.Context) ([]TenantTypeStatusCount, error) {
+	var rows []TenantTypeStatusCount
+	if err := s.client.Tenant.Query().
+		GroupBy(tenant.FieldType, tenant.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
 		return nil, err
 	}
-	stats["group"] = groupCount
+	return rows, nil
+}
 
-	subCount, err := s.client.Tenant.Query().
-		Where(tenant.TypeEQ(tenant.TypeSUB), tenant.DeletedAtIsNil()).
-		Count(ctx)
+// GetTenantStatistics 获取租户统计信息，包括总数、各类型和各状态的数量
+func (s *TenantService) GetTenantStatistics(ctx context.Context) (map[string]int, error) {
+	rows, err := s.CountByTypeStatus(ctx)
 	if err != nil {
 		return nil, err
 	}
-	stats["sub"] = subCount
+	return summarizeTenantCounts(rows), nil
+}
 
-	// 统计总租户数
-	totalCount, err := s.client.Tenant.Query().
-		Where(tenant.DeletedAtIsNil()).
-		Count(ctx)
-	if err != nil {
-		return nil, err
+// summarizeTenantCounts 把分组结果汇总为total、小写的类型名和状态名
+func summarizeTenantCounts(rows []TenantTypeStatusCount) map[string]int {
+	stats := map[string]int{"total": 0, "normal": 0, "group": 0, "sub": 0}
+	for _, r := range rows {
+		stats["total"] += r.Count
+		if r.Type != tenant.TypeROOT {
+			stats[strings.ToLower(r.Type.String())] += r.Count
+		}
+		stats[strings.ToLower(r.Status.String())] += r.Count
 	}
-	stats["total"] = totalCount
-
-	return stats, nil
+	return stats
 }
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/rls"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/logger"
+)
+
+// tenantExpiryBatchSize 每批处理的租户数量
+const tenantExpiryBatchSize = 200
+
+// TenantExpiryScheduler 定期将到期的租户置为EXPIRED，并在到期前发送提醒
+// 到期的租户在鉴权时已被拒绝，任务负责落状态、写审计记录和发布事件
+// 多实例同时运行时通过FOR UPDATE SKIP LOCKED避免重复处理
+type TenantExpiryScheduler struct {
+	client       *ent.Client
+	publisher    event.Publisher
+	interval     time.Duration
+	noticeBefore time.Duration
+}
+
+// NewTenantExpiryScheduler 创建租户到期任务，noticeBefore<=0时不发送到期提醒
+func NewTenantExpiryScheduler(client *ent.Client, publisher event.Publisher, interval, noticeBefore time.Duration) *TenantExpiryScheduler {
+	return &TenantExpiryScheduler{
+		client:       client,
+		publisher:    publisher,
+		interval:     interval,
+		noticeBefore: noticeBefore,
+	}
+}
+
+// Start 在后台按固定间隔执行，ctx取消后退出
+func (s *TenantExpiryScheduler) Start(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expired, noticed, err := s.Run(ctx)
+				if err != nil {
+					logger.Errorf("处理租户到期失败: %v", err)
+				}
+				if expired > 0 || noticed > 0 {
+					logger.Infof("租户到期处理：过期%d个，提醒%d个", expired, noticed)
+				}
+			}
+		}
+	}()
+}
+
+// Run 执行一次：先处理已到期的租户，再发送到期提醒
+func (s *TenantExpiryScheduler) Run(ctx context.Context) (expired, noticed int, err error) {
+	for {
+		n, err := s.expireBatch(ctx, time.Now())
+		expired += n
+		if err != nil {
+			return expired, noticed, err
+		}
+		if n < tenantExpiryBatchSize {
+			break
+		}
+	}
+	if s.noticeBefore <= 0 {
+		return expired, 0, nil
+	}
+	for {
+		n, err := s.noticeBatch(ctx, time.Now())
+		noticed += n
+		if err != nil {
+			return expired, noticed, err
+		}
+		if n < tenantExpiryBatchSize {
+			return expired, noticed, nil
+		}
+	}
+}
+
+// expireBatch 在跳过行级安全的事务中锁定并过期一批租户
+func (s *TenantExpiryScheduler) expireBatch(ctx context.Context, now time.Time) (int, error) {
+	tx, err := rls.BypassTx(ctx, s.client)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	tenants, err := tx.Tenant.Query().
+		Where(
+			tenant.StatusIn(tenant.StatusACTIVE, tenant.StatusPENDING),
+			tenant.ExpiredAtLTE(now),
+		).
+		Order(ent.Asc(tenant.FieldExpiredAt)).
+		Limit(tenantExpiryBatchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	events := make([]*TenantStatusEvent, 0, len(tenants))
+	for _, t := range tenants {
+		updated, log, err := applyTenantTransition(ctx, tx, t, tenancy.ActionExpire, nil, "到期自动过期", nil, now)
+		if err != nil {
+			return 0, err
+		}
+		events = append(events, newTenantStatusEvent(updated, log))
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	for _, e := range events {
+		s.publish(ctx, TopicTenantStatusChanged, e)
+	}
+	return len(tenants), nil
+}
+
+// noticeBatch 标记并提醒一批即将到期的租户，每次续期后只提醒一次
+func (s *TenantExpiryScheduler) noticeBatch(ctx context.Context, now time.Time) (int, error) {
+	tx, err := rls.BypassTx(ctx, s.client)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	tenants, err := tx.Tenant.Query().
+		Where(
+			tenant.StatusEQ(tenant.StatusACTIVE),
+			tenant.ExpiredAtGT(now),
+			tenant.ExpiredAtLTE(now.Add(s.noticeBefore)),
+			tenant.ExpiryNotifiedAtIsNil(),
+		).
+		Order(ent.Asc(tenant.FieldExpiredAt)).
+		Limit(tenantExpiryBatchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range tenants {
+		if err := tx.Tenant.UpdateOneID(t.ID).SetExpiryNotifiedAt(now).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	for _, t := range tenants {
+		s.publish(ctx, TopicTenantExpiring, newTenantStatusEvent(t, nil))
+	}
+	return len(tenants), nil
+}
+
+func (s *TenantExpiryScheduler) publish(ctx context.Context, topic string, payload any) {
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, event.New(topic, payload))
+	}
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
+	"github.com/yc-alpha/logger"
+)
+
+// 租户生命周期事件主题
+const (
+	TopicTenantStatusChanged = "tenant.status_changed" // 状态变更（含续期和自动过期）
+	TopicTenantExpiring      = "tenant.expiring"       // 即将到期提醒
+)
+
+// TenantStatusEvent 租户状态变更事件
+type TenantStatusEvent struct {
+	TenantID   int64      `json:"tenant_id"`
+	Name       string     `json:"name"`
+	OwnerID    int64      `json:"owner_id"`
+	Action     string     `json:"action,omitempty"`
+	From       string     `json:"from,omitempty"`
+	To         string     `json:"to"`
+	ExpiredAt  *time.Time `json:"expired_at,omitempty"`
+	Reason     string     `json:"reason,omitempty"`
+	OperatorID *int64     `json:"operator_id,omitempty"` // 为空表示系统自动变更
+}
+
+func newTenantStatusEvent(t *ent.Tenant, log *ent.TenantStatusLog) *TenantStatusEvent {
+	e := &TenantStatusEvent{
+		TenantID:  t.ID,
+		Name:      t.Name,
+		OwnerID:   t.OwnerID,
+		To:        t.Status.String(),
+		ExpiredAt: t.ExpiredAt,
+	}
+	if log != nil {
+		e.Action = log.Action.String()
+		e.From = log.FromStatus
+		e.Reason = log.Reason
+		e.OperatorID = log.OperatorID
+	}
+	return e
+}
+
+// ActivateTenant 激活租户
+func (s *TenantServiceImpl) ActivateTenant(ctx context.Context, req *v1.ActivateTenantRequest) (*v1.ActivateTenantResponse, error) {
+	t, code, msg := s.changeStatus(ctx, req.GetId(), tenancy.ActionActivate, nil, req.GetReason())
+	if code != 0 {
+		return &v1.ActivateTenantResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	return &v1.ActivateTenantResponse{Result: true, Code: 200, Msg: "激活成功", Tenant: s.convertTenantToProto(t)}, nil
+}
+
+// DisableTenant 停用租户，其下子租户在鉴权时同时被拒绝
+func (s *TenantServiceImpl) DisableTenant(ctx context.Context, req *v1.DisableTenantRequest) (*v1.DisableTenantResponse, error) {
+	t, code, msg := s.changeStatus(ctx, req.GetId(), tenancy.ActionDisable, nil, req.GetReason())
+	if code != 0 {
+		return &v1.DisableTenantResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	return &v1.DisableTenantResponse{Result: true, Code: 200, Msg: "停用成功", Tenant: s.convertTenantToProto(t)}, nil
+}
+
+// RenewTenant 续期租户
+func (s *TenantServiceImpl) RenewTenant(ctx context.Context, req *v1.RenewTenantRequest) (*v1.RenewTenantResponse, error) {
+	expiredAt, err := time.Parse(time.RFC3339, req.GetExpiredAt())
+	if err != nil {
+		return &v1.RenewTenantResponse{Result: false, Code: 400, Msg: "无效的到期时间"}, nil
+	}
+	t, code, msg := s.changeStatus(ctx, req.GetId(), tenancy.ActionRenew, &expiredAt, req.GetReason())
+	if code != 0 {
+		return &v1.RenewTenantResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	return &v1.RenewTenantResponse{Result: true, Code: 200, Msg: "续期成功", Tenant: s.convertTenantToProto(t)}, nil
+}
+
+// ExpireTenant 使租户立即过期
+func (s *TenantServiceImpl) ExpireTenant(ctx context.Context, req *v1.ExpireTenantRequest) (*v1.ExpireTenantResponse, error) {
+	t, code, msg := s.changeStatus(ctx, req.GetId(), tenancy.ActionExpire, nil, req.GetReason())
+	if code != 0 {
+		return &v1.ExpireTenantResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	return &v1.ExpireTenantResponse{Result: true, Code: 200, Msg: "操作成功", Tenant: s.convertTenantToProto(t)}, nil
+}
+
+// ListTenantStatusLogs 获取租户状态变更记录，按时间倒序
+func (s *TenantServiceImpl) ListTenantStatusLogs(ctx context.Context, req *v1.ListTenantStatusLogsRequest) (*v1.ListTenantStatusLogsResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.ListTenantStatusLogsResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+
+	query := s.tenantService.client.TenantStatusLog.Query().
+		Where(tenantstatuslog.TenantID(tenantID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return &v1.ListTenantStatusLogsResponse{Result: false, Code: 500, Msg: "查询状态变更记录失败"}, nil
+	}
+	logs, err := query.
+		Order(ent.Desc(tenantstatuslog.FieldCreatedAt)).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListTenantStatusLogsResponse{Result: false, Code: 500, Msg: "查询状态变更记录失败"}, nil
+	}
+
+	items := make([]*v1.TenantStatusLog, 0, len(logs))
+	for _, l := range logs {
+		item := &v1.TenantStatusLog{
+			Id:         strconv.FormatInt(l.ID, 10),
+			TenantId:   strconv.FormatInt(l.TenantID, 10),
+			Action:     l.Action.String(),
+			FromStatus: tenantStatusToProto(tenant.Status(l.FromStatus)),
+			ToStatus:   tenantStatusToProto(tenant.Status(l.ToStatus)),
+			Reason:     l.Reason,
+			CreatedAt:  l.CreatedAt.Format(time.RFC3339),
+		}
+		if l.ExpiredAt != nil {
+			item.ExpiredAt = l.ExpiredAt.Format(time.RFC3339)
+		}
+		if l.OperatorID != nil {
+			item.OperatorId = strconv.FormatInt(*l.OperatorID, 10)
+		}
+		items = append(items, item)
+	}
+	return &v1.ListTenantStatusLogsResponse{Result: true, Code: 200, Msg: "查询成功", Logs: items, Total: int32(total)}, nil
+}
+
+// changeStatus 在事务中锁定租户并执行状态变更，成功后发布事件；失败时返回错误码和提示
+// 租户状态变更只允许平台级用户执行
+func (s *TenantServiceImpl) changeStatus(ctx context.Context, id string, action tenancy.Action, renewTo *time.Time, reason string) (*ent.Tenant, int32, string) {
+	if code, msg := checkPlatformUser(ctx, s.tenantService.client); code != 0 {
+		return nil, code, msg
+	}
+	tenantID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, 400, "无效的租户ID"
+	}
+	var operatorID *int64
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		operatorID = &uid
+	}
+
+	tx, err := s.tenantService.client.Tx(ctx)
+	if err != nil {
+		return nil, 500, "租户状态变更失败"
+	}
+	defer tx.Rollback()
+
+	t, err := tx.Tenant.Query().
+		Where(tenant.ID(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 404, "租户不存在"
+		}
+		return nil, 500, "查询租户失败"
+	}
+	if t.Type == tenant.TypeROOT {
+		return nil, 400, "系统租户(ROOT)禁止变更状态"
+	}
+	// 上级租户不可用时激活没有意义，需先处理上级租户
+	if action == tenancy.ActionActivate && t.ParentID != nil {
+		var unavailable *tenancy.UnavailableError
+		if err := tenancy.Check(ctx, tx.Client(), *t.ParentID, time.Now()); errors.As(err, &unavailable) {
+			return nil, 400, "上级租户不可用: " + unavailable.Error()
+		} else if err != nil {
+			return nil, 500, "查询上级租户失败"
+		}
+	}
+
+	updated, log, err := applyTenantTransition(ctx, tx, t, action, renewTo, reason, operatorID, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, tenancy.ErrInvalidTransition):
+			return nil, 400, "租户当前状态为" + t.Status.String() + "，不允许执行" + string(action)
+		case errors.Is(err, tenancy.ErrRenewRequired):
+			return nil, 400, "租户已到期，请先续期"
+		case errors.Is(err, tenancy.ErrInvalidExpiry):
+			return nil, 400, "到期时间必须晚于当前时间"
+		}
+		logger.Errorf("租户状态变更失败: %v", err)
+		return nil, 500, "租户状态变更失败"
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, 500, "租户状态变更失败"
+	}
+
+	s.publish(ctx, TopicTenantStatusChanged, newTenantStatusEvent(updated, log))
+	return updated, 0, ""
+}
+
+// applyTenantTransition 校验并执行状态变更，同时写入审计记录，t需已在tx中锁定
+func applyTenantTransition(ctx context.Context, tx *ent.Tx, t *ent.Tenant, action tenancy.Action, renewTo *time.Time, reason string, operatorID *int64, now time.Time) (*ent.Tenant, *ent.TenantStatusLog, error) {
+	to, err := tenancy.Transition(t.Status, action, t.ExpiredAt, renewTo, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updater := tx.Tenant.UpdateOneID(t.ID).
+		SetStatus(to).
+		SetNillableUpdatedBy(operatorID)
+	switch action {
+	case tenancy.ActionRenew:
+		updater.SetExpiredAt(*renewTo).ClearExpiryNotifiedAt()
+	case tenancy.ActionExpire:
+		// 手动过期时到期时间记为当前时间
+		if t.ExpiredAt == nil || t.ExpiredAt.After(now) {
+			updater.SetExpiredAt(now)
+		}
+	}
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log, err := tx.TenantStatusLog.Create().
+		SetTenantID(t.ID).
+		SetAction(tenantstatuslog.Action(action)).
+		SetFromStatus(t.Status.String()).
+		SetToStatus(to.String()).
+		SetNillableExpiredAt(updated.ExpiredAt).
+		SetReason(reason).
+		SetNillableOperatorID(operatorID).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, log, nil
+}
+
+func (s *TenantServiceImpl) publish(ctx context.Context, topic string, payload any) {
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, event.New(topic, payload))
+	}
+}
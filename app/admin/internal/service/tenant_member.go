@@ -0,0 +1,509 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/casbin/casbin/v2"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/quota"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/admin/ent/usertenant"
+	"github.com/yc-alpha/logger"
+)
+
+// 租户成员事件主题
+const (
+	TopicTenantMemberAdded   = "tenant.member_added"
+	TopicTenantMemberRemoved = "tenant.member_removed"
+)
+
+// 身份标签的数量和长度限制
+const (
+	maxRoleLabels     = 10
+	maxRoleLabelChars = 32
+)
+
+// TenantMemberEvent 租户成员变更事件
+type TenantMemberEvent struct {
+	TenantID        int64    `json:"tenant_id"`
+	UserID          int64    `json:"user_id"`
+	RoleLabels      []string `json:"role_labels,omitempty"`
+	UserRoles       int      `json:"user_roles,omitempty"`       // 移除时撤销的角色授予数量
+	UserDepartments int      `json:"user_departments,omitempty"` // 移除时删除的部门关系数量
+	OperatorID      int64    `json:"operator_id,omitempty"`
+}
+
+// TenantMemberService 租户成员管理，成员关系保存在UserTenant中
+// enforcer 不为nil时，移除成员会同步删除其在该租户域下的g规则
+type TenantMemberService struct {
+	v1.UnimplementedTenantMemberServiceServer
+	client    *ent.Client
+	enforcer  *casbin.SyncedEnforcer
+	publisher event.Publisher
+}
+
+// NewTenantMemberService 创建租户成员服务，未启用Casbin时enforcer传nil
+func NewTenantMemberService(client *ent.Client, enforcer *casbin.SyncedEnforcer, publisher event.Publisher) *TenantMemberService {
+	return &TenantMemberService{
+		client:    client,
+		enforcer:  enforcer,
+		publisher: publisher,
+	}
+}
+
+// AddTenantMembers 将用户加入租户
+func (s *TenantMemberService) AddTenantMembers(ctx context.Context, req *v1.AddTenantMembersRequest) (*v1.AddTenantMembersResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.AddTenantMembersResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if len(req.GetUserIds()) == 0 {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 400, Msg: "请指定要添加的用户"}, nil
+	}
+	userIDs := make([]int64, 0, len(req.GetUserIds()))
+	for _, id := range req.GetUserIds() {
+		uid, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return &v1.AddTenantMembersResponse{Result: false, Code: 400, Msg: "无效的用户ID: " + id}, nil
+		}
+		if !slices.Contains(userIDs, uid) {
+			userIDs = append(userIDs, uid)
+		}
+	}
+	labels, msg := normalizeRoleLabels(req.GetRoleLabels())
+	if msg != "" {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	if exist, err := s.client.Tenant.Query().Where(tenant.ID(tenantID)).Exist(ctx); err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	} else if !exist {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+	}
+	users, err := s.client.User.Query().
+		Where(user.IDIn(userIDs...), user.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "查询用户失败"}, nil
+	}
+	if len(users) != len(userIDs) {
+		for _, uid := range userIDs {
+			if !slices.ContainsFunc(users, func(u *ent.User) bool { return u.ID == uid }) {
+				return &v1.AddTenantMembersResponse{Result: false, Code: 404, Msg: "用户不存在: " + strconv.FormatInt(uid, 10)}, nil
+			}
+		}
+	}
+
+	var existing []int64
+	if err := s.client.UserTenant.Query().
+		Where(usertenant.TenantID(tenantID), usertenant.UserIDIn(userIDs...)).
+		Select(usertenant.FieldUserID).
+		Scan(ctx, &existing); err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "添加租户成员失败"}, nil
+	}
+	defer tx.Rollback()
+
+	operatorID := middleware.GetUserIDFromContext(ctx)
+	builders := make([]*ent.UserTenantCreate, 0, len(users))
+	added := make([]*ent.User, 0, len(users))
+	for _, u := range users {
+		if slices.Contains(existing, u.ID) {
+			continue
+		}
+		c := tx.UserTenant.Create().
+			SetUserID(u.ID).
+			SetTenantID(tenantID).
+			SetRoleLabels(labels)
+		if operatorID > 0 {
+			c.SetCreatedBy(operatorID)
+		}
+		builders = append(builders, c)
+		added = append(added, u)
+	}
+	if len(builders) == 0 {
+		return &v1.AddTenantMembersResponse{Result: true, Code: 200, Msg: "用户均已是该租户的成员", Members: []*v1.TenantMember{}, Skipped: int32(len(users))}, nil
+	}
+	var exceeded *quota.ExceededError
+	if err := quota.Check(ctx, tx.Client(), tenantID, quota.ResourceUsers, len(builders)); errors.As(err, &exceeded) {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 403, Msg: quotaExceededMsg(exceeded)}, nil
+	} else if err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "查询租户配额失败"}, nil
+	}
+	// 并发添加同一用户时由唯一索引兜底
+	if err := tx.UserTenant.CreateBulk(builders...).
+		OnConflictColumns(usertenant.FieldUserID, usertenant.FieldTenantID).
+		DoNothing().
+		Exec(ctx); err != nil {
+		logger.Errorf("添加租户成员失败: %v", err)
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "添加租户成员失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.AddTenantMembersResponse{Result: false, Code: 500, Msg: "添加租户成员失败"}, nil
+	}
+
+	members := make([]*v1.TenantMember, 0, len(added))
+	now := time.Now()
+	for _, u := range added {
+		m := &ent.UserTenant{UserID: u.ID, TenantID: tenantID, RoleLabels: labels, CreatedAt: now}
+		if operatorID > 0 {
+			m.CreatedBy = &operatorID
+		}
+		m.Edges.User = u
+		members = append(members, convertTenantMemberToProto(m))
+		s.publish(ctx, TopicTenantMemberAdded, &TenantMemberEvent{
+			TenantID:   tenantID,
+			UserID:     u.ID,
+			RoleLabels: labels,
+			OperatorID: operatorID,
+		})
+	}
+	return &v1.AddTenantMembersResponse{
+		Result:  true,
+		Code:    200,
+		Msg:     "添加成功",
+		Members: members,
+		Skipped: int32(len(users) - len(added)),
+	}, nil
+}
+
+// UpdateTenantMember 修改成员的身份标签
+func (s *TenantMemberService) UpdateTenantMember(ctx context.Context, req *v1.UpdateTenantMemberRequest) (*v1.UpdateTenantMemberResponse, error) {
+	tenantID, userID, msg := parseMemberKey(req.GetTenantId(), req.GetUserId())
+	if msg != "" {
+		return &v1.UpdateTenantMemberResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.UpdateTenantMemberResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	labels, msg := normalizeRoleLabels(req.GetRoleLabels())
+	if msg != "" {
+		return &v1.UpdateTenantMemberResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+
+	n, err := s.client.UserTenant.Update().
+		Where(usertenant.TenantID(tenantID), usertenant.UserID(userID)).
+		SetRoleLabels(labels).
+		Save(ctx)
+	if err != nil {
+		return &v1.UpdateTenantMemberResponse{Result: false, Code: 500, Msg: "修改租户成员失败"}, nil
+	}
+	if n == 0 {
+		return &v1.UpdateTenantMemberResponse{Result: false, Code: 404, Msg: "用户不是该租户的成员"}, nil
+	}
+	m, err := s.client.UserTenant.Query().
+		Where(usertenant.TenantID(tenantID), usertenant.UserID(userID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return &v1.UpdateTenantMemberResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+	return &v1.UpdateTenantMemberResponse{Result: true, Code: 200, Msg: "修改成功", Member: convertTenantMemberToProto(m)}, nil
+}
+
+// RemoveTenantMember 将用户移出租户
+// 同一事务中撤销其在该租户下的角色授予（含限时授予）和部门关系，平台级授予不受影响
+func (s *TenantMemberService) RemoveTenantMember(ctx context.Context, req *v1.RemoveTenantMemberRequest) (*v1.RemoveTenantMemberResponse, error) {
+	tenantID, userID, msg := parseMemberKey(req.GetTenantId(), req.GetUserId())
+	if msg != "" {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+
+	t, err := s.client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.RemoveTenantMemberResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+	if t.OwnerID == userID {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 400, Msg: "不能移除租户的拥有者"}, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "移除租户成员失败"}, nil
+	}
+	defer tx.Rollback()
+
+	n, err := tx.UserTenant.Delete().
+		Where(usertenant.TenantID(tenantID), usertenant.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "移除租户成员失败"}, nil
+	}
+	if n == 0 {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 404, Msg: "用户不是该租户的成员"}, nil
+	}
+	grants, err := tx.UserRole.Query().
+		Where(userrole.UserID(userID), userrole.TenantIDEQ(tenantID)).
+		WithRole().
+		All(ctx)
+	if err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "查询角色授予失败"}, nil
+	}
+	if _, err := tx.UserRole.Delete().
+		Where(userrole.UserID(userID), userrole.TenantIDEQ(tenantID)).
+		Exec(ctx); err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "撤销角色授予失败"}, nil
+	}
+	departments, err := tx.UserDepartment.Delete().
+		Where(userdepartment.UserID(userID), userdepartment.TenantID(tenantID)).
+		Exec(ctx)
+	if err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "删除部门关系失败"}, nil
+	}
+	if err := clearDepartmentLeader(ctx, tx, tenantID, userID); err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "撤销部门负责人失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.RemoveTenantMemberResponse{Result: false, Code: 500, Msg: "移除租户成员失败"}, nil
+	}
+
+	s.removeGrantRules(grants)
+	s.publish(ctx, TopicTenantMemberRemoved, &TenantMemberEvent{
+		TenantID:        tenantID,
+		UserID:          userID,
+		UserRoles:       len(grants),
+		UserDepartments: departments,
+		OperatorID:      middleware.GetUserIDFromContext(ctx),
+	})
+	return &v1.RemoveTenantMemberResponse{
+		Result:          true,
+		Code:            200,
+		Msg:             "移除成功",
+		UserRoles:       int32(len(grants)),
+		UserDepartments: int32(departments),
+	}, nil
+}
+
+// ListTenantMembers 获取租户的成员列表，按加入时间倒序
+func (s *TenantMemberService) ListTenantMembers(ctx context.Context, req *v1.ListTenantMembersRequest) (*v1.ListTenantMembersResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.ListTenantMembersResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.ListTenantMembersResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+
+	query := s.client.UserTenant.Query().
+		Where(usertenant.TenantID(tenantID))
+	userFilter := []predicate.User{user.DeletedAtIsNil()}
+	if kw := req.GetKeyword(); kw != "" {
+		userFilter = append(userFilter, user.Or(
+			user.UsernameContains(kw),
+			user.FullNameContains(kw),
+			user.EmailContains(kw),
+			user.PhoneContains(kw),
+		))
+	}
+	query.Where(usertenant.HasUserWith(userFilter...))
+	if label := req.GetRoleLabel(); label != "" {
+		query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(usertenant.FieldRoleLabels, label))
+		})
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return &v1.ListTenantMembersResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+	members, err := query.
+		WithUser().
+		Order(ent.Desc(usertenant.FieldCreatedAt), ent.Asc(usertenant.FieldUserID)).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListTenantMembersResponse{Result: false, Code: 500, Msg: "查询租户成员失败"}, nil
+	}
+
+	items := make([]*v1.TenantMember, 0, len(members))
+	for _, m := range members {
+		items = append(items, convertTenantMemberToProto(m))
+	}
+	return &v1.ListTenantMembersResponse{Result: true, Code: 200, Msg: "查询成功", Members: items, Total: int32(total)}, nil
+}
+
+// ListUserTenants 获取用户所属的租户，已删除的租户不返回，不可用的租户标记usable=false
+// user_id为me或调用者自己的ID，查询其他用户需要平台级角色
+func (s *TenantMemberService) ListUserTenants(ctx context.Context, req *v1.ListUserTenantsRequest) (*v1.ListUserTenantsResponse, error) {
+	var userID int64
+	if req.GetUserId() == "me" {
+		userID = middleware.GetUserIDFromContext(ctx)
+	} else {
+		uid, err := strconv.ParseInt(req.GetUserId(), 10, 64)
+		if err != nil {
+			return &v1.ListUserTenantsResponse{Result: false, Code: 400, Msg: "无效的用户ID"}, nil
+		}
+		userID = uid
+	}
+	if userID == 0 {
+		return &v1.ListUserTenantsResponse{Result: false, Code: 401, Msg: "未登录"}, nil
+	}
+	// 查询他人的所属租户需要平台级角色
+	if caller := middleware.GetUserIDFromContext(ctx); userID != caller {
+		platform, err := middleware.IsPlatformUser(ctx, s.client, caller)
+		if err != nil {
+			return &v1.ListUserTenantsResponse{Result: false, Code: 500, Msg: "查询用户角色失败"}, nil
+		}
+		if caller == 0 || !platform {
+			return &v1.ListUserTenantsResponse{Result: false, Code: 403, Msg: "只能查询自己所属的租户"}, nil
+		}
+	}
+
+	memberships, err := s.client.UserTenant.Query().
+		Where(usertenant.UserID(userID)).
+		WithTenant().
+		Order(ent.Asc(usertenant.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListUserTenantsResponse{Result: false, Code: 500, Msg: "查询所属租户失败"}, nil
+	}
+
+	now := time.Now()
+	items := make([]*v1.UserTenant, 0, len(memberships))
+	for _, m := range memberships {
+		t := m.Edges.Tenant
+		// 已删除的租户被查询拦截器过滤
+		if t == nil {
+			continue
+		}
+		item := &v1.UserTenant{
+			TenantId:   strconv.FormatInt(t.ID, 10),
+			Name:       t.Name,
+			Type:       t.Type.String(),
+			Status:     t.Status.String(),
+			RoleLabels: m.RoleLabels,
+			JoinedAt:   m.CreatedAt.Format(time.RFC3339),
+			IsOwner:    t.OwnerID == userID,
+		}
+		if t.Path != nil {
+			item.Path = *t.Path
+		}
+		if err := tenancy.Check(ctx, s.client, t.ID, now); err == nil {
+			item.Usable = true
+		}
+		items = append(items, item)
+	}
+	return &v1.ListUserTenantsResponse{Result: true, Code: 200, Msg: "查询成功", Tenants: items}, nil
+}
+
+// removeGrantRules 删除被撤销授予对应的g规则
+func (s *TenantMemberService) removeGrantRules(grants []*ent.UserRole) {
+	if s.enforcer == nil {
+		return
+	}
+	var rules [][]string
+	for _, ur := range grants {
+		if ur.Edges.Role == nil {
+			continue
+		}
+		rule := authz.GroupingRule(ur.UserID, ur.Edges.Role.Code, tenantIDOrZero(ur.TenantID))
+		if ok, _ := s.enforcer.HasGroupingPolicy(rule); ok {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) > 0 {
+		if _, err := s.enforcer.RemoveGroupingPolicies(rules); err != nil {
+			logger.Errorf("删除成员角色授予规则失败: %v", err)
+		}
+	}
+}
+
+func (s *TenantMemberService) publish(ctx context.Context, topic string, payload any) {
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, event.New(topic, payload))
+	}
+}
+
+// parseMemberKey 解析路径中的租户ID和用户ID，失败时返回提示
+func parseMemberKey(tenantID, userID string) (int64, int64, string) {
+	tid, err := strconv.ParseInt(tenantID, 10, 64)
+	if err != nil {
+		return 0, 0, "无效的租户ID"
+	}
+	uid, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return 0, 0, "无效的用户ID"
+	}
+	return tid, uid, ""
+}
+
+// normalizeRoleLabels 去除空白和重复的身份标签，超出限制时返回提示
+func normalizeRoleLabels(labels []string) ([]string, string) {
+	result := make([]string, 0, len(labels))
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		if l == "" || slices.Contains(result, l) {
+			continue
+		}
+		if len([]rune(l)) > maxRoleLabelChars {
+			return nil, "身份标签不能超过" + strconv.Itoa(maxRoleLabelChars) + "个字符"
+		}
+		result = append(result, l)
+	}
+	if len(result) > maxRoleLabels {
+		return nil, "身份标签不能超过" + strconv.Itoa(maxRoleLabels) + "个"
+	}
+	return result, ""
+}
+
+func convertTenantMemberToProto(m *ent.UserTenant) *v1.TenantMember {
+	member := &v1.TenantMember{
+		UserId:     strconv.FormatInt(m.UserID, 10),
+		TenantId:   strconv.FormatInt(m.TenantID, 10),
+		RoleLabels: m.RoleLabels,
+		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+	}
+	if m.CreatedBy != nil {
+		member.CreatedBy = strconv.FormatInt(*m.CreatedBy, 10)
+	}
+	if u := m.Edges.User; u != nil {
+		member.Username = u.Username
+		member.UserStatus = u.Status.String()
+		if u.Email != nil {
+			member.Email = *u.Email
+		}
+		if u.Phone != nil {
+			member.Phone = *u.Phone
+		}
+		if u.Avatar != nil {
+			member.Avatar = *u.Avatar
+		}
+		if u.FullName != nil {
+			member.Fullname = *u.FullName
+		}
+	}
+	return member
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/softdelete"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/logger"
+)
+
+// TopicTenantMoved 租户层级变更（移动或转换类型），订阅方需刷新依赖租户路径的缓存
+const TopicTenantMoved = "tenant.moved"
+
+// TenantMovedEvent 租户层级变更事件
+type TenantMovedEvent struct {
+	TenantID     int64  `json:"tenant_id"`
+	FromType     string `json:"from_type"`
+	ToType       string `json:"to_type"`
+	FromParentID int64  `json:"from_parent_id"`
+	ToParentID   int64  `json:"to_parent_id"`
+	FromPath     string `json:"from_path"`
+	ToPath       string `json:"to_path"`
+	Affected     int64  `json:"affected"`
+	OperatorID   int64  `json:"operator_id,omitempty"`
+}
+
+// 租户的层级字段受ent更新钩子保护，这里用原生SQL在同一事务中改写整棵子树
+const (
+	relocateTenantSQL = `UPDATE tenants SET type = $1, parent_id = $2, updated_by = $3, updated_at = now() WHERE id = $4`
+	// 子树中每个节点的路径把旧前缀替换为新前缀，层级按差值整体平移
+	rebaseSubtreeSQL = `UPDATE tenants SET
+	path = CASE WHEN path = $1::ltree THEN $2::ltree ELSE $2::ltree || subpath(path, nlevel($1::ltree)) END,
+	level = level + $3,
+	updated_at = now()
+WHERE path <@ $1::ltree`
+)
+
+// MoveTenant 将租户连同其子租户移动到新的父租户下
+func (s *TenantServiceImpl) MoveTenant(ctx context.Context, req *v1.MoveTenantRequest) (*v1.MoveTenantResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.MoveTenantResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	parentID, err := strconv.ParseInt(req.GetParentId(), 10, 64)
+	if err != nil {
+		return &v1.MoveTenantResponse{Result: false, Code: 400, Msg: "无效的父租户ID"}, nil
+	}
+
+	t, affected, code, msg := s.relocate(ctx, tenantID, nil, &parentID)
+	if code != 0 {
+		return &v1.MoveTenantResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	return &v1.MoveTenantResponse{
+		Result:   true,
+		Code:     200,
+		Msg:      "移动成功",
+		Tenant:   s.convertTenantToProto(t),
+		Affected: int32(affected),
+	}, nil
+}
+
+// ConvertTenantType 转换租户类型
+func (s *TenantServiceImpl) ConvertTenantType(ctx context.Context, req *v1.ConvertTenantTypeRequest) (*v1.ConvertTenantTypeResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.ConvertTenantTypeResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	pid, err := parseOptionalID(req.GetParentId())
+	if err != nil {
+		return &v1.ConvertTenantTypeResponse{Result: false, Code: 400, Msg: "无效的父租户ID"}, nil
+	}
+	typ := tenant.Type(req.GetType().String())
+	// GROUP、NORMAL固定挂在系统租户下，忽略传入的父租户
+	var parentID *int64
+	if typ == tenant.TypeSUB && pid > 0 {
+		parentID = &pid
+	}
+
+	t, affected, code, msg := s.relocate(ctx, tenantID, &typ, parentID)
+	if code != 0 {
+		return &v1.ConvertTenantTypeResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	return &v1.ConvertTenantTypeResponse{
+		Result:   true,
+		Code:     200,
+		Msg:      "转换成功",
+		Tenant:   s.convertTenantToProto(t),
+		Affected: int32(affected),
+	}, nil
+}
+
+// relocate 在一个事务中变更租户的类型和父租户，并改写整棵子树的path和level
+// typ为nil表示类型不变；parentID为nil时GROUP/NORMAL挂到系统租户下，SUB保持原父租户
+// 租户的RLS和角色授予都按tenant_id隔离，不受路径变化影响；TENANT_SUBTREE数据范围和上级租户状态判定按新路径生效
+// 变更租户层级只允许平台级用户执行
+func (s *TenantServiceImpl) relocate(ctx context.Context, tenantID int64, typ *tenant.Type, parentID *int64) (*ent.Tenant, int64, int32, string) {
+	if code, msg := checkPlatformUser(ctx, s.tenantService.client); code != 0 {
+		return nil, 0, code, msg
+	}
+	tx, err := s.tenantService.client.Tx(ctx)
+	if err != nil {
+		return nil, 0, 500, "变更租户层级失败"
+	}
+	defer tx.Rollback()
+
+	t, err := tx.Tenant.Query().
+		Where(tenant.ID(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 0, 404, "租户不存在"
+		}
+		return nil, 0, 500, "查询租户失败"
+	}
+	if t.Path == nil {
+		return nil, 0, 500, "租户缺少层级路径"
+	}
+	target := t.Type
+	if typ != nil {
+		target = *typ
+	}
+
+	parentQuery := tx.Tenant.Query()
+	switch {
+	case parentID != nil:
+		parentQuery.Where(tenant.ID(*parentID))
+	case target != tenant.TypeSUB:
+		parentQuery.Where(tenant.TypeEQ(tenant.TypeROOT))
+	case t.Type == tenant.TypeSUB && t.ParentID != nil:
+		parentQuery.Where(tenant.ID(*t.ParentID))
+	default:
+		return nil, 0, 400, "转换为子租户需要指定父租户"
+	}
+	parent, err := parentQuery.ForUpdate().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 0, 404, "父租户不存在"
+		}
+		return nil, 0, 500, "查询父租户失败"
+	}
+	if parent.Path == nil {
+		return nil, 0, 500, "父租户缺少层级路径"
+	}
+
+	// 已删除的子租户仍受tenant_type_check约束，一并计入
+	hasChildren, err := tx.Tenant.Query().Where(tenant.ParentID(t.ID)).Exist(softdelete.Skip(ctx))
+	if err != nil {
+		return nil, 0, 500, "查询子租户失败"
+	}
+	if err := tenancy.ValidatePlacement(t, target, parent, hasChildren); err != nil {
+		switch {
+		case errors.Is(err, tenancy.ErrRootTenant):
+			return nil, 0, 400, "系统租户(ROOT)禁止移动或转换"
+		case errors.Is(err, tenancy.ErrInvalidParent):
+			return nil, 0, 400, "子租户的父租户必须是集团型租户，集团型和普通租户只能挂在系统租户下"
+		case errors.Is(err, tenancy.ErrHasChildren):
+			return nil, 0, 400, "该租户下还有子租户，只能保持为集团型租户"
+		case errors.Is(err, tenancy.ErrMoveIntoSelf):
+			return nil, 0, 400, "不能移动到自身或其下级租户下"
+		case errors.Is(err, tenancy.ErrNoChange):
+			return nil, 0, 400, "租户已在目标位置"
+		}
+		return nil, 0, 400, err.Error()
+	}
+
+	var operatorID *int64
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		operatorID = &uid
+	}
+	if _, err := tx.ExecContext(ctx, relocateTenantSQL, target.String(), parent.ID, operatorID, t.ID); err != nil {
+		logger.Errorf("变更租户层级失败: %v", err)
+		return nil, 0, 500, "变更租户层级失败"
+	}
+	newPath := tenancy.ChildPath(*parent.Path, t.ID)
+	res, err := tx.ExecContext(ctx, rebaseSubtreeSQL, *t.Path, newPath, parent.Level+1-t.Level)
+	if err != nil {
+		logger.Errorf("改写租户子树路径失败: %v", err)
+		return nil, 0, 500, "变更租户层级失败"
+	}
+	affected, _ := res.RowsAffected()
+
+	updated, err := tx.Tenant.Get(ctx, t.ID)
+	if err != nil {
+		return nil, 0, 500, "查询租户失败"
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, 0, 500, "变更租户层级失败"
+	}
+
+	e := &TenantMovedEvent{
+		TenantID:   t.ID,
+		FromType:   t.Type.String(),
+		ToType:     target.String(),
+		ToParentID: parent.ID,
+		FromPath:   *t.Path,
+		ToPath:     newPath,
+		Affected:   affected,
+	}
+	if t.ParentID != nil {
+		e.FromParentID = *t.ParentID
+	}
+	if operatorID != nil {
+		e.OperatorID = *operatorID
+	}
+	s.publish(ctx, TopicTenantMoved, e)
+	logger.Infof("租户%d层级变更: %s -> %s，影响%d个租户", t.ID, *t.Path, newPath, affected)
+	return updated, affected, 0, ""
+}
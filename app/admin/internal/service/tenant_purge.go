@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/rls"
+	"github.com/yc-alpha/admin/common/softdelete"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/casbinrule"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/role"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/admin/ent/usertenant"
+	"github.com/yc-alpha/logger"
+)
+
+// 租户删除、恢复和清理事件主题
+const (
+	TopicTenantDeleted  = "tenant.deleted"  // 软删除，保留期内可恢复
+	TopicTenantRestored = "tenant.restored" // 从已删除状态恢复
+	TopicTenantPurged   = "tenant.purged"   // 超过保留期被彻底清理
+)
+
+// TenantDeletedEvent 租户删除或恢复事件
+type TenantDeletedEvent struct {
+	TenantID   int64      `json:"tenant_id"`
+	Name       string     `json:"name"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+	PurgeAt    *time.Time `json:"purge_at,omitempty"`
+	OperatorID int64      `json:"operator_id,omitempty"`
+}
+
+// PurgedTenant 被清理的租户及随之删除的数据量
+type PurgedTenant struct {
+	TenantID        int64     `json:"tenant_id"`
+	Name            string    `json:"name"`
+	Path            string    `json:"path"`
+	DeletedAt       time.Time `json:"deleted_at"`
+	Departments     int       `json:"departments"`
+	Roles           int       `json:"roles"`
+	Members         int       `json:"members"`
+	UserRoles       int       `json:"user_roles"`
+	UserDepartments int       `json:"user_departments"`
+	CasbinRules     int       `json:"casbin_rules"`
+	StatusLogs      int       `json:"status_logs"`
+}
+
+// SkippedTenant 到达保留期但未被清理的租户
+type SkippedTenant struct {
+	TenantID int64  `json:"tenant_id"`
+	Name     string `json:"name"`
+	Reason   string `json:"reason"`
+}
+
+// PurgeReport 一次清理的报告
+type PurgeReport struct {
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+	Cutoff     time.Time        `json:"cutoff"`
+	DryRun     bool             `json:"dry_run"`
+	Purged     []*PurgedTenant  `json:"purged"`
+	Skipped    []*SkippedTenant `json:"skipped"`
+}
+
+// TenantPurger 彻底删除超过保留期的已删除租户
+// 部门、角色、成员关系等由外键级联删除，Casbin规则按租户域单独清理
+type TenantPurger struct {
+	client    *ent.Client
+	enforcer  *casbin.SyncedEnforcer
+	publisher event.Publisher
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewTenantPurger 创建租户清理任务，retention<=0时不清理，未启用Casbin时enforcer传nil
+func NewTenantPurger(client *ent.Client, enforcer *casbin.SyncedEnforcer, publisher event.Publisher, retention, interval time.Duration) *TenantPurger {
+	return &TenantPurger{
+		client:    client,
+		enforcer:  enforcer,
+		publisher: publisher,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// PurgeAt 已删除租户将被清理的时间，未启用清理时返回nil
+func (p *TenantPurger) PurgeAt(deletedAt *time.Time) *time.Time {
+	if p == nil || p.retention <= 0 || deletedAt == nil {
+		return nil
+	}
+	at := deletedAt.Add(p.retention)
+	return &at
+}
+
+// Start 在后台按固定间隔执行，ctx取消后退出
+func (p *TenantPurger) Start(ctx context.Context) {
+	if p.retention <= 0 || p.interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				report, err := p.Purge(ctx, false)
+				if err != nil {
+					logger.Errorf("清理已删除租户失败: %v", err)
+				}
+				if report != nil && (len(report.Purged) > 0 || len(report.Skipped) > 0) {
+					logger.Infof("清理已删除租户：清理%d个，跳过%d个", len(report.Purged), len(report.Skipped))
+				}
+			}
+		}
+	}()
+}
+
+// Purge 执行一次清理，dryRun时只统计不删除
+// 下级租户先于上级租户处理，仍有下级租户（含未到保留期的已删除租户）的租户本次跳过
+func (p *TenantPurger) Purge(ctx context.Context, dryRun bool) (*PurgeReport, error) {
+	now := time.Now()
+	report := &PurgeReport{StartedAt: now, DryRun: dryRun, Purged: []*PurgedTenant{}, Skipped: []*SkippedTenant{}}
+	if p.retention <= 0 {
+		report.FinishedAt = time.Now()
+		return report, nil
+	}
+	report.Cutoff = now.Add(-p.retention)
+
+	// 清理跨所有租户进行，候选租户的查询也需要跳过行级安全
+	err := rls.WithBypass(ctx, p.client, func(ctx context.Context) error {
+		return p.purge(ctx, report, dryRun)
+	})
+	if err != nil {
+		return report, err
+	}
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// purge 按层级从下到上逐个清理超过保留期的租户
+func (p *TenantPurger) purge(ctx context.Context, report *PurgeReport, dryRun bool) error {
+	ctx = softdelete.Skip(ctx)
+	tenants, err := p.client.Tenant.Query().
+		Where(
+			tenant.DeletedAtNotNil(),
+			tenant.DeletedAtLTE(report.Cutoff),
+			tenant.TypeNEQ(tenant.TypeROOT),
+		).
+		Order(ent.Desc(tenant.FieldLevel), ent.Asc(tenant.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// dry run时不会真正删除，记录本次“已清理”的租户以便正确判断上级租户
+	purged := make(map[int64]bool)
+	for _, t := range tenants {
+		children, err := p.client.Tenant.Query().Where(tenant.ParentID(t.ID)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		remaining := 0
+		for _, id := range children {
+			if !purged[id] {
+				remaining++
+			}
+		}
+		if remaining > 0 {
+			report.Skipped = append(report.Skipped, &SkippedTenant{
+				TenantID: t.ID,
+				Name:     t.Name,
+				Reason:   fmt.Sprintf("仍有%d个下级租户", remaining),
+			})
+			continue
+		}
+
+		item, err := p.purgeTenant(ctx, t, dryRun)
+		if err != nil {
+			return fmt.Errorf("清理租户%d失败: %w", t.ID, err)
+		}
+		purged[t.ID] = true
+		report.Purged = append(report.Purged, item)
+		if !dryRun {
+			logger.Infof("已清理租户%d(%s)：部门%d，角色%d，成员%d，角色授予%d，部门成员%d，Casbin规则%d",
+				t.ID, t.Name, item.Departments, item.Roles, item.Members, item.UserRoles, item.UserDepartments, item.CasbinRules)
+			p.publish(ctx, TopicTenantPurged, item)
+		}
+	}
+	return nil
+}
+
+// purgeTenant 统计并删除单个租户的数据，每个租户使用独立的跳过行级安全的事务
+func (p *TenantPurger) purgeTenant(ctx context.Context, t *ent.Tenant, dryRun bool) (*PurgedTenant, error) {
+	tx, err := rls.BypassTx(ctx, p.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	item := &PurgedTenant{TenantID: t.ID, Name: t.Name, DeletedAt: *t.DeletedAt}
+	if t.Path != nil {
+		item.Path = *t.Path
+	}
+	counts := []struct {
+		dst   *int
+		count func(context.Context) (int, error)
+	}{
+		{&item.Departments, tx.Department.Query().Where(department.TenantID(t.ID)).Count},
+		{&item.Roles, tx.Role.Query().Where(role.TenantID(t.ID)).Count},
+		{&item.Members, tx.UserTenant.Query().Where(usertenant.TenantID(t.ID)).Count},
+		{&item.UserRoles, tx.UserRole.Query().Where(userrole.TenantID(t.ID)).Count},
+		{&item.UserDepartments, tx.UserDepartment.Query().Where(userdepartment.TenantID(t.ID)).Count},
+		{&item.StatusLogs, tx.TenantStatusLog.Query().Where(tenantstatuslog.TenantID(t.ID)).Count},
+		{&item.CasbinRules, tx.CasbinRule.Query().Where(domainRules(t.ID)).Count},
+	}
+	for _, c := range counts {
+		n, err := c.count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		*c.dst = n
+	}
+	if dryRun {
+		return item, nil
+	}
+
+	if err := tx.Tenant.DeleteOneID(t.ID).Exec(ctx); err != nil {
+		return nil, err
+	}
+	// 未启用Casbin时直接删除规则表中该租户域的记录
+	if p.enforcer == nil {
+		if _, err := tx.CasbinRule.Delete().Where(domainRules(t.ID)).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// 启用Casbin时通过enforcer删除，同时更新内存策略并通知其他实例
+	if p.enforcer != nil {
+		domain := authz.DomainOf(t.ID)
+		if _, err := p.enforcer.RemoveFilteredPolicy(1, domain); err != nil {
+			logger.Errorf("清理租户%d的Casbin策略失败: %v", t.ID, err)
+		}
+		if _, err := p.enforcer.RemoveFilteredGroupingPolicy(2, domain); err != nil {
+			logger.Errorf("清理租户%d的Casbin角色授予失败: %v", t.ID, err)
+		}
+	}
+	return item, nil
+}
+
+// domainRules 匹配租户域下的p规则（域在v1）和g规则（域在v2）
+func domainRules(tenantID int64) predicate.CasbinRule {
+	domain := authz.DomainOf(tenantID)
+	return casbinrule.Or(
+		casbinrule.And(casbinrule.Ptype("p"), casbinrule.V1(domain)),
+		casbinrule.And(casbinrule.Ptype("g"), casbinrule.V2(domain)),
+	)
+}
+
+func (p *TenantPurger) publish(ctx context.Context, topic string, payload any) {
+	if p.publisher != nil {
+		p.publisher.Publish(ctx, event.New(topic, payload))
+	}
+}
+
+// RestoreTenant 恢复已删除的租户
+func (s *TenantServiceImpl) RestoreTenant(ctx context.Context, req *v1.RestoreTenantRequest) (*v1.RestoreTenantResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &v1.RestoreTenantResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkPlatformUser(ctx, s.tenantService.client); code != 0 {
+		return &v1.RestoreTenantResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	// 已删除的租户默认被查询过滤，更新钩子也需要读取它
+	ctx = softdelete.Skip(ctx)
+	tx, err := s.tenantService.client.Tx(ctx)
+	if err != nil {
+		return &v1.RestoreTenantResponse{Result: false, Code: 500, Msg: "恢复租户失败"}, nil
+	}
+	defer tx.Rollback()
+
+	t, err := tx.Tenant.Query().
+		Where(tenant.ID(tenantID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.RestoreTenantResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		return &v1.RestoreTenantResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+	if t.DeletedAt == nil {
+		return &v1.RestoreTenantResponse{Result: false, Code: 400, Msg: "租户未被删除"}, nil
+	}
+	if purgeAt := s.purger.PurgeAt(t.DeletedAt); purgeAt != nil && !time.Now().Before(*purgeAt) {
+		return &v1.RestoreTenantResponse{Result: false, Code: 400, Msg: "租户已超过保留期，无法恢复"}, nil
+	}
+	if t.ParentID != nil {
+		parent, err := tx.Tenant.Get(ctx, *t.ParentID)
+		if err != nil {
+			return &v1.RestoreTenantResponse{Result: false, Code: 500, Msg: "查询父租户失败"}, nil
+		}
+		if parent.DeletedAt != nil {
+			return &v1.RestoreTenantResponse{Result: false, Code: 400, Msg: "父租户已被删除，请先恢复父租户"}, nil
+		}
+	}
+
+	updater := tx.Tenant.UpdateOneID(t.ID).ClearDeletedAt()
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		updater.SetUpdatedBy(uid)
+	}
+	restored, err := updater.Save(ctx)
+	if err != nil {
+		logger.Errorf("恢复租户失败: %v", err)
+		return &v1.RestoreTenantResponse{Result: false, Code: 500, Msg: "恢复租户失败"}, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.RestoreTenantResponse{Result: false, Code: 500, Msg: "恢复租户失败"}, nil
+	}
+
+	e := s.newTenantDeletedEvent(ctx, restored)
+	e.DeletedAt = t.DeletedAt
+	s.publish(ctx, TopicTenantRestored, e)
+	return &v1.RestoreTenantResponse{Result: true, Code: 200, Msg: "恢复成功", Tenant: s.convertTenantToProto(restored)}, nil
+}
+
+// ListDeletedTenants 获取已删除的租户列表，按删除时间倒序
+func (s *TenantServiceImpl) ListDeletedTenants(ctx context.Context, req *v1.ListDeletedTenantsRequest) (*v1.ListDeletedTenantsResponse, error) {
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+
+	query := s.tenantService.client.Tenant.Query().
+		Where(tenant.DeletedAtNotNil())
+	if req.GetKeyword() != "" {
+		query.Where(tenant.NameContains(req.GetKeyword()))
+	}
+	ctx = softdelete.Skip(ctx)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return &v1.ListDeletedTenantsResponse{Result: false, Code: 500, Msg: "查询已删除租户失败"}, nil
+	}
+	tenants, err := query.
+		Order(ent.Desc(tenant.FieldDeletedAt)).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListDeletedTenantsResponse{Result: false, Code: 500, Msg: "查询已删除租户失败"}, nil
+	}
+
+	items := make([]*v1.Tenant, 0, len(tenants))
+	for _, t := range tenants {
+		items = append(items, s.convertTenantToProto(t))
+	}
+	return &v1.ListDeletedTenantsResponse{Result: true, Code: 200, Msg: "查询成功", Tenants: items, Total: int32(total)}, nil
+}
+
+// PurgeDeletedTenants 立即清理超过保留期的已删除租户
+func (s *TenantServiceImpl) PurgeDeletedTenants(ctx context.Context, req *v1.PurgeDeletedTenantsRequest) (*v1.PurgeDeletedTenantsResponse, error) {
+	if code, msg := checkPlatformUser(ctx, s.tenantService.client); code != 0 {
+		return &v1.PurgeDeletedTenantsResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if s.purger == nil || s.purger.retention <= 0 {
+		return &v1.PurgeDeletedTenantsResponse{Result: false, Code: 400, Msg: "未配置租户保留期，不清理已删除租户"}, nil
+	}
+	report, err := s.purger.Purge(ctx, req.GetDryRun())
+	if err != nil {
+		logger.Errorf("清理已删除租户失败: %v", err)
+		if report == nil {
+			return &v1.PurgeDeletedTenantsResponse{Result: false, Code: 500, Msg: "清理已删除租户失败"}, nil
+		}
+		// 出错前已清理的租户仍然返回
+		return &v1.PurgeDeletedTenantsResponse{Result: false, Code: 500, Msg: "清理已删除租户失败", Report: purgeReportToProto(report)}, nil
+	}
+	return &v1.PurgeDeletedTenantsResponse{Result: true, Code: 200, Msg: "清理完成", Report: purgeReportToProto(report)}, nil
+}
+
+func (s *TenantServiceImpl) newTenantDeletedEvent(ctx context.Context, t *ent.Tenant) *TenantDeletedEvent {
+	return &TenantDeletedEvent{
+		TenantID:   t.ID,
+		Name:       t.Name,
+		DeletedAt:  t.DeletedAt,
+		PurgeAt:    s.purger.PurgeAt(t.DeletedAt),
+		OperatorID: middleware.GetUserIDFromContext(ctx),
+	}
+}
+
+func purgeReportToProto(r *PurgeReport) *v1.TenantPurgeReport {
+	report := &v1.TenantPurgeReport{
+		StartedAt:  r.StartedAt.Format(time.RFC3339),
+		FinishedAt: r.FinishedAt.Format(time.RFC3339),
+		DryRun:     r.DryRun,
+	}
+	if !r.Cutoff.IsZero() {
+		report.Cutoff = r.Cutoff.Format(time.RFC3339)
+	}
+	for _, t := range r.Purged {
+		report.Purged = append(report.Purged, &v1.PurgedTenant{
+			Id:              strconv.FormatInt(t.TenantID, 10),
+			Name:            t.Name,
+			Path:            t.Path,
+			DeletedAt:       t.DeletedAt.Format(time.RFC3339),
+			Departments:     int32(t.Departments),
+			Roles:           int32(t.Roles),
+			Members:         int32(t.Members),
+			UserRoles:       int32(t.UserRoles),
+			UserDepartments: int32(t.UserDepartments),
+			CasbinRules:     int32(t.CasbinRules),
+			StatusLogs:      int32(t.StatusLogs),
+		})
+	}
+	for _, t := range r.Skipped {
+		report.Skipped = append(report.Skipped, &v1.SkippedTenant{
+			Id:     strconv.FormatInt(t.TenantID, 10),
+			Name:   t.Name,
+			Reason: t.Reason,
+		})
+	}
+	return report
+}
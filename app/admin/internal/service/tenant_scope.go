@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/ent"
+)
+
+// checkTenantScope 校验调用者能否操作tenantID下的数据，code为0表示通过
+// 租户下只能操作当前租户（x-tenant-id）；平台级（tenantID为0）或其他租户的操作需要平台级角色
+func checkTenantScope(ctx context.Context, client *ent.Client, tenantID int64) (int32, string) {
+	if tenantID > 0 && tenantID == middleware.GetTenantIDFromContext(ctx) {
+		return 0, ""
+	}
+	caller := middleware.GetUserIDFromContext(ctx)
+	if caller == 0 {
+		return 401, "未登录"
+	}
+	platform, err := middleware.IsPlatformUser(ctx, client, caller)
+	if err != nil {
+		return 500, "查询用户角色失败"
+	}
+	if !platform {
+		if tenantID == 0 {
+			return 403, "平台级操作需要平台级角色"
+		}
+		return 403, "无权操作该租户"
+	}
+	return 0, ""
+}
+
+// requestTenant 以当前租户上下文作为操作租户，请求中的租户ID只能为空或与之一致，随后按checkTenantScope校验
+// code为0表示通过
+func requestTenant(ctx context.Context, client *ent.Client, raw string) (int64, int32, string) {
+	tenantID, err := parseOptionalID(raw)
+	if err != nil {
+		return 0, 400, "无效的租户ID"
+	}
+	current := middleware.GetTenantIDFromContext(ctx)
+	if raw != "" && tenantID != current {
+		return 0, 403, "租户ID与当前租户不一致"
+	}
+	if code, msg := checkTenantScope(ctx, client, current); code != 0 {
+		return 0, code, msg
+	}
+	return current, 0, ""
+}
+
+// checkPlatformUser 租户状态、层级、恢复和清理等平台管理操作只允许平台级用户执行，code为0表示通过
+func checkPlatformUser(ctx context.Context, client *ent.Client) (int32, string) {
+	return checkTenantScope(ctx, client, 0)
+}
@@ -1,12 +1,17 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/middleware"
 	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
 	"github.com/yc-alpha/logger"
 )
 
@@ -14,12 +19,17 @@ import (
 type TenantServiceImpl struct {
 	v1.UnimplementedTenantServiceServer
 	tenantService *TenantService
+	publisher     event.Publisher
+	purger        *TenantPurger
 }
 
-// NewTenantServiceImpl 创建租户服务实现
-func NewTenantServiceImpl(client *ent.Client) *TenantServiceImpl {
+// NewTenantServiceImpl 创建租户服务实现，publisher用于发布租户生命周期事件，可为nil
+// purger负责已删除租户的保留期计算和清理
+func NewTenantServiceImpl(client *ent.Client, publisher event.Publisher, purger *TenantPurger) *TenantServiceImpl {
 	return &TenantServiceImpl{
 		tenantService: NewTenantService(client),
+		publisher:     publisher,
+		purger:        purger,
 	}
 }
 
@@ -49,7 +59,9 @@ func (s *TenantServiceImpl) CreateTenant(ctx context.Context, req *v1.CreateTena
 	}
 
 	var createdBy *int64
-	if req.CreatedBy != "" {
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		createdBy = &uid
+	} else if req.CreatedBy != "" {
 		cb, err := strconv.ParseInt(req.CreatedBy, 10, 64)
 		if err != nil {
 			return &v1.CreateTenantResponse{
@@ -61,30 +73,54 @@ func (s *TenantServiceImpl) CreateTenant(ctx context.Context, req *v1.CreateTena
 		createdBy = &cb
 	}
 
+	// 新租户只能是待激活或已激活，其余状态通过生命周期接口变更
+	if req.Status != v1.TenantStatus_TENANT_PENDING && req.Status != v1.TenantStatus_TENANT_ACTIVE {
+		return &v1.CreateTenantResponse{
+			Result: false,
+			Code:   400,
+			Msg:    "新建租户的状态只能是待激活或已激活",
+		}, nil
+	}
+
 	// 转换属性
 	attributes := make(map[string]any)
 	for k, v := range req.Attributes {
 		attributes[k] = v
 	}
 
+	planID, err := parseOptionalID(req.PlanId)
+	if err != nil {
+		return &v1.CreateTenantResponse{
+			Result: false,
+			Code:   400,
+			Msg:    "无效的套餐ID",
+		}, nil
+	}
+
 	// 创建租户请求
 	createReq := &CreateTenantRequest{
 		Name:       req.Name,
 		OwnerID:    ownerID,
 		Type:       TenantType(req.Type.String()),
 		ParentID:   parentID,
-		Status:     req.Status.String(),
+		Status:     tenantStatusFromProto(req.Status).String(),
 		Attributes: attributes,
 		CreatedBy:  createdBy,
 	}
+	if planID > 0 {
+		createReq.PlanID = &planID
+	}
 
 	// 处理过期时间
 	if req.ExpiredAt != "" {
 		expiredAt, err := time.Parse(time.RFC3339, req.ExpiredAt)
-		if err == nil {
-			expiredAtStr := expiredAt.Format(time.RFC3339)
-			createReq.ExpiredAt = &expiredAtStr
+		if err != nil {
+			return &v1.CreateTenantResponse{Result: false, Code: 400, Msg: "无效的到期时间"}, nil
+		}
+		if !expiredAt.After(time.Now()) {
+			return &v1.CreateTenantResponse{Result: false, Code: 400, Msg: "到期时间必须晚于当前时间"}, nil
 		}
+		createReq.ExpiredAt = &expiredAt
 	}
 
 	// 调用服务创建租户
@@ -101,7 +137,7 @@ func (s *TenantServiceImpl) CreateTenant(ctx context.Context, req *v1.CreateTena
 	if !resp.Success {
 		return &v1.CreateTenantResponse{
 			Result: false,
-			Code:   400,
+			Code:   cmp.Or(resp.Code, 400),
 			Msg:    resp.Message,
 		}, nil
 	}
@@ -272,30 +308,6 @@ func (s *TenantServiceImpl) ListGroupTenants(ctx context.Context, req *v1.ListGr
 	}, nil
 }
 
-// GetTenantStatistics 获取租户统计信息
-func (s *TenantServiceImpl) GetTenantStatistics(ctx context.Context, req *v1.GetTenantStatisticsRequest) (*v1.GetTenantStatisticsResponse, error) {
-	stats, err := s.tenantService.GetTenantStatistics(ctx)
-	if err != nil {
-		return &v1.GetTenantStatisticsResponse{
-			Result: false,
-			Code:   500,
-			Msg:    "获取统计信息失败",
-		}, nil
-	}
-
-	statistics := make(map[string]int32)
-	for k, v := range stats {
-		statistics[k] = int32(v)
-	}
-
-	return &v1.GetTenantStatisticsResponse{
-		Result:     true,
-		Code:       200,
-		Msg:        "查询成功",
-		Statistics: statistics,
-	}, nil
-}
-
 // UpdateTenant 更新租户
 func (s *TenantServiceImpl) UpdateTenant(ctx context.Context, req *v1.UpdateTenantRequest) (*v1.UpdateTenantResponse, error) {
 	tenantID, err := strconv.ParseInt(req.Id, 10, 64)
@@ -325,16 +337,21 @@ func (s *TenantServiceImpl) UpdateTenant(ctx context.Context, req *v1.UpdateTena
 		}
 		updater.SetOwnerID(ownerID)
 	}
-	// TODO:此处报错，待修复
-	// if req.Status != v1.TenantStatus_TENANT_STATUS_UNSPECIFIED {
-	// 	updater.SetStatus(tenant.Status(req.Status.String()))
-	// }
-
+	// 状态和到期时间只能通过生命周期接口变更，以便校验和审计
+	// TENANT_PENDING为枚举零值，视为未传入
+	if req.Status != v1.TenantStatus_TENANT_PENDING {
+		return &v1.UpdateTenantResponse{
+			Result: false,
+			Code:   400,
+			Msg:    "请通过激活、停用、过期接口变更租户状态",
+		}, nil
+	}
 	if req.ExpiredAt != "" {
-		expiredAt, err := time.Parse(time.RFC3339, req.ExpiredAt)
-		if err == nil {
-			updater.SetExpiredAt(expiredAt)
-		}
+		return &v1.UpdateTenantResponse{
+			Result: false,
+			Code:   400,
+			Msg:    "请通过续期接口变更到期时间",
+		}, nil
 	}
 
 	if req.Attributes != nil {
@@ -345,7 +362,9 @@ func (s *TenantServiceImpl) UpdateTenant(ctx context.Context, req *v1.UpdateTena
 		updater.SetAttributes(attributes)
 	}
 
-	if req.UpdatedBy != "" {
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		updater.SetUpdatedBy(uid)
+	} else if req.UpdatedBy != "" {
 		updatedBy, err := strconv.ParseInt(req.UpdatedBy, 10, 64)
 		if err == nil {
 			updater.SetUpdatedBy(updatedBy)
@@ -355,6 +374,14 @@ func (s *TenantServiceImpl) UpdateTenant(ctx context.Context, req *v1.UpdateTena
 	// 执行更新
 	updatedTenant, err := updater.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.UpdateTenantResponse{
+				Result: false,
+				Code:   404,
+				Msg:    "租户不存在",
+			}, nil
+		}
+		logger.Errorf("更新租户失败: %v", err)
 		return &v1.UpdateTenantResponse{
 			Result: false,
 			Code:   500,
@@ -382,9 +409,18 @@ func (s *TenantServiceImpl) DeleteTenant(ctx context.Context, req *v1.DeleteTena
 		}, nil
 	}
 
-	// 检查是否有子租户
-	subTenants, err := s.tenantService.GetSubTenants(ctx, tenantID)
-	if err == nil && len(subTenants) > 0 {
+	// 检查是否有子租户，不受数据范围限制
+	hasChildren, err := s.tenantService.client.Tenant.Query().
+		Where(tenant.ParentID(tenantID)).
+		Exist(ctx)
+	if err != nil {
+		return &v1.DeleteTenantResponse{
+			Result: false,
+			Code:   500,
+			Msg:    "查询子租户失败",
+		}, nil
+	}
+	if hasChildren {
 		return &v1.DeleteTenantResponse{
 			Result: false,
 			Code:   400,
@@ -392,18 +428,29 @@ func (s *TenantServiceImpl) DeleteTenant(ctx context.Context, req *v1.DeleteTena
 		}, nil
 	}
 
-	// 执行软删除
-	err = s.tenantService.client.Tenant.UpdateOneID(tenantID).
-		SetDeletedAt(time.Now()).
-		Exec(ctx)
-
+	// 执行软删除，保留期内可以恢复
+	updater := s.tenantService.client.Tenant.UpdateOneID(tenantID).
+		SetDeletedAt(time.Now())
+	if uid := middleware.GetUserIDFromContext(ctx); uid > 0 {
+		updater.SetUpdatedBy(uid)
+	}
+	deleted, err := updater.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.DeleteTenantResponse{
+				Result: false,
+				Code:   404,
+				Msg:    "租户不存在",
+			}, nil
+		}
+		logger.Errorf("删除租户失败: %v", err)
 		return &v1.DeleteTenantResponse{
 			Result: false,
 			Code:   500,
 			Msg:    "删除租户失败",
 		}, nil
 	}
+	s.publish(ctx, TopicTenantDeleted, s.newTenantDeletedEvent(ctx, deleted))
 
 	return &v1.DeleteTenantResponse{
 		Result: true,
@@ -420,16 +467,27 @@ func (s *TenantServiceImpl) convertTenantToProto(t *ent.Tenant) *v1.Tenant {
 		OwnerId:   strconv.FormatInt(t.OwnerID, 10),
 		Type:      v1.TenantType(v1.TenantType_value[t.Type.String()]),
 		Level:     int32(t.Level),
-		Status:    v1.TenantStatus(v1.TenantStatus_value[t.Status.String()]),
+		Status:    tenantStatusToProto(t.Status),
 		CreatedAt: t.CreatedAt.Format(time.RFC3339),
 		UpdatedAt: t.UpdatedAt.Format(time.RFC3339),
 		Deleted:   t.DeletedAt != nil,
 	}
 
+	if t.DeletedAt != nil {
+		tenantProto.DeletedAt = t.DeletedAt.Format(time.RFC3339)
+	}
+	if purgeAt := s.purger.PurgeAt(t.DeletedAt); purgeAt != nil {
+		tenantProto.PurgeAt = purgeAt.Format(time.RFC3339)
+	}
+
 	if t.ParentID != nil {
 		tenantProto.ParentId = strconv.FormatInt(*t.ParentID, 10)
 	}
 
+	if t.PlanID != nil {
+		tenantProto.PlanId = strconv.FormatInt(*t.PlanID, 10)
+	}
+
 	if t.Path != nil {
 		tenantProto.Path = *t.Path
 	}
@@ -468,3 +526,13 @@ func (s *TenantServiceImpl) convertTenantToProto(t *ent.Tenant) *v1.Tenant {
 
 	return tenantProto
 }
+
+// tenantStatusToProto 实体状态（ACTIVE）转换为Proto枚举（TENANT_ACTIVE）
+func tenantStatusToProto(status tenant.Status) v1.TenantStatus {
+	return v1.TenantStatus(v1.TenantStatus_value["TENANT_"+status.String()])
+}
+
+// tenantStatusFromProto Proto枚举（TENANT_ACTIVE）转换为实体状态（ACTIVE）
+func tenantStatusFromProto(status v1.TenantStatus) tenant.Status {
+	return tenant.Status(strings.TrimPrefix(status.String(), "TENANT_"))
+}
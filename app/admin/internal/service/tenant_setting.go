@@ -0,0 +1,325 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/event"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/settings"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/logger"
+)
+
+// TopicTenantSettingsChanged 租户设置变更，下级租户继承的值可能随之变化，订阅方需刷新该租户子树的设置缓存
+const TopicTenantSettingsChanged = "tenant.settings_changed"
+
+// TenantSettingsChangedEvent 租户设置变更事件
+type TenantSettingsChangedEvent struct {
+	TenantID   int64    `json:"tenant_id"`
+	Keys       []string `json:"keys"`
+	OperatorID int64    `json:"operator_id,omitempty"`
+}
+
+// SettingService 租户设置，未设置的项沿租户路径继承上级租户（集团、系统租户）的值或使用默认值
+type SettingService struct {
+	v1.UnimplementedSettingServiceServer
+	client    *ent.Client
+	publisher event.Publisher
+}
+
+func NewSettingService(client *ent.Client, publisher event.Publisher) *SettingService {
+	return &SettingService{client: client, publisher: publisher}
+}
+
+// ListSettingDefinitions 获取所有设置项的定义和默认值
+func (s *SettingService) ListSettingDefinitions(ctx context.Context, _ *v1.ListSettingDefinitionsRequest) (*v1.ListSettingDefinitionsResponse, error) {
+	defs := settings.Definitions()
+	items := make([]*v1.SettingDefinition, 0, len(defs))
+	for _, d := range defs {
+		items = append(items, &v1.SettingDefinition{
+			Key:          d.Key,
+			Group:        d.Group,
+			Description:  d.Description,
+			Kind:         string(d.Kind),
+			DefaultValue: encodeSettingValue(d.Default),
+		})
+	}
+	return &v1.ListSettingDefinitionsResponse{Result: true, Code: 200, Msg: "查询成功", Definitions: items}, nil
+}
+
+// GetTenantSettings 获取租户所有设置项的生效值
+func (s *SettingService) GetTenantSettings(ctx context.Context, req *v1.GetTenantSettingsRequest) (*v1.GetTenantSettingsResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.GetTenantSettingsResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.GetTenantSettingsResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	values, err := settings.Effective(ctx, s.client, tenantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.GetTenantSettingsResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		logger.Errorf("查询租户设置失败: %v", err)
+		return &v1.GetTenantSettingsResponse{Result: false, Code: 500, Msg: "查询租户设置失败"}, nil
+	}
+	return &v1.GetTenantSettingsResponse{Result: true, Code: 200, Msg: "查询成功", Settings: convertSettingValuesToProto(tenantID, values)}, nil
+}
+
+// UpdateTenantSettings 修改租户设置，任一值校验失败时都不保存；与当前值相同的项不记录变更
+func (s *SettingService) UpdateTenantSettings(ctx context.Context, req *v1.UpdateTenantSettingsRequest) (*v1.UpdateTenantSettingsResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	if len(req.GetValues()) == 0 {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 400, Msg: "设置值不能为空"}, nil
+	}
+	// 校验后重新编码，保存的值格式统一
+	values := make(map[string]json.RawMessage, len(req.GetValues()))
+	for key, raw := range req.GetValues() {
+		v, err := settings.Validate(key, json.RawMessage(raw))
+		if err != nil {
+			if errors.Is(err, settings.ErrUnknownKey) {
+				return &v1.UpdateTenantSettingsResponse{Result: false, Code: 400, Msg: "未知的设置项: " + key}, nil
+			}
+			return &v1.UpdateTenantSettingsResponse{Result: false, Code: 400, Msg: "设置值不合法: " + err.Error()}, nil
+		}
+		values[key] = json.RawMessage(encodeSettingValue(v))
+	}
+	operatorID := middleware.GetUserIDFromContext(ctx)
+	var operator *int64
+	if operatorID > 0 {
+		operator = &operatorID
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 500, Msg: "修改租户设置失败"}, nil
+	}
+	defer tx.Rollback()
+	// 锁定租户，同一租户的设置修改依次执行，变更记录中的旧值准确
+	if _, err := tx.Tenant.Query().Where(tenant.ID(tenantID)).ForUpdate().Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.UpdateTenantSettingsResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+	existing, err := tx.TenantSetting.Query().
+		Where(tenantsetting.TenantID(tenantID), tenantsetting.KeyIn(slices.Collect(maps.Keys(values))...)).
+		All(ctx)
+	if err != nil {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 500, Msg: "修改租户设置失败"}, nil
+	}
+	current := make(map[string]*ent.TenantSetting, len(existing))
+	for _, e := range existing {
+		current[e.Key] = e
+	}
+
+	changed := make([]string, 0, len(values))
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		value := values[key]
+		old := current[key]
+		if old != nil && bytes.Equal(old.Value, value) {
+			continue
+		}
+		if old != nil {
+			err = tx.TenantSetting.UpdateOne(old).SetValue(value).SetNillableUpdatedBy(operator).Exec(ctx)
+		} else {
+			err = tx.TenantSetting.Create().SetTenantID(tenantID).SetKey(key).SetValue(value).SetNillableUpdatedBy(operator).Exec(ctx)
+		}
+		if err == nil {
+			log := tx.TenantSettingLog.Create().
+				SetTenantID(tenantID).
+				SetKey(key).
+				SetAction(tenantsettinglog.ActionSET).
+				SetNewValue(value).
+				SetReason(req.GetReason()).
+				SetNillableOperatorID(operator)
+			if old != nil {
+				log.SetOldValue(old.Value)
+			}
+			err = log.Exec(ctx)
+		}
+		if err != nil {
+			logger.Errorf("保存租户设置失败: %v", err)
+			return &v1.UpdateTenantSettingsResponse{Result: false, Code: 500, Msg: "修改租户设置失败"}, nil
+		}
+		changed = append(changed, key)
+	}
+	if err := tx.Commit(); err != nil {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 500, Msg: "修改租户设置失败"}, nil
+	}
+	if len(changed) > 0 {
+		s.publish(ctx, TopicTenantSettingsChanged, &TenantSettingsChangedEvent{TenantID: tenantID, Keys: changed, OperatorID: operatorID})
+	}
+
+	effective, err := settings.Effective(ctx, s.client, tenantID)
+	if err != nil {
+		return &v1.UpdateTenantSettingsResponse{Result: false, Code: 500, Msg: "查询租户设置失败"}, nil
+	}
+	return &v1.UpdateTenantSettingsResponse{Result: true, Code: 200, Msg: "修改成功", Settings: convertSettingValuesToProto(tenantID, effective)}, nil
+}
+
+// ResetTenantSetting 删除租户自身的设置值，恢复继承上级租户的值或默认值
+func (s *SettingService) ResetTenantSetting(ctx context.Context, req *v1.ResetTenantSettingRequest) (*v1.ResetTenantSettingResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.ResetTenantSettingResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.ResetTenantSettingResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	key := req.GetKey()
+	if _, ok := settings.Lookup(key); !ok {
+		return &v1.ResetTenantSettingResponse{Result: false, Code: 400, Msg: "未知的设置项: " + key}, nil
+	}
+	operatorID := middleware.GetUserIDFromContext(ctx)
+	var operator *int64
+	if operatorID > 0 {
+		operator = &operatorID
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "恢复设置失败"}, nil
+	}
+	defer tx.Rollback()
+	if _, err := tx.Tenant.Query().Where(tenant.ID(tenantID)).ForUpdate().Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &v1.ResetTenantSettingResponse{Result: false, Code: 404, Msg: "租户不存在"}, nil
+		}
+		return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "查询租户失败"}, nil
+	}
+	old, err := tx.TenantSetting.Query().
+		Where(tenantsetting.TenantID(tenantID), tenantsetting.Key(key)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "恢复设置失败"}, nil
+	}
+	// 租户未设置该项时已是继承值，不需要变更
+	if old != nil {
+		if err := tx.TenantSetting.DeleteOne(old).Exec(ctx); err != nil {
+			return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "恢复设置失败"}, nil
+		}
+		err := tx.TenantSettingLog.Create().
+			SetTenantID(tenantID).
+			SetKey(key).
+			SetAction(tenantsettinglog.ActionRESET).
+			SetOldValue(old.Value).
+			SetReason(req.GetReason()).
+			SetNillableOperatorID(operator).
+			Exec(ctx)
+		if err != nil {
+			logger.Errorf("记录租户设置变更失败: %v", err)
+			return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "恢复设置失败"}, nil
+		}
+		if err := tx.Commit(); err != nil {
+			return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "恢复设置失败"}, nil
+		}
+		s.publish(ctx, TopicTenantSettingsChanged, &TenantSettingsChangedEvent{TenantID: tenantID, Keys: []string{key}, OperatorID: operatorID})
+	}
+
+	effective, err := settings.Effective(ctx, s.client, tenantID)
+	if err != nil {
+		return &v1.ResetTenantSettingResponse{Result: false, Code: 500, Msg: "查询租户设置失败"}, nil
+	}
+	return &v1.ResetTenantSettingResponse{Result: true, Code: 200, Msg: "恢复成功", Setting: convertSettingValueToProto(tenantID, effective[key])}, nil
+}
+
+// ListTenantSettingHistory 获取租户设置的变更记录，按时间倒序
+func (s *SettingService) ListTenantSettingHistory(ctx context.Context, req *v1.ListTenantSettingHistoryRequest) (*v1.ListTenantSettingHistoryResponse, error) {
+	tenantID, err := strconv.ParseInt(req.GetTenantId(), 10, 64)
+	if err != nil {
+		return &v1.ListTenantSettingHistoryResponse{Result: false, Code: 400, Msg: "无效的租户ID"}, nil
+	}
+	if code, msg := checkTenantScope(ctx, s.client, tenantID); code != 0 {
+		return &v1.ListTenantSettingHistoryResponse{Result: false, Code: code, Msg: msg}, nil
+	}
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+
+	query := s.client.TenantSettingLog.Query().
+		Where(tenantsettinglog.TenantID(tenantID))
+	if req.GetKey() != "" {
+		query.Where(tenantsettinglog.Key(req.GetKey()))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return &v1.ListTenantSettingHistoryResponse{Result: false, Code: 500, Msg: "查询设置变更记录失败"}, nil
+	}
+	logs, err := query.
+		Order(ent.Desc(tenantsettinglog.FieldCreatedAt)).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return &v1.ListTenantSettingHistoryResponse{Result: false, Code: 500, Msg: "查询设置变更记录失败"}, nil
+	}
+
+	items := make([]*v1.TenantSettingLog, 0, len(logs))
+	for _, l := range logs {
+		item := &v1.TenantSettingLog{
+			Id:        strconv.FormatInt(l.ID, 10),
+			Key:       l.Key,
+			Action:    l.Action.String(),
+			OldValue:  string(l.OldValue),
+			NewValue:  string(l.NewValue),
+			Reason:    l.Reason,
+			CreatedAt: l.CreatedAt.Format(time.RFC3339),
+		}
+		if l.OperatorID != nil {
+			item.OperatorId = strconv.FormatInt(*l.OperatorID, 10)
+		}
+		items = append(items, item)
+	}
+	return &v1.ListTenantSettingHistoryResponse{Result: true, Code: 200, Msg: "查询成功", Logs: items, Total: int32(total)}, nil
+}
+
+func (s *SettingService) publish(ctx context.Context, topic string, payload any) {
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, event.New(topic, payload))
+	}
+}
+
+// encodeSettingValue 设置值的JSON编码，值均来自Parse或默认值，编码不会失败
+func encodeSettingValue(v any) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func convertSettingValueToProto(tenantID int64, v settings.Value) *v1.TenantSettingValue {
+	item := &v1.TenantSettingValue{
+		Key:        v.Key,
+		Value:      encodeSettingValue(v.Value),
+		Overridden: v.Overridden(tenantID),
+	}
+	if v.Source != 0 {
+		item.SourceTenantId = strconv.FormatInt(v.Source, 10)
+	}
+	return item
+}
+
+// convertSettingValuesToProto 按设置项定义的顺序返回
+func convertSettingValuesToProto(tenantID int64, values map[string]settings.Value) []*v1.TenantSettingValue {
+	items := make([]*v1.TenantSettingValue, 0, len(values))
+	for _, d := range settings.Definitions() {
+		items = append(items, convertSettingValueToProto(tenantID, values[d.Key]))
+	}
+	return items
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/logger"
+)
+
+// 租户统计查询，每项统计一次查询完成，不随租户数量增加查询次数
+const (
+	// 集团型租户的子树统计，沿ltree路径包含集团本身及所有下级租户
+	groupStatisticsSQL = `SELECT g.id, g.name,
+	(SELECT count(*) FROM tenants t
+		WHERE t.path <@ g.path AND t.id <> g.id AND t.deleted_at IS NULL) AS sub_tenants,
+	(SELECT count(DISTINCT ut.user_id) FROM user_tenants ut JOIN tenants t ON t.id = ut.tenant_id
+		WHERE t.path <@ g.path AND t.deleted_at IS NULL) AS members,
+	(SELECT count(*) FROM departments d JOIN tenants t ON t.id = d.tenant_id
+		WHERE t.path <@ g.path AND t.deleted_at IS NULL AND d.deleted_at IS NULL) AS departments
+FROM tenants g
+WHERE g.type = 'GROUP' AND g.deleted_at IS NULL
+ORDER BY g.path`
+
+	// 各租户的成员和部门数，按成员数倒序分页
+	tenantCountsSQL = `SELECT t.id, t.name, t.type, t.status,
+	coalesce(m.n, 0) AS members,
+	coalesce(d.n, 0) AS departments
+FROM tenants t
+LEFT JOIN (SELECT tenant_id, count(*) AS n FROM user_tenants GROUP BY tenant_id) m ON m.tenant_id = t.id
+LEFT JOIN (SELECT tenant_id, count(*) AS n FROM departments WHERE deleted_at IS NULL GROUP BY tenant_id) d ON d.tenant_id = t.id
+WHERE t.deleted_at IS NULL
+ORDER BY members DESC, t.id
+LIMIT $1 OFFSET $2`
+
+	// 按created_at统计每个时间段创建的租户数和截至该时间段末的累计数
+	// 已删除的租户同样计入，系统租户不计入；没有创建的时间段补0
+	tenantGrowthSQL = `SELECT b.period,
+	count(t.id) AS created,
+	((SELECT count(*) FROM tenants WHERE type <> 'ROOT' AND created_at < date_trunc($1, $2::timestamptz))
+		+ sum(count(t.id)) OVER (ORDER BY b.period))::bigint AS total
+FROM generate_series(date_trunc($1, $2::timestamptz), date_trunc($1, $3::timestamptz), $4::interval) AS b(period)
+LEFT JOIN tenants t ON t.type <> 'ROOT' AND t.created_at >= b.period AND t.created_at < b.period + $4::interval
+GROUP BY b.period
+ORDER BY b.period`
+)
+
+// 创建趋势的时间段数量上限
+const (
+	maxDailyPeriods   = 366
+	maxMonthlyPeriods = 120
+)
+
+// GetTenantStatistics 获取租户统计信息：按类型和状态的分布、集团子树统计、各租户成员和部门数以及创建趋势
+func (s *TenantServiceImpl) GetTenantStatistics(ctx context.Context, req *v1.GetTenantStatisticsRequest) (*v1.GetTenantStatisticsResponse, error) {
+	unit, step, start, end, msg := growthRange(req.GetGranularity(), req.GetStart(), req.GetEnd(), time.Now())
+	if msg != "" {
+		return &v1.GetTenantStatisticsResponse{Result: false, Code: 400, Msg: msg}, nil
+	}
+	page := max(req.GetPage(), 1)
+	pageSize := min(max(req.GetPageSize(), 10), 100)
+
+	rows, err := s.tenantService.CountByTypeStatus(ctx)
+	if err != nil {
+		logger.Errorf("统计租户分布失败: %v", err)
+		return &v1.GetTenantStatisticsResponse{Result: false, Code: 500, Msg: "获取统计信息失败"}, nil
+	}
+	resp := &v1.GetTenantStatisticsResponse{
+		Result:       true,
+		Code:         200,
+		Msg:          "查询成功",
+		Statistics:   make(map[string]int32),
+		ByTypeStatus: make([]*v1.TenantTypeStatusCount, 0, len(rows)),
+	}
+	for k, v := range summarizeTenantCounts(rows) {
+		resp.Statistics[k] = int32(v)
+	}
+	resp.TenantTotal = resp.Statistics["total"]
+	for _, r := range rows {
+		resp.ByTypeStatus = append(resp.ByTypeStatus, &v1.TenantTypeStatusCount{
+			Type:   v1.TenantType(v1.TenantType_value[r.Type.String()]),
+			Status: tenantStatusToProto(r.Status),
+			Count:  int32(r.Count),
+		})
+	}
+
+	if resp.Groups, err = s.groupStatistics(ctx); err != nil {
+		logger.Errorf("统计集团租户失败: %v", err)
+		return &v1.GetTenantStatisticsResponse{Result: false, Code: 500, Msg: "获取统计信息失败"}, nil
+	}
+	if resp.Tenants, err = s.tenantCounts(ctx, int(pageSize), int((page-1)*pageSize)); err != nil {
+		logger.Errorf("统计租户成员和部门失败: %v", err)
+		return &v1.GetTenantStatisticsResponse{Result: false, Code: 500, Msg: "获取统计信息失败"}, nil
+	}
+	if resp.Growth, err = s.tenantGrowth(ctx, unit, step, start, end); err != nil {
+		logger.Errorf("统计租户创建趋势失败: %v", err)
+		return &v1.GetTenantStatisticsResponse{Result: false, Code: 500, Msg: "获取统计信息失败"}, nil
+	}
+	return resp, nil
+}
+
+func (s *TenantServiceImpl) groupStatistics(ctx context.Context) ([]*v1.TenantGroupStatistics, error) {
+	rows, err := s.tenantService.client.QueryContext(ctx, groupStatisticsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := make([]*v1.TenantGroupStatistics, 0)
+	for rows.Next() {
+		var (
+			id                               int64
+			name                             string
+			subTenants, members, departments int32
+		)
+		if err := rows.Scan(&id, &name, &subTenants, &members, &departments); err != nil {
+			return nil, err
+		}
+		groups = append(groups, &v1.TenantGroupStatistics{
+			TenantId:    strconv.FormatInt(id, 10),
+			Name:        name,
+			SubTenants:  subTenants,
+			Members:     members,
+			Departments: departments,
+		})
+	}
+	return groups, rows.Err()
+}
+
+func (s *TenantServiceImpl) tenantCounts(ctx context.Context, limit, offset int) ([]*v1.TenantCountStatistics, error) {
+	rows, err := s.tenantService.client.QueryContext(ctx, tenantCountsSQL, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tenants := make([]*v1.TenantCountStatistics, 0, limit)
+	for rows.Next() {
+		var (
+			id                   int64
+			name, typ, status    string
+			members, departments int32
+		)
+		if err := rows.Scan(&id, &name, &typ, &status, &members, &departments); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, &v1.TenantCountStatistics{
+			TenantId:    strconv.FormatInt(id, 10),
+			Name:        name,
+			Type:        v1.TenantType(v1.TenantType_value[typ]),
+			Status:      tenantStatusToProto(tenant.Status(status)),
+			Members:     members,
+			Departments: departments,
+		})
+	}
+	return tenants, rows.Err()
+}
+
+func (s *TenantServiceImpl) tenantGrowth(ctx context.Context, unit, step string, start, end time.Time) ([]*v1.TenantGrowthPoint, error) {
+	rows, err := s.tenantService.client.QueryContext(ctx, tenantGrowthSQL, unit, start, end, step)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := make([]*v1.TenantGrowthPoint, 0)
+	for rows.Next() {
+		var (
+			period         time.Time
+			created, total int32
+		)
+		if err := rows.Scan(&period, &created, &total); err != nil {
+			return nil, err
+		}
+		points = append(points, &v1.TenantGrowthPoint{
+			Period:  period.Format(time.RFC3339),
+			Created: created,
+			Total:   total,
+		})
+	}
+	return points, rows.Err()
+}
+
+// growthRange 解析创建趋势的粒度和时间范围，返回date_trunc的单位、时间段间隔和起止时间，参数不合法时返回提示
+func growthRange(granularity, startStr, endStr string, now time.Time) (unit, step string, start, end time.Time, msg string) {
+	var maxPeriods int
+	switch granularity {
+	case "", "DAY":
+		unit, step, maxPeriods = "day", "1 day", maxDailyPeriods
+	case "MONTH":
+		unit, step, maxPeriods = "month", "1 month", maxMonthlyPeriods
+	default:
+		return "", "", start, end, "无效的统计粒度，只支持DAY、MONTH"
+	}
+
+	end = now
+	if endStr != "" {
+		t, err := time.Parse(time.RFC3339, endStr)
+		if err != nil {
+			return "", "", start, end, "无效的结束时间"
+		}
+		end = t
+	}
+	if unit == "day" {
+		start = end.AddDate(0, 0, -29)
+	} else {
+		start = end.AddDate(0, -11, 0)
+	}
+	if startStr != "" {
+		t, err := time.Parse(time.RFC3339, startStr)
+		if err != nil {
+			return "", "", start, end, "无效的开始时间"
+		}
+		start = t
+	}
+	if start.After(end) {
+		return "", "", start, end, "开始时间不能晚于结束时间"
+	}
+
+	periods := int(end.Sub(start).Hours()/24) + 1
+	if unit == "month" {
+		periods = (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+	}
+	if periods > maxPeriods {
+		return "", "", start, end, "统计时间范围过大，按天最多" + strconv.Itoa(maxDailyPeriods) + "天，按月最多" + strconv.Itoa(maxMonthlyPeriods) + "个月"
+	}
+	return unit, step, start, end, ""
+}
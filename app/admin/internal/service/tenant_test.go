@@ -17,7 +17,7 @@ func TestTenantHierarchy(t *testing.T) {
 	// client := setupTestDB(t)
 	// defer client.Close()
 	//
-	// tenantService := NewSimpleTenantService(client)
+	// tenantService := NewTenantService(client)
 	//
 	// // 测试创建根租户
 	// rootTenant, err := tenantService.CreateTenant(context.Background(), "测试集团", 1, "GROUP")
@@ -58,7 +58,7 @@ func TestTenantTypeValidation(t *testing.T) {
 	// client := setupTestDB(t)
 	// defer client.Close()
 	//
-	// tenantService := NewSimpleTenantService(client)
+	// tenantService := NewTenantService(client)
 	//
 	// // 测试普通租户
 	// normalTenant, err := tenantService.CreateTenant(context.Background(), "普通租户", 1, "NORMAL")
@@ -14,8 +14,12 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/datascope"
 	"github.com/yc-alpha/admin/common/excel"
+	"github.com/yc-alpha/admin/common/middleware"
+	"github.com/yc-alpha/admin/common/quota"
 	"github.com/yc-alpha/admin/ent"
 	"github.com/yc-alpha/admin/ent/user"
 	"github.com/yc-alpha/config"
@@ -27,12 +31,14 @@ import (
 
 type UserService struct {
 	v1.UnimplementedUserServiceServer
-	client *ent.Client
+	client    *ent.Client
+	dataScope bool // 是否启用数据范围，启用时请求context中必须有数据范围
 }
 
-func NewUserService(client *ent.Client) *UserService {
+func NewUserService(client *ent.Client, dataScope bool) *UserService {
 	return &UserService{
-		client: client,
+		client:    client,
+		dataScope: dataScope,
 	}
 }
 
@@ -108,12 +114,27 @@ func (s *UserService) CreateUser(ctx context.Context, req *v1.CreateUserRequest)
 	if req.GetEmail() == "" && req.GetPhone() == "" {
 		return &v1.CreateUserResponse{Result: false, Code: 500, User: nil, Msg: "user email or phone is required"}, nil
 	}
+	tenantID, err := parseOptionalID(req.GetTenantId())
+	if err != nil {
+		return &v1.CreateUserResponse{Result: false, Code: 400, User: nil, Msg: "invalid tenant ID"}, nil
+	}
 	// Start a transaction.
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return &v1.CreateUserResponse{Result: false, Code: 500, User: nil, Msg: fmt.Errorf("starting a transaction: %w", err).Error()}, nil
 	}
 	defer tx.Rollback()
+	// The new user joins the tenant and counts against its member quota.
+	if tenantID > 0 {
+		var exceeded *quota.ExceededError
+		if err := quota.Check(ctx, tx.Client(), tenantID, quota.ResourceUsers, 1); errors.As(err, &exceeded) {
+			return &v1.CreateUserResponse{Result: false, Code: 403, User: nil, Msg: quotaExceededMsg(exceeded)}, nil
+		} else if ent.IsNotFound(err) {
+			return &v1.CreateUserResponse{Result: false, Code: 404, User: nil, Msg: "tenant not found"}, nil
+		} else if err != nil {
+			return &v1.CreateUserResponse{Result: false, Code: 500, User: nil, Msg: err.Error()}, nil
+		}
+	}
 
 	creator := tx.User.Create().
 		SetUsername(req.Username).
@@ -132,6 +153,17 @@ func (s *UserService) CreateUser(ctx context.Context, req *v1.CreateUserRequest)
 	if err != nil {
 		return nil, err
 	}
+	if tenantID > 0 {
+		member := tx.UserTenant.Create().
+			SetUserID(user.ID).
+			SetTenantID(tenantID)
+		if operatorID := middleware.GetUserIDFromContext(ctx); operatorID > 0 {
+			member.SetCreatedBy(operatorID)
+		}
+		if err := member.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	var userAccounts []*ent.UserAccount
 	if len(req.UserAccounts) > 0 {
@@ -325,7 +357,7 @@ func filterFunc(bo *filterBo, query *ent.UserQuery) {
 
 // ListUsers retrieves a list of users based on the provided filters and pagination.
 func (s *UserService) ListUsers(ctx context.Context, req *v1.ListUsersRequest) (*v1.ListUsersResponse, error) {
-	q := s.client.User.Query()
+	q := s.client.User.Query().Where(datascope.FromContext(ctx).Users()...)
 
 	filterFunc(&filterBo{
 		Username: req.GetUsername(),
@@ -396,8 +428,8 @@ func (s *UserService) ChangePassword(ctx context.Context, req *v1.ChangePassword
 	if !ok {
 		return &v1.ChangePasswordResponse{Result: false, Code: 500, Msg: "old password is incorrect"}, nil
 	}
-	// 更新密码
-	err = updateOne.SetPassword(newPwd).Exec(ctx)
+	// 更新密码，同时递增令牌版本使已签发的令牌失效
+	err = updateOne.SetPassword(newPwd).AddTokenVersion(1).Exec(ctx)
 	if err != nil {
 		return &v1.ChangePasswordResponse{Result: false, Code: 500, Msg: fmt.Sprintf("failed to update user password: %s", err)}, nil
 	}
@@ -437,14 +469,23 @@ func (s *UserService) CheckPassword(ctx context.Context, req *v1.CheckPasswordRe
 	return &v1.CheckPasswordResponse{Result: ok, Code: 200, Msg: ""}, nil
 }
 
-func (s *UserService) ExportUser(resp http.ResponseWriter, req *http.Request) {
+// OperationUserServiceExportUser 导出接口不在proto中定义，手动指定operation供认证、授权中间件和白名单匹配
+const OperationUserServiceExportUser = "/admin.v1.UserService/ExportUser"
+
+// ExportUser 导出用户为Excel，通过Kratos路由注册（POST /v1/users/export），与proto定义的接口一样经过认证、授权、数据范围等中间件
+func (s *UserService) ExportUser(ctx khttp.Context) error {
+	khttp.SetOperation(ctx, OperationUserServiceExportUser)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		s.exportUser(c, ctx.Response(), ctx.Request())
+		return nil, nil
+	})
+	_, err := h(ctx, nil)
+	return err
+}
 
-	raw, err := req.GetBody()
-	if err != nil {
-		http.Error(resp, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	bodyBytes, err := io.ReadAll(raw)
+// exportUser 在中间件处理后的ctx中查询并写出Excel
+func (s *UserService) exportUser(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	bodyBytes, err := io.ReadAll(req.Body)
 	if err != nil {
 		http.Error(resp, err.Error(), http.StatusInternalServerError)
 		return
@@ -462,7 +503,23 @@ func (s *UserService) ExportUser(resp http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	query := s.client.User.Query()
+	// 启用数据范围时context中没有数据范围说明未经过DataScopeMiddleware，拒绝导出而不是导出全部用户
+	scope := datascope.FromContext(ctx)
+	if s.dataScope && scope == nil {
+		http.Error(resp, "数据范围未生效，无法导出", http.StatusForbidden)
+		return
+	}
+	if tenantID := middleware.GetTenantIDFromContext(ctx); tenantID > 0 {
+		var disabled *quota.FeatureDisabledError
+		if err := quota.CheckFeature(ctx, s.client, tenantID, quota.FeatureUserExport); errors.As(err, &disabled) {
+			http.Error(resp, featureDisabledMsg(disabled), http.StatusForbidden)
+			return
+		} else if err != nil {
+			http.Error(resp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	query := s.client.User.Query().Where(scope.Users()...)
 	if len(body.Ids) > 0 {
 		var ids []int64
 		for _, id := range body.Ids {
@@ -473,7 +530,7 @@ func (s *UserService) ExportUser(resp http.ResponseWriter, req *http.Request) {
 		filterFunc(body.Params, query)
 	}
 
-	users, err := query.Select(body.Columns...).All(req.Context())
+	users, err := query.Select(body.Columns...).All(ctx)
 	if err != nil {
 		http.Error(resp, err.Error(), http.StatusInternalServerError)
 		return
@@ -517,4 +574,5 @@ func (s *UserService) ExportUser(resp http.ResponseWriter, req *http.Request) {
 	resp.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
 	resp.Header().Set("Content-Disposition", "attachment; filename*=UTF-8''"+url.QueryEscape(fileName))
 	resp.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	_, _ = resp.Write(content)
 }
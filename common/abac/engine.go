@@ -0,0 +1,178 @@
+// admin/common/abac/engine.go
+package abac
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Engine ABAC策略求值引擎，创建后只读，可并发使用
+//
+// 决策规则：
+//  1. 按priority从高到低依次匹配，第一个命中的策略决定结果
+//  2. 同一优先级同时命中allow和deny时，deny优先
+//  3. 没有策略命中时默认拒绝
+type Engine struct {
+	policies []Policy
+}
+
+// NewEngine 创建求值引擎，policies会按优先级排序（不修改入参）
+func NewEngine(policies []Policy) *Engine {
+	sorted := make([]Policy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		// 同优先级deny排在前面
+		return sorted[i].Effect == EffectDeny && sorted[j].Effect != EffectDeny
+	})
+	return &Engine{policies: sorted}
+}
+
+// Evaluate 对单个请求求值
+func (e *Engine) Evaluate(req *Request) Decision {
+	for i := range e.policies {
+		p := &e.policies[i]
+		if !p.matches(req) {
+			continue
+		}
+		if p.Effect == EffectAllow {
+			return Decision{Allowed: true, PolicyID: p.ID, Reason: fmt.Sprintf("allowed by policy %q (priority %d)", p.Name, p.Priority)}
+		}
+		return Decision{Allowed: false, PolicyID: p.ID, Reason: fmt.Sprintf("denied by policy %q (priority %d)", p.Name, p.Priority)}
+	}
+	return Decision{Allowed: false, Reason: "no matching policy, denied by default"}
+}
+
+// EvaluateBatch 批量求值，结果与reqs一一对应
+func (e *Engine) EvaluateBatch(reqs []*Request) []Decision {
+	decisions := make([]Decision, len(reqs))
+	for i, req := range reqs {
+		decisions[i] = e.Evaluate(req)
+	}
+	return decisions
+}
+
+func (p *Policy) matches(req *Request) bool {
+	return matchAll(p.Subjects, req.Subject, req) &&
+		matchAll(p.Resources, req.Resource, req) &&
+		matchAll(p.Actions, req.Action, req) &&
+		matchAll(p.Contexts, req.Context, req)
+}
+
+func matchAll(conds []Attribute, attrs map[string]string, req *Request) bool {
+	for _, cond := range conds {
+		value, ok := attrs[cond.Key]
+		if !ok {
+			// 请求中缺少该属性时条件不成立
+			return false
+		}
+		if !cond.match(value, req) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Attribute) match(value string, req *Request) bool {
+	values := make([]string, len(a.Values))
+	for i, v := range a.Values {
+		values[i] = resolve(v, req)
+	}
+
+	switch a.Operator {
+	case OperatorEqual:
+		return len(values) > 0 && value == values[0]
+	case OperatorNotEqual:
+		return len(values) > 0 && value != values[0]
+	case OperatorIn:
+		return contains(values, value)
+	case OperatorNotIn:
+		return !contains(values, value)
+	case OperatorContains:
+		return anyOf(values, func(v string) bool { return strings.Contains(value, v) })
+	case OperatorNotContains:
+		return !anyOf(values, func(v string) bool { return strings.Contains(value, v) })
+	case OperatorStartsWith:
+		return anyOf(values, func(v string) bool { return strings.HasPrefix(value, v) })
+	case OperatorEndsWith:
+		return anyOf(values, func(v string) bool { return strings.HasSuffix(value, v) })
+	case OperatorGreater:
+		return len(values) > 0 && compare(value, values[0]) > 0
+	case OperatorLess:
+		return len(values) > 0 && compare(value, values[0]) < 0
+	case OperatorGreaterOrEqual:
+		return len(values) > 0 && compare(value, values[0]) >= 0
+	case OperatorLessOrEqual:
+		return len(values) > 0 && compare(value, values[0]) <= 0
+	default:
+		return false
+	}
+}
+
+// resolve 将 ${subject.xxx}、${resource.xxx}、${action.xxx}、${context.xxx} 替换为请求属性
+func resolve(v string, req *Request) string {
+	if !strings.HasPrefix(v, "${") || !strings.HasSuffix(v, "}") {
+		return v
+	}
+	ref := v[2 : len(v)-1]
+	category, key, ok := strings.Cut(ref, ".")
+	if !ok {
+		return v
+	}
+	var attrs map[string]string
+	switch category {
+	case "subject":
+		attrs = req.Subject
+	case "resource":
+		attrs = req.Resource
+	case "action":
+		attrs = req.Action
+	case "context":
+		attrs = req.Context
+	default:
+		return v
+	}
+	if resolved, ok := attrs[key]; ok {
+		return resolved
+	}
+	return v
+}
+
+// compare 两边都是数字时按数值比较，否则按字符串比较（适用于RFC3339时间、HH:MM等定长格式）
+func compare(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case fa > fb:
+			return 1
+		case fa < fb:
+			return -1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func anyOf(values []string, fn func(string) bool) bool {
+	for _, v := range values {
+		if fn(v) {
+			return true
+		}
+	}
+	return false
+}
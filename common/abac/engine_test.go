@@ -0,0 +1,102 @@
+package abac
+
+import "testing"
+
+func TestEngineDefaultDeny(t *testing.T) {
+	e := NewEngine(nil)
+	d := e.Evaluate(&Request{})
+	if d.Allowed || d.PolicyID != "" || d.Reason == "" {
+		t.Errorf("unexpected decision: %+v", d)
+	}
+}
+
+func TestEnginePriorityAndDenyOverride(t *testing.T) {
+	e := NewEngine([]Policy{
+		{ID: "1", Name: "editors", Effect: EffectAllow, Priority: 10,
+			Subjects: []Attribute{{Key: "role", Operator: OperatorIn, Values: []string{"editor", "admin"}}}},
+		{ID: "2", Name: "no-delete", Effect: EffectDeny, Priority: 10,
+			Actions: []Attribute{{Key: "name", Operator: OperatorEqual, Values: []string{"delete"}}}},
+		{ID: "3", Name: "admin-delete", Effect: EffectAllow, Priority: 20,
+			Subjects: []Attribute{{Key: "role", Operator: OperatorEqual, Values: []string{"admin"}}}},
+	})
+
+	cases := []struct {
+		role, action string
+		allowed      bool
+		policyID     string
+	}{
+		{"editor", "read", true, "1"},
+		{"editor", "delete", false, "2"}, // 同优先级deny优先
+		{"admin", "delete", true, "3"},   // 更高优先级的allow覆盖deny
+		{"guest", "read", false, ""},
+	}
+	for _, c := range cases {
+		d := e.Evaluate(&Request{
+			Subject: map[string]string{"role": c.role},
+			Action:  map[string]string{"name": c.action},
+		})
+		if d.Allowed != c.allowed || d.PolicyID != c.policyID {
+			t.Errorf("%s/%s: got %+v, want allowed=%v policy=%s", c.role, c.action, d, c.allowed, c.policyID)
+		}
+	}
+}
+
+func TestAttributeOperators(t *testing.T) {
+	req := &Request{
+		Subject:  map[string]string{"user_id": "42", "level": "5"},
+		Resource: map[string]string{"owner_id": "42", "path": "/docs/report.pdf"},
+		Context:  map[string]string{"time": "10:30"},
+	}
+	cases := []struct {
+		attr  Attribute
+		value string
+		want  bool
+	}{
+		{Attribute{Operator: OperatorEqual, Values: []string{"${subject.user_id}"}}, req.Resource["owner_id"], true},
+		{Attribute{Operator: OperatorNotEqual, Values: []string{"${subject.user_id}"}}, req.Resource["owner_id"], false},
+		{Attribute{Operator: OperatorNotIn, Values: []string{"1", "2"}}, "3", true},
+		{Attribute{Operator: OperatorContains, Values: []string{"report"}}, req.Resource["path"], true},
+		{Attribute{Operator: OperatorNotContains, Values: []string{"secret"}}, req.Resource["path"], true},
+		{Attribute{Operator: OperatorStartsWith, Values: []string{"/docs/"}}, req.Resource["path"], true},
+		{Attribute{Operator: OperatorEndsWith, Values: []string{".doc", ".pdf"}}, req.Resource["path"], true},
+		{Attribute{Operator: OperatorGreater, Values: []string{"10"}}, req.Subject["level"], false}, // 数值比较而非字符串比较
+		{Attribute{Operator: OperatorGreaterOrEqual, Values: []string{"5"}}, req.Subject["level"], true},
+		{Attribute{Operator: OperatorLess, Values: []string{"18:00"}}, req.Context["time"], true},
+		{Attribute{Operator: OperatorLessOrEqual, Values: []string{"09:00"}}, req.Context["time"], false},
+		{Attribute{Operator: "UNKNOWN", Values: []string{"x"}}, "x", false},
+	}
+	for _, c := range cases {
+		if got := c.attr.match(c.value, req); got != c.want {
+			t.Errorf("%s %v on %q: got %v, want %v", c.attr.Operator, c.attr.Values, c.value, got, c.want)
+		}
+	}
+}
+
+func TestMissingAttributeDoesNotMatch(t *testing.T) {
+	e := NewEngine([]Policy{
+		{ID: "1", Effect: EffectDeny, Priority: 1,
+			Subjects: []Attribute{{Key: "dept", Operator: OperatorNotEqual, Values: []string{"finance"}}}},
+	})
+	if d := e.Evaluate(&Request{Subject: map[string]string{}}); d.PolicyID != "" {
+		t.Errorf("expected policy not to match when attribute is missing, got %+v", d)
+	}
+}
+
+func TestEvaluateBatch(t *testing.T) {
+	e := NewEngine([]Policy{
+		{ID: "1", Effect: EffectAllow, Priority: 1,
+			Resources: []Attribute{{Key: "button", Operator: OperatorIn, Values: []string{"create", "edit"}}}},
+	})
+	buttons := []string{"create", "edit", "delete"}
+	reqs := make([]*Request, len(buttons))
+	for i, b := range buttons {
+		reqs[i] = &Request{Resource: map[string]string{"button": b}}
+	}
+	decisions := e.EvaluateBatch(reqs)
+	want := []bool{true, true, false}
+	for i, d := range decisions {
+		if d.Allowed != want[i] {
+			t.Errorf("button %s: got %v, want %v", buttons[i], d.Allowed, want[i])
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// admin/common/abac/policy.go
+package abac
+
+// Effect 策略效果
+type Effect string
+
+const (
+	EffectAllow Effect = "ALLOW"
+	EffectDeny  Effect = "DENY"
+)
+
+// Operator 属性比较操作符，取值与permission.v1.Operator枚举名一致
+type Operator string
+
+const (
+	OperatorEqual          Operator = "EQUAL"
+	OperatorNotEqual       Operator = "NOT_EQUAL"
+	OperatorIn             Operator = "IN"
+	OperatorNotIn          Operator = "NOT_IN"
+	OperatorContains       Operator = "CONTAINS"
+	OperatorNotContains    Operator = "NOT_CONTAINS"
+	OperatorStartsWith     Operator = "STARTS_WITH"
+	OperatorEndsWith       Operator = "ENDS_WITH"
+	OperatorGreater        Operator = "GREATER"
+	OperatorLess           Operator = "LESS"
+	OperatorGreaterOrEqual Operator = "GREATER_OR_EQUAL"
+	OperatorLessOrEqual    Operator = "LESS_OR_EQUAL"
+)
+
+// Attribute 属性条件
+// Values 中形如 ${subject.user_id} 的值会被替换为请求中对应的属性值，用于"资源属主等于当前用户"这类比较
+type Attribute struct {
+	Key      string   `json:"key"`
+	Operator Operator `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+// Policy 参与求值的策略
+// 同一类条件之间为AND，条件为空表示不限制
+type Policy struct {
+	ID        string
+	Name      string
+	Effect    Effect
+	Priority  int32
+	Subjects  []Attribute
+	Resources []Attribute
+	Actions   []Attribute
+	Contexts  []Attribute
+}
+
+// Request 鉴权请求，按主体、资源、操作、环境四类给出属性
+type Request struct {
+	Subject  map[string]string
+	Resource map[string]string
+	Action   map[string]string
+	Context  map[string]string
+}
+
+// Decision 鉴权结果
+type Decision struct {
+	Allowed  bool
+	PolicyID string
+	Reason   string
+}
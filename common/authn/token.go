@@ -0,0 +1,161 @@
+// admin/common/authn/token.go
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenType 令牌类型
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"  // 访问令牌
+	TokenTypeRefresh TokenType = "refresh" // 刷新令牌
+)
+
+var (
+	ErrTokenInvalid   = errors.New("token is invalid")
+	ErrTokenExpired   = errors.New("token has expired")
+	ErrTokenWrongType = errors.New("token type mismatch")
+	ErrTokenRevoked   = errors.New("token has been revoked")
+)
+
+// Claims JWT声明，Subject 保存用户ID
+type Claims struct {
+	Username  string    `json:"username"`
+	TokenType TokenType `json:"typ"`
+	Version   int64     `json:"ver"` // 签发时用户的令牌版本
+	jwt.RegisteredClaims
+}
+
+// IsTokenError 错误是否由令牌本身无效（非法、过期、类型不符或已吊销）引起，其他错误来自VersionSource
+func IsTokenError(err error) bool {
+	return errors.Is(err, ErrTokenInvalid) || errors.Is(err, ErrTokenExpired) ||
+		errors.Is(err, ErrTokenWrongType) || errors.Is(err, ErrTokenRevoked)
+}
+
+// UserID 返回令牌所属的用户ID
+func (c *Claims) UserID() int64 {
+	id, _ := strconv.ParseInt(c.Subject, 10, 64)
+	return id
+}
+
+// TokenPair 登录成功后签发的令牌对
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int64 // 访问令牌有效期（秒）
+}
+
+// VersionSource 返回用户当前的令牌版本，登出或修改密码时版本递增，之前签发的令牌随之失效
+// 用户不存在时应返回ErrTokenInvalid
+type VersionSource func(ctx context.Context, userID int64) (int64, error)
+
+// TokenManager 负责签发和校验JWT
+type TokenManager struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	versions   VersionSource
+}
+
+func NewTokenManager(secret, issuer string, accessTTL, refreshTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		secret:     []byte(secret),
+		issuer:     issuer,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// SetVersionSource 设置令牌版本来源，设置后Parse*会拒绝版本与用户当前版本不一致（已吊销）的令牌
+func (m *TokenManager) SetVersionSource(src VersionSource) {
+	m.versions = src
+}
+
+// Issue 为用户签发访问令牌和刷新令牌，version为用户当前的令牌版本
+func (m *TokenManager) Issue(userID int64, username string, version int64) (*TokenPair, error) {
+	now := time.Now()
+	access, err := m.sign(userID, username, version, TokenTypeAccess, now, m.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := m.sign(userID, username, version, TokenTypeRefresh, now, m.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		ExpiresIn:    int64(m.accessTTL.Seconds()),
+	}, nil
+}
+
+// ParseAccessToken 校验访问令牌
+func (m *TokenManager) ParseAccessToken(ctx context.Context, token string) (*Claims, error) {
+	return m.parse(ctx, token, TokenTypeAccess)
+}
+
+// ParseRefreshToken 校验刷新令牌
+func (m *TokenManager) ParseRefreshToken(ctx context.Context, token string) (*Claims, error) {
+	return m.parse(ctx, token, TokenTypeRefresh)
+}
+
+func (m *TokenManager) sign(userID int64, username string, version int64, typ TokenType, now time.Time, ttl time.Duration) (string, error) {
+	claims := &Claims{
+		Username:  username,
+		TokenType: typ,
+		Version:   version,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", fmt.Errorf("signing %s token: %w", typ, err)
+	}
+	return signed, nil
+}
+
+func (m *TokenManager) parse(ctx context.Context, token string, typ TokenType) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, ErrTokenInvalid
+	}
+	if claims.TokenType != typ {
+		return nil, ErrTokenWrongType
+	}
+	if claims.UserID() == 0 {
+		return nil, ErrTokenInvalid
+	}
+	if m.versions != nil {
+		version, err := m.versions(ctx, claims.UserID())
+		if err != nil {
+			return nil, err
+		}
+		if claims.Version != version {
+			return nil, ErrTokenRevoked
+		}
+	}
+	return claims, nil
+}
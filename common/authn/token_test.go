@@ -0,0 +1,93 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenManagerIssueAndParse(t *testing.T) {
+	m := NewTokenManager("test-secret", "paas.admin", time.Hour, 24*time.Hour)
+
+	pair, err := m.Issue(10086, "admin", 0)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	if pair.ExpiresIn != 3600 {
+		t.Errorf("expected expires_in 3600, got %d", pair.ExpiresIn)
+	}
+
+	claims, err := m.ParseAccessToken(context.Background(), pair.AccessToken)
+	if err != nil {
+		t.Fatalf("parse access token: %v", err)
+	}
+	if claims.UserID() != 10086 || claims.Username != "admin" {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+
+	if _, err := m.ParseRefreshToken(context.Background(), pair.RefreshToken); err != nil {
+		t.Fatalf("parse refresh token: %v", err)
+	}
+}
+
+func TestTokenManagerRejectsWrongType(t *testing.T) {
+	m := NewTokenManager("test-secret", "paas.admin", time.Hour, 24*time.Hour)
+	pair, err := m.Issue(1, "admin", 0)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+
+	if _, err := m.ParseAccessToken(context.Background(), pair.RefreshToken); !errors.Is(err, ErrTokenWrongType) {
+		t.Errorf("expected ErrTokenWrongType, got %v", err)
+	}
+	if _, err := m.ParseRefreshToken(context.Background(), pair.AccessToken); !errors.Is(err, ErrTokenWrongType) {
+		t.Errorf("expected ErrTokenWrongType, got %v", err)
+	}
+}
+
+func TestTokenManagerRejectsForeignSignature(t *testing.T) {
+	issuer := NewTokenManager("secret-a", "paas.admin", time.Hour, time.Hour)
+	verifier := NewTokenManager("secret-b", "paas.admin", time.Hour, time.Hour)
+	pair, err := issuer.Issue(1, "admin", 0)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	if _, err := verifier.ParseAccessToken(context.Background(), pair.AccessToken); !errors.Is(err, ErrTokenInvalid) {
+		t.Errorf("expected ErrTokenInvalid, got %v", err)
+	}
+}
+
+func TestTokenManagerExpired(t *testing.T) {
+	m := NewTokenManager("test-secret", "paas.admin", -time.Minute, time.Hour)
+	pair, err := m.Issue(1, "admin", 0)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	if _, err := m.ParseAccessToken(context.Background(), pair.AccessToken); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expected ErrTokenExpired, got %v", err)
+	}
+}
+
+func TestTokenManagerRevokedVersion(t *testing.T) {
+	m := NewTokenManager("test-secret", "paas.admin", time.Hour, time.Hour)
+	current := int64(3)
+	m.SetVersionSource(func(context.Context, int64) (int64, error) { return current, nil })
+	pair, err := m.Issue(1, "admin", current)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := m.ParseAccessToken(ctx, pair.AccessToken); err != nil {
+		t.Fatalf("parse access token: %v", err)
+	}
+
+	// 登出或修改密码后版本递增，之前签发的令牌全部失效
+	current++
+	if _, err := m.ParseAccessToken(ctx, pair.AccessToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked for access token, got %v", err)
+	}
+	if _, err := m.ParseRefreshToken(ctx, pair.RefreshToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked for refresh token, got %v", err)
+	}
+}
@@ -4,16 +4,52 @@ package authz
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/casbin/casbin/v2/model"
 	"github.com/casbin/casbin/v2/persist"
 	"github.com/yc-alpha/admin/ent"
 	"github.com/yc-alpha/admin/ent/casbinrule"
+	"github.com/yc-alpha/admin/ent/predicate"
 )
 
+var (
+	_ persist.Adapter          = (*Adapter)(nil)
+	_ persist.BatchAdapter     = (*Adapter)(nil)
+	_ persist.UpdatableAdapter = (*Adapter)(nil)
+	_ persist.FilteredAdapter  = (*Adapter)(nil)
+)
+
+// ErrInvalidFilter LoadFilteredPolicy传入了不支持的过滤条件
+var ErrInvalidFilter = errors.New("invalid casbin filter, expect Filter, *Filter or []Filter")
+
+// Adapter 基于ent的Casbin适配器，所有写操作都在事务中执行
 type Adapter struct {
-	client *ent.Client
+	client   *ent.Client
+	filtered bool
+}
+
+// Filter 加载策略时的过滤条件，同一字段内的多个值为OR，字段之间为AND，为空表示不限制
+type Filter struct {
+	Ptype []string
+	V0    []string
+	V1    []string
+	V2    []string
+	V3    []string
+	V4    []string
+	V5    []string
+}
+
+// DomainFilter 返回只加载指定租户域（及平台级域*）策略的过滤条件
+// p规则的域在v1，g规则的域在v2
+func DomainFilter(domains ...string) []Filter {
+	values := append([]string{PlatformDomain}, domains...)
+	return []Filter{
+		{Ptype: []string{"p"}, V1: values},
+		{Ptype: []string{"g"}, V2: values},
+	}
 }
 
 func NewAdapter(client *ent.Client) *Adapter {
@@ -22,138 +58,295 @@ func NewAdapter(client *ent.Client) *Adapter {
 
 func (a *Adapter) LoadPolicy(model model.Model) error {
 	ctx := context.Background()
-	rules, err := a.client.CasbinRule.Query().All(ctx)
+	rules, err := a.client.CasbinRule.Query().Order(ent.Asc(casbinrule.FieldID)).All(ctx)
 	if err != nil {
 		return err
 	}
 
 	for _, rule := range rules {
-		loadPolicyLine(rule, model)
+		if err := loadPolicyLine(rule, model); err != nil {
+			return err
+		}
 	}
+	a.filtered = false
 	return nil
 }
 
-func (a *Adapter) SavePolicy(model model.Model) error {
-	ctx := context.Background()
+// LoadFilteredPolicy 只加载满足过滤条件的策略
+func (a *Adapter) LoadFilteredPolicy(model model.Model, filter interface{}) error {
+	var filters []Filter
+	switch f := filter.(type) {
+	case nil:
+		return a.LoadPolicy(model)
+	case Filter:
+		filters = []Filter{f}
+	case *Filter:
+		filters = []Filter{*f}
+	case []Filter:
+		filters = f
+	default:
+		return ErrInvalidFilter
+	}
 
-	// 清空现有策略
-	_, err := a.client.CasbinRule.Delete().Exec(ctx)
+	ctx := context.Background()
+	ors := make([]predicate.CasbinRule, 0, len(filters))
+	for _, f := range filters {
+		ors = append(ors, f.predicate())
+	}
+	rules, err := a.client.CasbinRule.Query().
+		Where(casbinrule.Or(ors...)).
+		Order(ent.Asc(casbinrule.FieldID)).
+		All(ctx)
 	if err != nil {
 		return err
 	}
 
-	var lines []string
-
-	for ptype, ast := range model["p"] {
-		for _, rule := range ast.Policy {
-			lines = append(lines, ptype+", "+strings.Join(rule, ", "))
+	for _, rule := range rules {
+		if err := loadPolicyLine(rule, model); err != nil {
+			return err
 		}
 	}
+	a.filtered = true
+	return nil
+}
 
-	for ptype, ast := range model["g"] {
-		for _, rule := range ast.Policy {
-			lines = append(lines, ptype+", "+strings.Join(rule, ", "))
+// IsFiltered 当前加载的是否为部分策略，为true时casbin禁止SavePolicy覆盖全部策略
+func (a *Adapter) IsFiltered() bool {
+	return a.filtered
+}
+
+// SavePolicy 以事务方式用model中的策略替换全部策略
+func (a *Adapter) SavePolicy(model model.Model) error {
+	return a.withTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		// 清空现有策略
+		if _, err := tx.CasbinRule.Delete().Exec(ctx); err != nil {
+			return err
 		}
-	}
 
-	return a.saveLines(ctx, lines)
+		var bulk []*ent.CasbinRuleCreate
+		for _, sec := range []string{"p", "g"} {
+			for ptype, ast := range model[sec] {
+				for _, rule := range ast.Policy {
+					bulk = append(bulk, newRuleCreate(tx, ptype, rule))
+				}
+			}
+		}
+		return createRules(ctx, tx, bulk)
+	})
 }
 
 func (a *Adapter) AddPolicy(sec string, ptype string, rule []string) error {
-	ctx := context.Background()
-	return a.saveLine(ctx, ptype, rule)
+	return a.AddPolicies(sec, ptype, [][]string{rule})
+}
+
+// AddPolicies 批量添加策略
+func (a *Adapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	return a.withTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		bulk := make([]*ent.CasbinRuleCreate, 0, len(rules))
+		for _, rule := range rules {
+			bulk = append(bulk, newRuleCreate(tx, ptype, rule))
+		}
+		return createRules(ctx, tx, bulk)
+	})
 }
 
 func (a *Adapter) RemovePolicy(sec string, ptype string, rule []string) error {
-	ctx := context.Background()
-	query := a.client.CasbinRule.Delete().Where(casbinrule.PtypeEQ(ptype))
+	return a.RemovePolicies(sec, ptype, [][]string{rule})
+}
 
-	for i, v := range rule {
-		switch i {
-		case 0:
-			query = query.Where(casbinrule.V0EQ(v))
-		case 1:
-			query = query.Where(casbinrule.V1EQ(v))
-		case 2:
-			query = query.Where(casbinrule.V2EQ(v))
-		case 3:
-			query = query.Where(casbinrule.V3EQ(v))
-		case 4:
-			query = query.Where(casbinrule.V4EQ(v))
-		case 5:
-			query = query.Where(casbinrule.V5EQ(v))
+// RemovePolicies 批量删除策略
+func (a *Adapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	return a.withTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		for _, rule := range rules {
+			if _, err := tx.CasbinRule.Delete().Where(exactRule(ptype, rule)).Exec(ctx); err != nil {
+				return err
+			}
 		}
-	}
-
-	_, err := query.Exec(ctx)
-	return err
+		return nil
+	})
 }
 
+// RemoveFilteredPolicy 删除从fieldIndex开始匹配fieldValues的策略，空字符串表示不限制该字段
 func (a *Adapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
-	return errors.New("not implemented")
+	return a.withTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		_, err := tx.CasbinRule.Delete().Where(filteredRule(ptype, fieldIndex, fieldValues...)).Exec(ctx)
+		return err
+	})
 }
 
-func (a *Adapter) saveLine(ctx context.Context, ptype string, rule []string) error {
-	create := a.client.CasbinRule.Create().SetPtype(ptype)
+// UpdatePolicy 更新单条策略
+func (a *Adapter) UpdatePolicy(sec string, ptype string, oldRule, newRule []string) error {
+	return a.UpdatePolicies(sec, ptype, [][]string{oldRule}, [][]string{newRule})
+}
 
-	if len(rule) > 0 {
-		create = create.SetV0(rule[0])
-	}
-	if len(rule) > 1 {
-		create = create.SetV1(rule[1])
-	}
-	if len(rule) > 2 {
-		create = create.SetV2(rule[2])
+// UpdatePolicies 批量更新策略，oldRules与newRules按下标一一对应
+func (a *Adapter) UpdatePolicies(sec string, ptype string, oldRules, newRules [][]string) error {
+	if len(oldRules) != len(newRules) {
+		return fmt.Errorf("old rules count %d does not match new rules count %d", len(oldRules), len(newRules))
 	}
-	if len(rule) > 3 {
-		create = create.SetV3(rule[3])
+	return a.withTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		for i, oldRule := range oldRules {
+			values := ruleValues(newRules[i])
+			n, err := tx.CasbinRule.Update().
+				Where(exactRule(ptype, oldRule)).
+				SetV0(values[0]).
+				SetV1(values[1]).
+				SetV2(values[2]).
+				SetV3(values[3]).
+				SetV4(values[4]).
+				SetV5(values[5]).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return fmt.Errorf("policy not found: %s, %s", ptype, strings.Join(oldRule, ", "))
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateFilteredPolicies 用newRules替换匹配过滤条件的策略，返回被替换的旧策略
+func (a *Adapter) UpdateFilteredPolicies(sec string, ptype string, newRules [][]string, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	var oldRules [][]string
+	err := a.withTx(context.Background(), func(ctx context.Context, tx *ent.Tx) error {
+		where := filteredRule(ptype, fieldIndex, fieldValues...)
+		rules, err := tx.CasbinRule.Query().Where(where).Order(ent.Asc(casbinrule.FieldID)).All(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.CasbinRule.Delete().Where(where).Exec(ctx); err != nil {
+			return err
+		}
+
+		bulk := make([]*ent.CasbinRuleCreate, 0, len(newRules))
+		for _, rule := range newRules {
+			bulk = append(bulk, newRuleCreate(tx, ptype, rule))
+		}
+		if err := createRules(ctx, tx, bulk); err != nil {
+			return err
+		}
+
+		oldRules = make([][]string, 0, len(rules))
+		for _, rule := range rules {
+			oldRules = append(oldRules, ruleOf(rule))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if len(rule) > 4 {
-		create = create.SetV4(rule[4])
+	return oldRules, nil
+}
+
+// withTx 在事务中执行fn，出错时回滚
+func (a *Adapter) withTx(ctx context.Context, fn func(ctx context.Context, tx *ent.Tx) error) error {
+	tx, err := a.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting a transaction: %w", err)
 	}
-	if len(rule) > 5 {
-		create = create.SetV5(rule[5])
+	if err := fn(ctx, tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
 	}
-
-	_, err := create.Save(ctx)
-	return err
+	return tx.Commit()
 }
 
-func (a *Adapter) saveLines(ctx context.Context, lines []string) error {
-	for _, line := range lines {
-		parts := strings.Split(line, ", ")
-		if len(parts) < 2 {
-			continue
-		}
-		ptype := parts[0]
-		rule := parts[1:]
-		if err := a.saveLine(ctx, ptype, rule); err != nil {
+// createBatchSize 单条INSERT语句最多插入的策略数，避免超过PostgreSQL参数上限
+const createBatchSize = 1000
+
+func createRules(ctx context.Context, tx *ent.Tx, bulk []*ent.CasbinRuleCreate) error {
+	for start := 0; start < len(bulk); start += createBatchSize {
+		end := min(start+createBatchSize, len(bulk))
+		if err := tx.CasbinRule.CreateBulk(bulk[start:end]...).Exec(ctx); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func loadPolicyLine(rule *ent.CasbinRule, model model.Model) {
-	lineText := rule.Ptype
-	if rule.V0 != "" {
-		lineText += ", " + rule.V0
+func newRuleCreate(tx *ent.Tx, ptype string, rule []string) *ent.CasbinRuleCreate {
+	values := ruleValues(rule)
+	return tx.CasbinRule.Create().
+		SetPtype(ptype).
+		SetV0(values[0]).
+		SetV1(values[1]).
+		SetV2(values[2]).
+		SetV3(values[3]).
+		SetV4(values[4]).
+		SetV5(values[5])
+}
+
+// ruleValues 将策略补齐为v0~v5六个字段
+func ruleValues(rule []string) [6]string {
+	var values [6]string
+	copy(values[:], rule)
+	return values
+}
+
+// ruleOf 将数据库记录还原为策略，去掉末尾的空字段
+func ruleOf(rule *ent.CasbinRule) []string {
+	values := []string{rule.V0, rule.V1, rule.V2, rule.V3, rule.V4, rule.V5}
+	n := len(values)
+	for n > 0 && values[n-1] == "" {
+		n--
 	}
-	if rule.V1 != "" {
-		lineText += ", " + rule.V1
+	return values[:n]
+}
+
+var fieldEQ = [6]func(string) predicate.CasbinRule{
+	casbinrule.V0EQ, casbinrule.V1EQ, casbinrule.V2EQ,
+	casbinrule.V3EQ, casbinrule.V4EQ, casbinrule.V5EQ,
+}
+
+var fieldIn = [6]func(...string) predicate.CasbinRule{
+	casbinrule.V0In, casbinrule.V1In, casbinrule.V2In,
+	casbinrule.V3In, casbinrule.V4In, casbinrule.V5In,
+}
+
+// exactRule 精确匹配一条策略，未给出的字段必须为空
+func exactRule(ptype string, rule []string) predicate.CasbinRule {
+	values := ruleValues(rule)
+	ps := []predicate.CasbinRule{casbinrule.PtypeEQ(ptype)}
+	for i, v := range values {
+		ps = append(ps, fieldEQ[i](v))
 	}
-	if rule.V2 != "" {
-		lineText += ", " + rule.V2
+	return casbinrule.And(ps...)
+}
+
+// filteredRule 从fieldIndex开始匹配fieldValues，空字符串表示不限制该字段
+func filteredRule(ptype string, fieldIndex int, fieldValues ...string) predicate.CasbinRule {
+	ps := []predicate.CasbinRule{casbinrule.PtypeEQ(ptype)}
+	for i, v := range fieldValues {
+		idx := fieldIndex + i
+		if v == "" || idx < 0 || idx >= len(fieldEQ) {
+			continue
+		}
+		ps = append(ps, fieldEQ[idx](v))
 	}
-	if rule.V3 != "" {
-		lineText += ", " + rule.V3
+	return casbinrule.And(ps...)
+}
+
+func (f Filter) predicate() predicate.CasbinRule {
+	var ps []predicate.CasbinRule
+	if len(f.Ptype) > 0 {
+		ps = append(ps, casbinrule.PtypeIn(f.Ptype...))
 	}
-	if rule.V4 != "" {
-		lineText += ", " + rule.V4
+	for i, values := range [6][]string{f.V0, f.V1, f.V2, f.V3, f.V4, f.V5} {
+		if len(values) > 0 {
+			ps = append(ps, fieldIn[i](values...))
+		}
 	}
-	if rule.V5 != "" {
-		lineText += ", " + rule.V5
+	if len(ps) == 0 {
+		// 空过滤条件匹配全部策略
+		return func(*sql.Selector) {}
 	}
+	return casbinrule.And(ps...)
+}
 
-	persist.LoadPolicyLine(lineText, model)
+func loadPolicyLine(rule *ent.CasbinRule, model model.Model) error {
+	return persist.LoadPolicyArray(append([]string{rule.Ptype}, ruleOf(rule)...), model)
 }
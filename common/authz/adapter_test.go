@@ -0,0 +1,41 @@
+package authz
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yc-alpha/admin/ent"
+)
+
+func TestRuleValuesAndRuleOf(t *testing.T) {
+	rule := []string{"tenant_admin", "200", "/admin.v1.UserService/*", "GET", "allow"}
+	values := ruleValues(rule)
+	if values[4] != "allow" || values[5] != "" {
+		t.Fatalf("unexpected padded values: %v", values)
+	}
+
+	got := ruleOf(&ent.CasbinRule{V0: values[0], V1: values[1], V2: values[2], V3: values[3], V4: values[4], V5: values[5]})
+	if !reflect.DeepEqual(got, rule) {
+		t.Errorf("ruleOf() = %v, want %v", got, rule)
+	}
+
+	// 中间的空字段需要保留，只去掉末尾的空字段
+	got = ruleOf(&ent.CasbinRule{V0: "1", V1: "", V2: "200"})
+	if want := []string{"1", "", "200"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ruleOf() = %v, want %v", got, want)
+	}
+}
+
+func TestDomainFilter(t *testing.T) {
+	filters := DomainFilter("200")
+	if len(filters) != 2 {
+		t.Fatalf("expected filters for p and g, got %d", len(filters))
+	}
+	want := []string{PlatformDomain, "200"}
+	if !reflect.DeepEqual(filters[0].Ptype, []string{"p"}) || !reflect.DeepEqual(filters[0].V1, want) {
+		t.Errorf("unexpected p filter: %+v", filters[0])
+	}
+	if !reflect.DeepEqual(filters[1].Ptype, []string{"g"}) || !reflect.DeepEqual(filters[1].V2, want) {
+		t.Errorf("unexpected g filter: %+v", filters[1])
+	}
+}
@@ -0,0 +1,71 @@
+// admin/common/authz/enforcer.go
+package authz
+
+import (
+	_ "embed"
+	"strconv"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/casbin/casbin/v2/util"
+
+	"github.com/yc-alpha/admin/ent"
+)
+
+// PlatformDomain 平台级域，p规则或g规则的域为*时在所有租户生效
+const PlatformDomain = "*"
+
+// DomainOf 返回租户对应的Casbin域，0表示平台级域
+func DomainOf(tenantID int64) string {
+	if tenantID == 0 {
+		return PlatformDomain
+	}
+	return strconv.FormatInt(tenantID, 10)
+}
+
+// UserKey 返回用户在Casbin g规则中的标识
+func UserKey(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
+
+// GroupingRule 返回用户在租户下拥有某角色的g规则，tenantID为0表示平台级授予
+func GroupingRule(userID int64, roleCode string, tenantID int64) []string {
+	return []string{UserKey(userID), roleCode, DomainOf(tenantID)}
+}
+
+// DefaultModel 内置的RBAC with domains模型
+//
+//go:embed model.conf
+var DefaultModel string
+
+// NewEnforcer 基于ent适配器创建线程安全的Casbin enforcer
+// modelPath 为空时使用内置模型
+func NewEnforcer(client *ent.Client, modelPath string) (*casbin.SyncedEnforcer, error) {
+	m, err := loadModel(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	return newEnforcer(m, NewAdapter(client))
+}
+
+func newEnforcer(m model.Model, adapter persist.Adapter) (*casbin.SyncedEnforcer, error) {
+	params := []interface{}{m}
+	if adapter != nil {
+		params = append(params, adapter)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(params...)
+	if err != nil {
+		return nil, err
+	}
+	// g规则的域为*时匹配任意租户，用于平台级角色授权
+	enforcer.AddNamedDomainMatchingFunc("g", "keyMatch", util.KeyMatch)
+	return enforcer, nil
+}
+
+func loadModel(modelPath string) (model.Model, error) {
+	if modelPath == "" {
+		return model.NewModelFromString(DefaultModel)
+	}
+	return model.NewModelFromFile(modelPath)
+}
@@ -0,0 +1,126 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/casbin/casbin/v2/model"
+)
+
+func newTestEnforcer(t *testing.T, policies, groupings [][]string) func(sub *Subject, dom, obj, act string) bool {
+	t.Helper()
+	m, err := model.NewModelFromString(DefaultModel)
+	if err != nil {
+		t.Fatalf("load model: %v", err)
+	}
+	e, err := newEnforcer(m, nil)
+	if err != nil {
+		t.Fatalf("new enforcer: %v", err)
+	}
+	if _, err := e.AddPolicies(policies); err != nil {
+		t.Fatalf("add policies: %v", err)
+	}
+	if len(groupings) > 0 {
+		if _, err := e.AddGroupingPolicies(groupings); err != nil {
+			t.Fatalf("add groupings: %v", err)
+		}
+	}
+	return func(sub *Subject, dom, obj, act string) bool {
+		ok, err := e.Enforce(sub, dom, obj, act)
+		if err != nil {
+			t.Fatalf("enforce: %v", err)
+		}
+		return ok
+	}
+}
+
+func TestEnforcerRoleCodes(t *testing.T) {
+	enforce := newTestEnforcer(t, [][]string{
+		{"tenant_admin", "200", "/admin.v1.UserService/*", "GET|POST", "allow"},
+		{"tenant_admin", "200", "/admin.v1.UserService/DeleteUser", "DELETE", "deny"},
+	}, nil)
+
+	sub := &Subject{UserID: 1, Key: "1", TenantID: 200, RoleCodes: []string{"tenant_admin"}}
+	if !enforce(sub, "200", "/admin.v1.UserService/ListUsers", "GET") {
+		t.Error("expected tenant_admin to list users in its tenant")
+	}
+	if enforce(sub, "300", "/admin.v1.UserService/ListUsers", "GET") {
+		t.Error("expected tenant_admin to be denied in another tenant")
+	}
+	if enforce(sub, "200", "/admin.v1.UserService/DeleteUser", "DELETE") {
+		t.Error("expected explicit deny to win")
+	}
+	if enforce(&Subject{UserID: 2, Key: "2"}, "200", "/admin.v1.UserService/ListUsers", "GET") {
+		t.Error("expected user without roles to be denied")
+	}
+}
+
+func TestEnforcerGroupingWithPlatformDomain(t *testing.T) {
+	enforce := newTestEnforcer(t, [][]string{
+		{"super_admin", PlatformDomain, "/*", ".*", "allow"},
+		{"auditor", "200", "/admin.v1.UserService/*", "GET", "allow"},
+	}, [][]string{
+		{"1", "super_admin", PlatformDomain},
+		{"2", "auditor", "200"},
+	})
+
+	admin := &Subject{UserID: 1, Key: "1"}
+	if !enforce(admin, "200", "/admin.v1.TenantService/DeleteTenant", "DELETE") {
+		t.Error("expected platform role to apply in any tenant")
+	}
+	if !enforce(admin, PlatformDomain, "/admin.v1.TenantService/ListTenants", "GET") {
+		t.Error("expected platform role to apply to platform APIs")
+	}
+
+	auditor := &Subject{UserID: 2, Key: "2"}
+	if !enforce(auditor, "200", "/admin.v1.UserService/GetUser", "GET") {
+		t.Error("expected g rule to grant auditor in tenant 200")
+	}
+	if enforce(auditor, "300", "/admin.v1.UserService/GetUser", "GET") {
+		t.Error("expected g rule not to leak into tenant 300")
+	}
+}
+
+func TestEnforcerRoleInheritance(t *testing.T) {
+	enforce := newTestEnforcer(t, [][]string{
+		{"sales_manager", PlatformDomain, "/admin.v1.UserService/ListUsers", "GET", "allow"},
+		{"auditor", PlatformDomain, "/admin.v1.UserService/GetUser", "GET", "allow"},
+	}, [][]string{
+		// 平台级继承：tenant_admin 包含 sales_manager
+		InheritanceRule("tenant_admin", "sales_manager", 0),
+		// 租户200派生的自定义角色继承平台模板 auditor
+		InheritanceRule("custom_auditor", "auditor", 200),
+		GroupingRule(1, "tenant_admin", 200),
+		GroupingRule(2, "custom_auditor", 200),
+	})
+
+	admin := &Subject{UserID: 1, Key: "1"}
+	if !enforce(admin, "200", "/admin.v1.UserService/ListUsers", "GET") {
+		t.Error("expected tenant_admin to inherit sales_manager")
+	}
+	if enforce(admin, "300", "/admin.v1.UserService/ListUsers", "GET") {
+		t.Error("expected tenant grant not to leak into tenant 300")
+	}
+
+	auditor := &Subject{UserID: 2, Key: "2"}
+	if !enforce(auditor, "200", "/admin.v1.UserService/GetUser", "GET") {
+		t.Error("expected derived tenant role to inherit the platform template")
+	}
+	if enforce(&Subject{UserID: 3, Key: "3", RoleCodes: []string{"custom_auditor"}}, "300", "/admin.v1.UserService/GetUser", "GET") {
+		t.Error("expected tenant scoped inheritance not to apply in tenant 300")
+	}
+}
+
+// configs/casbin_model.conf 是内置模型的副本，供需要自定义模型的部署参考，两者需保持一致
+func TestConfigModelMatchesDefault(t *testing.T) {
+	want, err := loadModel("")
+	if err != nil {
+		t.Fatalf("load default model: %v", err)
+	}
+	got, err := loadModel("../../app/admin/configs/casbin_model.conf")
+	if err != nil {
+		t.Fatalf("load config model: %v", err)
+	}
+	if got.ToText() != want.ToText() {
+		t.Errorf("configs/casbin_model.conf differs from the built-in model:\n%s\nwant:\n%s", got.ToText(), want.ToText())
+	}
+}
@@ -0,0 +1,94 @@
+// admin/common/authz/inheritance.go
+package authz
+
+import "errors"
+
+// MaxInheritanceDepth 角色继承链的最大层数（不含角色自身）
+const MaxInheritanceDepth = 8
+
+var (
+	// ErrInheritanceCycle 继承关系成环
+	ErrInheritanceCycle = errors.New("role inheritance cycle detected")
+	// ErrInheritanceTooDeep 继承链超过MaxInheritanceDepth
+	ErrInheritanceTooDeep = errors.New("role inheritance chain too deep")
+)
+
+// RoleGraph 角色继承关系图，key为角色ID，value为该角色直接继承的角色ID
+type RoleGraph map[int64][]int64
+
+// CheckEdge 校验新增parent继承child后，继承关系图仍然无环且深度不超限
+func (g RoleGraph) CheckEdge(parent, child int64) error {
+	if parent == child {
+		return ErrInheritanceCycle
+	}
+	next := make(RoleGraph, len(g)+1)
+	for k, v := range g {
+		next[k] = v
+	}
+	next[parent] = append(append([]int64(nil), g[parent]...), child)
+	return next.Validate()
+}
+
+// Validate 校验继承关系图无环，且最长继承链不超过MaxInheritanceDepth
+func (g RoleGraph) Validate() error {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[int64]int, len(g))
+	depth := make(map[int64]int, len(g))
+
+	var visit func(id int64) error
+	visit = func(id int64) error {
+		switch state[id] {
+		case visiting:
+			return ErrInheritanceCycle
+		case done:
+			return nil
+		}
+		state[id] = visiting
+		d := 0
+		for _, child := range g[id] {
+			if err := visit(child); err != nil {
+				return err
+			}
+			d = max(d, depth[child]+1)
+		}
+		if d > MaxInheritanceDepth {
+			return ErrInheritanceTooDeep
+		}
+		state[id] = done
+		depth[id] = d
+		return nil
+	}
+
+	for id := range g {
+		if err := visit(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Expand 返回roots及其直接、间接继承的所有角色ID，按广度优先顺序去重
+func (g RoleGraph) Expand(roots ...int64) []int64 {
+	seen := make(map[int64]struct{}, len(roots))
+	result := make([]int64, 0, len(roots))
+	queue := append([]int64(nil), roots...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+		queue = append(queue, g[id]...)
+	}
+	return result
+}
+
+// InheritanceRule 返回角色继承的g规则：parent在租户下包含child的权限，tenantID为0表示平台级继承
+func InheritanceRule(parentCode, childCode string, tenantID int64) []string {
+	return []string{parentCode, childCode, DomainOf(tenantID)}
+}
@@ -0,0 +1,52 @@
+package authz
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRoleGraphCheckEdge(t *testing.T) {
+	g := RoleGraph{
+		1: {2},
+		2: {3},
+	}
+	if err := g.CheckEdge(1, 3); err != nil {
+		t.Errorf("expected diamond-free shortcut to be allowed, got %v", err)
+	}
+	if err := g.CheckEdge(3, 1); !errors.Is(err, ErrInheritanceCycle) {
+		t.Errorf("expected cycle error, got %v", err)
+	}
+	if err := g.CheckEdge(2, 2); !errors.Is(err, ErrInheritanceCycle) {
+		t.Errorf("expected self inheritance to be rejected, got %v", err)
+	}
+	if len(g[1]) != 1 {
+		t.Error("expected CheckEdge not to modify the graph")
+	}
+}
+
+func TestRoleGraphDepth(t *testing.T) {
+	g := RoleGraph{}
+	for i := int64(1); i <= MaxInheritanceDepth; i++ {
+		g[i] = []int64{i + 1}
+	}
+	if err := g.Validate(); err != nil {
+		t.Fatalf("expected chain of max depth to be valid, got %v", err)
+	}
+	if err := g.CheckEdge(MaxInheritanceDepth+1, MaxInheritanceDepth+2); !errors.Is(err, ErrInheritanceTooDeep) {
+		t.Errorf("expected too deep error, got %v", err)
+	}
+}
+
+func TestRoleGraphExpand(t *testing.T) {
+	g := RoleGraph{
+		1: {2, 3},
+		2: {4},
+		3: {4},
+	}
+	got := g.Expand(1, 5)
+	want := []int64{1, 5, 2, 3, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expand() = %v, want %v", got, want)
+	}
+}
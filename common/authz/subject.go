@@ -3,18 +3,25 @@ package authz
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/userrole"
 )
 
 // Subject ABAC主体，包含用户的所有授权相关属性
 type Subject struct {
 	UserID     int64    `json:"user_id"`
+	Key        string   `json:"key"` // Casbin g规则中的用户标识，即字符串形式的UserID
 	Username   string   `json:"username"`
 	TenantID   int64    `json:"tenant_id"`   // 当前操作的租户
-	RoleCodes  []string `json:"role_codes"`  // 用户在当前租户下的角色code列表
+	RoleCodes  []string `json:"role_codes"`  // 用户在当前租户下的角色code列表，包含继承的角色
 	IsPlatform bool     `json:"is_platform"` // 是否拥有平台级角色
+	DataScopes []string `json:"data_scopes"` // 角色（含继承）的数据范围，见datascope.Scope
 }
 
 // HasRole 检查是否拥有某个角色
@@ -37,6 +44,19 @@ func (s *Subject) HasAnyRole(roleCodes ...string) bool {
 	return false
 }
 
+// Attributes ABAC求值使用的主体属性，多值属性以逗号连接
+// 属性由服务端根据当前用户构建，调用方不能自行指定
+func (s *Subject) Attributes() map[string]string {
+	return map[string]string{
+		"user_id":     strconv.FormatInt(s.UserID, 10),
+		"username":    s.Username,
+		"tenant_id":   strconv.FormatInt(s.TenantID, 10),
+		"role_codes":  strings.Join(s.RoleCodes, ","),
+		"is_platform": strconv.FormatBool(s.IsPlatform),
+		"data_scopes": strings.Join(s.DataScopes, ","),
+	}
+}
+
 // SubjectBuilder 从数据库构建Subject
 type SubjectBuilder struct {
 	client *ent.Client
@@ -46,7 +66,7 @@ func NewSubjectBuilder(client *ent.Client) *SubjectBuilder {
 	return &SubjectBuilder{client: client}
 }
 
-// BuildSubject 根据userID和tenantID构建Subject
+// BuildSubject 根据userID和tenantID构建Subject，已过期的角色授予不计入，继承的角色会被展开
 func (b *SubjectBuilder) BuildSubject(ctx context.Context, userID int64, tenantID int64) (*Subject, error) {
 	// 查询用户基本信息
 	user, err := b.client.User.Get(ctx, userID)
@@ -56,6 +76,7 @@ func (b *SubjectBuilder) BuildSubject(ctx context.Context, userID int64, tenantI
 
 	sub := &Subject{
 		UserID:     userID,
+		Key:        UserKey(userID),
 		Username:   user.Username,
 		TenantID:   tenantID,
 		RoleCodes:  make([]string, 0),
@@ -63,11 +84,13 @@ func (b *SubjectBuilder) BuildSubject(ctx context.Context, userID int64, tenantI
 	}
 
 	// 查询用户的角色
+	now := time.Now()
 	// 1. 平台级角色（tenant_id IS NULL）
 	platformRoles, err := b.client.UserRole.Query().
 		Where(
 			userrole.UserIDEQ(userID),
 			userrole.TenantIDIsNil(),
+			NotExpired(now),
 		).
 		WithRole().
 		All(ctx)
@@ -75,12 +98,21 @@ func (b *SubjectBuilder) BuildSubject(ctx context.Context, userID int64, tenantI
 		return nil, err
 	}
 
+	var granted []*ent.Role
 	for _, ur := range platformRoles {
 		if ur.Edges.Role != nil && ur.Edges.Role.IsActive {
-			sub.RoleCodes = append(sub.RoleCodes, ur.Edges.Role.Code)
+			granted = append(granted, ur.Edges.Role)
 			sub.IsPlatform = true
 		}
 	}
+	roles, err := b.expandRoles(ctx, granted, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		sub.RoleCodes = append(sub.RoleCodes, r.Code)
+		sub.DataScopes = append(sub.DataScopes, r.DataScope.String())
+	}
 
 	// 2. 租户级角色（当前租户）
 	if tenantID > 0 {
@@ -88,6 +120,7 @@ func (b *SubjectBuilder) BuildSubject(ctx context.Context, userID int64, tenantI
 			Where(
 				userrole.UserIDEQ(userID),
 				userrole.TenantIDEQ(tenantID),
+				NotExpired(now),
 			).
 			WithRole().
 			All(ctx)
@@ -95,12 +128,94 @@ func (b *SubjectBuilder) BuildSubject(ctx context.Context, userID int64, tenantI
 			return nil, err
 		}
 
+		var tenantGranted []*ent.Role
 		for _, ur := range tenantRoles {
 			if ur.Edges.Role != nil && ur.Edges.Role.IsActive {
-				sub.RoleCodes = append(sub.RoleCodes, ur.Edges.Role.Code)
+				tenantGranted = append(tenantGranted, ur.Edges.Role)
 			}
 		}
+		// 租户内授予的角色只在租户范围内生效：平台级角色仅限模板，数据范围不超过TENANT
+		seen := make(map[string]struct{}, len(sub.RoleCodes))
+		for _, code := range sub.RoleCodes {
+			seen[code] = struct{}{}
+		}
+		roles, err := b.expandRoles(ctx, tenantGranted, TenantRoleAllowed)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range roles {
+			if _, ok := seen[r.Code]; ok {
+				continue
+			}
+			sub.RoleCodes = append(sub.RoleCodes, r.Code)
+			sub.DataScopes = append(sub.DataScopes, TenantDataScope(r.DataScope).String())
+		}
 	}
 
 	return sub, nil
 }
+
+// TenantRoleAllowed 租户内授予或继承的角色是否生效：租户角色，或非ALL范围的平台级角色模板
+func TenantRoleAllowed(r *ent.Role) bool {
+	if r.TenantID != nil {
+		return true
+	}
+	return r.IsTemplate && r.DataScope != role.DataScopeALL
+}
+
+// TenantDataScope 租户内生效的数据范围，ALL收敛为TENANT
+func TenantDataScope(scope role.DataScope) role.DataScope {
+	if scope == role.DataScopeALL {
+		return role.DataScopeTENANT
+	}
+	return scope
+}
+
+// expandRoles 沿继承关系逐层展开角色，返回去重后包含自身在内的所有启用角色
+// 停用的角色不再向下展开；allow不为nil时，不满足allow的角色既不计入也不再向下展开
+func (b *SubjectBuilder) expandRoles(ctx context.Context, granted []*ent.Role, allow func(*ent.Role) bool) ([]*ent.Role, error) {
+	seen := make(map[int64]struct{}, len(granted))
+	roles := make([]*ent.Role, 0, len(granted))
+	var frontier []int64
+	for _, r := range granted {
+		if _, ok := seen[r.ID]; ok {
+			continue
+		}
+		seen[r.ID] = struct{}{}
+		if allow != nil && !allow(r) {
+			continue
+		}
+		roles = append(roles, r)
+		frontier = append(frontier, r.ID)
+	}
+
+	for depth := 0; len(frontier) > 0 && depth < MaxInheritanceDepth; depth++ {
+		inherited, err := b.client.Role.Query().
+			Where(
+				role.HasInheritedByWith(role.IDIn(frontier...)),
+				role.IsActive(true),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		frontier = nil
+		for _, r := range inherited {
+			if _, ok := seen[r.ID]; ok {
+				continue
+			}
+			seen[r.ID] = struct{}{}
+			if allow != nil && !allow(r) {
+				continue
+			}
+			roles = append(roles, r)
+			frontier = append(frontier, r.ID)
+		}
+	}
+	return roles, nil
+}
+
+// NotExpired 未过期的角色授予（expires_at为NULL表示永久有效）
+func NotExpired(now time.Time) predicate.UserRole {
+	return userrole.Or(userrole.ExpiresAtIsNil(), userrole.ExpiresAtGT(now))
+}
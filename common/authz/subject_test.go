@@ -0,0 +1,58 @@
+package authz
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/role"
+)
+
+func TestSubjectAttributes(t *testing.T) {
+	s := &Subject{
+		UserID:     42,
+		Username:   "alice",
+		TenantID:   7,
+		RoleCodes:  []string{"admin", "auditor"},
+		DataScopes: []string{"DEPT"},
+	}
+	want := map[string]string{
+		"user_id":     "42",
+		"username":    "alice",
+		"tenant_id":   "7",
+		"role_codes":  "admin,auditor",
+		"is_platform": "false",
+		"data_scopes": "DEPT",
+	}
+	if got := s.Attributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Attributes() = %v, want %v", got, want)
+	}
+}
+
+func TestTenantRoleAllowed(t *testing.T) {
+	tenantID := int64(7)
+	tests := []struct {
+		name string
+		role *ent.Role
+		want bool
+	}{
+		{"tenant role", &ent.Role{TenantID: &tenantID, DataScope: role.DataScopeTENANT}, true},
+		{"platform template", &ent.Role{IsTemplate: true, DataScope: role.DataScopeTENANT}, true},
+		{"platform non-template", &ent.Role{DataScope: role.DataScopeTENANT}, false},
+		{"platform template with ALL", &ent.Role{IsTemplate: true, DataScope: role.DataScopeALL}, false},
+	}
+	for _, tt := range tests {
+		if got := TenantRoleAllowed(tt.role); got != tt.want {
+			t.Errorf("%s: TenantRoleAllowed() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTenantDataScope(t *testing.T) {
+	if got := TenantDataScope(role.DataScopeALL); got != role.DataScopeTENANT {
+		t.Errorf("TenantDataScope(ALL) = %v, want TENANT", got)
+	}
+	if got := TenantDataScope(role.DataScopeSELF); got != role.DataScopeSELF {
+		t.Errorf("TenantDataScope(SELF) = %v, want SELF", got)
+	}
+}
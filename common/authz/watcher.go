@@ -0,0 +1,260 @@
+// admin/common/authz/watcher.go
+package authz
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/yc-alpha/logger"
+)
+
+var (
+	_ persist.WatcherEx        = (*Watcher)(nil)
+	_ persist.UpdatableWatcher = (*Watcher)(nil)
+)
+
+// UpdateType 策略变更类型
+type UpdateType string
+
+const (
+	UpdateForReload               UpdateType = "Reload" // 全量重新加载
+	UpdateForAddPolicies          UpdateType = "AddPolicies"
+	UpdateForRemovePolicies       UpdateType = "RemovePolicies"
+	UpdateForRemoveFilteredPolicy UpdateType = "RemoveFilteredPolicy"
+	UpdateForUpdatePolicies       UpdateType = "UpdatePolicies"
+)
+
+// PolicyMessage 实例之间广播的策略变更消息
+type PolicyMessage struct {
+	Instance    string     `json:"instance"`
+	Method      UpdateType `json:"method"`
+	Sec         string     `json:"sec,omitempty"`
+	Ptype       string     `json:"ptype,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+	NewRules    [][]string `json:"new_rules,omitempty"`
+	FieldIndex  int        `json:"field_index,omitempty"`
+	FieldValues []string   `json:"field_values,omitempty"`
+}
+
+// watcherTransport 策略变更消息的传输通道
+type watcherTransport interface {
+	// publish 广播消息
+	publish(payload []byte) error
+	// subscribe 订阅消息，reload在连接中断恢复后调用（期间的消息可能已丢失）
+	subscribe(handle func(payload []byte), reload func()) error
+	// maxPayload 单条消息的最大字节数，0表示不限制
+	maxPayload() int
+	close()
+}
+
+// Watcher Casbin多实例策略同步器
+// 本实例通过Enforcer修改策略后广播增量消息，其他实例收到后只更新内存中的对应策略
+type Watcher struct {
+	instance  string
+	transport watcherTransport
+
+	mu       sync.RWMutex
+	callback func(string)
+}
+
+func newWatcher(transport watcherTransport) (*Watcher, error) {
+	instance, err := newInstanceID()
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{instance: instance, transport: transport}
+	if err := transport.subscribe(w.receive, w.reload); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// SetUpdateCallback 设置收到其他实例变更消息时的回调，通常为SyncCallback
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+// Update 通知其他实例全量重新加载策略
+func (w *Watcher) Update() error {
+	return w.send(&PolicyMessage{Method: UpdateForReload})
+}
+
+func (w *Watcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForAddPolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForRemovePolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.send(&PolicyMessage{
+		Method:      UpdateForRemoveFilteredPolicy,
+		Sec:         sec,
+		Ptype:       ptype,
+		FieldIndex:  fieldIndex,
+		FieldValues: fieldValues,
+	})
+}
+
+// UpdateForSavePolicy SavePolicy会替换全部策略，通知其他实例全量重新加载
+func (w *Watcher) UpdateForSavePolicy(model model.Model) error {
+	return w.Update()
+}
+
+func (w *Watcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.send(&PolicyMessage{Method: UpdateForAddPolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+func (w *Watcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.send(&PolicyMessage{Method: UpdateForRemovePolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+func (w *Watcher) UpdateForUpdatePolicy(sec string, ptype string, oldRule, newRule []string) error {
+	return w.UpdateForUpdatePolicies(sec, ptype, [][]string{oldRule}, [][]string{newRule})
+}
+
+func (w *Watcher) UpdateForUpdatePolicies(sec string, ptype string, oldRules, newRules [][]string) error {
+	return w.send(&PolicyMessage{Method: UpdateForUpdatePolicies, Sec: sec, Ptype: ptype, Rules: oldRules, NewRules: newRules})
+}
+
+// Close 停止监听，之后不再触发回调
+func (w *Watcher) Close() {
+	w.transport.close()
+	_ = w.SetUpdateCallback(nil)
+}
+
+func (w *Watcher) send(msg *PolicyMessage) error {
+	msg.Instance = w.instance
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	// 消息超过通道限制时（如PostgreSQL NOTIFY的8000字节），退化为全量重新加载
+	if limit := w.transport.maxPayload(); limit > 0 && len(payload) > limit {
+		payload, err = json.Marshal(&PolicyMessage{Instance: w.instance, Method: UpdateForReload})
+		if err != nil {
+			return err
+		}
+	}
+	return w.transport.publish(payload)
+}
+
+func (w *Watcher) receive(payload []byte) {
+	var msg PolicyMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		logger.Errorf("解析策略变更消息失败: %v", err)
+		return
+	}
+	// 忽略本实例发出的消息
+	if msg.Instance == w.instance {
+		return
+	}
+	w.dispatch(string(payload))
+}
+
+func (w *Watcher) reload() {
+	payload, err := json.Marshal(&PolicyMessage{Method: UpdateForReload})
+	if err != nil {
+		return
+	}
+	w.dispatch(string(payload))
+}
+
+func (w *Watcher) dispatch(msg string) {
+	w.mu.RLock()
+	callback := w.callback
+	w.mu.RUnlock()
+	if callback != nil {
+		callback(msg)
+	}
+}
+
+// SyncCallback 返回将其他实例的策略变更增量应用到enforcer的回调
+// 变更只作用于内存中的model，不会再次写入数据库，也不会再次广播
+func SyncCallback(enforcer *casbin.SyncedEnforcer) func(string) {
+	return func(payload string) {
+		var msg PolicyMessage
+		if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+			logger.Errorf("解析策略变更消息失败: %v", err)
+			return
+		}
+		if err := applyPolicyMessage(enforcer, &msg); err != nil {
+			logger.Errorf("增量同步策略失败，重新加载全部策略: %v", err)
+			msg.Method = UpdateForReload
+		}
+		if msg.Method == UpdateForReload {
+			if err := enforcer.LoadPolicy(); err != nil {
+				logger.Errorf("重新加载策略失败: %v", err)
+			}
+		}
+	}
+}
+
+var errUnknownUpdateType = errors.New("unknown policy update type")
+
+func applyPolicyMessage(enforcer *casbin.SyncedEnforcer, msg *PolicyMessage) error {
+	if msg.Method == UpdateForReload {
+		return nil
+	}
+
+	lock := enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	m := enforcer.GetModel()
+	switch msg.Method {
+	case UpdateForAddPolicies:
+		affected, err := m.AddPoliciesWithAffected(msg.Sec, msg.Ptype, msg.Rules)
+		if err != nil {
+			return err
+		}
+		return buildRoleLinks(enforcer, msg.Sec, model.PolicyAdd, msg.Ptype, affected)
+	case UpdateForRemovePolicies:
+		affected, err := m.RemovePoliciesWithAffected(msg.Sec, msg.Ptype, msg.Rules)
+		if err != nil {
+			return err
+		}
+		return buildRoleLinks(enforcer, msg.Sec, model.PolicyRemove, msg.Ptype, affected)
+	case UpdateForRemoveFilteredPolicy:
+		_, affected, err := m.RemoveFilteredPolicy(msg.Sec, msg.Ptype, msg.FieldIndex, msg.FieldValues...)
+		if err != nil {
+			return err
+		}
+		return buildRoleLinks(enforcer, msg.Sec, model.PolicyRemove, msg.Ptype, affected)
+	case UpdateForUpdatePolicies:
+		if _, err := m.UpdatePolicies(msg.Sec, msg.Ptype, msg.Rules, msg.NewRules); err != nil {
+			return err
+		}
+		if err := buildRoleLinks(enforcer, msg.Sec, model.PolicyRemove, msg.Ptype, msg.Rules); err != nil {
+			return err
+		}
+		return buildRoleLinks(enforcer, msg.Sec, model.PolicyAdd, msg.Ptype, msg.NewRules)
+	default:
+		return errUnknownUpdateType
+	}
+}
+
+func buildRoleLinks(enforcer *casbin.SyncedEnforcer, sec string, op model.PolicyOp, ptype string, rules [][]string) error {
+	if sec != "g" || len(rules) == 0 {
+		return nil
+	}
+	return enforcer.Enforcer.BuildIncrementalRoleLinks(op, ptype, rules)
+}
+
+func newInstanceID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,74 @@
+// admin/common/authz/watcher_etcd.go
+package authz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// DefaultWatcherKey 默认的策略变更通知key
+const DefaultWatcherKey = "/paas.admin/casbin/policy"
+
+// etcdMaxPayload etcd默认单个请求上限为1.5MiB，预留余量
+const etcdMaxPayload = 1 << 20
+
+// NewEtcdWatcher 基于etcd watch的Watcher，每次变更写入同一个key
+func NewEtcdWatcher(client *clientv3.Client, key string) (*Watcher, error) {
+	if key == "" {
+		key = DefaultWatcherKey
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return newWatcher(&etcdTransport{client: client, key: key, ctx: ctx, cancel: cancel})
+}
+
+type etcdTransport struct {
+	client *clientv3.Client
+	key    string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	once   sync.Once
+}
+
+func (t *etcdTransport) publish(payload []byte) error {
+	ctx, cancel := context.WithTimeout(t.ctx, 5*time.Second)
+	defer cancel()
+	_, err := t.client.Put(ctx, t.key, string(payload))
+	return err
+}
+
+func (t *etcdTransport) subscribe(handle func([]byte), reload func()) error {
+	go func() {
+		for t.ctx.Err() == nil {
+			for resp := range t.client.Watch(t.ctx, t.key) {
+				// 被压缩或出错时可能丢失事件，全量重新加载
+				if err := resp.Err(); err != nil {
+					reload()
+					continue
+				}
+				for _, ev := range resp.Events {
+					if ev.Type == clientv3.EventTypePut {
+						handle(ev.Kv.Value)
+					}
+				}
+			}
+			// watch通道关闭后重新建立，期间的事件可能已丢失
+			if t.ctx.Err() == nil {
+				reload()
+				time.Sleep(time.Second)
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *etcdTransport) maxPayload() int {
+	return etcdMaxPayload
+}
+
+func (t *etcdTransport) close() {
+	t.once.Do(t.cancel)
+}
@@ -0,0 +1,92 @@
+// admin/common/authz/watcher_postgres.go
+package authz
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yc-alpha/logger"
+)
+
+// DefaultWatcherChannel 默认的策略变更通知通道
+const DefaultWatcherChannel = "casbin_policy"
+
+// pgNotifyMaxPayload PostgreSQL NOTIFY payload上限为8000字节
+const pgNotifyMaxPayload = 7999
+
+// NewPostgresWatcher 基于PostgreSQL LISTEN/NOTIFY的Watcher
+// 通知通过db发送；LISTEN需要独占连接，使用dsn单独建立pq.Listener
+func NewPostgresWatcher(db *sql.DB, dsn string, channel string) (*Watcher, error) {
+	if channel == "" {
+		channel = DefaultWatcherChannel
+	}
+	return newWatcher(&pgTransport{db: db, dsn: dsn, channel: channel, done: make(chan struct{})})
+}
+
+type pgTransport struct {
+	db      *sql.DB
+	dsn     string
+	channel string
+
+	listener *pq.Listener
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (t *pgTransport) publish(payload []byte) error {
+	_, err := t.db.Exec("SELECT pg_notify($1, $2)", t.channel, string(payload))
+	return err
+}
+
+func (t *pgTransport) subscribe(handle func([]byte), reload func()) error {
+	t.listener = pq.NewListener(t.dsn, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Errorf("策略变更监听连接异常: %v", err)
+		}
+	})
+	if err := t.listener.Listen(t.channel); err != nil {
+		_ = t.listener.Close()
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-t.done:
+				return
+			case n, ok := <-t.listener.Notify:
+				if !ok {
+					return
+				}
+				// 重连后会收到nil，断线期间的通知已丢失，需要全量重新加载
+				if n == nil {
+					reload()
+					continue
+				}
+				handle([]byte(n.Extra))
+			case <-time.After(90 * time.Second):
+				go func() {
+					if err := t.listener.Ping(); err != nil {
+						logger.Errorf("策略变更监听连接检测失败: %v", err)
+					}
+				}()
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *pgTransport) maxPayload() int {
+	return pgNotifyMaxPayload
+}
+
+func (t *pgTransport) close() {
+	t.once.Do(func() {
+		close(t.done)
+		if t.listener != nil {
+			_ = t.listener.Close()
+		}
+	})
+}
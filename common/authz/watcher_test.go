@@ -0,0 +1,102 @@
+package authz
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+)
+
+// memoryBus 进程内的消息通道，模拟多实例共享的NOTIFY通道
+type memoryBus struct {
+	mu       sync.Mutex
+	handlers []func([]byte)
+}
+
+type memoryTransport struct {
+	bus *memoryBus
+}
+
+func (t *memoryTransport) publish(payload []byte) error {
+	t.bus.mu.Lock()
+	handlers := append([]func([]byte){}, t.bus.handlers...)
+	t.bus.mu.Unlock()
+	for _, handle := range handlers {
+		handle(payload)
+	}
+	return nil
+}
+
+func (t *memoryTransport) subscribe(handle func([]byte), reload func()) error {
+	t.bus.mu.Lock()
+	defer t.bus.mu.Unlock()
+	t.bus.handlers = append(t.bus.handlers, handle)
+	return nil
+}
+
+func (t *memoryTransport) maxPayload() int { return 0 }
+func (t *memoryTransport) close()          {}
+
+func newSyncedTestEnforcer(t *testing.T, bus *memoryBus) *casbin.SyncedEnforcer {
+	t.Helper()
+	m, err := model.NewModelFromString(DefaultModel)
+	if err != nil {
+		t.Fatalf("load model: %v", err)
+	}
+	e, err := newEnforcer(m, nil)
+	if err != nil {
+		t.Fatalf("new enforcer: %v", err)
+	}
+	w, err := newWatcher(&memoryTransport{bus: bus})
+	if err != nil {
+		t.Fatalf("new watcher: %v", err)
+	}
+	if err := e.SetWatcher(w); err != nil {
+		t.Fatalf("set watcher: %v", err)
+	}
+	_ = w.SetUpdateCallback(SyncCallback(e))
+	return e
+}
+
+func TestWatcherSyncsIncrementalChanges(t *testing.T) {
+	bus := &memoryBus{}
+	a := newSyncedTestEnforcer(t, bus)
+	b := newSyncedTestEnforcer(t, bus)
+
+	sub := &Subject{UserID: 1, Key: "1"}
+	enforce := func(e *casbin.SyncedEnforcer) bool {
+		ok, err := e.Enforce(sub, "200", "/admin.v1.UserService/ListUsers", "GET")
+		if err != nil {
+			t.Fatalf("enforce: %v", err)
+		}
+		return ok
+	}
+
+	if _, err := a.AddPolicy("viewer", "200", "/admin.v1.UserService/*", "GET", "allow"); err != nil {
+		t.Fatalf("add policy: %v", err)
+	}
+	if _, err := a.AddGroupingPolicy("1", "viewer", "200"); err != nil {
+		t.Fatalf("add grouping policy: %v", err)
+	}
+	if !enforce(b) {
+		t.Fatal("expected replica to receive added policy and grouping")
+	}
+
+	if _, err := a.RemoveGroupingPolicy("1", "viewer", "200"); err != nil {
+		t.Fatalf("remove grouping policy: %v", err)
+	}
+	if enforce(b) {
+		t.Fatal("expected replica to receive removed grouping")
+	}
+
+	if _, err := a.AddGroupingPolicy("1", "viewer", "200"); err != nil {
+		t.Fatalf("add grouping policy: %v", err)
+	}
+	if _, err := a.RemoveFilteredPolicy(0, "viewer"); err != nil {
+		t.Fatalf("remove filtered policy: %v", err)
+	}
+	if enforce(b) {
+		t.Fatal("expected replica to receive filtered removal")
+	}
+}
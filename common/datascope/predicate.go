@@ -0,0 +1,91 @@
+// admin/common/datascope/predicate.go
+package datascope
+
+import (
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+	"github.com/yc-alpha/admin/ent/usertenant"
+)
+
+// Users 返回用户列表的过滤条件，不限制时返回nil，用法：q.Where(scope.Users()...)
+// 用户通过user_tenants、user_departments归属到租户和部门；SELF包含本人和本人创建的用户
+func (d *DataScope) Users() []predicate.User {
+	if d.Unrestricted() {
+		return nil
+	}
+	var ps []predicate.User
+	for _, s := range d.Scopes {
+		switch {
+		case s == TenantSubtree && d.TenantPath != "":
+			ps = append(ps, user.HasUserTenantsWith(usertenant.HasTenantWith(predicate.Tenant(DescendantOf(tenant.FieldPath, d.TenantPath)))))
+		case s == Tenant && d.TenantID > 0:
+			ps = append(ps, user.HasUserTenantsWith(usertenant.TenantID(d.TenantID)))
+		case s == DeptSubtree && len(d.DeptPaths) > 0:
+			ps = append(ps, user.HasUserDepartmentsWith(userdepartment.HasDepartmentWith(d.departmentSubtree())))
+		}
+	}
+	ps = append(ps, user.ID(d.UserID), user.CreatedBy(d.UserID))
+	return []predicate.User{user.Or(ps...)}
+}
+
+// Tenants 返回租户列表的过滤条件，不限制时返回nil
+// DEPT_SUBTREE只能看到部门所在的当前租户
+func (d *DataScope) Tenants() []predicate.Tenant {
+	if d.Unrestricted() {
+		return nil
+	}
+	var ps []predicate.Tenant
+	for _, s := range d.Scopes {
+		switch {
+		case s == TenantSubtree && d.TenantPath != "":
+			ps = append(ps, predicate.Tenant(DescendantOf(tenant.FieldPath, d.TenantPath)))
+		case (s == Tenant || s == DeptSubtree) && d.TenantID > 0:
+			ps = append(ps, tenant.ID(d.TenantID))
+		}
+	}
+	ps = append(ps, tenant.CreatedBy(d.UserID))
+	return []predicate.Tenant{tenant.Or(ps...)}
+}
+
+// Departments 返回部门列表的过滤条件，不限制时返回nil
+func (d *DataScope) Departments() []predicate.Department {
+	if d.Unrestricted() {
+		return nil
+	}
+	var ps []predicate.Department
+	for _, s := range d.Scopes {
+		switch {
+		case s == TenantSubtree && d.TenantPath != "":
+			ps = append(ps, department.HasTenantWith(predicate.Tenant(DescendantOf(tenant.FieldPath, d.TenantPath))))
+		case s == Tenant && d.TenantID > 0:
+			ps = append(ps, department.TenantID(d.TenantID))
+		case s == DeptSubtree && len(d.DeptPaths) > 0:
+			ps = append(ps, d.departmentSubtree())
+		}
+	}
+	ps = append(ps, department.CreatedBy(d.UserID))
+	return []predicate.Department{department.Or(ps...)}
+}
+
+// departmentSubtree 用户所在部门及其下级部门
+func (d *DataScope) departmentSubtree() predicate.Department {
+	ps := make([]predicate.Department, 0, len(d.DeptPaths))
+	for _, path := range d.DeptPaths {
+		ps = append(ps, predicate.Department(DescendantOf(department.FieldPath, path)))
+	}
+	return department.Or(ps...)
+}
+
+// DescendantOf ltree列为ancestor本身或其后代（column <@ ancestor）
+func DescendantOf(column, ancestor string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(column)).WriteString(" <@ ").Arg(ancestor).WriteString("::ltree")
+		}))
+	}
+}
@@ -0,0 +1,58 @@
+// admin/common/datascope/resolver.go
+package datascope
+
+import (
+	"context"
+	"slices"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/userdepartment"
+)
+
+// Resolver 根据角色的数据范围加载过滤所需的租户路径和部门路径
+type Resolver struct {
+	client *ent.Client
+}
+
+// NewResolver 创建数据范围解析器
+func NewResolver(client *ent.Client) *Resolver {
+	return &Resolver{client: client}
+}
+
+// Resolve 合并scopes并构建用户在租户下的数据范围
+func (r *Resolver) Resolve(ctx context.Context, userID, tenantID int64, scopes []Scope) (*DataScope, error) {
+	d := &DataScope{
+		UserID:   userID,
+		TenantID: tenantID,
+		Scopes:   Normalize(scopes),
+	}
+	if d.Unrestricted() || tenantID == 0 {
+		return d, nil
+	}
+
+	if slices.Contains(d.Scopes, TenantSubtree) {
+		t, err := r.client.Tenant.Get(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		if t.Path != nil {
+			d.TenantPath = *t.Path
+		}
+	}
+	if slices.Contains(d.Scopes, DeptSubtree) {
+		paths, err := r.client.Department.Query().
+			Where(
+				department.TenantID(tenantID),
+				department.DeletedAtIsNil(),
+				department.HasUserDepartmentsWith(userdepartment.UserID(userID)),
+			).
+			Select(department.FieldPath).
+			Strings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		d.DeptPaths = paths
+	}
+	return d, nil
+}
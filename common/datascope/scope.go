@@ -0,0 +1,80 @@
+// admin/common/datascope/scope.go
+package datascope
+
+import (
+	"context"
+	"slices"
+)
+
+// Scope 数据范围，决定列表查询能看到哪些行
+type Scope string
+
+const (
+	All           Scope = "ALL"            // 全部数据
+	TenantSubtree Scope = "TENANT_SUBTREE" // 当前租户及其下级租户（tenants.path ltree子树）
+	Tenant        Scope = "TENANT"         // 当前租户
+	DeptSubtree   Scope = "DEPT_SUBTREE"   // 用户所在部门及其下级部门（departments.path ltree子树）
+	Self          Scope = "SELF"           // 本人创建的数据（created_by）
+)
+
+// order 数据范围从宽到窄的顺序
+var order = []Scope{All, TenantSubtree, Tenant, DeptSubtree, Self}
+
+// Valid 是否为合法的数据范围
+func (s Scope) Valid() bool {
+	return slices.Contains(order, s)
+}
+
+// Normalize 合并多个角色的数据范围，结果按从宽到窄排序并去除被覆盖的范围
+// 包含ALL时只保留ALL，TENANT_SUBTREE覆盖TENANT；没有任何范围时返回SELF
+func Normalize(scopes []Scope) []Scope {
+	set := make(map[Scope]struct{}, len(scopes))
+	for _, s := range scopes {
+		if s.Valid() {
+			set[s] = struct{}{}
+		}
+	}
+	if len(set) == 0 {
+		return []Scope{Self}
+	}
+	if _, ok := set[All]; ok {
+		return []Scope{All}
+	}
+	if _, ok := set[TenantSubtree]; ok {
+		delete(set, Tenant)
+	}
+	result := make([]Scope, 0, len(set))
+	for _, s := range order {
+		if _, ok := set[s]; ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// DataScope 当前请求生效的数据范围，多个范围之间取并集
+type DataScope struct {
+	UserID     int64
+	TenantID   int64    // 当前租户，为0时租户相关的范围退化为SELF
+	Scopes     []Scope  // 经过Normalize的数据范围
+	TenantPath string   // 当前租户的ltree路径，TENANT_SUBTREE时使用
+	DeptPaths  []string // 用户在当前租户下所在部门的ltree路径，DEPT_SUBTREE时使用
+}
+
+// Unrestricted 是否不限制数据范围，nil表示未启用数据范围控制
+func (d *DataScope) Unrestricted() bool {
+	return d == nil || slices.Contains(d.Scopes, All)
+}
+
+type contextKey struct{}
+
+// NewContext 将数据范围写入context
+func NewContext(ctx context.Context, d *DataScope) context.Context {
+	return context.WithValue(ctx, contextKey{}, d)
+}
+
+// FromContext 读取context中的数据范围，未设置时返回nil（不限制）
+func FromContext(ctx context.Context) *DataScope {
+	d, _ := ctx.Value(contextKey{}).(*DataScope)
+	return d
+}
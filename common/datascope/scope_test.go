@@ -0,0 +1,87 @@
+package datascope
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/user"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []Scope
+		want []Scope
+	}{
+		{"empty defaults to self", nil, []Scope{Self}},
+		{"invalid ignored", []Scope{"UNKNOWN"}, []Scope{Self}},
+		{"all wins", []Scope{Self, All, Tenant}, []Scope{All}},
+		{"subtree covers tenant", []Scope{Tenant, TenantSubtree}, []Scope{TenantSubtree}},
+		{"ordered and deduplicated", []Scope{Self, DeptSubtree, Self, Tenant}, []Scope{Tenant, DeptSubtree, Self}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnrestricted(t *testing.T) {
+	var d *DataScope
+	if !d.Unrestricted() || d.Users() != nil {
+		t.Error("expected nil scope to be unrestricted")
+	}
+	if !(&DataScope{Scopes: []Scope{All}}).Unrestricted() {
+		t.Error("expected ALL to be unrestricted")
+	}
+	if (&DataScope{Scopes: []Scope{Tenant}}).Unrestricted() {
+		t.Error("expected TENANT to be restricted")
+	}
+}
+
+func TestContext(t *testing.T) {
+	if FromContext(context.Background()) != nil {
+		t.Error("expected nil scope without context value")
+	}
+	d := &DataScope{UserID: 1}
+	if FromContext(NewContext(context.Background(), d)) != d {
+		t.Error("expected scope from context")
+	}
+}
+
+func TestDescendantOf(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(department.Table))
+	DescendantOf(department.FieldPath, "100.200")(s)
+	query, args := s.Query()
+	if !strings.Contains(query, `"departments"."path" <@ $1::ltree`) {
+		t.Errorf("unexpected query: %s", query)
+	}
+	if !reflect.DeepEqual(args, []any{"100.200"}) {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
+
+func TestUsersPredicate(t *testing.T) {
+	d := &DataScope{UserID: 7, TenantID: 200, Scopes: []Scope{Tenant}}
+	ps := d.Users()
+	if len(ps) != 1 {
+		t.Fatalf("expected one combined predicate, got %d", len(ps))
+	}
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(user.Table))
+	ps[0](s)
+	query, args := s.Query()
+	if !strings.Contains(query, "user_tenants") || !strings.Contains(query, " OR ") {
+		t.Errorf("unexpected query: %s", query)
+	}
+	if !reflect.DeepEqual(args, []any{int64(200), int64(7), int64(7)}) {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
@@ -0,0 +1,79 @@
+// admin/common/event/bus.go
+package event
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yc-alpha/logger"
+)
+
+// Event 领域事件
+type Event struct {
+	Topic      string    `json:"topic"`
+	Payload    any       `json:"payload"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// New 创建事件，OccurredAt为当前时间
+func New(topic string, payload any) Event {
+	return Event{Topic: topic, Payload: payload, OccurredAt: time.Now()}
+}
+
+// Handler 事件处理函数
+type Handler func(ctx context.Context, e Event)
+
+// Publisher 事件发布者
+type Publisher interface {
+	Publish(ctx context.Context, e Event)
+}
+
+// Bus 进程内事件总线，同步调用订阅者
+// 订阅者的panic会被恢复并记录日志，不影响发布者和其他订阅者
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus 创建事件总线
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe 订阅主题，topic为*时订阅所有事件
+func (b *Bus) Subscribe(topic string, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[topic] = append(b.handlers[topic], h)
+}
+
+// Publish 发布事件
+func (b *Bus) Publish(ctx context.Context, e Event) {
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers[e.Topic])+len(b.handlers["*"]))
+	handlers = append(handlers, b.handlers[e.Topic]...)
+	handlers = append(handlers, b.handlers["*"]...)
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		dispatch(ctx, h, e)
+	}
+}
+
+func dispatch(ctx context.Context, h Handler, e Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("处理事件[%s]失败: %v", e.Topic, r)
+		}
+	}()
+	h(ctx, e)
+}
+
+// LogHandler 将事件写入日志
+func LogHandler(ctx context.Context, e Event) {
+	logger.Infof("事件[%s] %+v", e.Topic, e.Payload)
+}
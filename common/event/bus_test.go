@@ -0,0 +1,39 @@
+package event
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBusPublish(t *testing.T) {
+	bus := NewBus()
+	var topics, all []string
+	bus.Subscribe("user_role.expired", func(ctx context.Context, e Event) {
+		topics = append(topics, e.Topic)
+	})
+	bus.Subscribe("*", func(ctx context.Context, e Event) {
+		all = append(all, e.Topic)
+	})
+
+	bus.Publish(context.Background(), New("user_role.expired", nil))
+	bus.Publish(context.Background(), New("tenant.created", nil))
+
+	if len(topics) != 1 || topics[0] != "user_role.expired" {
+		t.Errorf("unexpected topic subscriber calls: %v", topics)
+	}
+	if len(all) != 2 {
+		t.Errorf("expected wildcard subscriber to receive every event, got %v", all)
+	}
+}
+
+func TestBusRecoversHandlerPanic(t *testing.T) {
+	bus := NewBus()
+	called := false
+	bus.Subscribe("t", func(ctx context.Context, e Event) { panic("boom") })
+	bus.Subscribe("t", func(ctx context.Context, e Event) { called = true })
+
+	bus.Publish(context.Background(), Event{Topic: "t"})
+	if !called {
+		t.Error("expected later handler to run after a panicking handler")
+	}
+}
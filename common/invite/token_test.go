@@ -0,0 +1,79 @@
+package invite
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/yc-alpha/admin/common/authn"
+)
+
+func TestSignerSignAndParse(t *testing.T) {
+	s := NewSigner("test-secret", "paas.admin")
+	now := time.Now()
+	token, err := s.Sign(42, "partner@example.com", Claims{
+		TenantID:     1001,
+		DepartmentID: 2001,
+		RoleIDs:      []int64{3, 5},
+		Nonce:        "n1",
+	}, now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	claims, err := s.Parse(token)
+	if err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	if claims.InvitationID() != 42 || claims.Subject != "partner@example.com" {
+		t.Errorf("unexpected registered claims: %+v", claims.RegisteredClaims)
+	}
+	if claims.TenantID != 1001 || claims.DepartmentID != 2001 || !slices.Equal(claims.RoleIDs, []int64{3, 5}) || claims.Nonce != "n1" {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+}
+
+func TestSignerRejectsExpired(t *testing.T) {
+	s := NewSigner("test-secret", "paas.admin")
+	now := time.Now().Add(-2 * time.Hour)
+	token, err := s.Sign(1, "+8613812345678", Claims{TenantID: 1, Nonce: "n"}, now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	if _, err := s.Parse(token); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expected ErrTokenExpired, got %v", err)
+	}
+}
+
+func TestSignerRejectsForeignSignature(t *testing.T) {
+	now := time.Now()
+	token, err := NewSigner("secret-a", "paas.admin").Sign(1, "a@example.com", Claims{TenantID: 1, Nonce: "n"}, now, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	if _, err := NewSigner("secret-b", "paas.admin").Parse(token); !errors.Is(err, ErrTokenInvalid) {
+		t.Errorf("expected ErrTokenInvalid, got %v", err)
+	}
+}
+
+func TestSignerRejectsAccessToken(t *testing.T) {
+	pair, err := authn.NewTokenManager("test-secret", "paas.admin", time.Hour, time.Hour).Issue(1, "admin", 0)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	if _, err := NewSigner("test-secret", "paas.admin").Parse(pair.AccessToken); !errors.Is(err, ErrTokenInvalid) {
+		t.Errorf("expected ErrTokenInvalid, got %v", err)
+	}
+}
+
+func TestNewNonce(t *testing.T) {
+	a, err := NewNonce()
+	if err != nil {
+		t.Fatalf("new nonce: %v", err)
+	}
+	b, _ := NewNonce()
+	if len(a) != 32 || a == b {
+		t.Errorf("unexpected nonces %q %q", a, b)
+	}
+}
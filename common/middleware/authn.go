@@ -0,0 +1,192 @@
+// admin/common/middleware/authn.go
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/yc-alpha/admin/common/authn"
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/tenancy"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/user"
+	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/admin/ent/usertenant"
+)
+
+const (
+	// AuthorizationHeader 携带Bearer令牌的请求头
+	AuthorizationHeader = "Authorization"
+	// TenantHeader 指定当前操作租户的请求头（gRPC中为同名metadata）
+	TenantHeader = "x-tenant-id"
+
+	bearerPrefix = "Bearer "
+)
+
+// AuthnMiddleware 认证中间件
+// 1. 校验Authorization中的Bearer访问令牌，令牌版本需与用户当前版本一致（见TokenVersions）
+// 2. 确认用户存在且处于ACTIVE状态
+// 3. 解析x-tenant-id并校验用户是否属于该租户，以及该租户和上级租户是否处于ACTIVE状态
+// 4. 将用户ID和租户ID写入context，供AuthzMiddleware读取
+func AuthnMiddleware(tokens *authn.TokenManager, client *ent.Client) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", "missing transport context")
+			}
+
+			// 1. 校验令牌
+			token, ok := extractBearerToken(tr.RequestHeader().Get(AuthorizationHeader))
+			if !ok {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", "missing bearer token")
+			}
+			claims, err := tokens.ParseAccessToken(ctx, token)
+			if err != nil {
+				switch {
+				case errors.Is(err, authn.ErrTokenExpired):
+					return nil, kerrors.Unauthorized("TOKEN_EXPIRED", err.Error())
+				case errors.Is(err, authn.ErrTokenRevoked):
+					return nil, kerrors.Unauthorized("TOKEN_REVOKED", err.Error())
+				case authn.IsTokenError(err):
+					return nil, kerrors.Unauthorized("UNAUTHORIZED", err.Error())
+				}
+				return nil, kerrors.InternalServer("AUTHN_ERROR", err.Error())
+			}
+
+			// 2. 校验用户状态
+			u, err := client.User.Query().
+				Where(user.ID(claims.UserID()), user.DeletedAtIsNil()).
+				Only(ctx)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return nil, kerrors.Unauthorized("UNAUTHORIZED", "user not found")
+				}
+				return nil, kerrors.InternalServer("AUTHN_ERROR", err.Error())
+			}
+			if u.Status != user.StatusACTIVE {
+				return nil, kerrors.Forbidden("USER_INACTIVE", "user is "+u.Status.String())
+			}
+
+			// 3. 解析当前租户
+			tenantID, err := resolveTenant(ctx, client, u.ID, tr.RequestHeader().Get(TenantHeader))
+			if err != nil {
+				return nil, err
+			}
+
+			ctx = SetUserContext(ctx, u.ID)
+			if tenantID > 0 {
+				ctx = SetTenantContext(ctx, tenantID)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// TokenVersions 从users表读取用户当前的令牌版本，供TokenManager校验令牌是否已吊销
+func TokenVersions(client *ent.Client) authn.VersionSource {
+	return func(ctx context.Context, userID int64) (int64, error) {
+		u, err := client.User.Query().
+			Where(user.ID(userID), user.DeletedAtIsNil()).
+			Select(user.FieldTokenVersion).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return 0, authn.ErrTokenInvalid
+			}
+			return 0, err
+		}
+		return u.TokenVersion, nil
+	}
+}
+
+// resolveTenant 解析请求头中的租户ID，并校验用户是该租户成员或拥有平台级角色
+func resolveTenant(ctx context.Context, client *ent.Client, userID int64, header string) (int64, error) {
+	if header == "" {
+		return 0, nil
+	}
+	tenantID, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || tenantID <= 0 {
+		return 0, kerrors.BadRequest("INVALID_TENANT", "invalid "+TenantHeader)
+	}
+
+	member, err := client.UserTenant.Query().
+		Where(usertenant.UserIDEQ(userID), usertenant.TenantIDEQ(tenantID)).
+		Exist(ctx)
+	if err != nil {
+		return 0, kerrors.InternalServer("AUTHN_ERROR", err.Error())
+	}
+	if member {
+		return tenantID, checkTenant(ctx, client, tenantID)
+	}
+
+	// 平台级角色（tenant_id IS NULL）可以进入任意租户
+	platform, err := client.UserRole.Query().
+		Where(userrole.UserIDEQ(userID), userrole.TenantIDIsNil(), authz.NotExpired(time.Now())).
+		Exist(ctx)
+	if err != nil {
+		return 0, kerrors.InternalServer("AUTHN_ERROR", err.Error())
+	}
+	if !platform {
+		return 0, kerrors.Forbidden("TENANT_FORBIDDEN", "user does not belong to tenant "+header)
+	}
+	return tenantID, checkTenant(ctx, client, tenantID)
+}
+
+// checkTenant 拒绝不可用（非ACTIVE、已到期或上级租户不可用）的租户
+func checkTenant(ctx context.Context, client *ent.Client, tenantID int64) error {
+	err := tenancy.Check(ctx, client, tenantID, time.Now())
+	var unavailable *tenancy.UnavailableError
+	if errors.As(err, &unavailable) {
+		return kerrors.Forbidden("TENANT_INACTIVE", unavailable.Error())
+	}
+	if err != nil {
+		return kerrors.InternalServer("AUTHN_ERROR", err.Error())
+	}
+	return nil
+}
+
+func extractBearerToken(header string) (string, bool) {
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(bearerPrefix):]), true
+}
+
+// Authenticated 包装需要登录才能访问的中间件，whitelist中的operation跳过这些中间件
+// whitelist 支持完整operation（/login.v1.LoginService/Login）或以*结尾的前缀（/login.v1.LoginService/*）
+func Authenticated(whitelist []string, ms ...middleware.Middleware) middleware.Middleware {
+	return selector.Server(ms...).Match(NewWhiteListMatcher(whitelist)).Build()
+}
+
+// NewWhiteListMatcher 返回selector匹配函数，命中白名单时返回false（即不执行被包装的中间件）
+func NewWhiteListMatcher(whitelist []string) selector.MatchFunc {
+	exact := make(map[string]struct{})
+	var prefixes []string
+	for _, op := range whitelist {
+		if strings.HasSuffix(op, "*") {
+			prefixes = append(prefixes, strings.TrimSuffix(op, "*"))
+			continue
+		}
+		exact[op] = struct{}{}
+	}
+	return func(ctx context.Context, operation string) bool {
+		if _, ok := exact[operation]; ok {
+			return false
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(operation, prefix) {
+				return false
+			}
+		}
+		return true
+	}
+}
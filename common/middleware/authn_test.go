@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWhiteListMatcher(t *testing.T) {
+	match := NewWhiteListMatcher([]string{
+		"/login.v1.LoginService/Login",
+		"/health.v1.Health/*",
+	})
+
+	cases := map[string]bool{
+		"/login.v1.LoginService/Login":   false,
+		"/login.v1.LoginService/Logout":  true,
+		"/health.v1.Health/Check":        false,
+		"/admin.v1.UserService/ListUser": true,
+	}
+	for op, want := range cases {
+		if got := match(context.Background(), op); got != want {
+			t.Errorf("match(%q) = %v, want %v", op, got, want)
+		}
+	}
+}
+
+func TestExtractBearerToken(t *testing.T) {
+	cases := []struct {
+		header string
+		token  string
+		ok     bool
+	}{
+		{"Bearer abc.def", "abc.def", true},
+		{"bearer abc.def", "abc.def", true},
+		{"Bearer ", "", false},
+		{"Basic abc", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		token, ok := extractBearerToken(c.header)
+		if token != c.token || ok != c.ok {
+			t.Errorf("extractBearerToken(%q) = (%q, %v), want (%q, %v)", c.header, token, ok, c.token, c.ok)
+		}
+	}
+}
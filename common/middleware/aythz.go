@@ -16,7 +16,7 @@ import (
 )
 
 // AuthzMiddleware Casbin授权中间件
-func AuthzMiddleware(enforcer *casbin.Enforcer, subBuilder *authz.SubjectBuilder) middleware.Middleware {
+func AuthzMiddleware(enforcer casbin.IEnforcer, subBuilder *authz.SubjectBuilder) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			// 1. 从context获取认证信息（假设已通过authn middleware）
@@ -42,10 +42,7 @@ func AuthzMiddleware(enforcer *casbin.Enforcer, subBuilder *authz.SubjectBuilder
 			}
 
 			// 5. Casbin Enforce
-			domain := fmt.Sprintf("%d", tenantID)
-			if tenantID == 0 {
-				domain = "*" // 平台级API
-			}
+			domain := authz.DomainOf(tenantID) // tenantID为0时为平台级API
 
 			ok, err := enforcer.Enforce(subject, domain, operation, method)
 			if err != nil {
@@ -139,6 +136,10 @@ func GetSubject(ctx context.Context) *authz.Subject {
 	return nil
 }
 
+func SetUserContext(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
 func SetTenantContext(ctx context.Context, tenantID int64) context.Context {
 	return context.WithValue(ctx, tenantIDKey, tenantID)
 }
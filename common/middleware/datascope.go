@@ -0,0 +1,47 @@
+// admin/common/middleware/datascope.go
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/datascope"
+)
+
+// DataScopeMiddleware 数据范围中间件，需放在AuthnMiddleware（及AuthzMiddleware）之后
+// 根据用户在当前租户下的角色计算数据范围并写入context，列表查询通过datascope.FromContext添加过滤条件
+func DataScopeMiddleware(subBuilder *authz.SubjectBuilder, resolver *datascope.Resolver) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			userID := GetUserIDFromContext(ctx)
+			if userID == 0 {
+				return handler(ctx, req)
+			}
+			tenantID := GetTenantIDFromContext(ctx)
+
+			// AuthzMiddleware已构建过Subject时直接复用
+			subject := GetSubject(ctx)
+			if subject == nil {
+				var err error
+				subject, err = subBuilder.BuildSubject(ctx, userID, tenantID)
+				if err != nil {
+					return nil, errors.InternalServer("DATA_SCOPE_ERROR", err.Error())
+				}
+				ctx = WithSubject(ctx, subject)
+			}
+
+			scopes := make([]datascope.Scope, 0, len(subject.DataScopes))
+			for _, s := range subject.DataScopes {
+				scopes = append(scopes, datascope.Scope(s))
+			}
+			scope, err := resolver.Resolve(ctx, userID, tenantID, scopes)
+			if err != nil {
+				return nil, errors.InternalServer("DATA_SCOPE_ERROR", err.Error())
+			}
+			return handler(datascope.NewContext(ctx, scope), req)
+		}
+	}
+}
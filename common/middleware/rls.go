@@ -0,0 +1,106 @@
+// admin/common/middleware/rls.go
+package middleware
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+
+	"github.com/yc-alpha/admin/common/authz"
+	"github.com/yc-alpha/admin/common/rls"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/role"
+	"github.com/yc-alpha/admin/ent/userrole"
+)
+
+// setRLSConfig 以事务级（SET LOCAL语义）设置行级安全参数，事务结束后自动失效，不会泄漏到连接池中的其他请求
+const setRLSConfig = `SELECT set_config('app.current_tenant', $1, true), set_config('app.current_user', $2, true), set_config('app.bypass_rls', $3, true)`
+
+// errReplyFailed 响应报告失败（Result为false）时用于回滚请求事务
+var errReplyFailed = stderrors.New("rls: reply reports failure")
+
+// SystemRLSMiddleware 为每个请求开启跳过行级安全的请求事务，需放在所有中间件的最前面（包括白名单operation）
+// 认证、授权和数据范围中间件在该事务中查询用户、角色和租户，白名单operation（登录等）整个在该事务中执行；
+// 登录用户的请求随后由RLSMiddleware在同一事务内收窄为当前租户和用户
+// handler返回错误、panic或响应的Result为false时回滚，否则提交：handler在语句失败后返回的业务错误码（如唯一约束冲突的409）
+// 不会因为事务已中止而在提交时变成500，失败的请求也不会提交之前已执行的写入
+func SystemRLSMiddleware(client *ent.Client) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			err = rls.WithBypass(ctx, client, func(ctx context.Context) error {
+				reply, err = handler(ctx, req)
+				if err != nil {
+					return err
+				}
+				if replyFailed(reply) {
+					return errReplyFailed
+				}
+				return nil
+			})
+			if err != nil && !stderrors.Is(err, errReplyFailed) {
+				return nil, err
+			}
+			return reply, nil
+		}
+	}
+}
+
+// replyFailed 响应是否报告失败，没有Result字段的响应视为成功
+func replyFailed(reply interface{}) bool {
+	r, ok := reply.(interface{ GetResult() bool })
+	return ok && !r.GetResult()
+}
+
+// RLSMiddleware PostgreSQL行级安全中间件，需放在认证、授权等中间件的最后，并依赖SystemRLSMiddleware开启的请求事务
+// 在请求事务内设置当前租户和用户并关闭跳过，之后请求内经ent.Client执行的查询都受行级安全约束（见rls.Driver）
+// 仅平台级用户在未指定租户时跳过行级安全；没有请求事务时拒绝请求，不会在连接池上无约束地执行
+func RLSMiddleware(client *ent.Client) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			userID := GetUserIDFromContext(ctx)
+			if userID == 0 {
+				return handler(ctx, req)
+			}
+			if !rls.InTx(ctx) {
+				return nil, errors.InternalServer("RLS_ERROR", "missing request transaction")
+			}
+			tenantID := GetTenantIDFromContext(ctx)
+
+			bypass := false
+			if tenantID == 0 {
+				if bypass, err = IsPlatformUser(ctx, client, userID); err != nil {
+					return nil, errors.InternalServer("RLS_ERROR", err.Error())
+				}
+			}
+
+			tenant := ""
+			if tenantID > 0 {
+				tenant = strconv.FormatInt(tenantID, 10)
+			}
+			if _, err := client.ExecContext(ctx, setRLSConfig,
+				tenant, strconv.FormatInt(userID, 10), strconv.FormatBool(bypass)); err != nil {
+				return nil, errors.InternalServer("RLS_ERROR", err.Error())
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// IsPlatformUser 用户是否拥有未过期的平台级角色，AuthzMiddleware已构建Subject时直接复用
+func IsPlatformUser(ctx context.Context, client *ent.Client, userID int64) (bool, error) {
+	if sub := GetSubject(ctx); sub != nil {
+		return sub.IsPlatform, nil
+	}
+	return client.UserRole.Query().
+		Where(
+			userrole.UserID(userID),
+			userrole.TenantIDIsNil(),
+			authz.NotExpired(time.Now()),
+			userrole.HasRoleWith(role.IsActive(true)),
+		).
+		Exist(ctx)
+}
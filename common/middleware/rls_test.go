@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	v1 "github.com/yc-alpha/admin/api/admin/v1"
+	"github.com/yc-alpha/admin/common/rls"
+	"github.com/yc-alpha/admin/ent"
+)
+
+// fakeTx 记录请求事务的结束方式
+type fakeTx struct {
+	end *string
+}
+
+func (fakeTx) Exec(context.Context, string, any, any) error  { return nil }
+func (fakeTx) Query(context.Context, string, any, any) error { return nil }
+func (fakeTx) ExecContext(context.Context, string, ...any) (stdsql.Result, error) {
+	return nil, nil
+}
+func (t fakeTx) Commit() error   { *t.end = "COMMIT"; return nil }
+func (t fakeTx) Rollback() error { *t.end = "ROLLBACK"; return nil }
+
+type fakeDriver struct {
+	fakeTx
+}
+
+func (d fakeDriver) Tx(context.Context) (dialect.Tx, error) { return d.fakeTx, nil }
+func (fakeDriver) Close() error                             { return nil }
+func (fakeDriver) Dialect() string                          { return dialect.Postgres }
+
+func TestSystemRLSMiddleware(t *testing.T) {
+	handlerErr := errors.New("handler failed")
+	cases := []struct {
+		name  string
+		reply interface{}
+		err   error
+		end   string
+	}{
+		{"success", &v1.CreateRoleResponse{Result: true}, nil, "COMMIT"},
+		{"reply reports failure", &v1.CreateRoleResponse{Result: false, Code: 409}, nil, "ROLLBACK"},
+		{"handler error", nil, handlerErr, "ROLLBACK"},
+		{"reply without result", &v1.Role{}, nil, "COMMIT"},
+	}
+	for _, c := range cases {
+		var end string
+		client := ent.NewClient(ent.Driver(rls.NewDriver(fakeDriver{fakeTx{end: &end}})))
+		h := SystemRLSMiddleware(client)(func(context.Context, interface{}) (interface{}, error) {
+			return c.reply, c.err
+		})
+		reply, err := h(context.Background(), nil)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: err = %v, want %v", c.name, err, c.err)
+		}
+		if c.err == nil && reply != c.reply {
+			t.Errorf("%s: reply = %v, want %v", c.name, reply, c.reply)
+		}
+		if end != c.end {
+			t.Errorf("%s: transaction ended with %q, want %q", c.name, end, c.end)
+		}
+	}
+}
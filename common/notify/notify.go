@@ -0,0 +1,82 @@
+// admin/common/notify/notify.go
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/yc-alpha/logger"
+)
+
+// Channel 投递渠道
+type Channel string
+
+const (
+	ChannelEmail Channel = "EMAIL"
+	ChannelSMS   Channel = "SMS"
+)
+
+var ErrUnsupportedChannel = errors.New("notify: unsupported channel")
+
+// Message 待投递的通知
+type Message struct {
+	Channel Channel           `json:"channel"`
+	To      string            `json:"to"`
+	Subject string            `json:"subject,omitempty"` // 短信忽略
+	Body    string            `json:"body"`
+	Data    map[string]string `json:"data,omitempty"` // 模板变量，供接入模板化的邮件、短信服务使用
+	SentAt  time.Time         `json:"sent_at"`
+}
+
+// Notifier 通知投递，实现需并发安全
+// 接入邮件、短信服务时实现该接口，不支持的渠道返回ErrUnsupportedChannel
+type Notifier interface {
+	Notify(ctx context.Context, msg *Message) error
+}
+
+// LogNotifier 只把通知写入日志，用于开发环境
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (*LogNotifier) Notify(_ context.Context, msg *Message) error {
+	logger.Infof("[notify] %s -> %s: %s %s", msg.Channel, msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileNotifier 把通知按JSON Lines追加到文件，用于测试和本地联调
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Notify(_ context.Context, msg *Message) error {
+	if msg.SentAt.IsZero() {
+		msg.SentAt = time.Now()
+	}
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("notify: open %s: %w", n.path, err)
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
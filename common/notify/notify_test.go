@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestFileNotifierAppendsMessages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notify.log")
+	n := NewFileNotifier(path)
+
+	var wg sync.WaitGroup
+	for _, to := range []string{"a@example.com", "+8613812345678"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := n.Notify(context.Background(), &Message{Channel: ChannelEmail, To: to, Body: "hello"}); err != nil {
+				t.Errorf("notify: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer f.Close()
+	got := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var msg Message
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			t.Fatalf("decode line %q: %v", scanner.Text(), err)
+		}
+		if msg.SentAt.IsZero() || msg.Body != "hello" {
+			t.Errorf("unexpected message: %+v", msg)
+		}
+		got[msg.To] = true
+	}
+	if len(got) != 2 {
+		t.Errorf("expected 2 recipients, got %v", got)
+	}
+}
+
+func TestLogNotifier(t *testing.T) {
+	if err := NewLogNotifier().Notify(context.Background(), &Message{Channel: ChannelSMS, To: "+8613812345678", Body: "hello"}); err != nil {
+		t.Errorf("notify: %v", err)
+	}
+}
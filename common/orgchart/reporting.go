@@ -0,0 +1,54 @@
+// admin/common/orgchart/reporting.go
+package orgchart
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Manager 汇报线上的一级主管：负责人及其负责的部门
+type Manager struct {
+	UserID       int64
+	DepartmentID int64
+}
+
+// PathIDs 部门ltree路径中的部门ID，从根部门到自身；无法解析的段被忽略
+func PathIDs(path string) []int64 {
+	parts := strings.Split(path, ".")
+	ids := make([]int64, 0, len(parts))
+	for _, p := range parts {
+		if id, err := strconv.ParseInt(p, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Managers 用户所在部门（路径为path）的汇报线，从直接主管开始向上
+// heads为部门ID到负责人用户ID的映射，不在映射中的部门视为负责人空缺而跳过；
+// 用户自己负责的部门同样跳过，即部门负责人向上级部门的负责人汇报；同一人负责多级部门时只保留最近的一级
+func Managers(path string, heads map[int64]int64, userID int64) []Manager {
+	ids := PathIDs(path)
+	seen := map[int64]bool{userID: true}
+	var chain []Manager
+	for i := len(ids) - 1; i >= 0; i-- {
+		head, ok := heads[ids[i]]
+		if !ok || seen[head] {
+			continue
+		}
+		seen[head] = true
+		chain = append(chain, Manager{UserID: head, DepartmentID: ids[i]})
+	}
+	return chain
+}
+
+// DirectManager 用户的直接主管，汇报线为空时返回false
+func DirectManager(path string, heads map[int64]int64, userID int64) (Manager, bool) {
+	ids := PathIDs(path)
+	for i := len(ids) - 1; i >= 0; i-- {
+		if head, ok := heads[ids[i]]; ok && head != userID {
+			return Manager{UserID: head, DepartmentID: ids[i]}, true
+		}
+	}
+	return Manager{}, false
+}
@@ -0,0 +1,44 @@
+package orgchart
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPathIDs(t *testing.T) {
+	if got := PathIDs("1.20.300"); !slices.Equal(got, []int64{1, 20, 300}) {
+		t.Errorf("PathIDs = %v, want [1 20 300]", got)
+	}
+	if got := PathIDs(""); len(got) != 0 {
+		t.Errorf("PathIDs(\"\") = %v, want empty", got)
+	}
+}
+
+func TestManagers(t *testing.T) {
+	// 1(公司, 负责人100) -> 2(研发, 空缺) -> 3(后端, 负责人300) -> 4(存储, 负责人100)
+	heads := map[int64]int64{1: 100, 3: 300, 4: 100}
+
+	tests := []struct {
+		name   string
+		path   string
+		userID int64
+		want   []Manager
+	}{
+		{"member of vacant department", "1.2", 500, []Manager{{100, 1}}},
+		{"member of led department", "1.2.3", 500, []Manager{{300, 3}, {100, 1}}},
+		{"head reports upwards", "1.2.3", 300, []Manager{{100, 1}}},
+		{"same head on two levels", "1.2.3.4", 500, []Manager{{100, 4}, {300, 3}}},
+		{"top head", "1", 100, nil},
+		{"head of nested department", "1.2.3.4", 100, []Manager{{300, 3}}},
+	}
+	for _, tt := range tests {
+		got := Managers(tt.path, heads, tt.userID)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Managers = %v, want %v", tt.name, got, tt.want)
+		}
+		m, ok := DirectManager(tt.path, heads, tt.userID)
+		if ok != (len(tt.want) > 0) || (ok && m != tt.want[0]) {
+			t.Errorf("%s: DirectManager = %v %v, want first of %v", tt.name, m, ok, tt.want)
+		}
+	}
+}
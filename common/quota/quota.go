@@ -0,0 +1,200 @@
+// admin/common/quota/quota.go
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/role"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/usertenant"
+)
+
+// Resource 受套餐限制的资源
+type Resource string
+
+const (
+	ResourceUsers       Resource = "users"
+	ResourceDepartments Resource = "departments"
+	ResourceSubTenants  Resource = "sub_tenants"
+	ResourceRoles       Resource = "roles"
+)
+
+// Resources 所有受限制的资源，用量报告按此顺序返回
+var Resources = []Resource{ResourceUsers, ResourceDepartments, ResourceSubTenants, ResourceRoles}
+
+// Label 资源的中文名称
+func (r Resource) Label() string {
+	switch r {
+	case ResourceUsers:
+		return "成员"
+	case ResourceDepartments:
+		return "部门"
+	case ResourceSubTenants:
+		return "子租户"
+	case ResourceRoles:
+		return "角色"
+	}
+	return string(r)
+}
+
+// ExceededError 新增资源后将超出套餐上限
+type ExceededError struct {
+	TenantID  int64
+	Resource  Resource
+	Limit     int
+	Used      int
+	Requested int
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("tenant %d %s quota exceeded: limit %d, used %d, requested %d", e.TenantID, e.Resource, e.Limit, e.Used, e.Requested)
+}
+
+// Limit 套餐对资源的上限，nil表示不限制；未分配套餐（p为nil）时不限制
+func Limit(p *ent.Plan, r Resource) *int {
+	if p == nil {
+		return nil
+	}
+	switch r {
+	case ResourceUsers:
+		return p.MaxUsers
+	case ResourceDepartments:
+		return p.MaxDepartments
+	case ResourceSubTenants:
+		return p.MaxSubTenants
+	case ResourceRoles:
+		return p.MaxRoles
+	}
+	return nil
+}
+
+// 接入检查的功能开关，套餐的features中以这些名称开启
+const (
+	FeatureInvitations    = "invitations"     // 邀请成员加入租户
+	FeatureUserExport     = "user_export"     // 导出用户
+	FeatureDepartmentSync = "department_sync" // 外部系统按外部ID同步部门
+)
+
+// Features 接入检查的功能，用量报告按此顺序返回
+var Features = []string{FeatureInvitations, FeatureUserExport, FeatureDepartmentSync}
+
+// FeatureLabel 功能的中文名称
+func FeatureLabel(feature string) string {
+	switch feature {
+	case FeatureInvitations:
+		return "邀请成员"
+	case FeatureUserExport:
+		return "导出用户"
+	case FeatureDepartmentSync:
+		return "部门同步"
+	}
+	return feature
+}
+
+// FeatureDisabledError 租户的套餐未开启功能
+type FeatureDisabledError struct {
+	TenantID int64
+	Feature  string
+}
+
+func (e *FeatureDisabledError) Error() string {
+	return fmt.Sprintf("tenant %d feature %s is disabled by plan", e.TenantID, e.Feature)
+}
+
+// FeatureEnabled 套餐是否开启功能，未分配套餐时所有功能可用，套餐中未列出的功能视为关闭
+func FeatureEnabled(p *ent.Plan, feature string) bool {
+	if p == nil {
+		return true
+	}
+	return p.Features[feature]
+}
+
+// CheckFeature 检查租户的套餐是否开启功能，未开启时返回*FeatureDisabledError
+func CheckFeature(ctx context.Context, client *ent.Client, tenantID int64, feature string) error {
+	t, err := client.Tenant.Query().
+		Where(tenant.ID(tenantID)).
+		WithPlan().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if !FeatureEnabled(t.Edges.Plan, feature) {
+		return &FeatureDisabledError{TenantID: tenantID, Feature: feature}
+	}
+	return nil
+}
+
+// Check 检查租户新增n个资源后是否超出套餐上限，超出时返回*ExceededError
+// 在事务中调用时锁定租户行，同一租户的并发创建依次检查，应在同一事务中完成创建
+func Check(ctx context.Context, client *ent.Client, tenantID int64, r Resource, n int) error {
+	t, err := client.Tenant.Query().
+		Where(tenant.ID(tenantID)).
+		WithPlan().
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	limit := Limit(t.Edges.Plan, r)
+	if limit == nil {
+		return nil
+	}
+	used, err := Count(ctx, client, tenantID, r)
+	if err != nil {
+		return err
+	}
+	if used+n > *limit {
+		return &ExceededError{TenantID: tenantID, Resource: r, Limit: *limit, Used: used, Requested: n}
+	}
+	return nil
+}
+
+// Count 统计租户当前的资源用量，已删除的部门和子租户不计入
+func Count(ctx context.Context, client *ent.Client, tenantID int64, r Resource) (int, error) {
+	switch r {
+	case ResourceUsers:
+		return client.UserTenant.Query().Where(usertenant.TenantID(tenantID)).Count(ctx)
+	case ResourceDepartments:
+		return client.Department.Query().Where(department.TenantID(tenantID), department.DeletedAtIsNil()).Count(ctx)
+	case ResourceSubTenants:
+		return client.Tenant.Query().Where(tenant.ParentID(tenantID)).Count(ctx)
+	case ResourceRoles:
+		return client.Role.Query().Where(role.TenantID(tenantID)).Count(ctx)
+	}
+	return 0, fmt.Errorf("quota: unknown resource %q", r)
+}
+
+// Usage 资源用量
+type Usage struct {
+	Resource Resource
+	Used     int
+	Limit    *int // nil表示不限制
+}
+
+// Exceeded 用量是否已超出上限，套餐降级后可能出现
+func (u Usage) Exceeded() bool {
+	return u.Limit != nil && u.Used > *u.Limit
+}
+
+// Report 统计租户各项资源的用量，返回租户的套餐（未分配时为nil）
+func Report(ctx context.Context, client *ent.Client, tenantID int64) (*ent.Plan, []Usage, error) {
+	t, err := client.Tenant.Query().
+		Where(tenant.ID(tenantID)).
+		WithPlan().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	usages := make([]Usage, 0, len(Resources))
+	for _, r := range Resources {
+		used, err := Count(ctx, client, tenantID, r)
+		if err != nil {
+			return nil, nil, err
+		}
+		usages = append(usages, Usage{Resource: r, Used: used, Limit: Limit(t.Edges.Plan, r)})
+	}
+	return t.Edges.Plan, usages, nil
+}
@@ -0,0 +1,64 @@
+package quota
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yc-alpha/admin/ent"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestLimit(t *testing.T) {
+	p := &ent.Plan{MaxUsers: intPtr(10), MaxRoles: intPtr(0)}
+	if l := Limit(p, ResourceUsers); l == nil || *l != 10 {
+		t.Errorf("users limit = %v, want 10", l)
+	}
+	if l := Limit(p, ResourceRoles); l == nil || *l != 0 {
+		t.Errorf("roles limit = %v, want 0", l)
+	}
+	if l := Limit(p, ResourceDepartments); l != nil {
+		t.Errorf("departments limit = %v, want unlimited", *l)
+	}
+	if l := Limit(nil, ResourceUsers); l != nil {
+		t.Errorf("limit without plan = %v, want unlimited", *l)
+	}
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	p := &ent.Plan{Features: map[string]bool{"sso": true, "audit": false}}
+	for feature, want := range map[string]bool{"sso": true, "audit": false, "export": false} {
+		if got := FeatureEnabled(p, feature); got != want {
+			t.Errorf("FeatureEnabled(%q) = %v, want %v", feature, got, want)
+		}
+	}
+	if !FeatureEnabled(nil, "export") {
+		t.Error("features should be enabled without a plan")
+	}
+}
+
+func TestUsageExceeded(t *testing.T) {
+	tests := []struct {
+		usage Usage
+		want  bool
+	}{
+		{Usage{Used: 5}, false},
+		{Usage{Used: 5, Limit: intPtr(5)}, false},
+		{Usage{Used: 6, Limit: intPtr(5)}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.usage.Exceeded(); got != tt.want {
+			t.Errorf("%+v Exceeded() = %v, want %v", tt.usage, got, tt.want)
+		}
+	}
+}
+
+func TestExceededError(t *testing.T) {
+	err := &ExceededError{TenantID: 1001, Resource: ResourceUsers, Limit: 10, Used: 9, Requested: 2}
+	if msg := err.Error(); !strings.Contains(msg, "users") || !strings.Contains(msg, "limit 10") {
+		t.Errorf("unexpected message %q", msg)
+	}
+	if ResourceSubTenants.Label() != "子租户" {
+		t.Errorf("unexpected label %q", ResourceSubTenants.Label())
+	}
+}
@@ -0,0 +1,53 @@
+// admin/common/rls/bypass.go
+package rls
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yc-alpha/admin/ent"
+)
+
+// setBypass 以事务级（SET LOCAL语义）跳过行级安全，事务结束后自动失效
+const setBypass = `SELECT set_config('app.bypass_rls', 'on', true)`
+
+// BypassTx 开启一个跳过行级安全的独立事务，供初始化、后台任务等系统操作显式使用
+// 连接默认不跳过行级安全，系统操作必须经由该事务或WithBypass访问受保护的表
+func BypassTx(ctx context.Context, client *ent.Client) (*ent.Tx, error) {
+	_, tx, err := beginBypass(ctx, client)
+	return tx, err
+}
+
+// WithBypass 在跳过行级安全的事务中执行fn，fn内经client执行的查询都在该事务中进行（见Driver）
+// fn返回错误或panic时回滚，否则提交
+func WithBypass(ctx context.Context, client *ent.Client, fn func(ctx context.Context) error) error {
+	ctx, tx, err := beginBypass(ctx, client)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// beginBypass 总是开启新的事务，不会嵌套在context中已有的请求事务里
+func beginBypass(ctx context.Context, client *ent.Client) (context.Context, *ent.Tx, error) {
+	ctx = NewContext(ctx)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := tx.ExecContext(ctx, setBypass); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("rls: %w", err)
+	}
+	return ctx, tx, nil
+}
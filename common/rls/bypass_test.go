@@ -0,0 +1,52 @@
+package rls
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/yc-alpha/admin/ent"
+)
+
+func TestWithBypass(t *testing.T) {
+	drv, fake, log := newFakeDriver()
+	client := ent.NewClient(ent.Driver(drv))
+
+	// 已有请求事务时也开启独立的事务，不会把跳过泄漏到请求事务中
+	ctx := NewContext(context.Background())
+	reqTx, _ := drv.Tx(ctx)
+	err := WithBypass(ctx, client, func(ctx context.Context) error {
+		_, err := client.ExecContext(ctx, "UPDATE c")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WithBypass: %v", err)
+	}
+	_ = reqTx.Commit()
+
+	if fake.txs != 2 {
+		t.Errorf("expected a separate bypass transaction, got %d", fake.txs)
+	}
+	want := []string{"tx: " + setBypass, "tx: UPDATE c", "tx: COMMIT", "tx: COMMIT"}
+	if !reflect.DeepEqual(*log, want) {
+		t.Errorf("log = %v, want %v", *log, want)
+	}
+}
+
+func TestWithBypassRollback(t *testing.T) {
+	drv, _, log := newFakeDriver()
+	client := ent.NewClient(ent.Driver(drv))
+
+	errFn := errors.New("fn failed")
+	err := WithBypass(context.Background(), client, func(context.Context) error {
+		return errFn
+	})
+	if !errors.Is(err, errFn) {
+		t.Fatalf("expected fn error, got %v", err)
+	}
+	want := []string{"tx: " + setBypass, "tx: ROLLBACK"}
+	if !reflect.DeepEqual(*log, want) {
+		t.Errorf("log = %v, want %v", *log, want)
+	}
+}
@@ -0,0 +1,194 @@
+// admin/common/rls/driver.go
+package rls
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"sync"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver 按请求路由的ent驱动
+// context通过NewContext携带请求事务时，经由该驱动的所有查询（包括直接使用ent.Client的查询）都在请求事务中执行，
+// 从而受到事务内SET LOCAL的行级安全参数约束；业务代码在请求内再开启的事务以SAVEPOINT的形式嵌套在请求事务中
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver 包装ent驱动
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+// requestTx 请求事务的占位，由第一次开启的事务填充
+type requestTx struct {
+	mu         sync.Mutex
+	tx         dialect.Tx
+	savepoints int
+}
+
+type contextKey struct{}
+
+// NewContext 在context中放入请求事务的占位，之后经Driver开启的第一个事务即为请求事务
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, &requestTx{})
+}
+
+// InTx context中是否已开启请求事务
+func InTx(ctx context.Context) bool {
+	return current(ctx) != nil
+}
+
+func current(ctx context.Context) dialect.Tx {
+	rt, ok := ctx.Value(contextKey{}).(*requestTx)
+	if !ok {
+		return nil
+	}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.tx
+}
+
+// Exec 存在请求事务时在事务中执行
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	if tx := current(ctx); tx != nil {
+		return tx.Exec(ctx, query, args, v)
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query 存在请求事务时在事务中执行
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	if tx := current(ctx); tx != nil {
+		return tx.Query(ctx, query, args, v)
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// ExecContext 供ent的sql/execquery特性使用
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	var ex any = d.Driver
+	if tx := current(ctx); tx != nil {
+		ex = tx
+	}
+	e, ok := ex.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("rls: ExecContext is not supported")
+	}
+	return e.ExecContext(ctx, query, args...)
+}
+
+// QueryContext 供ent的sql/execquery特性使用
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	var qr any = d.Driver
+	if tx := current(ctx); tx != nil {
+		qr = tx
+	}
+	q, ok := qr.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("rls: QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
+
+// Tx 开启事务，见BeginTx
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx 开启事务
+// context中有请求事务占位但尚未开启时，开启的事务成为请求事务；已有请求事务时返回基于SAVEPOINT的嵌套事务
+func (d *Driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	rt, ok := ctx.Value(contextKey{}).(*requestTx)
+	if !ok {
+		return d.begin(ctx, opts)
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.tx == nil {
+		tx, err := d.begin(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		rt.tx = tx
+		return tx, nil
+	}
+	rt.savepoints++
+	sp := &savepoint{tx: rt.tx, name: fmt.Sprintf("rls_sp_%d", rt.savepoints)}
+	if err := sp.exec(ctx, "SAVEPOINT "+sp.name); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+func (d *Driver) begin(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	if b, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		return b.BeginTx(ctx, opts)
+	}
+	return d.Driver.Tx(ctx)
+}
+
+// savepoint 请求事务内的嵌套事务，Commit释放保存点，Rollback回滚到保存点
+type savepoint struct {
+	tx   dialect.Tx
+	name string
+	done bool
+}
+
+func (sp *savepoint) Exec(ctx context.Context, query string, args, v any) error {
+	return sp.tx.Exec(ctx, query, args, v)
+}
+
+func (sp *savepoint) Query(ctx context.Context, query string, args, v any) error {
+	return sp.tx.Query(ctx, query, args, v)
+}
+
+func (sp *savepoint) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	e, ok := sp.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("rls: ExecContext is not supported")
+	}
+	return e.ExecContext(ctx, query, args...)
+}
+
+func (sp *savepoint) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := sp.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("rls: QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
+
+func (sp *savepoint) Commit() error {
+	if sp.done {
+		return stdsql.ErrTxDone
+	}
+	sp.done = true
+	return sp.exec(context.Background(), "RELEASE SAVEPOINT "+sp.name)
+}
+
+func (sp *savepoint) Rollback() error {
+	if sp.done {
+		return stdsql.ErrTxDone
+	}
+	sp.done = true
+	return sp.exec(context.Background(), "ROLLBACK TO SAVEPOINT "+sp.name)
+}
+
+func (sp *savepoint) exec(ctx context.Context, query string) error {
+	var res stdsql.Result
+	return sp.tx.Exec(ctx, query, []any{}, &res)
+}
@@ -0,0 +1,118 @@
+package rls
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/dialect"
+)
+
+// recorder 记录执行的语句，name区分驱动和事务
+type recorder struct {
+	name string
+	log  *[]string
+}
+
+func (r *recorder) Exec(_ context.Context, query string, _, _ any) error {
+	*r.log = append(*r.log, r.name+": "+query)
+	return nil
+}
+
+func (r *recorder) Query(_ context.Context, query string, _, _ any) error {
+	*r.log = append(*r.log, r.name+": "+query)
+	return nil
+}
+
+func (r *recorder) ExecContext(_ context.Context, query string, _ ...any) (stdsql.Result, error) {
+	*r.log = append(*r.log, r.name+": "+query)
+	return nil, nil
+}
+
+func (r *recorder) Commit() error {
+	*r.log = append(*r.log, r.name+": COMMIT")
+	return nil
+}
+
+func (r *recorder) Rollback() error {
+	*r.log = append(*r.log, r.name+": ROLLBACK")
+	return nil
+}
+
+type fakeDriver struct {
+	recorder
+	txs int
+}
+
+func (d *fakeDriver) Tx(context.Context) (dialect.Tx, error) {
+	d.txs++
+	return &recorder{name: "tx", log: d.log}, nil
+}
+
+func (d *fakeDriver) Close() error    { return nil }
+func (d *fakeDriver) Dialect() string { return dialect.Postgres }
+
+func newFakeDriver() (*Driver, *fakeDriver, *[]string) {
+	log := &[]string{}
+	fake := &fakeDriver{recorder: recorder{name: "db", log: log}}
+	return NewDriver(fake), fake, log
+}
+
+func TestDriverWithoutRequestTx(t *testing.T) {
+	drv, fake, log := newFakeDriver()
+	ctx := context.Background()
+	_ = drv.Exec(ctx, "UPDATE a", []any{}, nil)
+	tx, _ := drv.Tx(ctx)
+	_ = tx.Commit()
+
+	if fake.txs != 1 {
+		t.Errorf("expected a plain transaction, got %d", fake.txs)
+	}
+	want := []string{"db: UPDATE a", "tx: COMMIT"}
+	if !reflect.DeepEqual(*log, want) {
+		t.Errorf("log = %v, want %v", *log, want)
+	}
+}
+
+func TestDriverRoutesToRequestTx(t *testing.T) {
+	drv, fake, log := newFakeDriver()
+	ctx := NewContext(context.Background())
+	if InTx(ctx) {
+		t.Fatal("expected no request tx before begin")
+	}
+
+	reqTx, _ := drv.Tx(ctx)
+	if !InTx(ctx) {
+		t.Fatal("expected request tx after begin")
+	}
+	_ = drv.Query(ctx, "SELECT 1", []any{}, nil)
+
+	inner, _ := drv.Tx(ctx)
+	_ = inner.Exec(ctx, "INSERT b", []any{}, nil)
+	_ = inner.Rollback()
+	if err := inner.Rollback(); !errors.Is(err, stdsql.ErrTxDone) {
+		t.Errorf("expected ErrTxDone on second rollback, got %v", err)
+	}
+
+	inner, _ = drv.Tx(ctx)
+	_ = inner.Commit()
+	_ = reqTx.Commit()
+
+	if fake.txs != 1 {
+		t.Errorf("expected nested transactions to reuse the request tx, got %d", fake.txs)
+	}
+	want := []string{
+		"tx: SELECT 1",
+		"tx: SAVEPOINT rls_sp_1",
+		"tx: INSERT b",
+		"tx: ROLLBACK TO SAVEPOINT rls_sp_1",
+		"tx: SAVEPOINT rls_sp_2",
+		"tx: RELEASE SAVEPOINT rls_sp_2",
+		"tx: COMMIT",
+	}
+	if !reflect.DeepEqual(*log, want) {
+		t.Errorf("log = %v, want %v", *log, want)
+	}
+}
@@ -0,0 +1,87 @@
+// admin/common/settings/resolve.go
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/logger"
+)
+
+// Value 设置项的生效值
+type Value struct {
+	Key    string
+	Value  any
+	Source int64 // 值来源的租户ID，0表示使用默认值
+}
+
+// Overridden 值是否由租户自身设置
+func (v Value) Overridden(tenantID int64) bool {
+	return v.Source == tenantID
+}
+
+// ChainFromPath 由租户的ltree路径得到继承链，从租户自身到ROOT，越靠前优先级越高
+func ChainFromPath(path string) []int64 {
+	parts := strings.Split(path, ".")
+	chain := make([]int64, 0, len(parts))
+	for _, p := range slices.Backward(parts) {
+		if id, err := strconv.ParseInt(p, 10, 64); err == nil {
+			chain = append(chain, id)
+		}
+	}
+	return chain
+}
+
+// Resolve 按继承链计算每个设置项的生效值，stored为各租户保存的值
+// 取链上最近的合法值，都没有时使用默认值；不合法的值（例如校验规则收紧后的旧值）被跳过
+func Resolve(chain []int64, stored map[int64]map[string]json.RawMessage) map[string]Value {
+	values := make(map[string]Value, len(definitions))
+	for _, d := range definitions {
+		values[d.Key] = Value{Key: d.Key, Value: d.Default}
+		for _, id := range chain {
+			raw, ok := stored[id][d.Key]
+			if !ok {
+				continue
+			}
+			v, err := d.Parse(raw)
+			if err != nil {
+				logger.Warnf("忽略租户 %d 不合法的设置 %s: %v", id, d.Key, err)
+				continue
+			}
+			values[d.Key] = Value{Key: d.Key, Value: v, Source: id}
+			break
+		}
+	}
+	return values
+}
+
+// Effective 查询租户所有设置项的生效值，沿ltree路径一次查出继承链上各租户保存的值
+func Effective(ctx context.Context, client *ent.Client, tenantID int64) (map[string]Value, error) {
+	t, err := client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	chain := []int64{tenantID}
+	if t.Path != nil {
+		chain = ChainFromPath(*t.Path)
+	}
+	rows, err := client.TenantSetting.Query().
+		Where(tenantsetting.TenantIDIn(chain...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[int64]map[string]json.RawMessage, len(chain))
+	for _, r := range rows {
+		if stored[r.TenantID] == nil {
+			stored[r.TenantID] = make(map[string]json.RawMessage)
+		}
+		stored[r.TenantID][r.Key] = r.Value
+	}
+	return Resolve(chain, stored), nil
+}
@@ -0,0 +1,195 @@
+// admin/common/settings/settings.go
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Kind 设置值的类型，值以JSON保存和传输
+type Kind string
+
+const (
+	KindBool       Kind = "bool"
+	KindInt        Kind = "int"
+	KindString     Kind = "string"
+	KindStringList Kind = "string_list"
+)
+
+// 登录方式
+const (
+	LoginPassword = "password"
+	LoginSMS      = "sms"
+	LoginOAuth    = "oauth"
+)
+
+var (
+	ErrUnknownKey   = errors.New("settings: unknown key")
+	ErrInvalidValue = errors.New("settings: invalid value")
+)
+
+// Definition 设置项定义
+type Definition struct {
+	Key         string
+	Group       string // 分组：password、session、locale、login、branding
+	Description string
+	Kind        Kind
+	Default     any
+	validate    func(v any) error
+}
+
+// Parse 按类型解码JSON值并校验，返回的值为bool、int64、string或[]string
+func (d *Definition) Parse(raw json.RawMessage) (any, error) {
+	// json.Unmarshal把null解码为零值且不报错，需单独拒绝
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return nil, fmt.Errorf("%w: %s expects %s", ErrInvalidValue, d.Key, d.Kind)
+	}
+	var (
+		v   any
+		err error
+	)
+	switch d.Kind {
+	case KindBool:
+		var b bool
+		err = json.Unmarshal(raw, &b)
+		v = b
+	case KindInt:
+		var i int64
+		err = json.Unmarshal(raw, &i)
+		v = i
+	case KindString:
+		var s string
+		err = json.Unmarshal(raw, &s)
+		v = s
+	case KindStringList:
+		var l []string
+		err = json.Unmarshal(raw, &l)
+		v = l
+	default:
+		return nil, fmt.Errorf("settings: unsupported kind %q", d.Kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s expects %s", ErrInvalidValue, d.Key, d.Kind)
+	}
+	if d.validate != nil {
+		if err := d.validate(v); err != nil {
+			return nil, fmt.Errorf("%w: %s %v", ErrInvalidValue, d.Key, err)
+		}
+	}
+	return v, nil
+}
+
+var (
+	colorRegex   = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+	loginMethods = []string{LoginPassword, LoginSMS, LoginOAuth}
+)
+
+func intRange(min, max int64) func(any) error {
+	return func(v any) error {
+		if i := v.(int64); i < min || i > max {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+func maxLen(n int) func(any) error {
+	return func(v any) error {
+		if len([]rune(v.(string))) > n {
+			return fmt.Errorf("must be at most %d characters", n)
+		}
+		return nil
+	}
+}
+
+var definitions = []*Definition{
+	{Key: "password.min_length", Group: "password", Description: "密码最小长度", Kind: KindInt, Default: int64(8), validate: intRange(6, 128)},
+	{Key: "password.require_uppercase", Group: "password", Description: "密码必须包含大写字母", Kind: KindBool, Default: false},
+	{Key: "password.require_lowercase", Group: "password", Description: "密码必须包含小写字母", Kind: KindBool, Default: false},
+	{Key: "password.require_digit", Group: "password", Description: "密码必须包含数字", Kind: KindBool, Default: false},
+	{Key: "password.require_symbol", Group: "password", Description: "密码必须包含特殊字符", Kind: KindBool, Default: false},
+	{Key: "password.expire_days", Group: "password", Description: "密码有效天数，0表示不过期", Kind: KindInt, Default: int64(0), validate: intRange(0, 3650)},
+	{Key: "session.timeout", Group: "session", Description: "会话超时时间（秒）", Kind: KindInt, Default: int64(7200), validate: intRange(300, 30*24*3600)},
+	{Key: "locale.language", Group: "locale", Description: "默认语言，BCP 47格式", Kind: KindString, Default: "zh-CN", validate: func(v any) error {
+		if _, err := language.Parse(v.(string)); err != nil {
+			return errors.New("must be a BCP 47 language tag")
+		}
+		return nil
+	}},
+	{Key: "locale.timezone", Group: "locale", Description: "默认时区，IANA时区名", Kind: KindString, Default: "Asia/Shanghai", validate: func(v any) error {
+		if v.(string) == "" {
+			return errors.New("must not be empty")
+		}
+		if _, err := time.LoadLocation(v.(string)); err != nil {
+			return errors.New("must be an IANA time zone")
+		}
+		return nil
+	}},
+	{Key: "login.methods", Group: "login", Description: "允许的登录方式：password、sms、oauth", Kind: KindStringList, Default: []string{LoginPassword, LoginSMS, LoginOAuth}, validate: func(v any) error {
+		methods := v.([]string)
+		if len(methods) == 0 {
+			return errors.New("must allow at least one login method")
+		}
+		for i, m := range methods {
+			if !slices.Contains(loginMethods, m) {
+				return fmt.Errorf("unsupported login method %q", m)
+			}
+			if slices.Contains(methods[:i], m) {
+				return fmt.Errorf("duplicate login method %q", m)
+			}
+		}
+		return nil
+	}},
+	{Key: "branding.name", Group: "branding", Description: "品牌名称，为空时使用租户名称", Kind: KindString, Default: "", validate: maxLen(64)},
+	{Key: "branding.logo_url", Group: "branding", Description: "Logo地址", Kind: KindString, Default: "", validate: func(v any) error {
+		s := v.(string)
+		if s == "" {
+			return nil
+		}
+		if len(s) > 512 {
+			return errors.New("must be at most 512 characters")
+		}
+		u, err := url.Parse(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("must be an http or https URL")
+		}
+		return nil
+	}},
+	{Key: "branding.primary_color", Group: "branding", Description: "主题色，#RRGGBB", Kind: KindString, Default: "#1677FF", validate: func(v any) error {
+		if !colorRegex.MatchString(v.(string)) {
+			return errors.New("must be a #RRGGBB color")
+		}
+		return nil
+	}},
+}
+
+// Definitions 所有设置项定义
+func Definitions() []*Definition {
+	return definitions
+}
+
+// Lookup 按key查找设置项定义
+func Lookup(key string) (*Definition, bool) {
+	i := slices.IndexFunc(definitions, func(d *Definition) bool { return d.Key == key })
+	if i < 0 {
+		return nil, false
+	}
+	return definitions[i], true
+}
+
+// Validate 校验并解码一个设置值
+func Validate(key string, raw json.RawMessage) (any, error) {
+	d, ok := Lookup(key)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, key)
+	}
+	return d.Parse(raw)
+}
@@ -0,0 +1,110 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		key   string
+		raw   string
+		valid bool
+	}{
+		{"password.min_length", `12`, true},
+		{"password.min_length", `4`, false},
+		{"password.min_length", `"12"`, false},
+		{"password.min_length", `null`, false},
+		{"password.require_digit", ` null `, false},
+		{"locale.language", `null`, false},
+		{"login.methods", `null`, false},
+		{"password.require_digit", `true`, true},
+		{"password.require_digit", `1`, false},
+		{"session.timeout", `3600`, true},
+		{"session.timeout", `60`, false},
+		{"locale.language", `"en-US"`, true},
+		{"locale.language", `"not a tag"`, false},
+		{"locale.timezone", `"Europe/Berlin"`, true},
+		{"locale.timezone", `"Mars/Olympus"`, false},
+		{"locale.timezone", `""`, false},
+		{"login.methods", `["password","sms"]`, true},
+		{"login.methods", `[]`, false},
+		{"login.methods", `["password","password"]`, false},
+		{"login.methods", `["ldap"]`, false},
+		{"branding.logo_url", `""`, true},
+		{"branding.logo_url", `"https://cdn.example.com/logo.png"`, true},
+		{"branding.logo_url", `"javascript:alert(1)"`, false},
+		{"branding.primary_color", `"#1a2B3c"`, true},
+		{"branding.primary_color", `"red"`, false},
+	}
+	for _, tt := range tests {
+		_, err := Validate(tt.key, json.RawMessage(tt.raw))
+		if (err == nil) != tt.valid {
+			t.Errorf("Validate(%s, %s) error = %v, want valid %v", tt.key, tt.raw, err, tt.valid)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("Validate(%s, %s) error = %v, want ErrInvalidValue", tt.key, tt.raw, err)
+		}
+	}
+	if _, err := Validate("unknown.key", json.RawMessage(`1`)); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("unknown key error = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestDefaultsAreValid(t *testing.T) {
+	for _, d := range Definitions() {
+		raw, err := json.Marshal(d.Default)
+		if err != nil {
+			t.Fatalf("marshal default of %s: %v", d.Key, err)
+		}
+		if _, err := d.Parse(raw); err != nil {
+			t.Errorf("default of %s is invalid: %v", d.Key, err)
+		}
+	}
+}
+
+func TestChainFromPath(t *testing.T) {
+	if got := ChainFromPath("100.200.300"); !slices.Equal(got, []int64{300, 200, 100}) {
+		t.Errorf("ChainFromPath = %v, want [300 200 100]", got)
+	}
+	if got := ChainFromPath("100"); !slices.Equal(got, []int64{100}) {
+		t.Errorf("ChainFromPath = %v, want [100]", got)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	chain := []int64{300, 200, 100} // SUB -> GROUP -> ROOT
+	stored := map[int64]map[string]json.RawMessage{
+		100: {"password.min_length": json.RawMessage(`10`), "locale.language": json.RawMessage(`"en-US"`)},
+		200: {"password.min_length": json.RawMessage(`12`), "session.timeout": json.RawMessage(`1`)},
+		300: {"branding.name": json.RawMessage(`"Acme"`)},
+	}
+	values := Resolve(chain, stored)
+
+	tests := []struct {
+		key    string
+		value  any
+		source int64
+	}{
+		{"branding.name", "Acme", 300},
+		{"password.min_length", int64(12), 200},
+		{"locale.language", "en-US", 100},
+		// GROUP保存的值不合法，跳过后使用默认值
+		{"session.timeout", int64(7200), 0},
+		{"locale.timezone", "Asia/Shanghai", 0},
+	}
+	for _, tt := range tests {
+		v := values[tt.key]
+		if v.Value != tt.value || v.Source != tt.source {
+			t.Errorf("%s = %v from %d, want %v from %d", tt.key, v.Value, v.Source, tt.value, tt.source)
+		}
+	}
+	if !values["branding.name"].Overridden(300) || values["password.min_length"].Overridden(300) {
+		t.Error("only values set by the tenant itself should be overridden")
+	}
+	if len(values) != len(Definitions()) {
+		t.Errorf("resolved %d values, want %d", len(values), len(Definitions()))
+	}
+}
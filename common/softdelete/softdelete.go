@@ -0,0 +1,17 @@
+// admin/common/softdelete/softdelete.go
+package softdelete
+
+import "context"
+
+type skipKey struct{}
+
+// Skip 返回跳过软删除过滤的context，用于恢复、清理等需要读写已删除记录的场景
+func Skip(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipKey{}, true)
+}
+
+// Skipped context是否跳过软删除过滤
+func Skipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipKey{}).(bool)
+	return skip
+}
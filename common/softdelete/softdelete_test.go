@@ -0,0 +1,16 @@
+package softdelete
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSkip(t *testing.T) {
+	ctx := context.Background()
+	if Skipped(ctx) {
+		t.Error("expected soft-delete filtering by default")
+	}
+	if !Skipped(Skip(ctx)) {
+		t.Error("expected filtering to be skipped")
+	}
+}
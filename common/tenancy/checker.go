@@ -0,0 +1,90 @@
+// admin/common/tenancy/checker.go
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/yc-alpha/admin/common/softdelete"
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+// UnavailableError 租户不可用，TenantID为导致不可用的租户，可能是请求租户的上级租户
+type UnavailableError struct {
+	TenantID  int64
+	Status    tenant.Status
+	Inherited bool // 是否由上级租户（如停用的集团型租户）导致
+}
+
+func (e *UnavailableError) Error() string {
+	if e.Inherited {
+		return fmt.Sprintf("parent tenant %d is %s", e.TenantID, e.Status)
+	}
+	return fmt.Sprintf("tenant %d is %s", e.TenantID, e.Status)
+}
+
+// Check 检查租户及其所有上级租户是否可用
+// 状态不落到子租户上：集团型租户停用或过期时，沿ltree路径判定其下所有子租户不可用，恢复后子租户保持各自原有状态
+// 已删除的租户同样判定为不可用，因此查询时跳过软删除过滤
+func Check(ctx context.Context, client *ent.Client, tenantID int64, now time.Time) error {
+	ctx = softdelete.Skip(ctx)
+	t, err := client.Tenant.Get(ctx, tenantID)
+	if ent.IsNotFound(err) {
+		return &UnavailableError{TenantID: tenantID, Status: "NOT_FOUND"}
+	}
+	if err != nil {
+		return err
+	}
+	if !Usable(t, now) {
+		return unavailable(t, false)
+	}
+	if t.Path == nil {
+		return nil
+	}
+
+	ancestors, err := client.Tenant.Query().
+		Where(
+			predicate.Tenant(AncestorOf(tenant.FieldPath, *t.Path)),
+			tenant.IDNEQ(t.ID),
+		).
+		Order(ent.Asc(tenant.FieldLevel)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range ancestors {
+		// 系统租户（ROOT）不参与判定
+		if a.Type == tenant.TypeROOT {
+			continue
+		}
+		if !Usable(a, now) {
+			return unavailable(a, true)
+		}
+	}
+	return nil
+}
+
+func unavailable(t *ent.Tenant, inherited bool) *UnavailableError {
+	status := t.Status
+	if t.DeletedAt != nil {
+		status = "DELETED"
+	} else if status == tenant.StatusACTIVE {
+		// 已到期但过期任务尚未处理
+		status = tenant.StatusEXPIRED
+	}
+	return &UnavailableError{TenantID: t.ID, Status: status, Inherited: inherited}
+}
+
+// AncestorOf 筛选ltree路径为path祖先（含自身）的记录：column @> path
+func AncestorOf(column, path string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(column)).WriteString(" @> ").Arg(path).WriteString("::ltree")
+		}))
+	}
+}
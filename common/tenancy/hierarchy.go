@@ -0,0 +1,59 @@
+// admin/common/tenancy/hierarchy.go
+package tenancy
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+var (
+	ErrRootTenant    = errors.New("tenancy: the ROOT tenant cannot be moved or converted")
+	ErrInvalidParent = errors.New("tenancy: invalid parent tenant type")
+	ErrHasChildren   = errors.New("tenancy: only GROUP tenants can have child tenants")
+	ErrMoveIntoSelf  = errors.New("tenancy: cannot move a tenant into its own subtree")
+	ErrNoChange      = errors.New("tenancy: tenant is already placed there")
+)
+
+// ValidatePlacement 校验租户t以类型typ挂在parent下是否合法，规则与tenant_type_check约束及创建租户时一致：
+// GROUP、NORMAL的父租户为ROOT，SUB的父租户为GROUP，只有GROUP可以有子租户
+func ValidatePlacement(t *ent.Tenant, typ tenant.Type, parent *ent.Tenant, hasChildren bool) error {
+	if t.Type == tenant.TypeROOT || typ == tenant.TypeROOT {
+		return ErrRootTenant
+	}
+	if parent.ID == t.ID || (t.Path != nil && parent.Path != nil && IsDescendant(*parent.Path, *t.Path)) {
+		return ErrMoveIntoSelf
+	}
+	switch typ {
+	case tenant.TypeGROUP, tenant.TypeNORMAL:
+		if parent.Type != tenant.TypeROOT {
+			return ErrInvalidParent
+		}
+	case tenant.TypeSUB:
+		if parent.Type != tenant.TypeGROUP {
+			return ErrInvalidParent
+		}
+	default:
+		return ErrInvalidParent
+	}
+	if typ != tenant.TypeGROUP && hasChildren {
+		return ErrHasChildren
+	}
+	if typ == t.Type && t.ParentID != nil && *t.ParentID == parent.ID {
+		return ErrNoChange
+	}
+	return nil
+}
+
+// ChildPath 子节点的ltree路径
+func ChildPath(parentPath string, id int64) string {
+	return parentPath + "." + strconv.FormatInt(id, 10)
+}
+
+// IsDescendant path是否为ancestor自身或其下级路径
+func IsDescendant(path, ancestor string) bool {
+	return path == ancestor || strings.HasPrefix(path, ancestor+".")
+}
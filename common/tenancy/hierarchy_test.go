@@ -0,0 +1,76 @@
+package tenancy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+func newTenant(id int64, typ tenant.Type, parent *ent.Tenant) *ent.Tenant {
+	t := &ent.Tenant{ID: id, Type: typ}
+	path := "100"
+	if parent != nil {
+		t.ParentID = &parent.ID
+		path = ChildPath(*parent.Path, id)
+	}
+	t.Path = &path
+	return t
+}
+
+func TestValidatePlacement(t *testing.T) {
+	root := &ent.Tenant{ID: 100, Type: tenant.TypeROOT}
+	rootPath := "100"
+	root.Path = &rootPath
+	groupA := newTenant(1, tenant.TypeGROUP, root)
+	groupB := newTenant(2, tenant.TypeGROUP, root)
+	normal := newTenant(3, tenant.TypeNORMAL, root)
+	sub := newTenant(11, tenant.TypeSUB, groupA)
+
+	tests := []struct {
+		name        string
+		t           *ent.Tenant
+		typ         tenant.Type
+		parent      *ent.Tenant
+		hasChildren bool
+		err         error
+	}{
+		{"move sub to another group", sub, tenant.TypeSUB, groupB, false, nil},
+		{"move sub under same group", sub, tenant.TypeSUB, groupA, false, ErrNoChange},
+		{"move sub under normal", sub, tenant.TypeSUB, normal, false, ErrInvalidParent},
+		{"promote normal to group", normal, tenant.TypeGROUP, root, false, nil},
+		{"promote sub to normal", sub, tenant.TypeNORMAL, root, false, nil},
+		{"demote group with children", groupA, tenant.TypeNORMAL, root, true, ErrHasChildren},
+		{"demote empty group to sub", groupB, tenant.TypeSUB, groupA, false, nil},
+		{"group under itself", groupA, tenant.TypeSUB, groupA, false, ErrMoveIntoSelf},
+		{"group into own subtree", groupA, tenant.TypeSUB, sub, false, ErrMoveIntoSelf},
+		{"group under group", groupA, tenant.TypeGROUP, groupB, true, ErrInvalidParent},
+		{"move root", root, tenant.TypeGROUP, groupA, true, ErrRootTenant},
+		{"convert to root", normal, tenant.TypeROOT, root, false, ErrRootTenant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePlacement(tt.t, tt.typ, tt.parent, tt.hasChildren); !errors.Is(err, tt.err) {
+				t.Errorf("ValidatePlacement() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestIsDescendant(t *testing.T) {
+	tests := []struct {
+		path, ancestor string
+		want           bool
+	}{
+		{"100.1", "100.1", true},
+		{"100.1.11", "100.1", true},
+		{"100.11", "100.1", false},
+		{"100", "100.1", false},
+	}
+	for _, tt := range tests {
+		if got := IsDescendant(tt.path, tt.ancestor); got != tt.want {
+			t.Errorf("IsDescendant(%q, %q) = %v, want %v", tt.path, tt.ancestor, got, tt.want)
+		}
+	}
+}
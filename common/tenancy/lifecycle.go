@@ -0,0 +1,85 @@
+// admin/common/tenancy/lifecycle.go
+package tenancy
+
+import (
+	"errors"
+	"time"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+// Action 租户状态变更操作
+type Action string
+
+const (
+	ActionActivate Action = "ACTIVATE" // 激活：PENDING/DISABLED -> ACTIVE
+	ActionDisable  Action = "DISABLE"  // 停用：PENDING/ACTIVE/EXPIRED -> DISABLED
+	ActionRenew    Action = "RENEW"    // 续期：更新到期时间，EXPIRED -> ACTIVE，其余状态不变
+	ActionExpire   Action = "EXPIRE"   // 过期：PENDING/ACTIVE -> EXPIRED
+)
+
+var (
+	ErrInvalidTransition = errors.New("tenancy: invalid status transition")
+	ErrRenewRequired     = errors.New("tenancy: tenant has expired, renew it first")
+	ErrInvalidExpiry     = errors.New("tenancy: expiry must be in the future")
+)
+
+// transitions 各操作允许的起始状态及目标状态，目标为空表示保持原状态
+var transitions = map[Action]map[tenant.Status]tenant.Status{
+	ActionActivate: {
+		tenant.StatusPENDING:  tenant.StatusACTIVE,
+		tenant.StatusDISABLED: tenant.StatusACTIVE,
+	},
+	ActionDisable: {
+		tenant.StatusPENDING: tenant.StatusDISABLED,
+		tenant.StatusACTIVE:  tenant.StatusDISABLED,
+		tenant.StatusEXPIRED: tenant.StatusDISABLED,
+	},
+	ActionRenew: {
+		tenant.StatusPENDING:  "",
+		tenant.StatusACTIVE:   "",
+		tenant.StatusDISABLED: "",
+		tenant.StatusEXPIRED:  tenant.StatusACTIVE,
+	},
+	ActionExpire: {
+		tenant.StatusPENDING: tenant.StatusEXPIRED,
+		tenant.StatusACTIVE:  tenant.StatusEXPIRED,
+	},
+}
+
+// Transition 校验状态变更并返回目标状态
+// expiredAt为租户当前的到期时间；续期时renewTo为新的到期时间，必须晚于now
+// 已过了到期时间的租户不能直接激活，需要先续期
+func Transition(status tenant.Status, action Action, expiredAt, renewTo *time.Time, now time.Time) (tenant.Status, error) {
+	allowed, ok := transitions[action]
+	if !ok {
+		return "", ErrInvalidTransition
+	}
+	to, ok := allowed[status]
+	if !ok {
+		return "", ErrInvalidTransition
+	}
+	switch action {
+	case ActionActivate:
+		if expiredAt != nil && !expiredAt.After(now) {
+			return "", ErrRenewRequired
+		}
+	case ActionRenew:
+		if renewTo == nil || !renewTo.After(now) {
+			return "", ErrInvalidExpiry
+		}
+	}
+	if to == "" {
+		to = status
+	}
+	return to, nil
+}
+
+// Usable 租户自身是否可用：ACTIVE、未删除且未到期
+func Usable(t *ent.Tenant, now time.Time) bool {
+	if t.Status != tenant.StatusACTIVE || t.DeletedAt != nil {
+		return false
+	}
+	return t.ExpiredAt == nil || t.ExpiredAt.After(now)
+}
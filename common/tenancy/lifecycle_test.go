@@ -0,0 +1,96 @@
+package tenancy
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+func TestTransition(t *testing.T) {
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name      string
+		status    tenant.Status
+		action    Action
+		expiredAt *time.Time
+		renewTo   *time.Time
+		want      tenant.Status
+		err       error
+	}{
+		{"activate pending", tenant.StatusPENDING, ActionActivate, nil, nil, tenant.StatusACTIVE, nil},
+		{"activate disabled", tenant.StatusDISABLED, ActionActivate, &future, nil, tenant.StatusACTIVE, nil},
+		{"activate active", tenant.StatusACTIVE, ActionActivate, nil, nil, "", ErrInvalidTransition},
+		{"activate expired", tenant.StatusEXPIRED, ActionActivate, &past, nil, "", ErrInvalidTransition},
+		{"activate past expiry", tenant.StatusDISABLED, ActionActivate, &past, nil, "", ErrRenewRequired},
+		{"disable active", tenant.StatusACTIVE, ActionDisable, nil, nil, tenant.StatusDISABLED, nil},
+		{"disable disabled", tenant.StatusDISABLED, ActionDisable, nil, nil, "", ErrInvalidTransition},
+		{"renew expired", tenant.StatusEXPIRED, ActionRenew, &past, &future, tenant.StatusACTIVE, nil},
+		{"renew keeps disabled", tenant.StatusDISABLED, ActionRenew, &past, &future, tenant.StatusDISABLED, nil},
+		{"renew into past", tenant.StatusACTIVE, ActionRenew, &future, &past, "", ErrInvalidExpiry},
+		{"renew without expiry", tenant.StatusACTIVE, ActionRenew, nil, nil, "", ErrInvalidExpiry},
+		{"expire active", tenant.StatusACTIVE, ActionExpire, &past, nil, tenant.StatusEXPIRED, nil},
+		{"expire disabled", tenant.StatusDISABLED, ActionExpire, &past, nil, "", ErrInvalidTransition},
+		{"unknown action", tenant.StatusACTIVE, "DELETE", nil, nil, "", ErrInvalidTransition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Transition(tt.status, tt.action, tt.expiredAt, tt.renewTo, now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Transition() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Transition() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsable(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name string
+		t    *ent.Tenant
+		want bool
+	}{
+		{"active", &ent.Tenant{Status: tenant.StatusACTIVE}, true},
+		{"active not expired", &ent.Tenant{Status: tenant.StatusACTIVE, ExpiredAt: &future}, true},
+		{"active past expiry", &ent.Tenant{Status: tenant.StatusACTIVE, ExpiredAt: &past}, false},
+		{"disabled", &ent.Tenant{Status: tenant.StatusDISABLED}, false},
+		{"deleted", &ent.Tenant{Status: tenant.StatusACTIVE, DeletedAt: &past}, false},
+	}
+	for _, tt := range tests {
+		if got := Usable(tt.t, now); got != tt.want {
+			t.Errorf("%s: Usable() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUnavailable(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	err := unavailable(&ent.Tenant{ID: 1, Status: tenant.StatusACTIVE, ExpiredAt: &past}, true)
+	if err.Status != tenant.StatusEXPIRED || !strings.Contains(err.Error(), "parent tenant 1") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAncestorOf(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(tenant.Table))
+	AncestorOf(tenant.FieldPath, "1.100.200")(s)
+	query, args := s.Query()
+	if !strings.Contains(query, `"tenants"."path" @> $1::ltree`) {
+		t.Errorf("unexpected query: %s", query)
+	}
+	if !reflect.DeepEqual(args, []any{"1.100.200"}) {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+// AccessPolicy is the model entity for the AccessPolicy schema.
+type AccessPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary Key ID
+	ID int64 `json:"id,omitempty"`
+	// 租户ID，为空表示平台级策略，对所有租户生效
+	TenantID *int64 `json:"tenant_id,omitempty"`
+	// 策略名称
+	Name string `json:"name,omitempty"`
+	// 策略描述
+	Description string `json:"description,omitempty"`
+	// 策略效果
+	Effect accesspolicy.Effect `json:"effect,omitempty"`
+	// 主体属性条件
+	Subjects []abac.Attribute `json:"subjects,omitempty"`
+	// 资源属性条件
+	Resources []abac.Attribute `json:"resources,omitempty"`
+	// 操作属性条件
+	Actions []abac.Attribute `json:"actions,omitempty"`
+	// 环境属性条件
+	Contexts []abac.Attribute `json:"contexts,omitempty"`
+	// 优先级，数值越大越先匹配
+	Priority int32 `json:"priority,omitempty"`
+	// 是否启用
+	IsEnabled bool `json:"is_enabled,omitempty"`
+	// User who created this record
+	CreatedBy *int64 `json:"created_by,omitempty"`
+	// User who last updated this record
+	UpdatedBy *int64 `json:"updated_by,omitempty"`
+	// Creation timestamp of this record
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp of this record
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccessPolicyQuery when eager-loading is set.
+	Edges        AccessPolicyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccessPolicyEdges holds the relations/edges for other nodes in the graph.
+type AccessPolicyEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessPolicyEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accesspolicy.FieldSubjects, accesspolicy.FieldResources, accesspolicy.FieldActions, accesspolicy.FieldContexts:
+			values[i] = new([]byte)
+		case accesspolicy.FieldIsEnabled:
+			values[i] = new(sql.NullBool)
+		case accesspolicy.FieldID, accesspolicy.FieldTenantID, accesspolicy.FieldPriority, accesspolicy.FieldCreatedBy, accesspolicy.FieldUpdatedBy:
+			values[i] = new(sql.NullInt64)
+		case accesspolicy.FieldName, accesspolicy.FieldDescription, accesspolicy.FieldEffect:
+			values[i] = new(sql.NullString)
+		case accesspolicy.FieldCreatedAt, accesspolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessPolicy fields.
+func (ap *AccessPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accesspolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ap.ID = int64(value.Int64)
+		case accesspolicy.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ap.TenantID = new(int64)
+				*ap.TenantID = value.Int64
+			}
+		case accesspolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ap.Name = value.String
+			}
+		case accesspolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ap.Description = value.String
+			}
+		case accesspolicy.FieldEffect:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field effect", values[i])
+			} else if value.Valid {
+				ap.Effect = accesspolicy.Effect(value.String)
+			}
+		case accesspolicy.FieldSubjects:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subjects", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ap.Subjects); err != nil {
+					return fmt.Errorf("unmarshal field subjects: %w", err)
+				}
+			}
+		case accesspolicy.FieldResources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field resources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ap.Resources); err != nil {
+					return fmt.Errorf("unmarshal field resources: %w", err)
+				}
+			}
+		case accesspolicy.FieldActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ap.Actions); err != nil {
+					return fmt.Errorf("unmarshal field actions: %w", err)
+				}
+			}
+		case accesspolicy.FieldContexts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contexts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ap.Contexts); err != nil {
+					return fmt.Errorf("unmarshal field contexts: %w", err)
+				}
+			}
+		case accesspolicy.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				ap.Priority = int32(value.Int64)
+			}
+		case accesspolicy.FieldIsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_enabled", values[i])
+			} else if value.Valid {
+				ap.IsEnabled = value.Bool
+			}
+		case accesspolicy.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ap.CreatedBy = new(int64)
+				*ap.CreatedBy = value.Int64
+			}
+		case accesspolicy.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ap.UpdatedBy = new(int64)
+				*ap.UpdatedBy = value.Int64
+			}
+		case accesspolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ap.CreatedAt = value.Time
+			}
+		case accesspolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ap.UpdatedAt = value.Time
+			}
+		default:
+			ap.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessPolicy.
+// This includes values selected through modifiers, order, etc.
+func (ap *AccessPolicy) Value(name string) (ent.Value, error) {
+	return ap.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the AccessPolicy entity.
+func (ap *AccessPolicy) QueryTenant() *TenantQuery {
+	return NewAccessPolicyClient(ap.config).QueryTenant(ap)
+}
+
+// Update returns a builder for updating this AccessPolicy.
+// Note that you need to call AccessPolicy.Unwrap() before calling this method if this AccessPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ap *AccessPolicy) Update() *AccessPolicyUpdateOne {
+	return NewAccessPolicyClient(ap.config).UpdateOne(ap)
+}
+
+// Unwrap unwraps the AccessPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ap *AccessPolicy) Unwrap() *AccessPolicy {
+	_tx, ok := ap.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessPolicy is not a transactional entity")
+	}
+	ap.config.driver = _tx.drv
+	return ap
+}
+
+// String implements the fmt.Stringer.
+func (ap *AccessPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ap.ID))
+	if v := ap.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ap.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ap.Description)
+	builder.WriteString(", ")
+	builder.WriteString("effect=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Effect))
+	builder.WriteString(", ")
+	builder.WriteString("subjects=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Subjects))
+	builder.WriteString(", ")
+	builder.WriteString("resources=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Resources))
+	builder.WriteString(", ")
+	builder.WriteString("actions=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Actions))
+	builder.WriteString(", ")
+	builder.WriteString("contexts=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Contexts))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("is_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ap.IsEnabled))
+	builder.WriteString(", ")
+	if v := ap.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ap.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ap.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ap.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessPolicies is a parsable slice of AccessPolicy.
+type AccessPolicies []*AccessPolicy
@@ -0,0 +1,1358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+// AccessPolicyCreate is the builder for creating a AccessPolicy entity.
+type AccessPolicyCreate struct {
+	config
+	mutation *AccessPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (apc *AccessPolicyCreate) SetTenantID(i int64) *AccessPolicyCreate {
+	apc.mutation.SetTenantID(i)
+	return apc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableTenantID(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetTenantID(*i)
+	}
+	return apc
+}
+
+// SetName sets the "name" field.
+func (apc *AccessPolicyCreate) SetName(s string) *AccessPolicyCreate {
+	apc.mutation.SetName(s)
+	return apc
+}
+
+// SetDescription sets the "description" field.
+func (apc *AccessPolicyCreate) SetDescription(s string) *AccessPolicyCreate {
+	apc.mutation.SetDescription(s)
+	return apc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableDescription(s *string) *AccessPolicyCreate {
+	if s != nil {
+		apc.SetDescription(*s)
+	}
+	return apc
+}
+
+// SetEffect sets the "effect" field.
+func (apc *AccessPolicyCreate) SetEffect(a accesspolicy.Effect) *AccessPolicyCreate {
+	apc.mutation.SetEffect(a)
+	return apc
+}
+
+// SetSubjects sets the "subjects" field.
+func (apc *AccessPolicyCreate) SetSubjects(a []abac.Attribute) *AccessPolicyCreate {
+	apc.mutation.SetSubjects(a)
+	return apc
+}
+
+// SetResources sets the "resources" field.
+func (apc *AccessPolicyCreate) SetResources(a []abac.Attribute) *AccessPolicyCreate {
+	apc.mutation.SetResources(a)
+	return apc
+}
+
+// SetActions sets the "actions" field.
+func (apc *AccessPolicyCreate) SetActions(a []abac.Attribute) *AccessPolicyCreate {
+	apc.mutation.SetActions(a)
+	return apc
+}
+
+// SetContexts sets the "contexts" field.
+func (apc *AccessPolicyCreate) SetContexts(a []abac.Attribute) *AccessPolicyCreate {
+	apc.mutation.SetContexts(a)
+	return apc
+}
+
+// SetPriority sets the "priority" field.
+func (apc *AccessPolicyCreate) SetPriority(i int32) *AccessPolicyCreate {
+	apc.mutation.SetPriority(i)
+	return apc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillablePriority(i *int32) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetPriority(*i)
+	}
+	return apc
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (apc *AccessPolicyCreate) SetIsEnabled(b bool) *AccessPolicyCreate {
+	apc.mutation.SetIsEnabled(b)
+	return apc
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableIsEnabled(b *bool) *AccessPolicyCreate {
+	if b != nil {
+		apc.SetIsEnabled(*b)
+	}
+	return apc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (apc *AccessPolicyCreate) SetCreatedBy(i int64) *AccessPolicyCreate {
+	apc.mutation.SetCreatedBy(i)
+	return apc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableCreatedBy(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetCreatedBy(*i)
+	}
+	return apc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (apc *AccessPolicyCreate) SetUpdatedBy(i int64) *AccessPolicyCreate {
+	apc.mutation.SetUpdatedBy(i)
+	return apc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableUpdatedBy(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetUpdatedBy(*i)
+	}
+	return apc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (apc *AccessPolicyCreate) SetCreatedAt(t time.Time) *AccessPolicyCreate {
+	apc.mutation.SetCreatedAt(t)
+	return apc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableCreatedAt(t *time.Time) *AccessPolicyCreate {
+	if t != nil {
+		apc.SetCreatedAt(*t)
+	}
+	return apc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apc *AccessPolicyCreate) SetUpdatedAt(t time.Time) *AccessPolicyCreate {
+	apc.mutation.SetUpdatedAt(t)
+	return apc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableUpdatedAt(t *time.Time) *AccessPolicyCreate {
+	if t != nil {
+		apc.SetUpdatedAt(*t)
+	}
+	return apc
+}
+
+// SetID sets the "id" field.
+func (apc *AccessPolicyCreate) SetID(i int64) *AccessPolicyCreate {
+	apc.mutation.SetID(i)
+	return apc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableID(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetID(*i)
+	}
+	return apc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (apc *AccessPolicyCreate) SetTenant(t *Tenant) *AccessPolicyCreate {
+	return apc.SetTenantID(t.ID)
+}
+
+// Mutation returns the AccessPolicyMutation object of the builder.
+func (apc *AccessPolicyCreate) Mutation() *AccessPolicyMutation {
+	return apc.mutation
+}
+
+// Save creates the AccessPolicy in the database.
+func (apc *AccessPolicyCreate) Save(ctx context.Context) (*AccessPolicy, error) {
+	apc.defaults()
+	return withHooks(ctx, apc.sqlSave, apc.mutation, apc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (apc *AccessPolicyCreate) SaveX(ctx context.Context) *AccessPolicy {
+	v, err := apc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apc *AccessPolicyCreate) Exec(ctx context.Context) error {
+	_, err := apc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apc *AccessPolicyCreate) ExecX(ctx context.Context) {
+	if err := apc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apc *AccessPolicyCreate) defaults() {
+	if _, ok := apc.mutation.Subjects(); !ok {
+		v := accesspolicy.DefaultSubjects
+		apc.mutation.SetSubjects(v)
+	}
+	if _, ok := apc.mutation.Resources(); !ok {
+		v := accesspolicy.DefaultResources
+		apc.mutation.SetResources(v)
+	}
+	if _, ok := apc.mutation.Actions(); !ok {
+		v := accesspolicy.DefaultActions
+		apc.mutation.SetActions(v)
+	}
+	if _, ok := apc.mutation.Contexts(); !ok {
+		v := accesspolicy.DefaultContexts
+		apc.mutation.SetContexts(v)
+	}
+	if _, ok := apc.mutation.Priority(); !ok {
+		v := accesspolicy.DefaultPriority
+		apc.mutation.SetPriority(v)
+	}
+	if _, ok := apc.mutation.IsEnabled(); !ok {
+		v := accesspolicy.DefaultIsEnabled
+		apc.mutation.SetIsEnabled(v)
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		v := accesspolicy.DefaultCreatedAt()
+		apc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := apc.mutation.UpdatedAt(); !ok {
+		v := accesspolicy.DefaultUpdatedAt()
+		apc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := apc.mutation.ID(); !ok {
+		v := accesspolicy.DefaultID()
+		apc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apc *AccessPolicyCreate) check() error {
+	if _, ok := apc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AccessPolicy.name"`)}
+	}
+	if v, ok := apc.mutation.Name(); ok {
+		if err := accesspolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.Effect(); !ok {
+		return &ValidationError{Name: "effect", err: errors.New(`ent: missing required field "AccessPolicy.effect"`)}
+	}
+	if v, ok := apc.mutation.Effect(); ok {
+		if err := accesspolicy.EffectValidator(v); err != nil {
+			return &ValidationError{Name: "effect", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.effect": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.Subjects(); !ok {
+		return &ValidationError{Name: "subjects", err: errors.New(`ent: missing required field "AccessPolicy.subjects"`)}
+	}
+	if _, ok := apc.mutation.Resources(); !ok {
+		return &ValidationError{Name: "resources", err: errors.New(`ent: missing required field "AccessPolicy.resources"`)}
+	}
+	if _, ok := apc.mutation.Actions(); !ok {
+		return &ValidationError{Name: "actions", err: errors.New(`ent: missing required field "AccessPolicy.actions"`)}
+	}
+	if _, ok := apc.mutation.Contexts(); !ok {
+		return &ValidationError{Name: "contexts", err: errors.New(`ent: missing required field "AccessPolicy.contexts"`)}
+	}
+	if _, ok := apc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "AccessPolicy.priority"`)}
+	}
+	if _, ok := apc.mutation.IsEnabled(); !ok {
+		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "AccessPolicy.is_enabled"`)}
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessPolicy.created_at"`)}
+	}
+	if _, ok := apc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccessPolicy.updated_at"`)}
+	}
+	return nil
+}
+
+func (apc *AccessPolicyCreate) sqlSave(ctx context.Context) (*AccessPolicy, error) {
+	if err := apc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := apc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, apc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	apc.mutation.id = &_node.ID
+	apc.mutation.done = true
+	return _node, nil
+}
+
+func (apc *AccessPolicyCreate) createSpec() (*AccessPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessPolicy{config: apc.config}
+		_spec = sqlgraph.NewCreateSpec(accesspolicy.Table, sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = apc.conflict
+	if id, ok := apc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := apc.mutation.Name(); ok {
+		_spec.SetField(accesspolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := apc.mutation.Description(); ok {
+		_spec.SetField(accesspolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := apc.mutation.Effect(); ok {
+		_spec.SetField(accesspolicy.FieldEffect, field.TypeEnum, value)
+		_node.Effect = value
+	}
+	if value, ok := apc.mutation.Subjects(); ok {
+		_spec.SetField(accesspolicy.FieldSubjects, field.TypeJSON, value)
+		_node.Subjects = value
+	}
+	if value, ok := apc.mutation.Resources(); ok {
+		_spec.SetField(accesspolicy.FieldResources, field.TypeJSON, value)
+		_node.Resources = value
+	}
+	if value, ok := apc.mutation.Actions(); ok {
+		_spec.SetField(accesspolicy.FieldActions, field.TypeJSON, value)
+		_node.Actions = value
+	}
+	if value, ok := apc.mutation.Contexts(); ok {
+		_spec.SetField(accesspolicy.FieldContexts, field.TypeJSON, value)
+		_node.Contexts = value
+	}
+	if value, ok := apc.mutation.Priority(); ok {
+		_spec.SetField(accesspolicy.FieldPriority, field.TypeInt32, value)
+		_node.Priority = value
+	}
+	if value, ok := apc.mutation.IsEnabled(); ok {
+		_spec.SetField(accesspolicy.FieldIsEnabled, field.TypeBool, value)
+		_node.IsEnabled = value
+	}
+	if value, ok := apc.mutation.CreatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := apc.mutation.UpdatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := apc.mutation.CreatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := apc.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := apc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesspolicy.TenantTable,
+			Columns: []string{accesspolicy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessPolicy.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessPolicyUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (apc *AccessPolicyCreate) OnConflict(opts ...sql.ConflictOption) *AccessPolicyUpsertOne {
+	apc.conflict = opts
+	return &AccessPolicyUpsertOne{
+		create: apc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apc *AccessPolicyCreate) OnConflictColumns(columns ...string) *AccessPolicyUpsertOne {
+	apc.conflict = append(apc.conflict, sql.ConflictColumns(columns...))
+	return &AccessPolicyUpsertOne{
+		create: apc,
+	}
+}
+
+type (
+	// AccessPolicyUpsertOne is the builder for "upsert"-ing
+	//  one AccessPolicy node.
+	AccessPolicyUpsertOne struct {
+		create *AccessPolicyCreate
+	}
+
+	// AccessPolicyUpsert is the "OnConflict" setter.
+	AccessPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *AccessPolicyUpsert) SetName(v string) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateName() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *AccessPolicyUpsert) SetDescription(v string) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateDescription() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *AccessPolicyUpsert) ClearDescription() *AccessPolicyUpsert {
+	u.SetNull(accesspolicy.FieldDescription)
+	return u
+}
+
+// SetEffect sets the "effect" field.
+func (u *AccessPolicyUpsert) SetEffect(v accesspolicy.Effect) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldEffect, v)
+	return u
+}
+
+// UpdateEffect sets the "effect" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateEffect() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldEffect)
+	return u
+}
+
+// SetSubjects sets the "subjects" field.
+func (u *AccessPolicyUpsert) SetSubjects(v []abac.Attribute) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldSubjects, v)
+	return u
+}
+
+// UpdateSubjects sets the "subjects" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateSubjects() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldSubjects)
+	return u
+}
+
+// SetResources sets the "resources" field.
+func (u *AccessPolicyUpsert) SetResources(v []abac.Attribute) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldResources, v)
+	return u
+}
+
+// UpdateResources sets the "resources" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateResources() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldResources)
+	return u
+}
+
+// SetActions sets the "actions" field.
+func (u *AccessPolicyUpsert) SetActions(v []abac.Attribute) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldActions, v)
+	return u
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateActions() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldActions)
+	return u
+}
+
+// SetContexts sets the "contexts" field.
+func (u *AccessPolicyUpsert) SetContexts(v []abac.Attribute) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldContexts, v)
+	return u
+}
+
+// UpdateContexts sets the "contexts" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateContexts() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldContexts)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *AccessPolicyUpsert) SetPriority(v int32) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdatePriority() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *AccessPolicyUpsert) AddPriority(v int32) *AccessPolicyUpsert {
+	u.Add(accesspolicy.FieldPriority, v)
+	return u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *AccessPolicyUpsert) SetIsEnabled(v bool) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldIsEnabled, v)
+	return u
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateIsEnabled() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldIsEnabled)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AccessPolicyUpsert) SetCreatedBy(v int64) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateCreatedBy() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AccessPolicyUpsert) AddCreatedBy(v int64) *AccessPolicyUpsert {
+	u.Add(accesspolicy.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AccessPolicyUpsert) ClearCreatedBy() *AccessPolicyUpsert {
+	u.SetNull(accesspolicy.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AccessPolicyUpsert) SetUpdatedBy(v int64) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateUpdatedBy() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AccessPolicyUpsert) AddUpdatedBy(v int64) *AccessPolicyUpsert {
+	u.Add(accesspolicy.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AccessPolicyUpsert) ClearUpdatedBy() *AccessPolicyUpsert {
+	u.SetNull(accesspolicy.FieldUpdatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccessPolicyUpsert) SetUpdatedAt(v time.Time) *AccessPolicyUpsert {
+	u.Set(accesspolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccessPolicyUpsert) UpdateUpdatedAt() *AccessPolicyUpsert {
+	u.SetExcluded(accesspolicy.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccessPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accesspolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccessPolicyUpsertOne) UpdateNewValues() *AccessPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accesspolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(accesspolicy.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accesspolicy.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccessPolicyUpsertOne) Ignore() *AccessPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessPolicyUpsertOne) DoNothing() *AccessPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessPolicyCreate.OnConflict
+// documentation for more info.
+func (u *AccessPolicyUpsertOne) Update(set func(*AccessPolicyUpsert)) *AccessPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AccessPolicyUpsertOne) SetName(v string) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateName() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *AccessPolicyUpsertOne) SetDescription(v string) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateDescription() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *AccessPolicyUpsertOne) ClearDescription() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetEffect sets the "effect" field.
+func (u *AccessPolicyUpsertOne) SetEffect(v accesspolicy.Effect) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetEffect(v)
+	})
+}
+
+// UpdateEffect sets the "effect" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateEffect() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateEffect()
+	})
+}
+
+// SetSubjects sets the "subjects" field.
+func (u *AccessPolicyUpsertOne) SetSubjects(v []abac.Attribute) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetSubjects(v)
+	})
+}
+
+// UpdateSubjects sets the "subjects" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateSubjects() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateSubjects()
+	})
+}
+
+// SetResources sets the "resources" field.
+func (u *AccessPolicyUpsertOne) SetResources(v []abac.Attribute) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetResources(v)
+	})
+}
+
+// UpdateResources sets the "resources" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateResources() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateResources()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *AccessPolicyUpsertOne) SetActions(v []abac.Attribute) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateActions() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// SetContexts sets the "contexts" field.
+func (u *AccessPolicyUpsertOne) SetContexts(v []abac.Attribute) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetContexts(v)
+	})
+}
+
+// UpdateContexts sets the "contexts" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateContexts() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateContexts()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *AccessPolicyUpsertOne) SetPriority(v int32) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *AccessPolicyUpsertOne) AddPriority(v int32) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdatePriority() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *AccessPolicyUpsertOne) SetIsEnabled(v bool) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateIsEnabled() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AccessPolicyUpsertOne) SetCreatedBy(v int64) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AccessPolicyUpsertOne) AddCreatedBy(v int64) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateCreatedBy() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AccessPolicyUpsertOne) ClearCreatedBy() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AccessPolicyUpsertOne) SetUpdatedBy(v int64) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AccessPolicyUpsertOne) AddUpdatedBy(v int64) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateUpdatedBy() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AccessPolicyUpsertOne) ClearUpdatedBy() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccessPolicyUpsertOne) SetUpdatedAt(v time.Time) *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccessPolicyUpsertOne) UpdateUpdatedAt() *AccessPolicyUpsertOne {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccessPolicyUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccessPolicyUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccessPolicyCreateBulk is the builder for creating many AccessPolicy entities in bulk.
+type AccessPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*AccessPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccessPolicy entities in the database.
+func (apcb *AccessPolicyCreateBulk) Save(ctx context.Context) ([]*AccessPolicy, error) {
+	if apcb.err != nil {
+		return nil, apcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(apcb.builders))
+	nodes := make([]*AccessPolicy, len(apcb.builders))
+	mutators := make([]Mutator, len(apcb.builders))
+	for i := range apcb.builders {
+		func(i int, root context.Context) {
+			builder := apcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, apcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = apcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, apcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, apcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apcb *AccessPolicyCreateBulk) SaveX(ctx context.Context) []*AccessPolicy {
+	v, err := apcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apcb *AccessPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := apcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apcb *AccessPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := apcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessPolicyUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (apcb *AccessPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccessPolicyUpsertBulk {
+	apcb.conflict = opts
+	return &AccessPolicyUpsertBulk{
+		create: apcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apcb *AccessPolicyCreateBulk) OnConflictColumns(columns ...string) *AccessPolicyUpsertBulk {
+	apcb.conflict = append(apcb.conflict, sql.ConflictColumns(columns...))
+	return &AccessPolicyUpsertBulk{
+		create: apcb,
+	}
+}
+
+// AccessPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccessPolicy nodes.
+type AccessPolicyUpsertBulk struct {
+	create *AccessPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccessPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accesspolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccessPolicyUpsertBulk) UpdateNewValues() *AccessPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accesspolicy.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(accesspolicy.FieldTenantID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accesspolicy.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccessPolicyUpsertBulk) Ignore() *AccessPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessPolicyUpsertBulk) DoNothing() *AccessPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccessPolicyUpsertBulk) Update(set func(*AccessPolicyUpsert)) *AccessPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AccessPolicyUpsertBulk) SetName(v string) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateName() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *AccessPolicyUpsertBulk) SetDescription(v string) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateDescription() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *AccessPolicyUpsertBulk) ClearDescription() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetEffect sets the "effect" field.
+func (u *AccessPolicyUpsertBulk) SetEffect(v accesspolicy.Effect) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetEffect(v)
+	})
+}
+
+// UpdateEffect sets the "effect" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateEffect() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateEffect()
+	})
+}
+
+// SetSubjects sets the "subjects" field.
+func (u *AccessPolicyUpsertBulk) SetSubjects(v []abac.Attribute) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetSubjects(v)
+	})
+}
+
+// UpdateSubjects sets the "subjects" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateSubjects() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateSubjects()
+	})
+}
+
+// SetResources sets the "resources" field.
+func (u *AccessPolicyUpsertBulk) SetResources(v []abac.Attribute) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetResources(v)
+	})
+}
+
+// UpdateResources sets the "resources" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateResources() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateResources()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *AccessPolicyUpsertBulk) SetActions(v []abac.Attribute) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateActions() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// SetContexts sets the "contexts" field.
+func (u *AccessPolicyUpsertBulk) SetContexts(v []abac.Attribute) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetContexts(v)
+	})
+}
+
+// UpdateContexts sets the "contexts" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateContexts() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateContexts()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *AccessPolicyUpsertBulk) SetPriority(v int32) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *AccessPolicyUpsertBulk) AddPriority(v int32) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdatePriority() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *AccessPolicyUpsertBulk) SetIsEnabled(v bool) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateIsEnabled() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *AccessPolicyUpsertBulk) SetCreatedBy(v int64) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *AccessPolicyUpsertBulk) AddCreatedBy(v int64) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateCreatedBy() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *AccessPolicyUpsertBulk) ClearCreatedBy() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *AccessPolicyUpsertBulk) SetUpdatedBy(v int64) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *AccessPolicyUpsertBulk) AddUpdatedBy(v int64) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateUpdatedBy() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *AccessPolicyUpsertBulk) ClearUpdatedBy() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccessPolicyUpsertBulk) SetUpdatedAt(v time.Time) *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccessPolicyUpsertBulk) UpdateUpdatedAt() *AccessPolicyUpsertBulk {
+	return u.Update(func(s *AccessPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccessPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
+	"github.com/yc-alpha/admin/ent/predicate"
+)
+
+// AccessPolicyUpdate is the builder for updating AccessPolicy entities.
+type AccessPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessPolicyMutation
+}
+
+// Where appends a list predicates to the AccessPolicyUpdate builder.
+func (apu *AccessPolicyUpdate) Where(ps ...predicate.AccessPolicy) *AccessPolicyUpdate {
+	apu.mutation.Where(ps...)
+	return apu
+}
+
+// SetName sets the "name" field.
+func (apu *AccessPolicyUpdate) SetName(s string) *AccessPolicyUpdate {
+	apu.mutation.SetName(s)
+	return apu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableName(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetName(*s)
+	}
+	return apu
+}
+
+// SetDescription sets the "description" field.
+func (apu *AccessPolicyUpdate) SetDescription(s string) *AccessPolicyUpdate {
+	apu.mutation.SetDescription(s)
+	return apu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableDescription(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetDescription(*s)
+	}
+	return apu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (apu *AccessPolicyUpdate) ClearDescription() *AccessPolicyUpdate {
+	apu.mutation.ClearDescription()
+	return apu
+}
+
+// SetEffect sets the "effect" field.
+func (apu *AccessPolicyUpdate) SetEffect(a accesspolicy.Effect) *AccessPolicyUpdate {
+	apu.mutation.SetEffect(a)
+	return apu
+}
+
+// SetNillableEffect sets the "effect" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableEffect(a *accesspolicy.Effect) *AccessPolicyUpdate {
+	if a != nil {
+		apu.SetEffect(*a)
+	}
+	return apu
+}
+
+// SetSubjects sets the "subjects" field.
+func (apu *AccessPolicyUpdate) SetSubjects(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.SetSubjects(a)
+	return apu
+}
+
+// AppendSubjects appends a to the "subjects" field.
+func (apu *AccessPolicyUpdate) AppendSubjects(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.AppendSubjects(a)
+	return apu
+}
+
+// SetResources sets the "resources" field.
+func (apu *AccessPolicyUpdate) SetResources(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.SetResources(a)
+	return apu
+}
+
+// AppendResources appends a to the "resources" field.
+func (apu *AccessPolicyUpdate) AppendResources(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.AppendResources(a)
+	return apu
+}
+
+// SetActions sets the "actions" field.
+func (apu *AccessPolicyUpdate) SetActions(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.SetActions(a)
+	return apu
+}
+
+// AppendActions appends a to the "actions" field.
+func (apu *AccessPolicyUpdate) AppendActions(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.AppendActions(a)
+	return apu
+}
+
+// SetContexts sets the "contexts" field.
+func (apu *AccessPolicyUpdate) SetContexts(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.SetContexts(a)
+	return apu
+}
+
+// AppendContexts appends a to the "contexts" field.
+func (apu *AccessPolicyUpdate) AppendContexts(a []abac.Attribute) *AccessPolicyUpdate {
+	apu.mutation.AppendContexts(a)
+	return apu
+}
+
+// SetPriority sets the "priority" field.
+func (apu *AccessPolicyUpdate) SetPriority(i int32) *AccessPolicyUpdate {
+	apu.mutation.ResetPriority()
+	apu.mutation.SetPriority(i)
+	return apu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillablePriority(i *int32) *AccessPolicyUpdate {
+	if i != nil {
+		apu.SetPriority(*i)
+	}
+	return apu
+}
+
+// AddPriority adds i to the "priority" field.
+func (apu *AccessPolicyUpdate) AddPriority(i int32) *AccessPolicyUpdate {
+	apu.mutation.AddPriority(i)
+	return apu
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (apu *AccessPolicyUpdate) SetIsEnabled(b bool) *AccessPolicyUpdate {
+	apu.mutation.SetIsEnabled(b)
+	return apu
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableIsEnabled(b *bool) *AccessPolicyUpdate {
+	if b != nil {
+		apu.SetIsEnabled(*b)
+	}
+	return apu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (apu *AccessPolicyUpdate) SetCreatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.ResetCreatedBy()
+	apu.mutation.SetCreatedBy(i)
+	return apu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableCreatedBy(i *int64) *AccessPolicyUpdate {
+	if i != nil {
+		apu.SetCreatedBy(*i)
+	}
+	return apu
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (apu *AccessPolicyUpdate) AddCreatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.AddCreatedBy(i)
+	return apu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (apu *AccessPolicyUpdate) ClearCreatedBy() *AccessPolicyUpdate {
+	apu.mutation.ClearCreatedBy()
+	return apu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (apu *AccessPolicyUpdate) SetUpdatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.ResetUpdatedBy()
+	apu.mutation.SetUpdatedBy(i)
+	return apu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableUpdatedBy(i *int64) *AccessPolicyUpdate {
+	if i != nil {
+		apu.SetUpdatedBy(*i)
+	}
+	return apu
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (apu *AccessPolicyUpdate) AddUpdatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.AddUpdatedBy(i)
+	return apu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (apu *AccessPolicyUpdate) ClearUpdatedBy() *AccessPolicyUpdate {
+	apu.mutation.ClearUpdatedBy()
+	return apu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apu *AccessPolicyUpdate) SetUpdatedAt(t time.Time) *AccessPolicyUpdate {
+	apu.mutation.SetUpdatedAt(t)
+	return apu
+}
+
+// Mutation returns the AccessPolicyMutation object of the builder.
+func (apu *AccessPolicyUpdate) Mutation() *AccessPolicyMutation {
+	return apu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (apu *AccessPolicyUpdate) Save(ctx context.Context) (int, error) {
+	apu.defaults()
+	return withHooks(ctx, apu.sqlSave, apu.mutation, apu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apu *AccessPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := apu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (apu *AccessPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := apu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apu *AccessPolicyUpdate) ExecX(ctx context.Context) {
+	if err := apu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apu *AccessPolicyUpdate) defaults() {
+	if _, ok := apu.mutation.UpdatedAt(); !ok {
+		v := accesspolicy.UpdateDefaultUpdatedAt()
+		apu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apu *AccessPolicyUpdate) check() error {
+	if v, ok := apu.mutation.Name(); ok {
+		if err := accesspolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := apu.mutation.Effect(); ok {
+		if err := accesspolicy.EffectValidator(v); err != nil {
+			return &ValidationError{Name: "effect", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.effect": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apu *AccessPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := apu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesspolicy.Table, accesspolicy.Columns, sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64))
+	if ps := apu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apu.mutation.Name(); ok {
+		_spec.SetField(accesspolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Description(); ok {
+		_spec.SetField(accesspolicy.FieldDescription, field.TypeString, value)
+	}
+	if apu.mutation.DescriptionCleared() {
+		_spec.ClearField(accesspolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := apu.mutation.Effect(); ok {
+		_spec.SetField(accesspolicy.FieldEffect, field.TypeEnum, value)
+	}
+	if value, ok := apu.mutation.Subjects(); ok {
+		_spec.SetField(accesspolicy.FieldSubjects, field.TypeJSON, value)
+	}
+	if value, ok := apu.mutation.AppendedSubjects(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldSubjects, value)
+		})
+	}
+	if value, ok := apu.mutation.Resources(); ok {
+		_spec.SetField(accesspolicy.FieldResources, field.TypeJSON, value)
+	}
+	if value, ok := apu.mutation.AppendedResources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldResources, value)
+		})
+	}
+	if value, ok := apu.mutation.Actions(); ok {
+		_spec.SetField(accesspolicy.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := apu.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldActions, value)
+		})
+	}
+	if value, ok := apu.mutation.Contexts(); ok {
+		_spec.SetField(accesspolicy.FieldContexts, field.TypeJSON, value)
+	}
+	if value, ok := apu.mutation.AppendedContexts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldContexts, value)
+		})
+	}
+	if value, ok := apu.mutation.Priority(); ok {
+		_spec.SetField(accesspolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := apu.mutation.AddedPriority(); ok {
+		_spec.AddField(accesspolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := apu.mutation.IsEnabled(); ok {
+		_spec.SetField(accesspolicy.FieldIsEnabled, field.TypeBool, value)
+	}
+	if value, ok := apu.mutation.CreatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if apu.mutation.CreatedByCleared() {
+		_spec.ClearField(accesspolicy.FieldCreatedBy, field.TypeInt64)
+	}
+	if value, ok := apu.mutation.UpdatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apu.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if apu.mutation.UpdatedByCleared() {
+		_spec.ClearField(accesspolicy.FieldUpdatedBy, field.TypeInt64)
+	}
+	if value, ok := apu.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, apu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesspolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	apu.mutation.done = true
+	return n, nil
+}
+
+// AccessPolicyUpdateOne is the builder for updating a single AccessPolicy entity.
+type AccessPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessPolicyMutation
+}
+
+// SetName sets the "name" field.
+func (apuo *AccessPolicyUpdateOne) SetName(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetName(s)
+	return apuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableName(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetName(*s)
+	}
+	return apuo
+}
+
+// SetDescription sets the "description" field.
+func (apuo *AccessPolicyUpdateOne) SetDescription(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetDescription(s)
+	return apuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableDescription(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetDescription(*s)
+	}
+	return apuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (apuo *AccessPolicyUpdateOne) ClearDescription() *AccessPolicyUpdateOne {
+	apuo.mutation.ClearDescription()
+	return apuo
+}
+
+// SetEffect sets the "effect" field.
+func (apuo *AccessPolicyUpdateOne) SetEffect(a accesspolicy.Effect) *AccessPolicyUpdateOne {
+	apuo.mutation.SetEffect(a)
+	return apuo
+}
+
+// SetNillableEffect sets the "effect" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableEffect(a *accesspolicy.Effect) *AccessPolicyUpdateOne {
+	if a != nil {
+		apuo.SetEffect(*a)
+	}
+	return apuo
+}
+
+// SetSubjects sets the "subjects" field.
+func (apuo *AccessPolicyUpdateOne) SetSubjects(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.SetSubjects(a)
+	return apuo
+}
+
+// AppendSubjects appends a to the "subjects" field.
+func (apuo *AccessPolicyUpdateOne) AppendSubjects(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.AppendSubjects(a)
+	return apuo
+}
+
+// SetResources sets the "resources" field.
+func (apuo *AccessPolicyUpdateOne) SetResources(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.SetResources(a)
+	return apuo
+}
+
+// AppendResources appends a to the "resources" field.
+func (apuo *AccessPolicyUpdateOne) AppendResources(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.AppendResources(a)
+	return apuo
+}
+
+// SetActions sets the "actions" field.
+func (apuo *AccessPolicyUpdateOne) SetActions(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.SetActions(a)
+	return apuo
+}
+
+// AppendActions appends a to the "actions" field.
+func (apuo *AccessPolicyUpdateOne) AppendActions(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.AppendActions(a)
+	return apuo
+}
+
+// SetContexts sets the "contexts" field.
+func (apuo *AccessPolicyUpdateOne) SetContexts(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.SetContexts(a)
+	return apuo
+}
+
+// AppendContexts appends a to the "contexts" field.
+func (apuo *AccessPolicyUpdateOne) AppendContexts(a []abac.Attribute) *AccessPolicyUpdateOne {
+	apuo.mutation.AppendContexts(a)
+	return apuo
+}
+
+// SetPriority sets the "priority" field.
+func (apuo *AccessPolicyUpdateOne) SetPriority(i int32) *AccessPolicyUpdateOne {
+	apuo.mutation.ResetPriority()
+	apuo.mutation.SetPriority(i)
+	return apuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillablePriority(i *int32) *AccessPolicyUpdateOne {
+	if i != nil {
+		apuo.SetPriority(*i)
+	}
+	return apuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (apuo *AccessPolicyUpdateOne) AddPriority(i int32) *AccessPolicyUpdateOne {
+	apuo.mutation.AddPriority(i)
+	return apuo
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (apuo *AccessPolicyUpdateOne) SetIsEnabled(b bool) *AccessPolicyUpdateOne {
+	apuo.mutation.SetIsEnabled(b)
+	return apuo
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableIsEnabled(b *bool) *AccessPolicyUpdateOne {
+	if b != nil {
+		apuo.SetIsEnabled(*b)
+	}
+	return apuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (apuo *AccessPolicyUpdateOne) SetCreatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.ResetCreatedBy()
+	apuo.mutation.SetCreatedBy(i)
+	return apuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableCreatedBy(i *int64) *AccessPolicyUpdateOne {
+	if i != nil {
+		apuo.SetCreatedBy(*i)
+	}
+	return apuo
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (apuo *AccessPolicyUpdateOne) AddCreatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.AddCreatedBy(i)
+	return apuo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (apuo *AccessPolicyUpdateOne) ClearCreatedBy() *AccessPolicyUpdateOne {
+	apuo.mutation.ClearCreatedBy()
+	return apuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (apuo *AccessPolicyUpdateOne) SetUpdatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.ResetUpdatedBy()
+	apuo.mutation.SetUpdatedBy(i)
+	return apuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableUpdatedBy(i *int64) *AccessPolicyUpdateOne {
+	if i != nil {
+		apuo.SetUpdatedBy(*i)
+	}
+	return apuo
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (apuo *AccessPolicyUpdateOne) AddUpdatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.AddUpdatedBy(i)
+	return apuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (apuo *AccessPolicyUpdateOne) ClearUpdatedBy() *AccessPolicyUpdateOne {
+	apuo.mutation.ClearUpdatedBy()
+	return apuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apuo *AccessPolicyUpdateOne) SetUpdatedAt(t time.Time) *AccessPolicyUpdateOne {
+	apuo.mutation.SetUpdatedAt(t)
+	return apuo
+}
+
+// Mutation returns the AccessPolicyMutation object of the builder.
+func (apuo *AccessPolicyUpdateOne) Mutation() *AccessPolicyMutation {
+	return apuo.mutation
+}
+
+// Where appends a list predicates to the AccessPolicyUpdate builder.
+func (apuo *AccessPolicyUpdateOne) Where(ps ...predicate.AccessPolicy) *AccessPolicyUpdateOne {
+	apuo.mutation.Where(ps...)
+	return apuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (apuo *AccessPolicyUpdateOne) Select(field string, fields ...string) *AccessPolicyUpdateOne {
+	apuo.fields = append([]string{field}, fields...)
+	return apuo
+}
+
+// Save executes the query and returns the updated AccessPolicy entity.
+func (apuo *AccessPolicyUpdateOne) Save(ctx context.Context) (*AccessPolicy, error) {
+	apuo.defaults()
+	return withHooks(ctx, apuo.sqlSave, apuo.mutation, apuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apuo *AccessPolicyUpdateOne) SaveX(ctx context.Context) *AccessPolicy {
+	node, err := apuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (apuo *AccessPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := apuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apuo *AccessPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := apuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apuo *AccessPolicyUpdateOne) defaults() {
+	if _, ok := apuo.mutation.UpdatedAt(); !ok {
+		v := accesspolicy.UpdateDefaultUpdatedAt()
+		apuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apuo *AccessPolicyUpdateOne) check() error {
+	if v, ok := apuo.mutation.Name(); ok {
+		if err := accesspolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := apuo.mutation.Effect(); ok {
+		if err := accesspolicy.EffectValidator(v); err != nil {
+			return &ValidationError{Name: "effect", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.effect": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apuo *AccessPolicyUpdateOne) sqlSave(ctx context.Context) (_node *AccessPolicy, err error) {
+	if err := apuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesspolicy.Table, accesspolicy.Columns, sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64))
+	id, ok := apuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := apuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesspolicy.FieldID)
+		for _, f := range fields {
+			if !accesspolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesspolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := apuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apuo.mutation.Name(); ok {
+		_spec.SetField(accesspolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Description(); ok {
+		_spec.SetField(accesspolicy.FieldDescription, field.TypeString, value)
+	}
+	if apuo.mutation.DescriptionCleared() {
+		_spec.ClearField(accesspolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := apuo.mutation.Effect(); ok {
+		_spec.SetField(accesspolicy.FieldEffect, field.TypeEnum, value)
+	}
+	if value, ok := apuo.mutation.Subjects(); ok {
+		_spec.SetField(accesspolicy.FieldSubjects, field.TypeJSON, value)
+	}
+	if value, ok := apuo.mutation.AppendedSubjects(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldSubjects, value)
+		})
+	}
+	if value, ok := apuo.mutation.Resources(); ok {
+		_spec.SetField(accesspolicy.FieldResources, field.TypeJSON, value)
+	}
+	if value, ok := apuo.mutation.AppendedResources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldResources, value)
+		})
+	}
+	if value, ok := apuo.mutation.Actions(); ok {
+		_spec.SetField(accesspolicy.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := apuo.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldActions, value)
+		})
+	}
+	if value, ok := apuo.mutation.Contexts(); ok {
+		_spec.SetField(accesspolicy.FieldContexts, field.TypeJSON, value)
+	}
+	if value, ok := apuo.mutation.AppendedContexts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesspolicy.FieldContexts, value)
+		})
+	}
+	if value, ok := apuo.mutation.Priority(); ok {
+		_spec.SetField(accesspolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := apuo.mutation.AddedPriority(); ok {
+		_spec.AddField(accesspolicy.FieldPriority, field.TypeInt32, value)
+	}
+	if value, ok := apuo.mutation.IsEnabled(); ok {
+		_spec.SetField(accesspolicy.FieldIsEnabled, field.TypeBool, value)
+	}
+	if value, ok := apuo.mutation.CreatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if apuo.mutation.CreatedByCleared() {
+		_spec.ClearField(accesspolicy.FieldCreatedBy, field.TypeInt64)
+	}
+	if value, ok := apuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apuo.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if apuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(accesspolicy.FieldUpdatedBy, field.TypeInt64)
+	}
+	if value, ok := apuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AccessPolicy{config: apuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, apuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesspolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	apuo.mutation.done = true
+	return _node, nil
+}
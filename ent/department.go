@@ -26,8 +26,20 @@ type Department struct {
 	ParentID int64 `json:"parent_id,omitempty"`
 	// Name of the department
 	Name string `json:"name,omitempty"`
+	// 部门编码，租户内未删除的部门中唯一
+	Code *string `json:"code,omitempty"`
+	// 部门描述
+	Description string `json:"description,omitempty"`
+	// 外部系统（如HR系统）中的部门ID，租户内未删除的部门中唯一，用于同步
+	ExternalID *string `json:"external_id,omitempty"`
 	// save ltree path
 	Path string `json:"path,omitempty"`
+	// 同级部门中的排序，从小到大
+	SortOrder int `json:"sort_order,omitempty"`
+	// 部门负责人用户ID，为空表示空缺
+	HeadID *int64 `json:"head_id,omitempty"`
+	// 部门副职用户ID
+	DeputyIds []int64 `json:"deputy_ids,omitempty"`
 	// Attributes holds the value of the "attributes" field.
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
 	// User who created this record
@@ -82,11 +94,11 @@ func (*Department) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case department.FieldAttributes:
+		case department.FieldDeputyIds, department.FieldAttributes:
 			values[i] = new([]byte)
-		case department.FieldID, department.FieldTenantID, department.FieldParentID, department.FieldCreatedBy, department.FieldUpdatedBy:
+		case department.FieldID, department.FieldTenantID, department.FieldParentID, department.FieldSortOrder, department.FieldHeadID, department.FieldCreatedBy, department.FieldUpdatedBy:
 			values[i] = new(sql.NullInt64)
-		case department.FieldName, department.FieldPath:
+		case department.FieldName, department.FieldCode, department.FieldDescription, department.FieldExternalID, department.FieldPath:
 			values[i] = new(sql.NullString)
 		case department.FieldCreatedAt, department.FieldUpdatedAt, department.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -129,12 +141,53 @@ func (d *Department) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.Name = value.String
 			}
+		case department.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				d.Code = new(string)
+				*d.Code = value.String
+			}
+		case department.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				d.Description = value.String
+			}
+		case department.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				d.ExternalID = new(string)
+				*d.ExternalID = value.String
+			}
 		case department.FieldPath:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field path", values[i])
 			} else if value.Valid {
 				d.Path = value.String
 			}
+		case department.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				d.SortOrder = int(value.Int64)
+			}
+		case department.FieldHeadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field head_id", values[i])
+			} else if value.Valid {
+				d.HeadID = new(int64)
+				*d.HeadID = value.Int64
+			}
+		case department.FieldDeputyIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field deputy_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.DeputyIds); err != nil {
+					return fmt.Errorf("unmarshal field deputy_ids: %w", err)
+				}
+			}
 		case department.FieldAttributes:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field attributes", values[i])
@@ -231,9 +284,33 @@ func (d *Department) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(d.Name)
 	builder.WriteString(", ")
+	if v := d.Code; v != nil {
+		builder.WriteString("code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(d.Description)
+	builder.WriteString(", ")
+	if v := d.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("path=")
 	builder.WriteString(d.Path)
 	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", d.SortOrder))
+	builder.WriteString(", ")
+	if v := d.HeadID; v != nil {
+		builder.WriteString("head_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deputy_ids=")
+	builder.WriteString(fmt.Sprintf("%v", d.DeputyIds))
+	builder.WriteString(", ")
 	builder.WriteString("attributes=")
 	builder.WriteString(fmt.Sprintf("%v", d.Attributes))
 	builder.WriteString(", ")
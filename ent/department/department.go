@@ -5,6 +5,7 @@ package department
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,8 +21,20 @@ const (
 	FieldParentID = "parent_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
 	// FieldPath holds the string denoting the path field in the database.
 	FieldPath = "path"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldHeadID holds the string denoting the head_id field in the database.
+	FieldHeadID = "head_id"
+	// FieldDeputyIds holds the string denoting the deputy_ids field in the database.
+	FieldDeputyIds = "deputy_ids"
 	// FieldAttributes holds the string denoting the attributes field in the database.
 	FieldAttributes = "attributes"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
@@ -62,7 +75,13 @@ var Columns = []string{
 	FieldTenantID,
 	FieldParentID,
 	FieldName,
+	FieldCode,
+	FieldDescription,
+	FieldExternalID,
 	FieldPath,
+	FieldSortOrder,
+	FieldHeadID,
+	FieldDeputyIds,
 	FieldAttributes,
 	FieldCreatedBy,
 	FieldUpdatedBy,
@@ -81,7 +100,21 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/yc-alpha/admin/ent/runtime"
 var (
+	Hooks [2]ent.Hook
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ExternalIDValidator func(string) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// DefaultDeputyIds holds the default value on creation for the "deputy_ids" field.
+	DefaultDeputyIds []int64
 	// DefaultAttributes holds the default value on creation for the "attributes" field.
 	DefaultAttributes map[string]interface{}
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -117,11 +150,36 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
+
 // ByPath orders the results by the path field.
 func ByPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPath, opts...).ToFunc()
 }
 
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// ByHeadID orders the results by the head_id field.
+func ByHeadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeadID, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
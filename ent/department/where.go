@@ -70,11 +70,36 @@ func Name(v string) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldName, v))
 }
 
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldDescription, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldExternalID, v))
+}
+
 // Path applies equality check predicate on the "path" field. It's identical to PathEQ.
 func Path(v string) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldPath, v))
 }
 
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// HeadID applies equality check predicate on the "head_id" field. It's identical to HeadIDEQ.
+func HeadID(v int64) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldHeadID, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v int64) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldCreatedBy, v))
@@ -225,6 +250,231 @@ func NameContainsFold(v string) predicate.Department {
 	return predicate.Department(sql.FieldContainsFold(FieldName, v))
 }
 
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Department {
+	return predicate.Department(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.Department {
+	return predicate.Department(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.Department {
+	return predicate.Department(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Department {
+	return predicate.Department(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Department {
+	return predicate.Department(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Department {
+	return predicate.Department(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Department {
+	return predicate.Department(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Department {
+	return predicate.Department(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.Department {
+	return predicate.Department(sql.FieldIsNull(FieldExternalID))
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.Department {
+	return predicate.Department(sql.FieldNotNull(FieldExternalID))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Department {
+	return predicate.Department(sql.FieldContainsFold(FieldExternalID, v))
+}
+
 // PathEQ applies the EQ predicate on the "path" field.
 func PathEQ(v string) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldPath, v))
@@ -290,6 +540,96 @@ func PathContainsFold(v string) predicate.Department {
 	return predicate.Department(sql.FieldContainsFold(FieldPath, v))
 }
 
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// HeadIDEQ applies the EQ predicate on the "head_id" field.
+func HeadIDEQ(v int64) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldHeadID, v))
+}
+
+// HeadIDNEQ applies the NEQ predicate on the "head_id" field.
+func HeadIDNEQ(v int64) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldHeadID, v))
+}
+
+// HeadIDIn applies the In predicate on the "head_id" field.
+func HeadIDIn(vs ...int64) predicate.Department {
+	return predicate.Department(sql.FieldIn(FieldHeadID, vs...))
+}
+
+// HeadIDNotIn applies the NotIn predicate on the "head_id" field.
+func HeadIDNotIn(vs ...int64) predicate.Department {
+	return predicate.Department(sql.FieldNotIn(FieldHeadID, vs...))
+}
+
+// HeadIDGT applies the GT predicate on the "head_id" field.
+func HeadIDGT(v int64) predicate.Department {
+	return predicate.Department(sql.FieldGT(FieldHeadID, v))
+}
+
+// HeadIDGTE applies the GTE predicate on the "head_id" field.
+func HeadIDGTE(v int64) predicate.Department {
+	return predicate.Department(sql.FieldGTE(FieldHeadID, v))
+}
+
+// HeadIDLT applies the LT predicate on the "head_id" field.
+func HeadIDLT(v int64) predicate.Department {
+	return predicate.Department(sql.FieldLT(FieldHeadID, v))
+}
+
+// HeadIDLTE applies the LTE predicate on the "head_id" field.
+func HeadIDLTE(v int64) predicate.Department {
+	return predicate.Department(sql.FieldLTE(FieldHeadID, v))
+}
+
+// HeadIDIsNil applies the IsNil predicate on the "head_id" field.
+func HeadIDIsNil() predicate.Department {
+	return predicate.Department(sql.FieldIsNull(FieldHeadID))
+}
+
+// HeadIDNotNil applies the NotNil predicate on the "head_id" field.
+func HeadIDNotNil() predicate.Department {
+	return predicate.Department(sql.FieldNotNull(FieldHeadID))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v int64) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldCreatedBy, v))
@@ -42,12 +42,88 @@ func (dc *DepartmentCreate) SetName(s string) *DepartmentCreate {
 	return dc
 }
 
+// SetCode sets the "code" field.
+func (dc *DepartmentCreate) SetCode(s string) *DepartmentCreate {
+	dc.mutation.SetCode(s)
+	return dc
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableCode(s *string) *DepartmentCreate {
+	if s != nil {
+		dc.SetCode(*s)
+	}
+	return dc
+}
+
+// SetDescription sets the "description" field.
+func (dc *DepartmentCreate) SetDescription(s string) *DepartmentCreate {
+	dc.mutation.SetDescription(s)
+	return dc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableDescription(s *string) *DepartmentCreate {
+	if s != nil {
+		dc.SetDescription(*s)
+	}
+	return dc
+}
+
+// SetExternalID sets the "external_id" field.
+func (dc *DepartmentCreate) SetExternalID(s string) *DepartmentCreate {
+	dc.mutation.SetExternalID(s)
+	return dc
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableExternalID(s *string) *DepartmentCreate {
+	if s != nil {
+		dc.SetExternalID(*s)
+	}
+	return dc
+}
+
 // SetPath sets the "path" field.
 func (dc *DepartmentCreate) SetPath(s string) *DepartmentCreate {
 	dc.mutation.SetPath(s)
 	return dc
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (dc *DepartmentCreate) SetSortOrder(i int) *DepartmentCreate {
+	dc.mutation.SetSortOrder(i)
+	return dc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableSortOrder(i *int) *DepartmentCreate {
+	if i != nil {
+		dc.SetSortOrder(*i)
+	}
+	return dc
+}
+
+// SetHeadID sets the "head_id" field.
+func (dc *DepartmentCreate) SetHeadID(i int64) *DepartmentCreate {
+	dc.mutation.SetHeadID(i)
+	return dc
+}
+
+// SetNillableHeadID sets the "head_id" field if the given value is not nil.
+func (dc *DepartmentCreate) SetNillableHeadID(i *int64) *DepartmentCreate {
+	if i != nil {
+		dc.SetHeadID(*i)
+	}
+	return dc
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (dc *DepartmentCreate) SetDeputyIds(i []int64) *DepartmentCreate {
+	dc.mutation.SetDeputyIds(i)
+	return dc
+}
+
 // SetAttributes sets the "attributes" field.
 func (dc *DepartmentCreate) SetAttributes(m map[string]interface{}) *DepartmentCreate {
 	dc.mutation.SetAttributes(m)
@@ -165,7 +241,9 @@ func (dc *DepartmentCreate) Mutation() *DepartmentMutation {
 
 // Save creates the Department in the database.
 func (dc *DepartmentCreate) Save(ctx context.Context) (*Department, error) {
-	dc.defaults()
+	if err := dc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
 }
 
@@ -192,23 +270,41 @@ func (dc *DepartmentCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (dc *DepartmentCreate) defaults() {
+func (dc *DepartmentCreate) defaults() error {
+	if _, ok := dc.mutation.SortOrder(); !ok {
+		v := department.DefaultSortOrder
+		dc.mutation.SetSortOrder(v)
+	}
+	if _, ok := dc.mutation.DeputyIds(); !ok {
+		v := department.DefaultDeputyIds
+		dc.mutation.SetDeputyIds(v)
+	}
 	if _, ok := dc.mutation.Attributes(); !ok {
 		v := department.DefaultAttributes
 		dc.mutation.SetAttributes(v)
 	}
 	if _, ok := dc.mutation.CreatedAt(); !ok {
+		if department.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized department.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := department.DefaultCreatedAt()
 		dc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		if department.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized department.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := department.DefaultUpdatedAt()
 		dc.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := dc.mutation.ID(); !ok {
+		if department.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized department.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := department.DefaultID()
 		dc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -222,9 +318,25 @@ func (dc *DepartmentCreate) check() error {
 	if _, ok := dc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Department.name"`)}
 	}
+	if v, ok := dc.mutation.Code(); ok {
+		if err := department.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Department.code": %w`, err)}
+		}
+	}
+	if v, ok := dc.mutation.ExternalID(); ok {
+		if err := department.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Department.external_id": %w`, err)}
+		}
+	}
 	if _, ok := dc.mutation.Path(); !ok {
 		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Department.path"`)}
 	}
+	if _, ok := dc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Department.sort_order"`)}
+	}
+	if _, ok := dc.mutation.DeputyIds(); !ok {
+		return &ValidationError{Name: "deputy_ids", err: errors.New(`ent: missing required field "Department.deputy_ids"`)}
+	}
 	if _, ok := dc.mutation.Attributes(); !ok {
 		return &ValidationError{Name: "attributes", err: errors.New(`ent: missing required field "Department.attributes"`)}
 	}
@@ -278,10 +390,34 @@ func (dc *DepartmentCreate) createSpec() (*Department, *sqlgraph.CreateSpec) {
 		_spec.SetField(department.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := dc.mutation.Code(); ok {
+		_spec.SetField(department.FieldCode, field.TypeString, value)
+		_node.Code = &value
+	}
+	if value, ok := dc.mutation.Description(); ok {
+		_spec.SetField(department.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := dc.mutation.ExternalID(); ok {
+		_spec.SetField(department.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
 	if value, ok := dc.mutation.Path(); ok {
 		_spec.SetField(department.FieldPath, field.TypeString, value)
 		_node.Path = value
 	}
+	if value, ok := dc.mutation.SortOrder(); ok {
+		_spec.SetField(department.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := dc.mutation.HeadID(); ok {
+		_spec.SetField(department.FieldHeadID, field.TypeInt64, value)
+		_node.HeadID = &value
+	}
+	if value, ok := dc.mutation.DeputyIds(); ok {
+		_spec.SetField(department.FieldDeputyIds, field.TypeJSON, value)
+		_node.DeputyIds = value
+	}
 	if value, ok := dc.mutation.Attributes(); ok {
 		_spec.SetField(department.FieldAttributes, field.TypeJSON, value)
 		_node.Attributes = value
@@ -433,6 +569,60 @@ func (u *DepartmentUpsert) UpdateName() *DepartmentUpsert {
 	return u
 }
 
+// SetCode sets the "code" field.
+func (u *DepartmentUpsert) SetCode(v string) *DepartmentUpsert {
+	u.Set(department.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DepartmentUpsert) UpdateCode() *DepartmentUpsert {
+	u.SetExcluded(department.FieldCode)
+	return u
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DepartmentUpsert) ClearCode() *DepartmentUpsert {
+	u.SetNull(department.FieldCode)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *DepartmentUpsert) SetDescription(v string) *DepartmentUpsert {
+	u.Set(department.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DepartmentUpsert) UpdateDescription() *DepartmentUpsert {
+	u.SetExcluded(department.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *DepartmentUpsert) ClearDescription() *DepartmentUpsert {
+	u.SetNull(department.FieldDescription)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *DepartmentUpsert) SetExternalID(v string) *DepartmentUpsert {
+	u.Set(department.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *DepartmentUpsert) UpdateExternalID() *DepartmentUpsert {
+	u.SetExcluded(department.FieldExternalID)
+	return u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *DepartmentUpsert) ClearExternalID() *DepartmentUpsert {
+	u.SetNull(department.FieldExternalID)
+	return u
+}
+
 // SetPath sets the "path" field.
 func (u *DepartmentUpsert) SetPath(v string) *DepartmentUpsert {
 	u.Set(department.FieldPath, v)
@@ -445,6 +635,60 @@ func (u *DepartmentUpsert) UpdatePath() *DepartmentUpsert {
 	return u
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (u *DepartmentUpsert) SetSortOrder(v int) *DepartmentUpsert {
+	u.Set(department.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *DepartmentUpsert) UpdateSortOrder() *DepartmentUpsert {
+	u.SetExcluded(department.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *DepartmentUpsert) AddSortOrder(v int) *DepartmentUpsert {
+	u.Add(department.FieldSortOrder, v)
+	return u
+}
+
+// SetHeadID sets the "head_id" field.
+func (u *DepartmentUpsert) SetHeadID(v int64) *DepartmentUpsert {
+	u.Set(department.FieldHeadID, v)
+	return u
+}
+
+// UpdateHeadID sets the "head_id" field to the value that was provided on create.
+func (u *DepartmentUpsert) UpdateHeadID() *DepartmentUpsert {
+	u.SetExcluded(department.FieldHeadID)
+	return u
+}
+
+// AddHeadID adds v to the "head_id" field.
+func (u *DepartmentUpsert) AddHeadID(v int64) *DepartmentUpsert {
+	u.Add(department.FieldHeadID, v)
+	return u
+}
+
+// ClearHeadID clears the value of the "head_id" field.
+func (u *DepartmentUpsert) ClearHeadID() *DepartmentUpsert {
+	u.SetNull(department.FieldHeadID)
+	return u
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (u *DepartmentUpsert) SetDeputyIds(v []int64) *DepartmentUpsert {
+	u.Set(department.FieldDeputyIds, v)
+	return u
+}
+
+// UpdateDeputyIds sets the "deputy_ids" field to the value that was provided on create.
+func (u *DepartmentUpsert) UpdateDeputyIds() *DepartmentUpsert {
+	u.SetExcluded(department.FieldDeputyIds)
+	return u
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *DepartmentUpsert) SetAttributes(v map[string]interface{}) *DepartmentUpsert {
 	u.Set(department.FieldAttributes, v)
@@ -635,6 +879,69 @@ func (u *DepartmentUpsertOne) UpdateName() *DepartmentUpsertOne {
 	})
 }
 
+// SetCode sets the "code" field.
+func (u *DepartmentUpsertOne) SetCode(v string) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DepartmentUpsertOne) UpdateCode() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DepartmentUpsertOne) ClearCode() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *DepartmentUpsertOne) SetDescription(v string) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DepartmentUpsertOne) UpdateDescription() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *DepartmentUpsertOne) ClearDescription() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *DepartmentUpsertOne) SetExternalID(v string) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *DepartmentUpsertOne) UpdateExternalID() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *DepartmentUpsertOne) ClearExternalID() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearExternalID()
+	})
+}
+
 // SetPath sets the "path" field.
 func (u *DepartmentUpsertOne) SetPath(v string) *DepartmentUpsertOne {
 	return u.Update(func(s *DepartmentUpsert) {
@@ -649,6 +956,69 @@ func (u *DepartmentUpsertOne) UpdatePath() *DepartmentUpsertOne {
 	})
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (u *DepartmentUpsertOne) SetSortOrder(v int) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *DepartmentUpsertOne) AddSortOrder(v int) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *DepartmentUpsertOne) UpdateSortOrder() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetHeadID sets the "head_id" field.
+func (u *DepartmentUpsertOne) SetHeadID(v int64) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetHeadID(v)
+	})
+}
+
+// AddHeadID adds v to the "head_id" field.
+func (u *DepartmentUpsertOne) AddHeadID(v int64) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.AddHeadID(v)
+	})
+}
+
+// UpdateHeadID sets the "head_id" field to the value that was provided on create.
+func (u *DepartmentUpsertOne) UpdateHeadID() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateHeadID()
+	})
+}
+
+// ClearHeadID clears the value of the "head_id" field.
+func (u *DepartmentUpsertOne) ClearHeadID() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearHeadID()
+	})
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (u *DepartmentUpsertOne) SetDeputyIds(v []int64) *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetDeputyIds(v)
+	})
+}
+
+// UpdateDeputyIds sets the "deputy_ids" field to the value that was provided on create.
+func (u *DepartmentUpsertOne) UpdateDeputyIds() *DepartmentUpsertOne {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateDeputyIds()
+	})
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *DepartmentUpsertOne) SetAttributes(v map[string]interface{}) *DepartmentUpsertOne {
 	return u.Update(func(s *DepartmentUpsert) {
@@ -1020,6 +1390,69 @@ func (u *DepartmentUpsertBulk) UpdateName() *DepartmentUpsertBulk {
 	})
 }
 
+// SetCode sets the "code" field.
+func (u *DepartmentUpsertBulk) SetCode(v string) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *DepartmentUpsertBulk) UpdateCode() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *DepartmentUpsertBulk) ClearCode() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *DepartmentUpsertBulk) SetDescription(v string) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DepartmentUpsertBulk) UpdateDescription() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *DepartmentUpsertBulk) ClearDescription() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *DepartmentUpsertBulk) SetExternalID(v string) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *DepartmentUpsertBulk) UpdateExternalID() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *DepartmentUpsertBulk) ClearExternalID() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearExternalID()
+	})
+}
+
 // SetPath sets the "path" field.
 func (u *DepartmentUpsertBulk) SetPath(v string) *DepartmentUpsertBulk {
 	return u.Update(func(s *DepartmentUpsert) {
@@ -1034,6 +1467,69 @@ func (u *DepartmentUpsertBulk) UpdatePath() *DepartmentUpsertBulk {
 	})
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (u *DepartmentUpsertBulk) SetSortOrder(v int) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *DepartmentUpsertBulk) AddSortOrder(v int) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *DepartmentUpsertBulk) UpdateSortOrder() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetHeadID sets the "head_id" field.
+func (u *DepartmentUpsertBulk) SetHeadID(v int64) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetHeadID(v)
+	})
+}
+
+// AddHeadID adds v to the "head_id" field.
+func (u *DepartmentUpsertBulk) AddHeadID(v int64) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.AddHeadID(v)
+	})
+}
+
+// UpdateHeadID sets the "head_id" field to the value that was provided on create.
+func (u *DepartmentUpsertBulk) UpdateHeadID() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateHeadID()
+	})
+}
+
+// ClearHeadID clears the value of the "head_id" field.
+func (u *DepartmentUpsertBulk) ClearHeadID() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.ClearHeadID()
+	})
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (u *DepartmentUpsertBulk) SetDeputyIds(v []int64) *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.SetDeputyIds(v)
+	})
+}
+
+// UpdateDeputyIds sets the "deputy_ids" field to the value that was provided on create.
+func (u *DepartmentUpsertBulk) UpdateDeputyIds() *DepartmentUpsertBulk {
+	return u.Update(func(s *DepartmentUpsert) {
+		s.UpdateDeputyIds()
+	})
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *DepartmentUpsertBulk) SetAttributes(v map[string]interface{}) *DepartmentUpsertBulk {
 	return u.Update(func(s *DepartmentUpsert) {
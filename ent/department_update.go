@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/yc-alpha/admin/ent/department"
 	"github.com/yc-alpha/admin/ent/predicate"
@@ -79,6 +80,66 @@ func (du *DepartmentUpdate) SetNillableName(s *string) *DepartmentUpdate {
 	return du
 }
 
+// SetCode sets the "code" field.
+func (du *DepartmentUpdate) SetCode(s string) *DepartmentUpdate {
+	du.mutation.SetCode(s)
+	return du
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (du *DepartmentUpdate) SetNillableCode(s *string) *DepartmentUpdate {
+	if s != nil {
+		du.SetCode(*s)
+	}
+	return du
+}
+
+// ClearCode clears the value of the "code" field.
+func (du *DepartmentUpdate) ClearCode() *DepartmentUpdate {
+	du.mutation.ClearCode()
+	return du
+}
+
+// SetDescription sets the "description" field.
+func (du *DepartmentUpdate) SetDescription(s string) *DepartmentUpdate {
+	du.mutation.SetDescription(s)
+	return du
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (du *DepartmentUpdate) SetNillableDescription(s *string) *DepartmentUpdate {
+	if s != nil {
+		du.SetDescription(*s)
+	}
+	return du
+}
+
+// ClearDescription clears the value of the "description" field.
+func (du *DepartmentUpdate) ClearDescription() *DepartmentUpdate {
+	du.mutation.ClearDescription()
+	return du
+}
+
+// SetExternalID sets the "external_id" field.
+func (du *DepartmentUpdate) SetExternalID(s string) *DepartmentUpdate {
+	du.mutation.SetExternalID(s)
+	return du
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (du *DepartmentUpdate) SetNillableExternalID(s *string) *DepartmentUpdate {
+	if s != nil {
+		du.SetExternalID(*s)
+	}
+	return du
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (du *DepartmentUpdate) ClearExternalID() *DepartmentUpdate {
+	du.mutation.ClearExternalID()
+	return du
+}
+
 // SetPath sets the "path" field.
 func (du *DepartmentUpdate) SetPath(s string) *DepartmentUpdate {
 	du.mutation.SetPath(s)
@@ -93,6 +154,66 @@ func (du *DepartmentUpdate) SetNillablePath(s *string) *DepartmentUpdate {
 	return du
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (du *DepartmentUpdate) SetSortOrder(i int) *DepartmentUpdate {
+	du.mutation.ResetSortOrder()
+	du.mutation.SetSortOrder(i)
+	return du
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (du *DepartmentUpdate) SetNillableSortOrder(i *int) *DepartmentUpdate {
+	if i != nil {
+		du.SetSortOrder(*i)
+	}
+	return du
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (du *DepartmentUpdate) AddSortOrder(i int) *DepartmentUpdate {
+	du.mutation.AddSortOrder(i)
+	return du
+}
+
+// SetHeadID sets the "head_id" field.
+func (du *DepartmentUpdate) SetHeadID(i int64) *DepartmentUpdate {
+	du.mutation.ResetHeadID()
+	du.mutation.SetHeadID(i)
+	return du
+}
+
+// SetNillableHeadID sets the "head_id" field if the given value is not nil.
+func (du *DepartmentUpdate) SetNillableHeadID(i *int64) *DepartmentUpdate {
+	if i != nil {
+		du.SetHeadID(*i)
+	}
+	return du
+}
+
+// AddHeadID adds i to the "head_id" field.
+func (du *DepartmentUpdate) AddHeadID(i int64) *DepartmentUpdate {
+	du.mutation.AddHeadID(i)
+	return du
+}
+
+// ClearHeadID clears the value of the "head_id" field.
+func (du *DepartmentUpdate) ClearHeadID() *DepartmentUpdate {
+	du.mutation.ClearHeadID()
+	return du
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (du *DepartmentUpdate) SetDeputyIds(i []int64) *DepartmentUpdate {
+	du.mutation.SetDeputyIds(i)
+	return du
+}
+
+// AppendDeputyIds appends i to the "deputy_ids" field.
+func (du *DepartmentUpdate) AppendDeputyIds(i []int64) *DepartmentUpdate {
+	du.mutation.AppendDeputyIds(i)
+	return du
+}
+
 // SetAttributes sets the "attributes" field.
 func (du *DepartmentUpdate) SetAttributes(m map[string]interface{}) *DepartmentUpdate {
 	du.mutation.SetAttributes(m)
@@ -233,7 +354,9 @@ func (du *DepartmentUpdate) RemoveUserDepartments(u ...*UserDepartment) *Departm
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (du *DepartmentUpdate) Save(ctx context.Context) (int, error) {
-	du.defaults()
+	if err := du.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
 }
 
@@ -260,15 +383,29 @@ func (du *DepartmentUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (du *DepartmentUpdate) defaults() {
+func (du *DepartmentUpdate) defaults() error {
 	if _, ok := du.mutation.UpdatedAt(); !ok {
+		if department.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized department.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := department.UpdateDefaultUpdatedAt()
 		du.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (du *DepartmentUpdate) check() error {
+	if v, ok := du.mutation.Code(); ok {
+		if err := department.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Department.code": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.ExternalID(); ok {
+		if err := department.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Department.external_id": %w`, err)}
+		}
+	}
 	if du.mutation.TenantCleared() && len(du.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Department.tenant"`)
 	}
@@ -296,9 +433,50 @@ func (du *DepartmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.Name(); ok {
 		_spec.SetField(department.FieldName, field.TypeString, value)
 	}
+	if value, ok := du.mutation.Code(); ok {
+		_spec.SetField(department.FieldCode, field.TypeString, value)
+	}
+	if du.mutation.CodeCleared() {
+		_spec.ClearField(department.FieldCode, field.TypeString)
+	}
+	if value, ok := du.mutation.Description(); ok {
+		_spec.SetField(department.FieldDescription, field.TypeString, value)
+	}
+	if du.mutation.DescriptionCleared() {
+		_spec.ClearField(department.FieldDescription, field.TypeString)
+	}
+	if value, ok := du.mutation.ExternalID(); ok {
+		_spec.SetField(department.FieldExternalID, field.TypeString, value)
+	}
+	if du.mutation.ExternalIDCleared() {
+		_spec.ClearField(department.FieldExternalID, field.TypeString)
+	}
 	if value, ok := du.mutation.Path(); ok {
 		_spec.SetField(department.FieldPath, field.TypeString, value)
 	}
+	if value, ok := du.mutation.SortOrder(); ok {
+		_spec.SetField(department.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedSortOrder(); ok {
+		_spec.AddField(department.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.HeadID(); ok {
+		_spec.SetField(department.FieldHeadID, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.AddedHeadID(); ok {
+		_spec.AddField(department.FieldHeadID, field.TypeInt64, value)
+	}
+	if du.mutation.HeadIDCleared() {
+		_spec.ClearField(department.FieldHeadID, field.TypeInt64)
+	}
+	if value, ok := du.mutation.DeputyIds(); ok {
+		_spec.SetField(department.FieldDeputyIds, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedDeputyIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, department.FieldDeputyIds, value)
+		})
+	}
 	if value, ok := du.mutation.Attributes(); ok {
 		_spec.SetField(department.FieldAttributes, field.TypeJSON, value)
 	}
@@ -472,6 +650,66 @@ func (duo *DepartmentUpdateOne) SetNillableName(s *string) *DepartmentUpdateOne
 	return duo
 }
 
+// SetCode sets the "code" field.
+func (duo *DepartmentUpdateOne) SetCode(s string) *DepartmentUpdateOne {
+	duo.mutation.SetCode(s)
+	return duo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (duo *DepartmentUpdateOne) SetNillableCode(s *string) *DepartmentUpdateOne {
+	if s != nil {
+		duo.SetCode(*s)
+	}
+	return duo
+}
+
+// ClearCode clears the value of the "code" field.
+func (duo *DepartmentUpdateOne) ClearCode() *DepartmentUpdateOne {
+	duo.mutation.ClearCode()
+	return duo
+}
+
+// SetDescription sets the "description" field.
+func (duo *DepartmentUpdateOne) SetDescription(s string) *DepartmentUpdateOne {
+	duo.mutation.SetDescription(s)
+	return duo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (duo *DepartmentUpdateOne) SetNillableDescription(s *string) *DepartmentUpdateOne {
+	if s != nil {
+		duo.SetDescription(*s)
+	}
+	return duo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (duo *DepartmentUpdateOne) ClearDescription() *DepartmentUpdateOne {
+	duo.mutation.ClearDescription()
+	return duo
+}
+
+// SetExternalID sets the "external_id" field.
+func (duo *DepartmentUpdateOne) SetExternalID(s string) *DepartmentUpdateOne {
+	duo.mutation.SetExternalID(s)
+	return duo
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (duo *DepartmentUpdateOne) SetNillableExternalID(s *string) *DepartmentUpdateOne {
+	if s != nil {
+		duo.SetExternalID(*s)
+	}
+	return duo
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (duo *DepartmentUpdateOne) ClearExternalID() *DepartmentUpdateOne {
+	duo.mutation.ClearExternalID()
+	return duo
+}
+
 // SetPath sets the "path" field.
 func (duo *DepartmentUpdateOne) SetPath(s string) *DepartmentUpdateOne {
 	duo.mutation.SetPath(s)
@@ -486,6 +724,66 @@ func (duo *DepartmentUpdateOne) SetNillablePath(s *string) *DepartmentUpdateOne
 	return duo
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (duo *DepartmentUpdateOne) SetSortOrder(i int) *DepartmentUpdateOne {
+	duo.mutation.ResetSortOrder()
+	duo.mutation.SetSortOrder(i)
+	return duo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (duo *DepartmentUpdateOne) SetNillableSortOrder(i *int) *DepartmentUpdateOne {
+	if i != nil {
+		duo.SetSortOrder(*i)
+	}
+	return duo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (duo *DepartmentUpdateOne) AddSortOrder(i int) *DepartmentUpdateOne {
+	duo.mutation.AddSortOrder(i)
+	return duo
+}
+
+// SetHeadID sets the "head_id" field.
+func (duo *DepartmentUpdateOne) SetHeadID(i int64) *DepartmentUpdateOne {
+	duo.mutation.ResetHeadID()
+	duo.mutation.SetHeadID(i)
+	return duo
+}
+
+// SetNillableHeadID sets the "head_id" field if the given value is not nil.
+func (duo *DepartmentUpdateOne) SetNillableHeadID(i *int64) *DepartmentUpdateOne {
+	if i != nil {
+		duo.SetHeadID(*i)
+	}
+	return duo
+}
+
+// AddHeadID adds i to the "head_id" field.
+func (duo *DepartmentUpdateOne) AddHeadID(i int64) *DepartmentUpdateOne {
+	duo.mutation.AddHeadID(i)
+	return duo
+}
+
+// ClearHeadID clears the value of the "head_id" field.
+func (duo *DepartmentUpdateOne) ClearHeadID() *DepartmentUpdateOne {
+	duo.mutation.ClearHeadID()
+	return duo
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (duo *DepartmentUpdateOne) SetDeputyIds(i []int64) *DepartmentUpdateOne {
+	duo.mutation.SetDeputyIds(i)
+	return duo
+}
+
+// AppendDeputyIds appends i to the "deputy_ids" field.
+func (duo *DepartmentUpdateOne) AppendDeputyIds(i []int64) *DepartmentUpdateOne {
+	duo.mutation.AppendDeputyIds(i)
+	return duo
+}
+
 // SetAttributes sets the "attributes" field.
 func (duo *DepartmentUpdateOne) SetAttributes(m map[string]interface{}) *DepartmentUpdateOne {
 	duo.mutation.SetAttributes(m)
@@ -639,7 +937,9 @@ func (duo *DepartmentUpdateOne) Select(field string, fields ...string) *Departme
 
 // Save executes the query and returns the updated Department entity.
 func (duo *DepartmentUpdateOne) Save(ctx context.Context) (*Department, error) {
-	duo.defaults()
+	if err := duo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
 }
 
@@ -666,15 +966,29 @@ func (duo *DepartmentUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (duo *DepartmentUpdateOne) defaults() {
+func (duo *DepartmentUpdateOne) defaults() error {
 	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		if department.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized department.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := department.UpdateDefaultUpdatedAt()
 		duo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (duo *DepartmentUpdateOne) check() error {
+	if v, ok := duo.mutation.Code(); ok {
+		if err := department.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Department.code": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.ExternalID(); ok {
+		if err := department.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Department.external_id": %w`, err)}
+		}
+	}
 	if duo.mutation.TenantCleared() && len(duo.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Department.tenant"`)
 	}
@@ -719,9 +1033,50 @@ func (duo *DepartmentUpdateOne) sqlSave(ctx context.Context) (_node *Department,
 	if value, ok := duo.mutation.Name(); ok {
 		_spec.SetField(department.FieldName, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.Code(); ok {
+		_spec.SetField(department.FieldCode, field.TypeString, value)
+	}
+	if duo.mutation.CodeCleared() {
+		_spec.ClearField(department.FieldCode, field.TypeString)
+	}
+	if value, ok := duo.mutation.Description(); ok {
+		_spec.SetField(department.FieldDescription, field.TypeString, value)
+	}
+	if duo.mutation.DescriptionCleared() {
+		_spec.ClearField(department.FieldDescription, field.TypeString)
+	}
+	if value, ok := duo.mutation.ExternalID(); ok {
+		_spec.SetField(department.FieldExternalID, field.TypeString, value)
+	}
+	if duo.mutation.ExternalIDCleared() {
+		_spec.ClearField(department.FieldExternalID, field.TypeString)
+	}
 	if value, ok := duo.mutation.Path(); ok {
 		_spec.SetField(department.FieldPath, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.SortOrder(); ok {
+		_spec.SetField(department.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(department.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.HeadID(); ok {
+		_spec.SetField(department.FieldHeadID, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.AddedHeadID(); ok {
+		_spec.AddField(department.FieldHeadID, field.TypeInt64, value)
+	}
+	if duo.mutation.HeadIDCleared() {
+		_spec.ClearField(department.FieldHeadID, field.TypeInt64)
+	}
+	if value, ok := duo.mutation.DeputyIds(); ok {
+		_spec.SetField(department.FieldDeputyIds, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedDeputyIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, department.FieldDeputyIds, value)
+		})
+	}
 	if value, ok := duo.mutation.Attributes(); ok {
 		_spec.SetField(department.FieldAttributes, field.TypeJSON, value)
 	}
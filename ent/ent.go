@@ -12,10 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
 	"github.com/yc-alpha/admin/ent/casbinrule"
 	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
 	"github.com/yc-alpha/admin/ent/user"
 	"github.com/yc-alpha/admin/ent/useraccount"
 	"github.com/yc-alpha/admin/ent/userdepartment"
@@ -81,15 +87,21 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			casbinrule.Table:     casbinrule.ValidColumn,
-			department.Table:     department.ValidColumn,
-			role.Table:           role.ValidColumn,
-			tenant.Table:         tenant.ValidColumn,
-			user.Table:           user.ValidColumn,
-			useraccount.Table:    useraccount.ValidColumn,
-			userdepartment.Table: userdepartment.ValidColumn,
-			userrole.Table:       userrole.ValidColumn,
-			usertenant.Table:     usertenant.ValidColumn,
+			accesspolicy.Table:     accesspolicy.ValidColumn,
+			casbinrule.Table:       casbinrule.ValidColumn,
+			department.Table:       department.ValidColumn,
+			plan.Table:             plan.ValidColumn,
+			role.Table:             role.ValidColumn,
+			tenant.Table:           tenant.ValidColumn,
+			tenantinvitation.Table: tenantinvitation.ValidColumn,
+			tenantsetting.Table:    tenantsetting.ValidColumn,
+			tenantsettinglog.Table: tenantsettinglog.ValidColumn,
+			tenantstatuslog.Table:  tenantstatuslog.ValidColumn,
+			user.Table:             user.ValidColumn,
+			useraccount.Table:      useraccount.ValidColumn,
+			userdepartment.Table:   userdepartment.ValidColumn,
+			userrole.Table:         userrole.ValidColumn,
+			usertenant.Table:       usertenant.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/upsert,sql/lock
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/upsert,sql/lock,sql/execquery,intercept
@@ -0,0 +1,50 @@
+-- Create "access_policies" table
+CREATE TABLE "public"."access_policies" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "effect" character varying NOT NULL,
+  "subjects" jsonb NOT NULL,
+  "resources" jsonb NOT NULL,
+  "actions" jsonb NOT NULL,
+  "contexts" jsonb NOT NULL,
+  "priority" integer NOT NULL DEFAULT 0,
+  "is_enabled" boolean NOT NULL DEFAULT true,
+  "created_by" bigint NULL,
+  "updated_by" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "accesspolicy_name" to table: "access_policies"
+CREATE INDEX "accesspolicy_name" ON "public"."access_policies" ("name");
+-- Create index "accesspolicy_priority" to table: "access_policies"
+CREATE INDEX "accesspolicy_priority" ON "public"."access_policies" ("priority") WHERE is_enabled;
+-- Set comment to column: "id" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."id" IS 'Primary Key ID';
+-- Set comment to column: "name" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."name" IS '策略名称';
+-- Set comment to column: "description" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."description" IS '策略描述';
+-- Set comment to column: "effect" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."effect" IS '策略效果';
+-- Set comment to column: "subjects" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."subjects" IS '主体属性条件';
+-- Set comment to column: "resources" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."resources" IS '资源属性条件';
+-- Set comment to column: "actions" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."actions" IS '操作属性条件';
+-- Set comment to column: "contexts" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."contexts" IS '环境属性条件';
+-- Set comment to column: "priority" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."priority" IS '优先级，数值越大越先匹配';
+-- Set comment to column: "is_enabled" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."is_enabled" IS '是否启用';
+-- Set comment to column: "created_by" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."created_by" IS 'User who created this record';
+-- Set comment to column: "updated_by" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."updated_by" IS 'User who last updated this record';
+-- Set comment to column: "created_at" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."created_at" IS 'Creation timestamp of this record';
+-- Set comment to column: "updated_at" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."updated_at" IS 'Last update timestamp of this record';
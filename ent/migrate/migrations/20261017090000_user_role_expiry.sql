@@ -0,0 +1,8 @@
+-- Modify "user_roles" table
+ALTER TABLE "public"."user_roles" ADD COLUMN "granted_by" bigint NULL, ADD COLUMN "reason" character varying NULL;
+-- Create index "userrole_expires_at" to table: "user_roles"
+CREATE INDEX "userrole_expires_at" ON "public"."user_roles" ("expires_at") WHERE (expires_at IS NOT NULL);
+-- Set comment to column: "granted_by" on table: "user_roles"
+COMMENT ON COLUMN "public"."user_roles"."granted_by" IS '授予人ID';
+-- Set comment to column: "reason" on table: "user_roles"
+COMMENT ON COLUMN "public"."user_roles"."reason" IS '授予原因，临时提权时必填';
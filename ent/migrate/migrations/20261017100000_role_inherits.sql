@@ -0,0 +1,8 @@
+-- Create "role_inherits" table
+CREATE TABLE "public"."role_inherits" (
+  "role_id" bigint NOT NULL,
+  "inherited_by_id" bigint NOT NULL,
+  PRIMARY KEY ("role_id", "inherited_by_id"),
+  CONSTRAINT "role_inherits_inherited_by_id" FOREIGN KEY ("inherited_by_id") REFERENCES "public"."roles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "role_inherits_role_id" FOREIGN KEY ("role_id") REFERENCES "public"."roles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
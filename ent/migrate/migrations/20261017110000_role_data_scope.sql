@@ -0,0 +1,4 @@
+-- Modify "roles" table
+ALTER TABLE "public"."roles" ADD COLUMN "data_scope" character varying NOT NULL DEFAULT 'TENANT';
+-- Set comment to column: "data_scope" on table: "roles"
+COMMENT ON COLUMN "public"."roles"."data_scope" IS '数据范围：ALL(全部), TENANT_SUBTREE(当前租户及下级租户), TENANT(当前租户), DEPT_SUBTREE(所在部门及下级部门), SELF(本人创建)';
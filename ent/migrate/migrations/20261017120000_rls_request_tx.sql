@@ -0,0 +1,89 @@
+-- 行级安全参数由RLSMiddleware在每个请求事务内通过set_config(..., true)设置，事务结束即失效。
+-- 注意：应用连接的数据库账号不能是超级用户或带BYPASSRLS属性，否则以下策略不生效。
+
+-- app_current_user() 当前请求的用户ID，未设置时返回NULL
+CREATE OR REPLACE FUNCTION app_current_user() RETURNS BIGINT AS $$
+BEGIN
+	RETURN current_setting('app.current_user')::BIGINT;
+EXCEPTION WHEN others THEN
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- app_rls_bypass() 是否跳过行级安全：系统操作经rls.WithBypass显式开启，平台级用户未指定租户的请求开启；未设置时不跳过
+CREATE OR REPLACE FUNCTION app_rls_bypass() RETURNS BOOLEAN AS $$
+BEGIN
+	RETURN COALESCE(current_setting('app.bypass_rls', true)::BOOLEAN, false);
+EXCEPTION WHEN others THEN
+	RETURN false;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- tenants、users：仅补充跳过策略，表所有者不受限制的行为保持不变
+CREATE POLICY tenants_bypass ON tenants
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY users_bypass ON users
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+
+-- departments
+CREATE POLICY dept_bypass ON departments
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+ALTER TABLE departments FORCE ROW LEVEL SECURITY;
+
+-- user_tenants
+CREATE POLICY user_tenants_bypass ON user_tenants
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+ALTER TABLE user_tenants FORCE ROW LEVEL SECURITY;
+
+-- user_departments
+CREATE POLICY user_departments_bypass ON user_departments
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+ALTER TABLE user_departments FORCE ROW LEVEL SECURITY;
+
+-- roles：平台级角色（tenant_id IS NULL）所有租户可见，但只能在跳过行级安全时修改
+ALTER TABLE roles ENABLE ROW LEVEL SECURITY;
+CREATE POLICY roles_bypass ON roles
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY roles_select ON roles
+	FOR SELECT
+	USING (tenant_id IS NULL OR tenant_id = app_current_tenant());
+CREATE POLICY roles_insert ON roles
+	FOR INSERT
+	WITH CHECK (tenant_id = app_current_tenant());
+CREATE POLICY roles_update ON roles
+	FOR UPDATE
+	USING (tenant_id = app_current_tenant())
+	WITH CHECK (tenant_id = app_current_tenant());
+CREATE POLICY roles_delete ON roles
+	FOR DELETE
+	USING (tenant_id = app_current_tenant());
+ALTER TABLE roles FORCE ROW LEVEL SECURITY;
+
+-- user_roles：当前租户的授予，以及当前用户自己的平台级授予
+ALTER TABLE user_roles ENABLE ROW LEVEL SECURITY;
+CREATE POLICY user_roles_bypass ON user_roles
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY user_roles_select ON user_roles
+	FOR SELECT
+	USING (
+		tenant_id = app_current_tenant()
+		OR (tenant_id IS NULL AND user_id = app_current_user())
+	);
+CREATE POLICY user_roles_insert ON user_roles
+	FOR INSERT
+	WITH CHECK (tenant_id = app_current_tenant());
+CREATE POLICY user_roles_update ON user_roles
+	FOR UPDATE
+	USING (tenant_id = app_current_tenant())
+	WITH CHECK (tenant_id = app_current_tenant());
+CREATE POLICY user_roles_delete ON user_roles
+	FOR DELETE
+	USING (tenant_id = app_current_tenant());
+ALTER TABLE user_roles FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,38 @@
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ADD COLUMN "expiry_notified_at" timestamptz NULL;
+-- Set comment to column: "expiry_notified_at" on table: "tenants"
+COMMENT ON COLUMN "public"."tenants"."expiry_notified_at" IS '到期提醒发送时间，续期后清空';
+-- Create "tenant_status_logs" table
+CREATE TABLE "public"."tenant_status_logs" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "action" character varying NOT NULL,
+  "from_status" character varying NOT NULL,
+  "to_status" character varying NOT NULL,
+  "expired_at" timestamptz NULL,
+  "reason" character varying NULL,
+  "operator_id" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "tenant_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tenant_status_logs_tenants_status_logs" FOREIGN KEY ("tenant_id") REFERENCES "public"."tenants" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "tenantstatuslog_tenant_id_created_at" to table: "tenant_status_logs"
+CREATE INDEX "tenantstatuslog_tenant_id_created_at" ON "public"."tenant_status_logs" ("tenant_id", "created_at");
+-- Set comment to column: "id" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."id" IS 'Primary Key ID';
+-- Set comment to column: "action" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."action" IS '状态变更操作';
+-- Set comment to column: "from_status" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."from_status" IS '变更前状态';
+-- Set comment to column: "to_status" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."to_status" IS '变更后状态';
+-- Set comment to column: "expired_at" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."expired_at" IS '变更后的到期时间';
+-- Set comment to column: "reason" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."reason" IS '变更原因';
+-- Set comment to column: "operator_id" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."operator_id" IS '操作人ID，为空表示系统自动变更';
+-- Set comment to column: "created_at" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."created_at" IS 'Creation timestamp of this record';
+-- Set comment to column: "tenant_id" on table: "tenant_status_logs"
+COMMENT ON COLUMN "public"."tenant_status_logs"."tenant_id" IS '租户ID';
@@ -0,0 +1,10 @@
+-- Modify "user_tenants" table
+ALTER TABLE "public"."user_tenants" ADD COLUMN "created_by" bigint NULL, ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT now();
+-- Existing memberships are backfilled with the migration time, the default is owned by the application afterwards
+ALTER TABLE "public"."user_tenants" ALTER COLUMN "created_at" DROP DEFAULT;
+-- Set comment to column: "role_labels" on table: "user_tenants"
+COMMENT ON COLUMN "public"."user_tenants"."role_labels" IS '成员在租户内的身份标签，仅用于展示和筛选，权限以角色授予为准';
+-- Set comment to column: "created_by" on table: "user_tenants"
+COMMENT ON COLUMN "public"."user_tenants"."created_by" IS 'User who added this member';
+-- Set comment to column: "created_at" on table: "user_tenants"
+COMMENT ON COLUMN "public"."user_tenants"."created_at" IS 'Time when the user joined the tenant';
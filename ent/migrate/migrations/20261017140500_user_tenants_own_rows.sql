@@ -0,0 +1,4 @@
+-- 用户可以看到自己在所有租户下的成员关系，用于租户切换（GET /v1/users/me/tenants）
+CREATE POLICY user_tenants_select_own ON user_tenants
+	FOR SELECT
+	USING (user_id = app_current_user());
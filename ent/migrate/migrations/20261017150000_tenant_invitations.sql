@@ -0,0 +1,69 @@
+-- Create "tenant_invitations" table
+CREATE TABLE "public"."tenant_invitations" (
+  "id" bigint NOT NULL,
+  "email" character varying NULL,
+  "phone" character varying NULL,
+  "department_id" bigint NULL,
+  "role_ids" jsonb NOT NULL,
+  "role_labels" jsonb NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'PENDING',
+  "nonce" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "send_count" bigint NOT NULL DEFAULT 0,
+  "invited_by" bigint NULL,
+  "accepted_by" bigint NULL,
+  "accepted_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tenant_invitations_tenants_invitations" FOREIGN KEY ("tenant_id") REFERENCES "public"."tenants" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tenant_invitations_contact_check" CHECK ((email IS NOT NULL) OR (phone IS NOT NULL))
+);
+-- Create index "tenantinvitation_tenant_id_status" to table: "tenant_invitations"
+CREATE INDEX "tenantinvitation_tenant_id_status" ON "public"."tenant_invitations" ("tenant_id", "status");
+-- Create index "tenantinvitation_tenant_id_email" to table: "tenant_invitations"
+CREATE UNIQUE INDEX "tenantinvitation_tenant_id_email" ON "public"."tenant_invitations" ("tenant_id", "email") WHERE ((status)::text = 'PENDING'::text) AND (email IS NOT NULL);
+-- Create index "tenantinvitation_tenant_id_phone" to table: "tenant_invitations"
+CREATE UNIQUE INDEX "tenantinvitation_tenant_id_phone" ON "public"."tenant_invitations" ("tenant_id", "phone") WHERE ((status)::text = 'PENDING'::text) AND (phone IS NOT NULL);
+-- Set comment to column: "id" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."id" IS 'Primary Key ID';
+-- Set comment to column: "email" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."email" IS '被邀请人邮箱';
+-- Set comment to column: "phone" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."phone" IS '被邀请人手机号';
+-- Set comment to column: "department_id" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."department_id" IS '加入的部门ID';
+-- Set comment to column: "role_ids" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."role_ids" IS '初始角色ID';
+-- Set comment to column: "role_labels" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."role_labels" IS '成员身份标签';
+-- Set comment to column: "status" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."status" IS '邀请状态';
+-- Set comment to column: "nonce" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."nonce" IS '令牌版本，重新发送时更换使旧令牌失效';
+-- Set comment to column: "expires_at" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."expires_at" IS '过期时间';
+-- Set comment to column: "send_count" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."send_count" IS '发送次数';
+-- Set comment to column: "invited_by" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."invited_by" IS '邀请人ID';
+-- Set comment to column: "accepted_by" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."accepted_by" IS '接受邀请的用户ID';
+-- Set comment to column: "accepted_at" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."accepted_at" IS '接受时间';
+-- Set comment to column: "created_at" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."created_at" IS 'Creation timestamp of this record';
+-- Set comment to column: "updated_at" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."updated_at" IS 'Last update timestamp of this record';
+-- Set comment to column: "tenant_id" on table: "tenant_invitations"
+COMMENT ON COLUMN "public"."tenant_invitations"."tenant_id" IS '租户ID';
+-- tenant_invitations：与user_tenants一致按当前租户隔离；接受邀请的请求未登录，在SystemRLSMiddleware开启的跳过行级安全的请求事务中访问
+ALTER TABLE tenant_invitations ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_invitations_bypass ON tenant_invitations
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY tenant_invitations_tenant ON tenant_invitations
+	USING (tenant_id = app_current_tenant())
+	WITH CHECK (tenant_id = app_current_tenant());
+ALTER TABLE tenant_invitations FORCE ROW LEVEL SECURITY;
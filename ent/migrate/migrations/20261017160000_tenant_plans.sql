@@ -0,0 +1,50 @@
+-- Create "plans" table
+CREATE TABLE "public"."plans" (
+  "id" bigint NOT NULL,
+  "code" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "description" character varying NULL,
+  "max_users" bigint NULL,
+  "max_departments" bigint NULL,
+  "max_sub_tenants" bigint NULL,
+  "max_roles" bigint NULL,
+  "features" jsonb NOT NULL,
+  "is_default" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "plans_code_key" to table: "plans"
+CREATE UNIQUE INDEX "plans_code_key" ON "public"."plans" ("code");
+-- Create index "plan_is_default" to table: "plans"
+CREATE UNIQUE INDEX "plan_is_default" ON "public"."plans" ("is_default") WHERE is_default;
+-- Set comment to column: "id" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."id" IS 'Primary Key ID';
+-- Set comment to column: "code" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."code" IS '套餐编码';
+-- Set comment to column: "name" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."name" IS '套餐名称';
+-- Set comment to column: "description" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."description" IS '套餐说明';
+-- Set comment to column: "max_users" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."max_users" IS '成员数上限，为空不限制';
+-- Set comment to column: "max_departments" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."max_departments" IS '部门数上限，为空不限制';
+-- Set comment to column: "max_sub_tenants" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."max_sub_tenants" IS '子租户数上限，为空不限制';
+-- Set comment to column: "max_roles" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."max_roles" IS '租户角色数上限，为空不限制';
+-- Set comment to column: "features" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."features" IS '功能开关，未列出的功能视为关闭';
+-- Set comment to column: "is_default" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."is_default" IS '是否为新建租户的默认套餐';
+-- Set comment to column: "created_at" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."created_at" IS 'Creation timestamp of this record';
+-- Set comment to column: "updated_at" on table: "plans"
+COMMENT ON COLUMN "public"."plans"."updated_at" IS 'Last update timestamp of this record';
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ADD COLUMN "plan_id" bigint NULL, ADD CONSTRAINT "tenants_plans_tenants" FOREIGN KEY ("plan_id") REFERENCES "public"."plans" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "tenant_plan_id" to table: "tenants"
+CREATE INDEX "tenant_plan_id" ON "public"."tenants" ("plan_id");
+-- Set comment to column: "plan_id" on table: "tenants"
+COMMENT ON COLUMN "public"."tenants"."plan_id" IS '套餐ID，为空不限制用量';
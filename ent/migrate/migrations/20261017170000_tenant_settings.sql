@@ -0,0 +1,90 @@
+-- Create "tenant_settings" table
+CREATE TABLE "public"."tenant_settings" (
+  "id" bigint NOT NULL,
+  "key" character varying(64) NOT NULL,
+  "value" jsonb NOT NULL,
+  "updated_by" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "tenant_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tenant_settings_tenants_settings" FOREIGN KEY ("tenant_id") REFERENCES "public"."tenants" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "tenantsetting_tenant_id_key" to table: "tenant_settings"
+CREATE UNIQUE INDEX "tenantsetting_tenant_id_key" ON "public"."tenant_settings" ("tenant_id", "key");
+-- Set comment to column: "id" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."id" IS 'Primary Key ID';
+-- Set comment to column: "key" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."key" IS '设置项';
+-- Set comment to column: "value" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."value" IS '设置值，JSON编码';
+-- Set comment to column: "updated_by" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."updated_by" IS '最后修改人ID';
+-- Set comment to column: "created_at" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."created_at" IS 'Creation timestamp of this record';
+-- Set comment to column: "updated_at" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."updated_at" IS 'Last update timestamp of this record';
+-- Set comment to column: "tenant_id" on table: "tenant_settings"
+COMMENT ON COLUMN "public"."tenant_settings"."tenant_id" IS '租户ID';
+-- Create "tenant_setting_logs" table
+CREATE TABLE "public"."tenant_setting_logs" (
+  "id" bigint NOT NULL,
+  "key" character varying(64) NOT NULL,
+  "action" character varying NOT NULL,
+  "old_value" jsonb NULL,
+  "new_value" jsonb NULL,
+  "reason" character varying NULL,
+  "operator_id" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "tenant_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tenant_setting_logs_tenants_setting_logs" FOREIGN KEY ("tenant_id") REFERENCES "public"."tenants" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "tenantsettinglog_tenant_id_created_at" to table: "tenant_setting_logs"
+CREATE INDEX "tenantsettinglog_tenant_id_created_at" ON "public"."tenant_setting_logs" ("tenant_id", "created_at");
+-- Create index "tenantsettinglog_tenant_id_key" to table: "tenant_setting_logs"
+CREATE INDEX "tenantsettinglog_tenant_id_key" ON "public"."tenant_setting_logs" ("tenant_id", "key");
+-- Set comment to column: "id" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."id" IS 'Primary Key ID';
+-- Set comment to column: "key" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."key" IS '设置项';
+-- Set comment to column: "action" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."action" IS 'SET修改，RESET恢复继承';
+-- Set comment to column: "old_value" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."old_value" IS '变更前租户自身的值，为空表示变更前为继承值';
+-- Set comment to column: "new_value" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."new_value" IS '变更后的值，RESET时为空';
+-- Set comment to column: "reason" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."reason" IS '变更原因';
+-- Set comment to column: "operator_id" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."operator_id" IS '操作人ID';
+-- Set comment to column: "created_at" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."created_at" IS 'Creation timestamp of this record';
+-- Set comment to column: "tenant_id" on table: "tenant_setting_logs"
+COMMENT ON COLUMN "public"."tenant_setting_logs"."tenant_id" IS '租户ID';
+-- tenant_settings：按当前租户隔离，另允许读取当前租户路径上祖先租户的设置以计算继承值
+-- 只依赖当前租户自身的tenants行，不受tenants表只能看到当前租户的策略影响
+ALTER TABLE tenant_settings ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_settings_bypass ON tenant_settings
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY tenant_settings_tenant ON tenant_settings
+	USING (tenant_id = app_current_tenant())
+	WITH CHECK (tenant_id = app_current_tenant());
+CREATE POLICY tenant_settings_inherited ON tenant_settings
+	FOR SELECT
+	USING (EXISTS (
+		SELECT 1 FROM tenants c
+		WHERE c.id = app_current_tenant()
+			AND c.path ~ ('*.' || tenant_settings.tenant_id || '.*')::lquery
+	));
+ALTER TABLE tenant_settings FORCE ROW LEVEL SECURITY;
+-- tenant_setting_logs：只能查看和写入当前租户的变更记录
+ALTER TABLE tenant_setting_logs ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_setting_logs_bypass ON tenant_setting_logs
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY tenant_setting_logs_tenant ON tenant_setting_logs
+	USING (tenant_id = app_current_tenant())
+	WITH CHECK (tenant_id = app_current_tenant());
+ALTER TABLE tenant_setting_logs FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,15 @@
+-- Modify "departments" table
+ALTER TABLE "public"."departments" ADD COLUMN "sort_order" bigint NOT NULL DEFAULT 0;
+-- Drop index "department_tenant_id_parent_id" from table: "departments"
+DROP INDEX "public"."department_tenant_id_parent_id";
+-- Create index "department_tenant_id_parent_id_sort_order" to table: "departments"
+CREATE INDEX "department_tenant_id_parent_id_sort_order" ON "public"."departments" ("tenant_id", "parent_id", "sort_order") WHERE (deleted_at IS NULL);
+-- Set comment to column: "sort_order" on table: "departments"
+COMMENT ON COLUMN "public"."departments"."sort_order" IS '同级部门中的排序，从小到大';
+-- 已有部门按创建时间依次编号，保持原有的展示顺序
+UPDATE departments d SET sort_order = o.rn - 1
+FROM (
+	SELECT id, row_number() OVER (PARTITION BY tenant_id, parent_id ORDER BY created_at, id) AS rn
+	FROM departments
+) o
+WHERE d.id = o.id;
@@ -0,0 +1,15 @@
+-- Modify "user_departments" table
+ALTER TABLE "public"."user_departments" ADD COLUMN "is_primary" boolean NOT NULL DEFAULT false;
+-- Set comment to column: "is_primary" on table: "user_departments"
+COMMENT ON COLUMN "public"."user_departments"."is_primary" IS '是否为主部门，用户在每个租户下只有一个主部门';
+-- 已有成员在每个租户下最早加入的未删除部门作为主部门
+UPDATE user_departments ud SET is_primary = true
+FROM (
+	SELECT DISTINCT ON (m.user_id, m.tenant_id) m.id
+	FROM user_departments m
+	JOIN departments d ON d.id = m.dept_id AND d.deleted_at IS NULL
+	ORDER BY m.user_id, m.tenant_id, m.id
+) p
+WHERE ud.id = p.id;
+-- Create index "userdepartment_user_id_tenant_id" to table: "user_departments"
+CREATE UNIQUE INDEX "userdepartment_user_id_tenant_id" ON "public"."user_departments" ("user_id", "tenant_id") WHERE is_primary;
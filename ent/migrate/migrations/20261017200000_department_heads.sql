@@ -0,0 +1,10 @@
+-- Modify "departments" table
+ALTER TABLE "public"."departments" ADD COLUMN "head_id" bigint NULL, ADD COLUMN "deputy_ids" jsonb NOT NULL DEFAULT '[]';
+-- 已有部门的副职为空，回填后去掉默认值，与ent schema一致
+ALTER TABLE "public"."departments" ALTER COLUMN "deputy_ids" DROP DEFAULT;
+-- Set comment to column: "head_id" on table: "departments"
+COMMENT ON COLUMN "public"."departments"."head_id" IS '部门负责人用户ID，为空表示空缺';
+-- Set comment to column: "deputy_ids" on table: "departments"
+COMMENT ON COLUMN "public"."departments"."deputy_ids" IS '部门副职用户ID';
+-- Create index "department_head_id" to table: "departments"
+CREATE INDEX "department_head_id" ON "public"."departments" ("head_id");
@@ -0,0 +1,42 @@
+-- Modify "departments" table
+ALTER TABLE "public"."departments" ADD COLUMN "code" character varying(64) NULL, ADD COLUMN "description" character varying NULL, ADD COLUMN "external_id" character varying(128) NULL;
+-- Set comment to column: "code" on table: "departments"
+COMMENT ON COLUMN "public"."departments"."code" IS '部门编码，租户内未删除的部门中唯一';
+-- Set comment to column: "description" on table: "departments"
+COMMENT ON COLUMN "public"."departments"."description" IS '部门描述';
+-- Set comment to column: "external_id" on table: "departments"
+COMMENT ON COLUMN "public"."departments"."external_id" IS '外部系统（如HR系统）中的部门ID，租户内未删除的部门中唯一，用于同步';
+-- 编码和描述原先保存在扩展属性中，迁移到字段后从扩展属性中移除
+-- 超过64个字符的编码无法写入字段，保留在扩展属性legacy_code中，编码置空
+UPDATE "public"."departments" SET
+	attributes = attributes || jsonb_build_object('legacy_code', btrim(attributes->>'code'))
+WHERE char_length(btrim(attributes->>'code')) > 64;
+UPDATE "public"."departments" SET
+	code = CASE WHEN char_length(btrim(attributes->>'code')) > 64 THEN NULL ELSE NULLIF(btrim(attributes->>'code'), '') END,
+	description = NULLIF(attributes->>'description', ''),
+	attributes = attributes - 'code' - 'description'
+WHERE attributes ?| array['code', 'description'];
+-- 同一租户下重复的编码只保留最早创建的部门，其余部门的编码清空并保留在扩展属性legacy_code中
+UPDATE "public"."departments" d SET
+	attributes = d.attributes || jsonb_build_object('legacy_code', d.code),
+	code = NULL
+FROM (
+	SELECT id, row_number() OVER (PARTITION BY tenant_id, code ORDER BY created_at, id) AS rn
+	FROM "public"."departments"
+	WHERE deleted_at IS NULL AND code IS NOT NULL
+) dup
+WHERE d.id = dup.id AND dup.rn > 1;
+-- 同级部门中重复的名称只保留最早创建的部门，其余部门的名称加上部门ID后缀
+UPDATE "public"."departments" d SET name = d.name || ' (' || d.id || ')'
+FROM (
+	SELECT id, row_number() OVER (PARTITION BY tenant_id, parent_id, name ORDER BY created_at, id) AS rn
+	FROM "public"."departments"
+	WHERE deleted_at IS NULL
+) dup
+WHERE d.id = dup.id AND dup.rn > 1;
+-- Create index "department_tenant_id_parent_id_name" to table: "departments"
+CREATE UNIQUE INDEX "department_tenant_id_parent_id_name" ON "public"."departments" ("tenant_id", "parent_id", "name") WHERE (deleted_at IS NULL);
+-- Create index "department_tenant_id_code" to table: "departments"
+CREATE UNIQUE INDEX "department_tenant_id_code" ON "public"."departments" ("tenant_id", "code") WHERE (deleted_at IS NULL);
+-- Create index "department_tenant_id_external_id" to table: "departments"
+CREATE UNIQUE INDEX "department_tenant_id_external_id" ON "public"."departments" ("tenant_id", "external_id") WHERE (deleted_at IS NULL);
@@ -0,0 +1,21 @@
+-- Modify "access_policies" table
+ALTER TABLE "public"."access_policies" ADD COLUMN "tenant_id" bigint NULL, ADD CONSTRAINT "access_policies_tenants_access_policies" FOREIGN KEY ("tenant_id") REFERENCES "public"."tenants" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Drop index "accesspolicy_priority" from table: "access_policies"
+DROP INDEX "public"."accesspolicy_priority";
+-- Create index "accesspolicy_tenant_id_priority" to table: "access_policies"
+CREATE INDEX "accesspolicy_tenant_id_priority" ON "public"."access_policies" ("tenant_id", "priority") WHERE is_enabled;
+-- Set comment to column: "tenant_id" on table: "access_policies"
+COMMENT ON COLUMN "public"."access_policies"."tenant_id" IS '租户ID，为空表示平台级策略，对所有租户生效';
+-- access_policies：已有策略保留为平台级策略；租户只能管理自己的策略，平台级策略对所有租户只读，
+-- 只能由跳过行级安全的平台级用户修改
+ALTER TABLE access_policies ENABLE ROW LEVEL SECURITY;
+CREATE POLICY access_policies_bypass ON access_policies
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY access_policies_tenant ON access_policies
+	USING (tenant_id = app_current_tenant())
+	WITH CHECK (tenant_id = app_current_tenant());
+CREATE POLICY access_policies_platform ON access_policies
+	FOR SELECT
+	USING (tenant_id IS NULL);
+ALTER TABLE access_policies FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,4 @@
+-- Modify "roles" table
+ALTER TABLE "public"."roles" ADD COLUMN "is_template" boolean NOT NULL DEFAULT false;
+-- Set comment to column: "is_template" on table: "roles"
+COMMENT ON COLUMN "public"."roles"."is_template" IS '是否角色模板：只有平台级角色可以作为模板，租户角色只能继承模板';
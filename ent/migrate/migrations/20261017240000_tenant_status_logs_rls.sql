@@ -0,0 +1,9 @@
+-- tenant_status_logs：状态变更只由平台级用户和到期任务（均跳过行级安全）写入，租户只能查看本租户的变更记录
+ALTER TABLE tenant_status_logs ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_status_logs_bypass ON tenant_status_logs
+	USING (app_rls_bypass())
+	WITH CHECK (app_rls_bypass());
+CREATE POLICY tenant_status_logs_tenant ON tenant_status_logs
+	FOR SELECT
+	USING (tenant_id = app_current_tenant());
+ALTER TABLE tenant_status_logs FORCE ROW LEVEL SECURITY;
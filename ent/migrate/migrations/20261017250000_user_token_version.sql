@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "token_version" bigint NOT NULL DEFAULT 0;
+-- Set comment to column: "token_version" on table: "users"
+COMMENT ON COLUMN "public"."users"."token_version" IS 'Token version of the user, bumped on logout and password change to revoke issued tokens';
@@ -9,6 +9,53 @@ import (
 )
 
 var (
+	// AccessPoliciesColumns holds the columns for the "access_policies" table.
+	AccessPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
+		{Name: "name", Type: field.TypeString, Size: 128, Comment: "策略名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "策略描述"},
+		{Name: "effect", Type: field.TypeEnum, Comment: "策略效果", Enums: []string{"ALLOW", "DENY"}},
+		{Name: "subjects", Type: field.TypeJSON, Comment: "主体属性条件"},
+		{Name: "resources", Type: field.TypeJSON, Comment: "资源属性条件"},
+		{Name: "actions", Type: field.TypeJSON, Comment: "操作属性条件"},
+		{Name: "contexts", Type: field.TypeJSON, Comment: "环境属性条件"},
+		{Name: "priority", Type: field.TypeInt32, Comment: "优先级，数值越大越先匹配", Default: 0},
+		{Name: "is_enabled", Type: field.TypeBool, Comment: "是否启用", Default: true},
+		{Name: "created_by", Type: field.TypeInt64, Nullable: true, Comment: "User who created this record"},
+		{Name: "updated_by", Type: field.TypeInt64, Nullable: true, Comment: "User who last updated this record"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Last update timestamp of this record"},
+		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true, Comment: "租户ID，为空表示平台级策略，对所有租户生效"},
+	}
+	// AccessPoliciesTable holds the schema information for the "access_policies" table.
+	AccessPoliciesTable = &schema.Table{
+		Name:       "access_policies",
+		Columns:    AccessPoliciesColumns,
+		PrimaryKey: []*schema.Column{AccessPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_policies_tenants_access_policies",
+				Columns:    []*schema.Column{AccessPoliciesColumns[14]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesspolicy_tenant_id_priority",
+				Unique:  false,
+				Columns: []*schema.Column{AccessPoliciesColumns[14], AccessPoliciesColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_enabled",
+				},
+			},
+			{
+				Name:    "accesspolicy_name",
+				Unique:  false,
+				Columns: []*schema.Column{AccessPoliciesColumns[1]},
+			},
+		},
+	}
 	// CasbinRulesColumns holds the columns for the "casbin_rules" table.
 	CasbinRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -38,7 +85,13 @@ var (
 		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
 		{Name: "parent_id", Type: field.TypeInt64, Comment: "Parent Department ID"},
 		{Name: "name", Type: field.TypeString, Comment: "Name of the department"},
+		{Name: "code", Type: field.TypeString, Nullable: true, Size: 64, Comment: "部门编码，租户内未删除的部门中唯一"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "部门描述"},
+		{Name: "external_id", Type: field.TypeString, Nullable: true, Size: 128, Comment: "外部系统（如HR系统）中的部门ID，租户内未删除的部门中唯一，用于同步"},
 		{Name: "path", Type: field.TypeString, Comment: "save ltree path", SchemaType: map[string]string{"postgres": "ltree"}},
+		{Name: "sort_order", Type: field.TypeInt, Comment: "同级部门中的排序，从小到大", Default: 0},
+		{Name: "head_id", Type: field.TypeInt64, Nullable: true, Comment: "部门负责人用户ID，为空表示空缺"},
+		{Name: "deputy_ids", Type: field.TypeJSON, Comment: "部门副职用户ID"},
 		{Name: "attributes", Type: field.TypeJSON},
 		{Name: "created_by", Type: field.TypeInt64, Nullable: true, Comment: "User who created this record"},
 		{Name: "updated_by", Type: field.TypeInt64, Nullable: true, Comment: "User who last updated this record"},
@@ -55,7 +108,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "departments_tenants_departments",
-				Columns:    []*schema.Column{DepartmentsColumns[10]},
+				Columns:    []*schema.Column{DepartmentsColumns[16]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -64,12 +117,36 @@ var (
 			{
 				Name:    "department_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{DepartmentsColumns[10]},
+				Columns: []*schema.Column{DepartmentsColumns[16]},
 			},
 			{
-				Name:    "department_tenant_id_parent_id",
+				Name:    "department_tenant_id_parent_id_sort_order",
 				Unique:  false,
-				Columns: []*schema.Column{DepartmentsColumns[10], DepartmentsColumns[1]},
+				Columns: []*schema.Column{DepartmentsColumns[16], DepartmentsColumns[1], DepartmentsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "department_tenant_id_parent_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DepartmentsColumns[16], DepartmentsColumns[1], DepartmentsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "department_tenant_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{DepartmentsColumns[16], DepartmentsColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "department_tenant_id_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{DepartmentsColumns[16], DepartmentsColumns[5]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at IS NULL",
 				},
@@ -77,12 +154,17 @@ var (
 			{
 				Name:    "department_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{DepartmentsColumns[7]},
+				Columns: []*schema.Column{DepartmentsColumns[13]},
+			},
+			{
+				Name:    "department_head_id",
+				Unique:  false,
+				Columns: []*schema.Column{DepartmentsColumns[8]},
 			},
 			{
 				Name:    "department_path",
 				Unique:  false,
-				Columns: []*schema.Column{DepartmentsColumns[3]},
+				Columns: []*schema.Column{DepartmentsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Types: map[string]string{
 						"postgres": "GIST",
@@ -91,6 +173,37 @@ var (
 			},
 		},
 	}
+	// PlansColumns holds the columns for the "plans" table.
+	PlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 32, Comment: "套餐编码"},
+		{Name: "name", Type: field.TypeString, Size: 64, Comment: "套餐名称"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Comment: "套餐说明"},
+		{Name: "max_users", Type: field.TypeInt, Nullable: true, Comment: "成员数上限，为空不限制"},
+		{Name: "max_departments", Type: field.TypeInt, Nullable: true, Comment: "部门数上限，为空不限制"},
+		{Name: "max_sub_tenants", Type: field.TypeInt, Nullable: true, Comment: "子租户数上限，为空不限制"},
+		{Name: "max_roles", Type: field.TypeInt, Nullable: true, Comment: "租户角色数上限，为空不限制"},
+		{Name: "features", Type: field.TypeJSON, Comment: "功能开关，未列出的功能视为关闭"},
+		{Name: "is_default", Type: field.TypeBool, Comment: "是否为新建租户的默认套餐", Default: false},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Last update timestamp of this record"},
+	}
+	// PlansTable holds the schema information for the "plans" table.
+	PlansTable = &schema.Table{
+		Name:       "plans",
+		Columns:    PlansColumns,
+		PrimaryKey: []*schema.Column{PlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "plan_is_default",
+				Unique:  true,
+				Columns: []*schema.Column{PlansColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_default",
+				},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -99,6 +212,8 @@ var (
 		{Name: "is_system", Type: field.TypeBool, Comment: "是否系统预置角色（系统预置的不可删除/修改code）", Default: false},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "is_template", Type: field.TypeBool, Comment: "是否角色模板：只有平台级角色可以作为模板，租户角色只能继承模板", Default: false},
+		{Name: "data_scope", Type: field.TypeEnum, Comment: "数据范围：ALL(全部), TENANT_SUBTREE(当前租户及下级租户), TENANT(当前租户), DEPT_SUBTREE(所在部门及下级部门), SELF(本人创建)", Enums: []string{"ALL", "TENANT_SUBTREE", "TENANT", "DEPT_SUBTREE", "SELF"}, Default: "TENANT"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true, Comment: "租户ID"},
@@ -111,7 +226,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "roles_tenants_roles",
-				Columns:    []*schema.Column{RolesColumns[8]},
+				Columns:    []*schema.Column{RolesColumns[10]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -120,7 +235,7 @@ var (
 			{
 				Name:    "role_tenant_id_code",
 				Unique:  true,
-				Columns: []*schema.Column{RolesColumns[8], RolesColumns[1]},
+				Columns: []*schema.Column{RolesColumns[10], RolesColumns[1]},
 			},
 		},
 	}
@@ -134,12 +249,14 @@ var (
 		{Name: "level", Type: field.TypeInt32, Comment: "Tenant hierarchy level (0 for root tenants)", Default: 0},
 		{Name: "status", Type: field.TypeEnum, Comment: "Status of the tenant", Enums: []string{"ACTIVE", "DISABLED", "EXPIRED", "PENDING"}, Default: "PENDING"},
 		{Name: "expired_at", Type: field.TypeTime, Nullable: true, Comment: "Expiration time of the tenant"},
+		{Name: "expiry_notified_at", Type: field.TypeTime, Nullable: true, Comment: "到期提醒发送时间，续期后清空"},
 		{Name: "attributes", Type: field.TypeJSON, Comment: "Tenant attributes and metadata"},
 		{Name: "created_by", Type: field.TypeInt64, Nullable: true, Comment: "User who created this record"},
 		{Name: "updated_by", Type: field.TypeInt64, Nullable: true, Comment: "User who last updated this record"},
 		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
 		{Name: "updated_at", Type: field.TypeTime, Comment: "Last update timestamp of this record"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "Timestamp when the record was deleted, if applicable"},
+		{Name: "plan_id", Type: field.TypeInt64, Nullable: true, Comment: "套餐ID，为空不限制用量"},
 		{Name: "parent_id", Type: field.TypeInt64, Nullable: true, Comment: "Parent tenant ID for sub-tenants"},
 	}
 	// TenantsTable holds the schema information for the "tenants" table.
@@ -148,9 +265,15 @@ var (
 		Columns:    TenantsColumns,
 		PrimaryKey: []*schema.Column{TenantsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenants_plans_tenants",
+				Columns:    []*schema.Column{TenantsColumns[15]},
+				RefColumns: []*schema.Column{PlansColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "tenants_tenants_children",
-				Columns:    []*schema.Column{TenantsColumns[14]},
+				Columns:    []*schema.Column{TenantsColumns[16]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -169,16 +292,21 @@ var (
 			{
 				Name:    "tenant_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{TenantsColumns[14]},
+				Columns: []*schema.Column{TenantsColumns[16]},
 			},
 			{
 				Name:    "tenant_type_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{TenantsColumns[3], TenantsColumns[14]},
+				Columns: []*schema.Column{TenantsColumns[3], TenantsColumns[16]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at IS NULL",
 				},
 			},
+			{
+				Name:    "tenant_plan_id",
+				Unique:  false,
+				Columns: []*schema.Column{TenantsColumns[15]},
+			},
 			{
 				Name:    "tenant_level",
 				Unique:  false,
@@ -210,7 +338,165 @@ var (
 			{
 				Name:    "tenant_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TenantsColumns[11]},
+				Columns: []*schema.Column{TenantsColumns[12]},
+			},
+		},
+	}
+	// TenantInvitationsColumns holds the columns for the "tenant_invitations" table.
+	TenantInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Comment: "被邀请人邮箱"},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Comment: "被邀请人手机号"},
+		{Name: "department_id", Type: field.TypeInt64, Nullable: true, Comment: "加入的部门ID"},
+		{Name: "role_ids", Type: field.TypeJSON, Comment: "初始角色ID"},
+		{Name: "role_labels", Type: field.TypeJSON, Comment: "成员身份标签"},
+		{Name: "status", Type: field.TypeEnum, Comment: "邀请状态", Enums: []string{"PENDING", "ACCEPTED", "REVOKED"}, Default: "PENDING"},
+		{Name: "nonce", Type: field.TypeString, Comment: "令牌版本，重新发送时更换使旧令牌失效"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "过期时间"},
+		{Name: "send_count", Type: field.TypeInt, Comment: "发送次数", Default: 0},
+		{Name: "invited_by", Type: field.TypeInt64, Nullable: true, Comment: "邀请人ID"},
+		{Name: "accepted_by", Type: field.TypeInt64, Nullable: true, Comment: "接受邀请的用户ID"},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true, Comment: "接受时间"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Last update timestamp of this record"},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户ID"},
+	}
+	// TenantInvitationsTable holds the schema information for the "tenant_invitations" table.
+	TenantInvitationsTable = &schema.Table{
+		Name:       "tenant_invitations",
+		Columns:    TenantInvitationsColumns,
+		PrimaryKey: []*schema.Column{TenantInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_invitations_tenants_invitations",
+				Columns:    []*schema.Column{TenantInvitationsColumns[15]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantinvitation_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{TenantInvitationsColumns[15], TenantInvitationsColumns[6]},
+			},
+			{
+				Name:    "tenantinvitation_tenant_id_email",
+				Unique:  true,
+				Columns: []*schema.Column{TenantInvitationsColumns[15], TenantInvitationsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'PENDING' AND email IS NOT NULL",
+				},
+			},
+			{
+				Name:    "tenantinvitation_tenant_id_phone",
+				Unique:  true,
+				Columns: []*schema.Column{TenantInvitationsColumns[15], TenantInvitationsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'PENDING' AND phone IS NOT NULL",
+				},
+			},
+		},
+	}
+	// TenantSettingsColumns holds the columns for the "tenant_settings" table.
+	TenantSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
+		{Name: "key", Type: field.TypeString, Size: 64, Comment: "设置项"},
+		{Name: "value", Type: field.TypeJSON, Comment: "设置值，JSON编码"},
+		{Name: "updated_by", Type: field.TypeInt64, Nullable: true, Comment: "最后修改人ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "Last update timestamp of this record"},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户ID"},
+	}
+	// TenantSettingsTable holds the schema information for the "tenant_settings" table.
+	TenantSettingsTable = &schema.Table{
+		Name:       "tenant_settings",
+		Columns:    TenantSettingsColumns,
+		PrimaryKey: []*schema.Column{TenantSettingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_settings_tenants_settings",
+				Columns:    []*schema.Column{TenantSettingsColumns[6]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantsetting_tenant_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{TenantSettingsColumns[6], TenantSettingsColumns[1]},
+			},
+		},
+	}
+	// TenantSettingLogsColumns holds the columns for the "tenant_setting_logs" table.
+	TenantSettingLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
+		{Name: "key", Type: field.TypeString, Size: 64, Comment: "设置项"},
+		{Name: "action", Type: field.TypeEnum, Comment: "SET修改，RESET恢复继承", Enums: []string{"SET", "RESET"}},
+		{Name: "old_value", Type: field.TypeJSON, Nullable: true, Comment: "变更前租户自身的值，为空表示变更前为继承值"},
+		{Name: "new_value", Type: field.TypeJSON, Nullable: true, Comment: "变更后的值，RESET时为空"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "变更原因"},
+		{Name: "operator_id", Type: field.TypeInt64, Nullable: true, Comment: "操作人ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户ID"},
+	}
+	// TenantSettingLogsTable holds the schema information for the "tenant_setting_logs" table.
+	TenantSettingLogsTable = &schema.Table{
+		Name:       "tenant_setting_logs",
+		Columns:    TenantSettingLogsColumns,
+		PrimaryKey: []*schema.Column{TenantSettingLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_setting_logs_tenants_setting_logs",
+				Columns:    []*schema.Column{TenantSettingLogsColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantsettinglog_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TenantSettingLogsColumns[8], TenantSettingLogsColumns[7]},
+			},
+			{
+				Name:    "tenantsettinglog_tenant_id_key",
+				Unique:  false,
+				Columns: []*schema.Column{TenantSettingLogsColumns[8], TenantSettingLogsColumns[1]},
+			},
+		},
+	}
+	// TenantStatusLogsColumns holds the columns for the "tenant_status_logs" table.
+	TenantStatusLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true, Comment: "Primary Key ID"},
+		{Name: "action", Type: field.TypeEnum, Comment: "状态变更操作", Enums: []string{"ACTIVATE", "DISABLE", "RENEW", "EXPIRE"}},
+		{Name: "from_status", Type: field.TypeString, Comment: "变更前状态"},
+		{Name: "to_status", Type: field.TypeString, Comment: "变更后状态"},
+		{Name: "expired_at", Type: field.TypeTime, Nullable: true, Comment: "变更后的到期时间"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "变更原因"},
+		{Name: "operator_id", Type: field.TypeInt64, Nullable: true, Comment: "操作人ID，为空表示系统自动变更"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Creation timestamp of this record"},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户ID"},
+	}
+	// TenantStatusLogsTable holds the schema information for the "tenant_status_logs" table.
+	TenantStatusLogsTable = &schema.Table{
+		Name:       "tenant_status_logs",
+		Columns:    TenantStatusLogsColumns,
+		PrimaryKey: []*schema.Column{TenantStatusLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_status_logs_tenants_status_logs",
+				Columns:    []*schema.Column{TenantStatusLogsColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantstatuslog_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TenantStatusLogsColumns[8], TenantStatusLogsColumns[7]},
 			},
 		},
 	}
@@ -221,6 +507,7 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true, Comment: "Email address of the user"},
 		{Name: "phone", Type: field.TypeString, Unique: true, Nullable: true, Comment: "Phone number of the user"},
 		{Name: "password", Type: field.TypeString, Nullable: true, Comment: "Password of the user"},
+		{Name: "token_version", Type: field.TypeInt64, Comment: "Token version of the user, bumped on logout and password change to revoke issued tokens", Default: 0},
 		{Name: "status", Type: field.TypeEnum, Comment: "Status of the user", Enums: []string{"ACTIVE", "DISABLED", "PENDING"}, Default: "PENDING"},
 		{Name: "full_name", Type: field.TypeString, Nullable: true, Comment: "Full name of the user"},
 		{Name: "gender", Type: field.TypeEnum, Comment: "User gender", Enums: []string{"MALE", "FEMALE", "UNKNOWN"}, Default: "UNKNOWN"},
@@ -242,7 +529,7 @@ var (
 			{
 				Name:    "user_status_updated_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[5], UsersColumns[14]},
+				Columns: []*schema.Column{UsersColumns[6], UsersColumns[15]},
 			},
 		},
 	}
@@ -287,6 +574,7 @@ var (
 	UserDepartmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "tenant_id", Type: field.TypeInt64, Comment: "Tenant ID"},
+		{Name: "is_primary", Type: field.TypeBool, Comment: "是否为主部门，用户在每个租户下只有一个主部门", Default: false},
 		{Name: "attributes", Type: field.TypeJSON},
 		{Name: "dept_id", Type: field.TypeInt64, Comment: "Department ID"},
 		{Name: "user_id", Type: field.TypeInt64, Comment: "SysUser ID"},
@@ -299,13 +587,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_departments_departments_user_departments",
-				Columns:    []*schema.Column{UserDepartmentsColumns[3]},
+				Columns:    []*schema.Column{UserDepartmentsColumns[4]},
 				RefColumns: []*schema.Column{DepartmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_departments_users_user_departments",
-				Columns:    []*schema.Column{UserDepartmentsColumns[4]},
+				Columns:    []*schema.Column{UserDepartmentsColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -314,12 +602,12 @@ var (
 			{
 				Name:    "userdepartment_user_id_dept_id",
 				Unique:  true,
-				Columns: []*schema.Column{UserDepartmentsColumns[4], UserDepartmentsColumns[3]},
+				Columns: []*schema.Column{UserDepartmentsColumns[5], UserDepartmentsColumns[4]},
 			},
 			{
 				Name:    "userdepartment_dept_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserDepartmentsColumns[3]},
+				Columns: []*schema.Column{UserDepartmentsColumns[4]},
 			},
 			{
 				Name:    "userdepartment_tenant_id",
@@ -329,7 +617,15 @@ var (
 			{
 				Name:    "userdepartment_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserDepartmentsColumns[4]},
+				Columns: []*schema.Column{UserDepartmentsColumns[5]},
+			},
+			{
+				Name:    "userdepartment_user_id_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserDepartmentsColumns[5], UserDepartmentsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "is_primary",
+				},
 			},
 		},
 	}
@@ -338,6 +634,8 @@ var (
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "granted_at", Type: field.TypeTime},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间,NULL表示永久有效"},
+		{Name: "granted_by", Type: field.TypeInt64, Nullable: true, Comment: "授予人ID"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "授予原因，临时提权时必填"},
 		{Name: "role_id", Type: field.TypeInt64, Comment: "角色ID"},
 		{Name: "tenant_id", Type: field.TypeInt64, Nullable: true, Comment: "租户ID"},
 		{Name: "user_id", Type: field.TypeInt64, Comment: "用户ID"},
@@ -350,19 +648,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_roles_roles_user_roles",
-				Columns:    []*schema.Column{UserRolesColumns[3]},
+				Columns:    []*schema.Column{UserRolesColumns[5]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_roles_tenants_user_roles",
-				Columns:    []*schema.Column{UserRolesColumns[4]},
+				Columns:    []*schema.Column{UserRolesColumns[6]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_roles_users_user_roles",
-				Columns:    []*schema.Column{UserRolesColumns[5]},
+				Columns:    []*schema.Column{UserRolesColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -371,29 +669,39 @@ var (
 			{
 				Name:    "userrole_user_id_role_id_tenant_id",
 				Unique:  true,
-				Columns: []*schema.Column{UserRolesColumns[5], UserRolesColumns[3], UserRolesColumns[4]},
+				Columns: []*schema.Column{UserRolesColumns[7], UserRolesColumns[5], UserRolesColumns[6]},
 			},
 			{
 				Name:    "userrole_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserRolesColumns[5]},
+				Columns: []*schema.Column{UserRolesColumns[7]},
 			},
 			{
 				Name:    "userrole_role_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserRolesColumns[3]},
+				Columns: []*schema.Column{UserRolesColumns[5]},
 			},
 			{
 				Name:    "userrole_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserRolesColumns[4]},
+				Columns: []*schema.Column{UserRolesColumns[6]},
+			},
+			{
+				Name:    "userrole_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserRolesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "expires_at IS NOT NULL",
+				},
 			},
 		},
 	}
 	// UserTenantsColumns holds the columns for the "user_tenants" table.
 	UserTenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "role_labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "role_labels", Type: field.TypeJSON, Nullable: true, Comment: "成员在租户内的身份标签，仅用于展示和筛选，权限以角色授予为准"},
+		{Name: "created_by", Type: field.TypeInt64, Nullable: true, Comment: "User who added this member"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "Time when the user joined the tenant"},
 		{Name: "tenant_id", Type: field.TypeInt64, Comment: "Tenant ID"},
 		{Name: "user_id", Type: field.TypeInt64, Comment: "SysUser ID"},
 	}
@@ -405,13 +713,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_tenants_tenants_user_tenants",
-				Columns:    []*schema.Column{UserTenantsColumns[2]},
+				Columns:    []*schema.Column{UserTenantsColumns[4]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_tenants_users_user_tenants",
-				Columns:    []*schema.Column{UserTenantsColumns[3]},
+				Columns:    []*schema.Column{UserTenantsColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -420,42 +728,84 @@ var (
 			{
 				Name:    "usertenant_user_id_tenant_id",
 				Unique:  true,
-				Columns: []*schema.Column{UserTenantsColumns[3], UserTenantsColumns[2]},
+				Columns: []*schema.Column{UserTenantsColumns[5], UserTenantsColumns[4]},
 			},
 			{
 				Name:    "usertenant_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserTenantsColumns[2]},
+				Columns: []*schema.Column{UserTenantsColumns[4]},
 			},
 			{
 				Name:    "usertenant_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserTenantsColumns[3]},
+				Columns: []*schema.Column{UserTenantsColumns[5]},
+			},
+		},
+	}
+	// RoleInheritsColumns holds the columns for the "role_inherits" table.
+	RoleInheritsColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeInt64},
+		{Name: "inherited_by_id", Type: field.TypeInt64},
+	}
+	// RoleInheritsTable holds the schema information for the "role_inherits" table.
+	RoleInheritsTable = &schema.Table{
+		Name:       "role_inherits",
+		Columns:    RoleInheritsColumns,
+		PrimaryKey: []*schema.Column{RoleInheritsColumns[0], RoleInheritsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_inherits_role_id",
+				Columns:    []*schema.Column{RoleInheritsColumns[0]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_inherits_inherited_by_id",
+				Columns:    []*schema.Column{RoleInheritsColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessPoliciesTable,
 		CasbinRulesTable,
 		DepartmentsTable,
+		PlansTable,
 		RolesTable,
 		TenantsTable,
+		TenantInvitationsTable,
+		TenantSettingsTable,
+		TenantSettingLogsTable,
+		TenantStatusLogsTable,
 		UsersTable,
 		UserAccountsTable,
 		UserDepartmentsTable,
 		UserRolesTable,
 		UserTenantsTable,
+		RoleInheritsTable,
 	}
 )
 
 func init() {
+	AccessPoliciesTable.ForeignKeys[0].RefTable = TenantsTable
 	DepartmentsTable.ForeignKeys[0].RefTable = TenantsTable
 	RolesTable.ForeignKeys[0].RefTable = TenantsTable
-	TenantsTable.ForeignKeys[0].RefTable = TenantsTable
+	TenantsTable.ForeignKeys[0].RefTable = PlansTable
+	TenantsTable.ForeignKeys[1].RefTable = TenantsTable
 	TenantsTable.Annotation = &entsql.Annotation{}
 	TenantsTable.Annotation.Checks = map[string]string{
 		"tenant_type_check": "\n\t\t\t\t(type = 'ROOT' AND parent_id IS NULL) OR\n\t\t\t\t(type IN ('GROUP','NORMAL') AND parent_id = 100) OR \n\t\t\t\t(type = 'SUB' AND parent_id IS NOT NULL)",
 	}
+	TenantInvitationsTable.ForeignKeys[0].RefTable = TenantsTable
+	TenantInvitationsTable.Annotation = &entsql.Annotation{}
+	TenantInvitationsTable.Annotation.Checks = map[string]string{
+		"tenant_invitations_contact_check": "(email IS NOT NULL) OR (phone IS NOT NULL)",
+	}
+	TenantSettingsTable.ForeignKeys[0].RefTable = TenantsTable
+	TenantSettingLogsTable.ForeignKeys[0].RefTable = TenantsTable
+	TenantStatusLogsTable.ForeignKeys[0].RefTable = TenantsTable
 	UsersTable.Annotation = &entsql.Annotation{}
 	UsersTable.Annotation.Checks = map[string]string{
 		"users_contact_or_password_check": "(email IS NOT NULL) OR (phone IS NOT NULL) OR (password IS NOT NULL)",
@@ -468,4 +818,6 @@ func init() {
 	UserRolesTable.ForeignKeys[2].RefTable = UsersTable
 	UserTenantsTable.ForeignKeys[0].RefTable = TenantsTable
 	UserTenantsTable.ForeignKeys[1].RefTable = UsersTable
+	RoleInheritsTable.ForeignKeys[0].RefTable = RolesTable
+	RoleInheritsTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -11,11 +12,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
 	"github.com/yc-alpha/admin/ent/casbinrule"
 	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/predicate"
 	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
 	"github.com/yc-alpha/admin/ent/user"
 	"github.com/yc-alpha/admin/ent/useraccount"
 	"github.com/yc-alpha/admin/ent/userdepartment"
@@ -32,47 +40,68 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCasbinRule     = "CasbinRule"
-	TypeDepartment     = "Department"
-	TypeRole           = "Role"
-	TypeTenant         = "Tenant"
-	TypeUser           = "User"
-	TypeUserAccount    = "UserAccount"
-	TypeUserDepartment = "UserDepartment"
-	TypeUserRole       = "UserRole"
-	TypeUserTenant     = "UserTenant"
+	TypeAccessPolicy     = "AccessPolicy"
+	TypeCasbinRule       = "CasbinRule"
+	TypeDepartment       = "Department"
+	TypePlan             = "Plan"
+	TypeRole             = "Role"
+	TypeTenant           = "Tenant"
+	TypeTenantInvitation = "TenantInvitation"
+	TypeTenantSetting    = "TenantSetting"
+	TypeTenantSettingLog = "TenantSettingLog"
+	TypeTenantStatusLog  = "TenantStatusLog"
+	TypeUser             = "User"
+	TypeUserAccount      = "UserAccount"
+	TypeUserDepartment   = "UserDepartment"
+	TypeUserRole         = "UserRole"
+	TypeUserTenant       = "UserTenant"
 )
 
-// CasbinRuleMutation represents an operation that mutates the CasbinRule nodes in the graph.
-type CasbinRuleMutation struct {
+// AccessPolicyMutation represents an operation that mutates the AccessPolicy nodes in the graph.
+type AccessPolicyMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	ptype         *string
-	v0            *string
-	v1            *string
-	v2            *string
-	v3            *string
-	v4            *string
-	v5            *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*CasbinRule, error)
-	predicates    []predicate.CasbinRule
-}
-
-var _ ent.Mutation = (*CasbinRuleMutation)(nil)
-
-// casbinruleOption allows management of the mutation configuration using functional options.
-type casbinruleOption func(*CasbinRuleMutation)
-
-// newCasbinRuleMutation creates new mutation for the CasbinRule entity.
-func newCasbinRuleMutation(c config, op Op, opts ...casbinruleOption) *CasbinRuleMutation {
-	m := &CasbinRuleMutation{
+	op              Op
+	typ             string
+	id              *int64
+	name            *string
+	description     *string
+	effect          *accesspolicy.Effect
+	subjects        *[]abac.Attribute
+	appendsubjects  []abac.Attribute
+	resources       *[]abac.Attribute
+	appendresources []abac.Attribute
+	actions         *[]abac.Attribute
+	appendactions   []abac.Attribute
+	contexts        *[]abac.Attribute
+	appendcontexts  []abac.Attribute
+	priority        *int32
+	addpriority     *int32
+	is_enabled      *bool
+	created_by      *int64
+	addcreated_by   *int64
+	updated_by      *int64
+	addupdated_by   *int64
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	tenant          *int64
+	clearedtenant   bool
+	done            bool
+	oldValue        func(context.Context) (*AccessPolicy, error)
+	predicates      []predicate.AccessPolicy
+}
+
+var _ ent.Mutation = (*AccessPolicyMutation)(nil)
+
+// accesspolicyOption allows management of the mutation configuration using functional options.
+type accesspolicyOption func(*AccessPolicyMutation)
+
+// newAccessPolicyMutation creates new mutation for the AccessPolicy entity.
+func newAccessPolicyMutation(c config, op Op, opts ...accesspolicyOption) *AccessPolicyMutation {
+	m := &AccessPolicyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCasbinRule,
+		typ:           TypeAccessPolicy,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -81,20 +110,20 @@ func newCasbinRuleMutation(c config, op Op, opts ...casbinruleOption) *CasbinRul
 	return m
 }
 
-// withCasbinRuleID sets the ID field of the mutation.
-func withCasbinRuleID(id int) casbinruleOption {
-	return func(m *CasbinRuleMutation) {
+// withAccessPolicyID sets the ID field of the mutation.
+func withAccessPolicyID(id int64) accesspolicyOption {
+	return func(m *AccessPolicyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CasbinRule
+			value *AccessPolicy
 		)
-		m.oldValue = func(ctx context.Context) (*CasbinRule, error) {
+		m.oldValue = func(ctx context.Context) (*AccessPolicy, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CasbinRule.Get(ctx, id)
+					value, err = m.Client().AccessPolicy.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -103,10 +132,10 @@ func withCasbinRuleID(id int) casbinruleOption {
 	}
 }
 
-// withCasbinRule sets the old CasbinRule of the mutation.
-func withCasbinRule(node *CasbinRule) casbinruleOption {
-	return func(m *CasbinRuleMutation) {
-		m.oldValue = func(context.Context) (*CasbinRule, error) {
+// withAccessPolicy sets the old AccessPolicy of the mutation.
+func withAccessPolicy(node *AccessPolicy) accesspolicyOption {
+	return func(m *AccessPolicyMutation) {
+		m.oldValue = func(context.Context) (*AccessPolicy, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -115,7 +144,7 @@ func withCasbinRule(node *CasbinRule) casbinruleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CasbinRuleMutation) Client() *Client {
+func (m AccessPolicyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -123,7 +152,7 @@ func (m CasbinRuleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CasbinRuleMutation) Tx() (*Tx, error) {
+func (m AccessPolicyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -133,14 +162,14 @@ func (m CasbinRuleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CasbinRule entities.
-func (m *CasbinRuleMutation) SetID(id int) {
+// operation is only accepted on creation of AccessPolicy entities.
+func (m *AccessPolicyMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CasbinRuleMutation) ID() (id int, exists bool) {
+func (m *AccessPolicyMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -151,1034 +180,9017 @@ func (m *CasbinRuleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CasbinRuleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AccessPolicyMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []int64{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CasbinRule.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessPolicy.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPtype sets the "ptype" field.
-func (m *CasbinRuleMutation) SetPtype(s string) {
-	m.ptype = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *AccessPolicyMutation) SetTenantID(i int64) {
+	m.tenant = &i
 }
 
-// Ptype returns the value of the "ptype" field in the mutation.
-func (m *CasbinRuleMutation) Ptype() (r string, exists bool) {
-	v := m.ptype
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AccessPolicyMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPtype returns the old "ptype" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldPtype(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldTenantID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPtype is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPtype requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPtype: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Ptype, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetPtype resets all changes to the "ptype" field.
-func (m *CasbinRuleMutation) ResetPtype() {
-	m.ptype = nil
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *AccessPolicyMutation) ClearTenantID() {
+	m.tenant = nil
+	m.clearedFields[accesspolicy.FieldTenantID] = struct{}{}
 }
 
-// SetV0 sets the "v0" field.
-func (m *CasbinRuleMutation) SetV0(s string) {
-	m.v0 = &s
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *AccessPolicyMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[accesspolicy.FieldTenantID]
+	return ok
 }
 
-// V0 returns the value of the "v0" field in the mutation.
-func (m *CasbinRuleMutation) V0() (r string, exists bool) {
-	v := m.v0
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AccessPolicyMutation) ResetTenantID() {
+	m.tenant = nil
+	delete(m.clearedFields, accesspolicy.FieldTenantID)
+}
+
+// SetName sets the "name" field.
+func (m *AccessPolicyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AccessPolicyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldV0 returns the old "v0" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldV0(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldV0 is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldV0 requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldV0: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.V0, nil
+	return oldValue.Name, nil
 }
 
-// ResetV0 resets all changes to the "v0" field.
-func (m *CasbinRuleMutation) ResetV0() {
-	m.v0 = nil
+// ResetName resets all changes to the "name" field.
+func (m *AccessPolicyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetV1 sets the "v1" field.
-func (m *CasbinRuleMutation) SetV1(s string) {
-	m.v1 = &s
+// SetDescription sets the "description" field.
+func (m *AccessPolicyMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// V1 returns the value of the "v1" field in the mutation.
-func (m *CasbinRuleMutation) V1() (r string, exists bool) {
-	v := m.v1
+// Description returns the value of the "description" field in the mutation.
+func (m *AccessPolicyMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldV1 returns the old "v1" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldV1(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldV1 is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldV1 requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldV1: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.V1, nil
+	return oldValue.Description, nil
 }
 
-// ResetV1 resets all changes to the "v1" field.
-func (m *CasbinRuleMutation) ResetV1() {
-	m.v1 = nil
+// ClearDescription clears the value of the "description" field.
+func (m *AccessPolicyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[accesspolicy.FieldDescription] = struct{}{}
 }
 
-// SetV2 sets the "v2" field.
-func (m *CasbinRuleMutation) SetV2(s string) {
-	m.v2 = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *AccessPolicyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[accesspolicy.FieldDescription]
+	return ok
 }
 
-// V2 returns the value of the "v2" field in the mutation.
-func (m *CasbinRuleMutation) V2() (r string, exists bool) {
-	v := m.v2
+// ResetDescription resets all changes to the "description" field.
+func (m *AccessPolicyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, accesspolicy.FieldDescription)
+}
+
+// SetEffect sets the "effect" field.
+func (m *AccessPolicyMutation) SetEffect(a accesspolicy.Effect) {
+	m.effect = &a
+}
+
+// Effect returns the value of the "effect" field in the mutation.
+func (m *AccessPolicyMutation) Effect() (r accesspolicy.Effect, exists bool) {
+	v := m.effect
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldV2 returns the old "v2" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldEffect returns the old "effect" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldV2(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldEffect(ctx context.Context) (v accesspolicy.Effect, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldV2 is only allowed on UpdateOne operations")
+		return v, errors.New("OldEffect is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldV2 requires an ID field in the mutation")
+		return v, errors.New("OldEffect requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldV2: %w", err)
+		return v, fmt.Errorf("querying old value for OldEffect: %w", err)
 	}
-	return oldValue.V2, nil
+	return oldValue.Effect, nil
 }
 
-// ResetV2 resets all changes to the "v2" field.
-func (m *CasbinRuleMutation) ResetV2() {
-	m.v2 = nil
+// ResetEffect resets all changes to the "effect" field.
+func (m *AccessPolicyMutation) ResetEffect() {
+	m.effect = nil
 }
 
-// SetV3 sets the "v3" field.
-func (m *CasbinRuleMutation) SetV3(s string) {
-	m.v3 = &s
+// SetSubjects sets the "subjects" field.
+func (m *AccessPolicyMutation) SetSubjects(a []abac.Attribute) {
+	m.subjects = &a
+	m.appendsubjects = nil
 }
 
-// V3 returns the value of the "v3" field in the mutation.
-func (m *CasbinRuleMutation) V3() (r string, exists bool) {
-	v := m.v3
+// Subjects returns the value of the "subjects" field in the mutation.
+func (m *AccessPolicyMutation) Subjects() (r []abac.Attribute, exists bool) {
+	v := m.subjects
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldV3 returns the old "v3" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldSubjects returns the old "subjects" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldV3(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldSubjects(ctx context.Context) (v []abac.Attribute, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldV3 is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubjects is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldV3 requires an ID field in the mutation")
+		return v, errors.New("OldSubjects requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldV3: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubjects: %w", err)
 	}
-	return oldValue.V3, nil
+	return oldValue.Subjects, nil
 }
 
-// ResetV3 resets all changes to the "v3" field.
-func (m *CasbinRuleMutation) ResetV3() {
-	m.v3 = nil
+// AppendSubjects adds a to the "subjects" field.
+func (m *AccessPolicyMutation) AppendSubjects(a []abac.Attribute) {
+	m.appendsubjects = append(m.appendsubjects, a...)
 }
 
-// SetV4 sets the "v4" field.
-func (m *CasbinRuleMutation) SetV4(s string) {
-	m.v4 = &s
+// AppendedSubjects returns the list of values that were appended to the "subjects" field in this mutation.
+func (m *AccessPolicyMutation) AppendedSubjects() ([]abac.Attribute, bool) {
+	if len(m.appendsubjects) == 0 {
+		return nil, false
+	}
+	return m.appendsubjects, true
 }
 
-// V4 returns the value of the "v4" field in the mutation.
-func (m *CasbinRuleMutation) V4() (r string, exists bool) {
-	v := m.v4
+// ResetSubjects resets all changes to the "subjects" field.
+func (m *AccessPolicyMutation) ResetSubjects() {
+	m.subjects = nil
+	m.appendsubjects = nil
+}
+
+// SetResources sets the "resources" field.
+func (m *AccessPolicyMutation) SetResources(a []abac.Attribute) {
+	m.resources = &a
+	m.appendresources = nil
+}
+
+// Resources returns the value of the "resources" field in the mutation.
+func (m *AccessPolicyMutation) Resources() (r []abac.Attribute, exists bool) {
+	v := m.resources
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldV4 returns the old "v4" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldResources returns the old "resources" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldV4(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldResources(ctx context.Context) (v []abac.Attribute, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldV4 is only allowed on UpdateOne operations")
+		return v, errors.New("OldResources is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldV4 requires an ID field in the mutation")
+		return v, errors.New("OldResources requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldV4: %w", err)
+		return v, fmt.Errorf("querying old value for OldResources: %w", err)
 	}
-	return oldValue.V4, nil
+	return oldValue.Resources, nil
 }
 
-// ResetV4 resets all changes to the "v4" field.
-func (m *CasbinRuleMutation) ResetV4() {
-	m.v4 = nil
+// AppendResources adds a to the "resources" field.
+func (m *AccessPolicyMutation) AppendResources(a []abac.Attribute) {
+	m.appendresources = append(m.appendresources, a...)
 }
 
-// SetV5 sets the "v5" field.
-func (m *CasbinRuleMutation) SetV5(s string) {
-	m.v5 = &s
+// AppendedResources returns the list of values that were appended to the "resources" field in this mutation.
+func (m *AccessPolicyMutation) AppendedResources() ([]abac.Attribute, bool) {
+	if len(m.appendresources) == 0 {
+		return nil, false
+	}
+	return m.appendresources, true
 }
 
-// V5 returns the value of the "v5" field in the mutation.
-func (m *CasbinRuleMutation) V5() (r string, exists bool) {
-	v := m.v5
+// ResetResources resets all changes to the "resources" field.
+func (m *AccessPolicyMutation) ResetResources() {
+	m.resources = nil
+	m.appendresources = nil
+}
+
+// SetActions sets the "actions" field.
+func (m *AccessPolicyMutation) SetActions(a []abac.Attribute) {
+	m.actions = &a
+	m.appendactions = nil
+}
+
+// Actions returns the value of the "actions" field in the mutation.
+func (m *AccessPolicyMutation) Actions() (r []abac.Attribute, exists bool) {
+	v := m.actions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldV5 returns the old "v5" field's value of the CasbinRule entity.
-// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// OldActions returns the old "actions" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CasbinRuleMutation) OldV5(ctx context.Context) (v string, err error) {
+func (m *AccessPolicyMutation) OldActions(ctx context.Context) (v []abac.Attribute, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldV5 is only allowed on UpdateOne operations")
+		return v, errors.New("OldActions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldV5 requires an ID field in the mutation")
+		return v, errors.New("OldActions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldV5: %w", err)
+		return v, fmt.Errorf("querying old value for OldActions: %w", err)
 	}
-	return oldValue.V5, nil
-}
-
-// ResetV5 resets all changes to the "v5" field.
-func (m *CasbinRuleMutation) ResetV5() {
-	m.v5 = nil
+	return oldValue.Actions, nil
 }
 
-// Where appends a list predicates to the CasbinRuleMutation builder.
-func (m *CasbinRuleMutation) Where(ps ...predicate.CasbinRule) {
-	m.predicates = append(m.predicates, ps...)
+// AppendActions adds a to the "actions" field.
+func (m *AccessPolicyMutation) AppendActions(a []abac.Attribute) {
+	m.appendactions = append(m.appendactions, a...)
 }
 
-// WhereP appends storage-level predicates to the CasbinRuleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CasbinRuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CasbinRule, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AppendedActions returns the list of values that were appended to the "actions" field in this mutation.
+func (m *AccessPolicyMutation) AppendedActions() ([]abac.Attribute, bool) {
+	if len(m.appendactions) == 0 {
+		return nil, false
 	}
-	m.Where(p...)
+	return m.appendactions, true
 }
 
-// Op returns the operation name.
-func (m *CasbinRuleMutation) Op() Op {
-	return m.op
+// ResetActions resets all changes to the "actions" field.
+func (m *AccessPolicyMutation) ResetActions() {
+	m.actions = nil
+	m.appendactions = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CasbinRuleMutation) SetOp(op Op) {
-	m.op = op
+// SetContexts sets the "contexts" field.
+func (m *AccessPolicyMutation) SetContexts(a []abac.Attribute) {
+	m.contexts = &a
+	m.appendcontexts = nil
 }
 
-// Type returns the node type of this mutation (CasbinRule).
-func (m *CasbinRuleMutation) Type() string {
-	return m.typ
+// Contexts returns the value of the "contexts" field in the mutation.
+func (m *AccessPolicyMutation) Contexts() (r []abac.Attribute, exists bool) {
+	v := m.contexts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CasbinRuleMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.ptype != nil {
-		fields = append(fields, casbinrule.FieldPtype)
+// OldContexts returns the old "contexts" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldContexts(ctx context.Context) (v []abac.Attribute, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContexts is only allowed on UpdateOne operations")
 	}
-	if m.v0 != nil {
-		fields = append(fields, casbinrule.FieldV0)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContexts requires an ID field in the mutation")
 	}
-	if m.v1 != nil {
-		fields = append(fields, casbinrule.FieldV1)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContexts: %w", err)
+	}
+	return oldValue.Contexts, nil
+}
+
+// AppendContexts adds a to the "contexts" field.
+func (m *AccessPolicyMutation) AppendContexts(a []abac.Attribute) {
+	m.appendcontexts = append(m.appendcontexts, a...)
+}
+
+// AppendedContexts returns the list of values that were appended to the "contexts" field in this mutation.
+func (m *AccessPolicyMutation) AppendedContexts() ([]abac.Attribute, bool) {
+	if len(m.appendcontexts) == 0 {
+		return nil, false
+	}
+	return m.appendcontexts, true
+}
+
+// ResetContexts resets all changes to the "contexts" field.
+func (m *AccessPolicyMutation) ResetContexts() {
+	m.contexts = nil
+	m.appendcontexts = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *AccessPolicyMutation) SetPriority(i int32) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *AccessPolicyMutation) Priority() (r int32, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldPriority(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *AccessPolicyMutation) AddPriority(i int32) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *AccessPolicyMutation) AddedPriority() (r int32, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *AccessPolicyMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (m *AccessPolicyMutation) SetIsEnabled(b bool) {
+	m.is_enabled = &b
+}
+
+// IsEnabled returns the value of the "is_enabled" field in the mutation.
+func (m *AccessPolicyMutation) IsEnabled() (r bool, exists bool) {
+	v := m.is_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnabled returns the old "is_enabled" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+	}
+	return oldValue.IsEnabled, nil
+}
+
+// ResetIsEnabled resets all changes to the "is_enabled" field.
+func (m *AccessPolicyMutation) ResetIsEnabled() {
+	m.is_enabled = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *AccessPolicyMutation) SetCreatedBy(i int64) {
+	m.created_by = &i
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *AccessPolicyMutation) CreatedBy() (r int64, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldCreatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (m *AccessPolicyMutation) AddCreatedBy(i int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *AccessPolicyMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *AccessPolicyMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[accesspolicy.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *AccessPolicyMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[accesspolicy.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *AccessPolicyMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, accesspolicy.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *AccessPolicyMutation) SetUpdatedBy(i int64) {
+	m.updated_by = &i
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *AccessPolicyMutation) UpdatedBy() (r int64, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldUpdatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (m *AccessPolicyMutation) AddUpdatedBy(i int64) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += i
+	} else {
+		m.addupdated_by = &i
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *AccessPolicyMutation) AddedUpdatedBy() (r int64, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *AccessPolicyMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[accesspolicy.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *AccessPolicyMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[accesspolicy.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *AccessPolicyMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, accesspolicy.FieldUpdatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AccessPolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccessPolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccessPolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AccessPolicyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AccessPolicyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the AccessPolicy entity.
+// If the AccessPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessPolicyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AccessPolicyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *AccessPolicyMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[accesspolicy.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *AccessPolicyMutation) TenantCleared() bool {
+	return m.TenantIDCleared() || m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *AccessPolicyMutation) TenantIDs() (ids []int64) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *AccessPolicyMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// Where appends a list predicates to the AccessPolicyMutation builder.
+func (m *AccessPolicyMutation) Where(ps ...predicate.AccessPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccessPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccessPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AccessPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccessPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AccessPolicy).
+func (m *AccessPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccessPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.tenant != nil {
+		fields = append(fields, accesspolicy.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, accesspolicy.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, accesspolicy.FieldDescription)
+	}
+	if m.effect != nil {
+		fields = append(fields, accesspolicy.FieldEffect)
+	}
+	if m.subjects != nil {
+		fields = append(fields, accesspolicy.FieldSubjects)
+	}
+	if m.resources != nil {
+		fields = append(fields, accesspolicy.FieldResources)
+	}
+	if m.actions != nil {
+		fields = append(fields, accesspolicy.FieldActions)
+	}
+	if m.contexts != nil {
+		fields = append(fields, accesspolicy.FieldContexts)
+	}
+	if m.priority != nil {
+		fields = append(fields, accesspolicy.FieldPriority)
+	}
+	if m.is_enabled != nil {
+		fields = append(fields, accesspolicy.FieldIsEnabled)
+	}
+	if m.created_by != nil {
+		fields = append(fields, accesspolicy.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, accesspolicy.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, accesspolicy.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, accesspolicy.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccessPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accesspolicy.FieldTenantID:
+		return m.TenantID()
+	case accesspolicy.FieldName:
+		return m.Name()
+	case accesspolicy.FieldDescription:
+		return m.Description()
+	case accesspolicy.FieldEffect:
+		return m.Effect()
+	case accesspolicy.FieldSubjects:
+		return m.Subjects()
+	case accesspolicy.FieldResources:
+		return m.Resources()
+	case accesspolicy.FieldActions:
+		return m.Actions()
+	case accesspolicy.FieldContexts:
+		return m.Contexts()
+	case accesspolicy.FieldPriority:
+		return m.Priority()
+	case accesspolicy.FieldIsEnabled:
+		return m.IsEnabled()
+	case accesspolicy.FieldCreatedBy:
+		return m.CreatedBy()
+	case accesspolicy.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case accesspolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	case accesspolicy.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccessPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accesspolicy.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case accesspolicy.FieldName:
+		return m.OldName(ctx)
+	case accesspolicy.FieldDescription:
+		return m.OldDescription(ctx)
+	case accesspolicy.FieldEffect:
+		return m.OldEffect(ctx)
+	case accesspolicy.FieldSubjects:
+		return m.OldSubjects(ctx)
+	case accesspolicy.FieldResources:
+		return m.OldResources(ctx)
+	case accesspolicy.FieldActions:
+		return m.OldActions(ctx)
+	case accesspolicy.FieldContexts:
+		return m.OldContexts(ctx)
+	case accesspolicy.FieldPriority:
+		return m.OldPriority(ctx)
+	case accesspolicy.FieldIsEnabled:
+		return m.OldIsEnabled(ctx)
+	case accesspolicy.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case accesspolicy.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case accesspolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case accesspolicy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccessPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case accesspolicy.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case accesspolicy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case accesspolicy.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case accesspolicy.FieldEffect:
+		v, ok := value.(accesspolicy.Effect)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffect(v)
+		return nil
+	case accesspolicy.FieldSubjects:
+		v, ok := value.([]abac.Attribute)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjects(v)
+		return nil
+	case accesspolicy.FieldResources:
+		v, ok := value.([]abac.Attribute)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResources(v)
+		return nil
+	case accesspolicy.FieldActions:
+		v, ok := value.([]abac.Attribute)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActions(v)
+		return nil
+	case accesspolicy.FieldContexts:
+		v, ok := value.([]abac.Attribute)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContexts(v)
+		return nil
+	case accesspolicy.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case accesspolicy.FieldIsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnabled(v)
+		return nil
+	case accesspolicy.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case accesspolicy.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case accesspolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case accesspolicy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AccessPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccessPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, accesspolicy.FieldPriority)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, accesspolicy.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, accesspolicy.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccessPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case accesspolicy.FieldPriority:
+		return m.AddedPriority()
+	case accesspolicy.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case accesspolicy.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case accesspolicy.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case accesspolicy.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case accesspolicy.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AccessPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccessPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(accesspolicy.FieldTenantID) {
+		fields = append(fields, accesspolicy.FieldTenantID)
+	}
+	if m.FieldCleared(accesspolicy.FieldDescription) {
+		fields = append(fields, accesspolicy.FieldDescription)
+	}
+	if m.FieldCleared(accesspolicy.FieldCreatedBy) {
+		fields = append(fields, accesspolicy.FieldCreatedBy)
+	}
+	if m.FieldCleared(accesspolicy.FieldUpdatedBy) {
+		fields = append(fields, accesspolicy.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccessPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccessPolicyMutation) ClearField(name string) error {
+	switch name {
+	case accesspolicy.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case accesspolicy.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case accesspolicy.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case accesspolicy.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccessPolicyMutation) ResetField(name string) error {
+	switch name {
+	case accesspolicy.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case accesspolicy.FieldName:
+		m.ResetName()
+		return nil
+	case accesspolicy.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case accesspolicy.FieldEffect:
+		m.ResetEffect()
+		return nil
+	case accesspolicy.FieldSubjects:
+		m.ResetSubjects()
+		return nil
+	case accesspolicy.FieldResources:
+		m.ResetResources()
+		return nil
+	case accesspolicy.FieldActions:
+		m.ResetActions()
+		return nil
+	case accesspolicy.FieldContexts:
+		m.ResetContexts()
+		return nil
+	case accesspolicy.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case accesspolicy.FieldIsEnabled:
+		m.ResetIsEnabled()
+		return nil
+	case accesspolicy.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case accesspolicy.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case accesspolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case accesspolicy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccessPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tenant != nil {
+		edges = append(edges, accesspolicy.EdgeTenant)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccessPolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case accesspolicy.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccessPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccessPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccessPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtenant {
+		edges = append(edges, accesspolicy.EdgeTenant)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccessPolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case accesspolicy.EdgeTenant:
+		return m.clearedtenant
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccessPolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case accesspolicy.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccessPolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case accesspolicy.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessPolicy edge %s", name)
+}
+
+// CasbinRuleMutation represents an operation that mutates the CasbinRule nodes in the graph.
+type CasbinRuleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	ptype         *string
+	v0            *string
+	v1            *string
+	v2            *string
+	v3            *string
+	v4            *string
+	v5            *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CasbinRule, error)
+	predicates    []predicate.CasbinRule
+}
+
+var _ ent.Mutation = (*CasbinRuleMutation)(nil)
+
+// casbinruleOption allows management of the mutation configuration using functional options.
+type casbinruleOption func(*CasbinRuleMutation)
+
+// newCasbinRuleMutation creates new mutation for the CasbinRule entity.
+func newCasbinRuleMutation(c config, op Op, opts ...casbinruleOption) *CasbinRuleMutation {
+	m := &CasbinRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCasbinRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCasbinRuleID sets the ID field of the mutation.
+func withCasbinRuleID(id int) casbinruleOption {
+	return func(m *CasbinRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CasbinRule
+		)
+		m.oldValue = func(ctx context.Context) (*CasbinRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CasbinRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCasbinRule sets the old CasbinRule of the mutation.
+func withCasbinRule(node *CasbinRule) casbinruleOption {
+	return func(m *CasbinRuleMutation) {
+		m.oldValue = func(context.Context) (*CasbinRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CasbinRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CasbinRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CasbinRule entities.
+func (m *CasbinRuleMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CasbinRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CasbinRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CasbinRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPtype sets the "ptype" field.
+func (m *CasbinRuleMutation) SetPtype(s string) {
+	m.ptype = &s
+}
+
+// Ptype returns the value of the "ptype" field in the mutation.
+func (m *CasbinRuleMutation) Ptype() (r string, exists bool) {
+	v := m.ptype
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPtype returns the old "ptype" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldPtype(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPtype is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPtype requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPtype: %w", err)
+	}
+	return oldValue.Ptype, nil
+}
+
+// ResetPtype resets all changes to the "ptype" field.
+func (m *CasbinRuleMutation) ResetPtype() {
+	m.ptype = nil
+}
+
+// SetV0 sets the "v0" field.
+func (m *CasbinRuleMutation) SetV0(s string) {
+	m.v0 = &s
+}
+
+// V0 returns the value of the "v0" field in the mutation.
+func (m *CasbinRuleMutation) V0() (r string, exists bool) {
+	v := m.v0
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldV0 returns the old "v0" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldV0(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldV0 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldV0 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldV0: %w", err)
+	}
+	return oldValue.V0, nil
+}
+
+// ResetV0 resets all changes to the "v0" field.
+func (m *CasbinRuleMutation) ResetV0() {
+	m.v0 = nil
+}
+
+// SetV1 sets the "v1" field.
+func (m *CasbinRuleMutation) SetV1(s string) {
+	m.v1 = &s
+}
+
+// V1 returns the value of the "v1" field in the mutation.
+func (m *CasbinRuleMutation) V1() (r string, exists bool) {
+	v := m.v1
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldV1 returns the old "v1" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldV1(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldV1 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldV1 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldV1: %w", err)
+	}
+	return oldValue.V1, nil
+}
+
+// ResetV1 resets all changes to the "v1" field.
+func (m *CasbinRuleMutation) ResetV1() {
+	m.v1 = nil
+}
+
+// SetV2 sets the "v2" field.
+func (m *CasbinRuleMutation) SetV2(s string) {
+	m.v2 = &s
+}
+
+// V2 returns the value of the "v2" field in the mutation.
+func (m *CasbinRuleMutation) V2() (r string, exists bool) {
+	v := m.v2
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldV2 returns the old "v2" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldV2(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldV2 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldV2 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldV2: %w", err)
+	}
+	return oldValue.V2, nil
+}
+
+// ResetV2 resets all changes to the "v2" field.
+func (m *CasbinRuleMutation) ResetV2() {
+	m.v2 = nil
+}
+
+// SetV3 sets the "v3" field.
+func (m *CasbinRuleMutation) SetV3(s string) {
+	m.v3 = &s
+}
+
+// V3 returns the value of the "v3" field in the mutation.
+func (m *CasbinRuleMutation) V3() (r string, exists bool) {
+	v := m.v3
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldV3 returns the old "v3" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldV3(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldV3 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldV3 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldV3: %w", err)
+	}
+	return oldValue.V3, nil
+}
+
+// ResetV3 resets all changes to the "v3" field.
+func (m *CasbinRuleMutation) ResetV3() {
+	m.v3 = nil
+}
+
+// SetV4 sets the "v4" field.
+func (m *CasbinRuleMutation) SetV4(s string) {
+	m.v4 = &s
+}
+
+// V4 returns the value of the "v4" field in the mutation.
+func (m *CasbinRuleMutation) V4() (r string, exists bool) {
+	v := m.v4
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldV4 returns the old "v4" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldV4(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldV4 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldV4 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldV4: %w", err)
+	}
+	return oldValue.V4, nil
+}
+
+// ResetV4 resets all changes to the "v4" field.
+func (m *CasbinRuleMutation) ResetV4() {
+	m.v4 = nil
+}
+
+// SetV5 sets the "v5" field.
+func (m *CasbinRuleMutation) SetV5(s string) {
+	m.v5 = &s
+}
+
+// V5 returns the value of the "v5" field in the mutation.
+func (m *CasbinRuleMutation) V5() (r string, exists bool) {
+	v := m.v5
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldV5 returns the old "v5" field's value of the CasbinRule entity.
+// If the CasbinRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CasbinRuleMutation) OldV5(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldV5 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldV5 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldV5: %w", err)
+	}
+	return oldValue.V5, nil
+}
+
+// ResetV5 resets all changes to the "v5" field.
+func (m *CasbinRuleMutation) ResetV5() {
+	m.v5 = nil
+}
+
+// Where appends a list predicates to the CasbinRuleMutation builder.
+func (m *CasbinRuleMutation) Where(ps ...predicate.CasbinRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CasbinRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CasbinRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CasbinRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CasbinRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CasbinRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CasbinRule).
+func (m *CasbinRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CasbinRuleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.ptype != nil {
+		fields = append(fields, casbinrule.FieldPtype)
+	}
+	if m.v0 != nil {
+		fields = append(fields, casbinrule.FieldV0)
+	}
+	if m.v1 != nil {
+		fields = append(fields, casbinrule.FieldV1)
+	}
+	if m.v2 != nil {
+		fields = append(fields, casbinrule.FieldV2)
+	}
+	if m.v3 != nil {
+		fields = append(fields, casbinrule.FieldV3)
+	}
+	if m.v4 != nil {
+		fields = append(fields, casbinrule.FieldV4)
+	}
+	if m.v5 != nil {
+		fields = append(fields, casbinrule.FieldV5)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CasbinRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case casbinrule.FieldPtype:
+		return m.Ptype()
+	case casbinrule.FieldV0:
+		return m.V0()
+	case casbinrule.FieldV1:
+		return m.V1()
+	case casbinrule.FieldV2:
+		return m.V2()
+	case casbinrule.FieldV3:
+		return m.V3()
+	case casbinrule.FieldV4:
+		return m.V4()
+	case casbinrule.FieldV5:
+		return m.V5()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CasbinRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case casbinrule.FieldPtype:
+		return m.OldPtype(ctx)
+	case casbinrule.FieldV0:
+		return m.OldV0(ctx)
+	case casbinrule.FieldV1:
+		return m.OldV1(ctx)
+	case casbinrule.FieldV2:
+		return m.OldV2(ctx)
+	case casbinrule.FieldV3:
+		return m.OldV3(ctx)
+	case casbinrule.FieldV4:
+		return m.OldV4(ctx)
+	case casbinrule.FieldV5:
+		return m.OldV5(ctx)
+	}
+	return nil, fmt.Errorf("unknown CasbinRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CasbinRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case casbinrule.FieldPtype:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPtype(v)
+		return nil
+	case casbinrule.FieldV0:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV0(v)
+		return nil
+	case casbinrule.FieldV1:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV1(v)
+		return nil
+	case casbinrule.FieldV2:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV2(v)
+		return nil
+	case casbinrule.FieldV3:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV3(v)
+		return nil
+	case casbinrule.FieldV4:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV4(v)
+		return nil
+	case casbinrule.FieldV5:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetV5(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CasbinRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CasbinRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CasbinRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CasbinRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CasbinRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CasbinRuleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CasbinRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CasbinRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CasbinRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CasbinRuleMutation) ResetField(name string) error {
+	switch name {
+	case casbinrule.FieldPtype:
+		m.ResetPtype()
+		return nil
+	case casbinrule.FieldV0:
+		m.ResetV0()
+		return nil
+	case casbinrule.FieldV1:
+		m.ResetV1()
+		return nil
+	case casbinrule.FieldV2:
+		m.ResetV2()
+		return nil
+	case casbinrule.FieldV3:
+		m.ResetV3()
+		return nil
+	case casbinrule.FieldV4:
+		m.ResetV4()
+		return nil
+	case casbinrule.FieldV5:
+		m.ResetV5()
+		return nil
+	}
+	return fmt.Errorf("unknown CasbinRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CasbinRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CasbinRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CasbinRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CasbinRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CasbinRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CasbinRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CasbinRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CasbinRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CasbinRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CasbinRule edge %s", name)
+}
+
+// DepartmentMutation represents an operation that mutates the Department nodes in the graph.
+type DepartmentMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int64
+	parent_id               *int64
+	addparent_id            *int64
+	name                    *string
+	code                    *string
+	description             *string
+	external_id             *string
+	_path                   *string
+	sort_order              *int
+	addsort_order           *int
+	head_id                 *int64
+	addhead_id              *int64
+	deputy_ids              *[]int64
+	appenddeputy_ids        []int64
+	attributes              *map[string]interface{}
+	created_by              *int64
+	addcreated_by           *int64
+	updated_by              *int64
+	addupdated_by           *int64
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	clearedFields           map[string]struct{}
+	tenant                  *int64
+	clearedtenant           bool
+	user_departments        map[int]struct{}
+	removeduser_departments map[int]struct{}
+	cleareduser_departments bool
+	done                    bool
+	oldValue                func(context.Context) (*Department, error)
+	predicates              []predicate.Department
+}
+
+var _ ent.Mutation = (*DepartmentMutation)(nil)
+
+// departmentOption allows management of the mutation configuration using functional options.
+type departmentOption func(*DepartmentMutation)
+
+// newDepartmentMutation creates new mutation for the Department entity.
+func newDepartmentMutation(c config, op Op, opts ...departmentOption) *DepartmentMutation {
+	m := &DepartmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDepartment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDepartmentID sets the ID field of the mutation.
+func withDepartmentID(id int64) departmentOption {
+	return func(m *DepartmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Department
+		)
+		m.oldValue = func(ctx context.Context) (*Department, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Department.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDepartment sets the old Department of the mutation.
+func withDepartment(node *Department) departmentOption {
+	return func(m *DepartmentMutation) {
+		m.oldValue = func(context.Context) (*Department, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DepartmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DepartmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Department entities.
+func (m *DepartmentMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DepartmentMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DepartmentMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Department.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DepartmentMutation) SetTenantID(i int64) {
+	m.tenant = &i
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DepartmentMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DepartmentMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *DepartmentMutation) SetParentID(i int64) {
+	m.parent_id = &i
+	m.addparent_id = nil
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *DepartmentMutation) ParentID() (r int64, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldParentID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (m *DepartmentMutation) AddParentID(i int64) {
+	if m.addparent_id != nil {
+		*m.addparent_id += i
+	} else {
+		m.addparent_id = &i
+	}
+}
+
+// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
+func (m *DepartmentMutation) AddedParentID() (r int64, exists bool) {
+	v := m.addparent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *DepartmentMutation) ResetParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *DepartmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DepartmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DepartmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCode sets the "code" field.
+func (m *DepartmentMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *DepartmentMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ClearCode clears the value of the "code" field.
+func (m *DepartmentMutation) ClearCode() {
+	m.code = nil
+	m.clearedFields[department.FieldCode] = struct{}{}
+}
+
+// CodeCleared returns if the "code" field was cleared in this mutation.
+func (m *DepartmentMutation) CodeCleared() bool {
+	_, ok := m.clearedFields[department.FieldCode]
+	return ok
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *DepartmentMutation) ResetCode() {
+	m.code = nil
+	delete(m.clearedFields, department.FieldCode)
+}
+
+// SetDescription sets the "description" field.
+func (m *DepartmentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DepartmentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DepartmentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[department.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DepartmentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[department.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DepartmentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, department.FieldDescription)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *DepartmentMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *DepartmentMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldExternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *DepartmentMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[department.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *DepartmentMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[department.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *DepartmentMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, department.FieldExternalID)
+}
+
+// SetPath sets the "path" field.
+func (m *DepartmentMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *DepartmentMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *DepartmentMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *DepartmentMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *DepartmentMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (m *DepartmentMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *DepartmentMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *DepartmentMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetHeadID sets the "head_id" field.
+func (m *DepartmentMutation) SetHeadID(i int64) {
+	m.head_id = &i
+	m.addhead_id = nil
+}
+
+// HeadID returns the value of the "head_id" field in the mutation.
+func (m *DepartmentMutation) HeadID() (r int64, exists bool) {
+	v := m.head_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeadID returns the old "head_id" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldHeadID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeadID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeadID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeadID: %w", err)
+	}
+	return oldValue.HeadID, nil
+}
+
+// AddHeadID adds i to the "head_id" field.
+func (m *DepartmentMutation) AddHeadID(i int64) {
+	if m.addhead_id != nil {
+		*m.addhead_id += i
+	} else {
+		m.addhead_id = &i
+	}
+}
+
+// AddedHeadID returns the value that was added to the "head_id" field in this mutation.
+func (m *DepartmentMutation) AddedHeadID() (r int64, exists bool) {
+	v := m.addhead_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeadID clears the value of the "head_id" field.
+func (m *DepartmentMutation) ClearHeadID() {
+	m.head_id = nil
+	m.addhead_id = nil
+	m.clearedFields[department.FieldHeadID] = struct{}{}
+}
+
+// HeadIDCleared returns if the "head_id" field was cleared in this mutation.
+func (m *DepartmentMutation) HeadIDCleared() bool {
+	_, ok := m.clearedFields[department.FieldHeadID]
+	return ok
+}
+
+// ResetHeadID resets all changes to the "head_id" field.
+func (m *DepartmentMutation) ResetHeadID() {
+	m.head_id = nil
+	m.addhead_id = nil
+	delete(m.clearedFields, department.FieldHeadID)
+}
+
+// SetDeputyIds sets the "deputy_ids" field.
+func (m *DepartmentMutation) SetDeputyIds(i []int64) {
+	m.deputy_ids = &i
+	m.appenddeputy_ids = nil
+}
+
+// DeputyIds returns the value of the "deputy_ids" field in the mutation.
+func (m *DepartmentMutation) DeputyIds() (r []int64, exists bool) {
+	v := m.deputy_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeputyIds returns the old "deputy_ids" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldDeputyIds(ctx context.Context) (v []int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeputyIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeputyIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeputyIds: %w", err)
+	}
+	return oldValue.DeputyIds, nil
+}
+
+// AppendDeputyIds adds i to the "deputy_ids" field.
+func (m *DepartmentMutation) AppendDeputyIds(i []int64) {
+	m.appenddeputy_ids = append(m.appenddeputy_ids, i...)
+}
+
+// AppendedDeputyIds returns the list of values that were appended to the "deputy_ids" field in this mutation.
+func (m *DepartmentMutation) AppendedDeputyIds() ([]int64, bool) {
+	if len(m.appenddeputy_ids) == 0 {
+		return nil, false
+	}
+	return m.appenddeputy_ids, true
+}
+
+// ResetDeputyIds resets all changes to the "deputy_ids" field.
+func (m *DepartmentMutation) ResetDeputyIds() {
+	m.deputy_ids = nil
+	m.appenddeputy_ids = nil
+}
+
+// SetAttributes sets the "attributes" field.
+func (m *DepartmentMutation) SetAttributes(value map[string]interface{}) {
+	m.attributes = &value
+}
+
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *DepartmentMutation) Attributes() (r map[string]interface{}, exists bool) {
+	v := m.attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributes returns the old "attributes" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldAttributes(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+	}
+	return oldValue.Attributes, nil
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *DepartmentMutation) ResetAttributes() {
+	m.attributes = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *DepartmentMutation) SetCreatedBy(i int64) {
+	m.created_by = &i
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *DepartmentMutation) CreatedBy() (r int64, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldCreatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (m *DepartmentMutation) AddCreatedBy(i int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *DepartmentMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *DepartmentMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[department.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *DepartmentMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[department.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *DepartmentMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, department.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *DepartmentMutation) SetUpdatedBy(i int64) {
+	m.updated_by = &i
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *DepartmentMutation) UpdatedBy() (r int64, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldUpdatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (m *DepartmentMutation) AddUpdatedBy(i int64) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += i
+	} else {
+		m.addupdated_by = &i
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *DepartmentMutation) AddedUpdatedBy() (r int64, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *DepartmentMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[department.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *DepartmentMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[department.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *DepartmentMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, department.FieldUpdatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DepartmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DepartmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DepartmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DepartmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DepartmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DepartmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *DepartmentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *DepartmentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Department entity.
+// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DepartmentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *DepartmentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[department.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *DepartmentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[department.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *DepartmentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, department.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *DepartmentMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[department.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *DepartmentMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *DepartmentMutation) TenantIDs() (ids []int64) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *DepartmentMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddUserDepartmentIDs adds the "user_departments" edge to the UserDepartment entity by ids.
+func (m *DepartmentMutation) AddUserDepartmentIDs(ids ...int) {
+	if m.user_departments == nil {
+		m.user_departments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_departments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserDepartments clears the "user_departments" edge to the UserDepartment entity.
+func (m *DepartmentMutation) ClearUserDepartments() {
+	m.cleareduser_departments = true
+}
+
+// UserDepartmentsCleared reports if the "user_departments" edge to the UserDepartment entity was cleared.
+func (m *DepartmentMutation) UserDepartmentsCleared() bool {
+	return m.cleareduser_departments
+}
+
+// RemoveUserDepartmentIDs removes the "user_departments" edge to the UserDepartment entity by IDs.
+func (m *DepartmentMutation) RemoveUserDepartmentIDs(ids ...int) {
+	if m.removeduser_departments == nil {
+		m.removeduser_departments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_departments, ids[i])
+		m.removeduser_departments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserDepartments returns the removed IDs of the "user_departments" edge to the UserDepartment entity.
+func (m *DepartmentMutation) RemovedUserDepartmentsIDs() (ids []int) {
+	for id := range m.removeduser_departments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserDepartmentsIDs returns the "user_departments" edge IDs in the mutation.
+func (m *DepartmentMutation) UserDepartmentsIDs() (ids []int) {
+	for id := range m.user_departments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserDepartments resets all changes to the "user_departments" edge.
+func (m *DepartmentMutation) ResetUserDepartments() {
+	m.user_departments = nil
+	m.cleareduser_departments = false
+	m.removeduser_departments = nil
+}
+
+// Where appends a list predicates to the DepartmentMutation builder.
+func (m *DepartmentMutation) Where(ps ...predicate.Department) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DepartmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DepartmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Department, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DepartmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DepartmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Department).
+func (m *DepartmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DepartmentMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.tenant != nil {
+		fields = append(fields, department.FieldTenantID)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, department.FieldParentID)
+	}
+	if m.name != nil {
+		fields = append(fields, department.FieldName)
+	}
+	if m.code != nil {
+		fields = append(fields, department.FieldCode)
+	}
+	if m.description != nil {
+		fields = append(fields, department.FieldDescription)
+	}
+	if m.external_id != nil {
+		fields = append(fields, department.FieldExternalID)
+	}
+	if m._path != nil {
+		fields = append(fields, department.FieldPath)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, department.FieldSortOrder)
+	}
+	if m.head_id != nil {
+		fields = append(fields, department.FieldHeadID)
+	}
+	if m.deputy_ids != nil {
+		fields = append(fields, department.FieldDeputyIds)
+	}
+	if m.attributes != nil {
+		fields = append(fields, department.FieldAttributes)
+	}
+	if m.created_by != nil {
+		fields = append(fields, department.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, department.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, department.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, department.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, department.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DepartmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case department.FieldTenantID:
+		return m.TenantID()
+	case department.FieldParentID:
+		return m.ParentID()
+	case department.FieldName:
+		return m.Name()
+	case department.FieldCode:
+		return m.Code()
+	case department.FieldDescription:
+		return m.Description()
+	case department.FieldExternalID:
+		return m.ExternalID()
+	case department.FieldPath:
+		return m.Path()
+	case department.FieldSortOrder:
+		return m.SortOrder()
+	case department.FieldHeadID:
+		return m.HeadID()
+	case department.FieldDeputyIds:
+		return m.DeputyIds()
+	case department.FieldAttributes:
+		return m.Attributes()
+	case department.FieldCreatedBy:
+		return m.CreatedBy()
+	case department.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case department.FieldCreatedAt:
+		return m.CreatedAt()
+	case department.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case department.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DepartmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case department.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case department.FieldParentID:
+		return m.OldParentID(ctx)
+	case department.FieldName:
+		return m.OldName(ctx)
+	case department.FieldCode:
+		return m.OldCode(ctx)
+	case department.FieldDescription:
+		return m.OldDescription(ctx)
+	case department.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case department.FieldPath:
+		return m.OldPath(ctx)
+	case department.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case department.FieldHeadID:
+		return m.OldHeadID(ctx)
+	case department.FieldDeputyIds:
+		return m.OldDeputyIds(ctx)
+	case department.FieldAttributes:
+		return m.OldAttributes(ctx)
+	case department.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case department.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case department.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case department.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case department.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Department field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepartmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case department.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case department.FieldParentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case department.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case department.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case department.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case department.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case department.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case department.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	case department.FieldHeadID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeadID(v)
+		return nil
+	case department.FieldDeputyIds:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeputyIds(v)
+		return nil
+	case department.FieldAttributes:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributes(v)
+		return nil
+	case department.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case department.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case department.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case department.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case department.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Department field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DepartmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addparent_id != nil {
+		fields = append(fields, department.FieldParentID)
+	}
+	if m.addsort_order != nil {
+		fields = append(fields, department.FieldSortOrder)
+	}
+	if m.addhead_id != nil {
+		fields = append(fields, department.FieldHeadID)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, department.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, department.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DepartmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case department.FieldParentID:
+		return m.AddedParentID()
+	case department.FieldSortOrder:
+		return m.AddedSortOrder()
+	case department.FieldHeadID:
+		return m.AddedHeadID()
+	case department.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case department.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepartmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case department.FieldParentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentID(v)
+		return nil
+	case department.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
+	case department.FieldHeadID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeadID(v)
+		return nil
+	case department.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case department.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Department numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DepartmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(department.FieldCode) {
+		fields = append(fields, department.FieldCode)
+	}
+	if m.FieldCleared(department.FieldDescription) {
+		fields = append(fields, department.FieldDescription)
+	}
+	if m.FieldCleared(department.FieldExternalID) {
+		fields = append(fields, department.FieldExternalID)
+	}
+	if m.FieldCleared(department.FieldHeadID) {
+		fields = append(fields, department.FieldHeadID)
+	}
+	if m.FieldCleared(department.FieldCreatedBy) {
+		fields = append(fields, department.FieldCreatedBy)
+	}
+	if m.FieldCleared(department.FieldUpdatedBy) {
+		fields = append(fields, department.FieldUpdatedBy)
+	}
+	if m.FieldCleared(department.FieldDeletedAt) {
+		fields = append(fields, department.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DepartmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DepartmentMutation) ClearField(name string) error {
+	switch name {
+	case department.FieldCode:
+		m.ClearCode()
+		return nil
+	case department.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case department.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case department.FieldHeadID:
+		m.ClearHeadID()
+		return nil
+	case department.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case department.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case department.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Department nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DepartmentMutation) ResetField(name string) error {
+	switch name {
+	case department.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case department.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case department.FieldName:
+		m.ResetName()
+		return nil
+	case department.FieldCode:
+		m.ResetCode()
+		return nil
+	case department.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case department.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case department.FieldPath:
+		m.ResetPath()
+		return nil
+	case department.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	case department.FieldHeadID:
+		m.ResetHeadID()
+		return nil
+	case department.FieldDeputyIds:
+		m.ResetDeputyIds()
+		return nil
+	case department.FieldAttributes:
+		m.ResetAttributes()
+		return nil
+	case department.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case department.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case department.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case department.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case department.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Department field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DepartmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, department.EdgeTenant)
+	}
+	if m.user_departments != nil {
+		edges = append(edges, department.EdgeUserDepartments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DepartmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case department.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case department.EdgeUserDepartments:
+		ids := make([]ent.Value, 0, len(m.user_departments))
+		for id := range m.user_departments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DepartmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeduser_departments != nil {
+		edges = append(edges, department.EdgeUserDepartments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DepartmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case department.EdgeUserDepartments:
+		ids := make([]ent.Value, 0, len(m.removeduser_departments))
+		for id := range m.removeduser_departments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DepartmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, department.EdgeTenant)
+	}
+	if m.cleareduser_departments {
+		edges = append(edges, department.EdgeUserDepartments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DepartmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case department.EdgeTenant:
+		return m.clearedtenant
+	case department.EdgeUserDepartments:
+		return m.cleareduser_departments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DepartmentMutation) ClearEdge(name string) error {
+	switch name {
+	case department.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown Department unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DepartmentMutation) ResetEdge(name string) error {
+	switch name {
+	case department.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case department.EdgeUserDepartments:
+		m.ResetUserDepartments()
+		return nil
+	}
+	return fmt.Errorf("unknown Department edge %s", name)
+}
+
+// PlanMutation represents an operation that mutates the Plan nodes in the graph.
+type PlanMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int64
+	code               *string
+	name               *string
+	description        *string
+	max_users          *int
+	addmax_users       *int
+	max_departments    *int
+	addmax_departments *int
+	max_sub_tenants    *int
+	addmax_sub_tenants *int
+	max_roles          *int
+	addmax_roles       *int
+	features           *map[string]bool
+	is_default         *bool
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	tenants            map[int64]struct{}
+	removedtenants     map[int64]struct{}
+	clearedtenants     bool
+	done               bool
+	oldValue           func(context.Context) (*Plan, error)
+	predicates         []predicate.Plan
+}
+
+var _ ent.Mutation = (*PlanMutation)(nil)
+
+// planOption allows management of the mutation configuration using functional options.
+type planOption func(*PlanMutation)
+
+// newPlanMutation creates new mutation for the Plan entity.
+func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
+	m := &PlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlanID sets the ID field of the mutation.
+func withPlanID(id int64) planOption {
+	return func(m *PlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Plan
+		)
+		m.oldValue = func(ctx context.Context) (*Plan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Plan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlan sets the old Plan of the mutation.
+func withPlan(node *Plan) planOption {
+	return func(m *PlanMutation) {
+		m.oldValue = func(context.Context) (*Plan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Plan entities.
+func (m *PlanMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlanMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlanMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Plan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *PlanMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *PlanMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *PlanMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *PlanMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlanMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlanMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PlanMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PlanMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PlanMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[plan.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PlanMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[plan.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PlanMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, plan.FieldDescription)
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (m *PlanMutation) SetMaxUsers(i int) {
+	m.max_users = &i
+	m.addmax_users = nil
+}
+
+// MaxUsers returns the value of the "max_users" field in the mutation.
+func (m *PlanMutation) MaxUsers() (r int, exists bool) {
+	v := m.max_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUsers returns the old "max_users" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxUsers(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUsers: %w", err)
+	}
+	return oldValue.MaxUsers, nil
+}
+
+// AddMaxUsers adds i to the "max_users" field.
+func (m *PlanMutation) AddMaxUsers(i int) {
+	if m.addmax_users != nil {
+		*m.addmax_users += i
+	} else {
+		m.addmax_users = &i
+	}
+}
+
+// AddedMaxUsers returns the value that was added to the "max_users" field in this mutation.
+func (m *PlanMutation) AddedMaxUsers() (r int, exists bool) {
+	v := m.addmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (m *PlanMutation) ClearMaxUsers() {
+	m.max_users = nil
+	m.addmax_users = nil
+	m.clearedFields[plan.FieldMaxUsers] = struct{}{}
+}
+
+// MaxUsersCleared returns if the "max_users" field was cleared in this mutation.
+func (m *PlanMutation) MaxUsersCleared() bool {
+	_, ok := m.clearedFields[plan.FieldMaxUsers]
+	return ok
+}
+
+// ResetMaxUsers resets all changes to the "max_users" field.
+func (m *PlanMutation) ResetMaxUsers() {
+	m.max_users = nil
+	m.addmax_users = nil
+	delete(m.clearedFields, plan.FieldMaxUsers)
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (m *PlanMutation) SetMaxDepartments(i int) {
+	m.max_departments = &i
+	m.addmax_departments = nil
+}
+
+// MaxDepartments returns the value of the "max_departments" field in the mutation.
+func (m *PlanMutation) MaxDepartments() (r int, exists bool) {
+	v := m.max_departments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxDepartments returns the old "max_departments" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxDepartments(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxDepartments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxDepartments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxDepartments: %w", err)
+	}
+	return oldValue.MaxDepartments, nil
+}
+
+// AddMaxDepartments adds i to the "max_departments" field.
+func (m *PlanMutation) AddMaxDepartments(i int) {
+	if m.addmax_departments != nil {
+		*m.addmax_departments += i
+	} else {
+		m.addmax_departments = &i
+	}
+}
+
+// AddedMaxDepartments returns the value that was added to the "max_departments" field in this mutation.
+func (m *PlanMutation) AddedMaxDepartments() (r int, exists bool) {
+	v := m.addmax_departments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxDepartments clears the value of the "max_departments" field.
+func (m *PlanMutation) ClearMaxDepartments() {
+	m.max_departments = nil
+	m.addmax_departments = nil
+	m.clearedFields[plan.FieldMaxDepartments] = struct{}{}
+}
+
+// MaxDepartmentsCleared returns if the "max_departments" field was cleared in this mutation.
+func (m *PlanMutation) MaxDepartmentsCleared() bool {
+	_, ok := m.clearedFields[plan.FieldMaxDepartments]
+	return ok
+}
+
+// ResetMaxDepartments resets all changes to the "max_departments" field.
+func (m *PlanMutation) ResetMaxDepartments() {
+	m.max_departments = nil
+	m.addmax_departments = nil
+	delete(m.clearedFields, plan.FieldMaxDepartments)
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (m *PlanMutation) SetMaxSubTenants(i int) {
+	m.max_sub_tenants = &i
+	m.addmax_sub_tenants = nil
+}
+
+// MaxSubTenants returns the value of the "max_sub_tenants" field in the mutation.
+func (m *PlanMutation) MaxSubTenants() (r int, exists bool) {
+	v := m.max_sub_tenants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSubTenants returns the old "max_sub_tenants" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxSubTenants(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSubTenants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSubTenants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSubTenants: %w", err)
+	}
+	return oldValue.MaxSubTenants, nil
+}
+
+// AddMaxSubTenants adds i to the "max_sub_tenants" field.
+func (m *PlanMutation) AddMaxSubTenants(i int) {
+	if m.addmax_sub_tenants != nil {
+		*m.addmax_sub_tenants += i
+	} else {
+		m.addmax_sub_tenants = &i
+	}
+}
+
+// AddedMaxSubTenants returns the value that was added to the "max_sub_tenants" field in this mutation.
+func (m *PlanMutation) AddedMaxSubTenants() (r int, exists bool) {
+	v := m.addmax_sub_tenants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxSubTenants clears the value of the "max_sub_tenants" field.
+func (m *PlanMutation) ClearMaxSubTenants() {
+	m.max_sub_tenants = nil
+	m.addmax_sub_tenants = nil
+	m.clearedFields[plan.FieldMaxSubTenants] = struct{}{}
+}
+
+// MaxSubTenantsCleared returns if the "max_sub_tenants" field was cleared in this mutation.
+func (m *PlanMutation) MaxSubTenantsCleared() bool {
+	_, ok := m.clearedFields[plan.FieldMaxSubTenants]
+	return ok
+}
+
+// ResetMaxSubTenants resets all changes to the "max_sub_tenants" field.
+func (m *PlanMutation) ResetMaxSubTenants() {
+	m.max_sub_tenants = nil
+	m.addmax_sub_tenants = nil
+	delete(m.clearedFields, plan.FieldMaxSubTenants)
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (m *PlanMutation) SetMaxRoles(i int) {
+	m.max_roles = &i
+	m.addmax_roles = nil
+}
+
+// MaxRoles returns the value of the "max_roles" field in the mutation.
+func (m *PlanMutation) MaxRoles() (r int, exists bool) {
+	v := m.max_roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRoles returns the old "max_roles" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxRoles(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRoles: %w", err)
+	}
+	return oldValue.MaxRoles, nil
+}
+
+// AddMaxRoles adds i to the "max_roles" field.
+func (m *PlanMutation) AddMaxRoles(i int) {
+	if m.addmax_roles != nil {
+		*m.addmax_roles += i
+	} else {
+		m.addmax_roles = &i
+	}
+}
+
+// AddedMaxRoles returns the value that was added to the "max_roles" field in this mutation.
+func (m *PlanMutation) AddedMaxRoles() (r int, exists bool) {
+	v := m.addmax_roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxRoles clears the value of the "max_roles" field.
+func (m *PlanMutation) ClearMaxRoles() {
+	m.max_roles = nil
+	m.addmax_roles = nil
+	m.clearedFields[plan.FieldMaxRoles] = struct{}{}
+}
+
+// MaxRolesCleared returns if the "max_roles" field was cleared in this mutation.
+func (m *PlanMutation) MaxRolesCleared() bool {
+	_, ok := m.clearedFields[plan.FieldMaxRoles]
+	return ok
+}
+
+// ResetMaxRoles resets all changes to the "max_roles" field.
+func (m *PlanMutation) ResetMaxRoles() {
+	m.max_roles = nil
+	m.addmax_roles = nil
+	delete(m.clearedFields, plan.FieldMaxRoles)
+}
+
+// SetFeatures sets the "features" field.
+func (m *PlanMutation) SetFeatures(value map[string]bool) {
+	m.features = &value
+}
+
+// Features returns the value of the "features" field in the mutation.
+func (m *PlanMutation) Features() (r map[string]bool, exists bool) {
+	v := m.features
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatures returns the old "features" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldFeatures(ctx context.Context) (v map[string]bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatures: %w", err)
+	}
+	return oldValue.Features, nil
+}
+
+// ResetFeatures resets all changes to the "features" field.
+func (m *PlanMutation) ResetFeatures() {
+	m.features = nil
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *PlanMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *PlanMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *PlanMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by ids.
+func (m *PlanMutation) AddTenantIDs(ids ...int64) {
+	if m.tenants == nil {
+		m.tenants = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.tenants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTenants clears the "tenants" edge to the Tenant entity.
+func (m *PlanMutation) ClearTenants() {
+	m.clearedtenants = true
+}
+
+// TenantsCleared reports if the "tenants" edge to the Tenant entity was cleared.
+func (m *PlanMutation) TenantsCleared() bool {
+	return m.clearedtenants
+}
+
+// RemoveTenantIDs removes the "tenants" edge to the Tenant entity by IDs.
+func (m *PlanMutation) RemoveTenantIDs(ids ...int64) {
+	if m.removedtenants == nil {
+		m.removedtenants = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.tenants, ids[i])
+		m.removedtenants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTenants returns the removed IDs of the "tenants" edge to the Tenant entity.
+func (m *PlanMutation) RemovedTenantsIDs() (ids []int64) {
+	for id := range m.removedtenants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TenantsIDs returns the "tenants" edge IDs in the mutation.
+func (m *PlanMutation) TenantsIDs() (ids []int64) {
+	for id := range m.tenants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTenants resets all changes to the "tenants" edge.
+func (m *PlanMutation) ResetTenants() {
+	m.tenants = nil
+	m.clearedtenants = false
+	m.removedtenants = nil
+}
+
+// Where appends a list predicates to the PlanMutation builder.
+func (m *PlanMutation) Where(ps ...predicate.Plan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Plan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Plan).
+func (m *PlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlanMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.code != nil {
+		fields = append(fields, plan.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, plan.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, plan.FieldDescription)
+	}
+	if m.max_users != nil {
+		fields = append(fields, plan.FieldMaxUsers)
+	}
+	if m.max_departments != nil {
+		fields = append(fields, plan.FieldMaxDepartments)
+	}
+	if m.max_sub_tenants != nil {
+		fields = append(fields, plan.FieldMaxSubTenants)
+	}
+	if m.max_roles != nil {
+		fields = append(fields, plan.FieldMaxRoles)
+	}
+	if m.features != nil {
+		fields = append(fields, plan.FieldFeatures)
+	}
+	if m.is_default != nil {
+		fields = append(fields, plan.FieldIsDefault)
+	}
+	if m.created_at != nil {
+		fields = append(fields, plan.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, plan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldCode:
+		return m.Code()
+	case plan.FieldName:
+		return m.Name()
+	case plan.FieldDescription:
+		return m.Description()
+	case plan.FieldMaxUsers:
+		return m.MaxUsers()
+	case plan.FieldMaxDepartments:
+		return m.MaxDepartments()
+	case plan.FieldMaxSubTenants:
+		return m.MaxSubTenants()
+	case plan.FieldMaxRoles:
+		return m.MaxRoles()
+	case plan.FieldFeatures:
+		return m.Features()
+	case plan.FieldIsDefault:
+		return m.IsDefault()
+	case plan.FieldCreatedAt:
+		return m.CreatedAt()
+	case plan.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case plan.FieldCode:
+		return m.OldCode(ctx)
+	case plan.FieldName:
+		return m.OldName(ctx)
+	case plan.FieldDescription:
+		return m.OldDescription(ctx)
+	case plan.FieldMaxUsers:
+		return m.OldMaxUsers(ctx)
+	case plan.FieldMaxDepartments:
+		return m.OldMaxDepartments(ctx)
+	case plan.FieldMaxSubTenants:
+		return m.OldMaxSubTenants(ctx)
+	case plan.FieldMaxRoles:
+		return m.OldMaxRoles(ctx)
+	case plan.FieldFeatures:
+		return m.OldFeatures(ctx)
+	case plan.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case plan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case plan.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Plan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case plan.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case plan.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case plan.FieldMaxUsers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUsers(v)
+		return nil
+	case plan.FieldMaxDepartments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxDepartments(v)
+		return nil
+	case plan.FieldMaxSubTenants:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSubTenants(v)
+		return nil
+	case plan.FieldMaxRoles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRoles(v)
+		return nil
+	case plan.FieldFeatures:
+		v, ok := value.(map[string]bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatures(v)
+		return nil
+	case plan.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case plan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case plan.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlanMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_users != nil {
+		fields = append(fields, plan.FieldMaxUsers)
+	}
+	if m.addmax_departments != nil {
+		fields = append(fields, plan.FieldMaxDepartments)
+	}
+	if m.addmax_sub_tenants != nil {
+		fields = append(fields, plan.FieldMaxSubTenants)
+	}
+	if m.addmax_roles != nil {
+		fields = append(fields, plan.FieldMaxRoles)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldMaxUsers:
+		return m.AddedMaxUsers()
+	case plan.FieldMaxDepartments:
+		return m.AddedMaxDepartments()
+	case plan.FieldMaxSubTenants:
+		return m.AddedMaxSubTenants()
+	case plan.FieldMaxRoles:
+		return m.AddedMaxRoles()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldMaxUsers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUsers(v)
+		return nil
+	case plan.FieldMaxDepartments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxDepartments(v)
+		return nil
+	case plan.FieldMaxSubTenants:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSubTenants(v)
+		return nil
+	case plan.FieldMaxRoles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRoles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(plan.FieldDescription) {
+		fields = append(fields, plan.FieldDescription)
+	}
+	if m.FieldCleared(plan.FieldMaxUsers) {
+		fields = append(fields, plan.FieldMaxUsers)
+	}
+	if m.FieldCleared(plan.FieldMaxDepartments) {
+		fields = append(fields, plan.FieldMaxDepartments)
+	}
+	if m.FieldCleared(plan.FieldMaxSubTenants) {
+		fields = append(fields, plan.FieldMaxSubTenants)
+	}
+	if m.FieldCleared(plan.FieldMaxRoles) {
+		fields = append(fields, plan.FieldMaxRoles)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlanMutation) ClearField(name string) error {
+	switch name {
+	case plan.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case plan.FieldMaxUsers:
+		m.ClearMaxUsers()
+		return nil
+	case plan.FieldMaxDepartments:
+		m.ClearMaxDepartments()
+		return nil
+	case plan.FieldMaxSubTenants:
+		m.ClearMaxSubTenants()
+		return nil
+	case plan.FieldMaxRoles:
+		m.ClearMaxRoles()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlanMutation) ResetField(name string) error {
+	switch name {
+	case plan.FieldCode:
+		m.ResetCode()
+		return nil
+	case plan.FieldName:
+		m.ResetName()
+		return nil
+	case plan.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case plan.FieldMaxUsers:
+		m.ResetMaxUsers()
+		return nil
+	case plan.FieldMaxDepartments:
+		m.ResetMaxDepartments()
+		return nil
+	case plan.FieldMaxSubTenants:
+		m.ResetMaxSubTenants()
+		return nil
+	case plan.FieldMaxRoles:
+		m.ResetMaxRoles()
+		return nil
+	case plan.FieldFeatures:
+		m.ResetFeatures()
+		return nil
+	case plan.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case plan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case plan.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tenants != nil {
+		edges = append(edges, plan.EdgeTenants)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeTenants:
+		ids := make([]ent.Value, 0, len(m.tenants))
+		for id := range m.tenants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtenants != nil {
+		edges = append(edges, plan.EdgeTenants)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeTenants:
+		ids := make([]ent.Value, 0, len(m.removedtenants))
+		for id := range m.removedtenants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtenants {
+		edges = append(edges, plan.EdgeTenants)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case plan.EdgeTenants:
+		return m.clearedtenants
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlanMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Plan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlanMutation) ResetEdge(name string) error {
+	switch name {
+	case plan.EdgeTenants:
+		m.ResetTenants()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int64
+	code                *string
+	name                *string
+	is_system           *bool
+	description         *string
+	is_active           *bool
+	is_template         *bool
+	data_scope          *role.DataScope
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	user_roles          map[int64]struct{}
+	removeduser_roles   map[int64]struct{}
+	cleareduser_roles   bool
+	tenant              *int64
+	clearedtenant       bool
+	inherited_by        map[int64]struct{}
+	removedinherited_by map[int64]struct{}
+	clearedinherited_by bool
+	inherits            map[int64]struct{}
+	removedinherits     map[int64]struct{}
+	clearedinherits     bool
+	done                bool
+	oldValue            func(context.Context) (*Role, error)
+	predicates          []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int64) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Role entities.
+func (m *RoleMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *RoleMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *RoleMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *RoleMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RoleMutation) SetTenantID(i int64) {
+	m.tenant = &i
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RoleMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldTenantID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *RoleMutation) ClearTenantID() {
+	m.tenant = nil
+	m.clearedFields[role.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *RoleMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[role.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RoleMutation) ResetTenantID() {
+	m.tenant = nil
+	delete(m.clearedFields, role.FieldTenantID)
+}
+
+// SetIsSystem sets the "is_system" field.
+func (m *RoleMutation) SetIsSystem(b bool) {
+	m.is_system = &b
+}
+
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *RoleMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSystem returns the old "is_system" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+	}
+	return oldValue.IsSystem, nil
+}
+
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *RoleMutation) ResetIsSystem() {
+	m.is_system = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[role.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[role.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, role.FieldDescription)
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *RoleMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *RoleMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *RoleMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetIsTemplate sets the "is_template" field.
+func (m *RoleMutation) SetIsTemplate(b bool) {
+	m.is_template = &b
+}
+
+// IsTemplate returns the value of the "is_template" field in the mutation.
+func (m *RoleMutation) IsTemplate() (r bool, exists bool) {
+	v := m.is_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTemplate returns the old "is_template" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldIsTemplate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTemplate: %w", err)
+	}
+	return oldValue.IsTemplate, nil
+}
+
+// ResetIsTemplate resets all changes to the "is_template" field.
+func (m *RoleMutation) ResetIsTemplate() {
+	m.is_template = nil
+}
+
+// SetDataScope sets the "data_scope" field.
+func (m *RoleMutation) SetDataScope(rs role.DataScope) {
+	m.data_scope = &rs
+}
+
+// DataScope returns the value of the "data_scope" field in the mutation.
+func (m *RoleMutation) DataScope() (r role.DataScope, exists bool) {
+	v := m.data_scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataScope returns the old "data_scope" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDataScope(ctx context.Context) (v role.DataScope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataScope: %w", err)
+	}
+	return oldValue.DataScope, nil
+}
+
+// ResetDataScope resets all changes to the "data_scope" field.
+func (m *RoleMutation) ResetDataScope() {
+	m.data_scope = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
+func (m *RoleMutation) AddUserRoleIDs(ids ...int64) {
+	if m.user_roles == nil {
+		m.user_roles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
+func (m *RoleMutation) ClearUserRoles() {
+	m.cleareduser_roles = true
+}
+
+// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
+func (m *RoleMutation) UserRolesCleared() bool {
+	return m.cleareduser_roles
+}
+
+// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
+func (m *RoleMutation) RemoveUserRoleIDs(ids ...int64) {
+	if m.removeduser_roles == nil {
+		m.removeduser_roles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.user_roles, ids[i])
+		m.removeduser_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
+func (m *RoleMutation) RemovedUserRolesIDs() (ids []int64) {
+	for id := range m.removeduser_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
+func (m *RoleMutation) UserRolesIDs() (ids []int64) {
+	for id := range m.user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserRoles resets all changes to the "user_roles" edge.
+func (m *RoleMutation) ResetUserRoles() {
+	m.user_roles = nil
+	m.cleareduser_roles = false
+	m.removeduser_roles = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *RoleMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[role.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *RoleMutation) TenantCleared() bool {
+	return m.TenantIDCleared() || m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *RoleMutation) TenantIDs() (ids []int64) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *RoleMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddInheritedByIDs adds the "inherited_by" edge to the Role entity by ids.
+func (m *RoleMutation) AddInheritedByIDs(ids ...int64) {
+	if m.inherited_by == nil {
+		m.inherited_by = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.inherited_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInheritedBy clears the "inherited_by" edge to the Role entity.
+func (m *RoleMutation) ClearInheritedBy() {
+	m.clearedinherited_by = true
+}
+
+// InheritedByCleared reports if the "inherited_by" edge to the Role entity was cleared.
+func (m *RoleMutation) InheritedByCleared() bool {
+	return m.clearedinherited_by
+}
+
+// RemoveInheritedByIDs removes the "inherited_by" edge to the Role entity by IDs.
+func (m *RoleMutation) RemoveInheritedByIDs(ids ...int64) {
+	if m.removedinherited_by == nil {
+		m.removedinherited_by = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.inherited_by, ids[i])
+		m.removedinherited_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInheritedBy returns the removed IDs of the "inherited_by" edge to the Role entity.
+func (m *RoleMutation) RemovedInheritedByIDs() (ids []int64) {
+	for id := range m.removedinherited_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InheritedByIDs returns the "inherited_by" edge IDs in the mutation.
+func (m *RoleMutation) InheritedByIDs() (ids []int64) {
+	for id := range m.inherited_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInheritedBy resets all changes to the "inherited_by" edge.
+func (m *RoleMutation) ResetInheritedBy() {
+	m.inherited_by = nil
+	m.clearedinherited_by = false
+	m.removedinherited_by = nil
+}
+
+// AddInheritIDs adds the "inherits" edge to the Role entity by ids.
+func (m *RoleMutation) AddInheritIDs(ids ...int64) {
+	if m.inherits == nil {
+		m.inherits = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.inherits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInherits clears the "inherits" edge to the Role entity.
+func (m *RoleMutation) ClearInherits() {
+	m.clearedinherits = true
+}
+
+// InheritsCleared reports if the "inherits" edge to the Role entity was cleared.
+func (m *RoleMutation) InheritsCleared() bool {
+	return m.clearedinherits
+}
+
+// RemoveInheritIDs removes the "inherits" edge to the Role entity by IDs.
+func (m *RoleMutation) RemoveInheritIDs(ids ...int64) {
+	if m.removedinherits == nil {
+		m.removedinherits = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.inherits, ids[i])
+		m.removedinherits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInherits returns the removed IDs of the "inherits" edge to the Role entity.
+func (m *RoleMutation) RemovedInheritsIDs() (ids []int64) {
+	for id := range m.removedinherits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InheritsIDs returns the "inherits" edge IDs in the mutation.
+func (m *RoleMutation) InheritsIDs() (ids []int64) {
+	for id := range m.inherits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInherits resets all changes to the "inherits" edge.
+func (m *RoleMutation) ResetInherits() {
+	m.inherits = nil
+	m.clearedinherits = false
+	m.removedinherits = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.code != nil {
+		fields = append(fields, role.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.tenant != nil {
+		fields = append(fields, role.FieldTenantID)
+	}
+	if m.is_system != nil {
+		fields = append(fields, role.FieldIsSystem)
+	}
+	if m.description != nil {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.is_active != nil {
+		fields = append(fields, role.FieldIsActive)
+	}
+	if m.is_template != nil {
+		fields = append(fields, role.FieldIsTemplate)
+	}
+	if m.data_scope != nil {
+		fields = append(fields, role.FieldDataScope)
+	}
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldCode:
+		return m.Code()
+	case role.FieldName:
+		return m.Name()
+	case role.FieldTenantID:
+		return m.TenantID()
+	case role.FieldIsSystem:
+		return m.IsSystem()
+	case role.FieldDescription:
+		return m.Description()
+	case role.FieldIsActive:
+		return m.IsActive()
+	case role.FieldIsTemplate:
+		return m.IsTemplate()
+	case role.FieldDataScope:
+		return m.DataScope()
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldCode:
+		return m.OldCode(ctx)
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case role.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case role.FieldDescription:
+		return m.OldDescription(ctx)
+	case role.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case role.FieldIsTemplate:
+		return m.OldIsTemplate(ctx)
+	case role.FieldDataScope:
+		return m.OldDataScope(ctx)
+	case role.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case role.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case role.FieldIsSystem:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSystem(v)
+		return nil
+	case role.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case role.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case role.FieldIsTemplate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsTemplate(v)
+		return nil
+	case role.FieldDataScope:
+		v, ok := value.(role.DataScope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataScope(v)
+		return nil
+	case role.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case role.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(role.FieldTenantID) {
+		fields = append(fields, role.FieldTenantID)
+	}
+	if m.FieldCleared(role.FieldDescription) {
+		fields = append(fields, role.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	switch name {
+	case role.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case role.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldCode:
+		m.ResetCode()
+		return nil
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case role.FieldIsSystem:
+		m.ResetIsSystem()
+		return nil
+	case role.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case role.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case role.FieldIsTemplate:
+		m.ResetIsTemplate()
+		return nil
+	case role.FieldDataScope:
+		m.ResetDataScope()
+		return nil
+	case role.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case role.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user_roles != nil {
+		edges = append(edges, role.EdgeUserRoles)
+	}
+	if m.tenant != nil {
+		edges = append(edges, role.EdgeTenant)
+	}
+	if m.inherited_by != nil {
+		edges = append(edges, role.EdgeInheritedBy)
+	}
+	if m.inherits != nil {
+		edges = append(edges, role.EdgeInherits)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.user_roles))
+		for id := range m.user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case role.EdgeInheritedBy:
+		ids := make([]ent.Value, 0, len(m.inherited_by))
+		for id := range m.inherited_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeInherits:
+		ids := make([]ent.Value, 0, len(m.inherits))
+		for id := range m.inherits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removeduser_roles != nil {
+		edges = append(edges, role.EdgeUserRoles)
+	}
+	if m.removedinherited_by != nil {
+		edges = append(edges, role.EdgeInheritedBy)
+	}
+	if m.removedinherits != nil {
+		edges = append(edges, role.EdgeInherits)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.removeduser_roles))
+		for id := range m.removeduser_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeInheritedBy:
+		ids := make([]ent.Value, 0, len(m.removedinherited_by))
+		for id := range m.removedinherited_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeInherits:
+		ids := make([]ent.Value, 0, len(m.removedinherits))
+		for id := range m.removedinherits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser_roles {
+		edges = append(edges, role.EdgeUserRoles)
+	}
+	if m.clearedtenant {
+		edges = append(edges, role.EdgeTenant)
+	}
+	if m.clearedinherited_by {
+		edges = append(edges, role.EdgeInheritedBy)
+	}
+	if m.clearedinherits {
+		edges = append(edges, role.EdgeInherits)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgeUserRoles:
+		return m.cleareduser_roles
+	case role.EdgeTenant:
+		return m.clearedtenant
+	case role.EdgeInheritedBy:
+		return m.clearedinherited_by
+	case role.EdgeInherits:
+		return m.clearedinherits
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	case role.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgeUserRoles:
+		m.ResetUserRoles()
+		return nil
+	case role.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case role.EdgeInheritedBy:
+		m.ResetInheritedBy()
+		return nil
+	case role.EdgeInherits:
+		m.ResetInherits()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
+type TenantMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int64
+	name                   *string
+	owner_id               *int64
+	addowner_id            *int64
+	_type                  *tenant.Type
+	_path                  *string
+	level                  *int32
+	addlevel               *int32
+	status                 *tenant.Status
+	expired_at             *time.Time
+	expiry_notified_at     *time.Time
+	attributes             *map[string]interface{}
+	created_by             *int64
+	addcreated_by          *int64
+	updated_by             *int64
+	addupdated_by          *int64
+	created_at             *time.Time
+	updated_at             *time.Time
+	deleted_at             *time.Time
+	clearedFields          map[string]struct{}
+	user_tenants           map[int]struct{}
+	removeduser_tenants    map[int]struct{}
+	cleareduser_tenants    bool
+	departments            map[int64]struct{}
+	removeddepartments     map[int64]struct{}
+	cleareddepartments     bool
+	parent                 *int64
+	clearedparent          bool
+	children               map[int64]struct{}
+	removedchildren        map[int64]struct{}
+	clearedchildren        bool
+	roles                  map[int64]struct{}
+	removedroles           map[int64]struct{}
+	clearedroles           bool
+	user_roles             map[int64]struct{}
+	removeduser_roles      map[int64]struct{}
+	cleareduser_roles      bool
+	status_logs            map[int64]struct{}
+	removedstatus_logs     map[int64]struct{}
+	clearedstatus_logs     bool
+	invitations            map[int64]struct{}
+	removedinvitations     map[int64]struct{}
+	clearedinvitations     bool
+	settings               map[int64]struct{}
+	removedsettings        map[int64]struct{}
+	clearedsettings        bool
+	setting_logs           map[int64]struct{}
+	removedsetting_logs    map[int64]struct{}
+	clearedsetting_logs    bool
+	access_policies        map[int64]struct{}
+	removedaccess_policies map[int64]struct{}
+	clearedaccess_policies bool
+	plan                   *int64
+	clearedplan            bool
+	done                   bool
+	oldValue               func(context.Context) (*Tenant, error)
+	predicates             []predicate.Tenant
+}
+
+var _ ent.Mutation = (*TenantMutation)(nil)
+
+// tenantOption allows management of the mutation configuration using functional options.
+type tenantOption func(*TenantMutation)
+
+// newTenantMutation creates new mutation for the Tenant entity.
+func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
+	m := &TenantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantID sets the ID field of the mutation.
+func withTenantID(id int64) tenantOption {
+	return func(m *TenantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tenant
+		)
+		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tenant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenant sets the old Tenant of the mutation.
+func withTenant(node *Tenant) tenantOption {
+	return func(m *TenantMutation) {
+		m.oldValue = func(context.Context) (*Tenant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tenant entities.
+func (m *TenantMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TenantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TenantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TenantMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *TenantMutation) SetOwnerID(i int64) {
+	m.owner_id = &i
+	m.addowner_id = nil
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *TenantMutation) OwnerID() (r int64, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldOwnerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (m *TenantMutation) AddOwnerID(i int64) {
+	if m.addowner_id != nil {
+		*m.addowner_id += i
+	} else {
+		m.addowner_id = &i
+	}
+}
+
+// AddedOwnerID returns the value that was added to the "owner_id" field in this mutation.
+func (m *TenantMutation) AddedOwnerID() (r int64, exists bool) {
+	v := m.addowner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *TenantMutation) ResetOwnerID() {
+	m.owner_id = nil
+	m.addowner_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *TenantMutation) SetType(t tenant.Type) {
+	m._type = &t
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TenantMutation) GetType() (r tenant.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldType(ctx context.Context) (v tenant.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TenantMutation) ResetType() {
+	m._type = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TenantMutation) SetParentID(i int64) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TenantMutation) ParentID() (r int64, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldParentID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TenantMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[tenant.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TenantMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TenantMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, tenant.FieldParentID)
+}
+
+// SetPath sets the "path" field.
+func (m *TenantMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *TenantMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldPath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *TenantMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[tenant.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *TenantMutation) PathCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *TenantMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, tenant.FieldPath)
+}
+
+// SetLevel sets the "level" field.
+func (m *TenantMutation) SetLevel(i int32) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *TenantMutation) Level() (r int32, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldLevel(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *TenantMutation) AddLevel(i int32) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *TenantMutation) AddedLevel() (r int32, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *TenantMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TenantMutation) SetStatus(t tenant.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TenantMutation) Status() (r tenant.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldStatus(ctx context.Context) (v tenant.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TenantMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (m *TenantMutation) SetExpiredAt(t time.Time) {
+	m.expired_at = &t
+}
+
+// ExpiredAt returns the value of the "expired_at" field in the mutation.
+func (m *TenantMutation) ExpiredAt() (r time.Time, exists bool) {
+	v := m.expired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiredAt returns the old "expired_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldExpiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+	}
+	return oldValue.ExpiredAt, nil
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (m *TenantMutation) ClearExpiredAt() {
+	m.expired_at = nil
+	m.clearedFields[tenant.FieldExpiredAt] = struct{}{}
+}
+
+// ExpiredAtCleared returns if the "expired_at" field was cleared in this mutation.
+func (m *TenantMutation) ExpiredAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldExpiredAt]
+	return ok
+}
+
+// ResetExpiredAt resets all changes to the "expired_at" field.
+func (m *TenantMutation) ResetExpiredAt() {
+	m.expired_at = nil
+	delete(m.clearedFields, tenant.FieldExpiredAt)
+}
+
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (m *TenantMutation) SetExpiryNotifiedAt(t time.Time) {
+	m.expiry_notified_at = &t
+}
+
+// ExpiryNotifiedAt returns the value of the "expiry_notified_at" field in the mutation.
+func (m *TenantMutation) ExpiryNotifiedAt() (r time.Time, exists bool) {
+	v := m.expiry_notified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryNotifiedAt returns the old "expiry_notified_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldExpiryNotifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryNotifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryNotifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryNotifiedAt: %w", err)
+	}
+	return oldValue.ExpiryNotifiedAt, nil
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (m *TenantMutation) ClearExpiryNotifiedAt() {
+	m.expiry_notified_at = nil
+	m.clearedFields[tenant.FieldExpiryNotifiedAt] = struct{}{}
+}
+
+// ExpiryNotifiedAtCleared returns if the "expiry_notified_at" field was cleared in this mutation.
+func (m *TenantMutation) ExpiryNotifiedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldExpiryNotifiedAt]
+	return ok
+}
+
+// ResetExpiryNotifiedAt resets all changes to the "expiry_notified_at" field.
+func (m *TenantMutation) ResetExpiryNotifiedAt() {
+	m.expiry_notified_at = nil
+	delete(m.clearedFields, tenant.FieldExpiryNotifiedAt)
+}
+
+// SetAttributes sets the "attributes" field.
+func (m *TenantMutation) SetAttributes(value map[string]interface{}) {
+	m.attributes = &value
+}
+
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *TenantMutation) Attributes() (r map[string]interface{}, exists bool) {
+	v := m.attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributes returns the old "attributes" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldAttributes(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+	}
+	return oldValue.Attributes, nil
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *TenantMutation) ResetAttributes() {
+	m.attributes = nil
+}
+
+// SetPlanID sets the "plan_id" field.
+func (m *TenantMutation) SetPlanID(i int64) {
+	m.plan = &i
+}
+
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *TenantMutation) PlanID() (r int64, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlanID returns the old "plan_id" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldPlanID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+	}
+	return oldValue.PlanID, nil
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (m *TenantMutation) ClearPlanID() {
+	m.plan = nil
+	m.clearedFields[tenant.FieldPlanID] = struct{}{}
+}
+
+// PlanIDCleared returns if the "plan_id" field was cleared in this mutation.
+func (m *TenantMutation) PlanIDCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldPlanID]
+	return ok
+}
+
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *TenantMutation) ResetPlanID() {
+	m.plan = nil
+	delete(m.clearedFields, tenant.FieldPlanID)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *TenantMutation) SetCreatedBy(i int64) {
+	m.created_by = &i
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TenantMutation) CreatedBy() (r int64, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCreatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (m *TenantMutation) AddCreatedBy(i int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *TenantMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *TenantMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[tenant.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *TenantMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TenantMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, tenant.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *TenantMutation) SetUpdatedBy(i int64) {
+	m.updated_by = &i
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *TenantMutation) UpdatedBy() (r int64, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldUpdatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (m *TenantMutation) AddUpdatedBy(i int64) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += i
+	} else {
+		m.addupdated_by = &i
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *TenantMutation) AddedUpdatedBy() (r int64, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *TenantMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[tenant.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *TenantMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *TenantMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, tenant.FieldUpdatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TenantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TenantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TenantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TenantMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TenantMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TenantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TenantMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TenantMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TenantMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[tenant.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TenantMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TenantMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, tenant.FieldDeletedAt)
+}
+
+// AddUserTenantIDs adds the "user_tenants" edge to the UserTenant entity by ids.
+func (m *TenantMutation) AddUserTenantIDs(ids ...int) {
+	if m.user_tenants == nil {
+		m.user_tenants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_tenants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserTenants clears the "user_tenants" edge to the UserTenant entity.
+func (m *TenantMutation) ClearUserTenants() {
+	m.cleareduser_tenants = true
+}
+
+// UserTenantsCleared reports if the "user_tenants" edge to the UserTenant entity was cleared.
+func (m *TenantMutation) UserTenantsCleared() bool {
+	return m.cleareduser_tenants
+}
+
+// RemoveUserTenantIDs removes the "user_tenants" edge to the UserTenant entity by IDs.
+func (m *TenantMutation) RemoveUserTenantIDs(ids ...int) {
+	if m.removeduser_tenants == nil {
+		m.removeduser_tenants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_tenants, ids[i])
+		m.removeduser_tenants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserTenants returns the removed IDs of the "user_tenants" edge to the UserTenant entity.
+func (m *TenantMutation) RemovedUserTenantsIDs() (ids []int) {
+	for id := range m.removeduser_tenants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserTenantsIDs returns the "user_tenants" edge IDs in the mutation.
+func (m *TenantMutation) UserTenantsIDs() (ids []int) {
+	for id := range m.user_tenants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserTenants resets all changes to the "user_tenants" edge.
+func (m *TenantMutation) ResetUserTenants() {
+	m.user_tenants = nil
+	m.cleareduser_tenants = false
+	m.removeduser_tenants = nil
+}
+
+// AddDepartmentIDs adds the "departments" edge to the Department entity by ids.
+func (m *TenantMutation) AddDepartmentIDs(ids ...int64) {
+	if m.departments == nil {
+		m.departments = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.departments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDepartments clears the "departments" edge to the Department entity.
+func (m *TenantMutation) ClearDepartments() {
+	m.cleareddepartments = true
+}
+
+// DepartmentsCleared reports if the "departments" edge to the Department entity was cleared.
+func (m *TenantMutation) DepartmentsCleared() bool {
+	return m.cleareddepartments
+}
+
+// RemoveDepartmentIDs removes the "departments" edge to the Department entity by IDs.
+func (m *TenantMutation) RemoveDepartmentIDs(ids ...int64) {
+	if m.removeddepartments == nil {
+		m.removeddepartments = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.departments, ids[i])
+		m.removeddepartments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDepartments returns the removed IDs of the "departments" edge to the Department entity.
+func (m *TenantMutation) RemovedDepartmentsIDs() (ids []int64) {
+	for id := range m.removeddepartments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DepartmentsIDs returns the "departments" edge IDs in the mutation.
+func (m *TenantMutation) DepartmentsIDs() (ids []int64) {
+	for id := range m.departments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDepartments resets all changes to the "departments" edge.
+func (m *TenantMutation) ResetDepartments() {
+	m.departments = nil
+	m.cleareddepartments = false
+	m.removeddepartments = nil
+}
+
+// ClearParent clears the "parent" edge to the Tenant entity.
+func (m *TenantMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[tenant.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Tenant entity was cleared.
+func (m *TenantMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TenantMutation) ParentIDs() (ids []int64) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TenantMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Tenant entity by ids.
+func (m *TenantMutation) AddChildIDs(ids ...int64) {
+	if m.children == nil {
+		m.children = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Tenant entity.
+func (m *TenantMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Tenant entity was cleared.
+func (m *TenantMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Tenant entity by IDs.
+func (m *TenantMutation) RemoveChildIDs(ids ...int64) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Tenant entity.
+func (m *TenantMutation) RemovedChildrenIDs() (ids []int64) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *TenantMutation) ChildrenIDs() (ids []int64) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *TenantMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *TenantMutation) AddRoleIDs(ids ...int64) {
+	if m.roles == nil {
+		m.roles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *TenantMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *TenantMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *TenantMutation) RemoveRoleIDs(ids ...int64) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *TenantMutation) RemovedRolesIDs() (ids []int64) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *TenantMutation) RolesIDs() (ids []int64) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *TenantMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
+func (m *TenantMutation) AddUserRoleIDs(ids ...int64) {
+	if m.user_roles == nil {
+		m.user_roles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
+func (m *TenantMutation) ClearUserRoles() {
+	m.cleareduser_roles = true
+}
+
+// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
+func (m *TenantMutation) UserRolesCleared() bool {
+	return m.cleareduser_roles
+}
+
+// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
+func (m *TenantMutation) RemoveUserRoleIDs(ids ...int64) {
+	if m.removeduser_roles == nil {
+		m.removeduser_roles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.user_roles, ids[i])
+		m.removeduser_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
+func (m *TenantMutation) RemovedUserRolesIDs() (ids []int64) {
+	for id := range m.removeduser_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
+func (m *TenantMutation) UserRolesIDs() (ids []int64) {
+	for id := range m.user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserRoles resets all changes to the "user_roles" edge.
+func (m *TenantMutation) ResetUserRoles() {
+	m.user_roles = nil
+	m.cleareduser_roles = false
+	m.removeduser_roles = nil
+}
+
+// AddStatusLogIDs adds the "status_logs" edge to the TenantStatusLog entity by ids.
+func (m *TenantMutation) AddStatusLogIDs(ids ...int64) {
+	if m.status_logs == nil {
+		m.status_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.status_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatusLogs clears the "status_logs" edge to the TenantStatusLog entity.
+func (m *TenantMutation) ClearStatusLogs() {
+	m.clearedstatus_logs = true
+}
+
+// StatusLogsCleared reports if the "status_logs" edge to the TenantStatusLog entity was cleared.
+func (m *TenantMutation) StatusLogsCleared() bool {
+	return m.clearedstatus_logs
+}
+
+// RemoveStatusLogIDs removes the "status_logs" edge to the TenantStatusLog entity by IDs.
+func (m *TenantMutation) RemoveStatusLogIDs(ids ...int64) {
+	if m.removedstatus_logs == nil {
+		m.removedstatus_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.status_logs, ids[i])
+		m.removedstatus_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatusLogs returns the removed IDs of the "status_logs" edge to the TenantStatusLog entity.
+func (m *TenantMutation) RemovedStatusLogsIDs() (ids []int64) {
+	for id := range m.removedstatus_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatusLogsIDs returns the "status_logs" edge IDs in the mutation.
+func (m *TenantMutation) StatusLogsIDs() (ids []int64) {
+	for id := range m.status_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatusLogs resets all changes to the "status_logs" edge.
+func (m *TenantMutation) ResetStatusLogs() {
+	m.status_logs = nil
+	m.clearedstatus_logs = false
+	m.removedstatus_logs = nil
+}
+
+// AddInvitationIDs adds the "invitations" edge to the TenantInvitation entity by ids.
+func (m *TenantMutation) AddInvitationIDs(ids ...int64) {
+	if m.invitations == nil {
+		m.invitations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvitations clears the "invitations" edge to the TenantInvitation entity.
+func (m *TenantMutation) ClearInvitations() {
+	m.clearedinvitations = true
+}
+
+// InvitationsCleared reports if the "invitations" edge to the TenantInvitation entity was cleared.
+func (m *TenantMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to the TenantInvitation entity by IDs.
+func (m *TenantMutation) RemoveInvitationIDs(ids ...int64) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.invitations, ids[i])
+		m.removedinvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the TenantInvitation entity.
+func (m *TenantMutation) RemovedInvitationsIDs() (ids []int64) {
+	for id := range m.removedinvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *TenantMutation) InvitationsIDs() (ids []int64) {
+	for id := range m.invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *TenantMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
+}
+
+// AddSettingIDs adds the "settings" edge to the TenantSetting entity by ids.
+func (m *TenantMutation) AddSettingIDs(ids ...int64) {
+	if m.settings == nil {
+		m.settings = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.settings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSettings clears the "settings" edge to the TenantSetting entity.
+func (m *TenantMutation) ClearSettings() {
+	m.clearedsettings = true
+}
+
+// SettingsCleared reports if the "settings" edge to the TenantSetting entity was cleared.
+func (m *TenantMutation) SettingsCleared() bool {
+	return m.clearedsettings
+}
+
+// RemoveSettingIDs removes the "settings" edge to the TenantSetting entity by IDs.
+func (m *TenantMutation) RemoveSettingIDs(ids ...int64) {
+	if m.removedsettings == nil {
+		m.removedsettings = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.settings, ids[i])
+		m.removedsettings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSettings returns the removed IDs of the "settings" edge to the TenantSetting entity.
+func (m *TenantMutation) RemovedSettingsIDs() (ids []int64) {
+	for id := range m.removedsettings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SettingsIDs returns the "settings" edge IDs in the mutation.
+func (m *TenantMutation) SettingsIDs() (ids []int64) {
+	for id := range m.settings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSettings resets all changes to the "settings" edge.
+func (m *TenantMutation) ResetSettings() {
+	m.settings = nil
+	m.clearedsettings = false
+	m.removedsettings = nil
+}
+
+// AddSettingLogIDs adds the "setting_logs" edge to the TenantSettingLog entity by ids.
+func (m *TenantMutation) AddSettingLogIDs(ids ...int64) {
+	if m.setting_logs == nil {
+		m.setting_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.setting_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSettingLogs clears the "setting_logs" edge to the TenantSettingLog entity.
+func (m *TenantMutation) ClearSettingLogs() {
+	m.clearedsetting_logs = true
+}
+
+// SettingLogsCleared reports if the "setting_logs" edge to the TenantSettingLog entity was cleared.
+func (m *TenantMutation) SettingLogsCleared() bool {
+	return m.clearedsetting_logs
+}
+
+// RemoveSettingLogIDs removes the "setting_logs" edge to the TenantSettingLog entity by IDs.
+func (m *TenantMutation) RemoveSettingLogIDs(ids ...int64) {
+	if m.removedsetting_logs == nil {
+		m.removedsetting_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.setting_logs, ids[i])
+		m.removedsetting_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSettingLogs returns the removed IDs of the "setting_logs" edge to the TenantSettingLog entity.
+func (m *TenantMutation) RemovedSettingLogsIDs() (ids []int64) {
+	for id := range m.removedsetting_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SettingLogsIDs returns the "setting_logs" edge IDs in the mutation.
+func (m *TenantMutation) SettingLogsIDs() (ids []int64) {
+	for id := range m.setting_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSettingLogs resets all changes to the "setting_logs" edge.
+func (m *TenantMutation) ResetSettingLogs() {
+	m.setting_logs = nil
+	m.clearedsetting_logs = false
+	m.removedsetting_logs = nil
+}
+
+// AddAccessPolicyIDs adds the "access_policies" edge to the AccessPolicy entity by ids.
+func (m *TenantMutation) AddAccessPolicyIDs(ids ...int64) {
+	if m.access_policies == nil {
+		m.access_policies = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.access_policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccessPolicies clears the "access_policies" edge to the AccessPolicy entity.
+func (m *TenantMutation) ClearAccessPolicies() {
+	m.clearedaccess_policies = true
+}
+
+// AccessPoliciesCleared reports if the "access_policies" edge to the AccessPolicy entity was cleared.
+func (m *TenantMutation) AccessPoliciesCleared() bool {
+	return m.clearedaccess_policies
+}
+
+// RemoveAccessPolicyIDs removes the "access_policies" edge to the AccessPolicy entity by IDs.
+func (m *TenantMutation) RemoveAccessPolicyIDs(ids ...int64) {
+	if m.removedaccess_policies == nil {
+		m.removedaccess_policies = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.access_policies, ids[i])
+		m.removedaccess_policies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccessPolicies returns the removed IDs of the "access_policies" edge to the AccessPolicy entity.
+func (m *TenantMutation) RemovedAccessPoliciesIDs() (ids []int64) {
+	for id := range m.removedaccess_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccessPoliciesIDs returns the "access_policies" edge IDs in the mutation.
+func (m *TenantMutation) AccessPoliciesIDs() (ids []int64) {
+	for id := range m.access_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccessPolicies resets all changes to the "access_policies" edge.
+func (m *TenantMutation) ResetAccessPolicies() {
+	m.access_policies = nil
+	m.clearedaccess_policies = false
+	m.removedaccess_policies = nil
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *TenantMutation) ClearPlan() {
+	m.clearedplan = true
+	m.clearedFields[tenant.FieldPlanID] = struct{}{}
+}
+
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *TenantMutation) PlanCleared() bool {
+	return m.PlanIDCleared() || m.clearedplan
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *TenantMutation) PlanIDs() (ids []int64) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlan resets all changes to the "plan" edge.
+func (m *TenantMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
+}
+
+// Where appends a list predicates to the TenantMutation builder.
+func (m *TenantMutation) Where(ps ...predicate.Tenant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tenant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TenantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TenantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tenant).
+func (m *TenantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TenantMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.name != nil {
+		fields = append(fields, tenant.FieldName)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, tenant.FieldOwnerID)
+	}
+	if m._type != nil {
+		fields = append(fields, tenant.FieldType)
+	}
+	if m.parent != nil {
+		fields = append(fields, tenant.FieldParentID)
+	}
+	if m._path != nil {
+		fields = append(fields, tenant.FieldPath)
+	}
+	if m.level != nil {
+		fields = append(fields, tenant.FieldLevel)
+	}
+	if m.status != nil {
+		fields = append(fields, tenant.FieldStatus)
+	}
+	if m.expired_at != nil {
+		fields = append(fields, tenant.FieldExpiredAt)
+	}
+	if m.expiry_notified_at != nil {
+		fields = append(fields, tenant.FieldExpiryNotifiedAt)
+	}
+	if m.attributes != nil {
+		fields = append(fields, tenant.FieldAttributes)
+	}
+	if m.plan != nil {
+		fields = append(fields, tenant.FieldPlanID)
+	}
+	if m.created_by != nil {
+		fields = append(fields, tenant.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, tenant.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tenant.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tenant.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, tenant.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TenantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tenant.FieldName:
+		return m.Name()
+	case tenant.FieldOwnerID:
+		return m.OwnerID()
+	case tenant.FieldType:
+		return m.GetType()
+	case tenant.FieldParentID:
+		return m.ParentID()
+	case tenant.FieldPath:
+		return m.Path()
+	case tenant.FieldLevel:
+		return m.Level()
+	case tenant.FieldStatus:
+		return m.Status()
+	case tenant.FieldExpiredAt:
+		return m.ExpiredAt()
+	case tenant.FieldExpiryNotifiedAt:
+		return m.ExpiryNotifiedAt()
+	case tenant.FieldAttributes:
+		return m.Attributes()
+	case tenant.FieldPlanID:
+		return m.PlanID()
+	case tenant.FieldCreatedBy:
+		return m.CreatedBy()
+	case tenant.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case tenant.FieldCreatedAt:
+		return m.CreatedAt()
+	case tenant.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case tenant.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tenant.FieldName:
+		return m.OldName(ctx)
+	case tenant.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case tenant.FieldType:
+		return m.OldType(ctx)
+	case tenant.FieldParentID:
+		return m.OldParentID(ctx)
+	case tenant.FieldPath:
+		return m.OldPath(ctx)
+	case tenant.FieldLevel:
+		return m.OldLevel(ctx)
+	case tenant.FieldStatus:
+		return m.OldStatus(ctx)
+	case tenant.FieldExpiredAt:
+		return m.OldExpiredAt(ctx)
+	case tenant.FieldExpiryNotifiedAt:
+		return m.OldExpiryNotifiedAt(ctx)
+	case tenant.FieldAttributes:
+		return m.OldAttributes(ctx)
+	case tenant.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case tenant.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case tenant.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case tenant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tenant.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case tenant.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tenant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tenant.FieldOwnerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case tenant.FieldType:
+		v, ok := value.(tenant.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case tenant.FieldParentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case tenant.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case tenant.FieldLevel:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case tenant.FieldStatus:
+		v, ok := value.(tenant.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tenant.FieldExpiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiredAt(v)
+		return nil
+	case tenant.FieldExpiryNotifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryNotifiedAt(v)
+		return nil
+	case tenant.FieldAttributes:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributes(v)
+		return nil
+	case tenant.FieldPlanID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlanID(v)
+		return nil
+	case tenant.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case tenant.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case tenant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tenant.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case tenant.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TenantMutation) AddedFields() []string {
+	var fields []string
+	if m.addowner_id != nil {
+		fields = append(fields, tenant.FieldOwnerID)
+	}
+	if m.addlevel != nil {
+		fields = append(fields, tenant.FieldLevel)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, tenant.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, tenant.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tenant.FieldOwnerID:
+		return m.AddedOwnerID()
+	case tenant.FieldLevel:
+		return m.AddedLevel()
+	case tenant.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case tenant.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tenant.FieldOwnerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwnerID(v)
+		return nil
+	case tenant.FieldLevel:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case tenant.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case tenant.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tenant.FieldParentID) {
+		fields = append(fields, tenant.FieldParentID)
+	}
+	if m.FieldCleared(tenant.FieldPath) {
+		fields = append(fields, tenant.FieldPath)
+	}
+	if m.FieldCleared(tenant.FieldExpiredAt) {
+		fields = append(fields, tenant.FieldExpiredAt)
+	}
+	if m.FieldCleared(tenant.FieldExpiryNotifiedAt) {
+		fields = append(fields, tenant.FieldExpiryNotifiedAt)
+	}
+	if m.FieldCleared(tenant.FieldPlanID) {
+		fields = append(fields, tenant.FieldPlanID)
+	}
+	if m.FieldCleared(tenant.FieldCreatedBy) {
+		fields = append(fields, tenant.FieldCreatedBy)
+	}
+	if m.FieldCleared(tenant.FieldUpdatedBy) {
+		fields = append(fields, tenant.FieldUpdatedBy)
+	}
+	if m.FieldCleared(tenant.FieldDeletedAt) {
+		fields = append(fields, tenant.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TenantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TenantMutation) ClearField(name string) error {
+	switch name {
+	case tenant.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case tenant.FieldPath:
+		m.ClearPath()
+		return nil
+	case tenant.FieldExpiredAt:
+		m.ClearExpiredAt()
+		return nil
+	case tenant.FieldExpiryNotifiedAt:
+		m.ClearExpiryNotifiedAt()
+		return nil
+	case tenant.FieldPlanID:
+		m.ClearPlanID()
+		return nil
+	case tenant.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case tenant.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	case tenant.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TenantMutation) ResetField(name string) error {
+	switch name {
+	case tenant.FieldName:
+		m.ResetName()
+		return nil
+	case tenant.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case tenant.FieldType:
+		m.ResetType()
+		return nil
+	case tenant.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case tenant.FieldPath:
+		m.ResetPath()
+		return nil
+	case tenant.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case tenant.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tenant.FieldExpiredAt:
+		m.ResetExpiredAt()
+		return nil
+	case tenant.FieldExpiryNotifiedAt:
+		m.ResetExpiryNotifiedAt()
+		return nil
+	case tenant.FieldAttributes:
+		m.ResetAttributes()
+		return nil
+	case tenant.FieldPlanID:
+		m.ResetPlanID()
+		return nil
+	case tenant.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case tenant.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case tenant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tenant.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case tenant.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.user_tenants != nil {
+		edges = append(edges, tenant.EdgeUserTenants)
+	}
+	if m.departments != nil {
+		edges = append(edges, tenant.EdgeDepartments)
+	}
+	if m.parent != nil {
+		edges = append(edges, tenant.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, tenant.EdgeChildren)
+	}
+	if m.roles != nil {
+		edges = append(edges, tenant.EdgeRoles)
+	}
+	if m.user_roles != nil {
+		edges = append(edges, tenant.EdgeUserRoles)
+	}
+	if m.status_logs != nil {
+		edges = append(edges, tenant.EdgeStatusLogs)
+	}
+	if m.invitations != nil {
+		edges = append(edges, tenant.EdgeInvitations)
+	}
+	if m.settings != nil {
+		edges = append(edges, tenant.EdgeSettings)
+	}
+	if m.setting_logs != nil {
+		edges = append(edges, tenant.EdgeSettingLogs)
+	}
+	if m.access_policies != nil {
+		edges = append(edges, tenant.EdgeAccessPolicies)
+	}
+	if m.plan != nil {
+		edges = append(edges, tenant.EdgePlan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TenantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tenant.EdgeUserTenants:
+		ids := make([]ent.Value, 0, len(m.user_tenants))
+		for id := range m.user_tenants {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeDepartments:
+		ids := make([]ent.Value, 0, len(m.departments))
+		for id := range m.departments {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case tenant.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.user_roles))
+		for id := range m.user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeStatusLogs:
+		ids := make([]ent.Value, 0, len(m.status_logs))
+		for id := range m.status_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSettings:
+		ids := make([]ent.Value, 0, len(m.settings))
+		for id := range m.settings {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSettingLogs:
+		ids := make([]ent.Value, 0, len(m.setting_logs))
+		for id := range m.setting_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeAccessPolicies:
+		ids := make([]ent.Value, 0, len(m.access_policies))
+		for id := range m.access_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgePlan:
+		if id := m.plan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TenantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removeduser_tenants != nil {
+		edges = append(edges, tenant.EdgeUserTenants)
+	}
+	if m.removeddepartments != nil {
+		edges = append(edges, tenant.EdgeDepartments)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, tenant.EdgeChildren)
+	}
+	if m.removedroles != nil {
+		edges = append(edges, tenant.EdgeRoles)
+	}
+	if m.removeduser_roles != nil {
+		edges = append(edges, tenant.EdgeUserRoles)
+	}
+	if m.removedstatus_logs != nil {
+		edges = append(edges, tenant.EdgeStatusLogs)
+	}
+	if m.removedinvitations != nil {
+		edges = append(edges, tenant.EdgeInvitations)
+	}
+	if m.removedsettings != nil {
+		edges = append(edges, tenant.EdgeSettings)
+	}
+	if m.removedsetting_logs != nil {
+		edges = append(edges, tenant.EdgeSettingLogs)
+	}
+	if m.removedaccess_policies != nil {
+		edges = append(edges, tenant.EdgeAccessPolicies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tenant.EdgeUserTenants:
+		ids := make([]ent.Value, 0, len(m.removeduser_tenants))
+		for id := range m.removeduser_tenants {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeDepartments:
+		ids := make([]ent.Value, 0, len(m.removeddepartments))
+		for id := range m.removeddepartments {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.removeduser_roles))
+		for id := range m.removeduser_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeStatusLogs:
+		ids := make([]ent.Value, 0, len(m.removedstatus_logs))
+		for id := range m.removedstatus_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSettings:
+		ids := make([]ent.Value, 0, len(m.removedsettings))
+		for id := range m.removedsettings {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSettingLogs:
+		ids := make([]ent.Value, 0, len(m.removedsetting_logs))
+		for id := range m.removedsetting_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeAccessPolicies:
+		ids := make([]ent.Value, 0, len(m.removedaccess_policies))
+		for id := range m.removedaccess_policies {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	if m.v2 != nil {
-		fields = append(fields, casbinrule.FieldV2)
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TenantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.cleareduser_tenants {
+		edges = append(edges, tenant.EdgeUserTenants)
 	}
-	if m.v3 != nil {
-		fields = append(fields, casbinrule.FieldV3)
+	if m.cleareddepartments {
+		edges = append(edges, tenant.EdgeDepartments)
 	}
-	if m.v4 != nil {
-		fields = append(fields, casbinrule.FieldV4)
+	if m.clearedparent {
+		edges = append(edges, tenant.EdgeParent)
 	}
-	if m.v5 != nil {
-		fields = append(fields, casbinrule.FieldV5)
+	if m.clearedchildren {
+		edges = append(edges, tenant.EdgeChildren)
 	}
-	return fields
+	if m.clearedroles {
+		edges = append(edges, tenant.EdgeRoles)
+	}
+	if m.cleareduser_roles {
+		edges = append(edges, tenant.EdgeUserRoles)
+	}
+	if m.clearedstatus_logs {
+		edges = append(edges, tenant.EdgeStatusLogs)
+	}
+	if m.clearedinvitations {
+		edges = append(edges, tenant.EdgeInvitations)
+	}
+	if m.clearedsettings {
+		edges = append(edges, tenant.EdgeSettings)
+	}
+	if m.clearedsetting_logs {
+		edges = append(edges, tenant.EdgeSettingLogs)
+	}
+	if m.clearedaccess_policies {
+		edges = append(edges, tenant.EdgeAccessPolicies)
+	}
+	if m.clearedplan {
+		edges = append(edges, tenant.EdgePlan)
+	}
+	return edges
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CasbinRuleMutation) Field(name string) (ent.Value, bool) {
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TenantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case casbinrule.FieldPtype:
-		return m.Ptype()
-	case casbinrule.FieldV0:
-		return m.V0()
-	case casbinrule.FieldV1:
-		return m.V1()
-	case casbinrule.FieldV2:
-		return m.V2()
-	case casbinrule.FieldV3:
-		return m.V3()
-	case casbinrule.FieldV4:
-		return m.V4()
-	case casbinrule.FieldV5:
-		return m.V5()
+	case tenant.EdgeUserTenants:
+		return m.cleareduser_tenants
+	case tenant.EdgeDepartments:
+		return m.cleareddepartments
+	case tenant.EdgeParent:
+		return m.clearedparent
+	case tenant.EdgeChildren:
+		return m.clearedchildren
+	case tenant.EdgeRoles:
+		return m.clearedroles
+	case tenant.EdgeUserRoles:
+		return m.cleareduser_roles
+	case tenant.EdgeStatusLogs:
+		return m.clearedstatus_logs
+	case tenant.EdgeInvitations:
+		return m.clearedinvitations
+	case tenant.EdgeSettings:
+		return m.clearedsettings
+	case tenant.EdgeSettingLogs:
+		return m.clearedsetting_logs
+	case tenant.EdgeAccessPolicies:
+		return m.clearedaccess_policies
+	case tenant.EdgePlan:
+		return m.clearedplan
 	}
-	return nil, false
+	return false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CasbinRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TenantMutation) ClearEdge(name string) error {
 	switch name {
-	case casbinrule.FieldPtype:
-		return m.OldPtype(ctx)
-	case casbinrule.FieldV0:
-		return m.OldV0(ctx)
-	case casbinrule.FieldV1:
-		return m.OldV1(ctx)
-	case casbinrule.FieldV2:
-		return m.OldV2(ctx)
-	case casbinrule.FieldV3:
-		return m.OldV3(ctx)
-	case casbinrule.FieldV4:
-		return m.OldV4(ctx)
-	case casbinrule.FieldV5:
-		return m.OldV5(ctx)
+	case tenant.EdgeParent:
+		m.ClearParent()
+		return nil
+	case tenant.EdgePlan:
+		m.ClearPlan()
+		return nil
 	}
-	return nil, fmt.Errorf("unknown CasbinRule field %s", name)
+	return fmt.Errorf("unknown Tenant unique edge %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CasbinRuleMutation) SetField(name string, value ent.Value) error {
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TenantMutation) ResetEdge(name string) error {
 	switch name {
-	case casbinrule.FieldPtype:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPtype(v)
+	case tenant.EdgeUserTenants:
+		m.ResetUserTenants()
 		return nil
-	case casbinrule.FieldV0:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetV0(v)
+	case tenant.EdgeDepartments:
+		m.ResetDepartments()
 		return nil
-	case casbinrule.FieldV1:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetV1(v)
+	case tenant.EdgeParent:
+		m.ResetParent()
 		return nil
-	case casbinrule.FieldV2:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetV2(v)
+	case tenant.EdgeChildren:
+		m.ResetChildren()
 		return nil
-	case casbinrule.FieldV3:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetV3(v)
+	case tenant.EdgeRoles:
+		m.ResetRoles()
 		return nil
-	case casbinrule.FieldV4:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetV4(v)
+	case tenant.EdgeUserRoles:
+		m.ResetUserRoles()
 		return nil
-	case casbinrule.FieldV5:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetV5(v)
+	case tenant.EdgeStatusLogs:
+		m.ResetStatusLogs()
+		return nil
+	case tenant.EdgeInvitations:
+		m.ResetInvitations()
+		return nil
+	case tenant.EdgeSettings:
+		m.ResetSettings()
+		return nil
+	case tenant.EdgeSettingLogs:
+		m.ResetSettingLogs()
+		return nil
+	case tenant.EdgeAccessPolicies:
+		m.ResetAccessPolicies()
+		return nil
+	case tenant.EdgePlan:
+		m.ResetPlan()
 		return nil
 	}
-	return fmt.Errorf("unknown CasbinRule field %s", name)
+	return fmt.Errorf("unknown Tenant edge %s", name)
+}
+
+// TenantInvitationMutation represents an operation that mutates the TenantInvitation nodes in the graph.
+type TenantInvitationMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	email             *string
+	phone             *string
+	department_id     *int64
+	adddepartment_id  *int64
+	role_ids          *[]int64
+	appendrole_ids    []int64
+	role_labels       *[]string
+	appendrole_labels []string
+	status            *tenantinvitation.Status
+	nonce             *string
+	expires_at        *time.Time
+	send_count        *int
+	addsend_count     *int
+	invited_by        *int64
+	addinvited_by     *int64
+	accepted_by       *int64
+	addaccepted_by    *int64
+	accepted_at       *time.Time
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	tenant            *int64
+	clearedtenant     bool
+	done              bool
+	oldValue          func(context.Context) (*TenantInvitation, error)
+	predicates        []predicate.TenantInvitation
+}
+
+var _ ent.Mutation = (*TenantInvitationMutation)(nil)
+
+// tenantinvitationOption allows management of the mutation configuration using functional options.
+type tenantinvitationOption func(*TenantInvitationMutation)
+
+// newTenantInvitationMutation creates new mutation for the TenantInvitation entity.
+func newTenantInvitationMutation(c config, op Op, opts ...tenantinvitationOption) *TenantInvitationMutation {
+	m := &TenantInvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenantInvitation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantInvitationID sets the ID field of the mutation.
+func withTenantInvitationID(id int64) tenantinvitationOption {
+	return func(m *TenantInvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TenantInvitation
+		)
+		m.oldValue = func(ctx context.Context) (*TenantInvitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TenantInvitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenantInvitation sets the old TenantInvitation of the mutation.
+func withTenantInvitation(node *TenantInvitation) tenantinvitationOption {
+	return func(m *TenantInvitationMutation) {
+		m.oldValue = func(context.Context) (*TenantInvitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantInvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantInvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TenantInvitation entities.
+func (m *TenantInvitationMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantInvitationMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CasbinRuleMutation) AddedFields() []string {
-	return nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantInvitationMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TenantInvitation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CasbinRuleMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantInvitationMutation) SetTenantID(i int64) {
+	m.tenant = &i
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CasbinRuleMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantInvitationMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CasbinRule numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CasbinRuleMutation) ClearedFields() []string {
-	return nil
+// OldTenantID returns the old "tenant_id" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantInvitationMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CasbinRuleMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantInvitationMutation) ResetTenantID() {
+	m.tenant = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CasbinRuleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown CasbinRule nullable field %s", name)
+// SetEmail sets the "email" field.
+func (m *TenantInvitationMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CasbinRuleMutation) ResetField(name string) error {
-	switch name {
-	case casbinrule.FieldPtype:
-		m.ResetPtype()
-		return nil
-	case casbinrule.FieldV0:
-		m.ResetV0()
-		return nil
-	case casbinrule.FieldV1:
-		m.ResetV1()
-		return nil
-	case casbinrule.FieldV2:
-		m.ResetV2()
-		return nil
-	case casbinrule.FieldV3:
-		m.ResetV3()
-		return nil
-	case casbinrule.FieldV4:
-		m.ResetV4()
-		return nil
-	case casbinrule.FieldV5:
-		m.ResetV5()
-		return nil
+// Email returns the value of the "email" field in the mutation.
+func (m *TenantInvitationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown CasbinRule field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CasbinRuleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldEmail returns the old "email" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantInvitationMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CasbinRuleMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// ClearEmail clears the value of the "email" field.
+func (m *TenantInvitationMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[tenantinvitation.FieldEmail] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CasbinRuleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *TenantInvitationMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[tenantinvitation.FieldEmail]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CasbinRuleMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetEmail resets all changes to the "email" field.
+func (m *TenantInvitationMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, tenantinvitation.FieldEmail)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CasbinRuleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetPhone sets the "phone" field.
+func (m *TenantInvitationMutation) SetPhone(s string) {
+	m.phone = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CasbinRuleMutation) EdgeCleared(name string) bool {
-	return false
+// Phone returns the value of the "phone" field in the mutation.
+func (m *TenantInvitationMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CasbinRuleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CasbinRule unique edge %s", name)
+// OldPhone returns the old "phone" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantInvitationMutation) OldPhone(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CasbinRuleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CasbinRule edge %s", name)
+// ClearPhone clears the value of the "phone" field.
+func (m *TenantInvitationMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[tenantinvitation.FieldPhone] = struct{}{}
 }
 
-// DepartmentMutation represents an operation that mutates the Department nodes in the graph.
-type DepartmentMutation struct {
-	config
-	op                      Op
-	typ                     string
-	id                      *int64
-	parent_id               *int64
-	addparent_id            *int64
-	name                    *string
-	_path                   *string
-	attributes              *map[string]interface{}
-	created_by              *int64
-	addcreated_by           *int64
-	updated_by              *int64
-	addupdated_by           *int64
-	created_at              *time.Time
-	updated_at              *time.Time
-	deleted_at              *time.Time
-	clearedFields           map[string]struct{}
-	tenant                  *int64
-	clearedtenant           bool
-	user_departments        map[int]struct{}
-	removeduser_departments map[int]struct{}
-	cleareduser_departments bool
-	done                    bool
-	oldValue                func(context.Context) (*Department, error)
-	predicates              []predicate.Department
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *TenantInvitationMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[tenantinvitation.FieldPhone]
+	return ok
 }
 
-var _ ent.Mutation = (*DepartmentMutation)(nil)
+// ResetPhone resets all changes to the "phone" field.
+func (m *TenantInvitationMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, tenantinvitation.FieldPhone)
+}
 
-// departmentOption allows management of the mutation configuration using functional options.
-type departmentOption func(*DepartmentMutation)
+// SetDepartmentID sets the "department_id" field.
+func (m *TenantInvitationMutation) SetDepartmentID(i int64) {
+	m.department_id = &i
+	m.adddepartment_id = nil
+}
 
-// newDepartmentMutation creates new mutation for the Department entity.
-func newDepartmentMutation(c config, op Op, opts ...departmentOption) *DepartmentMutation {
-	m := &DepartmentMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDepartment,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// DepartmentID returns the value of the "department_id" field in the mutation.
+func (m *TenantInvitationMutation) DepartmentID() (r int64, exists bool) {
+	v := m.department_id
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withDepartmentID sets the ID field of the mutation.
-func withDepartmentID(id int64) departmentOption {
-	return func(m *DepartmentMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Department
-		)
-		m.oldValue = func(ctx context.Context) (*Department, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Department.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldDepartmentID returns the old "department_id" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantInvitationMutation) OldDepartmentID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartmentID: %w", err)
 	}
+	return oldValue.DepartmentID, nil
 }
 
-// withDepartment sets the old Department of the mutation.
-func withDepartment(node *Department) departmentOption {
-	return func(m *DepartmentMutation) {
-		m.oldValue = func(context.Context) (*Department, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddDepartmentID adds i to the "department_id" field.
+func (m *TenantInvitationMutation) AddDepartmentID(i int64) {
+	if m.adddepartment_id != nil {
+		*m.adddepartment_id += i
+	} else {
+		m.adddepartment_id = &i
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DepartmentMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddedDepartmentID returns the value that was added to the "department_id" field in this mutation.
+func (m *TenantInvitationMutation) AddedDepartmentID() (r int64, exists bool) {
+	v := m.adddepartment_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DepartmentMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearDepartmentID clears the value of the "department_id" field.
+func (m *TenantInvitationMutation) ClearDepartmentID() {
+	m.department_id = nil
+	m.adddepartment_id = nil
+	m.clearedFields[tenantinvitation.FieldDepartmentID] = struct{}{}
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Department entities.
-func (m *DepartmentMutation) SetID(id int64) {
-	m.id = &id
+// DepartmentIDCleared returns if the "department_id" field was cleared in this mutation.
+func (m *TenantInvitationMutation) DepartmentIDCleared() bool {
+	_, ok := m.clearedFields[tenantinvitation.FieldDepartmentID]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DepartmentMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// ResetDepartmentID resets all changes to the "department_id" field.
+func (m *TenantInvitationMutation) ResetDepartmentID() {
+	m.department_id = nil
+	m.adddepartment_id = nil
+	delete(m.clearedFields, tenantinvitation.FieldDepartmentID)
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (m *TenantInvitationMutation) SetRoleIds(i []int64) {
+	m.role_ids = &i
+	m.appendrole_ids = nil
+}
+
+// RoleIds returns the value of the "role_ids" field in the mutation.
+func (m *TenantInvitationMutation) RoleIds() (r []int64, exists bool) {
+	v := m.role_ids
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DepartmentMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Department.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldRoleIds returns the old "role_ids" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantInvitationMutation) OldRoleIds(ctx context.Context) (v []int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleIds: %w", err)
 	}
+	return oldValue.RoleIds, nil
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *DepartmentMutation) SetTenantID(i int64) {
-	m.tenant = &i
+// AppendRoleIds adds i to the "role_ids" field.
+func (m *TenantInvitationMutation) AppendRoleIds(i []int64) {
+	m.appendrole_ids = append(m.appendrole_ids, i...)
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *DepartmentMutation) TenantID() (r int64, exists bool) {
-	v := m.tenant
+// AppendedRoleIds returns the list of values that were appended to the "role_ids" field in this mutation.
+func (m *TenantInvitationMutation) AppendedRoleIds() ([]int64, bool) {
+	if len(m.appendrole_ids) == 0 {
+		return nil, false
+	}
+	return m.appendrole_ids, true
+}
+
+// ResetRoleIds resets all changes to the "role_ids" field.
+func (m *TenantInvitationMutation) ResetRoleIds() {
+	m.role_ids = nil
+	m.appendrole_ids = nil
+}
+
+// SetRoleLabels sets the "role_labels" field.
+func (m *TenantInvitationMutation) SetRoleLabels(s []string) {
+	m.role_labels = &s
+	m.appendrole_labels = nil
+}
+
+// RoleLabels returns the value of the "role_labels" field in the mutation.
+func (m *TenantInvitationMutation) RoleLabels() (r []string, exists bool) {
+	v := m.role_labels
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleLabels returns the old "role_labels" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldTenantID(ctx context.Context) (v int64, err error) {
+func (m *TenantInvitationMutation) OldRoleLabels(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleLabels is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldRoleLabels requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleLabels: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.RoleLabels, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *DepartmentMutation) ResetTenantID() {
-	m.tenant = nil
+// AppendRoleLabels adds s to the "role_labels" field.
+func (m *TenantInvitationMutation) AppendRoleLabels(s []string) {
+	m.appendrole_labels = append(m.appendrole_labels, s...)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *DepartmentMutation) SetParentID(i int64) {
-	m.parent_id = &i
-	m.addparent_id = nil
+// AppendedRoleLabels returns the list of values that were appended to the "role_labels" field in this mutation.
+func (m *TenantInvitationMutation) AppendedRoleLabels() ([]string, bool) {
+	if len(m.appendrole_labels) == 0 {
+		return nil, false
+	}
+	return m.appendrole_labels, true
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *DepartmentMutation) ParentID() (r int64, exists bool) {
-	v := m.parent_id
+// ResetRoleLabels resets all changes to the "role_labels" field.
+func (m *TenantInvitationMutation) ResetRoleLabels() {
+	m.role_labels = nil
+	m.appendrole_labels = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TenantInvitationMutation) SetStatus(t tenantinvitation.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TenantInvitationMutation) Status() (r tenantinvitation.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldParentID(ctx context.Context) (v int64, err error) {
+func (m *TenantInvitationMutation) OldStatus(ctx context.Context) (v tenantinvitation.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.Status, nil
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (m *DepartmentMutation) AddParentID(i int64) {
-	if m.addparent_id != nil {
-		*m.addparent_id += i
-	} else {
-		m.addparent_id = &i
-	}
+// ResetStatus resets all changes to the "status" field.
+func (m *TenantInvitationMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
-func (m *DepartmentMutation) AddedParentID() (r int64, exists bool) {
-	v := m.addparent_id
+// SetNonce sets the "nonce" field.
+func (m *TenantInvitationMutation) SetNonce(s string) {
+	m.nonce = &s
+}
+
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *TenantInvitationMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *DepartmentMutation) ResetParentID() {
-	m.parent_id = nil
-	m.addparent_id = nil
+// OldNonce returns the old "nonce" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantInvitationMutation) OldNonce(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNonce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
+	}
+	return oldValue.Nonce, nil
 }
 
-// SetName sets the "name" field.
-func (m *DepartmentMutation) SetName(s string) {
-	m.name = &s
+// ResetNonce resets all changes to the "nonce" field.
+func (m *TenantInvitationMutation) ResetNonce() {
+	m.nonce = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DepartmentMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetExpiresAt sets the "expires_at" field.
+func (m *TenantInvitationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TenantInvitationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TenantInvitationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DepartmentMutation) ResetName() {
-	m.name = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TenantInvitationMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetPath sets the "path" field.
-func (m *DepartmentMutation) SetPath(s string) {
-	m._path = &s
+// SetSendCount sets the "send_count" field.
+func (m *TenantInvitationMutation) SetSendCount(i int) {
+	m.send_count = &i
+	m.addsend_count = nil
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *DepartmentMutation) Path() (r string, exists bool) {
-	v := m._path
+// SendCount returns the value of the "send_count" field in the mutation.
+func (m *TenantInvitationMutation) SendCount() (r int, exists bool) {
+	v := m.send_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldSendCount returns the old "send_count" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *TenantInvitationMutation) OldSendCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldSendCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldSendCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldSendCount: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.SendCount, nil
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *DepartmentMutation) ResetPath() {
-	m._path = nil
+// AddSendCount adds i to the "send_count" field.
+func (m *TenantInvitationMutation) AddSendCount(i int) {
+	if m.addsend_count != nil {
+		*m.addsend_count += i
+	} else {
+		m.addsend_count = &i
+	}
 }
 
-// SetAttributes sets the "attributes" field.
-func (m *DepartmentMutation) SetAttributes(value map[string]interface{}) {
-	m.attributes = &value
+// AddedSendCount returns the value that was added to the "send_count" field in this mutation.
+func (m *TenantInvitationMutation) AddedSendCount() (r int, exists bool) {
+	v := m.addsend_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Attributes returns the value of the "attributes" field in the mutation.
-func (m *DepartmentMutation) Attributes() (r map[string]interface{}, exists bool) {
-	v := m.attributes
+// ResetSendCount resets all changes to the "send_count" field.
+func (m *TenantInvitationMutation) ResetSendCount() {
+	m.send_count = nil
+	m.addsend_count = nil
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (m *TenantInvitationMutation) SetInvitedBy(i int64) {
+	m.invited_by = &i
+	m.addinvited_by = nil
+}
+
+// InvitedBy returns the value of the "invited_by" field in the mutation.
+func (m *TenantInvitationMutation) InvitedBy() (r int64, exists bool) {
+	v := m.invited_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttributes returns the old "attributes" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldInvitedBy returns the old "invited_by" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldAttributes(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *TenantInvitationMutation) OldInvitedBy(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+		return v, errors.New("OldInvitedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttributes requires an ID field in the mutation")
+		return v, errors.New("OldInvitedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+		return v, fmt.Errorf("querying old value for OldInvitedBy: %w", err)
 	}
-	return oldValue.Attributes, nil
+	return oldValue.InvitedBy, nil
 }
 
-// ResetAttributes resets all changes to the "attributes" field.
-func (m *DepartmentMutation) ResetAttributes() {
-	m.attributes = nil
+// AddInvitedBy adds i to the "invited_by" field.
+func (m *TenantInvitationMutation) AddInvitedBy(i int64) {
+	if m.addinvited_by != nil {
+		*m.addinvited_by += i
+	} else {
+		m.addinvited_by = &i
+	}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *DepartmentMutation) SetCreatedBy(i int64) {
-	m.created_by = &i
-	m.addcreated_by = nil
+// AddedInvitedBy returns the value that was added to the "invited_by" field in this mutation.
+func (m *TenantInvitationMutation) AddedInvitedBy() (r int64, exists bool) {
+	v := m.addinvited_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *DepartmentMutation) CreatedBy() (r int64, exists bool) {
-	v := m.created_by
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (m *TenantInvitationMutation) ClearInvitedBy() {
+	m.invited_by = nil
+	m.addinvited_by = nil
+	m.clearedFields[tenantinvitation.FieldInvitedBy] = struct{}{}
+}
+
+// InvitedByCleared returns if the "invited_by" field was cleared in this mutation.
+func (m *TenantInvitationMutation) InvitedByCleared() bool {
+	_, ok := m.clearedFields[tenantinvitation.FieldInvitedBy]
+	return ok
+}
+
+// ResetInvitedBy resets all changes to the "invited_by" field.
+func (m *TenantInvitationMutation) ResetInvitedBy() {
+	m.invited_by = nil
+	m.addinvited_by = nil
+	delete(m.clearedFields, tenantinvitation.FieldInvitedBy)
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (m *TenantInvitationMutation) SetAcceptedBy(i int64) {
+	m.accepted_by = &i
+	m.addaccepted_by = nil
+}
+
+// AcceptedBy returns the value of the "accepted_by" field in the mutation.
+func (m *TenantInvitationMutation) AcceptedBy() (r int64, exists bool) {
+	v := m.accepted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldAcceptedBy returns the old "accepted_by" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldCreatedBy(ctx context.Context) (v *int64, err error) {
+func (m *TenantInvitationMutation) OldAcceptedBy(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcceptedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldAcceptedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcceptedBy: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.AcceptedBy, nil
 }
 
-// AddCreatedBy adds i to the "created_by" field.
-func (m *DepartmentMutation) AddCreatedBy(i int64) {
-	if m.addcreated_by != nil {
-		*m.addcreated_by += i
+// AddAcceptedBy adds i to the "accepted_by" field.
+func (m *TenantInvitationMutation) AddAcceptedBy(i int64) {
+	if m.addaccepted_by != nil {
+		*m.addaccepted_by += i
 	} else {
-		m.addcreated_by = &i
+		m.addaccepted_by = &i
 	}
 }
 
-// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
-func (m *DepartmentMutation) AddedCreatedBy() (r int64, exists bool) {
-	v := m.addcreated_by
+// AddedAcceptedBy returns the value that was added to the "accepted_by" field in this mutation.
+func (m *TenantInvitationMutation) AddedAcceptedBy() (r int64, exists bool) {
+	v := m.addaccepted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *DepartmentMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-	m.clearedFields[department.FieldCreatedBy] = struct{}{}
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (m *TenantInvitationMutation) ClearAcceptedBy() {
+	m.accepted_by = nil
+	m.addaccepted_by = nil
+	m.clearedFields[tenantinvitation.FieldAcceptedBy] = struct{}{}
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *DepartmentMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[department.FieldCreatedBy]
+// AcceptedByCleared returns if the "accepted_by" field was cleared in this mutation.
+func (m *TenantInvitationMutation) AcceptedByCleared() bool {
+	_, ok := m.clearedFields[tenantinvitation.FieldAcceptedBy]
 	return ok
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *DepartmentMutation) ResetCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-	delete(m.clearedFields, department.FieldCreatedBy)
+// ResetAcceptedBy resets all changes to the "accepted_by" field.
+func (m *TenantInvitationMutation) ResetAcceptedBy() {
+	m.accepted_by = nil
+	m.addaccepted_by = nil
+	delete(m.clearedFields, tenantinvitation.FieldAcceptedBy)
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *DepartmentMutation) SetUpdatedBy(i int64) {
-	m.updated_by = &i
-	m.addupdated_by = nil
+// SetAcceptedAt sets the "accepted_at" field.
+func (m *TenantInvitationMutation) SetAcceptedAt(t time.Time) {
+	m.accepted_at = &t
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *DepartmentMutation) UpdatedBy() (r int64, exists bool) {
-	v := m.updated_by
+// AcceptedAt returns the value of the "accepted_at" field in the mutation.
+func (m *TenantInvitationMutation) AcceptedAt() (r time.Time, exists bool) {
+	v := m.accepted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldAcceptedAt returns the old "accepted_at" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldUpdatedBy(ctx context.Context) (v *int64, err error) {
+func (m *TenantInvitationMutation) OldAcceptedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcceptedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+		return v, errors.New("OldAcceptedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
-	}
-	return oldValue.UpdatedBy, nil
-}
-
-// AddUpdatedBy adds i to the "updated_by" field.
-func (m *DepartmentMutation) AddUpdatedBy(i int64) {
-	if m.addupdated_by != nil {
-		*m.addupdated_by += i
-	} else {
-		m.addupdated_by = &i
-	}
-}
-
-// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
-func (m *DepartmentMutation) AddedUpdatedBy() (r int64, exists bool) {
-	v := m.addupdated_by
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAcceptedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.AcceptedAt, nil
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *DepartmentMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.addupdated_by = nil
-	m.clearedFields[department.FieldUpdatedBy] = struct{}{}
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (m *TenantInvitationMutation) ClearAcceptedAt() {
+	m.accepted_at = nil
+	m.clearedFields[tenantinvitation.FieldAcceptedAt] = struct{}{}
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *DepartmentMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[department.FieldUpdatedBy]
+// AcceptedAtCleared returns if the "accepted_at" field was cleared in this mutation.
+func (m *TenantInvitationMutation) AcceptedAtCleared() bool {
+	_, ok := m.clearedFields[tenantinvitation.FieldAcceptedAt]
 	return ok
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *DepartmentMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	m.addupdated_by = nil
-	delete(m.clearedFields, department.FieldUpdatedBy)
+// ResetAcceptedAt resets all changes to the "accepted_at" field.
+func (m *TenantInvitationMutation) ResetAcceptedAt() {
+	m.accepted_at = nil
+	delete(m.clearedFields, tenantinvitation.FieldAcceptedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DepartmentMutation) SetCreatedAt(t time.Time) {
+func (m *TenantInvitationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DepartmentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TenantInvitationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1186,10 +9198,10 @@ func (m *DepartmentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantInvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1204,17 +9216,17 @@ func (m *DepartmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DepartmentMutation) ResetCreatedAt() {
+func (m *TenantInvitationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DepartmentMutation) SetUpdatedAt(t time.Time) {
+func (m *TenantInvitationMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DepartmentMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *TenantInvitationMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1222,10 +9234,10 @@ func (m *DepartmentMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TenantInvitation entity.
+// If the TenantInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantInvitationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1240,74 +9252,25 @@ func (m *DepartmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DepartmentMutation) ResetUpdatedAt() {
+func (m *TenantInvitationMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *DepartmentMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
-}
-
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *DepartmentMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeletedAt returns the old "deleted_at" field's value of the Department entity.
-// If the Department object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DepartmentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *DepartmentMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[department.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *DepartmentMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[department.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *DepartmentMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, department.FieldDeletedAt)
-}
-
 // ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *DepartmentMutation) ClearTenant() {
+func (m *TenantInvitationMutation) ClearTenant() {
 	m.clearedtenant = true
-	m.clearedFields[department.FieldTenantID] = struct{}{}
+	m.clearedFields[tenantinvitation.FieldTenantID] = struct{}{}
 }
 
 // TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *DepartmentMutation) TenantCleared() bool {
+func (m *TenantInvitationMutation) TenantCleared() bool {
 	return m.clearedtenant
 }
 
 // TenantIDs returns the "tenant" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // TenantID instead. It exists only for internal usage by the builders.
-func (m *DepartmentMutation) TenantIDs() (ids []int64) {
+func (m *TenantInvitationMutation) TenantIDs() (ids []int64) {
 	if id := m.tenant; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1315,74 +9278,20 @@ func (m *DepartmentMutation) TenantIDs() (ids []int64) {
 }
 
 // ResetTenant resets all changes to the "tenant" edge.
-func (m *DepartmentMutation) ResetTenant() {
+func (m *TenantInvitationMutation) ResetTenant() {
 	m.tenant = nil
 	m.clearedtenant = false
 }
 
-// AddUserDepartmentIDs adds the "user_departments" edge to the UserDepartment entity by ids.
-func (m *DepartmentMutation) AddUserDepartmentIDs(ids ...int) {
-	if m.user_departments == nil {
-		m.user_departments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user_departments[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUserDepartments clears the "user_departments" edge to the UserDepartment entity.
-func (m *DepartmentMutation) ClearUserDepartments() {
-	m.cleareduser_departments = true
-}
-
-// UserDepartmentsCleared reports if the "user_departments" edge to the UserDepartment entity was cleared.
-func (m *DepartmentMutation) UserDepartmentsCleared() bool {
-	return m.cleareduser_departments
-}
-
-// RemoveUserDepartmentIDs removes the "user_departments" edge to the UserDepartment entity by IDs.
-func (m *DepartmentMutation) RemoveUserDepartmentIDs(ids ...int) {
-	if m.removeduser_departments == nil {
-		m.removeduser_departments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user_departments, ids[i])
-		m.removeduser_departments[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUserDepartments returns the removed IDs of the "user_departments" edge to the UserDepartment entity.
-func (m *DepartmentMutation) RemovedUserDepartmentsIDs() (ids []int) {
-	for id := range m.removeduser_departments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UserDepartmentsIDs returns the "user_departments" edge IDs in the mutation.
-func (m *DepartmentMutation) UserDepartmentsIDs() (ids []int) {
-	for id := range m.user_departments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUserDepartments resets all changes to the "user_departments" edge.
-func (m *DepartmentMutation) ResetUserDepartments() {
-	m.user_departments = nil
-	m.cleareduser_departments = false
-	m.removeduser_departments = nil
-}
-
-// Where appends a list predicates to the DepartmentMutation builder.
-func (m *DepartmentMutation) Where(ps ...predicate.Department) {
+// Where appends a list predicates to the TenantInvitationMutation builder.
+func (m *TenantInvitationMutation) Where(ps ...predicate.TenantInvitation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DepartmentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantInvitationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DepartmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Department, len(ps))
+func (m *TenantInvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantInvitation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1390,83 +9299,108 @@ func (m *DepartmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DepartmentMutation) Op() Op {
+func (m *TenantInvitationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DepartmentMutation) SetOp(op Op) {
+func (m *TenantInvitationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Department).
-func (m *DepartmentMutation) Type() string {
+// Type returns the node type of this mutation (TenantInvitation).
+func (m *TenantInvitationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DepartmentMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *TenantInvitationMutation) Fields() []string {
+	fields := make([]string, 0, 15)
 	if m.tenant != nil {
-		fields = append(fields, department.FieldTenantID)
+		fields = append(fields, tenantinvitation.FieldTenantID)
 	}
-	if m.parent_id != nil {
-		fields = append(fields, department.FieldParentID)
+	if m.email != nil {
+		fields = append(fields, tenantinvitation.FieldEmail)
 	}
-	if m.name != nil {
-		fields = append(fields, department.FieldName)
+	if m.phone != nil {
+		fields = append(fields, tenantinvitation.FieldPhone)
 	}
-	if m._path != nil {
-		fields = append(fields, department.FieldPath)
+	if m.department_id != nil {
+		fields = append(fields, tenantinvitation.FieldDepartmentID)
 	}
-	if m.attributes != nil {
-		fields = append(fields, department.FieldAttributes)
+	if m.role_ids != nil {
+		fields = append(fields, tenantinvitation.FieldRoleIds)
 	}
-	if m.created_by != nil {
-		fields = append(fields, department.FieldCreatedBy)
+	if m.role_labels != nil {
+		fields = append(fields, tenantinvitation.FieldRoleLabels)
 	}
-	if m.updated_by != nil {
-		fields = append(fields, department.FieldUpdatedBy)
+	if m.status != nil {
+		fields = append(fields, tenantinvitation.FieldStatus)
+	}
+	if m.nonce != nil {
+		fields = append(fields, tenantinvitation.FieldNonce)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, tenantinvitation.FieldExpiresAt)
+	}
+	if m.send_count != nil {
+		fields = append(fields, tenantinvitation.FieldSendCount)
+	}
+	if m.invited_by != nil {
+		fields = append(fields, tenantinvitation.FieldInvitedBy)
+	}
+	if m.accepted_by != nil {
+		fields = append(fields, tenantinvitation.FieldAcceptedBy)
+	}
+	if m.accepted_at != nil {
+		fields = append(fields, tenantinvitation.FieldAcceptedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, department.FieldCreatedAt)
+		fields = append(fields, tenantinvitation.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, department.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, department.FieldDeletedAt)
+		fields = append(fields, tenantinvitation.FieldUpdatedAt)
 	}
 	return fields
 }
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *DepartmentMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case department.FieldTenantID:
-		return m.TenantID()
-	case department.FieldParentID:
-		return m.ParentID()
-	case department.FieldName:
-		return m.Name()
-	case department.FieldPath:
-		return m.Path()
-	case department.FieldAttributes:
-		return m.Attributes()
-	case department.FieldCreatedBy:
-		return m.CreatedBy()
-	case department.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case department.FieldCreatedAt:
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TenantInvitationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tenantinvitation.FieldTenantID:
+		return m.TenantID()
+	case tenantinvitation.FieldEmail:
+		return m.Email()
+	case tenantinvitation.FieldPhone:
+		return m.Phone()
+	case tenantinvitation.FieldDepartmentID:
+		return m.DepartmentID()
+	case tenantinvitation.FieldRoleIds:
+		return m.RoleIds()
+	case tenantinvitation.FieldRoleLabels:
+		return m.RoleLabels()
+	case tenantinvitation.FieldStatus:
+		return m.Status()
+	case tenantinvitation.FieldNonce:
+		return m.Nonce()
+	case tenantinvitation.FieldExpiresAt:
+		return m.ExpiresAt()
+	case tenantinvitation.FieldSendCount:
+		return m.SendCount()
+	case tenantinvitation.FieldInvitedBy:
+		return m.InvitedBy()
+	case tenantinvitation.FieldAcceptedBy:
+		return m.AcceptedBy()
+	case tenantinvitation.FieldAcceptedAt:
+		return m.AcceptedAt()
+	case tenantinvitation.FieldCreatedAt:
 		return m.CreatedAt()
-	case department.FieldUpdatedAt:
+	case tenantinvitation.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case department.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -1474,123 +9408,171 @@ func (m *DepartmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DepartmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantInvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case department.FieldTenantID:
+	case tenantinvitation.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case department.FieldParentID:
-		return m.OldParentID(ctx)
-	case department.FieldName:
-		return m.OldName(ctx)
-	case department.FieldPath:
-		return m.OldPath(ctx)
-	case department.FieldAttributes:
-		return m.OldAttributes(ctx)
-	case department.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case department.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case department.FieldCreatedAt:
+	case tenantinvitation.FieldEmail:
+		return m.OldEmail(ctx)
+	case tenantinvitation.FieldPhone:
+		return m.OldPhone(ctx)
+	case tenantinvitation.FieldDepartmentID:
+		return m.OldDepartmentID(ctx)
+	case tenantinvitation.FieldRoleIds:
+		return m.OldRoleIds(ctx)
+	case tenantinvitation.FieldRoleLabels:
+		return m.OldRoleLabels(ctx)
+	case tenantinvitation.FieldStatus:
+		return m.OldStatus(ctx)
+	case tenantinvitation.FieldNonce:
+		return m.OldNonce(ctx)
+	case tenantinvitation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case tenantinvitation.FieldSendCount:
+		return m.OldSendCount(ctx)
+	case tenantinvitation.FieldInvitedBy:
+		return m.OldInvitedBy(ctx)
+	case tenantinvitation.FieldAcceptedBy:
+		return m.OldAcceptedBy(ctx)
+	case tenantinvitation.FieldAcceptedAt:
+		return m.OldAcceptedAt(ctx)
+	case tenantinvitation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case department.FieldUpdatedAt:
+	case tenantinvitation.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case department.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Department field %s", name)
+	return nil, fmt.Errorf("unknown TenantInvitation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DepartmentMutation) SetField(name string, value ent.Value) error {
+func (m *TenantInvitationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case department.FieldTenantID:
+	case tenantinvitation.FieldTenantID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case department.FieldParentID:
-		v, ok := value.(int64)
+	case tenantinvitation.FieldEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetEmail(v)
 		return nil
-	case department.FieldName:
+	case tenantinvitation.FieldPhone:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPhone(v)
 		return nil
-	case department.FieldPath:
+	case tenantinvitation.FieldDepartmentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartmentID(v)
+		return nil
+	case tenantinvitation.FieldRoleIds:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleIds(v)
+		return nil
+	case tenantinvitation.FieldRoleLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleLabels(v)
+		return nil
+	case tenantinvitation.FieldStatus:
+		v, ok := value.(tenantinvitation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tenantinvitation.FieldNonce:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetNonce(v)
 		return nil
-	case department.FieldAttributes:
-		v, ok := value.(map[string]interface{})
+	case tenantinvitation.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttributes(v)
+		m.SetExpiresAt(v)
 		return nil
-	case department.FieldCreatedBy:
+	case tenantinvitation.FieldSendCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendCount(v)
+		return nil
+	case tenantinvitation.FieldInvitedBy:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetInvitedBy(v)
 		return nil
-	case department.FieldUpdatedBy:
+	case tenantinvitation.FieldAcceptedBy:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedBy(v)
+		m.SetAcceptedBy(v)
 		return nil
-	case department.FieldCreatedAt:
+	case tenantinvitation.FieldAcceptedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAcceptedAt(v)
 		return nil
-	case department.FieldUpdatedAt:
+	case tenantinvitation.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case department.FieldDeletedAt:
+	case tenantinvitation.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Department field %s", name)
+	return fmt.Errorf("unknown TenantInvitation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DepartmentMutation) AddedFields() []string {
+func (m *TenantInvitationMutation) AddedFields() []string {
 	var fields []string
-	if m.addparent_id != nil {
-		fields = append(fields, department.FieldParentID)
+	if m.adddepartment_id != nil {
+		fields = append(fields, tenantinvitation.FieldDepartmentID)
 	}
-	if m.addcreated_by != nil {
-		fields = append(fields, department.FieldCreatedBy)
+	if m.addsend_count != nil {
+		fields = append(fields, tenantinvitation.FieldSendCount)
 	}
-	if m.addupdated_by != nil {
-		fields = append(fields, department.FieldUpdatedBy)
+	if m.addinvited_by != nil {
+		fields = append(fields, tenantinvitation.FieldInvitedBy)
+	}
+	if m.addaccepted_by != nil {
+		fields = append(fields, tenantinvitation.FieldAcceptedBy)
 	}
 	return fields
 }
@@ -1598,14 +9580,16 @@ func (m *DepartmentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DepartmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TenantInvitationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case department.FieldParentID:
-		return m.AddedParentID()
-	case department.FieldCreatedBy:
-		return m.AddedCreatedBy()
-	case department.FieldUpdatedBy:
-		return m.AddedUpdatedBy()
+	case tenantinvitation.FieldDepartmentID:
+		return m.AddedDepartmentID()
+	case tenantinvitation.FieldSendCount:
+		return m.AddedSendCount()
+	case tenantinvitation.FieldInvitedBy:
+		return m.AddedInvitedBy()
+	case tenantinvitation.FieldAcceptedBy:
+		return m.AddedAcceptedBy()
 	}
 	return nil, false
 }
@@ -1613,248 +9597,257 @@ func (m *DepartmentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DepartmentMutation) AddField(name string, value ent.Value) error {
+func (m *TenantInvitationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case department.FieldParentID:
+	case tenantinvitation.FieldDepartmentID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddParentID(v)
+		m.AddDepartmentID(v)
 		return nil
-	case department.FieldCreatedBy:
+	case tenantinvitation.FieldSendCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSendCount(v)
+		return nil
+	case tenantinvitation.FieldInvitedBy:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreatedBy(v)
+		m.AddInvitedBy(v)
 		return nil
-	case department.FieldUpdatedBy:
+	case tenantinvitation.FieldAcceptedBy:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUpdatedBy(v)
+		m.AddAcceptedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Department numeric field %s", name)
+	return fmt.Errorf("unknown TenantInvitation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DepartmentMutation) ClearedFields() []string {
+func (m *TenantInvitationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(department.FieldCreatedBy) {
-		fields = append(fields, department.FieldCreatedBy)
+	if m.FieldCleared(tenantinvitation.FieldEmail) {
+		fields = append(fields, tenantinvitation.FieldEmail)
 	}
-	if m.FieldCleared(department.FieldUpdatedBy) {
-		fields = append(fields, department.FieldUpdatedBy)
+	if m.FieldCleared(tenantinvitation.FieldPhone) {
+		fields = append(fields, tenantinvitation.FieldPhone)
 	}
-	if m.FieldCleared(department.FieldDeletedAt) {
-		fields = append(fields, department.FieldDeletedAt)
+	if m.FieldCleared(tenantinvitation.FieldDepartmentID) {
+		fields = append(fields, tenantinvitation.FieldDepartmentID)
+	}
+	if m.FieldCleared(tenantinvitation.FieldInvitedBy) {
+		fields = append(fields, tenantinvitation.FieldInvitedBy)
+	}
+	if m.FieldCleared(tenantinvitation.FieldAcceptedBy) {
+		fields = append(fields, tenantinvitation.FieldAcceptedBy)
+	}
+	if m.FieldCleared(tenantinvitation.FieldAcceptedAt) {
+		fields = append(fields, tenantinvitation.FieldAcceptedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DepartmentMutation) FieldCleared(name string) bool {
+func (m *TenantInvitationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DepartmentMutation) ClearField(name string) error {
+func (m *TenantInvitationMutation) ClearField(name string) error {
 	switch name {
-	case department.FieldCreatedBy:
-		m.ClearCreatedBy()
+	case tenantinvitation.FieldEmail:
+		m.ClearEmail()
 		return nil
-	case department.FieldUpdatedBy:
-		m.ClearUpdatedBy()
+	case tenantinvitation.FieldPhone:
+		m.ClearPhone()
 		return nil
-	case department.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case tenantinvitation.FieldDepartmentID:
+		m.ClearDepartmentID()
+		return nil
+	case tenantinvitation.FieldInvitedBy:
+		m.ClearInvitedBy()
+		return nil
+	case tenantinvitation.FieldAcceptedBy:
+		m.ClearAcceptedBy()
+		return nil
+	case tenantinvitation.FieldAcceptedAt:
+		m.ClearAcceptedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Department nullable field %s", name)
+	return fmt.Errorf("unknown TenantInvitation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DepartmentMutation) ResetField(name string) error {
+func (m *TenantInvitationMutation) ResetField(name string) error {
 	switch name {
-	case department.FieldTenantID:
+	case tenantinvitation.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case department.FieldParentID:
-		m.ResetParentID()
+	case tenantinvitation.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case department.FieldName:
-		m.ResetName()
+	case tenantinvitation.FieldPhone:
+		m.ResetPhone()
 		return nil
-	case department.FieldPath:
-		m.ResetPath()
+	case tenantinvitation.FieldDepartmentID:
+		m.ResetDepartmentID()
 		return nil
-	case department.FieldAttributes:
-		m.ResetAttributes()
+	case tenantinvitation.FieldRoleIds:
+		m.ResetRoleIds()
 		return nil
-	case department.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case tenantinvitation.FieldRoleLabels:
+		m.ResetRoleLabels()
 		return nil
-	case department.FieldUpdatedBy:
-		m.ResetUpdatedBy()
+	case tenantinvitation.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case department.FieldCreatedAt:
+	case tenantinvitation.FieldNonce:
+		m.ResetNonce()
+		return nil
+	case tenantinvitation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case tenantinvitation.FieldSendCount:
+		m.ResetSendCount()
+		return nil
+	case tenantinvitation.FieldInvitedBy:
+		m.ResetInvitedBy()
+		return nil
+	case tenantinvitation.FieldAcceptedBy:
+		m.ResetAcceptedBy()
+		return nil
+	case tenantinvitation.FieldAcceptedAt:
+		m.ResetAcceptedAt()
+		return nil
+	case tenantinvitation.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case department.FieldUpdatedAt:
+	case tenantinvitation.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case department.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Department field %s", name)
+	return fmt.Errorf("unknown TenantInvitation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DepartmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TenantInvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.tenant != nil {
-		edges = append(edges, department.EdgeTenant)
-	}
-	if m.user_departments != nil {
-		edges = append(edges, department.EdgeUserDepartments)
+		edges = append(edges, tenantinvitation.EdgeTenant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DepartmentMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantInvitationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case department.EdgeTenant:
+	case tenantinvitation.EdgeTenant:
 		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case department.EdgeUserDepartments:
-		ids := make([]ent.Value, 0, len(m.user_departments))
-		for id := range m.user_departments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DepartmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeduser_departments != nil {
-		edges = append(edges, department.EdgeUserDepartments)
-	}
+func (m *TenantInvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DepartmentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case department.EdgeUserDepartments:
-		ids := make([]ent.Value, 0, len(m.removeduser_departments))
-		for id := range m.removeduser_departments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TenantInvitationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DepartmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TenantInvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedtenant {
-		edges = append(edges, department.EdgeTenant)
-	}
-	if m.cleareduser_departments {
-		edges = append(edges, department.EdgeUserDepartments)
+		edges = append(edges, tenantinvitation.EdgeTenant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DepartmentMutation) EdgeCleared(name string) bool {
+func (m *TenantInvitationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case department.EdgeTenant:
+	case tenantinvitation.EdgeTenant:
 		return m.clearedtenant
-	case department.EdgeUserDepartments:
-		return m.cleareduser_departments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DepartmentMutation) ClearEdge(name string) error {
+func (m *TenantInvitationMutation) ClearEdge(name string) error {
 	switch name {
-	case department.EdgeTenant:
+	case tenantinvitation.EdgeTenant:
 		m.ClearTenant()
 		return nil
 	}
-	return fmt.Errorf("unknown Department unique edge %s", name)
+	return fmt.Errorf("unknown TenantInvitation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DepartmentMutation) ResetEdge(name string) error {
+func (m *TenantInvitationMutation) ResetEdge(name string) error {
 	switch name {
-	case department.EdgeTenant:
+	case tenantinvitation.EdgeTenant:
 		m.ResetTenant()
 		return nil
-	case department.EdgeUserDepartments:
-		m.ResetUserDepartments()
-		return nil
 	}
-	return fmt.Errorf("unknown Department edge %s", name)
+	return fmt.Errorf("unknown TenantInvitation edge %s", name)
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
+// TenantSettingMutation represents an operation that mutates the TenantSetting nodes in the graph.
+type TenantSettingMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int64
-	code              *string
-	name              *string
-	is_system         *bool
-	description       *string
-	is_active         *bool
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	user_roles        map[int64]struct{}
-	removeduser_roles map[int64]struct{}
-	cleareduser_roles bool
-	tenant            *int64
-	clearedtenant     bool
-	done              bool
-	oldValue          func(context.Context) (*Role, error)
-	predicates        []predicate.Role
+	op            Op
+	typ           string
+	id            *int64
+	key           *string
+	value         *jsontext.Value
+	appendvalue   jsontext.Value
+	updated_by    *int64
+	addupdated_by *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *int64
+	clearedtenant bool
+	done          bool
+	oldValue      func(context.Context) (*TenantSetting, error)
+	predicates    []predicate.TenantSetting
 }
 
-var _ ent.Mutation = (*RoleMutation)(nil)
+var _ ent.Mutation = (*TenantSettingMutation)(nil)
 
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
+// tenantsettingOption allows management of the mutation configuration using functional options.
+type tenantsettingOption func(*TenantSettingMutation)
 
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
+// newTenantSettingMutation creates new mutation for the TenantSetting entity.
+func newTenantSettingMutation(c config, op Op, opts ...tenantsettingOption) *TenantSettingMutation {
+	m := &TenantSettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRole,
+		typ:           TypeTenantSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1863,20 +9856,20 @@ func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
 	return m
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id int64) roleOption {
-	return func(m *RoleMutation) {
+// withTenantSettingID sets the ID field of the mutation.
+func withTenantSettingID(id int64) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Role
+			value *TenantSetting
 		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
+		m.oldValue = func(ctx context.Context) (*TenantSetting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Role.Get(ctx, id)
+					value, err = m.Client().TenantSetting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1885,10 +9878,10 @@ func withRoleID(id int64) roleOption {
 	}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
+// withTenantSetting sets the old TenantSetting of the mutation.
+func withTenantSetting(node *TenantSetting) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
+		m.oldValue = func(context.Context) (*TenantSetting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1897,7 +9890,7 @@ func withRole(node *Role) roleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
+func (m TenantSettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1905,7 +9898,7 @@ func (m RoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
+func (m TenantSettingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1915,14 +9908,14 @@ func (m RoleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Role entities.
-func (m *RoleMutation) SetID(id int64) {
+// operation is only accepted on creation of TenantSetting entities.
+func (m *TenantSettingMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id int64, exists bool) {
+func (m *TenantSettingMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1933,7 +9926,7 @@ func (m *RoleMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *TenantSettingMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1942,261 +9935,212 @@ func (m *RoleMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TenantSetting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *RoleMutation) SetCode(s string) {
-	m.code = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantSettingMutation) SetTenantID(i int64) {
+	m.tenant = &i
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *RoleMutation) Code() (r string, exists bool) {
-	v := m.code
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantSettingMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *TenantSettingMutation) OldTenantID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *RoleMutation) ResetCode() {
-	m.code = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantSettingMutation) ResetTenantID() {
+	m.tenant = nil
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
+// SetKey sets the "key" field.
+func (m *TenantSettingMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
+// Key returns the value of the "key" field in the mutation.
+func (m *TenantSettingMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TenantSettingMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Key, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
+// ResetKey resets all changes to the "key" field.
+func (m *TenantSettingMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *RoleMutation) SetTenantID(i int64) {
-	m.tenant = &i
+// SetValue sets the "value" field.
+func (m *TenantSettingMutation) SetValue(j jsontext.Value) {
+	m.value = &j
+	m.appendvalue = nil
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *RoleMutation) TenantID() (r int64, exists bool) {
-	v := m.tenant
+// Value returns the value of the "value" field in the mutation.
+func (m *TenantSettingMutation) Value() (r jsontext.Value, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldTenantID(ctx context.Context) (v *int64, err error) {
+func (m *TenantSettingMutation) OldValue(ctx context.Context) (v jsontext.Value, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.Value, nil
 }
 
-// ClearTenantID clears the value of the "tenant_id" field.
-func (m *RoleMutation) ClearTenantID() {
-	m.tenant = nil
-	m.clearedFields[role.FieldTenantID] = struct{}{}
+// AppendValue adds j to the "value" field.
+func (m *TenantSettingMutation) AppendValue(j jsontext.Value) {
+	m.appendvalue = append(m.appendvalue, j...)
 }
 
-// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
-func (m *RoleMutation) TenantIDCleared() bool {
-	_, ok := m.clearedFields[role.FieldTenantID]
-	return ok
+// AppendedValue returns the list of values that were appended to the "value" field in this mutation.
+func (m *TenantSettingMutation) AppendedValue() (jsontext.Value, bool) {
+	if len(m.appendvalue) == 0 {
+		return nil, false
+	}
+	return m.appendvalue, true
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *RoleMutation) ResetTenantID() {
-	m.tenant = nil
-	delete(m.clearedFields, role.FieldTenantID)
+// ResetValue resets all changes to the "value" field.
+func (m *TenantSettingMutation) ResetValue() {
+	m.value = nil
+	m.appendvalue = nil
 }
 
-// SetIsSystem sets the "is_system" field.
-func (m *RoleMutation) SetIsSystem(b bool) {
-	m.is_system = &b
+// SetUpdatedBy sets the "updated_by" field.
+func (m *TenantSettingMutation) SetUpdatedBy(i int64) {
+	m.updated_by = &i
+	m.addupdated_by = nil
 }
 
-// IsSystem returns the value of the "is_system" field in the mutation.
-func (m *RoleMutation) IsSystem() (r bool, exists bool) {
-	v := m.is_system
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *TenantSettingMutation) UpdatedBy() (r int64, exists bool) {
+	v := m.updated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSystem returns the old "is_system" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedBy returns the old "updated_by" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+func (m *TenantSettingMutation) OldUpdatedBy(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return oldValue.IsSystem, nil
-}
-
-// ResetIsSystem resets all changes to the "is_system" field.
-func (m *RoleMutation) ResetIsSystem() {
-	m.is_system = nil
+	return oldValue.UpdatedBy, nil
 }
 
-// SetDescription sets the "description" field.
-func (m *RoleMutation) SetDescription(s string) {
-	m.description = &s
+// AddUpdatedBy adds i to the "updated_by" field.
+func (m *TenantSettingMutation) AddUpdatedBy(i int64) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += i
+	} else {
+		m.addupdated_by = &i
+	}
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RoleMutation) Description() (r string, exists bool) {
-	v := m.description
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *TenantSettingMutation) AddedUpdatedBy() (r int64, exists bool) {
+	v := m.addupdated_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *RoleMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[role.FieldDescription] = struct{}{}
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *TenantSettingMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[tenantsetting.FieldUpdatedBy] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RoleMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[role.FieldDescription]
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *TenantSettingMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUpdatedBy]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RoleMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, role.FieldDescription)
-}
-
-// SetIsActive sets the "is_active" field.
-func (m *RoleMutation) SetIsActive(b bool) {
-	m.is_active = &b
-}
-
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *RoleMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsActive returns the old "is_active" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
-	}
-	return oldValue.IsActive, nil
-}
-
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *RoleMutation) ResetIsActive() {
-	m.is_active = nil
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *TenantSettingMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, tenantsetting.FieldUpdatedBy)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RoleMutation) SetCreatedAt(t time.Time) {
+func (m *TenantSettingMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TenantSettingMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2204,10 +10148,10 @@ func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2222,115 +10166,61 @@ func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
-func (m *RoleMutation) AddUserRoleIDs(ids ...int64) {
-	if m.user_roles == nil {
-		m.user_roles = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.user_roles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
-func (m *RoleMutation) ClearUserRoles() {
-	m.cleareduser_roles = true
-}
-
-// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
-func (m *RoleMutation) UserRolesCleared() bool {
-	return m.cleareduser_roles
+func (m *TenantSettingMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
-func (m *RoleMutation) RemoveUserRoleIDs(ids ...int64) {
-	if m.removeduser_roles == nil {
-		m.removeduser_roles = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.user_roles, ids[i])
-		m.removeduser_roles[ids[i]] = struct{}{}
-	}
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TenantSettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
-func (m *RoleMutation) RemovedUserRolesIDs() (ids []int64) {
-	for id := range m.removeduser_roles {
-		ids = append(ids, id)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TenantSettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
-func (m *RoleMutation) UserRolesIDs() (ids []int64) {
-	for id := range m.user_roles {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetUserRoles resets all changes to the "user_roles" edge.
-func (m *RoleMutation) ResetUserRoles() {
-	m.user_roles = nil
-	m.cleareduser_roles = false
-	m.removeduser_roles = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TenantSettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *RoleMutation) ClearTenant() {
+func (m *TenantSettingMutation) ClearTenant() {
 	m.clearedtenant = true
-	m.clearedFields[role.FieldTenantID] = struct{}{}
+	m.clearedFields[tenantsetting.FieldTenantID] = struct{}{}
 }
 
 // TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *RoleMutation) TenantCleared() bool {
-	return m.TenantIDCleared() || m.clearedtenant
+func (m *TenantSettingMutation) TenantCleared() bool {
+	return m.clearedtenant
 }
 
 // TenantIDs returns the "tenant" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // TenantID instead. It exists only for internal usage by the builders.
-func (m *RoleMutation) TenantIDs() (ids []int64) {
+func (m *TenantSettingMutation) TenantIDs() (ids []int64) {
 	if id := m.tenant; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2338,20 +10228,20 @@ func (m *RoleMutation) TenantIDs() (ids []int64) {
 }
 
 // ResetTenant resets all changes to the "tenant" edge.
-func (m *RoleMutation) ResetTenant() {
+func (m *TenantSettingMutation) ResetTenant() {
 	m.tenant = nil
 	m.clearedtenant = false
 }
 
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
+// Where appends a list predicates to the TenantSettingMutation builder.
+func (m *TenantSettingMutation) Where(ps ...predicate.TenantSetting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantSettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *TenantSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantSetting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2359,48 +10249,42 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *TenantSettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *TenantSettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (TenantSetting).
+func (m *TenantSettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.code != nil {
-		fields = append(fields, role.FieldCode)
-	}
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
-	}
+func (m *TenantSettingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.tenant != nil {
-		fields = append(fields, role.FieldTenantID)
+		fields = append(fields, tenantsetting.FieldTenantID)
 	}
-	if m.is_system != nil {
-		fields = append(fields, role.FieldIsSystem)
+	if m.key != nil {
+		fields = append(fields, tenantsetting.FieldKey)
 	}
-	if m.description != nil {
-		fields = append(fields, role.FieldDescription)
+	if m.value != nil {
+		fields = append(fields, tenantsetting.FieldValue)
 	}
-	if m.is_active != nil {
-		fields = append(fields, role.FieldIsActive)
+	if m.updated_by != nil {
+		fields = append(fields, tenantsetting.FieldUpdatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, role.FieldCreatedAt)
+		fields = append(fields, tenantsetting.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, role.FieldUpdatedAt)
+		fields = append(fields, tenantsetting.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2408,23 +10292,19 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantSettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldCode:
-		return m.Code()
-	case role.FieldName:
-		return m.Name()
-	case role.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		return m.TenantID()
-	case role.FieldIsSystem:
-		return m.IsSystem()
-	case role.FieldDescription:
-		return m.Description()
-	case role.FieldIsActive:
-		return m.IsActive()
-	case role.FieldCreatedAt:
+	case tenantsetting.FieldKey:
+		return m.Key()
+	case tenantsetting.FieldValue:
+		return m.Value()
+	case tenantsetting.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case tenantsetting.FieldCreatedAt:
 		return m.CreatedAt()
-	case role.FieldUpdatedAt:
+	case tenantsetting.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2433,83 +10313,65 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldCode:
-		return m.OldCode(ctx)
-	case role.FieldName:
-		return m.OldName(ctx)
-	case role.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case role.FieldIsSystem:
-		return m.OldIsSystem(ctx)
-	case role.FieldDescription:
-		return m.OldDescription(ctx)
-	case role.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case role.FieldCreatedAt:
+	case tenantsetting.FieldKey:
+		return m.OldKey(ctx)
+	case tenantsetting.FieldValue:
+		return m.OldValue(ctx)
+	case tenantsetting.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case tenantsetting.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case role.FieldUpdatedAt:
+	case tenantsetting.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *TenantSettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCode(v)
-		return nil
-	case role.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case role.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case role.FieldIsSystem:
-		v, ok := value.(bool)
+	case tenantsetting.FieldKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSystem(v)
+		m.SetKey(v)
 		return nil
-	case role.FieldDescription:
-		v, ok := value.(string)
+	case tenantsetting.FieldValue:
+		v, ok := value.(jsontext.Value)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetValue(v)
 		return nil
-	case role.FieldIsActive:
-		v, ok := value.(bool)
+	case tenantsetting.FieldUpdatedBy:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetUpdatedBy(v)
 		return nil
-	case role.FieldCreatedAt:
+	case tenantsetting.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case role.FieldUpdatedAt:
+	case tenantsetting.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2517,21 +10379,26 @@ func (m *RoleMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
+func (m *TenantSettingMutation) AddedFields() []string {
 	var fields []string
+	if m.addupdated_by != nil {
+		fields = append(fields, tenantsetting.FieldUpdatedBy)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TenantSettingMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case tenantsetting.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
 	}
 	return nil, false
 }
@@ -2539,101 +10406,87 @@ func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *TenantSettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tenantsetting.FieldUpdatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown TenantSetting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
+func (m *TenantSettingMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(role.FieldTenantID) {
-		fields = append(fields, role.FieldTenantID)
-	}
-	if m.FieldCleared(role.FieldDescription) {
-		fields = append(fields, role.FieldDescription)
+	if m.FieldCleared(tenantsetting.FieldUpdatedBy) {
+		fields = append(fields, tenantsetting.FieldUpdatedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *TenantSettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
+func (m *TenantSettingMutation) ClearField(name string) error {
 	switch name {
-	case role.FieldTenantID:
-		m.ClearTenantID()
-		return nil
-	case role.FieldDescription:
-		m.ClearDescription()
+	case tenantsetting.FieldUpdatedBy:
+		m.ClearUpdatedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown Role nullable field %s", name)
+	return fmt.Errorf("unknown TenantSetting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *TenantSettingMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldCode:
-		m.ResetCode()
-		return nil
-	case role.FieldName:
-		m.ResetName()
-		return nil
-	case role.FieldTenantID:
+	case tenantsetting.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case role.FieldIsSystem:
-		m.ResetIsSystem()
+	case tenantsetting.FieldKey:
+		m.ResetKey()
 		return nil
-	case role.FieldDescription:
-		m.ResetDescription()
+	case tenantsetting.FieldValue:
+		m.ResetValue()
 		return nil
-	case role.FieldIsActive:
-		m.ResetIsActive()
+	case tenantsetting.FieldUpdatedBy:
+		m.ResetUpdatedBy()
 		return nil
-	case role.FieldCreatedAt:
+	case tenantsetting.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case role.FieldUpdatedAt:
+	case tenantsetting.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user_roles != nil {
-		edges = append(edges, role.EdgeUserRoles)
-	}
+func (m *TenantSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.tenant != nil {
-		edges = append(edges, role.EdgeTenant)
+		edges = append(edges, tenantsetting.EdgeTenant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantSettingMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.user_roles))
-		for id := range m.user_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeTenant:
+	case tenantsetting.EdgeTenant:
 		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2642,47 +10495,31 @@ func (m *RoleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeduser_roles != nil {
-		edges = append(edges, role.EdgeUserRoles)
-	}
+func (m *TenantSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.removeduser_roles))
-		for id := range m.removeduser_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TenantSettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser_roles {
-		edges = append(edges, role.EdgeUserRoles)
-	}
+func (m *TenantSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedtenant {
-		edges = append(edges, role.EdgeTenant)
+		edges = append(edges, tenantsetting.EdgeTenant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
+func (m *TenantSettingMutation) EdgeCleared(name string) bool {
 	switch name {
-	case role.EdgeUserRoles:
-		return m.cleareduser_roles
-	case role.EdgeTenant:
+	case tenantsetting.EdgeTenant:
 		return m.clearedtenant
 	}
 	return false
@@ -2690,86 +10527,61 @@ func (m *RoleMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
+func (m *TenantSettingMutation) ClearEdge(name string) error {
 	switch name {
-	case role.EdgeTenant:
+	case tenantsetting.EdgeTenant:
 		m.ClearTenant()
 		return nil
 	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+	return fmt.Errorf("unknown TenantSetting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
+func (m *TenantSettingMutation) ResetEdge(name string) error {
 	switch name {
-	case role.EdgeUserRoles:
-		m.ResetUserRoles()
-		return nil
-	case role.EdgeTenant:
-		m.ResetTenant()
-		return nil
-	}
-	return fmt.Errorf("unknown Role edge %s", name)
-}
-
-// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
-type TenantMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *int64
-	name                *string
-	owner_id            *int64
-	addowner_id         *int64
-	_type               *tenant.Type
-	_path               *string
-	level               *int32
-	addlevel            *int32
-	status              *tenant.Status
-	expired_at          *time.Time
-	attributes          *map[string]interface{}
-	created_by          *int64
-	addcreated_by       *int64
-	updated_by          *int64
-	addupdated_by       *int64
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted_at          *time.Time
-	clearedFields       map[string]struct{}
-	user_tenants        map[int]struct{}
-	removeduser_tenants map[int]struct{}
-	cleareduser_tenants bool
-	departments         map[int64]struct{}
-	removeddepartments  map[int64]struct{}
-	cleareddepartments  bool
-	parent              *int64
-	clearedparent       bool
-	children            map[int64]struct{}
-	removedchildren     map[int64]struct{}
-	clearedchildren     bool
-	roles               map[int64]struct{}
-	removedroles        map[int64]struct{}
-	clearedroles        bool
-	user_roles          map[int64]struct{}
-	removeduser_roles   map[int64]struct{}
-	cleareduser_roles   bool
-	done                bool
-	oldValue            func(context.Context) (*Tenant, error)
-	predicates          []predicate.Tenant
+	case tenantsetting.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting edge %s", name)
 }
 
-var _ ent.Mutation = (*TenantMutation)(nil)
-
-// tenantOption allows management of the mutation configuration using functional options.
-type tenantOption func(*TenantMutation)
-
-// newTenantMutation creates new mutation for the Tenant entity.
-func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
-	m := &TenantMutation{
+// TenantSettingLogMutation represents an operation that mutates the TenantSettingLog nodes in the graph.
+type TenantSettingLogMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	key             *string
+	action          *tenantsettinglog.Action
+	old_value       *jsontext.Value
+	appendold_value jsontext.Value
+	new_value       *jsontext.Value
+	appendnew_value jsontext.Value
+	reason          *string
+	operator_id     *int64
+	addoperator_id  *int64
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	tenant          *int64
+	clearedtenant   bool
+	done            bool
+	oldValue        func(context.Context) (*TenantSettingLog, error)
+	predicates      []predicate.TenantSettingLog
+}
+
+var _ ent.Mutation = (*TenantSettingLogMutation)(nil)
+
+// tenantsettinglogOption allows management of the mutation configuration using functional options.
+type tenantsettinglogOption func(*TenantSettingLogMutation)
+
+// newTenantSettingLogMutation creates new mutation for the TenantSettingLog entity.
+func newTenantSettingLogMutation(c config, op Op, opts ...tenantsettinglogOption) *TenantSettingLogMutation {
+	m := &TenantSettingLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTenant,
+		typ:           TypeTenantSettingLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2778,20 +10590,20 @@ func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
 	return m
 }
 
-// withTenantID sets the ID field of the mutation.
-func withTenantID(id int64) tenantOption {
-	return func(m *TenantMutation) {
+// withTenantSettingLogID sets the ID field of the mutation.
+func withTenantSettingLogID(id int64) tenantsettinglogOption {
+	return func(m *TenantSettingLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tenant
+			value *TenantSettingLog
 		)
-		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+		m.oldValue = func(ctx context.Context) (*TenantSettingLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tenant.Get(ctx, id)
+					value, err = m.Client().TenantSettingLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2800,10 +10612,10 @@ func withTenantID(id int64) tenantOption {
 	}
 }
 
-// withTenant sets the old Tenant of the mutation.
-func withTenant(node *Tenant) tenantOption {
-	return func(m *TenantMutation) {
-		m.oldValue = func(context.Context) (*Tenant, error) {
+// withTenantSettingLog sets the old TenantSettingLog of the mutation.
+func withTenantSettingLog(node *TenantSettingLog) tenantsettinglogOption {
+	return func(m *TenantSettingLogMutation) {
+		m.oldValue = func(context.Context) (*TenantSettingLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2812,7 +10624,7 @@ func withTenant(node *Tenant) tenantOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TenantMutation) Client() *Client {
+func (m TenantSettingLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2820,7 +10632,7 @@ func (m TenantMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TenantMutation) Tx() (*Tx, error) {
+func (m TenantSettingLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2830,14 +10642,14 @@ func (m TenantMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tenant entities.
-func (m *TenantMutation) SetID(id int64) {
+// operation is only accepted on creation of TenantSettingLog entities.
+func (m *TenantSettingLogMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TenantMutation) ID() (id int64, exists bool) {
+func (m *TenantSettingLogMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2848,7 +10660,7 @@ func (m *TenantMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TenantMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *TenantSettingLogMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2857,982 +10669,1308 @@ func (m *TenantMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TenantSettingLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TenantMutation) SetName(s string) {
-	m.name = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantSettingLogMutation) SetTenantID(i int64) {
+	m.tenant = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TenantMutation) Name() (r string, exists bool) {
-	v := m.name
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantSettingLogMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TenantSettingLogMutation) OldTenantID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TenantMutation) ResetName() {
-	m.name = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantSettingLogMutation) ResetTenantID() {
+	m.tenant = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *TenantMutation) SetOwnerID(i int64) {
-	m.owner_id = &i
-	m.addowner_id = nil
+// SetKey sets the "key" field.
+func (m *TenantSettingLogMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *TenantMutation) OwnerID() (r int64, exists bool) {
-	v := m.owner_id
+// Key returns the value of the "key" field in the mutation.
+func (m *TenantSettingLogMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldOwnerID(ctx context.Context) (v int64, err error) {
+func (m *TenantSettingLogMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.Key, nil
 }
 
-// AddOwnerID adds i to the "owner_id" field.
-func (m *TenantMutation) AddOwnerID(i int64) {
-	if m.addowner_id != nil {
-		*m.addowner_id += i
-	} else {
-		m.addowner_id = &i
-	}
+// ResetKey resets all changes to the "key" field.
+func (m *TenantSettingLogMutation) ResetKey() {
+	m.key = nil
 }
 
-// AddedOwnerID returns the value that was added to the "owner_id" field in this mutation.
-func (m *TenantMutation) AddedOwnerID() (r int64, exists bool) {
-	v := m.addowner_id
+// SetAction sets the "action" field.
+func (m *TenantSettingLogMutation) SetAction(t tenantsettinglog.Action) {
+	m.action = &t
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *TenantSettingLogMutation) Action() (r tenantsettinglog.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *TenantMutation) ResetOwnerID() {
-	m.owner_id = nil
-	m.addowner_id = nil
+// OldAction returns the old "action" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingLogMutation) OldAction(ctx context.Context) (v tenantsettinglog.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// SetType sets the "type" field.
-func (m *TenantMutation) SetType(t tenant.Type) {
-	m._type = &t
+// ResetAction resets all changes to the "action" field.
+func (m *TenantSettingLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TenantMutation) GetType() (r tenant.Type, exists bool) {
-	v := m._type
+// SetOldValue sets the "old_value" field.
+func (m *TenantSettingLogMutation) SetOldValue(j jsontext.Value) {
+	m.old_value = &j
+	m.appendold_value = nil
+}
+
+// OldValue returns the value of the "old_value" field in the mutation.
+func (m *TenantSettingLogMutation) OldValue() (r jsontext.Value, exists bool) {
+	v := m.old_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldOldValue returns the old "old_value" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldType(ctx context.Context) (v tenant.Type, err error) {
+func (m *TenantSettingLogMutation) OldOldValue(ctx context.Context) (v jsontext.Value, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldOldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldOldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldOldValue: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.OldValue, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TenantMutation) ResetType() {
-	m._type = nil
+// AppendOldValue adds j to the "old_value" field.
+func (m *TenantSettingLogMutation) AppendOldValue(j jsontext.Value) {
+	m.appendold_value = append(m.appendold_value, j...)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *TenantMutation) SetParentID(i int64) {
-	m.parent = &i
+// AppendedOldValue returns the list of values that were appended to the "old_value" field in this mutation.
+func (m *TenantSettingLogMutation) AppendedOldValue() (jsontext.Value, bool) {
+	if len(m.appendold_value) == 0 {
+		return nil, false
+	}
+	return m.appendold_value, true
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *TenantMutation) ParentID() (r int64, exists bool) {
-	v := m.parent
+// ClearOldValue clears the value of the "old_value" field.
+func (m *TenantSettingLogMutation) ClearOldValue() {
+	m.old_value = nil
+	m.appendold_value = nil
+	m.clearedFields[tenantsettinglog.FieldOldValue] = struct{}{}
+}
+
+// OldValueCleared returns if the "old_value" field was cleared in this mutation.
+func (m *TenantSettingLogMutation) OldValueCleared() bool {
+	_, ok := m.clearedFields[tenantsettinglog.FieldOldValue]
+	return ok
+}
+
+// ResetOldValue resets all changes to the "old_value" field.
+func (m *TenantSettingLogMutation) ResetOldValue() {
+	m.old_value = nil
+	m.appendold_value = nil
+	delete(m.clearedFields, tenantsettinglog.FieldOldValue)
+}
+
+// SetNewValue sets the "new_value" field.
+func (m *TenantSettingLogMutation) SetNewValue(j jsontext.Value) {
+	m.new_value = &j
+	m.appendnew_value = nil
+}
+
+// NewValue returns the value of the "new_value" field in the mutation.
+func (m *TenantSettingLogMutation) NewValue() (r jsontext.Value, exists bool) {
+	v := m.new_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldNewValue returns the old "new_value" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldParentID(ctx context.Context) (v *int64, err error) {
+func (m *TenantSettingLogMutation) OldNewValue(ctx context.Context) (v jsontext.Value, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNewValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldNewValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldNewValue: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.NewValue, nil
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *TenantMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[tenant.FieldParentID] = struct{}{}
+// AppendNewValue adds j to the "new_value" field.
+func (m *TenantSettingLogMutation) AppendNewValue(j jsontext.Value) {
+	m.appendnew_value = append(m.appendnew_value, j...)
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *TenantMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldParentID]
+// AppendedNewValue returns the list of values that were appended to the "new_value" field in this mutation.
+func (m *TenantSettingLogMutation) AppendedNewValue() (jsontext.Value, bool) {
+	if len(m.appendnew_value) == 0 {
+		return nil, false
+	}
+	return m.appendnew_value, true
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (m *TenantSettingLogMutation) ClearNewValue() {
+	m.new_value = nil
+	m.appendnew_value = nil
+	m.clearedFields[tenantsettinglog.FieldNewValue] = struct{}{}
+}
+
+// NewValueCleared returns if the "new_value" field was cleared in this mutation.
+func (m *TenantSettingLogMutation) NewValueCleared() bool {
+	_, ok := m.clearedFields[tenantsettinglog.FieldNewValue]
 	return ok
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *TenantMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, tenant.FieldParentID)
+// ResetNewValue resets all changes to the "new_value" field.
+func (m *TenantSettingLogMutation) ResetNewValue() {
+	m.new_value = nil
+	m.appendnew_value = nil
+	delete(m.clearedFields, tenantsettinglog.FieldNewValue)
 }
 
-// SetPath sets the "path" field.
-func (m *TenantMutation) SetPath(s string) {
-	m._path = &s
+// SetReason sets the "reason" field.
+func (m *TenantSettingLogMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *TenantMutation) Path() (r string, exists bool) {
-	v := m._path
+// Reason returns the value of the "reason" field in the mutation.
+func (m *TenantSettingLogMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldPath(ctx context.Context) (v *string, err error) {
+func (m *TenantSettingLogMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.Reason, nil
 }
 
-// ClearPath clears the value of the "path" field.
-func (m *TenantMutation) ClearPath() {
-	m._path = nil
-	m.clearedFields[tenant.FieldPath] = struct{}{}
+// ClearReason clears the value of the "reason" field.
+func (m *TenantSettingLogMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[tenantsettinglog.FieldReason] = struct{}{}
 }
 
-// PathCleared returns if the "path" field was cleared in this mutation.
-func (m *TenantMutation) PathCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldPath]
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *TenantSettingLogMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[tenantsettinglog.FieldReason]
 	return ok
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *TenantMutation) ResetPath() {
-	m._path = nil
-	delete(m.clearedFields, tenant.FieldPath)
+// ResetReason resets all changes to the "reason" field.
+func (m *TenantSettingLogMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, tenantsettinglog.FieldReason)
 }
 
-// SetLevel sets the "level" field.
-func (m *TenantMutation) SetLevel(i int32) {
-	m.level = &i
-	m.addlevel = nil
+// SetOperatorID sets the "operator_id" field.
+func (m *TenantSettingLogMutation) SetOperatorID(i int64) {
+	m.operator_id = &i
+	m.addoperator_id = nil
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *TenantMutation) Level() (r int32, exists bool) {
-	v := m.level
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *TenantSettingLogMutation) OperatorID() (r int64, exists bool) {
+	v := m.operator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldOperatorID returns the old "operator_id" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldLevel(ctx context.Context) (v int32, err error) {
+func (m *TenantSettingLogMutation) OldOperatorID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
 	}
-	return oldValue.Level, nil
+	return oldValue.OperatorID, nil
 }
 
-// AddLevel adds i to the "level" field.
-func (m *TenantMutation) AddLevel(i int32) {
-	if m.addlevel != nil {
-		*m.addlevel += i
+// AddOperatorID adds i to the "operator_id" field.
+func (m *TenantSettingLogMutation) AddOperatorID(i int64) {
+	if m.addoperator_id != nil {
+		*m.addoperator_id += i
 	} else {
-		m.addlevel = &i
+		m.addoperator_id = &i
 	}
 }
 
-// AddedLevel returns the value that was added to the "level" field in this mutation.
-func (m *TenantMutation) AddedLevel() (r int32, exists bool) {
-	v := m.addlevel
+// AddedOperatorID returns the value that was added to the "operator_id" field in this mutation.
+func (m *TenantSettingLogMutation) AddedOperatorID() (r int64, exists bool) {
+	v := m.addoperator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *TenantMutation) ResetLevel() {
-	m.level = nil
-	m.addlevel = nil
+// ClearOperatorID clears the value of the "operator_id" field.
+func (m *TenantSettingLogMutation) ClearOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	m.clearedFields[tenantsettinglog.FieldOperatorID] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *TenantMutation) SetStatus(t tenant.Status) {
-	m.status = &t
+// OperatorIDCleared returns if the "operator_id" field was cleared in this mutation.
+func (m *TenantSettingLogMutation) OperatorIDCleared() bool {
+	_, ok := m.clearedFields[tenantsettinglog.FieldOperatorID]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TenantMutation) Status() (r tenant.Status, exists bool) {
-	v := m.status
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *TenantSettingLogMutation) ResetOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	delete(m.clearedFields, tenantsettinglog.FieldOperatorID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TenantSettingLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TenantSettingLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TenantSettingLog entity.
+// If the TenantSettingLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldStatus(ctx context.Context) (v tenant.Status, err error) {
+func (m *TenantSettingLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TenantMutation) ResetStatus() {
-	m.status = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TenantSettingLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *TenantSettingLogMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[tenantsettinglog.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *TenantSettingLogMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *TenantSettingLogMutation) TenantIDs() (ids []int64) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *TenantSettingLogMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
 }
 
-// SetExpiredAt sets the "expired_at" field.
-func (m *TenantMutation) SetExpiredAt(t time.Time) {
-	m.expired_at = &t
+// Where appends a list predicates to the TenantSettingLogMutation builder.
+func (m *TenantSettingLogMutation) Where(ps ...predicate.TenantSettingLog) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ExpiredAt returns the value of the "expired_at" field in the mutation.
-func (m *TenantMutation) ExpiredAt() (r time.Time, exists bool) {
-	v := m.expired_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the TenantSettingLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TenantSettingLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantSettingLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldExpiredAt returns the old "expired_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldExpiredAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
-	}
-	return oldValue.ExpiredAt, nil
+// Op returns the operation name.
+func (m *TenantSettingLogMutation) Op() Op {
+	return m.op
 }
 
-// ClearExpiredAt clears the value of the "expired_at" field.
-func (m *TenantMutation) ClearExpiredAt() {
-	m.expired_at = nil
-	m.clearedFields[tenant.FieldExpiredAt] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *TenantSettingLogMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ExpiredAtCleared returns if the "expired_at" field was cleared in this mutation.
-func (m *TenantMutation) ExpiredAtCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldExpiredAt]
-	return ok
+// Type returns the node type of this mutation (TenantSettingLog).
+func (m *TenantSettingLogMutation) Type() string {
+	return m.typ
 }
 
-// ResetExpiredAt resets all changes to the "expired_at" field.
-func (m *TenantMutation) ResetExpiredAt() {
-	m.expired_at = nil
-	delete(m.clearedFields, tenant.FieldExpiredAt)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TenantSettingLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, tenantsettinglog.FieldTenantID)
+	}
+	if m.key != nil {
+		fields = append(fields, tenantsettinglog.FieldKey)
+	}
+	if m.action != nil {
+		fields = append(fields, tenantsettinglog.FieldAction)
+	}
+	if m.old_value != nil {
+		fields = append(fields, tenantsettinglog.FieldOldValue)
+	}
+	if m.new_value != nil {
+		fields = append(fields, tenantsettinglog.FieldNewValue)
+	}
+	if m.reason != nil {
+		fields = append(fields, tenantsettinglog.FieldReason)
+	}
+	if m.operator_id != nil {
+		fields = append(fields, tenantsettinglog.FieldOperatorID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tenantsettinglog.FieldCreatedAt)
+	}
+	return fields
 }
 
-// SetAttributes sets the "attributes" field.
-func (m *TenantMutation) SetAttributes(value map[string]interface{}) {
-	m.attributes = &value
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TenantSettingLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tenantsettinglog.FieldTenantID:
+		return m.TenantID()
+	case tenantsettinglog.FieldKey:
+		return m.Key()
+	case tenantsettinglog.FieldAction:
+		return m.Action()
+	case tenantsettinglog.FieldOldValue:
+		return m.OldValue()
+	case tenantsettinglog.FieldNewValue:
+		return m.NewValue()
+	case tenantsettinglog.FieldReason:
+		return m.Reason()
+	case tenantsettinglog.FieldOperatorID:
+		return m.OperatorID()
+	case tenantsettinglog.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// Attributes returns the value of the "attributes" field in the mutation.
-func (m *TenantMutation) Attributes() (r map[string]interface{}, exists bool) {
-	v := m.attributes
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TenantSettingLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tenantsettinglog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tenantsettinglog.FieldKey:
+		return m.OldKey(ctx)
+	case tenantsettinglog.FieldAction:
+		return m.OldAction(ctx)
+	case tenantsettinglog.FieldOldValue:
+		return m.OldOldValue(ctx)
+	case tenantsettinglog.FieldNewValue:
+		return m.OldNewValue(ctx)
+	case tenantsettinglog.FieldReason:
+		return m.OldReason(ctx)
+	case tenantsettinglog.FieldOperatorID:
+		return m.OldOperatorID(ctx)
+	case tenantsettinglog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown TenantSettingLog field %s", name)
 }
 
-// OldAttributes returns the old "attributes" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldAttributes(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttributes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantSettingLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tenantsettinglog.FieldTenantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case tenantsettinglog.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case tenantsettinglog.FieldAction:
+		v, ok := value.(tenantsettinglog.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case tenantsettinglog.FieldOldValue:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldValue(v)
+		return nil
+	case tenantsettinglog.FieldNewValue:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewValue(v)
+		return nil
+	case tenantsettinglog.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case tenantsettinglog.FieldOperatorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorID(v)
+		return nil
+	case tenantsettinglog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return oldValue.Attributes, nil
+	return fmt.Errorf("unknown TenantSettingLog field %s", name)
 }
 
-// ResetAttributes resets all changes to the "attributes" field.
-func (m *TenantMutation) ResetAttributes() {
-	m.attributes = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TenantSettingLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addoperator_id != nil {
+		fields = append(fields, tenantsettinglog.FieldOperatorID)
+	}
+	return fields
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *TenantMutation) SetCreatedBy(i int64) {
-	m.created_by = &i
-	m.addcreated_by = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TenantSettingLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tenantsettinglog.FieldOperatorID:
+		return m.AddedOperatorID()
+	}
+	return nil, false
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *TenantMutation) CreatedBy() (r int64, exists bool) {
-	v := m.created_by
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantSettingLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tenantsettinglog.FieldOperatorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOperatorID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TenantSettingLog numeric field %s", name)
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldCreatedBy(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantSettingLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tenantsettinglog.FieldOldValue) {
+		fields = append(fields, tenantsettinglog.FieldOldValue)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	if m.FieldCleared(tenantsettinglog.FieldNewValue) {
+		fields = append(fields, tenantsettinglog.FieldNewValue)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	if m.FieldCleared(tenantsettinglog.FieldReason) {
+		fields = append(fields, tenantsettinglog.FieldReason)
 	}
-	return oldValue.CreatedBy, nil
+	if m.FieldCleared(tenantsettinglog.FieldOperatorID) {
+		fields = append(fields, tenantsettinglog.FieldOperatorID)
+	}
+	return fields
 }
 
-// AddCreatedBy adds i to the "created_by" field.
-func (m *TenantMutation) AddCreatedBy(i int64) {
-	if m.addcreated_by != nil {
-		*m.addcreated_by += i
-	} else {
-		m.addcreated_by = &i
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TenantSettingLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TenantSettingLogMutation) ClearField(name string) error {
+	switch name {
+	case tenantsettinglog.FieldOldValue:
+		m.ClearOldValue()
+		return nil
+	case tenantsettinglog.FieldNewValue:
+		m.ClearNewValue()
+		return nil
+	case tenantsettinglog.FieldReason:
+		m.ClearReason()
+		return nil
+	case tenantsettinglog.FieldOperatorID:
+		m.ClearOperatorID()
+		return nil
 	}
+	return fmt.Errorf("unknown TenantSettingLog nullable field %s", name)
 }
 
-// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
-func (m *TenantMutation) AddedCreatedBy() (r int64, exists bool) {
-	v := m.addcreated_by
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TenantSettingLogMutation) ResetField(name string) error {
+	switch name {
+	case tenantsettinglog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case tenantsettinglog.FieldKey:
+		m.ResetKey()
+		return nil
+	case tenantsettinglog.FieldAction:
+		m.ResetAction()
+		return nil
+	case tenantsettinglog.FieldOldValue:
+		m.ResetOldValue()
+		return nil
+	case tenantsettinglog.FieldNewValue:
+		m.ResetNewValue()
+		return nil
+	case tenantsettinglog.FieldReason:
+		m.ResetReason()
+		return nil
+	case tenantsettinglog.FieldOperatorID:
+		m.ResetOperatorID()
+		return nil
+	case tenantsettinglog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TenantSettingLog field %s", name)
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *TenantMutation) ClearCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-	m.clearedFields[tenant.FieldCreatedBy] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantSettingLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tenant != nil {
+		edges = append(edges, tenantsettinglog.EdgeTenant)
+	}
+	return edges
 }
 
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *TenantMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldCreatedBy]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TenantSettingLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tenantsettinglog.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *TenantMutation) ResetCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-	delete(m.clearedFields, tenant.FieldCreatedBy)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TenantSettingLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (m *TenantMutation) SetUpdatedBy(i int64) {
-	m.updated_by = &i
-	m.addupdated_by = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TenantSettingLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// UpdatedBy returns the value of the "updated_by" field in the mutation.
-func (m *TenantMutation) UpdatedBy() (r int64, exists bool) {
-	v := m.updated_by
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TenantSettingLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtenant {
+		edges = append(edges, tenantsettinglog.EdgeTenant)
 	}
-	return *v, true
+	return edges
 }
 
-// OldUpdatedBy returns the old "updated_by" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldUpdatedBy(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TenantSettingLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tenantsettinglog.EdgeTenant:
+		return m.clearedtenant
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TenantSettingLogMutation) ClearEdge(name string) error {
+	switch name {
+	case tenantsettinglog.EdgeTenant:
+		m.ClearTenant()
+		return nil
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	return fmt.Errorf("unknown TenantSettingLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TenantSettingLogMutation) ResetEdge(name string) error {
+	switch name {
+	case tenantsettinglog.EdgeTenant:
+		m.ResetTenant()
+		return nil
 	}
-	return oldValue.UpdatedBy, nil
+	return fmt.Errorf("unknown TenantSettingLog edge %s", name)
 }
 
-// AddUpdatedBy adds i to the "updated_by" field.
-func (m *TenantMutation) AddUpdatedBy(i int64) {
-	if m.addupdated_by != nil {
-		*m.addupdated_by += i
-	} else {
-		m.addupdated_by = &i
+// TenantStatusLogMutation represents an operation that mutates the TenantStatusLog nodes in the graph.
+type TenantStatusLogMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int64
+	action         *tenantstatuslog.Action
+	from_status    *string
+	to_status      *string
+	expired_at     *time.Time
+	reason         *string
+	operator_id    *int64
+	addoperator_id *int64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	tenant         *int64
+	clearedtenant  bool
+	done           bool
+	oldValue       func(context.Context) (*TenantStatusLog, error)
+	predicates     []predicate.TenantStatusLog
+}
+
+var _ ent.Mutation = (*TenantStatusLogMutation)(nil)
+
+// tenantstatuslogOption allows management of the mutation configuration using functional options.
+type tenantstatuslogOption func(*TenantStatusLogMutation)
+
+// newTenantStatusLogMutation creates new mutation for the TenantStatusLog entity.
+func newTenantStatusLogMutation(c config, op Op, opts ...tenantstatuslogOption) *TenantStatusLogMutation {
+	m := &TenantStatusLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenantStatusLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
-func (m *TenantMutation) AddedUpdatedBy() (r int64, exists bool) {
-	v := m.addupdated_by
-	if v == nil {
-		return
+// withTenantStatusLogID sets the ID field of the mutation.
+func withTenantStatusLogID(id int64) tenantstatuslogOption {
+	return func(m *TenantStatusLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TenantStatusLog
+		)
+		m.oldValue = func(ctx context.Context) (*TenantStatusLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TenantStatusLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (m *TenantMutation) ClearUpdatedBy() {
-	m.updated_by = nil
-	m.addupdated_by = nil
-	m.clearedFields[tenant.FieldUpdatedBy] = struct{}{}
+// withTenantStatusLog sets the old TenantStatusLog of the mutation.
+func withTenantStatusLog(node *TenantStatusLog) tenantstatuslogOption {
+	return func(m *TenantStatusLogMutation) {
+		m.oldValue = func(context.Context) (*TenantStatusLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
-func (m *TenantMutation) UpdatedByCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldUpdatedBy]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantStatusLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetUpdatedBy resets all changes to the "updated_by" field.
-func (m *TenantMutation) ResetUpdatedBy() {
-	m.updated_by = nil
-	m.addupdated_by = nil
-	delete(m.clearedFields, tenant.FieldUpdatedBy)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantStatusLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TenantMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TenantStatusLog entities.
+func (m *TenantStatusLogMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TenantMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantStatusLogMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantStatusLogMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TenantStatusLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TenantMutation) ResetCreatedAt() {
-	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TenantMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantStatusLogMutation) SetTenantID(i int64) {
+	m.tenant = &i
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TenantMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantStatusLogMutation) TenantID() (r int64, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantStatusLogMutation) OldTenantID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TenantMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantStatusLogMutation) ResetTenantID() {
+	m.tenant = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *TenantMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetAction sets the "action" field.
+func (m *TenantStatusLogMutation) SetAction(t tenantstatuslog.Action) {
+	m.action = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *TenantMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Action returns the value of the "action" field in the mutation.
+func (m *TenantStatusLogMutation) Action() (r tenantstatuslog.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantStatusLogMutation) OldAction(ctx context.Context) (v tenantstatuslog.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *TenantMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[tenant.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *TenantMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *TenantMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, tenant.FieldDeletedAt)
-}
-
-// AddUserTenantIDs adds the "user_tenants" edge to the UserTenant entity by ids.
-func (m *TenantMutation) AddUserTenantIDs(ids ...int) {
-	if m.user_tenants == nil {
-		m.user_tenants = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user_tenants[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUserTenants clears the "user_tenants" edge to the UserTenant entity.
-func (m *TenantMutation) ClearUserTenants() {
-	m.cleareduser_tenants = true
-}
-
-// UserTenantsCleared reports if the "user_tenants" edge to the UserTenant entity was cleared.
-func (m *TenantMutation) UserTenantsCleared() bool {
-	return m.cleareduser_tenants
-}
-
-// RemoveUserTenantIDs removes the "user_tenants" edge to the UserTenant entity by IDs.
-func (m *TenantMutation) RemoveUserTenantIDs(ids ...int) {
-	if m.removeduser_tenants == nil {
-		m.removeduser_tenants = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user_tenants, ids[i])
-		m.removeduser_tenants[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
+	return oldValue.Action, nil
 }
 
-// RemovedUserTenants returns the removed IDs of the "user_tenants" edge to the UserTenant entity.
-func (m *TenantMutation) RemovedUserTenantsIDs() (ids []int) {
-	for id := range m.removeduser_tenants {
-		ids = append(ids, id)
-	}
-	return
+// ResetAction resets all changes to the "action" field.
+func (m *TenantStatusLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// UserTenantsIDs returns the "user_tenants" edge IDs in the mutation.
-func (m *TenantMutation) UserTenantsIDs() (ids []int) {
-	for id := range m.user_tenants {
-		ids = append(ids, id)
-	}
-	return
+// SetFromStatus sets the "from_status" field.
+func (m *TenantStatusLogMutation) SetFromStatus(s string) {
+	m.from_status = &s
 }
 
-// ResetUserTenants resets all changes to the "user_tenants" edge.
-func (m *TenantMutation) ResetUserTenants() {
-	m.user_tenants = nil
-	m.cleareduser_tenants = false
-	m.removeduser_tenants = nil
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *TenantStatusLogMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddDepartmentIDs adds the "departments" edge to the Department entity by ids.
-func (m *TenantMutation) AddDepartmentIDs(ids ...int64) {
-	if m.departments == nil {
-		m.departments = make(map[int64]struct{})
+// OldFromStatus returns the old "from_status" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantStatusLogMutation) OldFromStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.departments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
 }
 
-// ClearDepartments clears the "departments" edge to the Department entity.
-func (m *TenantMutation) ClearDepartments() {
-	m.cleareddepartments = true
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *TenantStatusLogMutation) ResetFromStatus() {
+	m.from_status = nil
 }
 
-// DepartmentsCleared reports if the "departments" edge to the Department entity was cleared.
-func (m *TenantMutation) DepartmentsCleared() bool {
-	return m.cleareddepartments
+// SetToStatus sets the "to_status" field.
+func (m *TenantStatusLogMutation) SetToStatus(s string) {
+	m.to_status = &s
 }
 
-// RemoveDepartmentIDs removes the "departments" edge to the Department entity by IDs.
-func (m *TenantMutation) RemoveDepartmentIDs(ids ...int64) {
-	if m.removeddepartments == nil {
-		m.removeddepartments = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.departments, ids[i])
-		m.removeddepartments[ids[i]] = struct{}{}
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *TenantStatusLogMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedDepartments returns the removed IDs of the "departments" edge to the Department entity.
-func (m *TenantMutation) RemovedDepartmentsIDs() (ids []int64) {
-	for id := range m.removeddepartments {
-		ids = append(ids, id)
+// OldToStatus returns the old "to_status" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantStatusLogMutation) OldToStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// DepartmentsIDs returns the "departments" edge IDs in the mutation.
-func (m *TenantMutation) DepartmentsIDs() (ids []int64) {
-	for id := range m.departments {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
 }
 
-// ResetDepartments resets all changes to the "departments" edge.
-func (m *TenantMutation) ResetDepartments() {
-	m.departments = nil
-	m.cleareddepartments = false
-	m.removeddepartments = nil
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *TenantStatusLogMutation) ResetToStatus() {
+	m.to_status = nil
 }
 
-// ClearParent clears the "parent" edge to the Tenant entity.
-func (m *TenantMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[tenant.FieldParentID] = struct{}{}
+// SetExpiredAt sets the "expired_at" field.
+func (m *TenantStatusLogMutation) SetExpiredAt(t time.Time) {
+	m.expired_at = &t
 }
 
-// ParentCleared reports if the "parent" edge to the Tenant entity was cleared.
-func (m *TenantMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// ExpiredAt returns the value of the "expired_at" field in the mutation.
+func (m *TenantStatusLogMutation) ExpiredAt() (r time.Time, exists bool) {
+	v := m.expired_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *TenantMutation) ParentIDs() (ids []int64) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// OldExpiredAt returns the old "expired_at" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantStatusLogMutation) OldExpiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiredAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiredAt: %w", err)
+	}
+	return oldValue.ExpiredAt, nil
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *TenantMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (m *TenantStatusLogMutation) ClearExpiredAt() {
+	m.expired_at = nil
+	m.clearedFields[tenantstatuslog.FieldExpiredAt] = struct{}{}
 }
 
-// AddChildIDs adds the "children" edge to the Tenant entity by ids.
-func (m *TenantMutation) AddChildIDs(ids ...int64) {
-	if m.children == nil {
-		m.children = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
-	}
+// ExpiredAtCleared returns if the "expired_at" field was cleared in this mutation.
+func (m *TenantStatusLogMutation) ExpiredAtCleared() bool {
+	_, ok := m.clearedFields[tenantstatuslog.FieldExpiredAt]
+	return ok
 }
 
-// ClearChildren clears the "children" edge to the Tenant entity.
-func (m *TenantMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetExpiredAt resets all changes to the "expired_at" field.
+func (m *TenantStatusLogMutation) ResetExpiredAt() {
+	m.expired_at = nil
+	delete(m.clearedFields, tenantstatuslog.FieldExpiredAt)
 }
 
-// ChildrenCleared reports if the "children" edge to the Tenant entity was cleared.
-func (m *TenantMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetReason sets the "reason" field.
+func (m *TenantStatusLogMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// RemoveChildIDs removes the "children" edge to the Tenant entity by IDs.
-func (m *TenantMutation) RemoveChildIDs(ids ...int64) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+// Reason returns the value of the "reason" field in the mutation.
+func (m *TenantStatusLogMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Tenant entity.
-func (m *TenantMutation) RemovedChildrenIDs() (ids []int64) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// OldReason returns the old "reason" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantStatusLogMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *TenantMutation) ChildrenIDs() (ids []int64) {
-	for id := range m.children {
-		ids = append(ids, id)
-	}
-	return
+// ClearReason clears the value of the "reason" field.
+func (m *TenantStatusLogMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[tenantstatuslog.FieldReason] = struct{}{}
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *TenantMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *TenantStatusLogMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[tenantstatuslog.FieldReason]
+	return ok
 }
 
-// AddRoleIDs adds the "roles" edge to the Role entity by ids.
-func (m *TenantMutation) AddRoleIDs(ids ...int64) {
-	if m.roles == nil {
-		m.roles = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
-	}
+// ResetReason resets all changes to the "reason" field.
+func (m *TenantStatusLogMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, tenantstatuslog.FieldReason)
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *TenantMutation) ClearRoles() {
-	m.clearedroles = true
+// SetOperatorID sets the "operator_id" field.
+func (m *TenantStatusLogMutation) SetOperatorID(i int64) {
+	m.operator_id = &i
+	m.addoperator_id = nil
 }
 
-// RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *TenantMutation) RolesCleared() bool {
-	return m.clearedroles
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *TenantStatusLogMutation) OperatorID() (r int64, exists bool) {
+	v := m.operator_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
-func (m *TenantMutation) RemoveRoleIDs(ids ...int64) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[int64]struct{})
+// OldOperatorID returns the old "operator_id" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantStatusLogMutation) OldOperatorID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
 	}
+	return oldValue.OperatorID, nil
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
-func (m *TenantMutation) RemovedRolesIDs() (ids []int64) {
-	for id := range m.removedroles {
-		ids = append(ids, id)
+// AddOperatorID adds i to the "operator_id" field.
+func (m *TenantStatusLogMutation) AddOperatorID(i int64) {
+	if m.addoperator_id != nil {
+		*m.addoperator_id += i
+	} else {
+		m.addoperator_id = &i
 	}
-	return
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *TenantMutation) RolesIDs() (ids []int64) {
-	for id := range m.roles {
-		ids = append(ids, id)
+// AddedOperatorID returns the value that was added to the "operator_id" field in this mutation.
+func (m *TenantStatusLogMutation) AddedOperatorID() (r int64, exists bool) {
+	v := m.addoperator_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *TenantMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+// ClearOperatorID clears the value of the "operator_id" field.
+func (m *TenantStatusLogMutation) ClearOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	m.clearedFields[tenantstatuslog.FieldOperatorID] = struct{}{}
 }
 
-// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
-func (m *TenantMutation) AddUserRoleIDs(ids ...int64) {
-	if m.user_roles == nil {
-		m.user_roles = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.user_roles[ids[i]] = struct{}{}
-	}
+// OperatorIDCleared returns if the "operator_id" field was cleared in this mutation.
+func (m *TenantStatusLogMutation) OperatorIDCleared() bool {
+	_, ok := m.clearedFields[tenantstatuslog.FieldOperatorID]
+	return ok
 }
 
-// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
-func (m *TenantMutation) ClearUserRoles() {
-	m.cleareduser_roles = true
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *TenantStatusLogMutation) ResetOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	delete(m.clearedFields, tenantstatuslog.FieldOperatorID)
 }
 
-// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
-func (m *TenantMutation) UserRolesCleared() bool {
-	return m.cleareduser_roles
+// SetCreatedAt sets the "created_at" field.
+func (m *TenantStatusLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
-func (m *TenantMutation) RemoveUserRoleIDs(ids ...int64) {
-	if m.removeduser_roles == nil {
-		m.removeduser_roles = make(map[int64]struct{})
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TenantStatusLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.user_roles, ids[i])
-		m.removeduser_roles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TenantStatusLog entity.
+// If the TenantStatusLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantStatusLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TenantStatusLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *TenantStatusLogMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[tenantstatuslog.FieldTenantID] = struct{}{}
 }
 
-// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
-func (m *TenantMutation) RemovedUserRolesIDs() (ids []int64) {
-	for id := range m.removeduser_roles {
-		ids = append(ids, id)
-	}
-	return
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *TenantStatusLogMutation) TenantCleared() bool {
+	return m.clearedtenant
 }
 
-// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
-func (m *TenantMutation) UserRolesIDs() (ids []int64) {
-	for id := range m.user_roles {
-		ids = append(ids, id)
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *TenantStatusLogMutation) TenantIDs() (ids []int64) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUserRoles resets all changes to the "user_roles" edge.
-func (m *TenantMutation) ResetUserRoles() {
-	m.user_roles = nil
-	m.cleareduser_roles = false
-	m.removeduser_roles = nil
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *TenantStatusLogMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
 }
 
-// Where appends a list predicates to the TenantMutation builder.
-func (m *TenantMutation) Where(ps ...predicate.Tenant) {
+// Where appends a list predicates to the TenantStatusLogMutation builder.
+func (m *TenantStatusLogMutation) Where(ps ...predicate.TenantStatusLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantStatusLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tenant, len(ps))
+func (m *TenantStatusLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantStatusLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3840,66 +11978,48 @@ func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TenantMutation) Op() Op {
+func (m *TenantStatusLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TenantMutation) SetOp(op Op) {
+func (m *TenantStatusLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tenant).
-func (m *TenantMutation) Type() string {
+// Type returns the node type of this mutation (TenantStatusLog).
+func (m *TenantStatusLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TenantMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.name != nil {
-		fields = append(fields, tenant.FieldName)
-	}
-	if m.owner_id != nil {
-		fields = append(fields, tenant.FieldOwnerID)
-	}
-	if m._type != nil {
-		fields = append(fields, tenant.FieldType)
-	}
-	if m.parent != nil {
-		fields = append(fields, tenant.FieldParentID)
+func (m *TenantStatusLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, tenantstatuslog.FieldTenantID)
 	}
-	if m._path != nil {
-		fields = append(fields, tenant.FieldPath)
+	if m.action != nil {
+		fields = append(fields, tenantstatuslog.FieldAction)
 	}
-	if m.level != nil {
-		fields = append(fields, tenant.FieldLevel)
+	if m.from_status != nil {
+		fields = append(fields, tenantstatuslog.FieldFromStatus)
 	}
-	if m.status != nil {
-		fields = append(fields, tenant.FieldStatus)
+	if m.to_status != nil {
+		fields = append(fields, tenantstatuslog.FieldToStatus)
 	}
 	if m.expired_at != nil {
-		fields = append(fields, tenant.FieldExpiredAt)
-	}
-	if m.attributes != nil {
-		fields = append(fields, tenant.FieldAttributes)
+		fields = append(fields, tenantstatuslog.FieldExpiredAt)
 	}
-	if m.created_by != nil {
-		fields = append(fields, tenant.FieldCreatedBy)
+	if m.reason != nil {
+		fields = append(fields, tenantstatuslog.FieldReason)
 	}
-	if m.updated_by != nil {
-		fields = append(fields, tenant.FieldUpdatedBy)
+	if m.operator_id != nil {
+		fields = append(fields, tenantstatuslog.FieldOperatorID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tenant.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, tenant.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, tenant.FieldDeletedAt)
+		fields = append(fields, tenantstatuslog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3907,36 +12027,24 @@ func (m *TenantMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TenantMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantStatusLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tenant.FieldName:
-		return m.Name()
-	case tenant.FieldOwnerID:
-		return m.OwnerID()
-	case tenant.FieldType:
-		return m.GetType()
-	case tenant.FieldParentID:
-		return m.ParentID()
-	case tenant.FieldPath:
-		return m.Path()
-	case tenant.FieldLevel:
-		return m.Level()
-	case tenant.FieldStatus:
-		return m.Status()
-	case tenant.FieldExpiredAt:
+	case tenantstatuslog.FieldTenantID:
+		return m.TenantID()
+	case tenantstatuslog.FieldAction:
+		return m.Action()
+	case tenantstatuslog.FieldFromStatus:
+		return m.FromStatus()
+	case tenantstatuslog.FieldToStatus:
+		return m.ToStatus()
+	case tenantstatuslog.FieldExpiredAt:
 		return m.ExpiredAt()
-	case tenant.FieldAttributes:
-		return m.Attributes()
-	case tenant.FieldCreatedBy:
-		return m.CreatedBy()
-	case tenant.FieldUpdatedBy:
-		return m.UpdatedBy()
-	case tenant.FieldCreatedAt:
+	case tenantstatuslog.FieldReason:
+		return m.Reason()
+	case tenantstatuslog.FieldOperatorID:
+		return m.OperatorID()
+	case tenantstatuslog.FieldCreatedAt:
 		return m.CreatedAt()
-	case tenant.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case tenant.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -3944,162 +12052,99 @@ func (m *TenantMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantStatusLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tenant.FieldName:
-		return m.OldName(ctx)
-	case tenant.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case tenant.FieldType:
-		return m.OldType(ctx)
-	case tenant.FieldParentID:
-		return m.OldParentID(ctx)
-	case tenant.FieldPath:
-		return m.OldPath(ctx)
-	case tenant.FieldLevel:
-		return m.OldLevel(ctx)
-	case tenant.FieldStatus:
-		return m.OldStatus(ctx)
-	case tenant.FieldExpiredAt:
+	case tenantstatuslog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tenantstatuslog.FieldAction:
+		return m.OldAction(ctx)
+	case tenantstatuslog.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case tenantstatuslog.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case tenantstatuslog.FieldExpiredAt:
 		return m.OldExpiredAt(ctx)
-	case tenant.FieldAttributes:
-		return m.OldAttributes(ctx)
-	case tenant.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case tenant.FieldUpdatedBy:
-		return m.OldUpdatedBy(ctx)
-	case tenant.FieldCreatedAt:
+	case tenantstatuslog.FieldReason:
+		return m.OldReason(ctx)
+	case tenantstatuslog.FieldOperatorID:
+		return m.OldOperatorID(ctx)
+	case tenantstatuslog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case tenant.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case tenant.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tenant field %s", name)
+	return nil, fmt.Errorf("unknown TenantStatusLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TenantMutation) SetField(name string, value ent.Value) error {
+func (m *TenantStatusLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tenant.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case tenant.FieldOwnerID:
+	case tenantstatuslog.FieldTenantID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerID(v)
-		return nil
-	case tenant.FieldType:
-		v, ok := value.(tenant.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+		m.SetTenantID(v)
 		return nil
-	case tenant.FieldParentID:
-		v, ok := value.(int64)
+	case tenantstatuslog.FieldAction:
+		v, ok := value.(tenantstatuslog.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetAction(v)
 		return nil
-	case tenant.FieldPath:
+	case tenantstatuslog.FieldFromStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
-		return nil
-	case tenant.FieldLevel:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevel(v)
+		m.SetFromStatus(v)
 		return nil
-	case tenant.FieldStatus:
-		v, ok := value.(tenant.Status)
+	case tenantstatuslog.FieldToStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetToStatus(v)
 		return nil
-	case tenant.FieldExpiredAt:
+	case tenantstatuslog.FieldExpiredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiredAt(v)
 		return nil
-	case tenant.FieldAttributes:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAttributes(v)
-		return nil
-	case tenant.FieldCreatedBy:
-		v, ok := value.(int64)
+	case tenantstatuslog.FieldReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetReason(v)
 		return nil
-	case tenant.FieldUpdatedBy:
+	case tenantstatuslog.FieldOperatorID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedBy(v)
+		m.SetOperatorID(v)
 		return nil
-	case tenant.FieldCreatedAt:
+	case tenantstatuslog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case tenant.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case tenant.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Tenant field %s", name)
+	return fmt.Errorf("unknown TenantStatusLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TenantMutation) AddedFields() []string {
+func (m *TenantStatusLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addowner_id != nil {
-		fields = append(fields, tenant.FieldOwnerID)
-	}
-	if m.addlevel != nil {
-		fields = append(fields, tenant.FieldLevel)
-	}
-	if m.addcreated_by != nil {
-		fields = append(fields, tenant.FieldCreatedBy)
-	}
-	if m.addupdated_by != nil {
-		fields = append(fields, tenant.FieldUpdatedBy)
+	if m.addoperator_id != nil {
+		fields = append(fields, tenantstatuslog.FieldOperatorID)
 	}
 	return fields
 }
@@ -4107,16 +12152,10 @@ func (m *TenantMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TenantStatusLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tenant.FieldOwnerID:
-		return m.AddedOwnerID()
-	case tenant.FieldLevel:
-		return m.AddedLevel()
-	case tenant.FieldCreatedBy:
-		return m.AddedCreatedBy()
-	case tenant.FieldUpdatedBy:
-		return m.AddedUpdatedBy()
+	case tenantstatuslog.FieldOperatorID:
+		return m.AddedOperatorID()
 	}
 	return nil, false
 }
@@ -4124,352 +12163,163 @@ func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TenantMutation) AddField(name string, value ent.Value) error {
+func (m *TenantStatusLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tenant.FieldOwnerID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOwnerID(v)
-		return nil
-	case tenant.FieldLevel:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLevel(v)
-		return nil
-	case tenant.FieldCreatedBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreatedBy(v)
-		return nil
-	case tenant.FieldUpdatedBy:
+	case tenantstatuslog.FieldOperatorID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUpdatedBy(v)
+		m.AddOperatorID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant numeric field %s", name)
+	return fmt.Errorf("unknown TenantStatusLog numeric field %s", name)
 }
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TenantMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(tenant.FieldParentID) {
-		fields = append(fields, tenant.FieldParentID)
-	}
-	if m.FieldCleared(tenant.FieldPath) {
-		fields = append(fields, tenant.FieldPath)
-	}
-	if m.FieldCleared(tenant.FieldExpiredAt) {
-		fields = append(fields, tenant.FieldExpiredAt)
-	}
-	if m.FieldCleared(tenant.FieldCreatedBy) {
-		fields = append(fields, tenant.FieldCreatedBy)
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantStatusLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tenantstatuslog.FieldExpiredAt) {
+		fields = append(fields, tenantstatuslog.FieldExpiredAt)
 	}
-	if m.FieldCleared(tenant.FieldUpdatedBy) {
-		fields = append(fields, tenant.FieldUpdatedBy)
+	if m.FieldCleared(tenantstatuslog.FieldReason) {
+		fields = append(fields, tenantstatuslog.FieldReason)
 	}
-	if m.FieldCleared(tenant.FieldDeletedAt) {
-		fields = append(fields, tenant.FieldDeletedAt)
+	if m.FieldCleared(tenantstatuslog.FieldOperatorID) {
+		fields = append(fields, tenantstatuslog.FieldOperatorID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TenantMutation) FieldCleared(name string) bool {
+func (m *TenantStatusLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TenantMutation) ClearField(name string) error {
+func (m *TenantStatusLogMutation) ClearField(name string) error {
 	switch name {
-	case tenant.FieldParentID:
-		m.ClearParentID()
-		return nil
-	case tenant.FieldPath:
-		m.ClearPath()
-		return nil
-	case tenant.FieldExpiredAt:
+	case tenantstatuslog.FieldExpiredAt:
 		m.ClearExpiredAt()
 		return nil
-	case tenant.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case tenant.FieldUpdatedBy:
-		m.ClearUpdatedBy()
+	case tenantstatuslog.FieldReason:
+		m.ClearReason()
 		return nil
-	case tenant.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case tenantstatuslog.FieldOperatorID:
+		m.ClearOperatorID()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant nullable field %s", name)
+	return fmt.Errorf("unknown TenantStatusLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TenantMutation) ResetField(name string) error {
+func (m *TenantStatusLogMutation) ResetField(name string) error {
 	switch name {
-	case tenant.FieldName:
-		m.ResetName()
-		return nil
-	case tenant.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
-	case tenant.FieldType:
-		m.ResetType()
-		return nil
-	case tenant.FieldParentID:
-		m.ResetParentID()
+	case tenantstatuslog.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case tenant.FieldPath:
-		m.ResetPath()
+	case tenantstatuslog.FieldAction:
+		m.ResetAction()
 		return nil
-	case tenant.FieldLevel:
-		m.ResetLevel()
+	case tenantstatuslog.FieldFromStatus:
+		m.ResetFromStatus()
 		return nil
-	case tenant.FieldStatus:
-		m.ResetStatus()
+	case tenantstatuslog.FieldToStatus:
+		m.ResetToStatus()
 		return nil
-	case tenant.FieldExpiredAt:
+	case tenantstatuslog.FieldExpiredAt:
 		m.ResetExpiredAt()
 		return nil
-	case tenant.FieldAttributes:
-		m.ResetAttributes()
-		return nil
-	case tenant.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case tenantstatuslog.FieldReason:
+		m.ResetReason()
 		return nil
-	case tenant.FieldUpdatedBy:
-		m.ResetUpdatedBy()
+	case tenantstatuslog.FieldOperatorID:
+		m.ResetOperatorID()
 		return nil
-	case tenant.FieldCreatedAt:
+	case tenantstatuslog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case tenant.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case tenant.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Tenant field %s", name)
+	return fmt.Errorf("unknown TenantStatusLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TenantMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.user_tenants != nil {
-		edges = append(edges, tenant.EdgeUserTenants)
-	}
-	if m.departments != nil {
-		edges = append(edges, tenant.EdgeDepartments)
-	}
-	if m.parent != nil {
-		edges = append(edges, tenant.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, tenant.EdgeChildren)
-	}
-	if m.roles != nil {
-		edges = append(edges, tenant.EdgeRoles)
-	}
-	if m.user_roles != nil {
-		edges = append(edges, tenant.EdgeUserRoles)
+func (m *TenantStatusLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tenant != nil {
+		edges = append(edges, tenantstatuslog.EdgeTenant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TenantMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantStatusLogMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tenant.EdgeUserTenants:
-		ids := make([]ent.Value, 0, len(m.user_tenants))
-		for id := range m.user_tenants {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeDepartments:
-		ids := make([]ent.Value, 0, len(m.departments))
-		for id := range m.departments {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeParent:
-		if id := m.parent; id != nil {
+	case tenantstatuslog.EdgeTenant:
+		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case tenant.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.user_roles))
-		for id := range m.user_roles {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TenantMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.removeduser_tenants != nil {
-		edges = append(edges, tenant.EdgeUserTenants)
-	}
-	if m.removeddepartments != nil {
-		edges = append(edges, tenant.EdgeDepartments)
-	}
-	if m.removedchildren != nil {
-		edges = append(edges, tenant.EdgeChildren)
-	}
-	if m.removedroles != nil {
-		edges = append(edges, tenant.EdgeRoles)
-	}
-	if m.removeduser_roles != nil {
-		edges = append(edges, tenant.EdgeUserRoles)
-	}
+func (m *TenantStatusLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tenant.EdgeUserTenants:
-		ids := make([]ent.Value, 0, len(m.removeduser_tenants))
-		for id := range m.removeduser_tenants {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeDepartments:
-		ids := make([]ent.Value, 0, len(m.removeddepartments))
-		for id := range m.removeddepartments {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.removeduser_roles))
-		for id := range m.removeduser_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TenantStatusLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TenantMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.cleareduser_tenants {
-		edges = append(edges, tenant.EdgeUserTenants)
-	}
-	if m.cleareddepartments {
-		edges = append(edges, tenant.EdgeDepartments)
-	}
-	if m.clearedparent {
-		edges = append(edges, tenant.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, tenant.EdgeChildren)
-	}
-	if m.clearedroles {
-		edges = append(edges, tenant.EdgeRoles)
-	}
-	if m.cleareduser_roles {
-		edges = append(edges, tenant.EdgeUserRoles)
+func (m *TenantStatusLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtenant {
+		edges = append(edges, tenantstatuslog.EdgeTenant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TenantMutation) EdgeCleared(name string) bool {
+func (m *TenantStatusLogMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tenant.EdgeUserTenants:
-		return m.cleareduser_tenants
-	case tenant.EdgeDepartments:
-		return m.cleareddepartments
-	case tenant.EdgeParent:
-		return m.clearedparent
-	case tenant.EdgeChildren:
-		return m.clearedchildren
-	case tenant.EdgeRoles:
-		return m.clearedroles
-	case tenant.EdgeUserRoles:
-		return m.cleareduser_roles
+	case tenantstatuslog.EdgeTenant:
+		return m.clearedtenant
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TenantMutation) ClearEdge(name string) error {
+func (m *TenantStatusLogMutation) ClearEdge(name string) error {
 	switch name {
-	case tenant.EdgeParent:
-		m.ClearParent()
+	case tenantstatuslog.EdgeTenant:
+		m.ClearTenant()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant unique edge %s", name)
+	return fmt.Errorf("unknown TenantStatusLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TenantMutation) ResetEdge(name string) error {
+func (m *TenantStatusLogMutation) ResetEdge(name string) error {
 	switch name {
-	case tenant.EdgeUserTenants:
-		m.ResetUserTenants()
-		return nil
-	case tenant.EdgeDepartments:
-		m.ResetDepartments()
-		return nil
-	case tenant.EdgeParent:
-		m.ResetParent()
-		return nil
-	case tenant.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case tenant.EdgeRoles:
-		m.ResetRoles()
-		return nil
-	case tenant.EdgeUserRoles:
-		m.ResetUserRoles()
+	case tenantstatuslog.EdgeTenant:
+		m.ResetTenant()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant edge %s", name)
+	return fmt.Errorf("unknown TenantStatusLog edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -4482,6 +12332,8 @@ type UserMutation struct {
 	email                   *string
 	phone                   *string
 	password                *string
+	token_version           *int64
+	addtoken_version        *int64
 	status                  *user.Status
 	full_name               *string
 	gender                  *user.Gender
@@ -4800,6 +12652,62 @@ func (m *UserMutation) ResetPassword() {
 	delete(m.clearedFields, user.FieldPassword)
 }
 
+// SetTokenVersion sets the "token_version" field.
+func (m *UserMutation) SetTokenVersion(i int64) {
+	m.token_version = &i
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *UserMutation) TokenVersion() (r int64, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenVersion returns the old "token_version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTokenVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (m *UserMutation) AddTokenVersion(i int64) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
+	}
+}
+
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *UserMutation) AddedTokenVersion() (r int64, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *UserMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
+}
+
 // SetStatus sets the "status" field.
 func (m *UserMutation) SetStatus(u user.Status) {
 	m.status = &u
@@ -5553,7 +13461,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+	fields := make([]string, 0, 16)
 	if m.username != nil {
 		fields = append(fields, user.FieldUsername)
 	}
@@ -5566,6 +13474,9 @@ func (m *UserMutation) Fields() []string {
 	if m.password != nil {
 		fields = append(fields, user.FieldPassword)
 	}
+	if m.token_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
+	}
 	if m.status != nil {
 		fields = append(fields, user.FieldStatus)
 	}
@@ -5615,6 +13526,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Phone()
 	case user.FieldPassword:
 		return m.Password()
+	case user.FieldTokenVersion:
+		return m.TokenVersion()
 	case user.FieldStatus:
 		return m.Status()
 	case user.FieldFullName:
@@ -5654,6 +13567,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldPhone(ctx)
 	case user.FieldPassword:
 		return m.OldPassword(ctx)
+	case user.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
 	case user.FieldStatus:
 		return m.OldStatus(ctx)
 	case user.FieldFullName:
@@ -5713,6 +13628,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPassword(v)
 		return nil
+	case user.FieldTokenVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
+		return nil
 	case user.FieldStatus:
 		v, ok := value.(user.Status)
 		if !ok {
@@ -5798,6 +13720,9 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
 	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
+	}
 	if m.addcreated_by != nil {
 		fields = append(fields, user.FieldCreatedBy)
 	}
@@ -5812,6 +13737,8 @@ func (m *UserMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case user.FieldTokenVersion:
+		return m.AddedTokenVersion()
 	case user.FieldCreatedBy:
 		return m.AddedCreatedBy()
 	case user.FieldUpdatedBy:
@@ -5825,6 +13752,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldTokenVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
 	case user.FieldCreatedBy:
 		v, ok := value.(int64)
 		if !ok {
@@ -5929,6 +13863,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldPassword:
 		m.ResetPassword()
 		return nil
+	case user.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
 	case user.FieldStatus:
 		m.ResetStatus()
 		return nil
@@ -6865,6 +14802,7 @@ type UserDepartmentMutation struct {
 	id                *int
 	tenant_id         *int64
 	addtenant_id      *int64
+	is_primary        *bool
 	attributes        *map[string]interface{}
 	clearedFields     map[string]struct{}
 	user              *int64
@@ -7102,6 +15040,42 @@ func (m *UserDepartmentMutation) ResetTenantID() {
 	m.addtenant_id = nil
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (m *UserDepartmentMutation) SetIsPrimary(b bool) {
+	m.is_primary = &b
+}
+
+// IsPrimary returns the value of the "is_primary" field in the mutation.
+func (m *UserDepartmentMutation) IsPrimary() (r bool, exists bool) {
+	v := m.is_primary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPrimary returns the old "is_primary" field's value of the UserDepartment entity.
+// If the UserDepartment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDepartmentMutation) OldIsPrimary(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPrimary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPrimary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPrimary: %w", err)
+	}
+	return oldValue.IsPrimary, nil
+}
+
+// ResetIsPrimary resets all changes to the "is_primary" field.
+func (m *UserDepartmentMutation) ResetIsPrimary() {
+	m.is_primary = nil
+}
+
 // SetAttributes sets the "attributes" field.
 func (m *UserDepartmentMutation) SetAttributes(value map[string]interface{}) {
 	m.attributes = &value
@@ -7239,7 +15213,7 @@ func (m *UserDepartmentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserDepartmentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.user != nil {
 		fields = append(fields, userdepartment.FieldUserID)
 	}
@@ -7249,6 +15223,9 @@ func (m *UserDepartmentMutation) Fields() []string {
 	if m.tenant_id != nil {
 		fields = append(fields, userdepartment.FieldTenantID)
 	}
+	if m.is_primary != nil {
+		fields = append(fields, userdepartment.FieldIsPrimary)
+	}
 	if m.attributes != nil {
 		fields = append(fields, userdepartment.FieldAttributes)
 	}
@@ -7266,6 +15243,8 @@ func (m *UserDepartmentMutation) Field(name string) (ent.Value, bool) {
 		return m.DeptID()
 	case userdepartment.FieldTenantID:
 		return m.TenantID()
+	case userdepartment.FieldIsPrimary:
+		return m.IsPrimary()
 	case userdepartment.FieldAttributes:
 		return m.Attributes()
 	}
@@ -7283,6 +15262,8 @@ func (m *UserDepartmentMutation) OldField(ctx context.Context, name string) (ent
 		return m.OldDeptID(ctx)
 	case userdepartment.FieldTenantID:
 		return m.OldTenantID(ctx)
+	case userdepartment.FieldIsPrimary:
+		return m.OldIsPrimary(ctx)
 	case userdepartment.FieldAttributes:
 		return m.OldAttributes(ctx)
 	}
@@ -7315,6 +15296,13 @@ func (m *UserDepartmentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTenantID(v)
 		return nil
+	case userdepartment.FieldIsPrimary:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrimary(v)
+		return nil
 	case userdepartment.FieldAttributes:
 		v, ok := value.(map[string]interface{})
 		if !ok {
@@ -7395,6 +15383,9 @@ func (m *UserDepartmentMutation) ResetField(name string) error {
 	case userdepartment.FieldTenantID:
 		m.ResetTenantID()
 		return nil
+	case userdepartment.FieldIsPrimary:
+		m.ResetIsPrimary()
+		return nil
 	case userdepartment.FieldAttributes:
 		m.ResetAttributes()
 		return nil
@@ -7502,6 +15493,9 @@ type UserRoleMutation struct {
 	id            *int64
 	granted_at    *time.Time
 	expires_at    *time.Time
+	granted_by    *int64
+	addgranted_by *int64
+	reason        *string
 	clearedFields map[string]struct{}
 	user          *int64
 	cleareduser   bool
@@ -7824,6 +15818,125 @@ func (m *UserRoleMutation) ResetExpiresAt() {
 	delete(m.clearedFields, userrole.FieldExpiresAt)
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (m *UserRoleMutation) SetGrantedBy(i int64) {
+	m.granted_by = &i
+	m.addgranted_by = nil
+}
+
+// GrantedBy returns the value of the "granted_by" field in the mutation.
+func (m *UserRoleMutation) GrantedBy() (r int64, exists bool) {
+	v := m.granted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedBy returns the old "granted_by" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserRoleMutation) OldGrantedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
+	}
+	return oldValue.GrantedBy, nil
+}
+
+// AddGrantedBy adds i to the "granted_by" field.
+func (m *UserRoleMutation) AddGrantedBy(i int64) {
+	if m.addgranted_by != nil {
+		*m.addgranted_by += i
+	} else {
+		m.addgranted_by = &i
+	}
+}
+
+// AddedGrantedBy returns the value that was added to the "granted_by" field in this mutation.
+func (m *UserRoleMutation) AddedGrantedBy() (r int64, exists bool) {
+	v := m.addgranted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (m *UserRoleMutation) ClearGrantedBy() {
+	m.granted_by = nil
+	m.addgranted_by = nil
+	m.clearedFields[userrole.FieldGrantedBy] = struct{}{}
+}
+
+// GrantedByCleared returns if the "granted_by" field was cleared in this mutation.
+func (m *UserRoleMutation) GrantedByCleared() bool {
+	_, ok := m.clearedFields[userrole.FieldGrantedBy]
+	return ok
+}
+
+// ResetGrantedBy resets all changes to the "granted_by" field.
+func (m *UserRoleMutation) ResetGrantedBy() {
+	m.granted_by = nil
+	m.addgranted_by = nil
+	delete(m.clearedFields, userrole.FieldGrantedBy)
+}
+
+// SetReason sets the "reason" field.
+func (m *UserRoleMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *UserRoleMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserRoleMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *UserRoleMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[userrole.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *UserRoleMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[userrole.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *UserRoleMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, userrole.FieldReason)
+}
+
 // ClearUser clears the "user" edge to the User entity.
 func (m *UserRoleMutation) ClearUser() {
 	m.cleareduser = true
@@ -7939,7 +16052,7 @@ func (m *UserRoleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserRoleMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 7)
 	if m.user != nil {
 		fields = append(fields, userrole.FieldUserID)
 	}
@@ -7955,6 +16068,12 @@ func (m *UserRoleMutation) Fields() []string {
 	if m.expires_at != nil {
 		fields = append(fields, userrole.FieldExpiresAt)
 	}
+	if m.granted_by != nil {
+		fields = append(fields, userrole.FieldGrantedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, userrole.FieldReason)
+	}
 	return fields
 }
 
@@ -7973,6 +16092,10 @@ func (m *UserRoleMutation) Field(name string) (ent.Value, bool) {
 		return m.GrantedAt()
 	case userrole.FieldExpiresAt:
 		return m.ExpiresAt()
+	case userrole.FieldGrantedBy:
+		return m.GrantedBy()
+	case userrole.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -7992,6 +16115,10 @@ func (m *UserRoleMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldGrantedAt(ctx)
 	case userrole.FieldExpiresAt:
 		return m.OldExpiresAt(ctx)
+	case userrole.FieldGrantedBy:
+		return m.OldGrantedBy(ctx)
+	case userrole.FieldReason:
+		return m.OldReason(ctx)
 	}
 	return nil, fmt.Errorf("unknown UserRole field %s", name)
 }
@@ -8036,6 +16163,20 @@ func (m *UserRoleMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetExpiresAt(v)
 		return nil
+	case userrole.FieldGrantedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedBy(v)
+		return nil
+	case userrole.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserRole field %s", name)
 }
@@ -8044,6 +16185,9 @@ func (m *UserRoleMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *UserRoleMutation) AddedFields() []string {
 	var fields []string
+	if m.addgranted_by != nil {
+		fields = append(fields, userrole.FieldGrantedBy)
+	}
 	return fields
 }
 
@@ -8052,6 +16196,8 @@ func (m *UserRoleMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *UserRoleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case userrole.FieldGrantedBy:
+		return m.AddedGrantedBy()
 	}
 	return nil, false
 }
@@ -8061,6 +16207,13 @@ func (m *UserRoleMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserRoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case userrole.FieldGrantedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGrantedBy(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserRole numeric field %s", name)
 }
@@ -8075,6 +16228,12 @@ func (m *UserRoleMutation) ClearedFields() []string {
 	if m.FieldCleared(userrole.FieldExpiresAt) {
 		fields = append(fields, userrole.FieldExpiresAt)
 	}
+	if m.FieldCleared(userrole.FieldGrantedBy) {
+		fields = append(fields, userrole.FieldGrantedBy)
+	}
+	if m.FieldCleared(userrole.FieldReason) {
+		fields = append(fields, userrole.FieldReason)
+	}
 	return fields
 }
 
@@ -8095,6 +16254,12 @@ func (m *UserRoleMutation) ClearField(name string) error {
 	case userrole.FieldExpiresAt:
 		m.ClearExpiresAt()
 		return nil
+	case userrole.FieldGrantedBy:
+		m.ClearGrantedBy()
+		return nil
+	case userrole.FieldReason:
+		m.ClearReason()
+		return nil
 	}
 	return fmt.Errorf("unknown UserRole nullable field %s", name)
 }
@@ -8118,6 +16283,12 @@ func (m *UserRoleMutation) ResetField(name string) error {
 	case userrole.FieldExpiresAt:
 		m.ResetExpiresAt()
 		return nil
+	case userrole.FieldGrantedBy:
+		m.ResetGrantedBy()
+		return nil
+	case userrole.FieldReason:
+		m.ResetReason()
+		return nil
 	}
 	return fmt.Errorf("unknown UserRole field %s", name)
 }
@@ -8240,6 +16411,9 @@ type UserTenantMutation struct {
 	id                *int
 	role_labels       *[]string
 	appendrole_labels []string
+	created_by        *int64
+	addcreated_by     *int64
+	created_at        *time.Time
 	clearedFields     map[string]struct{}
 	user              *int64
 	cleareduser       bool
@@ -8485,6 +16659,112 @@ func (m *UserTenantMutation) ResetRoleLabels() {
 	delete(m.clearedFields, usertenant.FieldRoleLabels)
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (m *UserTenantMutation) SetCreatedBy(i int64) {
+	m.created_by = &i
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *UserTenantMutation) CreatedBy() (r int64, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the UserTenant entity.
+// If the UserTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserTenantMutation) OldCreatedBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (m *UserTenantMutation) AddCreatedBy(i int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *UserTenantMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *UserTenantMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[usertenant.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *UserTenantMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[usertenant.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *UserTenantMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, usertenant.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserTenantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserTenantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserTenant entity.
+// If the UserTenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserTenantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserTenantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
 // ClearUser clears the "user" edge to the User entity.
 func (m *UserTenantMutation) ClearUser() {
 	m.cleareduser = true
@@ -8573,7 +16853,7 @@ func (m *UserTenantMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserTenantMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 5)
 	if m.user != nil {
 		fields = append(fields, usertenant.FieldUserID)
 	}
@@ -8583,6 +16863,12 @@ func (m *UserTenantMutation) Fields() []string {
 	if m.role_labels != nil {
 		fields = append(fields, usertenant.FieldRoleLabels)
 	}
+	if m.created_by != nil {
+		fields = append(fields, usertenant.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, usertenant.FieldCreatedAt)
+	}
 	return fields
 }
 
@@ -8597,6 +16883,10 @@ func (m *UserTenantMutation) Field(name string) (ent.Value, bool) {
 		return m.TenantID()
 	case usertenant.FieldRoleLabels:
 		return m.RoleLabels()
+	case usertenant.FieldCreatedBy:
+		return m.CreatedBy()
+	case usertenant.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -8612,6 +16902,10 @@ func (m *UserTenantMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldTenantID(ctx)
 	case usertenant.FieldRoleLabels:
 		return m.OldRoleLabels(ctx)
+	case usertenant.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case usertenant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown UserTenant field %s", name)
 }
@@ -8642,6 +16936,20 @@ func (m *UserTenantMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRoleLabels(v)
 		return nil
+	case usertenant.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case usertenant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserTenant field %s", name)
 }
@@ -8650,6 +16958,9 @@ func (m *UserTenantMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *UserTenantMutation) AddedFields() []string {
 	var fields []string
+	if m.addcreated_by != nil {
+		fields = append(fields, usertenant.FieldCreatedBy)
+	}
 	return fields
 }
 
@@ -8658,6 +16969,8 @@ func (m *UserTenantMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *UserTenantMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case usertenant.FieldCreatedBy:
+		return m.AddedCreatedBy()
 	}
 	return nil, false
 }
@@ -8667,6 +16980,13 @@ func (m *UserTenantMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserTenantMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usertenant.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserTenant numeric field %s", name)
 }
@@ -8678,6 +16998,9 @@ func (m *UserTenantMutation) ClearedFields() []string {
 	if m.FieldCleared(usertenant.FieldRoleLabels) {
 		fields = append(fields, usertenant.FieldRoleLabels)
 	}
+	if m.FieldCleared(usertenant.FieldCreatedBy) {
+		fields = append(fields, usertenant.FieldCreatedBy)
+	}
 	return fields
 }
 
@@ -8695,6 +17018,9 @@ func (m *UserTenantMutation) ClearField(name string) error {
 	case usertenant.FieldRoleLabels:
 		m.ClearRoleLabels()
 		return nil
+	case usertenant.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
 	}
 	return fmt.Errorf("unknown UserTenant nullable field %s", name)
 }
@@ -8712,6 +17038,12 @@ func (m *UserTenantMutation) ResetField(name string) error {
 	case usertenant.FieldRoleLabels:
 		m.ResetRoleLabels()
 		return nil
+	case usertenant.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case usertenant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown UserTenant field %s", name)
 }
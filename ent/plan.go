@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/ent/plan"
+)
+
+// Plan is the model entity for the Plan schema.
+type Plan struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary Key ID
+	ID int64 `json:"id,omitempty"`
+	// 套餐编码
+	Code string `json:"code,omitempty"`
+	// 套餐名称
+	Name string `json:"name,omitempty"`
+	// 套餐说明
+	Description string `json:"description,omitempty"`
+	// 成员数上限，为空不限制
+	MaxUsers *int `json:"max_users,omitempty"`
+	// 部门数上限，为空不限制
+	MaxDepartments *int `json:"max_departments,omitempty"`
+	// 子租户数上限，为空不限制
+	MaxSubTenants *int `json:"max_sub_tenants,omitempty"`
+	// 租户角色数上限，为空不限制
+	MaxRoles *int `json:"max_roles,omitempty"`
+	// 功能开关，未列出的功能视为关闭
+	Features map[string]bool `json:"features,omitempty"`
+	// 是否为新建租户的默认套餐
+	IsDefault bool `json:"is_default,omitempty"`
+	// Creation timestamp of this record
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp of this record
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlanQuery when eager-loading is set.
+	Edges        PlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlanEdges holds the relations/edges for other nodes in the graph.
+type PlanEdges struct {
+	// Tenants holds the value of the tenants edge.
+	Tenants []*Tenant `json:"tenants,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantsOrErr returns the Tenants value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlanEdges) TenantsOrErr() ([]*Tenant, error) {
+	if e.loadedTypes[0] {
+		return e.Tenants, nil
+	}
+	return nil, &NotLoadedError{edge: "tenants"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Plan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case plan.FieldFeatures:
+			values[i] = new([]byte)
+		case plan.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case plan.FieldID, plan.FieldMaxUsers, plan.FieldMaxDepartments, plan.FieldMaxSubTenants, plan.FieldMaxRoles:
+			values[i] = new(sql.NullInt64)
+		case plan.FieldCode, plan.FieldName, plan.FieldDescription:
+			values[i] = new(sql.NullString)
+		case plan.FieldCreatedAt, plan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Plan fields.
+func (pl *Plan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case plan.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pl.ID = int64(value.Int64)
+		case plan.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				pl.Code = value.String
+			}
+		case plan.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pl.Name = value.String
+			}
+		case plan.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pl.Description = value.String
+			}
+		case plan.FieldMaxUsers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_users", values[i])
+			} else if value.Valid {
+				pl.MaxUsers = new(int)
+				*pl.MaxUsers = int(value.Int64)
+			}
+		case plan.FieldMaxDepartments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_departments", values[i])
+			} else if value.Valid {
+				pl.MaxDepartments = new(int)
+				*pl.MaxDepartments = int(value.Int64)
+			}
+		case plan.FieldMaxSubTenants:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_sub_tenants", values[i])
+			} else if value.Valid {
+				pl.MaxSubTenants = new(int)
+				*pl.MaxSubTenants = int(value.Int64)
+			}
+		case plan.FieldMaxRoles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_roles", values[i])
+			} else if value.Valid {
+				pl.MaxRoles = new(int)
+				*pl.MaxRoles = int(value.Int64)
+			}
+		case plan.FieldFeatures:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field features", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pl.Features); err != nil {
+					return fmt.Errorf("unmarshal field features: %w", err)
+				}
+			}
+		case plan.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				pl.IsDefault = value.Bool
+			}
+		case plan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Time
+			}
+		case plan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pl.UpdatedAt = value.Time
+			}
+		default:
+			pl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Plan.
+// This includes values selected through modifiers, order, etc.
+func (pl *Plan) Value(name string) (ent.Value, error) {
+	return pl.selectValues.Get(name)
+}
+
+// QueryTenants queries the "tenants" edge of the Plan entity.
+func (pl *Plan) QueryTenants() *TenantQuery {
+	return NewPlanClient(pl.config).QueryTenants(pl)
+}
+
+// Update returns a builder for updating this Plan.
+// Note that you need to call Plan.Unwrap() before calling this method if this Plan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *Plan) Update() *PlanUpdateOne {
+	return NewPlanClient(pl.config).UpdateOne(pl)
+}
+
+// Unwrap unwraps the Plan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *Plan) Unwrap() *Plan {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Plan is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *Plan) String() string {
+	var builder strings.Builder
+	builder.WriteString("Plan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("code=")
+	builder.WriteString(pl.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pl.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pl.Description)
+	builder.WriteString(", ")
+	if v := pl.MaxUsers; v != nil {
+		builder.WriteString("max_users=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pl.MaxDepartments; v != nil {
+		builder.WriteString("max_departments=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pl.MaxSubTenants; v != nil {
+		builder.WriteString("max_sub_tenants=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pl.MaxRoles; v != nil {
+		builder.WriteString("max_roles=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("features=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Features))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", pl.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Plans is a parsable slice of Plan.
+type Plans []*Plan
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the plan type in the database.
+	Label = "plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldMaxUsers holds the string denoting the max_users field in the database.
+	FieldMaxUsers = "max_users"
+	// FieldMaxDepartments holds the string denoting the max_departments field in the database.
+	FieldMaxDepartments = "max_departments"
+	// FieldMaxSubTenants holds the string denoting the max_sub_tenants field in the database.
+	FieldMaxSubTenants = "max_sub_tenants"
+	// FieldMaxRoles holds the string denoting the max_roles field in the database.
+	FieldMaxRoles = "max_roles"
+	// FieldFeatures holds the string denoting the features field in the database.
+	FieldFeatures = "features"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTenants holds the string denoting the tenants edge name in mutations.
+	EdgeTenants = "tenants"
+	// Table holds the table name of the plan in the database.
+	Table = "plans"
+	// TenantsTable is the table that holds the tenants relation/edge.
+	TenantsTable = "tenants"
+	// TenantsInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantsInverseTable = "tenants"
+	// TenantsColumn is the table column denoting the tenants relation/edge.
+	TenantsColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for plan fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldName,
+	FieldDescription,
+	FieldMaxUsers,
+	FieldMaxDepartments,
+	FieldMaxSubTenants,
+	FieldMaxRoles,
+	FieldFeatures,
+	FieldIsDefault,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// MaxUsersValidator is a validator for the "max_users" field. It is called by the builders before save.
+	MaxUsersValidator func(int) error
+	// MaxDepartmentsValidator is a validator for the "max_departments" field. It is called by the builders before save.
+	MaxDepartmentsValidator func(int) error
+	// MaxSubTenantsValidator is a validator for the "max_sub_tenants" field. It is called by the builders before save.
+	MaxSubTenantsValidator func(int) error
+	// MaxRolesValidator is a validator for the "max_roles" field. It is called by the builders before save.
+	MaxRolesValidator func(int) error
+	// DefaultFeatures holds the default value on creation for the "features" field.
+	DefaultFeatures map[string]bool
+	// DefaultIsDefault holds the default value on creation for the "is_default" field.
+	DefaultIsDefault bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() int64
+)
+
+// OrderOption defines the ordering options for the Plan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByMaxUsers orders the results by the max_users field.
+func ByMaxUsers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUsers, opts...).ToFunc()
+}
+
+// ByMaxDepartments orders the results by the max_departments field.
+func ByMaxDepartments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDepartments, opts...).ToFunc()
+}
+
+// ByMaxSubTenants orders the results by the max_sub_tenants field.
+func ByMaxSubTenants(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSubTenants, opts...).ToFunc()
+}
+
+// ByMaxRoles orders the results by the max_roles field.
+func ByMaxRoles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRoles, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTenantsCount orders the results by tenants count.
+func ByTenantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTenantsStep(), opts...)
+	}
+}
+
+// ByTenants orders the results by tenants terms.
+func ByTenants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TenantsTable, TenantsColumn),
+	)
+}
@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/yc-alpha/admin/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldDescription, v))
+}
+
+// MaxUsers applies equality check predicate on the "max_users" field. It's identical to MaxUsersEQ.
+func MaxUsers(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxUsers, v))
+}
+
+// MaxDepartments applies equality check predicate on the "max_departments" field. It's identical to MaxDepartmentsEQ.
+func MaxDepartments(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxDepartments, v))
+}
+
+// MaxSubTenants applies equality check predicate on the "max_sub_tenants" field. It's identical to MaxSubTenantsEQ.
+func MaxSubTenants(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxSubTenants, v))
+}
+
+// MaxRoles applies equality check predicate on the "max_roles" field. It's identical to MaxRolesEQ.
+func MaxRoles(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxRoles, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// MaxUsersEQ applies the EQ predicate on the "max_users" field.
+func MaxUsersEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxUsers, v))
+}
+
+// MaxUsersNEQ applies the NEQ predicate on the "max_users" field.
+func MaxUsersNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxUsers, v))
+}
+
+// MaxUsersIn applies the In predicate on the "max_users" field.
+func MaxUsersIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxUsers, vs...))
+}
+
+// MaxUsersNotIn applies the NotIn predicate on the "max_users" field.
+func MaxUsersNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxUsers, vs...))
+}
+
+// MaxUsersGT applies the GT predicate on the "max_users" field.
+func MaxUsersGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxUsers, v))
+}
+
+// MaxUsersGTE applies the GTE predicate on the "max_users" field.
+func MaxUsersGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxUsers, v))
+}
+
+// MaxUsersLT applies the LT predicate on the "max_users" field.
+func MaxUsersLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxUsers, v))
+}
+
+// MaxUsersLTE applies the LTE predicate on the "max_users" field.
+func MaxUsersLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxUsers, v))
+}
+
+// MaxUsersIsNil applies the IsNil predicate on the "max_users" field.
+func MaxUsersIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxUsers))
+}
+
+// MaxUsersNotNil applies the NotNil predicate on the "max_users" field.
+func MaxUsersNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxUsers))
+}
+
+// MaxDepartmentsEQ applies the EQ predicate on the "max_departments" field.
+func MaxDepartmentsEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxDepartments, v))
+}
+
+// MaxDepartmentsNEQ applies the NEQ predicate on the "max_departments" field.
+func MaxDepartmentsNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxDepartments, v))
+}
+
+// MaxDepartmentsIn applies the In predicate on the "max_departments" field.
+func MaxDepartmentsIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxDepartments, vs...))
+}
+
+// MaxDepartmentsNotIn applies the NotIn predicate on the "max_departments" field.
+func MaxDepartmentsNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxDepartments, vs...))
+}
+
+// MaxDepartmentsGT applies the GT predicate on the "max_departments" field.
+func MaxDepartmentsGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxDepartments, v))
+}
+
+// MaxDepartmentsGTE applies the GTE predicate on the "max_departments" field.
+func MaxDepartmentsGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxDepartments, v))
+}
+
+// MaxDepartmentsLT applies the LT predicate on the "max_departments" field.
+func MaxDepartmentsLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxDepartments, v))
+}
+
+// MaxDepartmentsLTE applies the LTE predicate on the "max_departments" field.
+func MaxDepartmentsLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxDepartments, v))
+}
+
+// MaxDepartmentsIsNil applies the IsNil predicate on the "max_departments" field.
+func MaxDepartmentsIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxDepartments))
+}
+
+// MaxDepartmentsNotNil applies the NotNil predicate on the "max_departments" field.
+func MaxDepartmentsNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxDepartments))
+}
+
+// MaxSubTenantsEQ applies the EQ predicate on the "max_sub_tenants" field.
+func MaxSubTenantsEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxSubTenants, v))
+}
+
+// MaxSubTenantsNEQ applies the NEQ predicate on the "max_sub_tenants" field.
+func MaxSubTenantsNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxSubTenants, v))
+}
+
+// MaxSubTenantsIn applies the In predicate on the "max_sub_tenants" field.
+func MaxSubTenantsIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxSubTenants, vs...))
+}
+
+// MaxSubTenantsNotIn applies the NotIn predicate on the "max_sub_tenants" field.
+func MaxSubTenantsNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxSubTenants, vs...))
+}
+
+// MaxSubTenantsGT applies the GT predicate on the "max_sub_tenants" field.
+func MaxSubTenantsGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxSubTenants, v))
+}
+
+// MaxSubTenantsGTE applies the GTE predicate on the "max_sub_tenants" field.
+func MaxSubTenantsGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxSubTenants, v))
+}
+
+// MaxSubTenantsLT applies the LT predicate on the "max_sub_tenants" field.
+func MaxSubTenantsLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxSubTenants, v))
+}
+
+// MaxSubTenantsLTE applies the LTE predicate on the "max_sub_tenants" field.
+func MaxSubTenantsLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxSubTenants, v))
+}
+
+// MaxSubTenantsIsNil applies the IsNil predicate on the "max_sub_tenants" field.
+func MaxSubTenantsIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxSubTenants))
+}
+
+// MaxSubTenantsNotNil applies the NotNil predicate on the "max_sub_tenants" field.
+func MaxSubTenantsNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxSubTenants))
+}
+
+// MaxRolesEQ applies the EQ predicate on the "max_roles" field.
+func MaxRolesEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxRoles, v))
+}
+
+// MaxRolesNEQ applies the NEQ predicate on the "max_roles" field.
+func MaxRolesNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxRoles, v))
+}
+
+// MaxRolesIn applies the In predicate on the "max_roles" field.
+func MaxRolesIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxRoles, vs...))
+}
+
+// MaxRolesNotIn applies the NotIn predicate on the "max_roles" field.
+func MaxRolesNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxRoles, vs...))
+}
+
+// MaxRolesGT applies the GT predicate on the "max_roles" field.
+func MaxRolesGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxRoles, v))
+}
+
+// MaxRolesGTE applies the GTE predicate on the "max_roles" field.
+func MaxRolesGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxRoles, v))
+}
+
+// MaxRolesLT applies the LT predicate on the "max_roles" field.
+func MaxRolesLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxRoles, v))
+}
+
+// MaxRolesLTE applies the LTE predicate on the "max_roles" field.
+func MaxRolesLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxRoles, v))
+}
+
+// MaxRolesIsNil applies the IsNil predicate on the "max_roles" field.
+func MaxRolesIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldMaxRoles))
+}
+
+// MaxRolesNotNil applies the NotNil predicate on the "max_roles" field.
+func MaxRolesNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldMaxRoles))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenants applies the HasEdge predicate on the "tenants" edge.
+func HasTenants() predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TenantsTable, TenantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantsWith applies the HasEdge predicate on the "tenants" edge with a given conditions (other predicates).
+func HasTenantsWith(preds ...predicate.Tenant) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := newTenantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.NotPredicates(p))
+}
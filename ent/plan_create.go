@@ -0,0 +1,1277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/plan"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+// PlanCreate is the builder for creating a Plan entity.
+type PlanCreate struct {
+	config
+	mutation *PlanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (pc *PlanCreate) SetCode(s string) *PlanCreate {
+	pc.mutation.SetCode(s)
+	return pc
+}
+
+// SetName sets the "name" field.
+func (pc *PlanCreate) SetName(s string) *PlanCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetDescription sets the "description" field.
+func (pc *PlanCreate) SetDescription(s string) *PlanCreate {
+	pc.mutation.SetDescription(s)
+	return pc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableDescription(s *string) *PlanCreate {
+	if s != nil {
+		pc.SetDescription(*s)
+	}
+	return pc
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (pc *PlanCreate) SetMaxUsers(i int) *PlanCreate {
+	pc.mutation.SetMaxUsers(i)
+	return pc
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxUsers(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetMaxUsers(*i)
+	}
+	return pc
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (pc *PlanCreate) SetMaxDepartments(i int) *PlanCreate {
+	pc.mutation.SetMaxDepartments(i)
+	return pc
+}
+
+// SetNillableMaxDepartments sets the "max_departments" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxDepartments(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetMaxDepartments(*i)
+	}
+	return pc
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (pc *PlanCreate) SetMaxSubTenants(i int) *PlanCreate {
+	pc.mutation.SetMaxSubTenants(i)
+	return pc
+}
+
+// SetNillableMaxSubTenants sets the "max_sub_tenants" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxSubTenants(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetMaxSubTenants(*i)
+	}
+	return pc
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (pc *PlanCreate) SetMaxRoles(i int) *PlanCreate {
+	pc.mutation.SetMaxRoles(i)
+	return pc
+}
+
+// SetNillableMaxRoles sets the "max_roles" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableMaxRoles(i *int) *PlanCreate {
+	if i != nil {
+		pc.SetMaxRoles(*i)
+	}
+	return pc
+}
+
+// SetFeatures sets the "features" field.
+func (pc *PlanCreate) SetFeatures(m map[string]bool) *PlanCreate {
+	pc.mutation.SetFeatures(m)
+	return pc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (pc *PlanCreate) SetIsDefault(b bool) *PlanCreate {
+	pc.mutation.SetIsDefault(b)
+	return pc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableIsDefault(b *bool) *PlanCreate {
+	if b != nil {
+		pc.SetIsDefault(*b)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PlanCreate) SetCreatedAt(t time.Time) *PlanCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableCreatedAt(t *time.Time) *PlanCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PlanCreate) SetUpdatedAt(t time.Time) *PlanCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableUpdatedAt(t *time.Time) *PlanCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PlanCreate) SetID(i int64) *PlanCreate {
+	pc.mutation.SetID(i)
+	return pc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableID(i *int64) *PlanCreate {
+	if i != nil {
+		pc.SetID(*i)
+	}
+	return pc
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by IDs.
+func (pc *PlanCreate) AddTenantIDs(ids ...int64) *PlanCreate {
+	pc.mutation.AddTenantIDs(ids...)
+	return pc
+}
+
+// AddTenants adds the "tenants" edges to the Tenant entity.
+func (pc *PlanCreate) AddTenants(t ...*Tenant) *PlanCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTenantIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (pc *PlanCreate) Mutation() *PlanMutation {
+	return pc.mutation
+}
+
+// Save creates the Plan in the database.
+func (pc *PlanCreate) Save(ctx context.Context) (*Plan, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PlanCreate) SaveX(ctx context.Context) *Plan {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PlanCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PlanCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PlanCreate) defaults() {
+	if _, ok := pc.mutation.Features(); !ok {
+		v := plan.DefaultFeatures
+		pc.mutation.SetFeatures(v)
+	}
+	if _, ok := pc.mutation.IsDefault(); !ok {
+		v := plan.DefaultIsDefault
+		pc.mutation.SetIsDefault(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := plan.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := plan.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		v := plan.DefaultID()
+		pc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlanCreate) check() error {
+	if _, ok := pc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Plan.code"`)}
+	}
+	if v, ok := pc.mutation.Code(); ok {
+		if err := plan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Plan.code": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Plan.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.Description(); ok {
+		if err := plan.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Plan.description": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxUsers(); ok {
+		if err := plan.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Plan.max_users": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxDepartments(); ok {
+		if err := plan.MaxDepartmentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_departments", err: fmt.Errorf(`ent: validator failed for field "Plan.max_departments": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxSubTenants(); ok {
+		if err := plan.MaxSubTenantsValidator(v); err != nil {
+			return &ValidationError{Name: "max_sub_tenants", err: fmt.Errorf(`ent: validator failed for field "Plan.max_sub_tenants": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.MaxRoles(); ok {
+		if err := plan.MaxRolesValidator(v); err != nil {
+			return &ValidationError{Name: "max_roles", err: fmt.Errorf(`ent: validator failed for field "Plan.max_roles": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Features(); !ok {
+		return &ValidationError{Name: "features", err: errors.New(`ent: missing required field "Plan.features"`)}
+	}
+	if _, ok := pc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "Plan.is_default"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Plan.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Plan.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PlanCreate) sqlSave(ctx context.Context) (*Plan, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Plan{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(plan.Table, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.Code(); ok {
+		_spec.SetField(plan.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Description(); ok {
+		_spec.SetField(plan.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := pc.mutation.MaxUsers(); ok {
+		_spec.SetField(plan.FieldMaxUsers, field.TypeInt, value)
+		_node.MaxUsers = &value
+	}
+	if value, ok := pc.mutation.MaxDepartments(); ok {
+		_spec.SetField(plan.FieldMaxDepartments, field.TypeInt, value)
+		_node.MaxDepartments = &value
+	}
+	if value, ok := pc.mutation.MaxSubTenants(); ok {
+		_spec.SetField(plan.FieldMaxSubTenants, field.TypeInt, value)
+		_node.MaxSubTenants = &value
+	}
+	if value, ok := pc.mutation.MaxRoles(); ok {
+		_spec.SetField(plan.FieldMaxRoles, field.TypeInt, value)
+		_node.MaxRoles = &value
+	}
+	if value, ok := pc.mutation.Features(); ok {
+		_spec.SetField(plan.FieldFeatures, field.TypeJSON, value)
+		_node.Features = value
+	}
+	if value, ok := pc.mutation.IsDefault(); ok {
+		_spec.SetField(plan.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.TenantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Plan.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlanUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PlanCreate) OnConflict(opts ...sql.ConflictOption) *PlanUpsertOne {
+	pc.conflict = opts
+	return &PlanUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PlanCreate) OnConflictColumns(columns ...string) *PlanUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PlanUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PlanUpsertOne is the builder for "upsert"-ing
+	//  one Plan node.
+	PlanUpsertOne struct {
+		create *PlanCreate
+	}
+
+	// PlanUpsert is the "OnConflict" setter.
+	PlanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *PlanUpsert) SetName(v string) *PlanUpsert {
+	u.Set(plan.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateName() *PlanUpsert {
+	u.SetExcluded(plan.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PlanUpsert) SetDescription(v string) *PlanUpsert {
+	u.Set(plan.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateDescription() *PlanUpsert {
+	u.SetExcluded(plan.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PlanUpsert) ClearDescription() *PlanUpsert {
+	u.SetNull(plan.FieldDescription)
+	return u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *PlanUpsert) SetMaxUsers(v int) *PlanUpsert {
+	u.Set(plan.FieldMaxUsers, v)
+	return u
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateMaxUsers() *PlanUpsert {
+	u.SetExcluded(plan.FieldMaxUsers)
+	return u
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *PlanUpsert) AddMaxUsers(v int) *PlanUpsert {
+	u.Add(plan.FieldMaxUsers, v)
+	return u
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *PlanUpsert) ClearMaxUsers() *PlanUpsert {
+	u.SetNull(plan.FieldMaxUsers)
+	return u
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (u *PlanUpsert) SetMaxDepartments(v int) *PlanUpsert {
+	u.Set(plan.FieldMaxDepartments, v)
+	return u
+}
+
+// UpdateMaxDepartments sets the "max_departments" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateMaxDepartments() *PlanUpsert {
+	u.SetExcluded(plan.FieldMaxDepartments)
+	return u
+}
+
+// AddMaxDepartments adds v to the "max_departments" field.
+func (u *PlanUpsert) AddMaxDepartments(v int) *PlanUpsert {
+	u.Add(plan.FieldMaxDepartments, v)
+	return u
+}
+
+// ClearMaxDepartments clears the value of the "max_departments" field.
+func (u *PlanUpsert) ClearMaxDepartments() *PlanUpsert {
+	u.SetNull(plan.FieldMaxDepartments)
+	return u
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (u *PlanUpsert) SetMaxSubTenants(v int) *PlanUpsert {
+	u.Set(plan.FieldMaxSubTenants, v)
+	return u
+}
+
+// UpdateMaxSubTenants sets the "max_sub_tenants" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateMaxSubTenants() *PlanUpsert {
+	u.SetExcluded(plan.FieldMaxSubTenants)
+	return u
+}
+
+// AddMaxSubTenants adds v to the "max_sub_tenants" field.
+func (u *PlanUpsert) AddMaxSubTenants(v int) *PlanUpsert {
+	u.Add(plan.FieldMaxSubTenants, v)
+	return u
+}
+
+// ClearMaxSubTenants clears the value of the "max_sub_tenants" field.
+func (u *PlanUpsert) ClearMaxSubTenants() *PlanUpsert {
+	u.SetNull(plan.FieldMaxSubTenants)
+	return u
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (u *PlanUpsert) SetMaxRoles(v int) *PlanUpsert {
+	u.Set(plan.FieldMaxRoles, v)
+	return u
+}
+
+// UpdateMaxRoles sets the "max_roles" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateMaxRoles() *PlanUpsert {
+	u.SetExcluded(plan.FieldMaxRoles)
+	return u
+}
+
+// AddMaxRoles adds v to the "max_roles" field.
+func (u *PlanUpsert) AddMaxRoles(v int) *PlanUpsert {
+	u.Add(plan.FieldMaxRoles, v)
+	return u
+}
+
+// ClearMaxRoles clears the value of the "max_roles" field.
+func (u *PlanUpsert) ClearMaxRoles() *PlanUpsert {
+	u.SetNull(plan.FieldMaxRoles)
+	return u
+}
+
+// SetFeatures sets the "features" field.
+func (u *PlanUpsert) SetFeatures(v map[string]bool) *PlanUpsert {
+	u.Set(plan.FieldFeatures, v)
+	return u
+}
+
+// UpdateFeatures sets the "features" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateFeatures() *PlanUpsert {
+	u.SetExcluded(plan.FieldFeatures)
+	return u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PlanUpsert) SetIsDefault(v bool) *PlanUpsert {
+	u.Set(plan.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateIsDefault() *PlanUpsert {
+	u.SetExcluded(plan.FieldIsDefault)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlanUpsert) SetUpdatedAt(v time.Time) *PlanUpsert {
+	u.Set(plan.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateUpdatedAt() *PlanUpsert {
+	u.SetExcluded(plan.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(plan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlanUpsertOne) UpdateNewValues() *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(plan.FieldID)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(plan.FieldCode)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(plan.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlanUpsertOne) Ignore() *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlanUpsertOne) DoNothing() *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlanCreate.OnConflict
+// documentation for more info.
+func (u *PlanUpsertOne) Update(set func(*PlanUpsert)) *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PlanUpsertOne) SetName(v string) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateName() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PlanUpsertOne) SetDescription(v string) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateDescription() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PlanUpsertOne) ClearDescription() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *PlanUpsertOne) SetMaxUsers(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *PlanUpsertOne) AddMaxUsers(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateMaxUsers() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *PlanUpsertOne) ClearMaxUsers() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxUsers()
+	})
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (u *PlanUpsertOne) SetMaxDepartments(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxDepartments(v)
+	})
+}
+
+// AddMaxDepartments adds v to the "max_departments" field.
+func (u *PlanUpsertOne) AddMaxDepartments(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxDepartments(v)
+	})
+}
+
+// UpdateMaxDepartments sets the "max_departments" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateMaxDepartments() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxDepartments()
+	})
+}
+
+// ClearMaxDepartments clears the value of the "max_departments" field.
+func (u *PlanUpsertOne) ClearMaxDepartments() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxDepartments()
+	})
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (u *PlanUpsertOne) SetMaxSubTenants(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxSubTenants(v)
+	})
+}
+
+// AddMaxSubTenants adds v to the "max_sub_tenants" field.
+func (u *PlanUpsertOne) AddMaxSubTenants(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxSubTenants(v)
+	})
+}
+
+// UpdateMaxSubTenants sets the "max_sub_tenants" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateMaxSubTenants() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxSubTenants()
+	})
+}
+
+// ClearMaxSubTenants clears the value of the "max_sub_tenants" field.
+func (u *PlanUpsertOne) ClearMaxSubTenants() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxSubTenants()
+	})
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (u *PlanUpsertOne) SetMaxRoles(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxRoles(v)
+	})
+}
+
+// AddMaxRoles adds v to the "max_roles" field.
+func (u *PlanUpsertOne) AddMaxRoles(v int) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxRoles(v)
+	})
+}
+
+// UpdateMaxRoles sets the "max_roles" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateMaxRoles() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxRoles()
+	})
+}
+
+// ClearMaxRoles clears the value of the "max_roles" field.
+func (u *PlanUpsertOne) ClearMaxRoles() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxRoles()
+	})
+}
+
+// SetFeatures sets the "features" field.
+func (u *PlanUpsertOne) SetFeatures(v map[string]bool) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetFeatures(v)
+	})
+}
+
+// UpdateFeatures sets the "features" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateFeatures() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateFeatures()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PlanUpsertOne) SetIsDefault(v bool) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateIsDefault() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlanUpsertOne) SetUpdatedAt(v time.Time) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateUpdatedAt() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlanUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlanUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlanCreateBulk is the builder for creating many Plan entities in bulk.
+type PlanCreateBulk struct {
+	config
+	err      error
+	builders []*PlanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Plan entities in the database.
+func (pcb *PlanCreateBulk) Save(ctx context.Context) ([]*Plan, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Plan, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlanCreateBulk) SaveX(ctx context.Context) []*Plan {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PlanCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Plan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlanUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PlanCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlanUpsertBulk {
+	pcb.conflict = opts
+	return &PlanUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PlanCreateBulk) OnConflictColumns(columns ...string) *PlanUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PlanUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PlanUpsertBulk is the builder for "upsert"-ing
+// a bulk of Plan nodes.
+type PlanUpsertBulk struct {
+	create *PlanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(plan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlanUpsertBulk) UpdateNewValues() *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(plan.FieldID)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(plan.FieldCode)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(plan.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlanUpsertBulk) Ignore() *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlanUpsertBulk) DoNothing() *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlanCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlanUpsertBulk) Update(set func(*PlanUpsert)) *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PlanUpsertBulk) SetName(v string) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateName() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PlanUpsertBulk) SetDescription(v string) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateDescription() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PlanUpsertBulk) ClearDescription() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *PlanUpsertBulk) SetMaxUsers(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *PlanUpsertBulk) AddMaxUsers(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateMaxUsers() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *PlanUpsertBulk) ClearMaxUsers() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxUsers()
+	})
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (u *PlanUpsertBulk) SetMaxDepartments(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxDepartments(v)
+	})
+}
+
+// AddMaxDepartments adds v to the "max_departments" field.
+func (u *PlanUpsertBulk) AddMaxDepartments(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxDepartments(v)
+	})
+}
+
+// UpdateMaxDepartments sets the "max_departments" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateMaxDepartments() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxDepartments()
+	})
+}
+
+// ClearMaxDepartments clears the value of the "max_departments" field.
+func (u *PlanUpsertBulk) ClearMaxDepartments() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxDepartments()
+	})
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (u *PlanUpsertBulk) SetMaxSubTenants(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxSubTenants(v)
+	})
+}
+
+// AddMaxSubTenants adds v to the "max_sub_tenants" field.
+func (u *PlanUpsertBulk) AddMaxSubTenants(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxSubTenants(v)
+	})
+}
+
+// UpdateMaxSubTenants sets the "max_sub_tenants" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateMaxSubTenants() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxSubTenants()
+	})
+}
+
+// ClearMaxSubTenants clears the value of the "max_sub_tenants" field.
+func (u *PlanUpsertBulk) ClearMaxSubTenants() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxSubTenants()
+	})
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (u *PlanUpsertBulk) SetMaxRoles(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetMaxRoles(v)
+	})
+}
+
+// AddMaxRoles adds v to the "max_roles" field.
+func (u *PlanUpsertBulk) AddMaxRoles(v int) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.AddMaxRoles(v)
+	})
+}
+
+// UpdateMaxRoles sets the "max_roles" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateMaxRoles() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateMaxRoles()
+	})
+}
+
+// ClearMaxRoles clears the value of the "max_roles" field.
+func (u *PlanUpsertBulk) ClearMaxRoles() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.ClearMaxRoles()
+	})
+}
+
+// SetFeatures sets the "features" field.
+func (u *PlanUpsertBulk) SetFeatures(v map[string]bool) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetFeatures(v)
+	})
+}
+
+// UpdateFeatures sets the "features" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateFeatures() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateFeatures()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *PlanUpsertBulk) SetIsDefault(v bool) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateIsDefault() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlanUpsertBulk) SetUpdatedAt(v time.Time) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateUpdatedAt() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PlanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,877 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/plan"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+)
+
+// PlanUpdate is the builder for updating Plan entities.
+type PlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (pu *PlanUpdate) Where(ps ...predicate.Plan) *PlanUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PlanUpdate) SetName(s string) *PlanUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableName(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetDescription sets the "description" field.
+func (pu *PlanUpdate) SetDescription(s string) *PlanUpdate {
+	pu.mutation.SetDescription(s)
+	return pu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableDescription(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetDescription(*s)
+	}
+	return pu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pu *PlanUpdate) ClearDescription() *PlanUpdate {
+	pu.mutation.ClearDescription()
+	return pu
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (pu *PlanUpdate) SetMaxUsers(i int) *PlanUpdate {
+	pu.mutation.ResetMaxUsers()
+	pu.mutation.SetMaxUsers(i)
+	return pu
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxUsers(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxUsers(*i)
+	}
+	return pu
+}
+
+// AddMaxUsers adds i to the "max_users" field.
+func (pu *PlanUpdate) AddMaxUsers(i int) *PlanUpdate {
+	pu.mutation.AddMaxUsers(i)
+	return pu
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (pu *PlanUpdate) ClearMaxUsers() *PlanUpdate {
+	pu.mutation.ClearMaxUsers()
+	return pu
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (pu *PlanUpdate) SetMaxDepartments(i int) *PlanUpdate {
+	pu.mutation.ResetMaxDepartments()
+	pu.mutation.SetMaxDepartments(i)
+	return pu
+}
+
+// SetNillableMaxDepartments sets the "max_departments" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxDepartments(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxDepartments(*i)
+	}
+	return pu
+}
+
+// AddMaxDepartments adds i to the "max_departments" field.
+func (pu *PlanUpdate) AddMaxDepartments(i int) *PlanUpdate {
+	pu.mutation.AddMaxDepartments(i)
+	return pu
+}
+
+// ClearMaxDepartments clears the value of the "max_departments" field.
+func (pu *PlanUpdate) ClearMaxDepartments() *PlanUpdate {
+	pu.mutation.ClearMaxDepartments()
+	return pu
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (pu *PlanUpdate) SetMaxSubTenants(i int) *PlanUpdate {
+	pu.mutation.ResetMaxSubTenants()
+	pu.mutation.SetMaxSubTenants(i)
+	return pu
+}
+
+// SetNillableMaxSubTenants sets the "max_sub_tenants" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxSubTenants(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxSubTenants(*i)
+	}
+	return pu
+}
+
+// AddMaxSubTenants adds i to the "max_sub_tenants" field.
+func (pu *PlanUpdate) AddMaxSubTenants(i int) *PlanUpdate {
+	pu.mutation.AddMaxSubTenants(i)
+	return pu
+}
+
+// ClearMaxSubTenants clears the value of the "max_sub_tenants" field.
+func (pu *PlanUpdate) ClearMaxSubTenants() *PlanUpdate {
+	pu.mutation.ClearMaxSubTenants()
+	return pu
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (pu *PlanUpdate) SetMaxRoles(i int) *PlanUpdate {
+	pu.mutation.ResetMaxRoles()
+	pu.mutation.SetMaxRoles(i)
+	return pu
+}
+
+// SetNillableMaxRoles sets the "max_roles" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableMaxRoles(i *int) *PlanUpdate {
+	if i != nil {
+		pu.SetMaxRoles(*i)
+	}
+	return pu
+}
+
+// AddMaxRoles adds i to the "max_roles" field.
+func (pu *PlanUpdate) AddMaxRoles(i int) *PlanUpdate {
+	pu.mutation.AddMaxRoles(i)
+	return pu
+}
+
+// ClearMaxRoles clears the value of the "max_roles" field.
+func (pu *PlanUpdate) ClearMaxRoles() *PlanUpdate {
+	pu.mutation.ClearMaxRoles()
+	return pu
+}
+
+// SetFeatures sets the "features" field.
+func (pu *PlanUpdate) SetFeatures(m map[string]bool) *PlanUpdate {
+	pu.mutation.SetFeatures(m)
+	return pu
+}
+
+// SetIsDefault sets the "is_default" field.
+func (pu *PlanUpdate) SetIsDefault(b bool) *PlanUpdate {
+	pu.mutation.SetIsDefault(b)
+	return pu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableIsDefault(b *bool) *PlanUpdate {
+	if b != nil {
+		pu.SetIsDefault(*b)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PlanUpdate) SetUpdatedAt(t time.Time) *PlanUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by IDs.
+func (pu *PlanUpdate) AddTenantIDs(ids ...int64) *PlanUpdate {
+	pu.mutation.AddTenantIDs(ids...)
+	return pu
+}
+
+// AddTenants adds the "tenants" edges to the Tenant entity.
+func (pu *PlanUpdate) AddTenants(t ...*Tenant) *PlanUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTenantIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (pu *PlanUpdate) Mutation() *PlanMutation {
+	return pu.mutation
+}
+
+// ClearTenants clears all "tenants" edges to the Tenant entity.
+func (pu *PlanUpdate) ClearTenants() *PlanUpdate {
+	pu.mutation.ClearTenants()
+	return pu
+}
+
+// RemoveTenantIDs removes the "tenants" edge to Tenant entities by IDs.
+func (pu *PlanUpdate) RemoveTenantIDs(ids ...int64) *PlanUpdate {
+	pu.mutation.RemoveTenantIDs(ids...)
+	return pu
+}
+
+// RemoveTenants removes "tenants" edges to Tenant entities.
+func (pu *PlanUpdate) RemoveTenants(t ...*Tenant) *PlanUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTenantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlanUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlanUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlanUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PlanUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PlanUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Description(); ok {
+		if err := plan.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Plan.description": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxUsers(); ok {
+		if err := plan.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Plan.max_users": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxDepartments(); ok {
+		if err := plan.MaxDepartmentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_departments", err: fmt.Errorf(`ent: validator failed for field "Plan.max_departments": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxSubTenants(); ok {
+		if err := plan.MaxSubTenantsValidator(v); err != nil {
+			return &ValidationError{Name: "max_sub_tenants", err: fmt.Errorf(`ent: validator failed for field "Plan.max_sub_tenants": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.MaxRoles(); ok {
+		if err := plan.MaxRolesValidator(v); err != nil {
+			return &ValidationError{Name: "max_roles", err: fmt.Errorf(`ent: validator failed for field "Plan.max_roles": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Description(); ok {
+		_spec.SetField(plan.FieldDescription, field.TypeString, value)
+	}
+	if pu.mutation.DescriptionCleared() {
+		_spec.ClearField(plan.FieldDescription, field.TypeString)
+	}
+	if value, ok := pu.mutation.MaxUsers(); ok {
+		_spec.SetField(plan.FieldMaxUsers, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMaxUsers(); ok {
+		_spec.AddField(plan.FieldMaxUsers, field.TypeInt, value)
+	}
+	if pu.mutation.MaxUsersCleared() {
+		_spec.ClearField(plan.FieldMaxUsers, field.TypeInt)
+	}
+	if value, ok := pu.mutation.MaxDepartments(); ok {
+		_spec.SetField(plan.FieldMaxDepartments, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMaxDepartments(); ok {
+		_spec.AddField(plan.FieldMaxDepartments, field.TypeInt, value)
+	}
+	if pu.mutation.MaxDepartmentsCleared() {
+		_spec.ClearField(plan.FieldMaxDepartments, field.TypeInt)
+	}
+	if value, ok := pu.mutation.MaxSubTenants(); ok {
+		_spec.SetField(plan.FieldMaxSubTenants, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMaxSubTenants(); ok {
+		_spec.AddField(plan.FieldMaxSubTenants, field.TypeInt, value)
+	}
+	if pu.mutation.MaxSubTenantsCleared() {
+		_spec.ClearField(plan.FieldMaxSubTenants, field.TypeInt)
+	}
+	if value, ok := pu.mutation.MaxRoles(); ok {
+		_spec.SetField(plan.FieldMaxRoles, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMaxRoles(); ok {
+		_spec.AddField(plan.FieldMaxRoles, field.TypeInt, value)
+	}
+	if pu.mutation.MaxRolesCleared() {
+		_spec.ClearField(plan.FieldMaxRoles, field.TypeInt)
+	}
+	if value, ok := pu.mutation.Features(); ok {
+		_spec.SetField(plan.FieldFeatures, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.IsDefault(); ok {
+		_spec.SetField(plan.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTenantsIDs(); len(nodes) > 0 && !pu.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TenantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlanUpdateOne is the builder for updating a single Plan entity.
+type PlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// SetName sets the "name" field.
+func (puo *PlanUpdateOne) SetName(s string) *PlanUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableName(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *PlanUpdateOne) SetDescription(s string) *PlanUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableDescription(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
+	}
+	return puo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (puo *PlanUpdateOne) ClearDescription() *PlanUpdateOne {
+	puo.mutation.ClearDescription()
+	return puo
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (puo *PlanUpdateOne) SetMaxUsers(i int) *PlanUpdateOne {
+	puo.mutation.ResetMaxUsers()
+	puo.mutation.SetMaxUsers(i)
+	return puo
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxUsers(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxUsers(*i)
+	}
+	return puo
+}
+
+// AddMaxUsers adds i to the "max_users" field.
+func (puo *PlanUpdateOne) AddMaxUsers(i int) *PlanUpdateOne {
+	puo.mutation.AddMaxUsers(i)
+	return puo
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (puo *PlanUpdateOne) ClearMaxUsers() *PlanUpdateOne {
+	puo.mutation.ClearMaxUsers()
+	return puo
+}
+
+// SetMaxDepartments sets the "max_departments" field.
+func (puo *PlanUpdateOne) SetMaxDepartments(i int) *PlanUpdateOne {
+	puo.mutation.ResetMaxDepartments()
+	puo.mutation.SetMaxDepartments(i)
+	return puo
+}
+
+// SetNillableMaxDepartments sets the "max_departments" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxDepartments(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxDepartments(*i)
+	}
+	return puo
+}
+
+// AddMaxDepartments adds i to the "max_departments" field.
+func (puo *PlanUpdateOne) AddMaxDepartments(i int) *PlanUpdateOne {
+	puo.mutation.AddMaxDepartments(i)
+	return puo
+}
+
+// ClearMaxDepartments clears the value of the "max_departments" field.
+func (puo *PlanUpdateOne) ClearMaxDepartments() *PlanUpdateOne {
+	puo.mutation.ClearMaxDepartments()
+	return puo
+}
+
+// SetMaxSubTenants sets the "max_sub_tenants" field.
+func (puo *PlanUpdateOne) SetMaxSubTenants(i int) *PlanUpdateOne {
+	puo.mutation.ResetMaxSubTenants()
+	puo.mutation.SetMaxSubTenants(i)
+	return puo
+}
+
+// SetNillableMaxSubTenants sets the "max_sub_tenants" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxSubTenants(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxSubTenants(*i)
+	}
+	return puo
+}
+
+// AddMaxSubTenants adds i to the "max_sub_tenants" field.
+func (puo *PlanUpdateOne) AddMaxSubTenants(i int) *PlanUpdateOne {
+	puo.mutation.AddMaxSubTenants(i)
+	return puo
+}
+
+// ClearMaxSubTenants clears the value of the "max_sub_tenants" field.
+func (puo *PlanUpdateOne) ClearMaxSubTenants() *PlanUpdateOne {
+	puo.mutation.ClearMaxSubTenants()
+	return puo
+}
+
+// SetMaxRoles sets the "max_roles" field.
+func (puo *PlanUpdateOne) SetMaxRoles(i int) *PlanUpdateOne {
+	puo.mutation.ResetMaxRoles()
+	puo.mutation.SetMaxRoles(i)
+	return puo
+}
+
+// SetNillableMaxRoles sets the "max_roles" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableMaxRoles(i *int) *PlanUpdateOne {
+	if i != nil {
+		puo.SetMaxRoles(*i)
+	}
+	return puo
+}
+
+// AddMaxRoles adds i to the "max_roles" field.
+func (puo *PlanUpdateOne) AddMaxRoles(i int) *PlanUpdateOne {
+	puo.mutation.AddMaxRoles(i)
+	return puo
+}
+
+// ClearMaxRoles clears the value of the "max_roles" field.
+func (puo *PlanUpdateOne) ClearMaxRoles() *PlanUpdateOne {
+	puo.mutation.ClearMaxRoles()
+	return puo
+}
+
+// SetFeatures sets the "features" field.
+func (puo *PlanUpdateOne) SetFeatures(m map[string]bool) *PlanUpdateOne {
+	puo.mutation.SetFeatures(m)
+	return puo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (puo *PlanUpdateOne) SetIsDefault(b bool) *PlanUpdateOne {
+	puo.mutation.SetIsDefault(b)
+	return puo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableIsDefault(b *bool) *PlanUpdateOne {
+	if b != nil {
+		puo.SetIsDefault(*b)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PlanUpdateOne) SetUpdatedAt(t time.Time) *PlanUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by IDs.
+func (puo *PlanUpdateOne) AddTenantIDs(ids ...int64) *PlanUpdateOne {
+	puo.mutation.AddTenantIDs(ids...)
+	return puo
+}
+
+// AddTenants adds the "tenants" edges to the Tenant entity.
+func (puo *PlanUpdateOne) AddTenants(t ...*Tenant) *PlanUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTenantIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (puo *PlanUpdateOne) Mutation() *PlanMutation {
+	return puo.mutation
+}
+
+// ClearTenants clears all "tenants" edges to the Tenant entity.
+func (puo *PlanUpdateOne) ClearTenants() *PlanUpdateOne {
+	puo.mutation.ClearTenants()
+	return puo
+}
+
+// RemoveTenantIDs removes the "tenants" edge to Tenant entities by IDs.
+func (puo *PlanUpdateOne) RemoveTenantIDs(ids ...int64) *PlanUpdateOne {
+	puo.mutation.RemoveTenantIDs(ids...)
+	return puo
+}
+
+// RemoveTenants removes "tenants" edges to Tenant entities.
+func (puo *PlanUpdateOne) RemoveTenants(t ...*Tenant) *PlanUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTenantIDs(ids...)
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (puo *PlanUpdateOne) Where(ps ...predicate.Plan) *PlanUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlanUpdateOne) Select(field string, fields ...string) *PlanUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Plan entity.
+func (puo *PlanUpdateOne) Save(ctx context.Context) (*Plan, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlanUpdateOne) SaveX(ctx context.Context) *Plan {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlanUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PlanUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PlanUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Description(); ok {
+		if err := plan.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Plan.description": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxUsers(); ok {
+		if err := plan.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Plan.max_users": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxDepartments(); ok {
+		if err := plan.MaxDepartmentsValidator(v); err != nil {
+			return &ValidationError{Name: "max_departments", err: fmt.Errorf(`ent: validator failed for field "Plan.max_departments": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxSubTenants(); ok {
+		if err := plan.MaxSubTenantsValidator(v); err != nil {
+			return &ValidationError{Name: "max_sub_tenants", err: fmt.Errorf(`ent: validator failed for field "Plan.max_sub_tenants": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.MaxRoles(); ok {
+		if err := plan.MaxRolesValidator(v); err != nil {
+			return &ValidationError{Name: "max_roles", err: fmt.Errorf(`ent: validator failed for field "Plan.max_roles": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Plan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plan.FieldID)
+		for _, f := range fields {
+			if !plan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != plan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.SetField(plan.FieldDescription, field.TypeString, value)
+	}
+	if puo.mutation.DescriptionCleared() {
+		_spec.ClearField(plan.FieldDescription, field.TypeString)
+	}
+	if value, ok := puo.mutation.MaxUsers(); ok {
+		_spec.SetField(plan.FieldMaxUsers, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMaxUsers(); ok {
+		_spec.AddField(plan.FieldMaxUsers, field.TypeInt, value)
+	}
+	if puo.mutation.MaxUsersCleared() {
+		_spec.ClearField(plan.FieldMaxUsers, field.TypeInt)
+	}
+	if value, ok := puo.mutation.MaxDepartments(); ok {
+		_spec.SetField(plan.FieldMaxDepartments, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMaxDepartments(); ok {
+		_spec.AddField(plan.FieldMaxDepartments, field.TypeInt, value)
+	}
+	if puo.mutation.MaxDepartmentsCleared() {
+		_spec.ClearField(plan.FieldMaxDepartments, field.TypeInt)
+	}
+	if value, ok := puo.mutation.MaxSubTenants(); ok {
+		_spec.SetField(plan.FieldMaxSubTenants, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMaxSubTenants(); ok {
+		_spec.AddField(plan.FieldMaxSubTenants, field.TypeInt, value)
+	}
+	if puo.mutation.MaxSubTenantsCleared() {
+		_spec.ClearField(plan.FieldMaxSubTenants, field.TypeInt)
+	}
+	if value, ok := puo.mutation.MaxRoles(); ok {
+		_spec.SetField(plan.FieldMaxRoles, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMaxRoles(); ok {
+		_spec.AddField(plan.FieldMaxRoles, field.TypeInt, value)
+	}
+	if puo.mutation.MaxRolesCleared() {
+		_spec.ClearField(plan.FieldMaxRoles, field.TypeInt)
+	}
+	if value, ok := puo.mutation.Features(); ok {
+		_spec.SetField(plan.FieldFeatures, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.IsDefault(); ok {
+		_spec.SetField(plan.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTenantsIDs(); len(nodes) > 0 && !puo.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TenantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Plan{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -6,18 +6,36 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessPolicy is the predicate function for accesspolicy builders.
+type AccessPolicy func(*sql.Selector)
+
 // CasbinRule is the predicate function for casbinrule builders.
 type CasbinRule func(*sql.Selector)
 
 // Department is the predicate function for department builders.
 type Department func(*sql.Selector)
 
+// Plan is the predicate function for plan builders.
+type Plan func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
+// TenantInvitation is the predicate function for tenantinvitation builders.
+type TenantInvitation func(*sql.Selector)
+
+// TenantSetting is the predicate function for tenantsetting builders.
+type TenantSetting func(*sql.Selector)
+
+// TenantSettingLog is the predicate function for tenantsettinglog builders.
+type TenantSettingLog func(*sql.Selector)
+
+// TenantStatusLog is the predicate function for tenantstatuslog builders.
+type TenantStatusLog func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
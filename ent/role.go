@@ -30,6 +30,10 @@ type Role struct {
 	Description string `json:"description,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// 是否角色模板：只有平台级角色可以作为模板，租户角色只能继承模板
+	IsTemplate bool `json:"is_template,omitempty"`
+	// 数据范围：ALL(全部), TENANT_SUBTREE(当前租户及下级租户), TENANT(当前租户), DEPT_SUBTREE(所在部门及下级部门), SELF(本人创建)
+	DataScope role.DataScope `json:"data_scope,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -46,9 +50,13 @@ type RoleEdges struct {
 	UserRoles []*UserRole `json:"user_roles,omitempty"`
 	// Tenant holds the value of the tenant edge.
 	Tenant *Tenant `json:"tenant,omitempty"`
+	// InheritedBy holds the value of the inherited_by edge.
+	InheritedBy []*Role `json:"inherited_by,omitempty"`
+	// Inherits holds the value of the inherits edge.
+	Inherits []*Role `json:"inherits,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // UserRolesOrErr returns the UserRoles value or an error if the edge
@@ -71,16 +79,34 @@ func (e RoleEdges) TenantOrErr() (*Tenant, error) {
 	return nil, &NotLoadedError{edge: "tenant"}
 }
 
+// InheritedByOrErr returns the InheritedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) InheritedByOrErr() ([]*Role, error) {
+	if e.loadedTypes[2] {
+		return e.InheritedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "inherited_by"}
+}
+
+// InheritsOrErr returns the Inherits value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) InheritsOrErr() ([]*Role, error) {
+	if e.loadedTypes[3] {
+		return e.Inherits, nil
+	}
+	return nil, &NotLoadedError{edge: "inherits"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case role.FieldIsSystem, role.FieldIsActive:
+		case role.FieldIsSystem, role.FieldIsActive, role.FieldIsTemplate:
 			values[i] = new(sql.NullBool)
 		case role.FieldID, role.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case role.FieldCode, role.FieldName, role.FieldDescription:
+		case role.FieldCode, role.FieldName, role.FieldDescription, role.FieldDataScope:
 			values[i] = new(sql.NullString)
 		case role.FieldCreatedAt, role.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -142,6 +168,18 @@ func (r *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.IsActive = value.Bool
 			}
+		case role.FieldIsTemplate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_template", values[i])
+			} else if value.Valid {
+				r.IsTemplate = value.Bool
+			}
+		case role.FieldDataScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_scope", values[i])
+			} else if value.Valid {
+				r.DataScope = role.DataScope(value.String)
+			}
 		case role.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -177,6 +215,16 @@ func (r *Role) QueryTenant() *TenantQuery {
 	return NewRoleClient(r.config).QueryTenant(r)
 }
 
+// QueryInheritedBy queries the "inherited_by" edge of the Role entity.
+func (r *Role) QueryInheritedBy() *RoleQuery {
+	return NewRoleClient(r.config).QueryInheritedBy(r)
+}
+
+// QueryInherits queries the "inherits" edge of the Role entity.
+func (r *Role) QueryInherits() *RoleQuery {
+	return NewRoleClient(r.config).QueryInherits(r)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -220,6 +268,12 @@ func (r *Role) String() string {
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", r.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("is_template=")
+	builder.WriteString(fmt.Sprintf("%v", r.IsTemplate))
+	builder.WriteString(", ")
+	builder.WriteString("data_scope=")
+	builder.WriteString(fmt.Sprintf("%v", r.DataScope))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package role
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +27,10 @@ const (
 	FieldDescription = "description"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldIsTemplate holds the string denoting the is_template field in the database.
+	FieldIsTemplate = "is_template"
+	// FieldDataScope holds the string denoting the data_scope field in the database.
+	FieldDataScope = "data_scope"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +39,10 @@ const (
 	EdgeUserRoles = "user_roles"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
+	// EdgeInheritedBy holds the string denoting the inherited_by edge name in mutations.
+	EdgeInheritedBy = "inherited_by"
+	// EdgeInherits holds the string denoting the inherits edge name in mutations.
+	EdgeInherits = "inherits"
 	// Table holds the table name of the role in the database.
 	Table = "roles"
 	// UserRolesTable is the table that holds the user_roles relation/edge.
@@ -50,6 +59,10 @@ const (
 	TenantInverseTable = "tenants"
 	// TenantColumn is the table column denoting the tenant relation/edge.
 	TenantColumn = "tenant_id"
+	// InheritedByTable is the table that holds the inherited_by relation/edge. The primary key declared below.
+	InheritedByTable = "role_inherits"
+	// InheritsTable is the table that holds the inherits relation/edge. The primary key declared below.
+	InheritsTable = "role_inherits"
 )
 
 // Columns holds all SQL columns for role fields.
@@ -61,10 +74,21 @@ var Columns = []string{
 	FieldIsSystem,
 	FieldDescription,
 	FieldIsActive,
+	FieldIsTemplate,
+	FieldDataScope,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
 
+var (
+	// InheritedByPrimaryKey and InheritedByColumn2 are the table columns denoting the
+	// primary key for the inherited_by relation (M2M).
+	InheritedByPrimaryKey = []string{"role_id", "inherited_by_id"}
+	// InheritsPrimaryKey and InheritsColumn2 are the table columns denoting the
+	// primary key for the inherits relation (M2M).
+	InheritsPrimaryKey = []string{"role_id", "inherited_by_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -84,6 +108,8 @@ var (
 	DefaultIsSystem bool
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultIsTemplate holds the default value on creation for the "is_template" field.
+	DefaultIsTemplate bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -92,6 +118,35 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// DataScope defines the type for the "data_scope" enum field.
+type DataScope string
+
+// DataScopeTENANT is the default value of the DataScope enum.
+const DefaultDataScope = DataScopeTENANT
+
+// DataScope values.
+const (
+	DataScopeALL            DataScope = "ALL"
+	DataScopeTENANT_SUBTREE DataScope = "TENANT_SUBTREE"
+	DataScopeTENANT         DataScope = "TENANT"
+	DataScopeDEPT_SUBTREE   DataScope = "DEPT_SUBTREE"
+	DataScopeSELF           DataScope = "SELF"
+)
+
+func (ds DataScope) String() string {
+	return string(ds)
+}
+
+// DataScopeValidator is a validator for the "data_scope" field enum values. It is called by the builders before save.
+func DataScopeValidator(ds DataScope) error {
+	switch ds {
+	case DataScopeALL, DataScopeTENANT_SUBTREE, DataScopeTENANT, DataScopeDEPT_SUBTREE, DataScopeSELF:
+		return nil
+	default:
+		return fmt.Errorf("role: invalid enum value for data_scope field: %q", ds)
+	}
+}
+
 // OrderOption defines the ordering options for the Role queries.
 type OrderOption func(*sql.Selector)
 
@@ -130,6 +185,16 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByIsTemplate orders the results by the is_template field.
+func ByIsTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsTemplate, opts...).ToFunc()
+}
+
+// ByDataScope orders the results by the data_scope field.
+func ByDataScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataScope, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -160,6 +225,34 @@ func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByInheritedByCount orders the results by inherited_by count.
+func ByInheritedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInheritedByStep(), opts...)
+	}
+}
+
+// ByInheritedBy orders the results by inherited_by terms.
+func ByInheritedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInheritedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInheritsCount orders the results by inherits count.
+func ByInheritsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInheritsStep(), opts...)
+	}
+}
+
+// ByInherits orders the results by inherits terms.
+func ByInherits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInheritsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -174,3 +267,17 @@ func newTenantStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
 	)
 }
+func newInheritedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, InheritedByTable, InheritedByPrimaryKey...),
+	)
+}
+func newInheritsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, InheritsTable, InheritsPrimaryKey...),
+	)
+}
@@ -85,6 +85,11 @@ func IsActive(v bool) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldIsActive, v))
 }
 
+// IsTemplate applies equality check predicate on the "is_template" field. It's identical to IsTemplateEQ.
+func IsTemplate(v bool) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldIsTemplate, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldCreatedAt, v))
@@ -350,6 +355,36 @@ func IsActiveNEQ(v bool) predicate.Role {
 	return predicate.Role(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// IsTemplateEQ applies the EQ predicate on the "is_template" field.
+func IsTemplateEQ(v bool) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldIsTemplate, v))
+}
+
+// IsTemplateNEQ applies the NEQ predicate on the "is_template" field.
+func IsTemplateNEQ(v bool) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldIsTemplate, v))
+}
+
+// DataScopeEQ applies the EQ predicate on the "data_scope" field.
+func DataScopeEQ(v DataScope) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldDataScope, v))
+}
+
+// DataScopeNEQ applies the NEQ predicate on the "data_scope" field.
+func DataScopeNEQ(v DataScope) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldDataScope, v))
+}
+
+// DataScopeIn applies the In predicate on the "data_scope" field.
+func DataScopeIn(vs ...DataScope) predicate.Role {
+	return predicate.Role(sql.FieldIn(FieldDataScope, vs...))
+}
+
+// DataScopeNotIn applies the NotIn predicate on the "data_scope" field.
+func DataScopeNotIn(vs ...DataScope) predicate.Role {
+	return predicate.Role(sql.FieldNotIn(FieldDataScope, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldCreatedAt, v))
@@ -476,6 +511,52 @@ func HasTenantWith(preds ...predicate.Tenant) predicate.Role {
 	})
 }
 
+// HasInheritedBy applies the HasEdge predicate on the "inherited_by" edge.
+func HasInheritedBy() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, InheritedByTable, InheritedByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInheritedByWith applies the HasEdge predicate on the "inherited_by" edge with a given conditions (other predicates).
+func HasInheritedByWith(preds ...predicate.Role) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := newInheritedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInherits applies the HasEdge predicate on the "inherits" edge.
+func HasInherits() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, InheritsTable, InheritsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInheritsWith applies the HasEdge predicate on the "inherits" edge with a given conditions (other predicates).
+func HasInheritsWith(preds ...predicate.Role) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := newInheritsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Role) predicate.Role {
 	return predicate.Role(sql.AndPredicates(predicates...))
@@ -92,6 +92,34 @@ func (rc *RoleCreate) SetNillableIsActive(b *bool) *RoleCreate {
 	return rc
 }
 
+// SetIsTemplate sets the "is_template" field.
+func (rc *RoleCreate) SetIsTemplate(b bool) *RoleCreate {
+	rc.mutation.SetIsTemplate(b)
+	return rc
+}
+
+// SetNillableIsTemplate sets the "is_template" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableIsTemplate(b *bool) *RoleCreate {
+	if b != nil {
+		rc.SetIsTemplate(*b)
+	}
+	return rc
+}
+
+// SetDataScope sets the "data_scope" field.
+func (rc *RoleCreate) SetDataScope(rs role.DataScope) *RoleCreate {
+	rc.mutation.SetDataScope(rs)
+	return rc
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableDataScope(rs *role.DataScope) *RoleCreate {
+	if rs != nil {
+		rc.SetDataScope(*rs)
+	}
+	return rc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rc *RoleCreate) SetCreatedAt(t time.Time) *RoleCreate {
 	rc.mutation.SetCreatedAt(t)
@@ -146,6 +174,36 @@ func (rc *RoleCreate) SetTenant(t *Tenant) *RoleCreate {
 	return rc.SetTenantID(t.ID)
 }
 
+// AddInheritedByIDs adds the "inherited_by" edge to the Role entity by IDs.
+func (rc *RoleCreate) AddInheritedByIDs(ids ...int64) *RoleCreate {
+	rc.mutation.AddInheritedByIDs(ids...)
+	return rc
+}
+
+// AddInheritedBy adds the "inherited_by" edges to the Role entity.
+func (rc *RoleCreate) AddInheritedBy(r ...*Role) *RoleCreate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddInheritedByIDs(ids...)
+}
+
+// AddInheritIDs adds the "inherits" edge to the Role entity by IDs.
+func (rc *RoleCreate) AddInheritIDs(ids ...int64) *RoleCreate {
+	rc.mutation.AddInheritIDs(ids...)
+	return rc
+}
+
+// AddInherits adds the "inherits" edges to the Role entity.
+func (rc *RoleCreate) AddInherits(r ...*Role) *RoleCreate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddInheritIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (rc *RoleCreate) Mutation() *RoleMutation {
 	return rc.mutation
@@ -189,6 +247,14 @@ func (rc *RoleCreate) defaults() {
 		v := role.DefaultIsActive
 		rc.mutation.SetIsActive(v)
 	}
+	if _, ok := rc.mutation.IsTemplate(); !ok {
+		v := role.DefaultIsTemplate
+		rc.mutation.SetIsTemplate(v)
+	}
+	if _, ok := rc.mutation.DataScope(); !ok {
+		v := role.DefaultDataScope
+		rc.mutation.SetDataScope(v)
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		v := role.DefaultCreatedAt()
 		rc.mutation.SetCreatedAt(v)
@@ -223,6 +289,17 @@ func (rc *RoleCreate) check() error {
 	if _, ok := rc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Role.is_active"`)}
 	}
+	if _, ok := rc.mutation.IsTemplate(); !ok {
+		return &ValidationError{Name: "is_template", err: errors.New(`ent: missing required field "Role.is_template"`)}
+	}
+	if _, ok := rc.mutation.DataScope(); !ok {
+		return &ValidationError{Name: "data_scope", err: errors.New(`ent: missing required field "Role.data_scope"`)}
+	}
+	if v, ok := rc.mutation.DataScope(); ok {
+		if err := role.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "Role.data_scope": %w`, err)}
+		}
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Role.created_at"`)}
 	}
@@ -282,6 +359,14 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := rc.mutation.IsTemplate(); ok {
+		_spec.SetField(role.FieldIsTemplate, field.TypeBool, value)
+		_node.IsTemplate = value
+	}
+	if value, ok := rc.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeEnum, value)
+		_node.DataScope = value
+	}
 	if value, ok := rc.mutation.CreatedAt(); ok {
 		_spec.SetField(role.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -323,6 +408,38 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_node.TenantID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.InheritedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.InheritsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -459,6 +576,30 @@ func (u *RoleUpsert) UpdateIsActive() *RoleUpsert {
 	return u
 }
 
+// SetIsTemplate sets the "is_template" field.
+func (u *RoleUpsert) SetIsTemplate(v bool) *RoleUpsert {
+	u.Set(role.FieldIsTemplate, v)
+	return u
+}
+
+// UpdateIsTemplate sets the "is_template" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateIsTemplate() *RoleUpsert {
+	u.SetExcluded(role.FieldIsTemplate)
+	return u
+}
+
+// SetDataScope sets the "data_scope" field.
+func (u *RoleUpsert) SetDataScope(v role.DataScope) *RoleUpsert {
+	u.Set(role.FieldDataScope, v)
+	return u
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateDataScope() *RoleUpsert {
+	u.SetExcluded(role.FieldDataScope)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *RoleUpsert) SetUpdatedAt(v time.Time) *RoleUpsert {
 	u.Set(role.FieldUpdatedAt, v)
@@ -620,6 +761,34 @@ func (u *RoleUpsertOne) UpdateIsActive() *RoleUpsertOne {
 	})
 }
 
+// SetIsTemplate sets the "is_template" field.
+func (u *RoleUpsertOne) SetIsTemplate(v bool) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetIsTemplate(v)
+	})
+}
+
+// UpdateIsTemplate sets the "is_template" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateIsTemplate() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateIsTemplate()
+	})
+}
+
+// SetDataScope sets the "data_scope" field.
+func (u *RoleUpsertOne) SetDataScope(v role.DataScope) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDataScope(v)
+	})
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateDataScope() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDataScope()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *RoleUpsertOne) SetUpdatedAt(v time.Time) *RoleUpsertOne {
 	return u.Update(func(s *RoleUpsert) {
@@ -949,6 +1118,34 @@ func (u *RoleUpsertBulk) UpdateIsActive() *RoleUpsertBulk {
 	})
 }
 
+// SetIsTemplate sets the "is_template" field.
+func (u *RoleUpsertBulk) SetIsTemplate(v bool) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetIsTemplate(v)
+	})
+}
+
+// UpdateIsTemplate sets the "is_template" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateIsTemplate() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateIsTemplate()
+	})
+}
+
+// SetDataScope sets the "data_scope" field.
+func (u *RoleUpsertBulk) SetDataScope(v role.DataScope) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetDataScope(v)
+	})
+}
+
+// UpdateDataScope sets the "data_scope" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateDataScope() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateDataScope()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *RoleUpsertBulk) SetUpdatedAt(v time.Time) *RoleUpsertBulk {
 	return u.Update(func(s *RoleUpsert) {
@@ -126,6 +126,34 @@ func (ru *RoleUpdate) SetNillableIsActive(b *bool) *RoleUpdate {
 	return ru
 }
 
+// SetIsTemplate sets the "is_template" field.
+func (ru *RoleUpdate) SetIsTemplate(b bool) *RoleUpdate {
+	ru.mutation.SetIsTemplate(b)
+	return ru
+}
+
+// SetNillableIsTemplate sets the "is_template" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableIsTemplate(b *bool) *RoleUpdate {
+	if b != nil {
+		ru.SetIsTemplate(*b)
+	}
+	return ru
+}
+
+// SetDataScope sets the "data_scope" field.
+func (ru *RoleUpdate) SetDataScope(rs role.DataScope) *RoleUpdate {
+	ru.mutation.SetDataScope(rs)
+	return ru
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableDataScope(rs *role.DataScope) *RoleUpdate {
+	if rs != nil {
+		ru.SetDataScope(*rs)
+	}
+	return ru
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ru *RoleUpdate) SetUpdatedAt(t time.Time) *RoleUpdate {
 	ru.mutation.SetUpdatedAt(t)
@@ -152,6 +180,36 @@ func (ru *RoleUpdate) SetTenant(t *Tenant) *RoleUpdate {
 	return ru.SetTenantID(t.ID)
 }
 
+// AddInheritedByIDs adds the "inherited_by" edge to the Role entity by IDs.
+func (ru *RoleUpdate) AddInheritedByIDs(ids ...int64) *RoleUpdate {
+	ru.mutation.AddInheritedByIDs(ids...)
+	return ru
+}
+
+// AddInheritedBy adds the "inherited_by" edges to the Role entity.
+func (ru *RoleUpdate) AddInheritedBy(r ...*Role) *RoleUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddInheritedByIDs(ids...)
+}
+
+// AddInheritIDs adds the "inherits" edge to the Role entity by IDs.
+func (ru *RoleUpdate) AddInheritIDs(ids ...int64) *RoleUpdate {
+	ru.mutation.AddInheritIDs(ids...)
+	return ru
+}
+
+// AddInherits adds the "inherits" edges to the Role entity.
+func (ru *RoleUpdate) AddInherits(r ...*Role) *RoleUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddInheritIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ru *RoleUpdate) Mutation() *RoleMutation {
 	return ru.mutation
@@ -184,6 +242,48 @@ func (ru *RoleUpdate) ClearTenant() *RoleUpdate {
 	return ru
 }
 
+// ClearInheritedBy clears all "inherited_by" edges to the Role entity.
+func (ru *RoleUpdate) ClearInheritedBy() *RoleUpdate {
+	ru.mutation.ClearInheritedBy()
+	return ru
+}
+
+// RemoveInheritedByIDs removes the "inherited_by" edge to Role entities by IDs.
+func (ru *RoleUpdate) RemoveInheritedByIDs(ids ...int64) *RoleUpdate {
+	ru.mutation.RemoveInheritedByIDs(ids...)
+	return ru
+}
+
+// RemoveInheritedBy removes "inherited_by" edges to Role entities.
+func (ru *RoleUpdate) RemoveInheritedBy(r ...*Role) *RoleUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveInheritedByIDs(ids...)
+}
+
+// ClearInherits clears all "inherits" edges to the Role entity.
+func (ru *RoleUpdate) ClearInherits() *RoleUpdate {
+	ru.mutation.ClearInherits()
+	return ru
+}
+
+// RemoveInheritIDs removes the "inherits" edge to Role entities by IDs.
+func (ru *RoleUpdate) RemoveInheritIDs(ids ...int64) *RoleUpdate {
+	ru.mutation.RemoveInheritIDs(ids...)
+	return ru
+}
+
+// RemoveInherits removes "inherits" edges to Role entities.
+func (ru *RoleUpdate) RemoveInherits(r ...*Role) *RoleUpdate {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveInheritIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RoleUpdate) Save(ctx context.Context) (int, error) {
 	ru.defaults()
@@ -232,6 +332,11 @@ func (ru *RoleUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Role.name": %w`, err)}
 		}
 	}
+	if v, ok := ru.mutation.DataScope(); ok {
+		if err := role.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "Role.data_scope": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -265,6 +370,12 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.IsActive(); ok {
 		_spec.SetField(role.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := ru.mutation.IsTemplate(); ok {
+		_spec.SetField(role.FieldIsTemplate, field.TypeBool, value)
+	}
+	if value, ok := ru.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeEnum, value)
+	}
 	if value, ok := ru.mutation.UpdatedAt(); ok {
 		_spec.SetField(role.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -342,6 +453,96 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.InheritedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedInheritedByIDs(); len(nodes) > 0 && !ru.mutation.InheritedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.InheritedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.InheritsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedInheritsIDs(); len(nodes) > 0 && !ru.mutation.InheritsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.InheritsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{role.Label}
@@ -458,6 +659,34 @@ func (ruo *RoleUpdateOne) SetNillableIsActive(b *bool) *RoleUpdateOne {
 	return ruo
 }
 
+// SetIsTemplate sets the "is_template" field.
+func (ruo *RoleUpdateOne) SetIsTemplate(b bool) *RoleUpdateOne {
+	ruo.mutation.SetIsTemplate(b)
+	return ruo
+}
+
+// SetNillableIsTemplate sets the "is_template" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableIsTemplate(b *bool) *RoleUpdateOne {
+	if b != nil {
+		ruo.SetIsTemplate(*b)
+	}
+	return ruo
+}
+
+// SetDataScope sets the "data_scope" field.
+func (ruo *RoleUpdateOne) SetDataScope(rs role.DataScope) *RoleUpdateOne {
+	ruo.mutation.SetDataScope(rs)
+	return ruo
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableDataScope(rs *role.DataScope) *RoleUpdateOne {
+	if rs != nil {
+		ruo.SetDataScope(*rs)
+	}
+	return ruo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ruo *RoleUpdateOne) SetUpdatedAt(t time.Time) *RoleUpdateOne {
 	ruo.mutation.SetUpdatedAt(t)
@@ -484,6 +713,36 @@ func (ruo *RoleUpdateOne) SetTenant(t *Tenant) *RoleUpdateOne {
 	return ruo.SetTenantID(t.ID)
 }
 
+// AddInheritedByIDs adds the "inherited_by" edge to the Role entity by IDs.
+func (ruo *RoleUpdateOne) AddInheritedByIDs(ids ...int64) *RoleUpdateOne {
+	ruo.mutation.AddInheritedByIDs(ids...)
+	return ruo
+}
+
+// AddInheritedBy adds the "inherited_by" edges to the Role entity.
+func (ruo *RoleUpdateOne) AddInheritedBy(r ...*Role) *RoleUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddInheritedByIDs(ids...)
+}
+
+// AddInheritIDs adds the "inherits" edge to the Role entity by IDs.
+func (ruo *RoleUpdateOne) AddInheritIDs(ids ...int64) *RoleUpdateOne {
+	ruo.mutation.AddInheritIDs(ids...)
+	return ruo
+}
+
+// AddInherits adds the "inherits" edges to the Role entity.
+func (ruo *RoleUpdateOne) AddInherits(r ...*Role) *RoleUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddInheritIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ruo *RoleUpdateOne) Mutation() *RoleMutation {
 	return ruo.mutation
@@ -516,6 +775,48 @@ func (ruo *RoleUpdateOne) ClearTenant() *RoleUpdateOne {
 	return ruo
 }
 
+// ClearInheritedBy clears all "inherited_by" edges to the Role entity.
+func (ruo *RoleUpdateOne) ClearInheritedBy() *RoleUpdateOne {
+	ruo.mutation.ClearInheritedBy()
+	return ruo
+}
+
+// RemoveInheritedByIDs removes the "inherited_by" edge to Role entities by IDs.
+func (ruo *RoleUpdateOne) RemoveInheritedByIDs(ids ...int64) *RoleUpdateOne {
+	ruo.mutation.RemoveInheritedByIDs(ids...)
+	return ruo
+}
+
+// RemoveInheritedBy removes "inherited_by" edges to Role entities.
+func (ruo *RoleUpdateOne) RemoveInheritedBy(r ...*Role) *RoleUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveInheritedByIDs(ids...)
+}
+
+// ClearInherits clears all "inherits" edges to the Role entity.
+func (ruo *RoleUpdateOne) ClearInherits() *RoleUpdateOne {
+	ruo.mutation.ClearInherits()
+	return ruo
+}
+
+// RemoveInheritIDs removes the "inherits" edge to Role entities by IDs.
+func (ruo *RoleUpdateOne) RemoveInheritIDs(ids ...int64) *RoleUpdateOne {
+	ruo.mutation.RemoveInheritIDs(ids...)
+	return ruo
+}
+
+// RemoveInherits removes "inherits" edges to Role entities.
+func (ruo *RoleUpdateOne) RemoveInherits(r ...*Role) *RoleUpdateOne {
+	ids := make([]int64, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveInheritIDs(ids...)
+}
+
 // Where appends a list predicates to the RoleUpdate builder.
 func (ruo *RoleUpdateOne) Where(ps ...predicate.Role) *RoleUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -577,6 +878,11 @@ func (ruo *RoleUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Role.name": %w`, err)}
 		}
 	}
+	if v, ok := ruo.mutation.DataScope(); ok {
+		if err := role.DataScopeValidator(v); err != nil {
+			return &ValidationError{Name: "data_scope", err: fmt.Errorf(`ent: validator failed for field "Role.data_scope": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -627,6 +933,12 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 	if value, ok := ruo.mutation.IsActive(); ok {
 		_spec.SetField(role.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := ruo.mutation.IsTemplate(); ok {
+		_spec.SetField(role.FieldIsTemplate, field.TypeBool, value)
+	}
+	if value, ok := ruo.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeEnum, value)
+	}
 	if value, ok := ruo.mutation.UpdatedAt(); ok {
 		_spec.SetField(role.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -704,6 +1016,96 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.InheritedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedInheritedByIDs(); len(nodes) > 0 && !ruo.mutation.InheritedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.InheritedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.InheritedByTable,
+			Columns: role.InheritedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.InheritsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedInheritsIDs(); len(nodes) > 0 && !ruo.mutation.InheritsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.InheritsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.InheritsTable,
+			Columns: role.InheritsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Role{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
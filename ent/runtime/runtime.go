@@ -5,21 +5,87 @@ package runtime
 import (
 	"time"
 
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
 	"github.com/yc-alpha/admin/ent/casbinrule"
 	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/schema"
 	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
 	"github.com/yc-alpha/admin/ent/user"
 	"github.com/yc-alpha/admin/ent/useraccount"
 	"github.com/yc-alpha/admin/ent/userdepartment"
 	"github.com/yc-alpha/admin/ent/userrole"
+	"github.com/yc-alpha/admin/ent/usertenant"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesspolicyFields := schema.AccessPolicy{}.Fields()
+	_ = accesspolicyFields
+	// accesspolicyDescName is the schema descriptor for name field.
+	accesspolicyDescName := accesspolicyFields[2].Descriptor()
+	// accesspolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesspolicy.NameValidator = func() func(string) error {
+		validators := accesspolicyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesspolicyDescSubjects is the schema descriptor for subjects field.
+	accesspolicyDescSubjects := accesspolicyFields[5].Descriptor()
+	// accesspolicy.DefaultSubjects holds the default value on creation for the subjects field.
+	accesspolicy.DefaultSubjects = accesspolicyDescSubjects.Default.([]abac.Attribute)
+	// accesspolicyDescResources is the schema descriptor for resources field.
+	accesspolicyDescResources := accesspolicyFields[6].Descriptor()
+	// accesspolicy.DefaultResources holds the default value on creation for the resources field.
+	accesspolicy.DefaultResources = accesspolicyDescResources.Default.([]abac.Attribute)
+	// accesspolicyDescActions is the schema descriptor for actions field.
+	accesspolicyDescActions := accesspolicyFields[7].Descriptor()
+	// accesspolicy.DefaultActions holds the default value on creation for the actions field.
+	accesspolicy.DefaultActions = accesspolicyDescActions.Default.([]abac.Attribute)
+	// accesspolicyDescContexts is the schema descriptor for contexts field.
+	accesspolicyDescContexts := accesspolicyFields[8].Descriptor()
+	// accesspolicy.DefaultContexts holds the default value on creation for the contexts field.
+	accesspolicy.DefaultContexts = accesspolicyDescContexts.Default.([]abac.Attribute)
+	// accesspolicyDescPriority is the schema descriptor for priority field.
+	accesspolicyDescPriority := accesspolicyFields[9].Descriptor()
+	// accesspolicy.DefaultPriority holds the default value on creation for the priority field.
+	accesspolicy.DefaultPriority = accesspolicyDescPriority.Default.(int32)
+	// accesspolicyDescIsEnabled is the schema descriptor for is_enabled field.
+	accesspolicyDescIsEnabled := accesspolicyFields[10].Descriptor()
+	// accesspolicy.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	accesspolicy.DefaultIsEnabled = accesspolicyDescIsEnabled.Default.(bool)
+	// accesspolicyDescCreatedAt is the schema descriptor for created_at field.
+	accesspolicyDescCreatedAt := accesspolicyFields[13].Descriptor()
+	// accesspolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesspolicy.DefaultCreatedAt = accesspolicyDescCreatedAt.Default.(func() time.Time)
+	// accesspolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	accesspolicyDescUpdatedAt := accesspolicyFields[14].Descriptor()
+	// accesspolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accesspolicy.DefaultUpdatedAt = accesspolicyDescUpdatedAt.Default.(func() time.Time)
+	// accesspolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accesspolicy.UpdateDefaultUpdatedAt = accesspolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accesspolicyDescID is the schema descriptor for id field.
+	accesspolicyDescID := accesspolicyFields[0].Descriptor()
+	// accesspolicy.DefaultID holds the default value on creation for the id field.
+	accesspolicy.DefaultID = accesspolicyDescID.Default.(func() int64)
 	casbinruleFields := schema.CasbinRule{}.Fields()
 	_ = casbinruleFields
 	// casbinruleDescPtype is the schema descriptor for ptype field.
@@ -62,18 +128,37 @@ func init() {
 	casbinrule.DefaultV5 = casbinruleDescV5.Default.(string)
 	// casbinrule.V5Validator is a validator for the "v5" field. It is called by the builders before save.
 	casbinrule.V5Validator = casbinruleDescV5.Validators[0].(func(string) error)
+	departmentHooks := schema.Department{}.Hooks()
+	department.Hooks[0] = departmentHooks[0]
+	department.Hooks[1] = departmentHooks[1]
 	departmentFields := schema.Department{}.Fields()
 	_ = departmentFields
+	// departmentDescCode is the schema descriptor for code field.
+	departmentDescCode := departmentFields[4].Descriptor()
+	// department.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	department.CodeValidator = departmentDescCode.Validators[0].(func(string) error)
+	// departmentDescExternalID is the schema descriptor for external_id field.
+	departmentDescExternalID := departmentFields[6].Descriptor()
+	// department.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	department.ExternalIDValidator = departmentDescExternalID.Validators[0].(func(string) error)
+	// departmentDescSortOrder is the schema descriptor for sort_order field.
+	departmentDescSortOrder := departmentFields[8].Descriptor()
+	// department.DefaultSortOrder holds the default value on creation for the sort_order field.
+	department.DefaultSortOrder = departmentDescSortOrder.Default.(int)
+	// departmentDescDeputyIds is the schema descriptor for deputy_ids field.
+	departmentDescDeputyIds := departmentFields[10].Descriptor()
+	// department.DefaultDeputyIds holds the default value on creation for the deputy_ids field.
+	department.DefaultDeputyIds = departmentDescDeputyIds.Default.([]int64)
 	// departmentDescAttributes is the schema descriptor for attributes field.
-	departmentDescAttributes := departmentFields[5].Descriptor()
+	departmentDescAttributes := departmentFields[11].Descriptor()
 	// department.DefaultAttributes holds the default value on creation for the attributes field.
 	department.DefaultAttributes = departmentDescAttributes.Default.(map[string]interface{})
 	// departmentDescCreatedAt is the schema descriptor for created_at field.
-	departmentDescCreatedAt := departmentFields[8].Descriptor()
+	departmentDescCreatedAt := departmentFields[14].Descriptor()
 	// department.DefaultCreatedAt holds the default value on creation for the created_at field.
 	department.DefaultCreatedAt = departmentDescCreatedAt.Default.(func() time.Time)
 	// departmentDescUpdatedAt is the schema descriptor for updated_at field.
-	departmentDescUpdatedAt := departmentFields[9].Descriptor()
+	departmentDescUpdatedAt := departmentFields[15].Descriptor()
 	// department.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	department.DefaultUpdatedAt = departmentDescUpdatedAt.Default.(func() time.Time)
 	// department.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -82,6 +167,86 @@ func init() {
 	departmentDescID := departmentFields[0].Descriptor()
 	// department.DefaultID holds the default value on creation for the id field.
 	department.DefaultID = departmentDescID.Default.(func() int64)
+	planFields := schema.Plan{}.Fields()
+	_ = planFields
+	// planDescCode is the schema descriptor for code field.
+	planDescCode := planFields[1].Descriptor()
+	// plan.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	plan.CodeValidator = func() func(string) error {
+		validators := planDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// planDescName is the schema descriptor for name field.
+	planDescName := planFields[2].Descriptor()
+	// plan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	plan.NameValidator = func() func(string) error {
+		validators := planDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// planDescDescription is the schema descriptor for description field.
+	planDescDescription := planFields[3].Descriptor()
+	// plan.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	plan.DescriptionValidator = planDescDescription.Validators[0].(func(string) error)
+	// planDescMaxUsers is the schema descriptor for max_users field.
+	planDescMaxUsers := planFields[4].Descriptor()
+	// plan.MaxUsersValidator is a validator for the "max_users" field. It is called by the builders before save.
+	plan.MaxUsersValidator = planDescMaxUsers.Validators[0].(func(int) error)
+	// planDescMaxDepartments is the schema descriptor for max_departments field.
+	planDescMaxDepartments := planFields[5].Descriptor()
+	// plan.MaxDepartmentsValidator is a validator for the "max_departments" field. It is called by the builders before save.
+	plan.MaxDepartmentsValidator = planDescMaxDepartments.Validators[0].(func(int) error)
+	// planDescMaxSubTenants is the schema descriptor for max_sub_tenants field.
+	planDescMaxSubTenants := planFields[6].Descriptor()
+	// plan.MaxSubTenantsValidator is a validator for the "max_sub_tenants" field. It is called by the builders before save.
+	plan.MaxSubTenantsValidator = planDescMaxSubTenants.Validators[0].(func(int) error)
+	// planDescMaxRoles is the schema descriptor for max_roles field.
+	planDescMaxRoles := planFields[7].Descriptor()
+	// plan.MaxRolesValidator is a validator for the "max_roles" field. It is called by the builders before save.
+	plan.MaxRolesValidator = planDescMaxRoles.Validators[0].(func(int) error)
+	// planDescFeatures is the schema descriptor for features field.
+	planDescFeatures := planFields[8].Descriptor()
+	// plan.DefaultFeatures holds the default value on creation for the features field.
+	plan.DefaultFeatures = planDescFeatures.Default.(map[string]bool)
+	// planDescIsDefault is the schema descriptor for is_default field.
+	planDescIsDefault := planFields[9].Descriptor()
+	// plan.DefaultIsDefault holds the default value on creation for the is_default field.
+	plan.DefaultIsDefault = planDescIsDefault.Default.(bool)
+	// planDescCreatedAt is the schema descriptor for created_at field.
+	planDescCreatedAt := planFields[10].Descriptor()
+	// plan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	plan.DefaultCreatedAt = planDescCreatedAt.Default.(func() time.Time)
+	// planDescUpdatedAt is the schema descriptor for updated_at field.
+	planDescUpdatedAt := planFields[11].Descriptor()
+	// plan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	plan.DefaultUpdatedAt = planDescUpdatedAt.Default.(func() time.Time)
+	// plan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	plan.UpdateDefaultUpdatedAt = planDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// planDescID is the schema descriptor for id field.
+	planDescID := planFields[0].Descriptor()
+	// plan.DefaultID holds the default value on creation for the id field.
+	plan.DefaultID = planDescID.Default.(func() int64)
 	roleFields := schema.Role{}.Fields()
 	_ = roleFields
 	// roleDescCode is the schema descriptor for code field.
@@ -100,12 +265,16 @@ func init() {
 	roleDescIsActive := roleFields[6].Descriptor()
 	// role.DefaultIsActive holds the default value on creation for the is_active field.
 	role.DefaultIsActive = roleDescIsActive.Default.(bool)
+	// roleDescIsTemplate is the schema descriptor for is_template field.
+	roleDescIsTemplate := roleFields[7].Descriptor()
+	// role.DefaultIsTemplate holds the default value on creation for the is_template field.
+	role.DefaultIsTemplate = roleDescIsTemplate.Default.(bool)
 	// roleDescCreatedAt is the schema descriptor for created_at field.
-	roleDescCreatedAt := roleFields[7].Descriptor()
+	roleDescCreatedAt := roleFields[9].Descriptor()
 	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
 	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
 	// roleDescUpdatedAt is the schema descriptor for updated_at field.
-	roleDescUpdatedAt := roleFields[8].Descriptor()
+	roleDescUpdatedAt := roleFields[10].Descriptor()
 	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
 	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -114,6 +283,8 @@ func init() {
 	tenant.Hooks[0] = tenantHooks[0]
 	tenant.Hooks[1] = tenantHooks[1]
 	tenant.Hooks[2] = tenantHooks[2]
+	tenantInters := schema.Tenant{}.Interceptors()
+	tenant.Interceptors[0] = tenantInters[0]
 	tenantFields := schema.Tenant{}.Fields()
 	_ = tenantFields
 	// tenantDescName is the schema descriptor for name field.
@@ -139,15 +310,15 @@ func init() {
 	// tenant.DefaultLevel holds the default value on creation for the level field.
 	tenant.DefaultLevel = tenantDescLevel.Default.(int32)
 	// tenantDescAttributes is the schema descriptor for attributes field.
-	tenantDescAttributes := tenantFields[9].Descriptor()
+	tenantDescAttributes := tenantFields[10].Descriptor()
 	// tenant.DefaultAttributes holds the default value on creation for the attributes field.
 	tenant.DefaultAttributes = tenantDescAttributes.Default.(map[string]interface{})
 	// tenantDescCreatedAt is the schema descriptor for created_at field.
-	tenantDescCreatedAt := tenantFields[12].Descriptor()
+	tenantDescCreatedAt := tenantFields[14].Descriptor()
 	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
 	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
 	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
-	tenantDescUpdatedAt := tenantFields[13].Descriptor()
+	tenantDescUpdatedAt := tenantFields[15].Descriptor()
 	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
 	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -156,6 +327,86 @@ func init() {
 	tenantDescID := tenantFields[0].Descriptor()
 	// tenant.DefaultID holds the default value on creation for the id field.
 	tenant.DefaultID = tenantDescID.Default.(func() int64)
+	tenantinvitationFields := schema.TenantInvitation{}.Fields()
+	_ = tenantinvitationFields
+	// tenantinvitationDescEmail is the schema descriptor for email field.
+	tenantinvitationDescEmail := tenantinvitationFields[2].Descriptor()
+	// tenantinvitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	tenantinvitation.EmailValidator = tenantinvitationDescEmail.Validators[0].(func(string) error)
+	// tenantinvitationDescPhone is the schema descriptor for phone field.
+	tenantinvitationDescPhone := tenantinvitationFields[3].Descriptor()
+	// tenantinvitation.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	tenantinvitation.PhoneValidator = tenantinvitationDescPhone.Validators[0].(func(string) error)
+	// tenantinvitationDescRoleIds is the schema descriptor for role_ids field.
+	tenantinvitationDescRoleIds := tenantinvitationFields[5].Descriptor()
+	// tenantinvitation.DefaultRoleIds holds the default value on creation for the role_ids field.
+	tenantinvitation.DefaultRoleIds = tenantinvitationDescRoleIds.Default.([]int64)
+	// tenantinvitationDescRoleLabels is the schema descriptor for role_labels field.
+	tenantinvitationDescRoleLabels := tenantinvitationFields[6].Descriptor()
+	// tenantinvitation.DefaultRoleLabels holds the default value on creation for the role_labels field.
+	tenantinvitation.DefaultRoleLabels = tenantinvitationDescRoleLabels.Default.([]string)
+	// tenantinvitationDescSendCount is the schema descriptor for send_count field.
+	tenantinvitationDescSendCount := tenantinvitationFields[10].Descriptor()
+	// tenantinvitation.DefaultSendCount holds the default value on creation for the send_count field.
+	tenantinvitation.DefaultSendCount = tenantinvitationDescSendCount.Default.(int)
+	// tenantinvitationDescCreatedAt is the schema descriptor for created_at field.
+	tenantinvitationDescCreatedAt := tenantinvitationFields[14].Descriptor()
+	// tenantinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantinvitation.DefaultCreatedAt = tenantinvitationDescCreatedAt.Default.(func() time.Time)
+	// tenantinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantinvitationDescUpdatedAt := tenantinvitationFields[15].Descriptor()
+	// tenantinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenantinvitation.DefaultUpdatedAt = tenantinvitationDescUpdatedAt.Default.(func() time.Time)
+	// tenantinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenantinvitation.UpdateDefaultUpdatedAt = tenantinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantinvitationDescID is the schema descriptor for id field.
+	tenantinvitationDescID := tenantinvitationFields[0].Descriptor()
+	// tenantinvitation.DefaultID holds the default value on creation for the id field.
+	tenantinvitation.DefaultID = tenantinvitationDescID.Default.(func() int64)
+	tenantsettingFields := schema.TenantSetting{}.Fields()
+	_ = tenantsettingFields
+	// tenantsettingDescKey is the schema descriptor for key field.
+	tenantsettingDescKey := tenantsettingFields[2].Descriptor()
+	// tenantsetting.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	tenantsetting.KeyValidator = tenantsettingDescKey.Validators[0].(func(string) error)
+	// tenantsettingDescCreatedAt is the schema descriptor for created_at field.
+	tenantsettingDescCreatedAt := tenantsettingFields[5].Descriptor()
+	// tenantsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantsetting.DefaultCreatedAt = tenantsettingDescCreatedAt.Default.(func() time.Time)
+	// tenantsettingDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantsettingDescUpdatedAt := tenantsettingFields[6].Descriptor()
+	// tenantsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenantsetting.DefaultUpdatedAt = tenantsettingDescUpdatedAt.Default.(func() time.Time)
+	// tenantsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenantsetting.UpdateDefaultUpdatedAt = tenantsettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantsettingDescID is the schema descriptor for id field.
+	tenantsettingDescID := tenantsettingFields[0].Descriptor()
+	// tenantsetting.DefaultID holds the default value on creation for the id field.
+	tenantsetting.DefaultID = tenantsettingDescID.Default.(func() int64)
+	tenantsettinglogFields := schema.TenantSettingLog{}.Fields()
+	_ = tenantsettinglogFields
+	// tenantsettinglogDescKey is the schema descriptor for key field.
+	tenantsettinglogDescKey := tenantsettinglogFields[2].Descriptor()
+	// tenantsettinglog.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	tenantsettinglog.KeyValidator = tenantsettinglogDescKey.Validators[0].(func(string) error)
+	// tenantsettinglogDescCreatedAt is the schema descriptor for created_at field.
+	tenantsettinglogDescCreatedAt := tenantsettinglogFields[8].Descriptor()
+	// tenantsettinglog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantsettinglog.DefaultCreatedAt = tenantsettinglogDescCreatedAt.Default.(func() time.Time)
+	// tenantsettinglogDescID is the schema descriptor for id field.
+	tenantsettinglogDescID := tenantsettinglogFields[0].Descriptor()
+	// tenantsettinglog.DefaultID holds the default value on creation for the id field.
+	tenantsettinglog.DefaultID = tenantsettinglogDescID.Default.(func() int64)
+	tenantstatuslogFields := schema.TenantStatusLog{}.Fields()
+	_ = tenantstatuslogFields
+	// tenantstatuslogDescCreatedAt is the schema descriptor for created_at field.
+	tenantstatuslogDescCreatedAt := tenantstatuslogFields[8].Descriptor()
+	// tenantstatuslog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenantstatuslog.DefaultCreatedAt = tenantstatuslogDescCreatedAt.Default.(func() time.Time)
+	// tenantstatuslogDescID is the schema descriptor for id field.
+	tenantstatuslogDescID := tenantstatuslogFields[0].Descriptor()
+	// tenantstatuslog.DefaultID holds the default value on creation for the id field.
+	tenantstatuslog.DefaultID = tenantstatuslogDescID.Default.(func() int64)
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
 	userFields := schema.User{}.Fields()
@@ -186,24 +437,28 @@ func init() {
 	userDescPhone := userFields[3].Descriptor()
 	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
 	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
+	// userDescTokenVersion is the schema descriptor for token_version field.
+	userDescTokenVersion := userFields[5].Descriptor()
+	// user.DefaultTokenVersion holds the default value on creation for the token_version field.
+	user.DefaultTokenVersion = userDescTokenVersion.Default.(int64)
 	// userDescLanguage is the schema descriptor for language field.
-	userDescLanguage := userFields[9].Descriptor()
+	userDescLanguage := userFields[10].Descriptor()
 	// user.DefaultLanguage holds the default value on creation for the language field.
 	user.DefaultLanguage = userDescLanguage.Default.(string)
 	// user.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
 	user.LanguageValidator = userDescLanguage.Validators[0].(func(string) error)
 	// userDescTimezone is the schema descriptor for timezone field.
-	userDescTimezone := userFields[10].Descriptor()
+	userDescTimezone := userFields[11].Descriptor()
 	// user.DefaultTimezone holds the default value on creation for the timezone field.
 	user.DefaultTimezone = userDescTimezone.Default.(string)
 	// user.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
 	user.TimezoneValidator = userDescTimezone.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[13].Descriptor()
+	userDescCreatedAt := userFields[14].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[14].Descriptor()
+	userDescUpdatedAt := userFields[15].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -238,8 +493,12 @@ func init() {
 	useraccount.UpdateDefaultUpdatedAt = useraccountDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userdepartmentFields := schema.UserDepartment{}.Fields()
 	_ = userdepartmentFields
+	// userdepartmentDescIsPrimary is the schema descriptor for is_primary field.
+	userdepartmentDescIsPrimary := userdepartmentFields[3].Descriptor()
+	// userdepartment.DefaultIsPrimary holds the default value on creation for the is_primary field.
+	userdepartment.DefaultIsPrimary = userdepartmentDescIsPrimary.Default.(bool)
 	// userdepartmentDescAttributes is the schema descriptor for attributes field.
-	userdepartmentDescAttributes := userdepartmentFields[3].Descriptor()
+	userdepartmentDescAttributes := userdepartmentFields[4].Descriptor()
 	// userdepartment.DefaultAttributes holds the default value on creation for the attributes field.
 	userdepartment.DefaultAttributes = userdepartmentDescAttributes.Default.(map[string]interface{})
 	userroleFields := schema.UserRole{}.Fields()
@@ -248,6 +507,12 @@ func init() {
 	userroleDescGrantedAt := userroleFields[4].Descriptor()
 	// userrole.DefaultGrantedAt holds the default value on creation for the granted_at field.
 	userrole.DefaultGrantedAt = userroleDescGrantedAt.Default.(func() time.Time)
+	usertenantFields := schema.UserTenant{}.Fields()
+	_ = usertenantFields
+	// usertenantDescCreatedAt is the schema descriptor for created_at field.
+	usertenantDescCreatedAt := usertenantFields[4].Descriptor()
+	// usertenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertenant.DefaultCreatedAt = usertenantDescCreatedAt.Default.(func() time.Time)
 }
 
 const (
@@ -0,0 +1,61 @@
+// ent/schema/access_policy.go
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/yc-alpha/admin/common/abac"
+	"github.com/yc-alpha/admin/common/snowflake"
+)
+
+// AccessPolicy ABAC策略，对应permission.v1.Policy
+type AccessPolicy struct {
+	ent.Schema
+}
+
+func (AccessPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().DefaultFunc(snowflake.GenId).Comment("Primary Key ID"),
+		field.Int64("tenant_id").Optional().Nillable().Immutable().Comment("租户ID，为空表示平台级策略，对所有租户生效"),
+		field.String("name").MaxLen(128).NotEmpty().Comment("策略名称"),
+		field.String("description").Optional().Comment("策略描述"),
+		field.Enum("effect").Values("ALLOW", "DENY").Comment("策略效果"),
+		field.JSON("subjects", []abac.Attribute{}).Default([]abac.Attribute{}).Comment("主体属性条件"),
+		field.JSON("resources", []abac.Attribute{}).Default([]abac.Attribute{}).Comment("资源属性条件"),
+		field.JSON("actions", []abac.Attribute{}).Default([]abac.Attribute{}).Comment("操作属性条件"),
+		field.JSON("contexts", []abac.Attribute{}).Default([]abac.Attribute{}).Comment("环境属性条件"),
+		field.Int32("priority").Default(0).Comment("优先级，数值越大越先匹配"),
+		field.Bool("is_enabled").Default(true).Comment("是否启用"),
+		field.Int64("created_by").Optional().Nillable().Comment("User who created this record"),
+		field.Int64("updated_by").Optional().Nillable().Comment("User who last updated this record"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Last update timestamp of this record"),
+	}
+}
+
+func (AccessPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("access_policies").Field("tenant_id").Unique().Immutable(),
+	}
+}
+
+func (AccessPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		// 求值时按租户和优先级加载启用的策略
+		index.Fields("tenant_id", "priority").Annotations(entsql.IndexWhere("is_enabled")),
+		index.Fields("name"),
+	}
+}
+
+func (AccessPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+	}
+}
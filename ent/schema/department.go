@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"entgo.io/ent"
@@ -10,6 +12,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/yc-alpha/admin/common/snowflake"
+	gen "github.com/yc-alpha/admin/ent"
+	"github.com/yc-alpha/admin/ent/hook"
 )
 
 type Department struct{ ent.Schema }
@@ -20,7 +24,13 @@ func (Department) Fields() []ent.Field {
 		field.Int64("tenant_id").Comment("Tenant ID"),
 		field.Int64("parent_id").Comment("Parent Department ID"),
 		field.String("name").Comment("Name of the department"),
+		field.String("code").MaxLen(64).Optional().Nillable().Comment("部门编码，租户内未删除的部门中唯一"),
+		field.String("description").Optional().Comment("部门描述"),
+		field.String("external_id").MaxLen(128).Optional().Nillable().Comment("外部系统（如HR系统）中的部门ID，租户内未删除的部门中唯一，用于同步"),
 		field.String("path").SchemaType(map[string]string{"postgres": "ltree"}).Comment("save ltree path"),
+		field.Int("sort_order").Default(0).Comment("同级部门中的排序，从小到大"),
+		field.Int64("head_id").Optional().Nillable().Comment("部门负责人用户ID，为空表示空缺"),
+		field.JSON("deputy_ids", []int64{}).Default([]int64{}).Comment("部门副职用户ID"),
 		field.JSON("attributes", map[string]any{}).Default(map[string]any{}),
 		field.Int64("created_by").Optional().Nillable().Comment("User who created this record"),
 		field.Int64("updated_by").Optional().Nillable().Comment("User who last updated this record"),
@@ -40,9 +50,16 @@ func (Department) Edges() []ent.Edge {
 func (Department) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id"), // 多租户条件
-		index.Fields("tenant_id", "parent_id").
-			Annotations(entsql.IndexWhere("deleted_at IS NULL")), // 软删除过滤
+		index.Fields("tenant_id", "parent_id", "sort_order").
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")), // 软删除过滤，同级部门排序
+		index.Fields("tenant_id", "parent_id", "name").Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")), // 同级部门名称唯一
+		index.Fields("tenant_id", "code").Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")), // 编码租户内唯一
+		index.Fields("tenant_id", "external_id").Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")), // 按外部ID同步
 		index.Fields("created_at"), // 创建时间排序
+		index.Fields("head_id"),    // 查询用户负责的部门
 		index.Fields("path").
 			Annotations(entsql.IndexAnnotation{
 				Types: map[string]string{
@@ -57,3 +74,45 @@ func (Department) Annotations() []schema.Annotation {
 		entsql.WithComments(true),
 	}
 }
+
+// Hooks 维护部门的ltree路径
+func (Department) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// 创建时根据上级部门计算路径：根部门（parent_id为0）的路径为自身ID，其余为上级部门路径加自身ID
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return hook.DepartmentFunc(func(ctx context.Context, m *gen.DepartmentMutation) (ent.Value, error) {
+				id, _ := m.ID()
+				tenantID, ok := m.TenantID()
+				if !ok {
+					return nil, fmt.Errorf("缺少租户ID")
+				}
+				parentID, _ := m.ParentID()
+				if parentID == 0 {
+					m.SetPath(fmt.Sprintf("%d", id))
+					return next.Mutate(ctx, m)
+				}
+				parent, err := m.Client().Department.Get(ctx, parentID)
+				if err != nil {
+					return nil, fmt.Errorf("获取上级部门失败: %w", err)
+				}
+				if parent.TenantID != tenantID || parent.DeletedAt != nil {
+					return nil, fmt.Errorf("上级部门不存在")
+				}
+				m.SetPath(fmt.Sprintf("%s.%d", parent.Path, id))
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpCreate),
+
+		// 禁止直接修改层级字段，调整上级部门需整棵子树改写路径
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return hook.DepartmentFunc(func(ctx context.Context, m *gen.DepartmentMutation) (ent.Value, error) {
+				for _, f := range []string{"tenant_id", "parent_id", "path"} {
+					if _, ok := m.Field(f); ok {
+						return nil, fmt.Errorf("禁止修改字段: %s", f)
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
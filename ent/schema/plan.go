@@ -0,0 +1,57 @@
+// ent/schema/plan.go
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/yc-alpha/admin/common/snowflake"
+)
+
+// Plan 租户套餐，限制租户的资源用量并控制功能开关
+// 各上限为空表示不限制；未分配套餐的租户不受限制，所有功能可用
+type Plan struct {
+	ent.Schema
+}
+
+func (Plan) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().DefaultFunc(snowflake.GenId).Comment("Primary Key ID"),
+		field.String("code").MaxLen(32).NotEmpty().Unique().Immutable().Comment("套餐编码"),
+		field.String("name").MaxLen(64).NotEmpty().Comment("套餐名称"),
+		field.String("description").MaxLen(255).Optional().Comment("套餐说明"),
+		field.Int("max_users").Optional().Nillable().NonNegative().Comment("成员数上限，为空不限制"),
+		field.Int("max_departments").Optional().Nillable().NonNegative().Comment("部门数上限，为空不限制"),
+		field.Int("max_sub_tenants").Optional().Nillable().NonNegative().Comment("子租户数上限，为空不限制"),
+		field.Int("max_roles").Optional().Nillable().NonNegative().Comment("租户角色数上限，为空不限制"),
+		field.JSON("features", map[string]bool{}).Default(map[string]bool{}).Comment("功能开关，未列出的功能视为关闭"),
+		field.Bool("is_default").Default(false).Comment("是否为新建租户的默认套餐"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Last update timestamp of this record"),
+	}
+}
+
+func (Plan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tenants", Tenant.Type),
+	}
+}
+
+func (Plan) Indexes() []ent.Index {
+	return []ent.Index{
+		// 最多一个默认套餐
+		index.Fields("is_default").Unique().Annotations(entsql.IndexWhere("is_default")),
+	}
+}
+
+func (Plan) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+	}
+}
@@ -25,6 +25,9 @@ func (Role) Fields() []ent.Field {
 		field.Bool("is_system").Default(false).Comment("是否系统预置角色（系统预置的不可删除/修改code）"),
 		field.String("description").Optional(),
 		field.Bool("is_active").Default(true),
+		field.Bool("is_template").Default(false).Comment("是否角色模板：只有平台级角色可以作为模板，租户角色只能继承模板"),
+		field.Enum("data_scope").Values("ALL", "TENANT_SUBTREE", "TENANT", "DEPT_SUBTREE", "SELF").Default("TENANT").
+			Comment("数据范围：ALL(全部), TENANT_SUBTREE(当前租户及下级租户), TENANT(当前租户), DEPT_SUBTREE(所在部门及下级部门), SELF(本人创建)"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -34,6 +37,9 @@ func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("user_roles", UserRole.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("tenant", Tenant.Type).Ref("roles").Unique().Field("tenant_id"),
+		// 角色继承：inherits为当前角色直接包含的角色，inherited_by为直接包含当前角色的角色
+		// 平台级角色只能继承平台级角色；租户角色可以继承本租户角色或非ALL范围的平台级角色模板
+		edge.To("inherits", Role.Type).From("inherited_by"),
 	}
 }
 
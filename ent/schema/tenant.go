@@ -14,8 +14,10 @@ import (
 
 	"github.com/yc-alpha/admin/app/admin/constant"
 	"github.com/yc-alpha/admin/common/snowflake"
+	"github.com/yc-alpha/admin/common/softdelete"
 	gen "github.com/yc-alpha/admin/ent"
 	"github.com/yc-alpha/admin/ent/hook"
+	"github.com/yc-alpha/admin/ent/intercept"
 	"github.com/yc-alpha/admin/ent/tenant"
 )
 
@@ -32,7 +34,9 @@ func (Tenant) Fields() []ent.Field {
 		field.Int32("level").Default(0).Comment("Tenant hierarchy level (0 for root tenants)"),
 		field.Enum("status").Values("ACTIVE", "DISABLED", "EXPIRED", "PENDING").Default("PENDING").Comment("Status of the tenant"),
 		field.Time("expired_at").Optional().Nillable().Comment("Expiration time of the tenant"),
+		field.Time("expiry_notified_at").Optional().Nillable().Comment("到期提醒发送时间，续期后清空"),
 		field.JSON("attributes", map[string]any{}).Default(map[string]any{}).Comment("Tenant attributes and metadata"),
+		field.Int64("plan_id").Optional().Nillable().Comment("套餐ID，为空不限制用量"),
 		field.Int64("created_by").Optional().Nillable().Comment("User who created this record"),
 		field.Int64("updated_by").Optional().Nillable().Comment("User who last updated this record"),
 		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
@@ -50,6 +54,12 @@ func (Tenant) Edges() []ent.Edge {
 		edge.To("children", Tenant.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("roles", Role.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("user_roles", UserRole.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("status_logs", TenantStatusLog.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitations", TenantInvitation.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("settings", TenantSetting.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("setting_logs", TenantSettingLog.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_policies", AccessPolicy.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("plan", Plan.Type).Ref("tenants").Unique().Field("plan_id"),
 	}
 }
 
@@ -62,6 +72,8 @@ func (Tenant) Indexes() []ent.Index {
 		// 父子租户关系查询
 		index.Fields("parent_id"),
 		index.Fields("type", "parent_id").Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		// 套餐使用情况
+		index.Fields("plan_id"),
 		// 租户层级查询
 		index.Fields("level"),
 		index.Fields("path").Annotations(entsql.IndexAnnotation{
@@ -91,6 +103,19 @@ func (Tenant) Annotations() []schema.Annotation {
 	}
 }
 
+// Interceptors 查询默认排除已软删除的租户（deleted_at不为空），包括边的加载和遍历
+// 需要访问已删除租户时使用softdelete.Skip(ctx)
+func (Tenant) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseTenant(func(ctx context.Context, q *gen.TenantQuery) error {
+			if !softdelete.Skipped(ctx) {
+				q.Where(tenant.DeletedAtIsNil())
+			}
+			return nil
+		}),
+	}
+}
+
 // Hooks 处理租户层级关系
 func (Tenant) Hooks() []ent.Hook {
 	return []ent.Hook{
@@ -192,7 +217,7 @@ func (Tenant) Hooks() []ent.Hook {
 					return nil, fmt.Errorf("系统租户(ROOT)禁止修改")
 				}
 
-				// 禁止修改层级字段
+				// 禁止修改层级字段，层级调整通过TenantService.MoveTenant/ConvertTenantType整棵子树改写
 				protected := []string{"level", "parent_id", "type", "path"}
 				for _, f := range protected {
 					if _, ok := m.Field(f); ok {
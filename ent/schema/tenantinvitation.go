@@ -0,0 +1,66 @@
+// ent/schema/tenant_invitation.go
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/yc-alpha/admin/common/snowflake"
+)
+
+// TenantInvitation 租户邀请，被邀请人凭令牌接受后加入租户
+// 邀请过期不改状态，以expires_at判断
+type TenantInvitation struct {
+	ent.Schema
+}
+
+func (TenantInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().DefaultFunc(snowflake.GenId).Comment("Primary Key ID"),
+		field.Int64("tenant_id").Immutable().Comment("租户ID"),
+		field.String("email").Optional().Nillable().Immutable().Match(EmailRegex).Comment("被邀请人邮箱"),
+		field.String("phone").Optional().Nillable().Immutable().Match(PhoneRegex).Comment("被邀请人手机号"),
+		field.Int64("department_id").Optional().Nillable().Immutable().Comment("加入的部门ID"),
+		field.JSON("role_ids", []int64{}).Default([]int64{}).Immutable().Comment("初始角色ID"),
+		field.JSON("role_labels", []string{}).Default([]string{}).Immutable().Comment("成员身份标签"),
+		field.Enum("status").Values("PENDING", "ACCEPTED", "REVOKED").Default("PENDING").Comment("邀请状态"),
+		field.String("nonce").Sensitive().Comment("令牌版本，重新发送时更换使旧令牌失效"),
+		field.Time("expires_at").Comment("过期时间"),
+		field.Int("send_count").Default(0).Comment("发送次数"),
+		field.Int64("invited_by").Optional().Nillable().Immutable().Comment("邀请人ID"),
+		field.Int64("accepted_by").Optional().Nillable().Comment("接受邀请的用户ID"),
+		field.Time("accepted_at").Optional().Nillable().Comment("接受时间"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Last update timestamp of this record"),
+	}
+}
+
+func (TenantInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("invitations").Field("tenant_id").Unique().Required().Immutable(),
+	}
+}
+
+func (TenantInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+		// 同一租户对同一联系方式只保留一个待接受的邀请
+		index.Fields("tenant_id", "email").Unique().Annotations(entsql.IndexWhere("status = 'PENDING' AND email IS NOT NULL")),
+		index.Fields("tenant_id", "phone").Unique().Annotations(entsql.IndexWhere("status = 'PENDING' AND phone IS NOT NULL")),
+	}
+}
+
+func (TenantInvitation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		entsql.Checks(map[string]string{
+			"tenant_invitations_contact_check": "(email IS NOT NULL) OR (phone IS NOT NULL)",
+		}),
+	}
+}
@@ -0,0 +1,51 @@
+// ent/schema/tenant_setting.go
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/yc-alpha/admin/common/snowflake"
+)
+
+// TenantSetting 租户设置，只保存租户自身覆盖的值，未设置的项沿租户路径继承上级租户或使用默认值
+type TenantSetting struct {
+	ent.Schema
+}
+
+func (TenantSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().DefaultFunc(snowflake.GenId).Comment("Primary Key ID"),
+		field.Int64("tenant_id").Immutable().Comment("租户ID"),
+		field.String("key").Immutable().MaxLen(64).Comment("设置项"),
+		field.JSON("value", json.RawMessage{}).Comment("设置值，JSON编码"),
+		field.Int64("updated_by").Optional().Nillable().Comment("最后修改人ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Last update timestamp of this record"),
+	}
+}
+
+func (TenantSetting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("settings").Field("tenant_id").Unique().Required().Immutable(),
+	}
+}
+
+func (TenantSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "key").Unique(),
+	}
+}
+
+func (TenantSetting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+	}
+}
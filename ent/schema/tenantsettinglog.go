@@ -0,0 +1,54 @@
+// ent/schema/tenant_setting_log.go
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/yc-alpha/admin/common/snowflake"
+)
+
+// TenantSettingLog 租户设置变更记录
+type TenantSettingLog struct {
+	ent.Schema
+}
+
+func (TenantSettingLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().DefaultFunc(snowflake.GenId).Comment("Primary Key ID"),
+		field.Int64("tenant_id").Immutable().Comment("租户ID"),
+		field.String("key").Immutable().MaxLen(64).Comment("设置项"),
+		field.Enum("action").Values("SET", "RESET").Immutable().Comment("SET修改，RESET恢复继承"),
+		field.JSON("old_value", json.RawMessage{}).Optional().Immutable().Comment("变更前租户自身的值，为空表示变更前为继承值"),
+		field.JSON("new_value", json.RawMessage{}).Optional().Immutable().Comment("变更后的值，RESET时为空"),
+		field.String("reason").Optional().Immutable().Comment("变更原因"),
+		field.Int64("operator_id").Optional().Nillable().Immutable().Comment("操作人ID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
+	}
+}
+
+func (TenantSettingLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("setting_logs").Field("tenant_id").Unique().Required().Immutable(),
+	}
+}
+
+func (TenantSettingLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("tenant_id", "key"),
+	}
+}
+
+func (TenantSettingLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+	}
+}
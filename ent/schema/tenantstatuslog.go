@@ -0,0 +1,52 @@
+// ent/schema/tenant_status_log.go
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/yc-alpha/admin/common/snowflake"
+)
+
+// TenantStatusLog 租户状态变更审计记录
+type TenantStatusLog struct {
+	ent.Schema
+}
+
+func (TenantStatusLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable().DefaultFunc(snowflake.GenId).Comment("Primary Key ID"),
+		field.Int64("tenant_id").Immutable().Comment("租户ID"),
+		field.Enum("action").Values("ACTIVATE", "DISABLE", "RENEW", "EXPIRE").Immutable().Comment("状态变更操作"),
+		field.String("from_status").Immutable().Comment("变更前状态"),
+		field.String("to_status").Immutable().Comment("变更后状态"),
+		field.Time("expired_at").Optional().Nillable().Immutable().Comment("变更后的到期时间"),
+		field.String("reason").Optional().Immutable().Comment("变更原因"),
+		field.Int64("operator_id").Optional().Nillable().Immutable().Comment("操作人ID，为空表示系统自动变更"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation timestamp of this record"),
+	}
+}
+
+func (TenantStatusLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("status_logs").Field("tenant_id").Unique().Required().Immutable(),
+	}
+}
+
+func (TenantStatusLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+	}
+}
+
+func (TenantStatusLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+	}
+}
@@ -49,6 +49,7 @@ func (User) Fields() []ent.Field {
 		field.String("email").Optional().Nillable().Match(EmailRegex).Unique().Comment("Email address of the user"),
 		field.String("phone").Optional().Nillable().Match(PhoneRegex).Unique().Comment("Phone number of the user"),
 		field.String("password").Optional().Nillable().Sensitive().Comment("Password of the user"),
+		field.Int64("token_version").Default(0).Comment("Token version of the user, bumped on logout and password change to revoke issued tokens"),
 		field.Enum("status").Values("ACTIVE", "DISABLED", "PENDING").Default("PENDING").Comment("Status of the user"),
 		field.String("full_name").Optional().Nillable().Comment("Full name of the user"),
 		field.Enum("gender").Values("MALE", "FEMALE", "UNKNOWN").Default("UNKNOWN").Comment("User gender"),
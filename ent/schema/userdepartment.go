@@ -16,6 +16,7 @@ func (UserDepartment) Fields() []ent.Field {
 		field.Int64("user_id").Comment("SysUser ID"),
 		field.Int64("dept_id").Comment("Department ID"),
 		field.Int64("tenant_id").Comment("Tenant ID"),
+		field.Bool("is_primary").Default(false).Comment("是否为主部门，用户在每个租户下只有一个主部门"),
 		field.JSON("attributes", map[string]any{}).Default(map[string]any{}),
 	}
 }
@@ -33,6 +34,8 @@ func (UserDepartment) Indexes() []ent.Index {
 		index.Fields("dept_id"),
 		index.Fields("tenant_id"),
 		index.Fields("user_id"),
+		index.Fields("user_id", "tenant_id").Unique().
+			Annotations(entsql.IndexWhere("is_primary")), // 每个租户下只有一个主部门
 	}
 }
 
@@ -24,6 +24,8 @@ func (UserRole) Fields() []ent.Field {
 		field.Int64("tenant_id").Optional().Nillable().Comment("租户ID"),
 		field.Time("granted_at").Default(time.Now).Immutable(),
 		field.Time("expires_at").Optional().Nillable().Comment("过期时间,NULL表示永久有效"),
+		field.Int64("granted_by").Optional().Nillable().Comment("授予人ID"),
+		field.String("reason").Optional().Comment("授予原因，临时提权时必填"),
 	}
 }
 
@@ -42,6 +44,8 @@ func (UserRole) Indexes() []ent.Index {
 		index.Fields("user_id"),
 		index.Fields("role_id"),
 		index.Fields("tenant_id"),
+		// 过期清理
+		index.Fields("expires_at").Annotations(entsql.IndexWhere("expires_at IS NOT NULL")),
 	}
 }
 
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -15,7 +17,9 @@ func (UserTenant) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("user_id").Comment("SysUser ID"),
 		field.Int64("tenant_id").Comment("Tenant ID"),
-		field.JSON("role_labels", []string{}).Optional(),
+		field.JSON("role_labels", []string{}).Optional().Comment("成员在租户内的身份标签，仅用于展示和筛选，权限以角色授予为准"),
+		field.Int64("created_by").Optional().Nillable().Comment("User who added this member"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Time when the user joined the tenant"),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/tenant"
 )
 
@@ -35,8 +36,12 @@ type Tenant struct {
 	Status tenant.Status `json:"status,omitempty"`
 	// Expiration time of the tenant
 	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// 到期提醒发送时间，续期后清空
+	ExpiryNotifiedAt *time.Time `json:"expiry_notified_at,omitempty"`
 	// Tenant attributes and metadata
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	// 套餐ID，为空不限制用量
+	PlanID *int64 `json:"plan_id,omitempty"`
 	// User who created this record
 	CreatedBy *int64 `json:"created_by,omitempty"`
 	// User who last updated this record
@@ -67,9 +72,21 @@ type TenantEdges struct {
 	Roles []*Role `json:"roles,omitempty"`
 	// UserRoles holds the value of the user_roles edge.
 	UserRoles []*UserRole `json:"user_roles,omitempty"`
+	// StatusLogs holds the value of the status_logs edge.
+	StatusLogs []*TenantStatusLog `json:"status_logs,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*TenantInvitation `json:"invitations,omitempty"`
+	// Settings holds the value of the settings edge.
+	Settings []*TenantSetting `json:"settings,omitempty"`
+	// SettingLogs holds the value of the setting_logs edge.
+	SettingLogs []*TenantSettingLog `json:"setting_logs,omitempty"`
+	// AccessPolicies holds the value of the access_policies edge.
+	AccessPolicies []*AccessPolicy `json:"access_policies,omitempty"`
+	// Plan holds the value of the plan edge.
+	Plan *Plan `json:"plan,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [12]bool
 }
 
 // UserTenantsOrErr returns the UserTenants value or an error if the edge
@@ -128,6 +145,62 @@ func (e TenantEdges) UserRolesOrErr() ([]*UserRole, error) {
 	return nil, &NotLoadedError{edge: "user_roles"}
 }
 
+// StatusLogsOrErr returns the StatusLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) StatusLogsOrErr() ([]*TenantStatusLog, error) {
+	if e.loadedTypes[6] {
+		return e.StatusLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "status_logs"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) InvitationsOrErr() ([]*TenantInvitation, error) {
+	if e.loadedTypes[7] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// SettingsOrErr returns the Settings value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) SettingsOrErr() ([]*TenantSetting, error) {
+	if e.loadedTypes[8] {
+		return e.Settings, nil
+	}
+	return nil, &NotLoadedError{edge: "settings"}
+}
+
+// SettingLogsOrErr returns the SettingLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) SettingLogsOrErr() ([]*TenantSettingLog, error) {
+	if e.loadedTypes[9] {
+		return e.SettingLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "setting_logs"}
+}
+
+// AccessPoliciesOrErr returns the AccessPolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) AccessPoliciesOrErr() ([]*AccessPolicy, error) {
+	if e.loadedTypes[10] {
+		return e.AccessPolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "access_policies"}
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -135,11 +208,11 @@ func (*Tenant) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case tenant.FieldAttributes:
 			values[i] = new([]byte)
-		case tenant.FieldID, tenant.FieldOwnerID, tenant.FieldParentID, tenant.FieldLevel, tenant.FieldCreatedBy, tenant.FieldUpdatedBy:
+		case tenant.FieldID, tenant.FieldOwnerID, tenant.FieldParentID, tenant.FieldLevel, tenant.FieldPlanID, tenant.FieldCreatedBy, tenant.FieldUpdatedBy:
 			values[i] = new(sql.NullInt64)
 		case tenant.FieldName, tenant.FieldType, tenant.FieldPath, tenant.FieldStatus:
 			values[i] = new(sql.NullString)
-		case tenant.FieldExpiredAt, tenant.FieldCreatedAt, tenant.FieldUpdatedAt, tenant.FieldDeletedAt:
+		case tenant.FieldExpiredAt, tenant.FieldExpiryNotifiedAt, tenant.FieldCreatedAt, tenant.FieldUpdatedAt, tenant.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -213,6 +286,13 @@ func (t *Tenant) assignValues(columns []string, values []any) error {
 				t.ExpiredAt = new(time.Time)
 				*t.ExpiredAt = value.Time
 			}
+		case tenant.FieldExpiryNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_notified_at", values[i])
+			} else if value.Valid {
+				t.ExpiryNotifiedAt = new(time.Time)
+				*t.ExpiryNotifiedAt = value.Time
+			}
 		case tenant.FieldAttributes:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field attributes", values[i])
@@ -221,6 +301,13 @@ func (t *Tenant) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field attributes: %w", err)
 				}
 			}
+		case tenant.FieldPlanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				t.PlanID = new(int64)
+				*t.PlanID = value.Int64
+			}
 		case tenant.FieldCreatedBy:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -297,6 +384,36 @@ func (t *Tenant) QueryUserRoles() *UserRoleQuery {
 	return NewTenantClient(t.config).QueryUserRoles(t)
 }
 
+// QueryStatusLogs queries the "status_logs" edge of the Tenant entity.
+func (t *Tenant) QueryStatusLogs() *TenantStatusLogQuery {
+	return NewTenantClient(t.config).QueryStatusLogs(t)
+}
+
+// QueryInvitations queries the "invitations" edge of the Tenant entity.
+func (t *Tenant) QueryInvitations() *TenantInvitationQuery {
+	return NewTenantClient(t.config).QueryInvitations(t)
+}
+
+// QuerySettings queries the "settings" edge of the Tenant entity.
+func (t *Tenant) QuerySettings() *TenantSettingQuery {
+	return NewTenantClient(t.config).QuerySettings(t)
+}
+
+// QuerySettingLogs queries the "setting_logs" edge of the Tenant entity.
+func (t *Tenant) QuerySettingLogs() *TenantSettingLogQuery {
+	return NewTenantClient(t.config).QuerySettingLogs(t)
+}
+
+// QueryAccessPolicies queries the "access_policies" edge of the Tenant entity.
+func (t *Tenant) QueryAccessPolicies() *AccessPolicyQuery {
+	return NewTenantClient(t.config).QueryAccessPolicies(t)
+}
+
+// QueryPlan queries the "plan" edge of the Tenant entity.
+func (t *Tenant) QueryPlan() *PlanQuery {
+	return NewTenantClient(t.config).QueryPlan(t)
+}
+
 // Update returns a builder for updating this Tenant.
 // Note that you need to call Tenant.Unwrap() before calling this method if this Tenant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -350,9 +467,19 @@ func (t *Tenant) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := t.ExpiryNotifiedAt; v != nil {
+		builder.WriteString("expiry_notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("attributes=")
 	builder.WriteString(fmt.Sprintf("%v", t.Attributes))
 	builder.WriteString(", ")
+	if v := t.PlanID; v != nil {
+		builder.WriteString("plan_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := t.CreatedBy; v != nil {
 		builder.WriteString("created_by=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -32,8 +32,12 @@ const (
 	FieldStatus = "status"
 	// FieldExpiredAt holds the string denoting the expired_at field in the database.
 	FieldExpiredAt = "expired_at"
+	// FieldExpiryNotifiedAt holds the string denoting the expiry_notified_at field in the database.
+	FieldExpiryNotifiedAt = "expiry_notified_at"
 	// FieldAttributes holds the string denoting the attributes field in the database.
 	FieldAttributes = "attributes"
+	// FieldPlanID holds the string denoting the plan_id field in the database.
+	FieldPlanID = "plan_id"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
@@ -56,6 +60,18 @@ const (
 	EdgeRoles = "roles"
 	// EdgeUserRoles holds the string denoting the user_roles edge name in mutations.
 	EdgeUserRoles = "user_roles"
+	// EdgeStatusLogs holds the string denoting the status_logs edge name in mutations.
+	EdgeStatusLogs = "status_logs"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeSettings holds the string denoting the settings edge name in mutations.
+	EdgeSettings = "settings"
+	// EdgeSettingLogs holds the string denoting the setting_logs edge name in mutations.
+	EdgeSettingLogs = "setting_logs"
+	// EdgeAccessPolicies holds the string denoting the access_policies edge name in mutations.
+	EdgeAccessPolicies = "access_policies"
+	// EdgePlan holds the string denoting the plan edge name in mutations.
+	EdgePlan = "plan"
 	// Table holds the table name of the tenant in the database.
 	Table = "tenants"
 	// UserTenantsTable is the table that holds the user_tenants relation/edge.
@@ -94,6 +110,48 @@ const (
 	UserRolesInverseTable = "user_roles"
 	// UserRolesColumn is the table column denoting the user_roles relation/edge.
 	UserRolesColumn = "tenant_id"
+	// StatusLogsTable is the table that holds the status_logs relation/edge.
+	StatusLogsTable = "tenant_status_logs"
+	// StatusLogsInverseTable is the table name for the TenantStatusLog entity.
+	// It exists in this package in order to avoid circular dependency with the "tenantstatuslog" package.
+	StatusLogsInverseTable = "tenant_status_logs"
+	// StatusLogsColumn is the table column denoting the status_logs relation/edge.
+	StatusLogsColumn = "tenant_id"
+	// InvitationsTable is the table that holds the invitations relation/edge.
+	InvitationsTable = "tenant_invitations"
+	// InvitationsInverseTable is the table name for the TenantInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "tenantinvitation" package.
+	InvitationsInverseTable = "tenant_invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "tenant_id"
+	// SettingsTable is the table that holds the settings relation/edge.
+	SettingsTable = "tenant_settings"
+	// SettingsInverseTable is the table name for the TenantSetting entity.
+	// It exists in this package in order to avoid circular dependency with the "tenantsetting" package.
+	SettingsInverseTable = "tenant_settings"
+	// SettingsColumn is the table column denoting the settings relation/edge.
+	SettingsColumn = "tenant_id"
+	// SettingLogsTable is the table that holds the setting_logs relation/edge.
+	SettingLogsTable = "tenant_setting_logs"
+	// SettingLogsInverseTable is the table name for the TenantSettingLog entity.
+	// It exists in this package in order to avoid circular dependency with the "tenantsettinglog" package.
+	SettingLogsInverseTable = "tenant_setting_logs"
+	// SettingLogsColumn is the table column denoting the setting_logs relation/edge.
+	SettingLogsColumn = "tenant_id"
+	// AccessPoliciesTable is the table that holds the access_policies relation/edge.
+	AccessPoliciesTable = "access_policies"
+	// AccessPoliciesInverseTable is the table name for the AccessPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "accesspolicy" package.
+	AccessPoliciesInverseTable = "access_policies"
+	// AccessPoliciesColumn is the table column denoting the access_policies relation/edge.
+	AccessPoliciesColumn = "tenant_id"
+	// PlanTable is the table that holds the plan relation/edge.
+	PlanTable = "tenants"
+	// PlanInverseTable is the table name for the Plan entity.
+	// It exists in this package in order to avoid circular dependency with the "plan" package.
+	PlanInverseTable = "plans"
+	// PlanColumn is the table column denoting the plan relation/edge.
+	PlanColumn = "plan_id"
 )
 
 // Columns holds all SQL columns for tenant fields.
@@ -107,7 +165,9 @@ var Columns = []string{
 	FieldLevel,
 	FieldStatus,
 	FieldExpiredAt,
+	FieldExpiryNotifiedAt,
 	FieldAttributes,
+	FieldPlanID,
 	FieldCreatedBy,
 	FieldUpdatedBy,
 	FieldCreatedAt,
@@ -131,7 +191,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/yc-alpha/admin/ent/runtime"
 var (
-	Hooks [3]ent.Hook
+	Hooks        [3]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultLevel holds the default value on creation for the "level" field.
@@ -252,6 +313,16 @@ func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
 }
 
+// ByExpiryNotifiedAt orders the results by the expiry_notified_at field.
+func ByExpiryNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryNotifiedAt, opts...).ToFunc()
+}
+
+// ByPlanID orders the results by the plan_id field.
+func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -353,6 +424,83 @@ func ByUserRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByStatusLogsCount orders the results by status_logs count.
+func ByStatusLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusLogsStep(), opts...)
+	}
+}
+
+// ByStatusLogs orders the results by status_logs terms.
+func ByStatusLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitationsCount orders the results by invitations count.
+func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitationsStep(), opts...)
+	}
+}
+
+// ByInvitations orders the results by invitations terms.
+func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySettingsCount orders the results by settings count.
+func BySettingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSettingsStep(), opts...)
+	}
+}
+
+// BySettings orders the results by settings terms.
+func BySettings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySettingLogsCount orders the results by setting_logs count.
+func BySettingLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSettingLogsStep(), opts...)
+	}
+}
+
+// BySettingLogs orders the results by setting_logs terms.
+func BySettingLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettingLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessPoliciesCount orders the results by access_policies count.
+func ByAccessPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessPoliciesStep(), opts...)
+	}
+}
+
+// ByAccessPolicies orders the results by access_policies terms.
+func ByAccessPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPlanField orders the results by plan field.
+func ByPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserTenantsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -395,3 +543,45 @@ func newUserRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UserRolesTable, UserRolesColumn),
 	)
 }
+func newStatusLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusLogsTable, StatusLogsColumn),
+	)
+}
+func newInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+	)
+}
+func newSettingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SettingsTable, SettingsColumn),
+	)
+}
+func newSettingLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettingLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SettingLogsTable, SettingLogsColumn),
+	)
+}
+func newAccessPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessPoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessPoliciesTable, AccessPoliciesColumn),
+	)
+}
+func newPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+	)
+}
@@ -85,6 +85,16 @@ func ExpiredAt(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldExpiredAt, v))
 }
 
+// ExpiryNotifiedAt applies equality check predicate on the "expiry_notified_at" field. It's identical to ExpiryNotifiedAtEQ.
+func ExpiryNotifiedAt(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldExpiryNotifiedAt, v))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPlanID, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v int64) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedBy, v))
@@ -450,6 +460,86 @@ func ExpiredAtNotNil() predicate.Tenant {
 	return predicate.Tenant(sql.FieldNotNull(FieldExpiredAt))
 }
 
+// ExpiryNotifiedAtEQ applies the EQ predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtEQ(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtNEQ applies the NEQ predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtNEQ(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtIn applies the In predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtIn(vs ...time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldExpiryNotifiedAt, vs...))
+}
+
+// ExpiryNotifiedAtNotIn applies the NotIn predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtNotIn(vs ...time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldExpiryNotifiedAt, vs...))
+}
+
+// ExpiryNotifiedAtGT applies the GT predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtGT(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtGTE applies the GTE predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtGTE(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtLT applies the LT predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtLT(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtLTE applies the LTE predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtLTE(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtIsNil applies the IsNil predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldExpiryNotifiedAt))
+}
+
+// ExpiryNotifiedAtNotNil applies the NotNil predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldExpiryNotifiedAt))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDIsNil applies the IsNil predicate on the "plan_id" field.
+func PlanIDIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldPlanID))
+}
+
+// PlanIDNotNil applies the NotNil predicate on the "plan_id" field.
+func PlanIDNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldPlanID))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v int64) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedBy, v))
@@ -818,6 +908,144 @@ func HasUserRolesWith(preds ...predicate.UserRole) predicate.Tenant {
 	})
 }
 
+// HasStatusLogs applies the HasEdge predicate on the "status_logs" edge.
+func HasStatusLogs() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusLogsTable, StatusLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusLogsWith applies the HasEdge predicate on the "status_logs" edge with a given conditions (other predicates).
+func HasStatusLogsWith(preds ...predicate.TenantStatusLog) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newStatusLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.TenantInvitation) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSettings applies the HasEdge predicate on the "settings" edge.
+func HasSettings() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SettingsTable, SettingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettingsWith applies the HasEdge predicate on the "settings" edge with a given conditions (other predicates).
+func HasSettingsWith(preds ...predicate.TenantSetting) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newSettingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSettingLogs applies the HasEdge predicate on the "setting_logs" edge.
+func HasSettingLogs() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SettingLogsTable, SettingLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettingLogsWith applies the HasEdge predicate on the "setting_logs" edge with a given conditions (other predicates).
+func HasSettingLogsWith(preds ...predicate.TenantSettingLog) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newSettingLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessPolicies applies the HasEdge predicate on the "access_policies" edge.
+func HasAccessPolicies() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessPoliciesTable, AccessPoliciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessPoliciesWith applies the HasEdge predicate on the "access_policies" edge with a given conditions (other predicates).
+func HasAccessPoliciesWith(preds ...predicate.AccessPolicy) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newAccessPoliciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlan applies the HasEdge predicate on the "plan" edge.
+func HasPlan() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlanWith applies the HasEdge predicate on the "plan" edge with a given conditions (other predicates).
+func HasPlanWith(preds ...predicate.Plan) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tenant) predicate.Tenant {
 	return predicate.Tenant(sql.AndPredicates(predicates...))
@@ -11,9 +11,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
 	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
 	"github.com/yc-alpha/admin/ent/userrole"
 	"github.com/yc-alpha/admin/ent/usertenant"
 )
@@ -122,12 +128,40 @@ func (tc *TenantCreate) SetNillableExpiredAt(t *time.Time) *TenantCreate {
 	return tc
 }
 
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (tc *TenantCreate) SetExpiryNotifiedAt(t time.Time) *TenantCreate {
+	tc.mutation.SetExpiryNotifiedAt(t)
+	return tc
+}
+
+// SetNillableExpiryNotifiedAt sets the "expiry_notified_at" field if the given value is not nil.
+func (tc *TenantCreate) SetNillableExpiryNotifiedAt(t *time.Time) *TenantCreate {
+	if t != nil {
+		tc.SetExpiryNotifiedAt(*t)
+	}
+	return tc
+}
+
 // SetAttributes sets the "attributes" field.
 func (tc *TenantCreate) SetAttributes(m map[string]interface{}) *TenantCreate {
 	tc.mutation.SetAttributes(m)
 	return tc
 }
 
+// SetPlanID sets the "plan_id" field.
+func (tc *TenantCreate) SetPlanID(i int64) *TenantCreate {
+	tc.mutation.SetPlanID(i)
+	return tc
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (tc *TenantCreate) SetNillablePlanID(i *int64) *TenantCreate {
+	if i != nil {
+		tc.SetPlanID(*i)
+	}
+	return tc
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (tc *TenantCreate) SetCreatedBy(i int64) *TenantCreate {
 	tc.mutation.SetCreatedBy(i)
@@ -292,6 +326,86 @@ func (tc *TenantCreate) AddUserRoles(u ...*UserRole) *TenantCreate {
 	return tc.AddUserRoleIDs(ids...)
 }
 
+// AddStatusLogIDs adds the "status_logs" edge to the TenantStatusLog entity by IDs.
+func (tc *TenantCreate) AddStatusLogIDs(ids ...int64) *TenantCreate {
+	tc.mutation.AddStatusLogIDs(ids...)
+	return tc
+}
+
+// AddStatusLogs adds the "status_logs" edges to the TenantStatusLog entity.
+func (tc *TenantCreate) AddStatusLogs(t ...*TenantStatusLog) *TenantCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddStatusLogIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the TenantInvitation entity by IDs.
+func (tc *TenantCreate) AddInvitationIDs(ids ...int64) *TenantCreate {
+	tc.mutation.AddInvitationIDs(ids...)
+	return tc
+}
+
+// AddInvitations adds the "invitations" edges to the TenantInvitation entity.
+func (tc *TenantCreate) AddInvitations(t ...*TenantInvitation) *TenantCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddInvitationIDs(ids...)
+}
+
+// AddSettingIDs adds the "settings" edge to the TenantSetting entity by IDs.
+func (tc *TenantCreate) AddSettingIDs(ids ...int64) *TenantCreate {
+	tc.mutation.AddSettingIDs(ids...)
+	return tc
+}
+
+// AddSettings adds the "settings" edges to the TenantSetting entity.
+func (tc *TenantCreate) AddSettings(t ...*TenantSetting) *TenantCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddSettingIDs(ids...)
+}
+
+// AddSettingLogIDs adds the "setting_logs" edge to the TenantSettingLog entity by IDs.
+func (tc *TenantCreate) AddSettingLogIDs(ids ...int64) *TenantCreate {
+	tc.mutation.AddSettingLogIDs(ids...)
+	return tc
+}
+
+// AddSettingLogs adds the "setting_logs" edges to the TenantSettingLog entity.
+func (tc *TenantCreate) AddSettingLogs(t ...*TenantSettingLog) *TenantCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddSettingLogIDs(ids...)
+}
+
+// AddAccessPolicyIDs adds the "access_policies" edge to the AccessPolicy entity by IDs.
+func (tc *TenantCreate) AddAccessPolicyIDs(ids ...int64) *TenantCreate {
+	tc.mutation.AddAccessPolicyIDs(ids...)
+	return tc
+}
+
+// AddAccessPolicies adds the "access_policies" edges to the AccessPolicy entity.
+func (tc *TenantCreate) AddAccessPolicies(a ...*AccessPolicy) *TenantCreate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tc.AddAccessPolicyIDs(ids...)
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (tc *TenantCreate) SetPlan(p *Plan) *TenantCreate {
+	return tc.SetPlanID(p.ID)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (tc *TenantCreate) Mutation() *TenantMutation {
 	return tc.mutation
@@ -471,6 +585,10 @@ func (tc *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldExpiredAt, field.TypeTime, value)
 		_node.ExpiredAt = &value
 	}
+	if value, ok := tc.mutation.ExpiryNotifiedAt(); ok {
+		_spec.SetField(tenant.FieldExpiryNotifiedAt, field.TypeTime, value)
+		_node.ExpiryNotifiedAt = &value
+	}
 	if value, ok := tc.mutation.Attributes(); ok {
 		_spec.SetField(tenant.FieldAttributes, field.TypeJSON, value)
 		_node.Attributes = value
@@ -592,6 +710,103 @@ func (tc *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.StatusLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SettingLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AccessPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -770,6 +985,24 @@ func (u *TenantUpsert) ClearExpiredAt() *TenantUpsert {
 	return u
 }
 
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (u *TenantUpsert) SetExpiryNotifiedAt(v time.Time) *TenantUpsert {
+	u.Set(tenant.FieldExpiryNotifiedAt, v)
+	return u
+}
+
+// UpdateExpiryNotifiedAt sets the "expiry_notified_at" field to the value that was provided on create.
+func (u *TenantUpsert) UpdateExpiryNotifiedAt() *TenantUpsert {
+	u.SetExcluded(tenant.FieldExpiryNotifiedAt)
+	return u
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (u *TenantUpsert) ClearExpiryNotifiedAt() *TenantUpsert {
+	u.SetNull(tenant.FieldExpiryNotifiedAt)
+	return u
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *TenantUpsert) SetAttributes(v map[string]interface{}) *TenantUpsert {
 	u.Set(tenant.FieldAttributes, v)
@@ -782,6 +1015,24 @@ func (u *TenantUpsert) UpdateAttributes() *TenantUpsert {
 	return u
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *TenantUpsert) SetPlanID(v int64) *TenantUpsert {
+	u.Set(tenant.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *TenantUpsert) UpdatePlanID() *TenantUpsert {
+	u.SetExcluded(tenant.FieldPlanID)
+	return u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *TenantUpsert) ClearPlanID() *TenantUpsert {
+	u.SetNull(tenant.FieldPlanID)
+	return u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (u *TenantUpsert) SetCreatedBy(v int64) *TenantUpsert {
 	u.Set(tenant.FieldCreatedBy, v)
@@ -1058,6 +1309,27 @@ func (u *TenantUpsertOne) ClearExpiredAt() *TenantUpsertOne {
 	})
 }
 
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (u *TenantUpsertOne) SetExpiryNotifiedAt(v time.Time) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetExpiryNotifiedAt(v)
+	})
+}
+
+// UpdateExpiryNotifiedAt sets the "expiry_notified_at" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdateExpiryNotifiedAt() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateExpiryNotifiedAt()
+	})
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (u *TenantUpsertOne) ClearExpiryNotifiedAt() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearExpiryNotifiedAt()
+	})
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *TenantUpsertOne) SetAttributes(v map[string]interface{}) *TenantUpsertOne {
 	return u.Update(func(s *TenantUpsert) {
@@ -1072,6 +1344,27 @@ func (u *TenantUpsertOne) UpdateAttributes() *TenantUpsertOne {
 	})
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *TenantUpsertOne) SetPlanID(v int64) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdatePlanID() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *TenantUpsertOne) ClearPlanID() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (u *TenantUpsertOne) SetCreatedBy(v int64) *TenantUpsertOne {
 	return u.Update(func(s *TenantUpsert) {
@@ -1527,6 +1820,27 @@ func (u *TenantUpsertBulk) ClearExpiredAt() *TenantUpsertBulk {
 	})
 }
 
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (u *TenantUpsertBulk) SetExpiryNotifiedAt(v time.Time) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetExpiryNotifiedAt(v)
+	})
+}
+
+// UpdateExpiryNotifiedAt sets the "expiry_notified_at" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdateExpiryNotifiedAt() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdateExpiryNotifiedAt()
+	})
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (u *TenantUpsertBulk) ClearExpiryNotifiedAt() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearExpiryNotifiedAt()
+	})
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *TenantUpsertBulk) SetAttributes(v map[string]interface{}) *TenantUpsertBulk {
 	return u.Update(func(s *TenantUpsert) {
@@ -1541,6 +1855,27 @@ func (u *TenantUpsertBulk) UpdateAttributes() *TenantUpsertBulk {
 	})
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *TenantUpsertBulk) SetPlanID(v int64) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdatePlanID() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *TenantUpsertBulk) ClearPlanID() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (u *TenantUpsertBulk) SetCreatedBy(v int64) *TenantUpsertBulk {
 	return u.Update(func(s *TenantUpsert) {
@@ -13,10 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
 	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/predicate"
 	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
 	"github.com/yc-alpha/admin/ent/userrole"
 	"github.com/yc-alpha/admin/ent/usertenant"
 )
@@ -24,17 +30,23 @@ import (
 // TenantQuery is the builder for querying Tenant entities.
 type TenantQuery struct {
 	config
-	ctx             *QueryContext
-	order           []tenant.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Tenant
-	withUserTenants *UserTenantQuery
-	withDepartments *DepartmentQuery
-	withParent      *TenantQuery
-	withChildren    *TenantQuery
-	withRoles       *RoleQuery
-	withUserRoles   *UserRoleQuery
-	modifiers       []func(*sql.Selector)
+	ctx                *QueryContext
+	order              []tenant.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Tenant
+	withUserTenants    *UserTenantQuery
+	withDepartments    *DepartmentQuery
+	withParent         *TenantQuery
+	withChildren       *TenantQuery
+	withRoles          *RoleQuery
+	withUserRoles      *UserRoleQuery
+	withStatusLogs     *TenantStatusLogQuery
+	withInvitations    *TenantInvitationQuery
+	withSettings       *TenantSettingQuery
+	withSettingLogs    *TenantSettingLogQuery
+	withAccessPolicies *AccessPolicyQuery
+	withPlan           *PlanQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -203,6 +215,138 @@ func (tq *TenantQuery) QueryUserRoles() *UserRoleQuery {
 	return query
 }
 
+// QueryStatusLogs chains the current query on the "status_logs" edge.
+func (tq *TenantQuery) QueryStatusLogs() *TenantStatusLogQuery {
+	query := (&TenantStatusLogClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenantstatuslog.Table, tenantstatuslog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.StatusLogsTable, tenant.StatusLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitations chains the current query on the "invitations" edge.
+func (tq *TenantQuery) QueryInvitations() *TenantInvitationQuery {
+	query := (&TenantInvitationClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenantinvitation.Table, tenantinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.InvitationsTable, tenant.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySettings chains the current query on the "settings" edge.
+func (tq *TenantQuery) QuerySettings() *TenantSettingQuery {
+	query := (&TenantSettingClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenantsetting.Table, tenantsetting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.SettingsTable, tenant.SettingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySettingLogs chains the current query on the "setting_logs" edge.
+func (tq *TenantQuery) QuerySettingLogs() *TenantSettingLogQuery {
+	query := (&TenantSettingLogClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenantsettinglog.Table, tenantsettinglog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.SettingLogsTable, tenant.SettingLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessPolicies chains the current query on the "access_policies" edge.
+func (tq *TenantQuery) QueryAccessPolicies() *AccessPolicyQuery {
+	query := (&AccessPolicyClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(accesspolicy.Table, accesspolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.AccessPoliciesTable, tenant.AccessPoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPlan chains the current query on the "plan" edge.
+func (tq *TenantQuery) QueryPlan() *PlanQuery {
+	query := (&PlanClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenant.PlanTable, tenant.PlanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Tenant entity from the query.
 // Returns a *NotFoundError when no Tenant was found.
 func (tq *TenantQuery) First(ctx context.Context) (*Tenant, error) {
@@ -390,17 +534,23 @@ func (tq *TenantQuery) Clone() *TenantQuery {
 		return nil
 	}
 	return &TenantQuery{
-		config:          tq.config,
-		ctx:             tq.ctx.Clone(),
-		order:           append([]tenant.OrderOption{}, tq.order...),
-		inters:          append([]Interceptor{}, tq.inters...),
-		predicates:      append([]predicate.Tenant{}, tq.predicates...),
-		withUserTenants: tq.withUserTenants.Clone(),
-		withDepartments: tq.withDepartments.Clone(),
-		withParent:      tq.withParent.Clone(),
-		withChildren:    tq.withChildren.Clone(),
-		withRoles:       tq.withRoles.Clone(),
-		withUserRoles:   tq.withUserRoles.Clone(),
+		config:             tq.config,
+		ctx:                tq.ctx.Clone(),
+		order:              append([]tenant.OrderOption{}, tq.order...),
+		inters:             append([]Interceptor{}, tq.inters...),
+		predicates:         append([]predicate.Tenant{}, tq.predicates...),
+		withUserTenants:    tq.withUserTenants.Clone(),
+		withDepartments:    tq.withDepartments.Clone(),
+		withParent:         tq.withParent.Clone(),
+		withChildren:       tq.withChildren.Clone(),
+		withRoles:          tq.withRoles.Clone(),
+		withUserRoles:      tq.withUserRoles.Clone(),
+		withStatusLogs:     tq.withStatusLogs.Clone(),
+		withInvitations:    tq.withInvitations.Clone(),
+		withSettings:       tq.withSettings.Clone(),
+		withSettingLogs:    tq.withSettingLogs.Clone(),
+		withAccessPolicies: tq.withAccessPolicies.Clone(),
+		withPlan:           tq.withPlan.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -473,6 +623,72 @@ func (tq *TenantQuery) WithUserRoles(opts ...func(*UserRoleQuery)) *TenantQuery
 	return tq
 }
 
+// WithStatusLogs tells the query-builder to eager-load the nodes that are connected to
+// the "status_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TenantQuery) WithStatusLogs(opts ...func(*TenantStatusLogQuery)) *TenantQuery {
+	query := (&TenantStatusLogClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withStatusLogs = query
+	return tq
+}
+
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TenantQuery) WithInvitations(opts ...func(*TenantInvitationQuery)) *TenantQuery {
+	query := (&TenantInvitationClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withInvitations = query
+	return tq
+}
+
+// WithSettings tells the query-builder to eager-load the nodes that are connected to
+// the "settings" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TenantQuery) WithSettings(opts ...func(*TenantSettingQuery)) *TenantQuery {
+	query := (&TenantSettingClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withSettings = query
+	return tq
+}
+
+// WithSettingLogs tells the query-builder to eager-load the nodes that are connected to
+// the "setting_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TenantQuery) WithSettingLogs(opts ...func(*TenantSettingLogQuery)) *TenantQuery {
+	query := (&TenantSettingLogClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withSettingLogs = query
+	return tq
+}
+
+// WithAccessPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "access_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TenantQuery) WithAccessPolicies(opts ...func(*AccessPolicyQuery)) *TenantQuery {
+	query := (&AccessPolicyClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withAccessPolicies = query
+	return tq
+}
+
+// WithPlan tells the query-builder to eager-load the nodes that are connected to
+// the "plan" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TenantQuery) WithPlan(opts ...func(*PlanQuery)) *TenantQuery {
+	query := (&PlanClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withPlan = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -551,13 +767,19 @@ func (tq *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 	var (
 		nodes       = []*Tenant{}
 		_spec       = tq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [12]bool{
 			tq.withUserTenants != nil,
 			tq.withDepartments != nil,
 			tq.withParent != nil,
 			tq.withChildren != nil,
 			tq.withRoles != nil,
 			tq.withUserRoles != nil,
+			tq.withStatusLogs != nil,
+			tq.withInvitations != nil,
+			tq.withSettings != nil,
+			tq.withSettingLogs != nil,
+			tq.withAccessPolicies != nil,
+			tq.withPlan != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -622,6 +844,47 @@ func (tq *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := tq.withStatusLogs; query != nil {
+		if err := tq.loadStatusLogs(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.StatusLogs = []*TenantStatusLog{} },
+			func(n *Tenant, e *TenantStatusLog) { n.Edges.StatusLogs = append(n.Edges.StatusLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withInvitations; query != nil {
+		if err := tq.loadInvitations(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Invitations = []*TenantInvitation{} },
+			func(n *Tenant, e *TenantInvitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withSettings; query != nil {
+		if err := tq.loadSettings(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Settings = []*TenantSetting{} },
+			func(n *Tenant, e *TenantSetting) { n.Edges.Settings = append(n.Edges.Settings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withSettingLogs; query != nil {
+		if err := tq.loadSettingLogs(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.SettingLogs = []*TenantSettingLog{} },
+			func(n *Tenant, e *TenantSettingLog) { n.Edges.SettingLogs = append(n.Edges.SettingLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withAccessPolicies; query != nil {
+		if err := tq.loadAccessPolicies(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.AccessPolicies = []*AccessPolicy{} },
+			func(n *Tenant, e *AccessPolicy) { n.Edges.AccessPolicies = append(n.Edges.AccessPolicies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withPlan; query != nil {
+		if err := tq.loadPlan(ctx, query, nodes, nil,
+			func(n *Tenant, e *Plan) { n.Edges.Plan = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -816,6 +1079,191 @@ func (tq *TenantQuery) loadUserRoles(ctx context.Context, query *UserRoleQuery,
 	}
 	return nil
 }
+func (tq *TenantQuery) loadStatusLogs(ctx context.Context, query *TenantStatusLogQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TenantStatusLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantstatuslog.FieldTenantID)
+	}
+	query.Where(predicate.TenantStatusLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.StatusLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TenantQuery) loadInvitations(ctx context.Context, query *TenantInvitationQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TenantInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantinvitation.FieldTenantID)
+	}
+	query.Where(predicate.TenantInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TenantQuery) loadSettings(ctx context.Context, query *TenantSettingQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TenantSetting)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantsetting.FieldTenantID)
+	}
+	query.Where(predicate.TenantSetting(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.SettingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TenantQuery) loadSettingLogs(ctx context.Context, query *TenantSettingLogQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TenantSettingLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantsettinglog.FieldTenantID)
+	}
+	query.Where(predicate.TenantSettingLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.SettingLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TenantQuery) loadAccessPolicies(ctx context.Context, query *AccessPolicyQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *AccessPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int64]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accesspolicy.FieldTenantID)
+	}
+	query.Where(predicate.AccessPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.AccessPoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tenant_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TenantQuery) loadPlan(ctx context.Context, query *PlanQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Plan)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*Tenant)
+	for i := range nodes {
+		if nodes[i].PlanID == nil {
+			continue
+		}
+		fk := *nodes[i].PlanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(plan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "plan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (tq *TenantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -848,6 +1296,9 @@ func (tq *TenantQuery) querySpec() *sqlgraph.QuerySpec {
 		if tq.withParent != nil {
 			_spec.Node.AddColumnOnce(tenant.FieldParentID)
 		}
+		if tq.withPlan != nil {
+			_spec.Node.AddColumnOnce(tenant.FieldPlanID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -11,10 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/accesspolicy"
 	"github.com/yc-alpha/admin/ent/department"
+	"github.com/yc-alpha/admin/ent/plan"
 	"github.com/yc-alpha/admin/ent/predicate"
 	"github.com/yc-alpha/admin/ent/role"
 	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
 	"github.com/yc-alpha/admin/ent/userrole"
 	"github.com/yc-alpha/admin/ent/usertenant"
 )
@@ -176,12 +182,52 @@ func (tu *TenantUpdate) ClearExpiredAt() *TenantUpdate {
 	return tu
 }
 
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (tu *TenantUpdate) SetExpiryNotifiedAt(t time.Time) *TenantUpdate {
+	tu.mutation.SetExpiryNotifiedAt(t)
+	return tu
+}
+
+// SetNillableExpiryNotifiedAt sets the "expiry_notified_at" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillableExpiryNotifiedAt(t *time.Time) *TenantUpdate {
+	if t != nil {
+		tu.SetExpiryNotifiedAt(*t)
+	}
+	return tu
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (tu *TenantUpdate) ClearExpiryNotifiedAt() *TenantUpdate {
+	tu.mutation.ClearExpiryNotifiedAt()
+	return tu
+}
+
 // SetAttributes sets the "attributes" field.
 func (tu *TenantUpdate) SetAttributes(m map[string]interface{}) *TenantUpdate {
 	tu.mutation.SetAttributes(m)
 	return tu
 }
 
+// SetPlanID sets the "plan_id" field.
+func (tu *TenantUpdate) SetPlanID(i int64) *TenantUpdate {
+	tu.mutation.SetPlanID(i)
+	return tu
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (tu *TenantUpdate) SetNillablePlanID(i *int64) *TenantUpdate {
+	if i != nil {
+		tu.SetPlanID(*i)
+	}
+	return tu
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (tu *TenantUpdate) ClearPlanID() *TenantUpdate {
+	tu.mutation.ClearPlanID()
+	return tu
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (tu *TenantUpdate) SetCreatedBy(i int64) *TenantUpdate {
 	tu.mutation.ResetCreatedBy()
@@ -342,6 +388,86 @@ func (tu *TenantUpdate) AddUserRoles(u ...*UserRole) *TenantUpdate {
 	return tu.AddUserRoleIDs(ids...)
 }
 
+// AddStatusLogIDs adds the "status_logs" edge to the TenantStatusLog entity by IDs.
+func (tu *TenantUpdate) AddStatusLogIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.AddStatusLogIDs(ids...)
+	return tu
+}
+
+// AddStatusLogs adds the "status_logs" edges to the TenantStatusLog entity.
+func (tu *TenantUpdate) AddStatusLogs(t ...*TenantStatusLog) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddStatusLogIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the TenantInvitation entity by IDs.
+func (tu *TenantUpdate) AddInvitationIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.AddInvitationIDs(ids...)
+	return tu
+}
+
+// AddInvitations adds the "invitations" edges to the TenantInvitation entity.
+func (tu *TenantUpdate) AddInvitations(t ...*TenantInvitation) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddInvitationIDs(ids...)
+}
+
+// AddSettingIDs adds the "settings" edge to the TenantSetting entity by IDs.
+func (tu *TenantUpdate) AddSettingIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.AddSettingIDs(ids...)
+	return tu
+}
+
+// AddSettings adds the "settings" edges to the TenantSetting entity.
+func (tu *TenantUpdate) AddSettings(t ...*TenantSetting) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddSettingIDs(ids...)
+}
+
+// AddSettingLogIDs adds the "setting_logs" edge to the TenantSettingLog entity by IDs.
+func (tu *TenantUpdate) AddSettingLogIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.AddSettingLogIDs(ids...)
+	return tu
+}
+
+// AddSettingLogs adds the "setting_logs" edges to the TenantSettingLog entity.
+func (tu *TenantUpdate) AddSettingLogs(t ...*TenantSettingLog) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddSettingLogIDs(ids...)
+}
+
+// AddAccessPolicyIDs adds the "access_policies" edge to the AccessPolicy entity by IDs.
+func (tu *TenantUpdate) AddAccessPolicyIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.AddAccessPolicyIDs(ids...)
+	return tu
+}
+
+// AddAccessPolicies adds the "access_policies" edges to the AccessPolicy entity.
+func (tu *TenantUpdate) AddAccessPolicies(a ...*AccessPolicy) *TenantUpdate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.AddAccessPolicyIDs(ids...)
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (tu *TenantUpdate) SetPlan(p *Plan) *TenantUpdate {
+	return tu.SetPlanID(p.ID)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (tu *TenantUpdate) Mutation() *TenantMutation {
 	return tu.mutation
@@ -458,6 +584,117 @@ func (tu *TenantUpdate) RemoveUserRoles(u ...*UserRole) *TenantUpdate {
 	return tu.RemoveUserRoleIDs(ids...)
 }
 
+// ClearStatusLogs clears all "status_logs" edges to the TenantStatusLog entity.
+func (tu *TenantUpdate) ClearStatusLogs() *TenantUpdate {
+	tu.mutation.ClearStatusLogs()
+	return tu
+}
+
+// RemoveStatusLogIDs removes the "status_logs" edge to TenantStatusLog entities by IDs.
+func (tu *TenantUpdate) RemoveStatusLogIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.RemoveStatusLogIDs(ids...)
+	return tu
+}
+
+// RemoveStatusLogs removes "status_logs" edges to TenantStatusLog entities.
+func (tu *TenantUpdate) RemoveStatusLogs(t ...*TenantStatusLog) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveStatusLogIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the TenantInvitation entity.
+func (tu *TenantUpdate) ClearInvitations() *TenantUpdate {
+	tu.mutation.ClearInvitations()
+	return tu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to TenantInvitation entities by IDs.
+func (tu *TenantUpdate) RemoveInvitationIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.RemoveInvitationIDs(ids...)
+	return tu
+}
+
+// RemoveInvitations removes "invitations" edges to TenantInvitation entities.
+func (tu *TenantUpdate) RemoveInvitations(t ...*TenantInvitation) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveInvitationIDs(ids...)
+}
+
+// ClearSettings clears all "settings" edges to the TenantSetting entity.
+func (tu *TenantUpdate) ClearSettings() *TenantUpdate {
+	tu.mutation.ClearSettings()
+	return tu
+}
+
+// RemoveSettingIDs removes the "settings" edge to TenantSetting entities by IDs.
+func (tu *TenantUpdate) RemoveSettingIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.RemoveSettingIDs(ids...)
+	return tu
+}
+
+// RemoveSettings removes "settings" edges to TenantSetting entities.
+func (tu *TenantUpdate) RemoveSettings(t ...*TenantSetting) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveSettingIDs(ids...)
+}
+
+// ClearSettingLogs clears all "setting_logs" edges to the TenantSettingLog entity.
+func (tu *TenantUpdate) ClearSettingLogs() *TenantUpdate {
+	tu.mutation.ClearSettingLogs()
+	return tu
+}
+
+// RemoveSettingLogIDs removes the "setting_logs" edge to TenantSettingLog entities by IDs.
+func (tu *TenantUpdate) RemoveSettingLogIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.RemoveSettingLogIDs(ids...)
+	return tu
+}
+
+// RemoveSettingLogs removes "setting_logs" edges to TenantSettingLog entities.
+func (tu *TenantUpdate) RemoveSettingLogs(t ...*TenantSettingLog) *TenantUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveSettingLogIDs(ids...)
+}
+
+// ClearAccessPolicies clears all "access_policies" edges to the AccessPolicy entity.
+func (tu *TenantUpdate) ClearAccessPolicies() *TenantUpdate {
+	tu.mutation.ClearAccessPolicies()
+	return tu
+}
+
+// RemoveAccessPolicyIDs removes the "access_policies" edge to AccessPolicy entities by IDs.
+func (tu *TenantUpdate) RemoveAccessPolicyIDs(ids ...int64) *TenantUpdate {
+	tu.mutation.RemoveAccessPolicyIDs(ids...)
+	return tu
+}
+
+// RemoveAccessPolicies removes "access_policies" edges to AccessPolicy entities.
+func (tu *TenantUpdate) RemoveAccessPolicies(a ...*AccessPolicy) *TenantUpdate {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.RemoveAccessPolicyIDs(ids...)
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (tu *TenantUpdate) ClearPlan() *TenantUpdate {
+	tu.mutation.ClearPlan()
+	return tu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TenantUpdate) Save(ctx context.Context) (int, error) {
 	if err := tu.defaults(); err != nil {
@@ -565,6 +802,12 @@ func (tu *TenantUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.ExpiredAtCleared() {
 		_spec.ClearField(tenant.FieldExpiredAt, field.TypeTime)
 	}
+	if value, ok := tu.mutation.ExpiryNotifiedAt(); ok {
+		_spec.SetField(tenant.FieldExpiryNotifiedAt, field.TypeTime, value)
+	}
+	if tu.mutation.ExpiryNotifiedAtCleared() {
+		_spec.ClearField(tenant.FieldExpiryNotifiedAt, field.TypeTime)
+	}
 	if value, ok := tu.mutation.Attributes(); ok {
 		_spec.SetField(tenant.FieldAttributes, field.TypeJSON, value)
 	}
@@ -849,92 +1092,346 @@ func (tu *TenantUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tenant.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if tu.mutation.StatusLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	tu.mutation.done = true
-	return n, nil
-}
-
-// TenantUpdateOne is the builder for updating a single Tenant entity.
-type TenantUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TenantMutation
-}
-
-// SetName sets the "name" field.
-func (tuo *TenantUpdateOne) SetName(s string) *TenantUpdateOne {
-	tuo.mutation.SetName(s)
-	return tuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (tuo *TenantUpdateOne) SetNillableName(s *string) *TenantUpdateOne {
-	if s != nil {
-		tuo.SetName(*s)
+	if nodes := tu.mutation.RemovedStatusLogsIDs(); len(nodes) > 0 && !tu.mutation.StatusLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (tuo *TenantUpdateOne) SetOwnerID(i int64) *TenantUpdateOne {
-	tuo.mutation.ResetOwnerID()
-	tuo.mutation.SetOwnerID(i)
-	return tuo
-}
-
-// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
-func (tuo *TenantUpdateOne) SetNillableOwnerID(i *int64) *TenantUpdateOne {
-	if i != nil {
-		tuo.SetOwnerID(*i)
+	if nodes := tu.mutation.StatusLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return tuo
-}
-
-// AddOwnerID adds i to the "owner_id" field.
-func (tuo *TenantUpdateOne) AddOwnerID(i int64) *TenantUpdateOne {
-	tuo.mutation.AddOwnerID(i)
-	return tuo
-}
-
-// SetType sets the "type" field.
-func (tuo *TenantUpdateOne) SetType(t tenant.Type) *TenantUpdateOne {
-	tuo.mutation.SetType(t)
-	return tuo
-}
-
-// SetNillableType sets the "type" field if the given value is not nil.
-func (tuo *TenantUpdateOne) SetNillableType(t *tenant.Type) *TenantUpdateOne {
-	if t != nil {
-		tuo.SetType(*t)
+	if tu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// SetParentID sets the "parent_id" field.
-func (tuo *TenantUpdateOne) SetParentID(i int64) *TenantUpdateOne {
-	tuo.mutation.SetParentID(i)
-	return tuo
-}
-
-// SetNillableParentID sets the "parent_id" field if the given value is not nil.
-func (tuo *TenantUpdateOne) SetNillableParentID(i *int64) *TenantUpdateOne {
-	if i != nil {
-		tuo.SetParentID(*i)
+	if nodes := tu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !tu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// ClearParentID clears the value of the "parent_id" field.
-func (tuo *TenantUpdateOne) ClearParentID() *TenantUpdateOne {
-	tuo.mutation.ClearParentID()
+	if nodes := tu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedSettingsIDs(); len(nodes) > 0 && !tu.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.SettingLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedSettingLogsIDs(); len(nodes) > 0 && !tu.mutation.SettingLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SettingLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AccessPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAccessPoliciesIDs(); len(nodes) > 0 && !tu.mutation.AccessPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AccessPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TenantUpdateOne is the builder for updating a single Tenant entity.
+type TenantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TenantUpdateOne) SetName(s string) *TenantUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableName(s *string) *TenantUpdateOne {
+	if s != nil {
+		tuo.SetName(*s)
+	}
+	return tuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tuo *TenantUpdateOne) SetOwnerID(i int64) *TenantUpdateOne {
+	tuo.mutation.ResetOwnerID()
+	tuo.mutation.SetOwnerID(i)
+	return tuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableOwnerID(i *int64) *TenantUpdateOne {
+	if i != nil {
+		tuo.SetOwnerID(*i)
+	}
+	return tuo
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (tuo *TenantUpdateOne) AddOwnerID(i int64) *TenantUpdateOne {
+	tuo.mutation.AddOwnerID(i)
+	return tuo
+}
+
+// SetType sets the "type" field.
+func (tuo *TenantUpdateOne) SetType(t tenant.Type) *TenantUpdateOne {
+	tuo.mutation.SetType(t)
+	return tuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableType(t *tenant.Type) *TenantUpdateOne {
+	if t != nil {
+		tuo.SetType(*t)
+	}
+	return tuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (tuo *TenantUpdateOne) SetParentID(i int64) *TenantUpdateOne {
+	tuo.mutation.SetParentID(i)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableParentID(i *int64) *TenantUpdateOne {
+	if i != nil {
+		tuo.SetParentID(*i)
+	}
+	return tuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tuo *TenantUpdateOne) ClearParentID() *TenantUpdateOne {
+	tuo.mutation.ClearParentID()
 	return tuo
 }
 
@@ -1013,12 +1510,52 @@ func (tuo *TenantUpdateOne) ClearExpiredAt() *TenantUpdateOne {
 	return tuo
 }
 
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (tuo *TenantUpdateOne) SetExpiryNotifiedAt(t time.Time) *TenantUpdateOne {
+	tuo.mutation.SetExpiryNotifiedAt(t)
+	return tuo
+}
+
+// SetNillableExpiryNotifiedAt sets the "expiry_notified_at" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillableExpiryNotifiedAt(t *time.Time) *TenantUpdateOne {
+	if t != nil {
+		tuo.SetExpiryNotifiedAt(*t)
+	}
+	return tuo
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (tuo *TenantUpdateOne) ClearExpiryNotifiedAt() *TenantUpdateOne {
+	tuo.mutation.ClearExpiryNotifiedAt()
+	return tuo
+}
+
 // SetAttributes sets the "attributes" field.
 func (tuo *TenantUpdateOne) SetAttributes(m map[string]interface{}) *TenantUpdateOne {
 	tuo.mutation.SetAttributes(m)
 	return tuo
 }
 
+// SetPlanID sets the "plan_id" field.
+func (tuo *TenantUpdateOne) SetPlanID(i int64) *TenantUpdateOne {
+	tuo.mutation.SetPlanID(i)
+	return tuo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (tuo *TenantUpdateOne) SetNillablePlanID(i *int64) *TenantUpdateOne {
+	if i != nil {
+		tuo.SetPlanID(*i)
+	}
+	return tuo
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (tuo *TenantUpdateOne) ClearPlanID() *TenantUpdateOne {
+	tuo.mutation.ClearPlanID()
+	return tuo
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (tuo *TenantUpdateOne) SetCreatedBy(i int64) *TenantUpdateOne {
 	tuo.mutation.ResetCreatedBy()
@@ -1170,13 +1707,93 @@ func (tuo *TenantUpdateOne) AddUserRoleIDs(ids ...int64) *TenantUpdateOne {
 	return tuo
 }
 
-// AddUserRoles adds the "user_roles" edges to the UserRole entity.
-func (tuo *TenantUpdateOne) AddUserRoles(u ...*UserRole) *TenantUpdateOne {
-	ids := make([]int64, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// AddUserRoles adds the "user_roles" edges to the UserRole entity.
+func (tuo *TenantUpdateOne) AddUserRoles(u ...*UserRole) *TenantUpdateOne {
+	ids := make([]int64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.AddUserRoleIDs(ids...)
+}
+
+// AddStatusLogIDs adds the "status_logs" edge to the TenantStatusLog entity by IDs.
+func (tuo *TenantUpdateOne) AddStatusLogIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.AddStatusLogIDs(ids...)
+	return tuo
+}
+
+// AddStatusLogs adds the "status_logs" edges to the TenantStatusLog entity.
+func (tuo *TenantUpdateOne) AddStatusLogs(t ...*TenantStatusLog) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddStatusLogIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the TenantInvitation entity by IDs.
+func (tuo *TenantUpdateOne) AddInvitationIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.AddInvitationIDs(ids...)
+	return tuo
+}
+
+// AddInvitations adds the "invitations" edges to the TenantInvitation entity.
+func (tuo *TenantUpdateOne) AddInvitations(t ...*TenantInvitation) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddInvitationIDs(ids...)
+}
+
+// AddSettingIDs adds the "settings" edge to the TenantSetting entity by IDs.
+func (tuo *TenantUpdateOne) AddSettingIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.AddSettingIDs(ids...)
+	return tuo
+}
+
+// AddSettings adds the "settings" edges to the TenantSetting entity.
+func (tuo *TenantUpdateOne) AddSettings(t ...*TenantSetting) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddSettingIDs(ids...)
+}
+
+// AddSettingLogIDs adds the "setting_logs" edge to the TenantSettingLog entity by IDs.
+func (tuo *TenantUpdateOne) AddSettingLogIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.AddSettingLogIDs(ids...)
+	return tuo
+}
+
+// AddSettingLogs adds the "setting_logs" edges to the TenantSettingLog entity.
+func (tuo *TenantUpdateOne) AddSettingLogs(t ...*TenantSettingLog) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddSettingLogIDs(ids...)
+}
+
+// AddAccessPolicyIDs adds the "access_policies" edge to the AccessPolicy entity by IDs.
+func (tuo *TenantUpdateOne) AddAccessPolicyIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.AddAccessPolicyIDs(ids...)
+	return tuo
+}
+
+// AddAccessPolicies adds the "access_policies" edges to the AccessPolicy entity.
+func (tuo *TenantUpdateOne) AddAccessPolicies(a ...*AccessPolicy) *TenantUpdateOne {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return tuo.AddUserRoleIDs(ids...)
+	return tuo.AddAccessPolicyIDs(ids...)
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (tuo *TenantUpdateOne) SetPlan(p *Plan) *TenantUpdateOne {
+	return tuo.SetPlanID(p.ID)
 }
 
 // Mutation returns the TenantMutation object of the builder.
@@ -1295,6 +1912,117 @@ func (tuo *TenantUpdateOne) RemoveUserRoles(u ...*UserRole) *TenantUpdateOne {
 	return tuo.RemoveUserRoleIDs(ids...)
 }
 
+// ClearStatusLogs clears all "status_logs" edges to the TenantStatusLog entity.
+func (tuo *TenantUpdateOne) ClearStatusLogs() *TenantUpdateOne {
+	tuo.mutation.ClearStatusLogs()
+	return tuo
+}
+
+// RemoveStatusLogIDs removes the "status_logs" edge to TenantStatusLog entities by IDs.
+func (tuo *TenantUpdateOne) RemoveStatusLogIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.RemoveStatusLogIDs(ids...)
+	return tuo
+}
+
+// RemoveStatusLogs removes "status_logs" edges to TenantStatusLog entities.
+func (tuo *TenantUpdateOne) RemoveStatusLogs(t ...*TenantStatusLog) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveStatusLogIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the TenantInvitation entity.
+func (tuo *TenantUpdateOne) ClearInvitations() *TenantUpdateOne {
+	tuo.mutation.ClearInvitations()
+	return tuo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to TenantInvitation entities by IDs.
+func (tuo *TenantUpdateOne) RemoveInvitationIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.RemoveInvitationIDs(ids...)
+	return tuo
+}
+
+// RemoveInvitations removes "invitations" edges to TenantInvitation entities.
+func (tuo *TenantUpdateOne) RemoveInvitations(t ...*TenantInvitation) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveInvitationIDs(ids...)
+}
+
+// ClearSettings clears all "settings" edges to the TenantSetting entity.
+func (tuo *TenantUpdateOne) ClearSettings() *TenantUpdateOne {
+	tuo.mutation.ClearSettings()
+	return tuo
+}
+
+// RemoveSettingIDs removes the "settings" edge to TenantSetting entities by IDs.
+func (tuo *TenantUpdateOne) RemoveSettingIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.RemoveSettingIDs(ids...)
+	return tuo
+}
+
+// RemoveSettings removes "settings" edges to TenantSetting entities.
+func (tuo *TenantUpdateOne) RemoveSettings(t ...*TenantSetting) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveSettingIDs(ids...)
+}
+
+// ClearSettingLogs clears all "setting_logs" edges to the TenantSettingLog entity.
+func (tuo *TenantUpdateOne) ClearSettingLogs() *TenantUpdateOne {
+	tuo.mutation.ClearSettingLogs()
+	return tuo
+}
+
+// RemoveSettingLogIDs removes the "setting_logs" edge to TenantSettingLog entities by IDs.
+func (tuo *TenantUpdateOne) RemoveSettingLogIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.RemoveSettingLogIDs(ids...)
+	return tuo
+}
+
+// RemoveSettingLogs removes "setting_logs" edges to TenantSettingLog entities.
+func (tuo *TenantUpdateOne) RemoveSettingLogs(t ...*TenantSettingLog) *TenantUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveSettingLogIDs(ids...)
+}
+
+// ClearAccessPolicies clears all "access_policies" edges to the AccessPolicy entity.
+func (tuo *TenantUpdateOne) ClearAccessPolicies() *TenantUpdateOne {
+	tuo.mutation.ClearAccessPolicies()
+	return tuo
+}
+
+// RemoveAccessPolicyIDs removes the "access_policies" edge to AccessPolicy entities by IDs.
+func (tuo *TenantUpdateOne) RemoveAccessPolicyIDs(ids ...int64) *TenantUpdateOne {
+	tuo.mutation.RemoveAccessPolicyIDs(ids...)
+	return tuo
+}
+
+// RemoveAccessPolicies removes "access_policies" edges to AccessPolicy entities.
+func (tuo *TenantUpdateOne) RemoveAccessPolicies(a ...*AccessPolicy) *TenantUpdateOne {
+	ids := make([]int64, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.RemoveAccessPolicyIDs(ids...)
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (tuo *TenantUpdateOne) ClearPlan() *TenantUpdateOne {
+	tuo.mutation.ClearPlan()
+	return tuo
+}
+
 // Where appends a list predicates to the TenantUpdate builder.
 func (tuo *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -1432,6 +2160,12 @@ func (tuo *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err err
 	if tuo.mutation.ExpiredAtCleared() {
 		_spec.ClearField(tenant.FieldExpiredAt, field.TypeTime)
 	}
+	if value, ok := tuo.mutation.ExpiryNotifiedAt(); ok {
+		_spec.SetField(tenant.FieldExpiryNotifiedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.ExpiryNotifiedAtCleared() {
+		_spec.ClearField(tenant.FieldExpiryNotifiedAt, field.TypeTime)
+	}
 	if value, ok := tuo.mutation.Attributes(); ok {
 		_spec.SetField(tenant.FieldAttributes, field.TypeJSON, value)
 	}
@@ -1716,6 +2450,260 @@ func (tuo *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.StatusLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedStatusLogsIDs(); len(nodes) > 0 && !tuo.mutation.StatusLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.StatusLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.StatusLogsTable,
+			Columns: []string{tenant.StatusLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !tuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.InvitationsTable,
+			Columns: []string{tenant.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedSettingsIDs(); len(nodes) > 0 && !tuo.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.SettingLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedSettingLogsIDs(); len(nodes) > 0 && !tuo.mutation.SettingLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.SettingLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SettingLogsTable,
+			Columns: []string{tenant.SettingLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AccessPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAccessPoliciesIDs(); len(nodes) > 0 && !tuo.mutation.AccessPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AccessPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.AccessPoliciesTable,
+			Columns: []string{tenant.AccessPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesspolicy.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Tenant{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
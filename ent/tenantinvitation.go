@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+)
+
+// TenantInvitation is the model entity for the TenantInvitation schema.
+type TenantInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary Key ID
+	ID int64 `json:"id,omitempty"`
+	// 租户ID
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// 被邀请人邮箱
+	Email *string `json:"email,omitempty"`
+	// 被邀请人手机号
+	Phone *string `json:"phone,omitempty"`
+	// 加入的部门ID
+	DepartmentID *int64 `json:"department_id,omitempty"`
+	// 初始角色ID
+	RoleIds []int64 `json:"role_ids,omitempty"`
+	// 成员身份标签
+	RoleLabels []string `json:"role_labels,omitempty"`
+	// 邀请状态
+	Status tenantinvitation.Status `json:"status,omitempty"`
+	// 令牌版本，重新发送时更换使旧令牌失效
+	Nonce string `json:"-"`
+	// 过期时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 发送次数
+	SendCount int `json:"send_count,omitempty"`
+	// 邀请人ID
+	InvitedBy *int64 `json:"invited_by,omitempty"`
+	// 接受邀请的用户ID
+	AcceptedBy *int64 `json:"accepted_by,omitempty"`
+	// 接受时间
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// Creation timestamp of this record
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp of this record
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TenantInvitationQuery when eager-loading is set.
+	Edges        TenantInvitationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TenantInvitationEdges holds the relations/edges for other nodes in the graph.
+type TenantInvitationEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantInvitationEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantinvitation.FieldRoleIds, tenantinvitation.FieldRoleLabels:
+			values[i] = new([]byte)
+		case tenantinvitation.FieldID, tenantinvitation.FieldTenantID, tenantinvitation.FieldDepartmentID, tenantinvitation.FieldSendCount, tenantinvitation.FieldInvitedBy, tenantinvitation.FieldAcceptedBy:
+			values[i] = new(sql.NullInt64)
+		case tenantinvitation.FieldEmail, tenantinvitation.FieldPhone, tenantinvitation.FieldStatus, tenantinvitation.FieldNonce:
+			values[i] = new(sql.NullString)
+		case tenantinvitation.FieldExpiresAt, tenantinvitation.FieldAcceptedAt, tenantinvitation.FieldCreatedAt, tenantinvitation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantInvitation fields.
+func (ti *TenantInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantinvitation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ti.ID = int64(value.Int64)
+		case tenantinvitation.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ti.TenantID = value.Int64
+			}
+		case tenantinvitation.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ti.Email = new(string)
+				*ti.Email = value.String
+			}
+		case tenantinvitation.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				ti.Phone = new(string)
+				*ti.Phone = value.String
+			}
+		case tenantinvitation.FieldDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value.Valid {
+				ti.DepartmentID = new(int64)
+				*ti.DepartmentID = value.Int64
+			}
+		case tenantinvitation.FieldRoleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ti.RoleIds); err != nil {
+					return fmt.Errorf("unmarshal field role_ids: %w", err)
+				}
+			}
+		case tenantinvitation.FieldRoleLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ti.RoleLabels); err != nil {
+					return fmt.Errorf("unmarshal field role_labels: %w", err)
+				}
+			}
+		case tenantinvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ti.Status = tenantinvitation.Status(value.String)
+			}
+		case tenantinvitation.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				ti.Nonce = value.String
+			}
+		case tenantinvitation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ti.ExpiresAt = value.Time
+			}
+		case tenantinvitation.FieldSendCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field send_count", values[i])
+			} else if value.Valid {
+				ti.SendCount = int(value.Int64)
+			}
+		case tenantinvitation.FieldInvitedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[i])
+			} else if value.Valid {
+				ti.InvitedBy = new(int64)
+				*ti.InvitedBy = value.Int64
+			}
+		case tenantinvitation.FieldAcceptedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_by", values[i])
+			} else if value.Valid {
+				ti.AcceptedBy = new(int64)
+				*ti.AcceptedBy = value.Int64
+			}
+		case tenantinvitation.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				ti.AcceptedAt = new(time.Time)
+				*ti.AcceptedAt = value.Time
+			}
+		case tenantinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ti.CreatedAt = value.Time
+			}
+		case tenantinvitation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ti.UpdatedAt = value.Time
+			}
+		default:
+			ti.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantInvitation.
+// This includes values selected through modifiers, order, etc.
+func (ti *TenantInvitation) Value(name string) (ent.Value, error) {
+	return ti.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TenantInvitation entity.
+func (ti *TenantInvitation) QueryTenant() *TenantQuery {
+	return NewTenantInvitationClient(ti.config).QueryTenant(ti)
+}
+
+// Update returns a builder for updating this TenantInvitation.
+// Note that you need to call TenantInvitation.Unwrap() before calling this method if this TenantInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ti *TenantInvitation) Update() *TenantInvitationUpdateOne {
+	return NewTenantInvitationClient(ti.config).UpdateOne(ti)
+}
+
+// Unwrap unwraps the TenantInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ti *TenantInvitation) Unwrap() *TenantInvitation {
+	_tx, ok := ti.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantInvitation is not a transactional entity")
+	}
+	ti.config.driver = _tx.drv
+	return ti
+}
+
+// String implements the fmt.Stringer.
+func (ti *TenantInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ti.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ti.TenantID))
+	builder.WriteString(", ")
+	if v := ti.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ti.Phone; v != nil {
+		builder.WriteString("phone=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ti.DepartmentID; v != nil {
+		builder.WriteString("department_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", ti.RoleIds))
+	builder.WriteString(", ")
+	builder.WriteString("role_labels=")
+	builder.WriteString(fmt.Sprintf("%v", ti.RoleLabels))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ti.Status))
+	builder.WriteString(", ")
+	builder.WriteString("nonce=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ti.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("send_count=")
+	builder.WriteString(fmt.Sprintf("%v", ti.SendCount))
+	builder.WriteString(", ")
+	if v := ti.InvitedBy; v != nil {
+		builder.WriteString("invited_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ti.AcceptedBy; v != nil {
+		builder.WriteString("accepted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ti.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ti.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ti.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantInvitations is a parsable slice of TenantInvitation.
+type TenantInvitations []*TenantInvitation
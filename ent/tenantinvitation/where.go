@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantinvitation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/yc-alpha/admin/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldEmail, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldPhone, v))
+}
+
+// DepartmentID applies equality check predicate on the "department_id" field. It's identical to DepartmentIDEQ.
+func DepartmentID(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldNonce, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// SendCount applies equality check predicate on the "send_count" field. It's identical to SendCountEQ.
+func SendCount(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldSendCount, v))
+}
+
+// InvitedBy applies equality check predicate on the "invited_by" field. It's identical to InvitedByEQ.
+func InvitedBy(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// AcceptedBy applies equality check predicate on the "accepted_by" field. It's identical to AcceptedByEQ.
+func AcceptedBy(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldAcceptedBy, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// DepartmentIDEQ applies the EQ predicate on the "department_id" field.
+func DepartmentIDEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDNEQ applies the NEQ predicate on the "department_id" field.
+func DepartmentIDNEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDIn applies the In predicate on the "department_id" field.
+func DepartmentIDIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDNotIn applies the NotIn predicate on the "department_id" field.
+func DepartmentIDNotIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDGT applies the GT predicate on the "department_id" field.
+func DepartmentIDGT(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldDepartmentID, v))
+}
+
+// DepartmentIDGTE applies the GTE predicate on the "department_id" field.
+func DepartmentIDGTE(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldDepartmentID, v))
+}
+
+// DepartmentIDLT applies the LT predicate on the "department_id" field.
+func DepartmentIDLT(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldDepartmentID, v))
+}
+
+// DepartmentIDLTE applies the LTE predicate on the "department_id" field.
+func DepartmentIDLTE(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldDepartmentID, v))
+}
+
+// DepartmentIDIsNil applies the IsNil predicate on the "department_id" field.
+func DepartmentIDIsNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIsNull(FieldDepartmentID))
+}
+
+// DepartmentIDNotNil applies the NotNil predicate on the "department_id" field.
+func DepartmentIDNotNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotNull(FieldDepartmentID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// SendCountEQ applies the EQ predicate on the "send_count" field.
+func SendCountEQ(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldSendCount, v))
+}
+
+// SendCountNEQ applies the NEQ predicate on the "send_count" field.
+func SendCountNEQ(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldSendCount, v))
+}
+
+// SendCountIn applies the In predicate on the "send_count" field.
+func SendCountIn(vs ...int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldSendCount, vs...))
+}
+
+// SendCountNotIn applies the NotIn predicate on the "send_count" field.
+func SendCountNotIn(vs ...int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldSendCount, vs...))
+}
+
+// SendCountGT applies the GT predicate on the "send_count" field.
+func SendCountGT(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldSendCount, v))
+}
+
+// SendCountGTE applies the GTE predicate on the "send_count" field.
+func SendCountGTE(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldSendCount, v))
+}
+
+// SendCountLT applies the LT predicate on the "send_count" field.
+func SendCountLT(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldSendCount, v))
+}
+
+// SendCountLTE applies the LTE predicate on the "send_count" field.
+func SendCountLTE(v int) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldSendCount, v))
+}
+
+// InvitedByEQ applies the EQ predicate on the "invited_by" field.
+func InvitedByEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// InvitedByNEQ applies the NEQ predicate on the "invited_by" field.
+func InvitedByNEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldInvitedBy, v))
+}
+
+// InvitedByIn applies the In predicate on the "invited_by" field.
+func InvitedByIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByNotIn applies the NotIn predicate on the "invited_by" field.
+func InvitedByNotIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByGT applies the GT predicate on the "invited_by" field.
+func InvitedByGT(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldInvitedBy, v))
+}
+
+// InvitedByGTE applies the GTE predicate on the "invited_by" field.
+func InvitedByGTE(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldInvitedBy, v))
+}
+
+// InvitedByLT applies the LT predicate on the "invited_by" field.
+func InvitedByLT(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldInvitedBy, v))
+}
+
+// InvitedByLTE applies the LTE predicate on the "invited_by" field.
+func InvitedByLTE(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldInvitedBy, v))
+}
+
+// InvitedByIsNil applies the IsNil predicate on the "invited_by" field.
+func InvitedByIsNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIsNull(FieldInvitedBy))
+}
+
+// InvitedByNotNil applies the NotNil predicate on the "invited_by" field.
+func InvitedByNotNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotNull(FieldInvitedBy))
+}
+
+// AcceptedByEQ applies the EQ predicate on the "accepted_by" field.
+func AcceptedByEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldAcceptedBy, v))
+}
+
+// AcceptedByNEQ applies the NEQ predicate on the "accepted_by" field.
+func AcceptedByNEQ(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldAcceptedBy, v))
+}
+
+// AcceptedByIn applies the In predicate on the "accepted_by" field.
+func AcceptedByIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldAcceptedBy, vs...))
+}
+
+// AcceptedByNotIn applies the NotIn predicate on the "accepted_by" field.
+func AcceptedByNotIn(vs ...int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldAcceptedBy, vs...))
+}
+
+// AcceptedByGT applies the GT predicate on the "accepted_by" field.
+func AcceptedByGT(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldAcceptedBy, v))
+}
+
+// AcceptedByGTE applies the GTE predicate on the "accepted_by" field.
+func AcceptedByGTE(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldAcceptedBy, v))
+}
+
+// AcceptedByLT applies the LT predicate on the "accepted_by" field.
+func AcceptedByLT(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldAcceptedBy, v))
+}
+
+// AcceptedByLTE applies the LTE predicate on the "accepted_by" field.
+func AcceptedByLTE(v int64) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldAcceptedBy, v))
+}
+
+// AcceptedByIsNil applies the IsNil predicate on the "accepted_by" field.
+func AcceptedByIsNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIsNull(FieldAcceptedBy))
+}
+
+// AcceptedByNotNil applies the NotNil predicate on the "accepted_by" field.
+func AcceptedByNotNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotNull(FieldAcceptedBy))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.TenantInvitation {
+	return predicate.TenantInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.TenantInvitation {
+	return predicate.TenantInvitation(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantInvitation) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantInvitation) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantInvitation) predicate.TenantInvitation {
+	return predicate.TenantInvitation(sql.NotPredicates(p))
+}
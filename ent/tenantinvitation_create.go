@@ -0,0 +1,1176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+)
+
+// TenantInvitationCreate is the builder for creating a TenantInvitation entity.
+type TenantInvitationCreate struct {
+	config
+	mutation *TenantInvitationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tic *TenantInvitationCreate) SetTenantID(i int64) *TenantInvitationCreate {
+	tic.mutation.SetTenantID(i)
+	return tic
+}
+
+// SetEmail sets the "email" field.
+func (tic *TenantInvitationCreate) SetEmail(s string) *TenantInvitationCreate {
+	tic.mutation.SetEmail(s)
+	return tic
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableEmail(s *string) *TenantInvitationCreate {
+	if s != nil {
+		tic.SetEmail(*s)
+	}
+	return tic
+}
+
+// SetPhone sets the "phone" field.
+func (tic *TenantInvitationCreate) SetPhone(s string) *TenantInvitationCreate {
+	tic.mutation.SetPhone(s)
+	return tic
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillablePhone(s *string) *TenantInvitationCreate {
+	if s != nil {
+		tic.SetPhone(*s)
+	}
+	return tic
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (tic *TenantInvitationCreate) SetDepartmentID(i int64) *TenantInvitationCreate {
+	tic.mutation.SetDepartmentID(i)
+	return tic
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableDepartmentID(i *int64) *TenantInvitationCreate {
+	if i != nil {
+		tic.SetDepartmentID(*i)
+	}
+	return tic
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (tic *TenantInvitationCreate) SetRoleIds(i []int64) *TenantInvitationCreate {
+	tic.mutation.SetRoleIds(i)
+	return tic
+}
+
+// SetRoleLabels sets the "role_labels" field.
+func (tic *TenantInvitationCreate) SetRoleLabels(s []string) *TenantInvitationCreate {
+	tic.mutation.SetRoleLabels(s)
+	return tic
+}
+
+// SetStatus sets the "status" field.
+func (tic *TenantInvitationCreate) SetStatus(t tenantinvitation.Status) *TenantInvitationCreate {
+	tic.mutation.SetStatus(t)
+	return tic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableStatus(t *tenantinvitation.Status) *TenantInvitationCreate {
+	if t != nil {
+		tic.SetStatus(*t)
+	}
+	return tic
+}
+
+// SetNonce sets the "nonce" field.
+func (tic *TenantInvitationCreate) SetNonce(s string) *TenantInvitationCreate {
+	tic.mutation.SetNonce(s)
+	return tic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tic *TenantInvitationCreate) SetExpiresAt(t time.Time) *TenantInvitationCreate {
+	tic.mutation.SetExpiresAt(t)
+	return tic
+}
+
+// SetSendCount sets the "send_count" field.
+func (tic *TenantInvitationCreate) SetSendCount(i int) *TenantInvitationCreate {
+	tic.mutation.SetSendCount(i)
+	return tic
+}
+
+// SetNillableSendCount sets the "send_count" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableSendCount(i *int) *TenantInvitationCreate {
+	if i != nil {
+		tic.SetSendCount(*i)
+	}
+	return tic
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (tic *TenantInvitationCreate) SetInvitedBy(i int64) *TenantInvitationCreate {
+	tic.mutation.SetInvitedBy(i)
+	return tic
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableInvitedBy(i *int64) *TenantInvitationCreate {
+	if i != nil {
+		tic.SetInvitedBy(*i)
+	}
+	return tic
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (tic *TenantInvitationCreate) SetAcceptedBy(i int64) *TenantInvitationCreate {
+	tic.mutation.SetAcceptedBy(i)
+	return tic
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableAcceptedBy(i *int64) *TenantInvitationCreate {
+	if i != nil {
+		tic.SetAcceptedBy(*i)
+	}
+	return tic
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (tic *TenantInvitationCreate) SetAcceptedAt(t time.Time) *TenantInvitationCreate {
+	tic.mutation.SetAcceptedAt(t)
+	return tic
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableAcceptedAt(t *time.Time) *TenantInvitationCreate {
+	if t != nil {
+		tic.SetAcceptedAt(*t)
+	}
+	return tic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tic *TenantInvitationCreate) SetCreatedAt(t time.Time) *TenantInvitationCreate {
+	tic.mutation.SetCreatedAt(t)
+	return tic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableCreatedAt(t *time.Time) *TenantInvitationCreate {
+	if t != nil {
+		tic.SetCreatedAt(*t)
+	}
+	return tic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tic *TenantInvitationCreate) SetUpdatedAt(t time.Time) *TenantInvitationCreate {
+	tic.mutation.SetUpdatedAt(t)
+	return tic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableUpdatedAt(t *time.Time) *TenantInvitationCreate {
+	if t != nil {
+		tic.SetUpdatedAt(*t)
+	}
+	return tic
+}
+
+// SetID sets the "id" field.
+func (tic *TenantInvitationCreate) SetID(i int64) *TenantInvitationCreate {
+	tic.mutation.SetID(i)
+	return tic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tic *TenantInvitationCreate) SetNillableID(i *int64) *TenantInvitationCreate {
+	if i != nil {
+		tic.SetID(*i)
+	}
+	return tic
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (tic *TenantInvitationCreate) SetTenant(t *Tenant) *TenantInvitationCreate {
+	return tic.SetTenantID(t.ID)
+}
+
+// Mutation returns the TenantInvitationMutation object of the builder.
+func (tic *TenantInvitationCreate) Mutation() *TenantInvitationMutation {
+	return tic.mutation
+}
+
+// Save creates the TenantInvitation in the database.
+func (tic *TenantInvitationCreate) Save(ctx context.Context) (*TenantInvitation, error) {
+	tic.defaults()
+	return withHooks(ctx, tic.sqlSave, tic.mutation, tic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tic *TenantInvitationCreate) SaveX(ctx context.Context) *TenantInvitation {
+	v, err := tic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tic *TenantInvitationCreate) Exec(ctx context.Context) error {
+	_, err := tic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tic *TenantInvitationCreate) ExecX(ctx context.Context) {
+	if err := tic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tic *TenantInvitationCreate) defaults() {
+	if _, ok := tic.mutation.RoleIds(); !ok {
+		v := tenantinvitation.DefaultRoleIds
+		tic.mutation.SetRoleIds(v)
+	}
+	if _, ok := tic.mutation.RoleLabels(); !ok {
+		v := tenantinvitation.DefaultRoleLabels
+		tic.mutation.SetRoleLabels(v)
+	}
+	if _, ok := tic.mutation.Status(); !ok {
+		v := tenantinvitation.DefaultStatus
+		tic.mutation.SetStatus(v)
+	}
+	if _, ok := tic.mutation.SendCount(); !ok {
+		v := tenantinvitation.DefaultSendCount
+		tic.mutation.SetSendCount(v)
+	}
+	if _, ok := tic.mutation.CreatedAt(); !ok {
+		v := tenantinvitation.DefaultCreatedAt()
+		tic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tic.mutation.UpdatedAt(); !ok {
+		v := tenantinvitation.DefaultUpdatedAt()
+		tic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tic.mutation.ID(); !ok {
+		v := tenantinvitation.DefaultID()
+		tic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tic *TenantInvitationCreate) check() error {
+	if _, ok := tic.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantInvitation.tenant_id"`)}
+	}
+	if v, ok := tic.mutation.Email(); ok {
+		if err := tenantinvitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "TenantInvitation.email": %w`, err)}
+		}
+	}
+	if v, ok := tic.mutation.Phone(); ok {
+		if err := tenantinvitation.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "TenantInvitation.phone": %w`, err)}
+		}
+	}
+	if _, ok := tic.mutation.RoleIds(); !ok {
+		return &ValidationError{Name: "role_ids", err: errors.New(`ent: missing required field "TenantInvitation.role_ids"`)}
+	}
+	if _, ok := tic.mutation.RoleLabels(); !ok {
+		return &ValidationError{Name: "role_labels", err: errors.New(`ent: missing required field "TenantInvitation.role_labels"`)}
+	}
+	if _, ok := tic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TenantInvitation.status"`)}
+	}
+	if v, ok := tic.mutation.Status(); ok {
+		if err := tenantinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := tic.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "TenantInvitation.nonce"`)}
+	}
+	if _, ok := tic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TenantInvitation.expires_at"`)}
+	}
+	if _, ok := tic.mutation.SendCount(); !ok {
+		return &ValidationError{Name: "send_count", err: errors.New(`ent: missing required field "TenantInvitation.send_count"`)}
+	}
+	if _, ok := tic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantInvitation.created_at"`)}
+	}
+	if _, ok := tic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TenantInvitation.updated_at"`)}
+	}
+	if len(tic.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TenantInvitation.tenant"`)}
+	}
+	return nil
+}
+
+func (tic *TenantInvitationCreate) sqlSave(ctx context.Context) (*TenantInvitation, error) {
+	if err := tic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tic.mutation.id = &_node.ID
+	tic.mutation.done = true
+	return _node, nil
+}
+
+func (tic *TenantInvitationCreate) createSpec() (*TenantInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantInvitation{config: tic.config}
+		_spec = sqlgraph.NewCreateSpec(tenantinvitation.Table, sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = tic.conflict
+	if id, ok := tic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tic.mutation.Email(); ok {
+		_spec.SetField(tenantinvitation.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := tic.mutation.Phone(); ok {
+		_spec.SetField(tenantinvitation.FieldPhone, field.TypeString, value)
+		_node.Phone = &value
+	}
+	if value, ok := tic.mutation.DepartmentID(); ok {
+		_spec.SetField(tenantinvitation.FieldDepartmentID, field.TypeInt64, value)
+		_node.DepartmentID = &value
+	}
+	if value, ok := tic.mutation.RoleIds(); ok {
+		_spec.SetField(tenantinvitation.FieldRoleIds, field.TypeJSON, value)
+		_node.RoleIds = value
+	}
+	if value, ok := tic.mutation.RoleLabels(); ok {
+		_spec.SetField(tenantinvitation.FieldRoleLabels, field.TypeJSON, value)
+		_node.RoleLabels = value
+	}
+	if value, ok := tic.mutation.Status(); ok {
+		_spec.SetField(tenantinvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tic.mutation.Nonce(); ok {
+		_spec.SetField(tenantinvitation.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := tic.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := tic.mutation.SendCount(); ok {
+		_spec.SetField(tenantinvitation.FieldSendCount, field.TypeInt, value)
+		_node.SendCount = value
+	}
+	if value, ok := tic.mutation.InvitedBy(); ok {
+		_spec.SetField(tenantinvitation.FieldInvitedBy, field.TypeInt64, value)
+		_node.InvitedBy = &value
+	}
+	if value, ok := tic.mutation.AcceptedBy(); ok {
+		_spec.SetField(tenantinvitation.FieldAcceptedBy, field.TypeInt64, value)
+		_node.AcceptedBy = &value
+	}
+	if value, ok := tic.mutation.AcceptedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if value, ok := tic.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tic.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tic.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantinvitation.TenantTable,
+			Columns: []string{tenantinvitation.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantInvitation.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantInvitationUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tic *TenantInvitationCreate) OnConflict(opts ...sql.ConflictOption) *TenantInvitationUpsertOne {
+	tic.conflict = opts
+	return &TenantInvitationUpsertOne{
+		create: tic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantInvitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tic *TenantInvitationCreate) OnConflictColumns(columns ...string) *TenantInvitationUpsertOne {
+	tic.conflict = append(tic.conflict, sql.ConflictColumns(columns...))
+	return &TenantInvitationUpsertOne{
+		create: tic,
+	}
+}
+
+type (
+	// TenantInvitationUpsertOne is the builder for "upsert"-ing
+	//  one TenantInvitation node.
+	TenantInvitationUpsertOne struct {
+		create *TenantInvitationCreate
+	}
+
+	// TenantInvitationUpsert is the "OnConflict" setter.
+	TenantInvitationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *TenantInvitationUpsert) SetStatus(v tenantinvitation.Status) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateStatus() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldStatus)
+	return u
+}
+
+// SetNonce sets the "nonce" field.
+func (u *TenantInvitationUpsert) SetNonce(v string) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldNonce, v)
+	return u
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateNonce() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldNonce)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TenantInvitationUpsert) SetExpiresAt(v time.Time) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateExpiresAt() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldExpiresAt)
+	return u
+}
+
+// SetSendCount sets the "send_count" field.
+func (u *TenantInvitationUpsert) SetSendCount(v int) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldSendCount, v)
+	return u
+}
+
+// UpdateSendCount sets the "send_count" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateSendCount() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldSendCount)
+	return u
+}
+
+// AddSendCount adds v to the "send_count" field.
+func (u *TenantInvitationUpsert) AddSendCount(v int) *TenantInvitationUpsert {
+	u.Add(tenantinvitation.FieldSendCount, v)
+	return u
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *TenantInvitationUpsert) SetAcceptedBy(v int64) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldAcceptedBy, v)
+	return u
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateAcceptedBy() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldAcceptedBy)
+	return u
+}
+
+// AddAcceptedBy adds v to the "accepted_by" field.
+func (u *TenantInvitationUpsert) AddAcceptedBy(v int64) *TenantInvitationUpsert {
+	u.Add(tenantinvitation.FieldAcceptedBy, v)
+	return u
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *TenantInvitationUpsert) ClearAcceptedBy() *TenantInvitationUpsert {
+	u.SetNull(tenantinvitation.FieldAcceptedBy)
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *TenantInvitationUpsert) SetAcceptedAt(v time.Time) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateAcceptedAt() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *TenantInvitationUpsert) ClearAcceptedAt() *TenantInvitationUpsert {
+	u.SetNull(tenantinvitation.FieldAcceptedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantInvitationUpsert) SetUpdatedAt(v time.Time) *TenantInvitationUpsert {
+	u.Set(tenantinvitation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsert) UpdateUpdatedAt() *TenantInvitationUpsert {
+	u.SetExcluded(tenantinvitation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantInvitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantinvitation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantInvitationUpsertOne) UpdateNewValues() *TenantInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantinvitation.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantinvitation.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Email(); exists {
+			s.SetIgnore(tenantinvitation.FieldEmail)
+		}
+		if _, exists := u.create.mutation.Phone(); exists {
+			s.SetIgnore(tenantinvitation.FieldPhone)
+		}
+		if _, exists := u.create.mutation.DepartmentID(); exists {
+			s.SetIgnore(tenantinvitation.FieldDepartmentID)
+		}
+		if _, exists := u.create.mutation.RoleIds(); exists {
+			s.SetIgnore(tenantinvitation.FieldRoleIds)
+		}
+		if _, exists := u.create.mutation.RoleLabels(); exists {
+			s.SetIgnore(tenantinvitation.FieldRoleLabels)
+		}
+		if _, exists := u.create.mutation.InvitedBy(); exists {
+			s.SetIgnore(tenantinvitation.FieldInvitedBy)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantinvitation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantInvitation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantInvitationUpsertOne) Ignore() *TenantInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantInvitationUpsertOne) DoNothing() *TenantInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantInvitationCreate.OnConflict
+// documentation for more info.
+func (u *TenantInvitationUpsertOne) Update(set func(*TenantInvitationUpsert)) *TenantInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantInvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantInvitationUpsertOne) SetStatus(v tenantinvitation.Status) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateStatus() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNonce sets the "nonce" field.
+func (u *TenantInvitationUpsertOne) SetNonce(v string) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetNonce(v)
+	})
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateNonce() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateNonce()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TenantInvitationUpsertOne) SetExpiresAt(v time.Time) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateExpiresAt() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetSendCount sets the "send_count" field.
+func (u *TenantInvitationUpsertOne) SetSendCount(v int) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetSendCount(v)
+	})
+}
+
+// AddSendCount adds v to the "send_count" field.
+func (u *TenantInvitationUpsertOne) AddSendCount(v int) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.AddSendCount(v)
+	})
+}
+
+// UpdateSendCount sets the "send_count" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateSendCount() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateSendCount()
+	})
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *TenantInvitationUpsertOne) SetAcceptedBy(v int64) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetAcceptedBy(v)
+	})
+}
+
+// AddAcceptedBy adds v to the "accepted_by" field.
+func (u *TenantInvitationUpsertOne) AddAcceptedBy(v int64) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.AddAcceptedBy(v)
+	})
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateAcceptedBy() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateAcceptedBy()
+	})
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *TenantInvitationUpsertOne) ClearAcceptedBy() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.ClearAcceptedBy()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *TenantInvitationUpsertOne) SetAcceptedAt(v time.Time) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateAcceptedAt() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *TenantInvitationUpsertOne) ClearAcceptedAt() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantInvitationUpsertOne) SetUpdatedAt(v time.Time) *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsertOne) UpdateUpdatedAt() *TenantInvitationUpsertOne {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantInvitationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantInvitationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantInvitationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantInvitationUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantInvitationUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantInvitationCreateBulk is the builder for creating many TenantInvitation entities in bulk.
+type TenantInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*TenantInvitationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantInvitation entities in the database.
+func (ticb *TenantInvitationCreateBulk) Save(ctx context.Context) ([]*TenantInvitation, error) {
+	if ticb.err != nil {
+		return nil, ticb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ticb.builders))
+	nodes := make([]*TenantInvitation, len(ticb.builders))
+	mutators := make([]Mutator, len(ticb.builders))
+	for i := range ticb.builders {
+		func(i int, root context.Context) {
+			builder := ticb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ticb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ticb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ticb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ticb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ticb *TenantInvitationCreateBulk) SaveX(ctx context.Context) []*TenantInvitation {
+	v, err := ticb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ticb *TenantInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ticb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ticb *TenantInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := ticb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantInvitation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantInvitationUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (ticb *TenantInvitationCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantInvitationUpsertBulk {
+	ticb.conflict = opts
+	return &TenantInvitationUpsertBulk{
+		create: ticb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantInvitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ticb *TenantInvitationCreateBulk) OnConflictColumns(columns ...string) *TenantInvitationUpsertBulk {
+	ticb.conflict = append(ticb.conflict, sql.ConflictColumns(columns...))
+	return &TenantInvitationUpsertBulk{
+		create: ticb,
+	}
+}
+
+// TenantInvitationUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantInvitation nodes.
+type TenantInvitationUpsertBulk struct {
+	create *TenantInvitationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantInvitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantinvitation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantInvitationUpsertBulk) UpdateNewValues() *TenantInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantinvitation.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantinvitation.FieldTenantID)
+			}
+			if _, exists := b.mutation.Email(); exists {
+				s.SetIgnore(tenantinvitation.FieldEmail)
+			}
+			if _, exists := b.mutation.Phone(); exists {
+				s.SetIgnore(tenantinvitation.FieldPhone)
+			}
+			if _, exists := b.mutation.DepartmentID(); exists {
+				s.SetIgnore(tenantinvitation.FieldDepartmentID)
+			}
+			if _, exists := b.mutation.RoleIds(); exists {
+				s.SetIgnore(tenantinvitation.FieldRoleIds)
+			}
+			if _, exists := b.mutation.RoleLabels(); exists {
+				s.SetIgnore(tenantinvitation.FieldRoleLabels)
+			}
+			if _, exists := b.mutation.InvitedBy(); exists {
+				s.SetIgnore(tenantinvitation.FieldInvitedBy)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantinvitation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantInvitation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantInvitationUpsertBulk) Ignore() *TenantInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantInvitationUpsertBulk) DoNothing() *TenantInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantInvitationCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantInvitationUpsertBulk) Update(set func(*TenantInvitationUpsert)) *TenantInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantInvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantInvitationUpsertBulk) SetStatus(v tenantinvitation.Status) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateStatus() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNonce sets the "nonce" field.
+func (u *TenantInvitationUpsertBulk) SetNonce(v string) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetNonce(v)
+	})
+}
+
+// UpdateNonce sets the "nonce" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateNonce() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateNonce()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TenantInvitationUpsertBulk) SetExpiresAt(v time.Time) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateExpiresAt() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetSendCount sets the "send_count" field.
+func (u *TenantInvitationUpsertBulk) SetSendCount(v int) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetSendCount(v)
+	})
+}
+
+// AddSendCount adds v to the "send_count" field.
+func (u *TenantInvitationUpsertBulk) AddSendCount(v int) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.AddSendCount(v)
+	})
+}
+
+// UpdateSendCount sets the "send_count" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateSendCount() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateSendCount()
+	})
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *TenantInvitationUpsertBulk) SetAcceptedBy(v int64) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetAcceptedBy(v)
+	})
+}
+
+// AddAcceptedBy adds v to the "accepted_by" field.
+func (u *TenantInvitationUpsertBulk) AddAcceptedBy(v int64) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.AddAcceptedBy(v)
+	})
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateAcceptedBy() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateAcceptedBy()
+	})
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *TenantInvitationUpsertBulk) ClearAcceptedBy() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.ClearAcceptedBy()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *TenantInvitationUpsertBulk) SetAcceptedAt(v time.Time) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateAcceptedAt() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *TenantInvitationUpsertBulk) ClearAcceptedAt() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantInvitationUpsertBulk) SetUpdatedAt(v time.Time) *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantInvitationUpsertBulk) UpdateUpdatedAt() *TenantInvitationUpsertBulk {
+	return u.Update(func(s *TenantInvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantInvitationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantInvitationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantInvitationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantInvitationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
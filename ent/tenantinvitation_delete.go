@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+)
+
+// TenantInvitationDelete is the builder for deleting a TenantInvitation entity.
+type TenantInvitationDelete struct {
+	config
+	hooks    []Hook
+	mutation *TenantInvitationMutation
+}
+
+// Where appends a list predicates to the TenantInvitationDelete builder.
+func (tid *TenantInvitationDelete) Where(ps ...predicate.TenantInvitation) *TenantInvitationDelete {
+	tid.mutation.Where(ps...)
+	return tid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tid *TenantInvitationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tid.sqlExec, tid.mutation, tid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tid *TenantInvitationDelete) ExecX(ctx context.Context) int {
+	n, err := tid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tid *TenantInvitationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tenantinvitation.Table, sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64))
+	if ps := tid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tid.mutation.done = true
+	return affected, err
+}
+
+// TenantInvitationDeleteOne is the builder for deleting a single TenantInvitation entity.
+type TenantInvitationDeleteOne struct {
+	tid *TenantInvitationDelete
+}
+
+// Where appends a list predicates to the TenantInvitationDelete builder.
+func (tido *TenantInvitationDeleteOne) Where(ps ...predicate.TenantInvitation) *TenantInvitationDeleteOne {
+	tido.tid.mutation.Where(ps...)
+	return tido
+}
+
+// Exec executes the deletion query.
+func (tido *TenantInvitationDeleteOne) Exec(ctx context.Context) error {
+	n, err := tido.tid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tenantinvitation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tido *TenantInvitationDeleteOne) ExecX(ctx context.Context) {
+	if err := tido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+)
+
+// TenantInvitationQuery is the builder for querying TenantInvitation entities.
+type TenantInvitationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tenantinvitation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TenantInvitation
+	withTenant *TenantQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TenantInvitationQuery builder.
+func (tiq *TenantInvitationQuery) Where(ps ...predicate.TenantInvitation) *TenantInvitationQuery {
+	tiq.predicates = append(tiq.predicates, ps...)
+	return tiq
+}
+
+// Limit the number of records to be returned by this query.
+func (tiq *TenantInvitationQuery) Limit(limit int) *TenantInvitationQuery {
+	tiq.ctx.Limit = &limit
+	return tiq
+}
+
+// Offset to start from.
+func (tiq *TenantInvitationQuery) Offset(offset int) *TenantInvitationQuery {
+	tiq.ctx.Offset = &offset
+	return tiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tiq *TenantInvitationQuery) Unique(unique bool) *TenantInvitationQuery {
+	tiq.ctx.Unique = &unique
+	return tiq
+}
+
+// Order specifies how the records should be ordered.
+func (tiq *TenantInvitationQuery) Order(o ...tenantinvitation.OrderOption) *TenantInvitationQuery {
+	tiq.order = append(tiq.order, o...)
+	return tiq
+}
+
+// QueryTenant chains the current query on the "tenant" edge.
+func (tiq *TenantInvitationQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: tiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenantinvitation.Table, tenantinvitation.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenantinvitation.TenantTable, tenantinvitation.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TenantInvitation entity from the query.
+// Returns a *NotFoundError when no TenantInvitation was found.
+func (tiq *TenantInvitationQuery) First(ctx context.Context) (*TenantInvitation, error) {
+	nodes, err := tiq.Limit(1).All(setContextOp(ctx, tiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tenantinvitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) FirstX(ctx context.Context) *TenantInvitation {
+	node, err := tiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TenantInvitation ID from the query.
+// Returns a *NotFoundError when no TenantInvitation ID was found.
+func (tiq *TenantInvitationQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tiq.Limit(1).IDs(setContextOp(ctx, tiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tenantinvitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := tiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TenantInvitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TenantInvitation entity is found.
+// Returns a *NotFoundError when no TenantInvitation entities are found.
+func (tiq *TenantInvitationQuery) Only(ctx context.Context) (*TenantInvitation, error) {
+	nodes, err := tiq.Limit(2).All(setContextOp(ctx, tiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tenantinvitation.Label}
+	default:
+		return nil, &NotSingularError{tenantinvitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) OnlyX(ctx context.Context) *TenantInvitation {
+	node, err := tiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TenantInvitation ID in the query.
+// Returns a *NotSingularError when more than one TenantInvitation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tiq *TenantInvitationQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tiq.Limit(2).IDs(setContextOp(ctx, tiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tenantinvitation.Label}
+	default:
+		err = &NotSingularError{tenantinvitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := tiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TenantInvitations.
+func (tiq *TenantInvitationQuery) All(ctx context.Context) ([]*TenantInvitation, error) {
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryAll)
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TenantInvitation, *TenantInvitationQuery]()
+	return withInterceptors[[]*TenantInvitation](ctx, tiq, qr, tiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) AllX(ctx context.Context) []*TenantInvitation {
+	nodes, err := tiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TenantInvitation IDs.
+func (tiq *TenantInvitationQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if tiq.ctx.Unique == nil && tiq.path != nil {
+		tiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryIDs)
+	if err = tiq.Select(tenantinvitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := tiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tiq *TenantInvitationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryCount)
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tiq, querierCount[*TenantInvitationQuery](), tiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) CountX(ctx context.Context) int {
+	count, err := tiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tiq *TenantInvitationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryExist)
+	switch _, err := tiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tiq *TenantInvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := tiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TenantInvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tiq *TenantInvitationQuery) Clone() *TenantInvitationQuery {
+	if tiq == nil {
+		return nil
+	}
+	return &TenantInvitationQuery{
+		config:     tiq.config,
+		ctx:        tiq.ctx.Clone(),
+		order:      append([]tenantinvitation.OrderOption{}, tiq.order...),
+		inters:     append([]Interceptor{}, tiq.inters...),
+		predicates: append([]predicate.TenantInvitation{}, tiq.predicates...),
+		withTenant: tiq.withTenant.Clone(),
+		// clone intermediate query.
+		sql:  tiq.sql.Clone(),
+		path: tiq.path,
+	}
+}
+
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TenantInvitationQuery) WithTenant(opts ...func(*TenantQuery)) *TenantInvitationQuery {
+	query := (&TenantClient{config: tiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withTenant = query
+	return tiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TenantInvitation.Query().
+//		GroupBy(tenantinvitation.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tiq *TenantInvitationQuery) GroupBy(field string, fields ...string) *TenantInvitationGroupBy {
+	tiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TenantInvitationGroupBy{build: tiq}
+	grbuild.flds = &tiq.ctx.Fields
+	grbuild.label = tenantinvitation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TenantInvitation.Query().
+//		Select(tenantinvitation.FieldTenantID).
+//		Scan(ctx, &v)
+func (tiq *TenantInvitationQuery) Select(fields ...string) *TenantInvitationSelect {
+	tiq.ctx.Fields = append(tiq.ctx.Fields, fields...)
+	sbuild := &TenantInvitationSelect{TenantInvitationQuery: tiq}
+	sbuild.label = tenantinvitation.Label
+	sbuild.flds, sbuild.scan = &tiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TenantInvitationSelect configured with the given aggregations.
+func (tiq *TenantInvitationQuery) Aggregate(fns ...AggregateFunc) *TenantInvitationSelect {
+	return tiq.Select().Aggregate(fns...)
+}
+
+func (tiq *TenantInvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tiq.ctx.Fields {
+		if !tenantinvitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tiq.path != nil {
+		prev, err := tiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tiq.sql = prev
+	}
+	return nil
+}
+
+func (tiq *TenantInvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TenantInvitation, error) {
+	var (
+		nodes       = []*TenantInvitation{}
+		_spec       = tiq.querySpec()
+		loadedTypes = [1]bool{
+			tiq.withTenant != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TenantInvitation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TenantInvitation{config: tiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tiq.modifiers) > 0 {
+		_spec.Modifiers = tiq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tiq.withTenant; query != nil {
+		if err := tiq.loadTenant(ctx, query, nodes, nil,
+			func(n *TenantInvitation, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tiq *TenantInvitationQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*TenantInvitation, init func(*TenantInvitation), assign func(*TenantInvitation, *Tenant)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*TenantInvitation)
+	for i := range nodes {
+		fk := nodes[i].TenantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tiq *TenantInvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tiq.querySpec()
+	if len(tiq.modifiers) > 0 {
+		_spec.Modifiers = tiq.modifiers
+	}
+	_spec.Node.Columns = tiq.ctx.Fields
+	if len(tiq.ctx.Fields) > 0 {
+		_spec.Unique = tiq.ctx.Unique != nil && *tiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tiq.driver, _spec)
+}
+
+func (tiq *TenantInvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tenantinvitation.Table, tenantinvitation.Columns, sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64))
+	_spec.From = tiq.sql
+	if unique := tiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantinvitation.FieldID)
+		for i := range fields {
+			if fields[i] != tenantinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tiq.withTenant != nil {
+			_spec.Node.AddColumnOnce(tenantinvitation.FieldTenantID)
+		}
+	}
+	if ps := tiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tiq *TenantInvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tiq.driver.Dialect())
+	t1 := builder.Table(tenantinvitation.Table)
+	columns := tiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tenantinvitation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tiq.sql != nil {
+		selector = tiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tiq.ctx.Unique != nil && *tiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tiq.modifiers {
+		m(selector)
+	}
+	for _, p := range tiq.predicates {
+		p(selector)
+	}
+	for _, p := range tiq.order {
+		p(selector)
+	}
+	if offset := tiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tiq *TenantInvitationQuery) ForUpdate(opts ...sql.LockOption) *TenantInvitationQuery {
+	if tiq.driver.Dialect() == dialect.Postgres {
+		tiq.Unique(false)
+	}
+	tiq.modifiers = append(tiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tiq *TenantInvitationQuery) ForShare(opts ...sql.LockOption) *TenantInvitationQuery {
+	if tiq.driver.Dialect() == dialect.Postgres {
+		tiq.Unique(false)
+	}
+	tiq.modifiers = append(tiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tiq
+}
+
+// TenantInvitationGroupBy is the group-by builder for TenantInvitation entities.
+type TenantInvitationGroupBy struct {
+	selector
+	build *TenantInvitationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tigb *TenantInvitationGroupBy) Aggregate(fns ...AggregateFunc) *TenantInvitationGroupBy {
+	tigb.fns = append(tigb.fns, fns...)
+	return tigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tigb *TenantInvitationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tigb.build.ctx, ent.OpQueryGroupBy)
+	if err := tigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantInvitationQuery, *TenantInvitationGroupBy](ctx, tigb.build, tigb, tigb.build.inters, v)
+}
+
+func (tigb *TenantInvitationGroupBy) sqlScan(ctx context.Context, root *TenantInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tigb.fns))
+	for _, fn := range tigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tigb.flds)+len(tigb.fns))
+		for _, f := range *tigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TenantInvitationSelect is the builder for selecting fields of TenantInvitation entities.
+type TenantInvitationSelect struct {
+	*TenantInvitationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tis *TenantInvitationSelect) Aggregate(fns ...AggregateFunc) *TenantInvitationSelect {
+	tis.fns = append(tis.fns, fns...)
+	return tis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tis *TenantInvitationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tis.ctx, ent.OpQuerySelect)
+	if err := tis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantInvitationQuery, *TenantInvitationSelect](ctx, tis.TenantInvitationQuery, tis, tis.inters, v)
+}
+
+func (tis *TenantInvitationSelect) sqlScan(ctx context.Context, root *TenantInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tis.fns))
+	for _, fn := range tis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantinvitation"
+)
+
+// TenantInvitationUpdate is the builder for updating TenantInvitation entities.
+type TenantInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantInvitationMutation
+}
+
+// Where appends a list predicates to the TenantInvitationUpdate builder.
+func (tiu *TenantInvitationUpdate) Where(ps ...predicate.TenantInvitation) *TenantInvitationUpdate {
+	tiu.mutation.Where(ps...)
+	return tiu
+}
+
+// SetStatus sets the "status" field.
+func (tiu *TenantInvitationUpdate) SetStatus(t tenantinvitation.Status) *TenantInvitationUpdate {
+	tiu.mutation.SetStatus(t)
+	return tiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tiu *TenantInvitationUpdate) SetNillableStatus(t *tenantinvitation.Status) *TenantInvitationUpdate {
+	if t != nil {
+		tiu.SetStatus(*t)
+	}
+	return tiu
+}
+
+// SetNonce sets the "nonce" field.
+func (tiu *TenantInvitationUpdate) SetNonce(s string) *TenantInvitationUpdate {
+	tiu.mutation.SetNonce(s)
+	return tiu
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (tiu *TenantInvitationUpdate) SetNillableNonce(s *string) *TenantInvitationUpdate {
+	if s != nil {
+		tiu.SetNonce(*s)
+	}
+	return tiu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tiu *TenantInvitationUpdate) SetExpiresAt(t time.Time) *TenantInvitationUpdate {
+	tiu.mutation.SetExpiresAt(t)
+	return tiu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tiu *TenantInvitationUpdate) SetNillableExpiresAt(t *time.Time) *TenantInvitationUpdate {
+	if t != nil {
+		tiu.SetExpiresAt(*t)
+	}
+	return tiu
+}
+
+// SetSendCount sets the "send_count" field.
+func (tiu *TenantInvitationUpdate) SetSendCount(i int) *TenantInvitationUpdate {
+	tiu.mutation.ResetSendCount()
+	tiu.mutation.SetSendCount(i)
+	return tiu
+}
+
+// SetNillableSendCount sets the "send_count" field if the given value is not nil.
+func (tiu *TenantInvitationUpdate) SetNillableSendCount(i *int) *TenantInvitationUpdate {
+	if i != nil {
+		tiu.SetSendCount(*i)
+	}
+	return tiu
+}
+
+// AddSendCount adds i to the "send_count" field.
+func (tiu *TenantInvitationUpdate) AddSendCount(i int) *TenantInvitationUpdate {
+	tiu.mutation.AddSendCount(i)
+	return tiu
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (tiu *TenantInvitationUpdate) SetAcceptedBy(i int64) *TenantInvitationUpdate {
+	tiu.mutation.ResetAcceptedBy()
+	tiu.mutation.SetAcceptedBy(i)
+	return tiu
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (tiu *TenantInvitationUpdate) SetNillableAcceptedBy(i *int64) *TenantInvitationUpdate {
+	if i != nil {
+		tiu.SetAcceptedBy(*i)
+	}
+	return tiu
+}
+
+// AddAcceptedBy adds i to the "accepted_by" field.
+func (tiu *TenantInvitationUpdate) AddAcceptedBy(i int64) *TenantInvitationUpdate {
+	tiu.mutation.AddAcceptedBy(i)
+	return tiu
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (tiu *TenantInvitationUpdate) ClearAcceptedBy() *TenantInvitationUpdate {
+	tiu.mutation.ClearAcceptedBy()
+	return tiu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (tiu *TenantInvitationUpdate) SetAcceptedAt(t time.Time) *TenantInvitationUpdate {
+	tiu.mutation.SetAcceptedAt(t)
+	return tiu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (tiu *TenantInvitationUpdate) SetNillableAcceptedAt(t *time.Time) *TenantInvitationUpdate {
+	if t != nil {
+		tiu.SetAcceptedAt(*t)
+	}
+	return tiu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (tiu *TenantInvitationUpdate) ClearAcceptedAt() *TenantInvitationUpdate {
+	tiu.mutation.ClearAcceptedAt()
+	return tiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiu *TenantInvitationUpdate) SetUpdatedAt(t time.Time) *TenantInvitationUpdate {
+	tiu.mutation.SetUpdatedAt(t)
+	return tiu
+}
+
+// Mutation returns the TenantInvitationMutation object of the builder.
+func (tiu *TenantInvitationUpdate) Mutation() *TenantInvitationMutation {
+	return tiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tiu *TenantInvitationUpdate) Save(ctx context.Context) (int, error) {
+	tiu.defaults()
+	return withHooks(ctx, tiu.sqlSave, tiu.mutation, tiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiu *TenantInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := tiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tiu *TenantInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := tiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiu *TenantInvitationUpdate) ExecX(ctx context.Context) {
+	if err := tiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tiu *TenantInvitationUpdate) defaults() {
+	if _, ok := tiu.mutation.UpdatedAt(); !ok {
+		v := tenantinvitation.UpdateDefaultUpdatedAt()
+		tiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tiu *TenantInvitationUpdate) check() error {
+	if v, ok := tiu.mutation.Status(); ok {
+		if err := tenantinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantInvitation.status": %w`, err)}
+		}
+	}
+	if tiu.mutation.TenantCleared() && len(tiu.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantInvitation.tenant"`)
+	}
+	return nil
+}
+
+func (tiu *TenantInvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinvitation.Table, tenantinvitation.Columns, sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64))
+	if ps := tiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tiu.mutation.EmailCleared() {
+		_spec.ClearField(tenantinvitation.FieldEmail, field.TypeString)
+	}
+	if tiu.mutation.PhoneCleared() {
+		_spec.ClearField(tenantinvitation.FieldPhone, field.TypeString)
+	}
+	if tiu.mutation.DepartmentIDCleared() {
+		_spec.ClearField(tenantinvitation.FieldDepartmentID, field.TypeInt64)
+	}
+	if value, ok := tiu.mutation.Status(); ok {
+		_spec.SetField(tenantinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tiu.mutation.Nonce(); ok {
+		_spec.SetField(tenantinvitation.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := tiu.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := tiu.mutation.SendCount(); ok {
+		_spec.SetField(tenantinvitation.FieldSendCount, field.TypeInt, value)
+	}
+	if value, ok := tiu.mutation.AddedSendCount(); ok {
+		_spec.AddField(tenantinvitation.FieldSendCount, field.TypeInt, value)
+	}
+	if tiu.mutation.InvitedByCleared() {
+		_spec.ClearField(tenantinvitation.FieldInvitedBy, field.TypeInt64)
+	}
+	if value, ok := tiu.mutation.AcceptedBy(); ok {
+		_spec.SetField(tenantinvitation.FieldAcceptedBy, field.TypeInt64, value)
+	}
+	if value, ok := tiu.mutation.AddedAcceptedBy(); ok {
+		_spec.AddField(tenantinvitation.FieldAcceptedBy, field.TypeInt64, value)
+	}
+	if tiu.mutation.AcceptedByCleared() {
+		_spec.ClearField(tenantinvitation.FieldAcceptedBy, field.TypeInt64)
+	}
+	if value, ok := tiu.mutation.AcceptedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if tiu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(tenantinvitation.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := tiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tiu.mutation.done = true
+	return n, nil
+}
+
+// TenantInvitationUpdateOne is the builder for updating a single TenantInvitation entity.
+type TenantInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantInvitationMutation
+}
+
+// SetStatus sets the "status" field.
+func (tiuo *TenantInvitationUpdateOne) SetStatus(t tenantinvitation.Status) *TenantInvitationUpdateOne {
+	tiuo.mutation.SetStatus(t)
+	return tiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tiuo *TenantInvitationUpdateOne) SetNillableStatus(t *tenantinvitation.Status) *TenantInvitationUpdateOne {
+	if t != nil {
+		tiuo.SetStatus(*t)
+	}
+	return tiuo
+}
+
+// SetNonce sets the "nonce" field.
+func (tiuo *TenantInvitationUpdateOne) SetNonce(s string) *TenantInvitationUpdateOne {
+	tiuo.mutation.SetNonce(s)
+	return tiuo
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (tiuo *TenantInvitationUpdateOne) SetNillableNonce(s *string) *TenantInvitationUpdateOne {
+	if s != nil {
+		tiuo.SetNonce(*s)
+	}
+	return tiuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tiuo *TenantInvitationUpdateOne) SetExpiresAt(t time.Time) *TenantInvitationUpdateOne {
+	tiuo.mutation.SetExpiresAt(t)
+	return tiuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tiuo *TenantInvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *TenantInvitationUpdateOne {
+	if t != nil {
+		tiuo.SetExpiresAt(*t)
+	}
+	return tiuo
+}
+
+// SetSendCount sets the "send_count" field.
+func (tiuo *TenantInvitationUpdateOne) SetSendCount(i int) *TenantInvitationUpdateOne {
+	tiuo.mutation.ResetSendCount()
+	tiuo.mutation.SetSendCount(i)
+	return tiuo
+}
+
+// SetNillableSendCount sets the "send_count" field if the given value is not nil.
+func (tiuo *TenantInvitationUpdateOne) SetNillableSendCount(i *int) *TenantInvitationUpdateOne {
+	if i != nil {
+		tiuo.SetSendCount(*i)
+	}
+	return tiuo
+}
+
+// AddSendCount adds i to the "send_count" field.
+func (tiuo *TenantInvitationUpdateOne) AddSendCount(i int) *TenantInvitationUpdateOne {
+	tiuo.mutation.AddSendCount(i)
+	return tiuo
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (tiuo *TenantInvitationUpdateOne) SetAcceptedBy(i int64) *TenantInvitationUpdateOne {
+	tiuo.mutation.ResetAcceptedBy()
+	tiuo.mutation.SetAcceptedBy(i)
+	return tiuo
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (tiuo *TenantInvitationUpdateOne) SetNillableAcceptedBy(i *int64) *TenantInvitationUpdateOne {
+	if i != nil {
+		tiuo.SetAcceptedBy(*i)
+	}
+	return tiuo
+}
+
+// AddAcceptedBy adds i to the "accepted_by" field.
+func (tiuo *TenantInvitationUpdateOne) AddAcceptedBy(i int64) *TenantInvitationUpdateOne {
+	tiuo.mutation.AddAcceptedBy(i)
+	return tiuo
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (tiuo *TenantInvitationUpdateOne) ClearAcceptedBy() *TenantInvitationUpdateOne {
+	tiuo.mutation.ClearAcceptedBy()
+	return tiuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (tiuo *TenantInvitationUpdateOne) SetAcceptedAt(t time.Time) *TenantInvitationUpdateOne {
+	tiuo.mutation.SetAcceptedAt(t)
+	return tiuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (tiuo *TenantInvitationUpdateOne) SetNillableAcceptedAt(t *time.Time) *TenantInvitationUpdateOne {
+	if t != nil {
+		tiuo.SetAcceptedAt(*t)
+	}
+	return tiuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (tiuo *TenantInvitationUpdateOne) ClearAcceptedAt() *TenantInvitationUpdateOne {
+	tiuo.mutation.ClearAcceptedAt()
+	return tiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiuo *TenantInvitationUpdateOne) SetUpdatedAt(t time.Time) *TenantInvitationUpdateOne {
+	tiuo.mutation.SetUpdatedAt(t)
+	return tiuo
+}
+
+// Mutation returns the TenantInvitationMutation object of the builder.
+func (tiuo *TenantInvitationUpdateOne) Mutation() *TenantInvitationMutation {
+	return tiuo.mutation
+}
+
+// Where appends a list predicates to the TenantInvitationUpdate builder.
+func (tiuo *TenantInvitationUpdateOne) Where(ps ...predicate.TenantInvitation) *TenantInvitationUpdateOne {
+	tiuo.mutation.Where(ps...)
+	return tiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tiuo *TenantInvitationUpdateOne) Select(field string, fields ...string) *TenantInvitationUpdateOne {
+	tiuo.fields = append([]string{field}, fields...)
+	return tiuo
+}
+
+// Save executes the query and returns the updated TenantInvitation entity.
+func (tiuo *TenantInvitationUpdateOne) Save(ctx context.Context) (*TenantInvitation, error) {
+	tiuo.defaults()
+	return withHooks(ctx, tiuo.sqlSave, tiuo.mutation, tiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiuo *TenantInvitationUpdateOne) SaveX(ctx context.Context) *TenantInvitation {
+	node, err := tiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tiuo *TenantInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := tiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiuo *TenantInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := tiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tiuo *TenantInvitationUpdateOne) defaults() {
+	if _, ok := tiuo.mutation.UpdatedAt(); !ok {
+		v := tenantinvitation.UpdateDefaultUpdatedAt()
+		tiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tiuo *TenantInvitationUpdateOne) check() error {
+	if v, ok := tiuo.mutation.Status(); ok {
+		if err := tenantinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantInvitation.status": %w`, err)}
+		}
+	}
+	if tiuo.mutation.TenantCleared() && len(tiuo.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantInvitation.tenant"`)
+	}
+	return nil
+}
+
+func (tiuo *TenantInvitationUpdateOne) sqlSave(ctx context.Context) (_node *TenantInvitation, err error) {
+	if err := tiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantinvitation.Table, tenantinvitation.Columns, sqlgraph.NewFieldSpec(tenantinvitation.FieldID, field.TypeInt64))
+	id, ok := tiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantinvitation.FieldID)
+		for _, f := range fields {
+			if !tenantinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tiuo.mutation.EmailCleared() {
+		_spec.ClearField(tenantinvitation.FieldEmail, field.TypeString)
+	}
+	if tiuo.mutation.PhoneCleared() {
+		_spec.ClearField(tenantinvitation.FieldPhone, field.TypeString)
+	}
+	if tiuo.mutation.DepartmentIDCleared() {
+		_spec.ClearField(tenantinvitation.FieldDepartmentID, field.TypeInt64)
+	}
+	if value, ok := tiuo.mutation.Status(); ok {
+		_spec.SetField(tenantinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tiuo.mutation.Nonce(); ok {
+		_spec.SetField(tenantinvitation.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := tiuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := tiuo.mutation.SendCount(); ok {
+		_spec.SetField(tenantinvitation.FieldSendCount, field.TypeInt, value)
+	}
+	if value, ok := tiuo.mutation.AddedSendCount(); ok {
+		_spec.AddField(tenantinvitation.FieldSendCount, field.TypeInt, value)
+	}
+	if tiuo.mutation.InvitedByCleared() {
+		_spec.ClearField(tenantinvitation.FieldInvitedBy, field.TypeInt64)
+	}
+	if value, ok := tiuo.mutation.AcceptedBy(); ok {
+		_spec.SetField(tenantinvitation.FieldAcceptedBy, field.TypeInt64, value)
+	}
+	if value, ok := tiuo.mutation.AddedAcceptedBy(); ok {
+		_spec.AddField(tenantinvitation.FieldAcceptedBy, field.TypeInt64, value)
+	}
+	if tiuo.mutation.AcceptedByCleared() {
+		_spec.ClearField(tenantinvitation.FieldAcceptedBy, field.TypeInt64)
+	}
+	if value, ok := tiuo.mutation.AcceptedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if tiuo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(tenantinvitation.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := tiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TenantInvitation{config: tiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+)
+
+// TenantSetting is the model entity for the TenantSetting schema.
+type TenantSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary Key ID
+	ID int64 `json:"id,omitempty"`
+	// 租户ID
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// 设置项
+	Key string `json:"key,omitempty"`
+	// 设置值，JSON编码
+	Value jsontext.Value `json:"value,omitempty"`
+	// 最后修改人ID
+	UpdatedBy *int64 `json:"updated_by,omitempty"`
+	// Creation timestamp of this record
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last update timestamp of this record
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TenantSettingQuery when eager-loading is set.
+	Edges        TenantSettingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TenantSettingEdges holds the relations/edges for other nodes in the graph.
+type TenantSettingEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantSettingEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantsetting.FieldValue:
+			values[i] = new([]byte)
+		case tenantsetting.FieldID, tenantsetting.FieldTenantID, tenantsetting.FieldUpdatedBy:
+			values[i] = new(sql.NullInt64)
+		case tenantsetting.FieldKey:
+			values[i] = new(sql.NullString)
+		case tenantsetting.FieldCreatedAt, tenantsetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantSetting fields.
+func (ts *TenantSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantsetting.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ts.ID = int64(value.Int64)
+		case tenantsetting.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				ts.TenantID = value.Int64
+			}
+		case tenantsetting.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ts.Key = value.String
+			}
+		case tenantsetting.FieldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ts.Value); err != nil {
+					return fmt.Errorf("unmarshal field value: %w", err)
+				}
+			}
+		case tenantsetting.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ts.UpdatedBy = new(int64)
+				*ts.UpdatedBy = value.Int64
+			}
+		case tenantsetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		case tenantsetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ts.UpdatedAt = value.Time
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the TenantSetting.
+// This includes values selected through modifiers, order, etc.
+func (ts *TenantSetting) GetValue(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TenantSetting entity.
+func (ts *TenantSetting) QueryTenant() *TenantQuery {
+	return NewTenantSettingClient(ts.config).QueryTenant(ts)
+}
+
+// Update returns a builder for updating this TenantSetting.
+// Note that you need to call TenantSetting.Unwrap() before calling this method if this TenantSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TenantSetting) Update() *TenantSettingUpdateOne {
+	return NewTenantSettingClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TenantSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TenantSetting) Unwrap() *TenantSetting {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantSetting is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TenantSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", ts.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(ts.Key)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", ts.Value))
+	builder.WriteString(", ")
+	if v := ts.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ts.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantSettings is a parsable slice of TenantSetting.
+type TenantSettings []*TenantSetting
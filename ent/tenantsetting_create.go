@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+)
+
+// TenantSettingCreate is the builder for creating a TenantSetting entity.
+type TenantSettingCreate struct {
+	config
+	mutation *TenantSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tsc *TenantSettingCreate) SetTenantID(i int64) *TenantSettingCreate {
+	tsc.mutation.SetTenantID(i)
+	return tsc
+}
+
+// SetKey sets the "key" field.
+func (tsc *TenantSettingCreate) SetKey(s string) *TenantSettingCreate {
+	tsc.mutation.SetKey(s)
+	return tsc
+}
+
+// SetValue sets the "value" field.
+func (tsc *TenantSettingCreate) SetValue(j jsontext.Value) *TenantSettingCreate {
+	tsc.mutation.SetValue(j)
+	return tsc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tsc *TenantSettingCreate) SetUpdatedBy(i int64) *TenantSettingCreate {
+	tsc.mutation.SetUpdatedBy(i)
+	return tsc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tsc *TenantSettingCreate) SetNillableUpdatedBy(i *int64) *TenantSettingCreate {
+	if i != nil {
+		tsc.SetUpdatedBy(*i)
+	}
+	return tsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TenantSettingCreate) SetCreatedAt(t time.Time) *TenantSettingCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TenantSettingCreate) SetNillableCreatedAt(t *time.Time) *TenantSettingCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsc *TenantSettingCreate) SetUpdatedAt(t time.Time) *TenantSettingCreate {
+	tsc.mutation.SetUpdatedAt(t)
+	return tsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tsc *TenantSettingCreate) SetNillableUpdatedAt(t *time.Time) *TenantSettingCreate {
+	if t != nil {
+		tsc.SetUpdatedAt(*t)
+	}
+	return tsc
+}
+
+// SetID sets the "id" field.
+func (tsc *TenantSettingCreate) SetID(i int64) *TenantSettingCreate {
+	tsc.mutation.SetID(i)
+	return tsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsc *TenantSettingCreate) SetNillableID(i *int64) *TenantSettingCreate {
+	if i != nil {
+		tsc.SetID(*i)
+	}
+	return tsc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (tsc *TenantSettingCreate) SetTenant(t *Tenant) *TenantSettingCreate {
+	return tsc.SetTenantID(t.ID)
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (tsc *TenantSettingCreate) Mutation() *TenantSettingMutation {
+	return tsc.mutation
+}
+
+// Save creates the TenantSetting in the database.
+func (tsc *TenantSettingCreate) Save(ctx context.Context) (*TenantSetting, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TenantSettingCreate) SaveX(ctx context.Context) *TenantSetting {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TenantSettingCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TenantSettingCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TenantSettingCreate) defaults() {
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := tenantsetting.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsc.mutation.UpdatedAt(); !ok {
+		v := tenantsetting.DefaultUpdatedAt()
+		tsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tsc.mutation.ID(); !ok {
+		v := tenantsetting.DefaultID()
+		tsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TenantSettingCreate) check() error {
+	if _, ok := tsc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantSetting.tenant_id"`)}
+	}
+	if _, ok := tsc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "TenantSetting.key"`)}
+	}
+	if v, ok := tsc.mutation.Key(); ok {
+		if err := tenantsetting.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.key": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "TenantSetting.value"`)}
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantSetting.created_at"`)}
+	}
+	if _, ok := tsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TenantSetting.updated_at"`)}
+	}
+	if len(tsc.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TenantSetting.tenant"`)}
+	}
+	return nil
+}
+
+func (tsc *TenantSettingCreate) sqlSave(ctx context.Context) (*TenantSetting, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TenantSettingCreate) createSpec() (*TenantSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSetting{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(tenantsetting.Table, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = tsc.conflict
+	if id, ok := tsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tsc.mutation.Key(); ok {
+		_spec.SetField(tenantsetting.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := tsc.mutation.Value(); ok {
+		_spec.SetField(tenantsetting.FieldValue, field.TypeJSON, value)
+		_node.Value = value
+	}
+	if value, ok := tsc.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedBy, field.TypeInt64, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tsc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsetting.TenantTable,
+			Columns: []string{tenantsetting.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSetting.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tsc *TenantSettingCreate) OnConflict(opts ...sql.ConflictOption) *TenantSettingUpsertOne {
+	tsc.conflict = opts
+	return &TenantSettingUpsertOne{
+		create: tsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsc *TenantSettingCreate) OnConflictColumns(columns ...string) *TenantSettingUpsertOne {
+	tsc.conflict = append(tsc.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingUpsertOne{
+		create: tsc,
+	}
+}
+
+type (
+	// TenantSettingUpsertOne is the builder for "upsert"-ing
+	//  one TenantSetting node.
+	TenantSettingUpsertOne struct {
+		create *TenantSettingCreate
+	}
+
+	// TenantSettingUpsert is the "OnConflict" setter.
+	TenantSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetValue sets the "value" field.
+func (u *TenantSettingUpsert) SetValue(v jsontext.Value) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateValue() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldValue)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TenantSettingUpsert) SetUpdatedBy(v int64) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateUpdatedBy() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TenantSettingUpsert) AddUpdatedBy(v int64) *TenantSettingUpsert {
+	u.Add(tenantsetting.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TenantSettingUpsert) ClearUpdatedBy() *TenantSettingUpsert {
+	u.SetNull(tenantsetting.FieldUpdatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantSettingUpsert) SetUpdatedAt(v time.Time) *TenantSettingUpsert {
+	u.Set(tenantsetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantSettingUpsert) UpdateUpdatedAt() *TenantSettingUpsert {
+	u.SetExcluded(tenantsetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingUpsertOne) UpdateNewValues() *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantsetting.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantsetting.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(tenantsetting.FieldKey)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantsetting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantSettingUpsertOne) Ignore() *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingUpsertOne) DoNothing() *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingCreate.OnConflict
+// documentation for more info.
+func (u *TenantSettingUpsertOne) Update(set func(*TenantSettingUpsert)) *TenantSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *TenantSettingUpsertOne) SetValue(v jsontext.Value) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateValue() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TenantSettingUpsertOne) SetUpdatedBy(v int64) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TenantSettingUpsertOne) AddUpdatedBy(v int64) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateUpdatedBy() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TenantSettingUpsertOne) ClearUpdatedBy() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantSettingUpsertOne) SetUpdatedAt(v time.Time) *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantSettingUpsertOne) UpdateUpdatedAt() *TenantSettingUpsertOne {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantSettingUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantSettingUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantSettingCreateBulk is the builder for creating many TenantSetting entities in bulk.
+type TenantSettingCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantSetting entities in the database.
+func (tscb *TenantSettingCreateBulk) Save(ctx context.Context) ([]*TenantSetting, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TenantSetting, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TenantSettingCreateBulk) SaveX(ctx context.Context) []*TenantSetting {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TenantSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TenantSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tscb *TenantSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantSettingUpsertBulk {
+	tscb.conflict = opts
+	return &TenantSettingUpsertBulk{
+		create: tscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tscb *TenantSettingCreateBulk) OnConflictColumns(columns ...string) *TenantSettingUpsertBulk {
+	tscb.conflict = append(tscb.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingUpsertBulk{
+		create: tscb,
+	}
+}
+
+// TenantSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantSetting nodes.
+type TenantSettingUpsertBulk struct {
+	create *TenantSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingUpsertBulk) UpdateNewValues() *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantsetting.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantsetting.FieldTenantID)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(tenantsetting.FieldKey)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantsetting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantSettingUpsertBulk) Ignore() *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingUpsertBulk) DoNothing() *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantSettingUpsertBulk) Update(set func(*TenantSettingUpsert)) *TenantSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *TenantSettingUpsertBulk) SetValue(v jsontext.Value) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateValue() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TenantSettingUpsertBulk) SetUpdatedBy(v int64) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TenantSettingUpsertBulk) AddUpdatedBy(v int64) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateUpdatedBy() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TenantSettingUpsertBulk) ClearUpdatedBy() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantSettingUpsertBulk) SetUpdatedAt(v time.Time) *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantSettingUpsertBulk) UpdateUpdatedAt() *TenantSettingUpsertBulk {
+	return u.Update(func(s *TenantSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
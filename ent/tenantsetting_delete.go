@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+)
+
+// TenantSettingDelete is the builder for deleting a TenantSetting entity.
+type TenantSettingDelete struct {
+	config
+	hooks    []Hook
+	mutation *TenantSettingMutation
+}
+
+// Where appends a list predicates to the TenantSettingDelete builder.
+func (tsd *TenantSettingDelete) Where(ps ...predicate.TenantSetting) *TenantSettingDelete {
+	tsd.mutation.Where(ps...)
+	return tsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsd *TenantSettingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsd.sqlExec, tsd.mutation, tsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsd *TenantSettingDelete) ExecX(ctx context.Context) int {
+	n, err := tsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsd *TenantSettingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tenantsetting.Table, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64))
+	if ps := tsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsd.mutation.done = true
+	return affected, err
+}
+
+// TenantSettingDeleteOne is the builder for deleting a single TenantSetting entity.
+type TenantSettingDeleteOne struct {
+	tsd *TenantSettingDelete
+}
+
+// Where appends a list predicates to the TenantSettingDelete builder.
+func (tsdo *TenantSettingDeleteOne) Where(ps ...predicate.TenantSetting) *TenantSettingDeleteOne {
+	tsdo.tsd.mutation.Where(ps...)
+	return tsdo
+}
+
+// Exec executes the deletion query.
+func (tsdo *TenantSettingDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsdo.tsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tenantsetting.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsdo *TenantSettingDeleteOne) ExecX(ctx context.Context) {
+	if err := tsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+)
+
+// TenantSettingQuery is the builder for querying TenantSetting entities.
+type TenantSettingQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tenantsetting.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TenantSetting
+	withTenant *TenantQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TenantSettingQuery builder.
+func (tsq *TenantSettingQuery) Where(ps ...predicate.TenantSetting) *TenantSettingQuery {
+	tsq.predicates = append(tsq.predicates, ps...)
+	return tsq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsq *TenantSettingQuery) Limit(limit int) *TenantSettingQuery {
+	tsq.ctx.Limit = &limit
+	return tsq
+}
+
+// Offset to start from.
+func (tsq *TenantSettingQuery) Offset(offset int) *TenantSettingQuery {
+	tsq.ctx.Offset = &offset
+	return tsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsq *TenantSettingQuery) Unique(unique bool) *TenantSettingQuery {
+	tsq.ctx.Unique = &unique
+	return tsq
+}
+
+// Order specifies how the records should be ordered.
+func (tsq *TenantSettingQuery) Order(o ...tenantsetting.OrderOption) *TenantSettingQuery {
+	tsq.order = append(tsq.order, o...)
+	return tsq
+}
+
+// QueryTenant chains the current query on the "tenant" edge.
+func (tsq *TenantSettingQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenantsetting.Table, tenantsetting.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenantsetting.TenantTable, tenantsetting.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TenantSetting entity from the query.
+// Returns a *NotFoundError when no TenantSetting was found.
+func (tsq *TenantSettingQuery) First(ctx context.Context) (*TenantSetting, error) {
+	nodes, err := tsq.Limit(1).All(setContextOp(ctx, tsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tenantsetting.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsq *TenantSettingQuery) FirstX(ctx context.Context) *TenantSetting {
+	node, err := tsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TenantSetting ID from the query.
+// Returns a *NotFoundError when no TenantSetting ID was found.
+func (tsq *TenantSettingQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tsq.Limit(1).IDs(setContextOp(ctx, tsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tenantsetting.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsq *TenantSettingQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := tsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TenantSetting entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TenantSetting entity is found.
+// Returns a *NotFoundError when no TenantSetting entities are found.
+func (tsq *TenantSettingQuery) Only(ctx context.Context) (*TenantSetting, error) {
+	nodes, err := tsq.Limit(2).All(setContextOp(ctx, tsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tenantsetting.Label}
+	default:
+		return nil, &NotSingularError{tenantsetting.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsq *TenantSettingQuery) OnlyX(ctx context.Context) *TenantSetting {
+	node, err := tsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TenantSetting ID in the query.
+// Returns a *NotSingularError when more than one TenantSetting ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsq *TenantSettingQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tsq.Limit(2).IDs(setContextOp(ctx, tsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tenantsetting.Label}
+	default:
+		err = &NotSingularError{tenantsetting.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsq *TenantSettingQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := tsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TenantSettings.
+func (tsq *TenantSettingQuery) All(ctx context.Context) ([]*TenantSetting, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryAll)
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TenantSetting, *TenantSettingQuery]()
+	return withInterceptors[[]*TenantSetting](ctx, tsq, qr, tsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsq *TenantSettingQuery) AllX(ctx context.Context) []*TenantSetting {
+	nodes, err := tsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TenantSetting IDs.
+func (tsq *TenantSettingQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if tsq.ctx.Unique == nil && tsq.path != nil {
+		tsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryIDs)
+	if err = tsq.Select(tenantsetting.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsq *TenantSettingQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := tsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsq *TenantSettingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryCount)
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsq, querierCount[*TenantSettingQuery](), tsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsq *TenantSettingQuery) CountX(ctx context.Context) int {
+	count, err := tsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsq *TenantSettingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryExist)
+	switch _, err := tsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsq *TenantSettingQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TenantSettingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsq *TenantSettingQuery) Clone() *TenantSettingQuery {
+	if tsq == nil {
+		return nil
+	}
+	return &TenantSettingQuery{
+		config:     tsq.config,
+		ctx:        tsq.ctx.Clone(),
+		order:      append([]tenantsetting.OrderOption{}, tsq.order...),
+		inters:     append([]Interceptor{}, tsq.inters...),
+		predicates: append([]predicate.TenantSetting{}, tsq.predicates...),
+		withTenant: tsq.withTenant.Clone(),
+		// clone intermediate query.
+		sql:  tsq.sql.Clone(),
+		path: tsq.path,
+	}
+}
+
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TenantSettingQuery) WithTenant(opts ...func(*TenantQuery)) *TenantSettingQuery {
+	query := (&TenantClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withTenant = query
+	return tsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TenantSetting.Query().
+//		GroupBy(tenantsetting.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsq *TenantSettingQuery) GroupBy(field string, fields ...string) *TenantSettingGroupBy {
+	tsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TenantSettingGroupBy{build: tsq}
+	grbuild.flds = &tsq.ctx.Fields
+	grbuild.label = tenantsetting.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TenantSetting.Query().
+//		Select(tenantsetting.FieldTenantID).
+//		Scan(ctx, &v)
+func (tsq *TenantSettingQuery) Select(fields ...string) *TenantSettingSelect {
+	tsq.ctx.Fields = append(tsq.ctx.Fields, fields...)
+	sbuild := &TenantSettingSelect{TenantSettingQuery: tsq}
+	sbuild.label = tenantsetting.Label
+	sbuild.flds, sbuild.scan = &tsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TenantSettingSelect configured with the given aggregations.
+func (tsq *TenantSettingQuery) Aggregate(fns ...AggregateFunc) *TenantSettingSelect {
+	return tsq.Select().Aggregate(fns...)
+}
+
+func (tsq *TenantSettingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsq.ctx.Fields {
+		if !tenantsetting.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsq.path != nil {
+		prev, err := tsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsq.sql = prev
+	}
+	return nil
+}
+
+func (tsq *TenantSettingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TenantSetting, error) {
+	var (
+		nodes       = []*TenantSetting{}
+		_spec       = tsq.querySpec()
+		loadedTypes = [1]bool{
+			tsq.withTenant != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TenantSetting).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TenantSetting{config: tsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsq.withTenant; query != nil {
+		if err := tsq.loadTenant(ctx, query, nodes, nil,
+			func(n *TenantSetting, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsq *TenantSettingQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*TenantSetting, init func(*TenantSetting), assign func(*TenantSetting, *Tenant)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*TenantSetting)
+	for i := range nodes {
+		fk := nodes[i].TenantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsq *TenantSettingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsq.querySpec()
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
+	_spec.Node.Columns = tsq.ctx.Fields
+	if len(tsq.ctx.Fields) > 0 {
+		_spec.Unique = tsq.ctx.Unique != nil && *tsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsq.driver, _spec)
+}
+
+func (tsq *TenantSettingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64))
+	_spec.From = tsq.sql
+	if unique := tsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsetting.FieldID)
+		for i := range fields {
+			if fields[i] != tenantsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsq.withTenant != nil {
+			_spec.Node.AddColumnOnce(tenantsetting.FieldTenantID)
+		}
+	}
+	if ps := tsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsq *TenantSettingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsq.driver.Dialect())
+	t1 := builder.Table(tenantsetting.Table)
+	columns := tsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tenantsetting.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsq.sql != nil {
+		selector = tsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsq.ctx.Unique != nil && *tsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tsq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsq.predicates {
+		p(selector)
+	}
+	for _, p := range tsq.order {
+		p(selector)
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsq *TenantSettingQuery) ForUpdate(opts ...sql.LockOption) *TenantSettingQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsq *TenantSettingQuery) ForShare(opts ...sql.LockOption) *TenantSettingQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsq
+}
+
+// TenantSettingGroupBy is the group-by builder for TenantSetting entities.
+type TenantSettingGroupBy struct {
+	selector
+	build *TenantSettingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsgb *TenantSettingGroupBy) Aggregate(fns ...AggregateFunc) *TenantSettingGroupBy {
+	tsgb.fns = append(tsgb.fns, fns...)
+	return tsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsgb *TenantSettingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantSettingQuery, *TenantSettingGroupBy](ctx, tsgb.build, tsgb, tsgb.build.inters, v)
+}
+
+func (tsgb *TenantSettingGroupBy) sqlScan(ctx context.Context, root *TenantSettingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsgb.fns))
+	for _, fn := range tsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsgb.flds)+len(tsgb.fns))
+		for _, f := range *tsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TenantSettingSelect is the builder for selecting fields of TenantSetting entities.
+type TenantSettingSelect struct {
+	*TenantSettingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tss *TenantSettingSelect) Aggregate(fns ...AggregateFunc) *TenantSettingSelect {
+	tss.fns = append(tss.fns, fns...)
+	return tss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tss *TenantSettingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tss.ctx, ent.OpQuerySelect)
+	if err := tss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantSettingQuery, *TenantSettingSelect](ctx, tss.TenantSettingQuery, tss, tss.inters, v)
+}
+
+func (tss *TenantSettingSelect) sqlScan(ctx context.Context, root *TenantSettingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tss.fns))
+	for _, fn := range tss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantsetting"
+)
+
+// TenantSettingUpdate is the builder for updating TenantSetting entities.
+type TenantSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantSettingMutation
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (tsu *TenantSettingUpdate) Where(ps ...predicate.TenantSetting) *TenantSettingUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// SetValue sets the "value" field.
+func (tsu *TenantSettingUpdate) SetValue(j jsontext.Value) *TenantSettingUpdate {
+	tsu.mutation.SetValue(j)
+	return tsu
+}
+
+// AppendValue appends j to the "value" field.
+func (tsu *TenantSettingUpdate) AppendValue(j jsontext.Value) *TenantSettingUpdate {
+	tsu.mutation.AppendValue(j)
+	return tsu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tsu *TenantSettingUpdate) SetUpdatedBy(i int64) *TenantSettingUpdate {
+	tsu.mutation.ResetUpdatedBy()
+	tsu.mutation.SetUpdatedBy(i)
+	return tsu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tsu *TenantSettingUpdate) SetNillableUpdatedBy(i *int64) *TenantSettingUpdate {
+	if i != nil {
+		tsu.SetUpdatedBy(*i)
+	}
+	return tsu
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (tsu *TenantSettingUpdate) AddUpdatedBy(i int64) *TenantSettingUpdate {
+	tsu.mutation.AddUpdatedBy(i)
+	return tsu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tsu *TenantSettingUpdate) ClearUpdatedBy() *TenantSettingUpdate {
+	tsu.mutation.ClearUpdatedBy()
+	return tsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsu *TenantSettingUpdate) SetUpdatedAt(t time.Time) *TenantSettingUpdate {
+	tsu.mutation.SetUpdatedAt(t)
+	return tsu
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (tsu *TenantSettingUpdate) Mutation() *TenantSettingMutation {
+	return tsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TenantSettingUpdate) Save(ctx context.Context) (int, error) {
+	tsu.defaults()
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TenantSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TenantSettingUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TenantSettingUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsu *TenantSettingUpdate) defaults() {
+	if _, ok := tsu.mutation.UpdatedAt(); !ok {
+		v := tenantsetting.UpdateDefaultUpdatedAt()
+		tsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsu *TenantSettingUpdate) check() error {
+	if tsu.mutation.TenantCleared() && len(tsu.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantSetting.tenant"`)
+	}
+	return nil
+}
+
+func (tsu *TenantSettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsu.mutation.Value(); ok {
+		_spec.SetField(tenantsetting.FieldValue, field.TypeJSON, value)
+	}
+	if value, ok := tsu.mutation.AppendedValue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldValue, value)
+		})
+	}
+	if value, ok := tsu.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := tsu.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(tenantsetting.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if tsu.mutation.UpdatedByCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdatedBy, field.TypeInt64)
+	}
+	if value, ok := tsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TenantSettingUpdateOne is the builder for updating a single TenantSetting entity.
+type TenantSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantSettingMutation
+}
+
+// SetValue sets the "value" field.
+func (tsuo *TenantSettingUpdateOne) SetValue(j jsontext.Value) *TenantSettingUpdateOne {
+	tsuo.mutation.SetValue(j)
+	return tsuo
+}
+
+// AppendValue appends j to the "value" field.
+func (tsuo *TenantSettingUpdateOne) AppendValue(j jsontext.Value) *TenantSettingUpdateOne {
+	tsuo.mutation.AppendValue(j)
+	return tsuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tsuo *TenantSettingUpdateOne) SetUpdatedBy(i int64) *TenantSettingUpdateOne {
+	tsuo.mutation.ResetUpdatedBy()
+	tsuo.mutation.SetUpdatedBy(i)
+	return tsuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tsuo *TenantSettingUpdateOne) SetNillableUpdatedBy(i *int64) *TenantSettingUpdateOne {
+	if i != nil {
+		tsuo.SetUpdatedBy(*i)
+	}
+	return tsuo
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (tsuo *TenantSettingUpdateOne) AddUpdatedBy(i int64) *TenantSettingUpdateOne {
+	tsuo.mutation.AddUpdatedBy(i)
+	return tsuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tsuo *TenantSettingUpdateOne) ClearUpdatedBy() *TenantSettingUpdateOne {
+	tsuo.mutation.ClearUpdatedBy()
+	return tsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsuo *TenantSettingUpdateOne) SetUpdatedAt(t time.Time) *TenantSettingUpdateOne {
+	tsuo.mutation.SetUpdatedAt(t)
+	return tsuo
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (tsuo *TenantSettingUpdateOne) Mutation() *TenantSettingMutation {
+	return tsuo.mutation
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (tsuo *TenantSettingUpdateOne) Where(ps ...predicate.TenantSetting) *TenantSettingUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TenantSettingUpdateOne) Select(field string, fields ...string) *TenantSettingUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TenantSetting entity.
+func (tsuo *TenantSettingUpdateOne) Save(ctx context.Context) (*TenantSetting, error) {
+	tsuo.defaults()
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TenantSettingUpdateOne) SaveX(ctx context.Context) *TenantSetting {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TenantSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TenantSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsuo *TenantSettingUpdateOne) defaults() {
+	if _, ok := tsuo.mutation.UpdatedAt(); !ok {
+		v := tenantsetting.UpdateDefaultUpdatedAt()
+		tsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsuo *TenantSettingUpdateOne) check() error {
+	if tsuo.mutation.TenantCleared() && len(tsuo.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantSetting.tenant"`)
+	}
+	return nil
+}
+
+func (tsuo *TenantSettingUpdateOne) sqlSave(ctx context.Context) (_node *TenantSetting, err error) {
+	if err := tsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeInt64))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsetting.FieldID)
+		for _, f := range fields {
+			if !tenantsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsuo.mutation.Value(); ok {
+		_spec.SetField(tenantsetting.FieldValue, field.TypeJSON, value)
+	}
+	if value, ok := tsuo.mutation.AppendedValue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldValue, value)
+		})
+	}
+	if value, ok := tsuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := tsuo.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(tenantsetting.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if tsuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdatedBy, field.TypeInt64)
+	}
+	if value, ok := tsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TenantSetting{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+)
+
+// TenantSettingLog is the model entity for the TenantSettingLog schema.
+type TenantSettingLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary Key ID
+	ID int64 `json:"id,omitempty"`
+	// 租户ID
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// 设置项
+	Key string `json:"key,omitempty"`
+	// SET修改，RESET恢复继承
+	Action tenantsettinglog.Action `json:"action,omitempty"`
+	// 变更前租户自身的值，为空表示变更前为继承值
+	OldValue jsontext.Value `json:"old_value,omitempty"`
+	// 变更后的值，RESET时为空
+	NewValue jsontext.Value `json:"new_value,omitempty"`
+	// 变更原因
+	Reason string `json:"reason,omitempty"`
+	// 操作人ID
+	OperatorID *int64 `json:"operator_id,omitempty"`
+	// Creation timestamp of this record
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TenantSettingLogQuery when eager-loading is set.
+	Edges        TenantSettingLogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TenantSettingLogEdges holds the relations/edges for other nodes in the graph.
+type TenantSettingLogEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantSettingLogEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantSettingLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantsettinglog.FieldOldValue, tenantsettinglog.FieldNewValue:
+			values[i] = new([]byte)
+		case tenantsettinglog.FieldID, tenantsettinglog.FieldTenantID, tenantsettinglog.FieldOperatorID:
+			values[i] = new(sql.NullInt64)
+		case tenantsettinglog.FieldKey, tenantsettinglog.FieldAction, tenantsettinglog.FieldReason:
+			values[i] = new(sql.NullString)
+		case tenantsettinglog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantSettingLog fields.
+func (tsl *TenantSettingLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantsettinglog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tsl.ID = int64(value.Int64)
+		case tenantsettinglog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				tsl.TenantID = value.Int64
+			}
+		case tenantsettinglog.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				tsl.Key = value.String
+			}
+		case tenantsettinglog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				tsl.Action = tenantsettinglog.Action(value.String)
+			}
+		case tenantsettinglog.FieldOldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field old_value", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tsl.OldValue); err != nil {
+					return fmt.Errorf("unmarshal field old_value: %w", err)
+				}
+			}
+		case tenantsettinglog.FieldNewValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field new_value", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tsl.NewValue); err != nil {
+					return fmt.Errorf("unmarshal field new_value: %w", err)
+				}
+			}
+		case tenantsettinglog.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				tsl.Reason = value.String
+			}
+		case tenantsettinglog.FieldOperatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value.Valid {
+				tsl.OperatorID = new(int64)
+				*tsl.OperatorID = value.Int64
+			}
+		case tenantsettinglog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsl.CreatedAt = value.Time
+			}
+		default:
+			tsl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantSettingLog.
+// This includes values selected through modifiers, order, etc.
+func (tsl *TenantSettingLog) Value(name string) (ent.Value, error) {
+	return tsl.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TenantSettingLog entity.
+func (tsl *TenantSettingLog) QueryTenant() *TenantQuery {
+	return NewTenantSettingLogClient(tsl.config).QueryTenant(tsl)
+}
+
+// Update returns a builder for updating this TenantSettingLog.
+// Note that you need to call TenantSettingLog.Unwrap() before calling this method if this TenantSettingLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsl *TenantSettingLog) Update() *TenantSettingLogUpdateOne {
+	return NewTenantSettingLogClient(tsl.config).UpdateOne(tsl)
+}
+
+// Unwrap unwraps the TenantSettingLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsl *TenantSettingLog) Unwrap() *TenantSettingLog {
+	_tx, ok := tsl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantSettingLog is not a transactional entity")
+	}
+	tsl.config.driver = _tx.drv
+	return tsl
+}
+
+// String implements the fmt.Stringer.
+func (tsl *TenantSettingLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantSettingLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsl.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsl.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(tsl.Key)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", tsl.Action))
+	builder.WriteString(", ")
+	builder.WriteString("old_value=")
+	builder.WriteString(fmt.Sprintf("%v", tsl.OldValue))
+	builder.WriteString(", ")
+	builder.WriteString("new_value=")
+	builder.WriteString(fmt.Sprintf("%v", tsl.NewValue))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(tsl.Reason)
+	builder.WriteString(", ")
+	if v := tsl.OperatorID; v != nil {
+		builder.WriteString("operator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tsl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantSettingLogs is a parsable slice of TenantSettingLog.
+type TenantSettingLogs []*TenantSettingLog
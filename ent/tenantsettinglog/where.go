@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsettinglog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/yc-alpha/admin/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldKey, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldReason, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIsNull(FieldOldValue))
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotNull(FieldOldValue))
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIsNull(FieldNewValue))
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotNull(FieldNewValue))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldContainsFold(FieldReason, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v int64) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorIDIsNil applies the IsNil predicate on the "operator_id" field.
+func OperatorIDIsNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIsNull(FieldOperatorID))
+}
+
+// OperatorIDNotNil applies the NotNil predicate on the "operator_id" field.
+func OperatorIDNotNil() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotNull(FieldOperatorID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantSettingLog) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantSettingLog) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantSettingLog) predicate.TenantSettingLog {
+	return predicate.TenantSettingLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+)
+
+// TenantSettingLogCreate is the builder for creating a TenantSettingLog entity.
+type TenantSettingLogCreate struct {
+	config
+	mutation *TenantSettingLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tslc *TenantSettingLogCreate) SetTenantID(i int64) *TenantSettingLogCreate {
+	tslc.mutation.SetTenantID(i)
+	return tslc
+}
+
+// SetKey sets the "key" field.
+func (tslc *TenantSettingLogCreate) SetKey(s string) *TenantSettingLogCreate {
+	tslc.mutation.SetKey(s)
+	return tslc
+}
+
+// SetAction sets the "action" field.
+func (tslc *TenantSettingLogCreate) SetAction(t tenantsettinglog.Action) *TenantSettingLogCreate {
+	tslc.mutation.SetAction(t)
+	return tslc
+}
+
+// SetOldValue sets the "old_value" field.
+func (tslc *TenantSettingLogCreate) SetOldValue(j jsontext.Value) *TenantSettingLogCreate {
+	tslc.mutation.SetOldValue(j)
+	return tslc
+}
+
+// SetNewValue sets the "new_value" field.
+func (tslc *TenantSettingLogCreate) SetNewValue(j jsontext.Value) *TenantSettingLogCreate {
+	tslc.mutation.SetNewValue(j)
+	return tslc
+}
+
+// SetReason sets the "reason" field.
+func (tslc *TenantSettingLogCreate) SetReason(s string) *TenantSettingLogCreate {
+	tslc.mutation.SetReason(s)
+	return tslc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (tslc *TenantSettingLogCreate) SetNillableReason(s *string) *TenantSettingLogCreate {
+	if s != nil {
+		tslc.SetReason(*s)
+	}
+	return tslc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (tslc *TenantSettingLogCreate) SetOperatorID(i int64) *TenantSettingLogCreate {
+	tslc.mutation.SetOperatorID(i)
+	return tslc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (tslc *TenantSettingLogCreate) SetNillableOperatorID(i *int64) *TenantSettingLogCreate {
+	if i != nil {
+		tslc.SetOperatorID(*i)
+	}
+	return tslc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tslc *TenantSettingLogCreate) SetCreatedAt(t time.Time) *TenantSettingLogCreate {
+	tslc.mutation.SetCreatedAt(t)
+	return tslc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tslc *TenantSettingLogCreate) SetNillableCreatedAt(t *time.Time) *TenantSettingLogCreate {
+	if t != nil {
+		tslc.SetCreatedAt(*t)
+	}
+	return tslc
+}
+
+// SetID sets the "id" field.
+func (tslc *TenantSettingLogCreate) SetID(i int64) *TenantSettingLogCreate {
+	tslc.mutation.SetID(i)
+	return tslc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tslc *TenantSettingLogCreate) SetNillableID(i *int64) *TenantSettingLogCreate {
+	if i != nil {
+		tslc.SetID(*i)
+	}
+	return tslc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (tslc *TenantSettingLogCreate) SetTenant(t *Tenant) *TenantSettingLogCreate {
+	return tslc.SetTenantID(t.ID)
+}
+
+// Mutation returns the TenantSettingLogMutation object of the builder.
+func (tslc *TenantSettingLogCreate) Mutation() *TenantSettingLogMutation {
+	return tslc.mutation
+}
+
+// Save creates the TenantSettingLog in the database.
+func (tslc *TenantSettingLogCreate) Save(ctx context.Context) (*TenantSettingLog, error) {
+	tslc.defaults()
+	return withHooks(ctx, tslc.sqlSave, tslc.mutation, tslc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tslc *TenantSettingLogCreate) SaveX(ctx context.Context) *TenantSettingLog {
+	v, err := tslc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tslc *TenantSettingLogCreate) Exec(ctx context.Context) error {
+	_, err := tslc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tslc *TenantSettingLogCreate) ExecX(ctx context.Context) {
+	if err := tslc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tslc *TenantSettingLogCreate) defaults() {
+	if _, ok := tslc.mutation.CreatedAt(); !ok {
+		v := tenantsettinglog.DefaultCreatedAt()
+		tslc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tslc.mutation.ID(); !ok {
+		v := tenantsettinglog.DefaultID()
+		tslc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tslc *TenantSettingLogCreate) check() error {
+	if _, ok := tslc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantSettingLog.tenant_id"`)}
+	}
+	if _, ok := tslc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "TenantSettingLog.key"`)}
+	}
+	if v, ok := tslc.mutation.Key(); ok {
+		if err := tenantsettinglog.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "TenantSettingLog.key": %w`, err)}
+		}
+	}
+	if _, ok := tslc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "TenantSettingLog.action"`)}
+	}
+	if v, ok := tslc.mutation.Action(); ok {
+		if err := tenantsettinglog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TenantSettingLog.action": %w`, err)}
+		}
+	}
+	if _, ok := tslc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantSettingLog.created_at"`)}
+	}
+	if len(tslc.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TenantSettingLog.tenant"`)}
+	}
+	return nil
+}
+
+func (tslc *TenantSettingLogCreate) sqlSave(ctx context.Context) (*TenantSettingLog, error) {
+	if err := tslc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tslc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tslc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tslc.mutation.id = &_node.ID
+	tslc.mutation.done = true
+	return _node, nil
+}
+
+func (tslc *TenantSettingLogCreate) createSpec() (*TenantSettingLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSettingLog{config: tslc.config}
+		_spec = sqlgraph.NewCreateSpec(tenantsettinglog.Table, sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = tslc.conflict
+	if id, ok := tslc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tslc.mutation.Key(); ok {
+		_spec.SetField(tenantsettinglog.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := tslc.mutation.Action(); ok {
+		_spec.SetField(tenantsettinglog.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := tslc.mutation.OldValue(); ok {
+		_spec.SetField(tenantsettinglog.FieldOldValue, field.TypeJSON, value)
+		_node.OldValue = value
+	}
+	if value, ok := tslc.mutation.NewValue(); ok {
+		_spec.SetField(tenantsettinglog.FieldNewValue, field.TypeJSON, value)
+		_node.NewValue = value
+	}
+	if value, ok := tslc.mutation.Reason(); ok {
+		_spec.SetField(tenantsettinglog.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := tslc.mutation.OperatorID(); ok {
+		_spec.SetField(tenantsettinglog.FieldOperatorID, field.TypeInt64, value)
+		_node.OperatorID = &value
+	}
+	if value, ok := tslc.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantsettinglog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tslc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsettinglog.TenantTable,
+			Columns: []string{tenantsettinglog.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSettingLog.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingLogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tslc *TenantSettingLogCreate) OnConflict(opts ...sql.ConflictOption) *TenantSettingLogUpsertOne {
+	tslc.conflict = opts
+	return &TenantSettingLogUpsertOne{
+		create: tslc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSettingLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tslc *TenantSettingLogCreate) OnConflictColumns(columns ...string) *TenantSettingLogUpsertOne {
+	tslc.conflict = append(tslc.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingLogUpsertOne{
+		create: tslc,
+	}
+}
+
+type (
+	// TenantSettingLogUpsertOne is the builder for "upsert"-ing
+	//  one TenantSettingLog node.
+	TenantSettingLogUpsertOne struct {
+		create *TenantSettingLogCreate
+	}
+
+	// TenantSettingLogUpsert is the "OnConflict" setter.
+	TenantSettingLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantSettingLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsettinglog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingLogUpsertOne) UpdateNewValues() *TenantSettingLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantsettinglog.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantsettinglog.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(tenantsettinglog.FieldKey)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(tenantsettinglog.FieldAction)
+		}
+		if _, exists := u.create.mutation.OldValue(); exists {
+			s.SetIgnore(tenantsettinglog.FieldOldValue)
+		}
+		if _, exists := u.create.mutation.NewValue(); exists {
+			s.SetIgnore(tenantsettinglog.FieldNewValue)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(tenantsettinglog.FieldReason)
+		}
+		if _, exists := u.create.mutation.OperatorID(); exists {
+			s.SetIgnore(tenantsettinglog.FieldOperatorID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantsettinglog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSettingLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantSettingLogUpsertOne) Ignore() *TenantSettingLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingLogUpsertOne) DoNothing() *TenantSettingLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingLogCreate.OnConflict
+// documentation for more info.
+func (u *TenantSettingLogUpsertOne) Update(set func(*TenantSettingLogUpsert)) *TenantSettingLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TenantSettingLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantSettingLogUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantSettingLogUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantSettingLogCreateBulk is the builder for creating many TenantSettingLog entities in bulk.
+type TenantSettingLogCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSettingLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantSettingLog entities in the database.
+func (tslcb *TenantSettingLogCreateBulk) Save(ctx context.Context) ([]*TenantSettingLog, error) {
+	if tslcb.err != nil {
+		return nil, tslcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tslcb.builders))
+	nodes := make([]*TenantSettingLog, len(tslcb.builders))
+	mutators := make([]Mutator, len(tslcb.builders))
+	for i := range tslcb.builders {
+		func(i int, root context.Context) {
+			builder := tslcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSettingLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tslcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tslcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tslcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tslcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tslcb *TenantSettingLogCreateBulk) SaveX(ctx context.Context) []*TenantSettingLog {
+	v, err := tslcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tslcb *TenantSettingLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := tslcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tslcb *TenantSettingLogCreateBulk) ExecX(ctx context.Context) {
+	if err := tslcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantSettingLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantSettingLogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tslcb *TenantSettingLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantSettingLogUpsertBulk {
+	tslcb.conflict = opts
+	return &TenantSettingLogUpsertBulk{
+		create: tslcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantSettingLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tslcb *TenantSettingLogCreateBulk) OnConflictColumns(columns ...string) *TenantSettingLogUpsertBulk {
+	tslcb.conflict = append(tslcb.conflict, sql.ConflictColumns(columns...))
+	return &TenantSettingLogUpsertBulk{
+		create: tslcb,
+	}
+}
+
+// TenantSettingLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantSettingLog nodes.
+type TenantSettingLogUpsertBulk struct {
+	create *TenantSettingLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantSettingLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantsettinglog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantSettingLogUpsertBulk) UpdateNewValues() *TenantSettingLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantsettinglog.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantsettinglog.FieldTenantID)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(tenantsettinglog.FieldKey)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(tenantsettinglog.FieldAction)
+			}
+			if _, exists := b.mutation.OldValue(); exists {
+				s.SetIgnore(tenantsettinglog.FieldOldValue)
+			}
+			if _, exists := b.mutation.NewValue(); exists {
+				s.SetIgnore(tenantsettinglog.FieldNewValue)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(tenantsettinglog.FieldReason)
+			}
+			if _, exists := b.mutation.OperatorID(); exists {
+				s.SetIgnore(tenantsettinglog.FieldOperatorID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantsettinglog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantSettingLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantSettingLogUpsertBulk) Ignore() *TenantSettingLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantSettingLogUpsertBulk) DoNothing() *TenantSettingLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantSettingLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantSettingLogUpsertBulk) Update(set func(*TenantSettingLogUpsert)) *TenantSettingLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantSettingLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TenantSettingLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantSettingLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantSettingLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantSettingLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+)
+
+// TenantSettingLogDelete is the builder for deleting a TenantSettingLog entity.
+type TenantSettingLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *TenantSettingLogMutation
+}
+
+// Where appends a list predicates to the TenantSettingLogDelete builder.
+func (tsld *TenantSettingLogDelete) Where(ps ...predicate.TenantSettingLog) *TenantSettingLogDelete {
+	tsld.mutation.Where(ps...)
+	return tsld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsld *TenantSettingLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsld.sqlExec, tsld.mutation, tsld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsld *TenantSettingLogDelete) ExecX(ctx context.Context) int {
+	n, err := tsld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsld *TenantSettingLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tenantsettinglog.Table, sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64))
+	if ps := tsld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsld.mutation.done = true
+	return affected, err
+}
+
+// TenantSettingLogDeleteOne is the builder for deleting a single TenantSettingLog entity.
+type TenantSettingLogDeleteOne struct {
+	tsld *TenantSettingLogDelete
+}
+
+// Where appends a list predicates to the TenantSettingLogDelete builder.
+func (tsldo *TenantSettingLogDeleteOne) Where(ps ...predicate.TenantSettingLog) *TenantSettingLogDeleteOne {
+	tsldo.tsld.mutation.Where(ps...)
+	return tsldo
+}
+
+// Exec executes the deletion query.
+func (tsldo *TenantSettingLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsldo.tsld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tenantsettinglog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsldo *TenantSettingLogDeleteOne) ExecX(ctx context.Context) {
+	if err := tsldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
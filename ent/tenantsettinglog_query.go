@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+)
+
+// TenantSettingLogQuery is the builder for querying TenantSettingLog entities.
+type TenantSettingLogQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tenantsettinglog.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TenantSettingLog
+	withTenant *TenantQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TenantSettingLogQuery builder.
+func (tslq *TenantSettingLogQuery) Where(ps ...predicate.TenantSettingLog) *TenantSettingLogQuery {
+	tslq.predicates = append(tslq.predicates, ps...)
+	return tslq
+}
+
+// Limit the number of records to be returned by this query.
+func (tslq *TenantSettingLogQuery) Limit(limit int) *TenantSettingLogQuery {
+	tslq.ctx.Limit = &limit
+	return tslq
+}
+
+// Offset to start from.
+func (tslq *TenantSettingLogQuery) Offset(offset int) *TenantSettingLogQuery {
+	tslq.ctx.Offset = &offset
+	return tslq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tslq *TenantSettingLogQuery) Unique(unique bool) *TenantSettingLogQuery {
+	tslq.ctx.Unique = &unique
+	return tslq
+}
+
+// Order specifies how the records should be ordered.
+func (tslq *TenantSettingLogQuery) Order(o ...tenantsettinglog.OrderOption) *TenantSettingLogQuery {
+	tslq.order = append(tslq.order, o...)
+	return tslq
+}
+
+// QueryTenant chains the current query on the "tenant" edge.
+func (tslq *TenantSettingLogQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: tslq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tslq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tslq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenantsettinglog.Table, tenantsettinglog.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenantsettinglog.TenantTable, tenantsettinglog.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tslq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TenantSettingLog entity from the query.
+// Returns a *NotFoundError when no TenantSettingLog was found.
+func (tslq *TenantSettingLogQuery) First(ctx context.Context) (*TenantSettingLog, error) {
+	nodes, err := tslq.Limit(1).All(setContextOp(ctx, tslq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tenantsettinglog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) FirstX(ctx context.Context) *TenantSettingLog {
+	node, err := tslq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TenantSettingLog ID from the query.
+// Returns a *NotFoundError when no TenantSettingLog ID was found.
+func (tslq *TenantSettingLogQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tslq.Limit(1).IDs(setContextOp(ctx, tslq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tenantsettinglog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := tslq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TenantSettingLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TenantSettingLog entity is found.
+// Returns a *NotFoundError when no TenantSettingLog entities are found.
+func (tslq *TenantSettingLogQuery) Only(ctx context.Context) (*TenantSettingLog, error) {
+	nodes, err := tslq.Limit(2).All(setContextOp(ctx, tslq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tenantsettinglog.Label}
+	default:
+		return nil, &NotSingularError{tenantsettinglog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) OnlyX(ctx context.Context) *TenantSettingLog {
+	node, err := tslq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TenantSettingLog ID in the query.
+// Returns a *NotSingularError when more than one TenantSettingLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tslq *TenantSettingLogQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tslq.Limit(2).IDs(setContextOp(ctx, tslq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tenantsettinglog.Label}
+	default:
+		err = &NotSingularError{tenantsettinglog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := tslq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TenantSettingLogs.
+func (tslq *TenantSettingLogQuery) All(ctx context.Context) ([]*TenantSettingLog, error) {
+	ctx = setContextOp(ctx, tslq.ctx, ent.OpQueryAll)
+	if err := tslq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TenantSettingLog, *TenantSettingLogQuery]()
+	return withInterceptors[[]*TenantSettingLog](ctx, tslq, qr, tslq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) AllX(ctx context.Context) []*TenantSettingLog {
+	nodes, err := tslq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TenantSettingLog IDs.
+func (tslq *TenantSettingLogQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if tslq.ctx.Unique == nil && tslq.path != nil {
+		tslq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tslq.ctx, ent.OpQueryIDs)
+	if err = tslq.Select(tenantsettinglog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := tslq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tslq *TenantSettingLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tslq.ctx, ent.OpQueryCount)
+	if err := tslq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tslq, querierCount[*TenantSettingLogQuery](), tslq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) CountX(ctx context.Context) int {
+	count, err := tslq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tslq *TenantSettingLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tslq.ctx, ent.OpQueryExist)
+	switch _, err := tslq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tslq *TenantSettingLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := tslq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TenantSettingLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tslq *TenantSettingLogQuery) Clone() *TenantSettingLogQuery {
+	if tslq == nil {
+		return nil
+	}
+	return &TenantSettingLogQuery{
+		config:     tslq.config,
+		ctx:        tslq.ctx.Clone(),
+		order:      append([]tenantsettinglog.OrderOption{}, tslq.order...),
+		inters:     append([]Interceptor{}, tslq.inters...),
+		predicates: append([]predicate.TenantSettingLog{}, tslq.predicates...),
+		withTenant: tslq.withTenant.Clone(),
+		// clone intermediate query.
+		sql:  tslq.sql.Clone(),
+		path: tslq.path,
+	}
+}
+
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (tslq *TenantSettingLogQuery) WithTenant(opts ...func(*TenantQuery)) *TenantSettingLogQuery {
+	query := (&TenantClient{config: tslq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tslq.withTenant = query
+	return tslq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TenantSettingLog.Query().
+//		GroupBy(tenantsettinglog.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tslq *TenantSettingLogQuery) GroupBy(field string, fields ...string) *TenantSettingLogGroupBy {
+	tslq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TenantSettingLogGroupBy{build: tslq}
+	grbuild.flds = &tslq.ctx.Fields
+	grbuild.label = tenantsettinglog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int64 `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.TenantSettingLog.Query().
+//		Select(tenantsettinglog.FieldTenantID).
+//		Scan(ctx, &v)
+func (tslq *TenantSettingLogQuery) Select(fields ...string) *TenantSettingLogSelect {
+	tslq.ctx.Fields = append(tslq.ctx.Fields, fields...)
+	sbuild := &TenantSettingLogSelect{TenantSettingLogQuery: tslq}
+	sbuild.label = tenantsettinglog.Label
+	sbuild.flds, sbuild.scan = &tslq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TenantSettingLogSelect configured with the given aggregations.
+func (tslq *TenantSettingLogQuery) Aggregate(fns ...AggregateFunc) *TenantSettingLogSelect {
+	return tslq.Select().Aggregate(fns...)
+}
+
+func (tslq *TenantSettingLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tslq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tslq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tslq.ctx.Fields {
+		if !tenantsettinglog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tslq.path != nil {
+		prev, err := tslq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tslq.sql = prev
+	}
+	return nil
+}
+
+func (tslq *TenantSettingLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TenantSettingLog, error) {
+	var (
+		nodes       = []*TenantSettingLog{}
+		_spec       = tslq.querySpec()
+		loadedTypes = [1]bool{
+			tslq.withTenant != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TenantSettingLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TenantSettingLog{config: tslq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tslq.modifiers) > 0 {
+		_spec.Modifiers = tslq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tslq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tslq.withTenant; query != nil {
+		if err := tslq.loadTenant(ctx, query, nodes, nil,
+			func(n *TenantSettingLog, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tslq *TenantSettingLogQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*TenantSettingLog, init func(*TenantSettingLog), assign func(*TenantSettingLog, *Tenant)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*TenantSettingLog)
+	for i := range nodes {
+		fk := nodes[i].TenantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tslq *TenantSettingLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tslq.querySpec()
+	if len(tslq.modifiers) > 0 {
+		_spec.Modifiers = tslq.modifiers
+	}
+	_spec.Node.Columns = tslq.ctx.Fields
+	if len(tslq.ctx.Fields) > 0 {
+		_spec.Unique = tslq.ctx.Unique != nil && *tslq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tslq.driver, _spec)
+}
+
+func (tslq *TenantSettingLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tenantsettinglog.Table, tenantsettinglog.Columns, sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64))
+	_spec.From = tslq.sql
+	if unique := tslq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tslq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tslq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsettinglog.FieldID)
+		for i := range fields {
+			if fields[i] != tenantsettinglog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tslq.withTenant != nil {
+			_spec.Node.AddColumnOnce(tenantsettinglog.FieldTenantID)
+		}
+	}
+	if ps := tslq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tslq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tslq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tslq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tslq *TenantSettingLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tslq.driver.Dialect())
+	t1 := builder.Table(tenantsettinglog.Table)
+	columns := tslq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tenantsettinglog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tslq.sql != nil {
+		selector = tslq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tslq.ctx.Unique != nil && *tslq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tslq.modifiers {
+		m(selector)
+	}
+	for _, p := range tslq.predicates {
+		p(selector)
+	}
+	for _, p := range tslq.order {
+		p(selector)
+	}
+	if offset := tslq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tslq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tslq *TenantSettingLogQuery) ForUpdate(opts ...sql.LockOption) *TenantSettingLogQuery {
+	if tslq.driver.Dialect() == dialect.Postgres {
+		tslq.Unique(false)
+	}
+	tslq.modifiers = append(tslq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tslq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tslq *TenantSettingLogQuery) ForShare(opts ...sql.LockOption) *TenantSettingLogQuery {
+	if tslq.driver.Dialect() == dialect.Postgres {
+		tslq.Unique(false)
+	}
+	tslq.modifiers = append(tslq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tslq
+}
+
+// TenantSettingLogGroupBy is the group-by builder for TenantSettingLog entities.
+type TenantSettingLogGroupBy struct {
+	selector
+	build *TenantSettingLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tslgb *TenantSettingLogGroupBy) Aggregate(fns ...AggregateFunc) *TenantSettingLogGroupBy {
+	tslgb.fns = append(tslgb.fns, fns...)
+	return tslgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tslgb *TenantSettingLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tslgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tslgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantSettingLogQuery, *TenantSettingLogGroupBy](ctx, tslgb.build, tslgb, tslgb.build.inters, v)
+}
+
+func (tslgb *TenantSettingLogGroupBy) sqlScan(ctx context.Context, root *TenantSettingLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tslgb.fns))
+	for _, fn := range tslgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tslgb.flds)+len(tslgb.fns))
+		for _, f := range *tslgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tslgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tslgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TenantSettingLogSelect is the builder for selecting fields of TenantSettingLog entities.
+type TenantSettingLogSelect struct {
+	*TenantSettingLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tsls *TenantSettingLogSelect) Aggregate(fns ...AggregateFunc) *TenantSettingLogSelect {
+	tsls.fns = append(tsls.fns, fns...)
+	return tsls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsls *TenantSettingLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsls.ctx, ent.OpQuerySelect)
+	if err := tsls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TenantSettingLogQuery, *TenantSettingLogSelect](ctx, tsls.TenantSettingLogQuery, tsls, tsls.inters, v)
+}
+
+func (tsls *TenantSettingLogSelect) sqlScan(ctx context.Context, root *TenantSettingLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tsls.fns))
+	for _, fn := range tsls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tsls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
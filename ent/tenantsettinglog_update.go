@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantsettinglog"
+)
+
+// TenantSettingLogUpdate is the builder for updating TenantSettingLog entities.
+type TenantSettingLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantSettingLogMutation
+}
+
+// Where appends a list predicates to the TenantSettingLogUpdate builder.
+func (tslu *TenantSettingLogUpdate) Where(ps ...predicate.TenantSettingLog) *TenantSettingLogUpdate {
+	tslu.mutation.Where(ps...)
+	return tslu
+}
+
+// Mutation returns the TenantSettingLogMutation object of the builder.
+func (tslu *TenantSettingLogUpdate) Mutation() *TenantSettingLogMutation {
+	return tslu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tslu *TenantSettingLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tslu.sqlSave, tslu.mutation, tslu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tslu *TenantSettingLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := tslu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tslu *TenantSettingLogUpdate) Exec(ctx context.Context) error {
+	_, err := tslu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tslu *TenantSettingLogUpdate) ExecX(ctx context.Context) {
+	if err := tslu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tslu *TenantSettingLogUpdate) check() error {
+	if tslu.mutation.TenantCleared() && len(tslu.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantSettingLog.tenant"`)
+	}
+	return nil
+}
+
+func (tslu *TenantSettingLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tslu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsettinglog.Table, tenantsettinglog.Columns, sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64))
+	if ps := tslu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tslu.mutation.OldValueCleared() {
+		_spec.ClearField(tenantsettinglog.FieldOldValue, field.TypeJSON)
+	}
+	if tslu.mutation.NewValueCleared() {
+		_spec.ClearField(tenantsettinglog.FieldNewValue, field.TypeJSON)
+	}
+	if tslu.mutation.ReasonCleared() {
+		_spec.ClearField(tenantsettinglog.FieldReason, field.TypeString)
+	}
+	if tslu.mutation.OperatorIDCleared() {
+		_spec.ClearField(tenantsettinglog.FieldOperatorID, field.TypeInt64)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tslu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsettinglog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tslu.mutation.done = true
+	return n, nil
+}
+
+// TenantSettingLogUpdateOne is the builder for updating a single TenantSettingLog entity.
+type TenantSettingLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantSettingLogMutation
+}
+
+// Mutation returns the TenantSettingLogMutation object of the builder.
+func (tsluo *TenantSettingLogUpdateOne) Mutation() *TenantSettingLogMutation {
+	return tsluo.mutation
+}
+
+// Where appends a list predicates to the TenantSettingLogUpdate builder.
+func (tsluo *TenantSettingLogUpdateOne) Where(ps ...predicate.TenantSettingLog) *TenantSettingLogUpdateOne {
+	tsluo.mutation.Where(ps...)
+	return tsluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsluo *TenantSettingLogUpdateOne) Select(field string, fields ...string) *TenantSettingLogUpdateOne {
+	tsluo.fields = append([]string{field}, fields...)
+	return tsluo
+}
+
+// Save executes the query and returns the updated TenantSettingLog entity.
+func (tsluo *TenantSettingLogUpdateOne) Save(ctx context.Context) (*TenantSettingLog, error) {
+	return withHooks(ctx, tsluo.sqlSave, tsluo.mutation, tsluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsluo *TenantSettingLogUpdateOne) SaveX(ctx context.Context) *TenantSettingLog {
+	node, err := tsluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsluo *TenantSettingLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsluo *TenantSettingLogUpdateOne) ExecX(ctx context.Context) {
+	if err := tsluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsluo *TenantSettingLogUpdateOne) check() error {
+	if tsluo.mutation.TenantCleared() && len(tsluo.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantSettingLog.tenant"`)
+	}
+	return nil
+}
+
+func (tsluo *TenantSettingLogUpdateOne) sqlSave(ctx context.Context) (_node *TenantSettingLog, err error) {
+	if err := tsluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsettinglog.Table, tenantsettinglog.Columns, sqlgraph.NewFieldSpec(tenantsettinglog.FieldID, field.TypeInt64))
+	id, ok := tsluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantSettingLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsettinglog.FieldID)
+		for _, f := range fields {
+			if !tenantsettinglog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantsettinglog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tsluo.mutation.OldValueCleared() {
+		_spec.ClearField(tenantsettinglog.FieldOldValue, field.TypeJSON)
+	}
+	if tsluo.mutation.NewValueCleared() {
+		_spec.ClearField(tenantsettinglog.FieldNewValue, field.TypeJSON)
+	}
+	if tsluo.mutation.ReasonCleared() {
+		_spec.ClearField(tenantsettinglog.FieldReason, field.TypeString)
+	}
+	if tsluo.mutation.OperatorIDCleared() {
+		_spec.ClearField(tenantsettinglog.FieldOperatorID, field.TypeInt64)
+	}
+	_node = &TenantSettingLog{config: tsluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsettinglog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
+)
+
+// TenantStatusLog is the model entity for the TenantStatusLog schema.
+type TenantStatusLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Primary Key ID
+	ID int64 `json:"id,omitempty"`
+	// 租户ID
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// 状态变更操作
+	Action tenantstatuslog.Action `json:"action,omitempty"`
+	// 变更前状态
+	FromStatus string `json:"from_status,omitempty"`
+	// 变更后状态
+	ToStatus string `json:"to_status,omitempty"`
+	// 变更后的到期时间
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// 变更原因
+	Reason string `json:"reason,omitempty"`
+	// 操作人ID，为空表示系统自动变更
+	OperatorID *int64 `json:"operator_id,omitempty"`
+	// Creation timestamp of this record
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TenantStatusLogQuery when eager-loading is set.
+	Edges        TenantStatusLogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TenantStatusLogEdges holds the relations/edges for other nodes in the graph.
+type TenantStatusLogEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantStatusLogEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantStatusLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantstatuslog.FieldID, tenantstatuslog.FieldTenantID, tenantstatuslog.FieldOperatorID:
+			values[i] = new(sql.NullInt64)
+		case tenantstatuslog.FieldAction, tenantstatuslog.FieldFromStatus, tenantstatuslog.FieldToStatus, tenantstatuslog.FieldReason:
+			values[i] = new(sql.NullString)
+		case tenantstatuslog.FieldExpiredAt, tenantstatuslog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantStatusLog fields.
+func (tsl *TenantStatusLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantstatuslog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tsl.ID = int64(value.Int64)
+		case tenantstatuslog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				tsl.TenantID = value.Int64
+			}
+		case tenantstatuslog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				tsl.Action = tenantstatuslog.Action(value.String)
+			}
+		case tenantstatuslog.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				tsl.FromStatus = value.String
+			}
+		case tenantstatuslog.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				tsl.ToStatus = value.String
+			}
+		case tenantstatuslog.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				tsl.ExpiredAt = new(time.Time)
+				*tsl.ExpiredAt = value.Time
+			}
+		case tenantstatuslog.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				tsl.Reason = value.String
+			}
+		case tenantstatuslog.FieldOperatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value.Valid {
+				tsl.OperatorID = new(int64)
+				*tsl.OperatorID = value.Int64
+			}
+		case tenantstatuslog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsl.CreatedAt = value.Time
+			}
+		default:
+			tsl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantStatusLog.
+// This includes values selected through modifiers, order, etc.
+func (tsl *TenantStatusLog) Value(name string) (ent.Value, error) {
+	return tsl.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the TenantStatusLog entity.
+func (tsl *TenantStatusLog) QueryTenant() *TenantQuery {
+	return NewTenantStatusLogClient(tsl.config).QueryTenant(tsl)
+}
+
+// Update returns a builder for updating this TenantStatusLog.
+// Note that you need to call TenantStatusLog.Unwrap() before calling this method if this TenantStatusLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsl *TenantStatusLog) Update() *TenantStatusLogUpdateOne {
+	return NewTenantStatusLogClient(tsl.config).UpdateOne(tsl)
+}
+
+// Unwrap unwraps the TenantStatusLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsl *TenantStatusLog) Unwrap() *TenantStatusLog {
+	_tx, ok := tsl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantStatusLog is not a transactional entity")
+	}
+	tsl.config.driver = _tx.drv
+	return tsl
+}
+
+// String implements the fmt.Stringer.
+func (tsl *TenantStatusLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantStatusLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsl.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsl.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", tsl.Action))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(tsl.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(tsl.ToStatus)
+	builder.WriteString(", ")
+	if v := tsl.ExpiredAt; v != nil {
+		builder.WriteString("expired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(tsl.Reason)
+	builder.WriteString(", ")
+	if v := tsl.OperatorID; v != nil {
+		builder.WriteString("operator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tsl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantStatusLogs is a parsable slice of TenantStatusLog.
+type TenantStatusLogs []*TenantStatusLog
@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/tenant"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
+)
+
+// TenantStatusLogCreate is the builder for creating a TenantStatusLog entity.
+type TenantStatusLogCreate struct {
+	config
+	mutation *TenantStatusLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (tslc *TenantStatusLogCreate) SetTenantID(i int64) *TenantStatusLogCreate {
+	tslc.mutation.SetTenantID(i)
+	return tslc
+}
+
+// SetAction sets the "action" field.
+func (tslc *TenantStatusLogCreate) SetAction(t tenantstatuslog.Action) *TenantStatusLogCreate {
+	tslc.mutation.SetAction(t)
+	return tslc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (tslc *TenantStatusLogCreate) SetFromStatus(s string) *TenantStatusLogCreate {
+	tslc.mutation.SetFromStatus(s)
+	return tslc
+}
+
+// SetToStatus sets the "to_status" field.
+func (tslc *TenantStatusLogCreate) SetToStatus(s string) *TenantStatusLogCreate {
+	tslc.mutation.SetToStatus(s)
+	return tslc
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (tslc *TenantStatusLogCreate) SetExpiredAt(t time.Time) *TenantStatusLogCreate {
+	tslc.mutation.SetExpiredAt(t)
+	return tslc
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (tslc *TenantStatusLogCreate) SetNillableExpiredAt(t *time.Time) *TenantStatusLogCreate {
+	if t != nil {
+		tslc.SetExpiredAt(*t)
+	}
+	return tslc
+}
+
+// SetReason sets the "reason" field.
+func (tslc *TenantStatusLogCreate) SetReason(s string) *TenantStatusLogCreate {
+	tslc.mutation.SetReason(s)
+	return tslc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (tslc *TenantStatusLogCreate) SetNillableReason(s *string) *TenantStatusLogCreate {
+	if s != nil {
+		tslc.SetReason(*s)
+	}
+	return tslc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (tslc *TenantStatusLogCreate) SetOperatorID(i int64) *TenantStatusLogCreate {
+	tslc.mutation.SetOperatorID(i)
+	return tslc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (tslc *TenantStatusLogCreate) SetNillableOperatorID(i *int64) *TenantStatusLogCreate {
+	if i != nil {
+		tslc.SetOperatorID(*i)
+	}
+	return tslc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tslc *TenantStatusLogCreate) SetCreatedAt(t time.Time) *TenantStatusLogCreate {
+	tslc.mutation.SetCreatedAt(t)
+	return tslc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tslc *TenantStatusLogCreate) SetNillableCreatedAt(t *time.Time) *TenantStatusLogCreate {
+	if t != nil {
+		tslc.SetCreatedAt(*t)
+	}
+	return tslc
+}
+
+// SetID sets the "id" field.
+func (tslc *TenantStatusLogCreate) SetID(i int64) *TenantStatusLogCreate {
+	tslc.mutation.SetID(i)
+	return tslc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tslc *TenantStatusLogCreate) SetNillableID(i *int64) *TenantStatusLogCreate {
+	if i != nil {
+		tslc.SetID(*i)
+	}
+	return tslc
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (tslc *TenantStatusLogCreate) SetTenant(t *Tenant) *TenantStatusLogCreate {
+	return tslc.SetTenantID(t.ID)
+}
+
+// Mutation returns the TenantStatusLogMutation object of the builder.
+func (tslc *TenantStatusLogCreate) Mutation() *TenantStatusLogMutation {
+	return tslc.mutation
+}
+
+// Save creates the TenantStatusLog in the database.
+func (tslc *TenantStatusLogCreate) Save(ctx context.Context) (*TenantStatusLog, error) {
+	tslc.defaults()
+	return withHooks(ctx, tslc.sqlSave, tslc.mutation, tslc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tslc *TenantStatusLogCreate) SaveX(ctx context.Context) *TenantStatusLog {
+	v, err := tslc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tslc *TenantStatusLogCreate) Exec(ctx context.Context) error {
+	_, err := tslc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tslc *TenantStatusLogCreate) ExecX(ctx context.Context) {
+	if err := tslc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tslc *TenantStatusLogCreate) defaults() {
+	if _, ok := tslc.mutation.CreatedAt(); !ok {
+		v := tenantstatuslog.DefaultCreatedAt()
+		tslc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tslc.mutation.ID(); !ok {
+		v := tenantstatuslog.DefaultID()
+		tslc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tslc *TenantStatusLogCreate) check() error {
+	if _, ok := tslc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantStatusLog.tenant_id"`)}
+	}
+	if _, ok := tslc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "TenantStatusLog.action"`)}
+	}
+	if v, ok := tslc.mutation.Action(); ok {
+		if err := tenantstatuslog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TenantStatusLog.action": %w`, err)}
+		}
+	}
+	if _, ok := tslc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "TenantStatusLog.from_status"`)}
+	}
+	if _, ok := tslc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "TenantStatusLog.to_status"`)}
+	}
+	if _, ok := tslc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TenantStatusLog.created_at"`)}
+	}
+	if len(tslc.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TenantStatusLog.tenant"`)}
+	}
+	return nil
+}
+
+func (tslc *TenantStatusLogCreate) sqlSave(ctx context.Context) (*TenantStatusLog, error) {
+	if err := tslc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tslc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tslc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tslc.mutation.id = &_node.ID
+	tslc.mutation.done = true
+	return _node, nil
+}
+
+func (tslc *TenantStatusLogCreate) createSpec() (*TenantStatusLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantStatusLog{config: tslc.config}
+		_spec = sqlgraph.NewCreateSpec(tenantstatuslog.Table, sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = tslc.conflict
+	if id, ok := tslc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tslc.mutation.Action(); ok {
+		_spec.SetField(tenantstatuslog.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := tslc.mutation.FromStatus(); ok {
+		_spec.SetField(tenantstatuslog.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := tslc.mutation.ToStatus(); ok {
+		_spec.SetField(tenantstatuslog.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := tslc.mutation.ExpiredAt(); ok {
+		_spec.SetField(tenantstatuslog.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = &value
+	}
+	if value, ok := tslc.mutation.Reason(); ok {
+		_spec.SetField(tenantstatuslog.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := tslc.mutation.OperatorID(); ok {
+		_spec.SetField(tenantstatuslog.FieldOperatorID, field.TypeInt64, value)
+		_node.OperatorID = &value
+	}
+	if value, ok := tslc.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantstatuslog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tslc.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantstatuslog.TenantTable,
+			Columns: []string{tenantstatuslog.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantStatusLog.Create().
+//		SetTenantID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantStatusLogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tslc *TenantStatusLogCreate) OnConflict(opts ...sql.ConflictOption) *TenantStatusLogUpsertOne {
+	tslc.conflict = opts
+	return &TenantStatusLogUpsertOne{
+		create: tslc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantStatusLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tslc *TenantStatusLogCreate) OnConflictColumns(columns ...string) *TenantStatusLogUpsertOne {
+	tslc.conflict = append(tslc.conflict, sql.ConflictColumns(columns...))
+	return &TenantStatusLogUpsertOne{
+		create: tslc,
+	}
+}
+
+type (
+	// TenantStatusLogUpsertOne is the builder for "upsert"-ing
+	//  one TenantStatusLog node.
+	TenantStatusLogUpsertOne struct {
+		create *TenantStatusLogCreate
+	}
+
+	// TenantStatusLogUpsert is the "OnConflict" setter.
+	TenantStatusLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantStatusLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantstatuslog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantStatusLogUpsertOne) UpdateNewValues() *TenantStatusLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantstatuslog.FieldID)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantstatuslog.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(tenantstatuslog.FieldAction)
+		}
+		if _, exists := u.create.mutation.FromStatus(); exists {
+			s.SetIgnore(tenantstatuslog.FieldFromStatus)
+		}
+		if _, exists := u.create.mutation.ToStatus(); exists {
+			s.SetIgnore(tenantstatuslog.FieldToStatus)
+		}
+		if _, exists := u.create.mutation.ExpiredAt(); exists {
+			s.SetIgnore(tenantstatuslog.FieldExpiredAt)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(tenantstatuslog.FieldReason)
+		}
+		if _, exists := u.create.mutation.OperatorID(); exists {
+			s.SetIgnore(tenantstatuslog.FieldOperatorID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantstatuslog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantStatusLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantStatusLogUpsertOne) Ignore() *TenantStatusLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantStatusLogUpsertOne) DoNothing() *TenantStatusLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantStatusLogCreate.OnConflict
+// documentation for more info.
+func (u *TenantStatusLogUpsertOne) Update(set func(*TenantStatusLogUpsert)) *TenantStatusLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantStatusLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TenantStatusLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantStatusLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantStatusLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantStatusLogUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantStatusLogUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantStatusLogCreateBulk is the builder for creating many TenantStatusLog entities in bulk.
+type TenantStatusLogCreateBulk struct {
+	config
+	err      error
+	builders []*TenantStatusLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantStatusLog entities in the database.
+func (tslcb *TenantStatusLogCreateBulk) Save(ctx context.Context) ([]*TenantStatusLog, error) {
+	if tslcb.err != nil {
+		return nil, tslcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tslcb.builders))
+	nodes := make([]*TenantStatusLog, len(tslcb.builders))
+	mutators := make([]Mutator, len(tslcb.builders))
+	for i := range tslcb.builders {
+		func(i int, root context.Context) {
+			builder := tslcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantStatusLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tslcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tslcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tslcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tslcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tslcb *TenantStatusLogCreateBulk) SaveX(ctx context.Context) []*TenantStatusLog {
+	v, err := tslcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tslcb *TenantStatusLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := tslcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tslcb *TenantStatusLogCreateBulk) ExecX(ctx context.Context) {
+	if err := tslcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantStatusLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantStatusLogUpsert) {
+//			SetTenantID(v+v).
+//		}).
+//		Exec(ctx)
+func (tslcb *TenantStatusLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantStatusLogUpsertBulk {
+	tslcb.conflict = opts
+	return &TenantStatusLogUpsertBulk{
+		create: tslcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantStatusLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tslcb *TenantStatusLogCreateBulk) OnConflictColumns(columns ...string) *TenantStatusLogUpsertBulk {
+	tslcb.conflict = append(tslcb.conflict, sql.ConflictColumns(columns...))
+	return &TenantStatusLogUpsertBulk{
+		create: tslcb,
+	}
+}
+
+// TenantStatusLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantStatusLog nodes.
+type TenantStatusLogUpsertBulk struct {
+	create *TenantStatusLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantStatusLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantstatuslog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantStatusLogUpsertBulk) UpdateNewValues() *TenantStatusLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantstatuslog.FieldID)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantstatuslog.FieldTenantID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(tenantstatuslog.FieldAction)
+			}
+			if _, exists := b.mutation.FromStatus(); exists {
+				s.SetIgnore(tenantstatuslog.FieldFromStatus)
+			}
+			if _, exists := b.mutation.ToStatus(); exists {
+				s.SetIgnore(tenantstatuslog.FieldToStatus)
+			}
+			if _, exists := b.mutation.ExpiredAt(); exists {
+				s.SetIgnore(tenantstatuslog.FieldExpiredAt)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(tenantstatuslog.FieldReason)
+			}
+			if _, exists := b.mutation.OperatorID(); exists {
+				s.SetIgnore(tenantstatuslog.FieldOperatorID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantstatuslog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantStatusLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantStatusLogUpsertBulk) Ignore() *TenantStatusLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantStatusLogUpsertBulk) DoNothing() *TenantStatusLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantStatusLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantStatusLogUpsertBulk) Update(set func(*TenantStatusLogUpsert)) *TenantStatusLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantStatusLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TenantStatusLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantStatusLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantStatusLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantStatusLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
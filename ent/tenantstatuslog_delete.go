@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
+)
+
+// TenantStatusLogDelete is the builder for deleting a TenantStatusLog entity.
+type TenantStatusLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *TenantStatusLogMutation
+}
+
+// Where appends a list predicates to the TenantStatusLogDelete builder.
+func (tsld *TenantStatusLogDelete) Where(ps ...predicate.TenantStatusLog) *TenantStatusLogDelete {
+	tsld.mutation.Where(ps...)
+	return tsld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsld *TenantStatusLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsld.sqlExec, tsld.mutation, tsld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsld *TenantStatusLogDelete) ExecX(ctx context.Context) int {
+	n, err := tsld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsld *TenantStatusLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tenantstatuslog.Table, sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64))
+	if ps := tsld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsld.mutation.done = true
+	return affected, err
+}
+
+// TenantStatusLogDeleteOne is the builder for deleting a single TenantStatusLog entity.
+type TenantStatusLogDeleteOne struct {
+	tsld *TenantStatusLogDelete
+}
+
+// Where appends a list predicates to the TenantStatusLogDelete builder.
+func (tsldo *TenantStatusLogDeleteOne) Where(ps ...predicate.TenantStatusLog) *TenantStatusLogDeleteOne {
+	tsldo.tsld.mutation.Where(ps...)
+	return tsldo
+}
+
+// Exec executes the deletion query.
+func (tsldo *TenantStatusLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsldo.tsld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tenantstatuslog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsldo *TenantStatusLogDeleteOne) ExecX(ctx context.Context) {
+	if err := tsldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
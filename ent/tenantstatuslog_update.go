@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/yc-alpha/admin/ent/predicate"
+	"github.com/yc-alpha/admin/ent/tenantstatuslog"
+)
+
+// TenantStatusLogUpdate is the builder for updating TenantStatusLog entities.
+type TenantStatusLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantStatusLogMutation
+}
+
+// Where appends a list predicates to the TenantStatusLogUpdate builder.
+func (tslu *TenantStatusLogUpdate) Where(ps ...predicate.TenantStatusLog) *TenantStatusLogUpdate {
+	tslu.mutation.Where(ps...)
+	return tslu
+}
+
+// Mutation returns the TenantStatusLogMutation object of the builder.
+func (tslu *TenantStatusLogUpdate) Mutation() *TenantStatusLogMutation {
+	return tslu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tslu *TenantStatusLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tslu.sqlSave, tslu.mutation, tslu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tslu *TenantStatusLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := tslu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tslu *TenantStatusLogUpdate) Exec(ctx context.Context) error {
+	_, err := tslu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tslu *TenantStatusLogUpdate) ExecX(ctx context.Context) {
+	if err := tslu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tslu *TenantStatusLogUpdate) check() error {
+	if tslu.mutation.TenantCleared() && len(tslu.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantStatusLog.tenant"`)
+	}
+	return nil
+}
+
+func (tslu *TenantStatusLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tslu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantstatuslog.Table, tenantstatuslog.Columns, sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64))
+	if ps := tslu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tslu.mutation.ExpiredAtCleared() {
+		_spec.ClearField(tenantstatuslog.FieldExpiredAt, field.TypeTime)
+	}
+	if tslu.mutation.ReasonCleared() {
+		_spec.ClearField(tenantstatuslog.FieldReason, field.TypeString)
+	}
+	if tslu.mutation.OperatorIDCleared() {
+		_spec.ClearField(tenantstatuslog.FieldOperatorID, field.TypeInt64)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tslu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantstatuslog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tslu.mutation.done = true
+	return n, nil
+}
+
+// TenantStatusLogUpdateOne is the builder for updating a single TenantStatusLog entity.
+type TenantStatusLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantStatusLogMutation
+}
+
+// Mutation returns the TenantStatusLogMutation object of the builder.
+func (tsluo *TenantStatusLogUpdateOne) Mutation() *TenantStatusLogMutation {
+	return tsluo.mutation
+}
+
+// Where appends a list predicates to the TenantStatusLogUpdate builder.
+func (tsluo *TenantStatusLogUpdateOne) Where(ps ...predicate.TenantStatusLog) *TenantStatusLogUpdateOne {
+	tsluo.mutation.Where(ps...)
+	return tsluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsluo *TenantStatusLogUpdateOne) Select(field string, fields ...string) *TenantStatusLogUpdateOne {
+	tsluo.fields = append([]string{field}, fields...)
+	return tsluo
+}
+
+// Save executes the query and returns the updated TenantStatusLog entity.
+func (tsluo *TenantStatusLogUpdateOne) Save(ctx context.Context) (*TenantStatusLog, error) {
+	return withHooks(ctx, tsluo.sqlSave, tsluo.mutation, tsluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsluo *TenantStatusLogUpdateOne) SaveX(ctx context.Context) *TenantStatusLog {
+	node, err := tsluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsluo *TenantStatusLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsluo *TenantStatusLogUpdateOne) ExecX(ctx context.Context) {
+	if err := tsluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsluo *TenantStatusLogUpdateOne) check() error {
+	if tsluo.mutation.TenantCleared() && len(tsluo.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantStatusLog.tenant"`)
+	}
+	return nil
+}
+
+func (tsluo *TenantStatusLogUpdateOne) sqlSave(ctx context.Context) (_node *TenantStatusLog, err error) {
+	if err := tsluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantstatuslog.Table, tenantstatuslog.Columns, sqlgraph.NewFieldSpec(tenantstatuslog.FieldID, field.TypeInt64))
+	id, ok := tsluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantStatusLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantstatuslog.FieldID)
+		for _, f := range fields {
+			if !tenantstatuslog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantstatuslog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tsluo.mutation.ExpiredAtCleared() {
+		_spec.ClearField(tenantstatuslog.FieldExpiredAt, field.TypeTime)
+	}
+	if tsluo.mutation.ReasonCleared() {
+		_spec.ClearField(tenantstatuslog.FieldReason, field.TypeString)
+	}
+	if tsluo.mutation.OperatorIDCleared() {
+		_spec.ClearField(tenantstatuslog.FieldOperatorID, field.TypeInt64)
+	}
+	_node = &TenantStatusLog{config: tsluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantstatuslog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsluo.mutation.done = true
+	return _node, nil
+}
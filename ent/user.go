@@ -26,6 +26,8 @@ type User struct {
 	Phone *string `json:"phone,omitempty"`
 	// Password of the user
 	Password *string `json:"-"`
+	// Token version of the user, bumped on logout and password change to revoke issued tokens
+	TokenVersion int64 `json:"token_version,omitempty"`
 	// Status of the user
 	Status user.Status `json:"status,omitempty"`
 	// Full name of the user
@@ -110,7 +112,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldCreatedBy, user.FieldUpdatedBy:
+		case user.FieldID, user.FieldTokenVersion, user.FieldCreatedBy, user.FieldUpdatedBy:
 			values[i] = new(sql.NullInt64)
 		case user.FieldUsername, user.FieldEmail, user.FieldPhone, user.FieldPassword, user.FieldStatus, user.FieldFullName, user.FieldGender, user.FieldAvatar, user.FieldLanguage, user.FieldTimezone:
 			values[i] = new(sql.NullString)
@@ -164,6 +166,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.Password = new(string)
 				*u.Password = value.String
 			}
+		case user.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
+			} else if value.Valid {
+				u.TokenVersion = value.Int64
+			}
 		case user.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -306,6 +314,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.TokenVersion))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", u.Status))
 	builder.WriteString(", ")
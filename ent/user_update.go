@@ -106,6 +106,27 @@ func (uu *UserUpdate) ClearPassword() *UserUpdate {
 	return uu
 }
 
+// SetTokenVersion sets the "token_version" field.
+func (uu *UserUpdate) SetTokenVersion(i int64) *UserUpdate {
+	uu.mutation.ResetTokenVersion()
+	uu.mutation.SetTokenVersion(i)
+	return uu
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTokenVersion(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTokenVersion(*i)
+	}
+	return uu
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (uu *UserUpdate) AddTokenVersion(i int64) *UserUpdate {
+	uu.mutation.AddTokenVersion(i)
+	return uu
+}
+
 // SetStatus sets the "status" field.
 func (uu *UserUpdate) SetStatus(u user.Status) *UserUpdate {
 	uu.mutation.SetStatus(u)
@@ -546,6 +567,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.PasswordCleared() {
 		_spec.ClearField(user.FieldPassword, field.TypeString)
 	}
+	if value, ok := uu.mutation.TokenVersion(); ok {
+		_spec.SetField(user.FieldTokenVersion, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedTokenVersion(); ok {
+		_spec.AddField(user.FieldTokenVersion, field.TypeInt64, value)
+	}
 	if value, ok := uu.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 	}
@@ -871,6 +898,27 @@ func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
 	return uuo
 }
 
+// SetTokenVersion sets the "token_version" field.
+func (uuo *UserUpdateOne) SetTokenVersion(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTokenVersion()
+	uuo.mutation.SetTokenVersion(i)
+	return uuo
+}
+
+// SetNillableTokenVersion sets the "token_version" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTokenVersion(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTokenVersion(*i)
+	}
+	return uuo
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (uuo *UserUpdateOne) AddTokenVersion(i int64) *UserUpdateOne {
+	uuo.mutation.AddTokenVersion(i)
+	return uuo
+}
+
 // SetStatus sets the "status" field.
 func (uuo *UserUpdateOne) SetStatus(u user.Status) *UserUpdateOne {
 	uuo.mutation.SetStatus(u)
@@ -1341,6 +1389,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.PasswordCleared() {
 		_spec.ClearField(user.FieldPassword, field.TypeString)
 	}
+	if value, ok := uuo.mutation.TokenVersion(); ok {
+		_spec.SetField(user.FieldTokenVersion, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedTokenVersion(); ok {
+		_spec.AddField(user.FieldTokenVersion, field.TypeInt64, value)
+	}
 	if value, ok := uuo.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 	}
@@ -25,6 +25,8 @@ type UserDepartment struct {
 	DeptID int64 `json:"dept_id,omitempty"`
 	// Tenant ID
 	TenantID int64 `json:"tenant_id,omitempty"`
+	// 是否为主部门，用户在每个租户下只有一个主部门
+	IsPrimary bool `json:"is_primary,omitempty"`
 	// Attributes holds the value of the "attributes" field.
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -73,6 +75,8 @@ func (*UserDepartment) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userdepartment.FieldAttributes:
 			values[i] = new([]byte)
+		case userdepartment.FieldIsPrimary:
+			values[i] = new(sql.NullBool)
 		case userdepartment.FieldID, userdepartment.FieldUserID, userdepartment.FieldDeptID, userdepartment.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		default:
@@ -114,6 +118,12 @@ func (ud *UserDepartment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ud.TenantID = value.Int64
 			}
+		case userdepartment.FieldIsPrimary:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_primary", values[i])
+			} else if value.Valid {
+				ud.IsPrimary = value.Bool
+			}
 		case userdepartment.FieldAttributes:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field attributes", values[i])
@@ -177,6 +187,9 @@ func (ud *UserDepartment) String() string {
 	builder.WriteString("tenant_id=")
 	builder.WriteString(fmt.Sprintf("%v", ud.TenantID))
 	builder.WriteString(", ")
+	builder.WriteString("is_primary=")
+	builder.WriteString(fmt.Sprintf("%v", ud.IsPrimary))
+	builder.WriteString(", ")
 	builder.WriteString("attributes=")
 	builder.WriteString(fmt.Sprintf("%v", ud.Attributes))
 	builder.WriteByte(')')
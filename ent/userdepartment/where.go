@@ -68,6 +68,11 @@ func TenantID(v int64) predicate.UserDepartment {
 	return predicate.UserDepartment(sql.FieldEQ(FieldTenantID, v))
 }
 
+// IsPrimary applies equality check predicate on the "is_primary" field. It's identical to IsPrimaryEQ.
+func IsPrimary(v bool) predicate.UserDepartment {
+	return predicate.UserDepartment(sql.FieldEQ(FieldIsPrimary, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v int64) predicate.UserDepartment {
 	return predicate.UserDepartment(sql.FieldEQ(FieldUserID, v))
@@ -148,6 +153,16 @@ func TenantIDLTE(v int64) predicate.UserDepartment {
 	return predicate.UserDepartment(sql.FieldLTE(FieldTenantID, v))
 }
 
+// IsPrimaryEQ applies the EQ predicate on the "is_primary" field.
+func IsPrimaryEQ(v bool) predicate.UserDepartment {
+	return predicate.UserDepartment(sql.FieldEQ(FieldIsPrimary, v))
+}
+
+// IsPrimaryNEQ applies the NEQ predicate on the "is_primary" field.
+func IsPrimaryNEQ(v bool) predicate.UserDepartment {
+	return predicate.UserDepartment(sql.FieldNEQ(FieldIsPrimary, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserDepartment {
 	return predicate.UserDepartment(func(s *sql.Selector) {
@@ -41,6 +41,20 @@ func (udc *UserDepartmentCreate) SetTenantID(i int64) *UserDepartmentCreate {
 	return udc
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (udc *UserDepartmentCreate) SetIsPrimary(b bool) *UserDepartmentCreate {
+	udc.mutation.SetIsPrimary(b)
+	return udc
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (udc *UserDepartmentCreate) SetNillableIsPrimary(b *bool) *UserDepartmentCreate {
+	if b != nil {
+		udc.SetIsPrimary(*b)
+	}
+	return udc
+}
+
 // SetAttributes sets the "attributes" field.
 func (udc *UserDepartmentCreate) SetAttributes(m map[string]interface{}) *UserDepartmentCreate {
 	udc.mutation.SetAttributes(m)
@@ -98,6 +112,10 @@ func (udc *UserDepartmentCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (udc *UserDepartmentCreate) defaults() {
+	if _, ok := udc.mutation.IsPrimary(); !ok {
+		v := userdepartment.DefaultIsPrimary
+		udc.mutation.SetIsPrimary(v)
+	}
 	if _, ok := udc.mutation.Attributes(); !ok {
 		v := userdepartment.DefaultAttributes
 		udc.mutation.SetAttributes(v)
@@ -115,6 +133,9 @@ func (udc *UserDepartmentCreate) check() error {
 	if _, ok := udc.mutation.TenantID(); !ok {
 		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "UserDepartment.tenant_id"`)}
 	}
+	if _, ok := udc.mutation.IsPrimary(); !ok {
+		return &ValidationError{Name: "is_primary", err: errors.New(`ent: missing required field "UserDepartment.is_primary"`)}
+	}
 	if _, ok := udc.mutation.Attributes(); !ok {
 		return &ValidationError{Name: "attributes", err: errors.New(`ent: missing required field "UserDepartment.attributes"`)}
 	}
@@ -155,6 +176,10 @@ func (udc *UserDepartmentCreate) createSpec() (*UserDepartment, *sqlgraph.Create
 		_spec.SetField(userdepartment.FieldTenantID, field.TypeInt64, value)
 		_node.TenantID = value
 	}
+	if value, ok := udc.mutation.IsPrimary(); ok {
+		_spec.SetField(userdepartment.FieldIsPrimary, field.TypeBool, value)
+		_node.IsPrimary = value
+	}
 	if value, ok := udc.mutation.Attributes(); ok {
 		_spec.SetField(userdepartment.FieldAttributes, field.TypeJSON, value)
 		_node.Attributes = value
@@ -287,6 +312,18 @@ func (u *UserDepartmentUpsert) AddTenantID(v int64) *UserDepartmentUpsert {
 	return u
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (u *UserDepartmentUpsert) SetIsPrimary(v bool) *UserDepartmentUpsert {
+	u.Set(userdepartment.FieldIsPrimary, v)
+	return u
+}
+
+// UpdateIsPrimary sets the "is_primary" field to the value that was provided on create.
+func (u *UserDepartmentUpsert) UpdateIsPrimary() *UserDepartmentUpsert {
+	u.SetExcluded(userdepartment.FieldIsPrimary)
+	return u
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *UserDepartmentUpsert) SetAttributes(v map[string]interface{}) *UserDepartmentUpsert {
 	u.Set(userdepartment.FieldAttributes, v)
@@ -388,6 +425,20 @@ func (u *UserDepartmentUpsertOne) UpdateTenantID() *UserDepartmentUpsertOne {
 	})
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (u *UserDepartmentUpsertOne) SetIsPrimary(v bool) *UserDepartmentUpsertOne {
+	return u.Update(func(s *UserDepartmentUpsert) {
+		s.SetIsPrimary(v)
+	})
+}
+
+// UpdateIsPrimary sets the "is_primary" field to the value that was provided on create.
+func (u *UserDepartmentUpsertOne) UpdateIsPrimary() *UserDepartmentUpsertOne {
+	return u.Update(func(s *UserDepartmentUpsert) {
+		s.UpdateIsPrimary()
+	})
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *UserDepartmentUpsertOne) SetAttributes(v map[string]interface{}) *UserDepartmentUpsertOne {
 	return u.Update(func(s *UserDepartmentUpsert) {
@@ -655,6 +706,20 @@ func (u *UserDepartmentUpsertBulk) UpdateTenantID() *UserDepartmentUpsertBulk {
 	})
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (u *UserDepartmentUpsertBulk) SetIsPrimary(v bool) *UserDepartmentUpsertBulk {
+	return u.Update(func(s *UserDepartmentUpsert) {
+		s.SetIsPrimary(v)
+	})
+}
+
+// UpdateIsPrimary sets the "is_primary" field to the value that was provided on create.
+func (u *UserDepartmentUpsertBulk) UpdateIsPrimary() *UserDepartmentUpsertBulk {
+	return u.Update(func(s *UserDepartmentUpsert) {
+		s.UpdateIsPrimary()
+	})
+}
+
 // SetAttributes sets the "attributes" field.
 func (u *UserDepartmentUpsertBulk) SetAttributes(v map[string]interface{}) *UserDepartmentUpsertBulk {
 	return u.Update(func(s *UserDepartmentUpsert) {
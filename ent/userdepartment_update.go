@@ -78,6 +78,20 @@ func (udu *UserDepartmentUpdate) AddTenantID(i int64) *UserDepartmentUpdate {
 	return udu
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (udu *UserDepartmentUpdate) SetIsPrimary(b bool) *UserDepartmentUpdate {
+	udu.mutation.SetIsPrimary(b)
+	return udu
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (udu *UserDepartmentUpdate) SetNillableIsPrimary(b *bool) *UserDepartmentUpdate {
+	if b != nil {
+		udu.SetIsPrimary(*b)
+	}
+	return udu
+}
+
 // SetAttributes sets the "attributes" field.
 func (udu *UserDepartmentUpdate) SetAttributes(m map[string]interface{}) *UserDepartmentUpdate {
 	udu.mutation.SetAttributes(m)
@@ -173,6 +187,9 @@ func (udu *UserDepartmentUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := udu.mutation.AddedTenantID(); ok {
 		_spec.AddField(userdepartment.FieldTenantID, field.TypeInt64, value)
 	}
+	if value, ok := udu.mutation.IsPrimary(); ok {
+		_spec.SetField(userdepartment.FieldIsPrimary, field.TypeBool, value)
+	}
 	if value, ok := udu.mutation.Attributes(); ok {
 		_spec.SetField(userdepartment.FieldAttributes, field.TypeJSON, value)
 	}
@@ -303,6 +320,20 @@ func (uduo *UserDepartmentUpdateOne) AddTenantID(i int64) *UserDepartmentUpdateO
 	return uduo
 }
 
+// SetIsPrimary sets the "is_primary" field.
+func (uduo *UserDepartmentUpdateOne) SetIsPrimary(b bool) *UserDepartmentUpdateOne {
+	uduo.mutation.SetIsPrimary(b)
+	return uduo
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (uduo *UserDepartmentUpdateOne) SetNillableIsPrimary(b *bool) *UserDepartmentUpdateOne {
+	if b != nil {
+		uduo.SetIsPrimary(*b)
+	}
+	return uduo
+}
+
 // SetAttributes sets the "attributes" field.
 func (uduo *UserDepartmentUpdateOne) SetAttributes(m map[string]interface{}) *UserDepartmentUpdateOne {
 	uduo.mutation.SetAttributes(m)
@@ -428,6 +459,9 @@ func (uduo *UserDepartmentUpdateOne) sqlSave(ctx context.Context) (_node *UserDe
 	if value, ok := uduo.mutation.AddedTenantID(); ok {
 		_spec.AddField(userdepartment.FieldTenantID, field.TypeInt64, value)
 	}
+	if value, ok := uduo.mutation.IsPrimary(); ok {
+		_spec.SetField(userdepartment.FieldIsPrimary, field.TypeBool, value)
+	}
 	if value, ok := uduo.mutation.Attributes(); ok {
 		_spec.SetField(userdepartment.FieldAttributes, field.TypeJSON, value)
 	}
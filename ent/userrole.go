@@ -30,6 +30,10 @@ type UserRole struct {
 	GrantedAt time.Time `json:"granted_at,omitempty"`
 	// 过期时间,NULL表示永久有效
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 授予人ID
+	GrantedBy *int64 `json:"granted_by,omitempty"`
+	// 授予原因，临时提权时必填
+	Reason string `json:"reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserRoleQuery when eager-loading is set.
 	Edges        UserRoleEdges `json:"edges"`
@@ -87,8 +91,10 @@ func (*UserRole) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case userrole.FieldID, userrole.FieldUserID, userrole.FieldRoleID, userrole.FieldTenantID:
+		case userrole.FieldID, userrole.FieldUserID, userrole.FieldRoleID, userrole.FieldTenantID, userrole.FieldGrantedBy:
 			values[i] = new(sql.NullInt64)
+		case userrole.FieldReason:
+			values[i] = new(sql.NullString)
 		case userrole.FieldGrantedAt, userrole.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		default:
@@ -144,6 +150,19 @@ func (ur *UserRole) assignValues(columns []string, values []any) error {
 				ur.ExpiresAt = new(time.Time)
 				*ur.ExpiresAt = value.Time
 			}
+		case userrole.FieldGrantedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by", values[i])
+			} else if value.Valid {
+				ur.GrantedBy = new(int64)
+				*ur.GrantedBy = value.Int64
+			}
+		case userrole.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ur.Reason = value.String
+			}
 		default:
 			ur.selectValues.Set(columns[i], values[i])
 		}
@@ -213,6 +232,14 @@ func (ur *UserRole) String() string {
 		builder.WriteString("expires_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := ur.GrantedBy; v != nil {
+		builder.WriteString("granted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ur.Reason)
 	builder.WriteByte(')')
 	return builder.String()
 }
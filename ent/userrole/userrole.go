@@ -24,6 +24,10 @@ const (
 	FieldGrantedAt = "granted_at"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldGrantedBy holds the string denoting the granted_by field in the database.
+	FieldGrantedBy = "granted_by"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeRole holds the string denoting the role edge name in mutations.
@@ -63,6 +67,8 @@ var Columns = []string{
 	FieldTenantID,
 	FieldGrantedAt,
 	FieldExpiresAt,
+	FieldGrantedBy,
+	FieldReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -113,6 +119,16 @@ func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
 
+// ByGrantedBy orders the results by the granted_by field.
+func ByGrantedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrantedBy, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -80,6 +80,16 @@ func ExpiresAt(v time.Time) predicate.UserRole {
 	return predicate.UserRole(sql.FieldEQ(FieldExpiresAt, v))
 }
 
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldEQ(FieldReason, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v int64) predicate.UserRole {
 	return predicate.UserRole(sql.FieldEQ(FieldUserID, v))
@@ -240,6 +250,131 @@ func ExpiresAtNotNil() predicate.UserRole {
 	return predicate.UserRole(sql.FieldNotNull(FieldExpiresAt))
 }
 
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v int64) predicate.UserRole {
+	return predicate.UserRole(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// GrantedByIsNil applies the IsNil predicate on the "granted_by" field.
+func GrantedByIsNil() predicate.UserRole {
+	return predicate.UserRole(sql.FieldIsNull(FieldGrantedBy))
+}
+
+// GrantedByNotNil applies the NotNil predicate on the "granted_by" field.
+func GrantedByNotNil() predicate.UserRole {
+	return predicate.UserRole(sql.FieldNotNull(FieldGrantedBy))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.UserRole {
+	return predicate.UserRole(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.UserRole {
+	return predicate.UserRole(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.UserRole {
+	return predicate.UserRole(sql.FieldContainsFold(FieldReason, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.UserRole {
 	return predicate.UserRole(func(s *sql.Selector) {
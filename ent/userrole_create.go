@@ -79,6 +79,34 @@ func (urc *UserRoleCreate) SetNillableExpiresAt(t *time.Time) *UserRoleCreate {
 	return urc
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (urc *UserRoleCreate) SetGrantedBy(i int64) *UserRoleCreate {
+	urc.mutation.SetGrantedBy(i)
+	return urc
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (urc *UserRoleCreate) SetNillableGrantedBy(i *int64) *UserRoleCreate {
+	if i != nil {
+		urc.SetGrantedBy(*i)
+	}
+	return urc
+}
+
+// SetReason sets the "reason" field.
+func (urc *UserRoleCreate) SetReason(s string) *UserRoleCreate {
+	urc.mutation.SetReason(s)
+	return urc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (urc *UserRoleCreate) SetNillableReason(s *string) *UserRoleCreate {
+	if s != nil {
+		urc.SetReason(*s)
+	}
+	return urc
+}
+
 // SetID sets the "id" field.
 func (urc *UserRoleCreate) SetID(i int64) *UserRoleCreate {
 	urc.mutation.SetID(i)
@@ -199,6 +227,14 @@ func (urc *UserRoleCreate) createSpec() (*UserRole, *sqlgraph.CreateSpec) {
 		_spec.SetField(userrole.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = &value
 	}
+	if value, ok := urc.mutation.GrantedBy(); ok {
+		_spec.SetField(userrole.FieldGrantedBy, field.TypeInt64, value)
+		_node.GrantedBy = &value
+	}
+	if value, ok := urc.mutation.Reason(); ok {
+		_spec.SetField(userrole.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
 	if nodes := urc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -362,6 +398,48 @@ func (u *UserRoleUpsert) ClearExpiresAt() *UserRoleUpsert {
 	return u
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (u *UserRoleUpsert) SetGrantedBy(v int64) *UserRoleUpsert {
+	u.Set(userrole.FieldGrantedBy, v)
+	return u
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *UserRoleUpsert) UpdateGrantedBy() *UserRoleUpsert {
+	u.SetExcluded(userrole.FieldGrantedBy)
+	return u
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *UserRoleUpsert) AddGrantedBy(v int64) *UserRoleUpsert {
+	u.Add(userrole.FieldGrantedBy, v)
+	return u
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *UserRoleUpsert) ClearGrantedBy() *UserRoleUpsert {
+	u.SetNull(userrole.FieldGrantedBy)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *UserRoleUpsert) SetReason(v string) *UserRoleUpsert {
+	u.Set(userrole.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UserRoleUpsert) UpdateReason() *UserRoleUpsert {
+	u.SetExcluded(userrole.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *UserRoleUpsert) ClearReason() *UserRoleUpsert {
+	u.SetNull(userrole.FieldReason)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -483,6 +561,55 @@ func (u *UserRoleUpsertOne) ClearExpiresAt() *UserRoleUpsertOne {
 	})
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (u *UserRoleUpsertOne) SetGrantedBy(v int64) *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *UserRoleUpsertOne) AddGrantedBy(v int64) *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.AddGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *UserRoleUpsertOne) UpdateGrantedBy() *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *UserRoleUpsertOne) ClearGrantedBy() *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.ClearGrantedBy()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *UserRoleUpsertOne) SetReason(v string) *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UserRoleUpsertOne) UpdateReason() *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *UserRoleUpsertOne) ClearReason() *UserRoleUpsertOne {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.ClearReason()
+	})
+}
+
 // Exec executes the query.
 func (u *UserRoleUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -770,6 +897,55 @@ func (u *UserRoleUpsertBulk) ClearExpiresAt() *UserRoleUpsertBulk {
 	})
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (u *UserRoleUpsertBulk) SetGrantedBy(v int64) *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *UserRoleUpsertBulk) AddGrantedBy(v int64) *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.AddGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *UserRoleUpsertBulk) UpdateGrantedBy() *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (u *UserRoleUpsertBulk) ClearGrantedBy() *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.ClearGrantedBy()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *UserRoleUpsertBulk) SetReason(v string) *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UserRoleUpsertBulk) UpdateReason() *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *UserRoleUpsertBulk) ClearReason() *UserRoleUpsertBulk {
+	return u.Update(func(s *UserRoleUpsert) {
+		s.ClearReason()
+	})
+}
+
 // Exec executes the query.
 func (u *UserRoleUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -99,6 +99,53 @@ func (uru *UserRoleUpdate) ClearExpiresAt() *UserRoleUpdate {
 	return uru
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (uru *UserRoleUpdate) SetGrantedBy(i int64) *UserRoleUpdate {
+	uru.mutation.ResetGrantedBy()
+	uru.mutation.SetGrantedBy(i)
+	return uru
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableGrantedBy(i *int64) *UserRoleUpdate {
+	if i != nil {
+		uru.SetGrantedBy(*i)
+	}
+	return uru
+}
+
+// AddGrantedBy adds i to the "granted_by" field.
+func (uru *UserRoleUpdate) AddGrantedBy(i int64) *UserRoleUpdate {
+	uru.mutation.AddGrantedBy(i)
+	return uru
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (uru *UserRoleUpdate) ClearGrantedBy() *UserRoleUpdate {
+	uru.mutation.ClearGrantedBy()
+	return uru
+}
+
+// SetReason sets the "reason" field.
+func (uru *UserRoleUpdate) SetReason(s string) *UserRoleUpdate {
+	uru.mutation.SetReason(s)
+	return uru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableReason(s *string) *UserRoleUpdate {
+	if s != nil {
+		uru.SetReason(*s)
+	}
+	return uru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (uru *UserRoleUpdate) ClearReason() *UserRoleUpdate {
+	uru.mutation.ClearReason()
+	return uru
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (uru *UserRoleUpdate) SetUser(u *User) *UserRoleUpdate {
 	return uru.SetUserID(u.ID)
@@ -193,6 +240,21 @@ func (uru *UserRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uru.mutation.ExpiresAtCleared() {
 		_spec.ClearField(userrole.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := uru.mutation.GrantedBy(); ok {
+		_spec.SetField(userrole.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if value, ok := uru.mutation.AddedGrantedBy(); ok {
+		_spec.AddField(userrole.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if uru.mutation.GrantedByCleared() {
+		_spec.ClearField(userrole.FieldGrantedBy, field.TypeInt64)
+	}
+	if value, ok := uru.mutation.Reason(); ok {
+		_spec.SetField(userrole.FieldReason, field.TypeString, value)
+	}
+	if uru.mutation.ReasonCleared() {
+		_spec.ClearField(userrole.FieldReason, field.TypeString)
+	}
 	if uru.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -368,6 +430,53 @@ func (uruo *UserRoleUpdateOne) ClearExpiresAt() *UserRoleUpdateOne {
 	return uruo
 }
 
+// SetGrantedBy sets the "granted_by" field.
+func (uruo *UserRoleUpdateOne) SetGrantedBy(i int64) *UserRoleUpdateOne {
+	uruo.mutation.ResetGrantedBy()
+	uruo.mutation.SetGrantedBy(i)
+	return uruo
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableGrantedBy(i *int64) *UserRoleUpdateOne {
+	if i != nil {
+		uruo.SetGrantedBy(*i)
+	}
+	return uruo
+}
+
+// AddGrantedBy adds i to the "granted_by" field.
+func (uruo *UserRoleUpdateOne) AddGrantedBy(i int64) *UserRoleUpdateOne {
+	uruo.mutation.AddGrantedBy(i)
+	return uruo
+}
+
+// ClearGrantedBy clears the value of the "granted_by" field.
+func (uruo *UserRoleUpdateOne) ClearGrantedBy() *UserRoleUpdateOne {
+	uruo.mutation.ClearGrantedBy()
+	return uruo
+}
+
+// SetReason sets the "reason" field.
+func (uruo *UserRoleUpdateOne) SetReason(s string) *UserRoleUpdateOne {
+	uruo.mutation.SetReason(s)
+	return uruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableReason(s *string) *UserRoleUpdateOne {
+	if s != nil {
+		uruo.SetReason(*s)
+	}
+	return uruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (uruo *UserRoleUpdateOne) ClearReason() *UserRoleUpdateOne {
+	uruo.mutation.ClearReason()
+	return uruo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (uruo *UserRoleUpdateOne) SetUser(u *User) *UserRoleUpdateOne {
 	return uruo.SetUserID(u.ID)
@@ -492,6 +601,21 @@ func (uruo *UserRoleUpdateOne) sqlSave(ctx context.Context) (_node *UserRole, er
 	if uruo.mutation.ExpiresAtCleared() {
 		_spec.ClearField(userrole.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := uruo.mutation.GrantedBy(); ok {
+		_spec.SetField(userrole.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if value, ok := uruo.mutation.AddedGrantedBy(); ok {
+		_spec.AddField(userrole.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if uruo.mutation.GrantedByCleared() {
+		_spec.ClearField(userrole.FieldGrantedBy, field.TypeInt64)
+	}
+	if value, ok := uruo.mutation.Reason(); ok {
+		_spec.SetField(userrole.FieldReason, field.TypeString, value)
+	}
+	if uruo.mutation.ReasonCleared() {
+		_spec.ClearField(userrole.FieldReason, field.TypeString)
+	}
 	if uruo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
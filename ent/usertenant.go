@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,8 +24,12 @@ type UserTenant struct {
 	UserID int64 `json:"user_id,omitempty"`
 	// Tenant ID
 	TenantID int64 `json:"tenant_id,omitempty"`
-	// RoleLabels holds the value of the "role_labels" field.
+	// 成员在租户内的身份标签，仅用于展示和筛选，权限以角色授予为准
 	RoleLabels []string `json:"role_labels,omitempty"`
+	// User who added this member
+	CreatedBy *int64 `json:"created_by,omitempty"`
+	// Time when the user joined the tenant
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserTenantQuery when eager-loading is set.
 	Edges        UserTenantEdges `json:"edges"`
@@ -71,8 +76,10 @@ func (*UserTenant) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case usertenant.FieldRoleLabels:
 			values[i] = new([]byte)
-		case usertenant.FieldID, usertenant.FieldUserID, usertenant.FieldTenantID:
+		case usertenant.FieldID, usertenant.FieldUserID, usertenant.FieldTenantID, usertenant.FieldCreatedBy:
 			values[i] = new(sql.NullInt64)
+		case usertenant.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -114,6 +121,19 @@ func (ut *UserTenant) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field role_labels: %w", err)
 				}
 			}
+		case usertenant.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ut.CreatedBy = new(int64)
+				*ut.CreatedBy = value.Int64
+			}
+		case usertenant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ut.CreatedAt = value.Time
+			}
 		default:
 			ut.selectValues.Set(columns[i], values[i])
 		}
@@ -168,6 +188,14 @@ func (ut *UserTenant) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("role_labels=")
 	builder.WriteString(fmt.Sprintf("%v", ut.RoleLabels))
+	builder.WriteString(", ")
+	if v := ut.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ut.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
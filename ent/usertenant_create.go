@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -41,6 +42,34 @@ func (utc *UserTenantCreate) SetRoleLabels(s []string) *UserTenantCreate {
 	return utc
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (utc *UserTenantCreate) SetCreatedBy(i int64) *UserTenantCreate {
+	utc.mutation.SetCreatedBy(i)
+	return utc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (utc *UserTenantCreate) SetNillableCreatedBy(i *int64) *UserTenantCreate {
+	if i != nil {
+		utc.SetCreatedBy(*i)
+	}
+	return utc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utc *UserTenantCreate) SetCreatedAt(t time.Time) *UserTenantCreate {
+	utc.mutation.SetCreatedAt(t)
+	return utc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utc *UserTenantCreate) SetNillableCreatedAt(t *time.Time) *UserTenantCreate {
+	if t != nil {
+		utc.SetCreatedAt(*t)
+	}
+	return utc
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (utc *UserTenantCreate) SetUser(u *User) *UserTenantCreate {
 	return utc.SetUserID(u.ID)
@@ -58,6 +87,7 @@ func (utc *UserTenantCreate) Mutation() *UserTenantMutation {
 
 // Save creates the UserTenant in the database.
 func (utc *UserTenantCreate) Save(ctx context.Context) (*UserTenant, error) {
+	utc.defaults()
 	return withHooks(ctx, utc.sqlSave, utc.mutation, utc.hooks)
 }
 
@@ -83,6 +113,14 @@ func (utc *UserTenantCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (utc *UserTenantCreate) defaults() {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		v := usertenant.DefaultCreatedAt()
+		utc.mutation.SetCreatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (utc *UserTenantCreate) check() error {
 	if _, ok := utc.mutation.UserID(); !ok {
@@ -91,6 +129,9 @@ func (utc *UserTenantCreate) check() error {
 	if _, ok := utc.mutation.TenantID(); !ok {
 		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "UserTenant.tenant_id"`)}
 	}
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserTenant.created_at"`)}
+	}
 	if len(utc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserTenant.user"`)}
 	}
@@ -128,6 +169,14 @@ func (utc *UserTenantCreate) createSpec() (*UserTenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(usertenant.FieldRoleLabels, field.TypeJSON, value)
 		_node.RoleLabels = value
 	}
+	if value, ok := utc.mutation.CreatedBy(); ok {
+		_spec.SetField(usertenant.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := utc.mutation.CreatedAt(); ok {
+		_spec.SetField(usertenant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if nodes := utc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -256,6 +305,30 @@ func (u *UserTenantUpsert) ClearRoleLabels() *UserTenantUpsert {
 	return u
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (u *UserTenantUpsert) SetCreatedBy(v int64) *UserTenantUpsert {
+	u.Set(usertenant.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *UserTenantUpsert) UpdateCreatedBy() *UserTenantUpsert {
+	u.SetExcluded(usertenant.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *UserTenantUpsert) AddCreatedBy(v int64) *UserTenantUpsert {
+	u.Add(usertenant.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *UserTenantUpsert) ClearCreatedBy() *UserTenantUpsert {
+	u.SetNull(usertenant.FieldCreatedBy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -266,6 +339,11 @@ func (u *UserTenantUpsert) ClearRoleLabels() *UserTenantUpsert {
 //		Exec(ctx)
 func (u *UserTenantUpsertOne) UpdateNewValues() *UserTenantUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usertenant.FieldCreatedAt)
+		}
+	}))
 	return u
 }
 
@@ -345,6 +423,34 @@ func (u *UserTenantUpsertOne) ClearRoleLabels() *UserTenantUpsertOne {
 	})
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (u *UserTenantUpsertOne) SetCreatedBy(v int64) *UserTenantUpsertOne {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *UserTenantUpsertOne) AddCreatedBy(v int64) *UserTenantUpsertOne {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *UserTenantUpsertOne) UpdateCreatedBy() *UserTenantUpsertOne {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *UserTenantUpsertOne) ClearCreatedBy() *UserTenantUpsertOne {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
 // Exec executes the query.
 func (u *UserTenantUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -397,6 +503,7 @@ func (utcb *UserTenantCreateBulk) Save(ctx context.Context) ([]*UserTenant, erro
 	for i := range utcb.builders {
 		func(i int, root context.Context) {
 			builder := utcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*UserTenantMutation)
 				if !ok {
@@ -518,6 +625,13 @@ type UserTenantUpsertBulk struct {
 //		Exec(ctx)
 func (u *UserTenantUpsertBulk) UpdateNewValues() *UserTenantUpsertBulk {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usertenant.FieldCreatedAt)
+			}
+		}
+	}))
 	return u
 }
 
@@ -597,6 +711,34 @@ func (u *UserTenantUpsertBulk) ClearRoleLabels() *UserTenantUpsertBulk {
 	})
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (u *UserTenantUpsertBulk) SetCreatedBy(v int64) *UserTenantUpsertBulk {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *UserTenantUpsertBulk) AddCreatedBy(v int64) *UserTenantUpsertBulk {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *UserTenantUpsertBulk) UpdateCreatedBy() *UserTenantUpsertBulk {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *UserTenantUpsertBulk) ClearCreatedBy() *UserTenantUpsertBulk {
+	return u.Update(func(s *UserTenantUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
 // Exec executes the query.
 func (u *UserTenantUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
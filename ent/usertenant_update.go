@@ -76,6 +76,33 @@ func (utu *UserTenantUpdate) ClearRoleLabels() *UserTenantUpdate {
 	return utu
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (utu *UserTenantUpdate) SetCreatedBy(i int64) *UserTenantUpdate {
+	utu.mutation.ResetCreatedBy()
+	utu.mutation.SetCreatedBy(i)
+	return utu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (utu *UserTenantUpdate) SetNillableCreatedBy(i *int64) *UserTenantUpdate {
+	if i != nil {
+		utu.SetCreatedBy(*i)
+	}
+	return utu
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (utu *UserTenantUpdate) AddCreatedBy(i int64) *UserTenantUpdate {
+	utu.mutation.AddCreatedBy(i)
+	return utu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (utu *UserTenantUpdate) ClearCreatedBy() *UserTenantUpdate {
+	utu.mutation.ClearCreatedBy()
+	return utu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (utu *UserTenantUpdate) SetUser(u *User) *UserTenantUpdate {
 	return utu.SetUserID(u.ID)
@@ -164,6 +191,15 @@ func (utu *UserTenantUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if utu.mutation.RoleLabelsCleared() {
 		_spec.ClearField(usertenant.FieldRoleLabels, field.TypeJSON)
 	}
+	if value, ok := utu.mutation.CreatedBy(); ok {
+		_spec.SetField(usertenant.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := utu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(usertenant.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if utu.mutation.CreatedByCleared() {
+		_spec.ClearField(usertenant.FieldCreatedBy, field.TypeInt64)
+	}
 	if utu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -288,6 +324,33 @@ func (utuo *UserTenantUpdateOne) ClearRoleLabels() *UserTenantUpdateOne {
 	return utuo
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (utuo *UserTenantUpdateOne) SetCreatedBy(i int64) *UserTenantUpdateOne {
+	utuo.mutation.ResetCreatedBy()
+	utuo.mutation.SetCreatedBy(i)
+	return utuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (utuo *UserTenantUpdateOne) SetNillableCreatedBy(i *int64) *UserTenantUpdateOne {
+	if i != nil {
+		utuo.SetCreatedBy(*i)
+	}
+	return utuo
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (utuo *UserTenantUpdateOne) AddCreatedBy(i int64) *UserTenantUpdateOne {
+	utuo.mutation.AddCreatedBy(i)
+	return utuo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (utuo *UserTenantUpdateOne) ClearCreatedBy() *UserTenantUpdateOne {
+	utuo.mutation.ClearCreatedBy()
+	return utuo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (utuo *UserTenantUpdateOne) SetUser(u *User) *UserTenantUpdateOne {
 	return utuo.SetUserID(u.ID)
@@ -406,6 +469,15 @@ func (utuo *UserTenantUpdateOne) sqlSave(ctx context.Context) (_node *UserTenant
 	if utuo.mutation.RoleLabelsCleared() {
 		_spec.ClearField(usertenant.FieldRoleLabels, field.TypeJSON)
 	}
+	if value, ok := utuo.mutation.CreatedBy(); ok {
+		_spec.SetField(usertenant.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := utuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(usertenant.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if utuo.mutation.CreatedByCleared() {
+		_spec.ClearField(usertenant.FieldCreatedBy, field.TypeInt64)
+	}
 	if utuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,